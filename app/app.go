@@ -10,6 +10,7 @@ import (
 	evidencekeeper "github.com/cosmos/cosmos-sdk/x/evidence/keeper"
 
 	upgradev46 "github.com/Pylons-tech/pylons/app/upgrade"
+	"github.com/Pylons-tech/pylons/app/upgrade/nftmirror"
 
 	storetypes "github.com/cosmos/cosmos-sdk/store/types"
 	"github.com/cosmos/cosmos-sdk/version"
@@ -78,6 +79,8 @@ import (
 	ibchost "github.com/cosmos/ibc-go/v5/modules/core/24-host"
 	ibckeeper "github.com/cosmos/ibc-go/v5/modules/core/keeper"
 
+	"github.com/cosmos/cosmos-sdk/x/nft"
+	nftkeeper "github.com/cosmos/cosmos-sdk/x/nft/keeper"
 	"github.com/cosmos/cosmos-sdk/x/slashing"
 	slashingkeeper "github.com/cosmos/cosmos-sdk/x/slashing/keeper"
 	slashingtypes "github.com/cosmos/cosmos-sdk/x/slashing/types"
@@ -180,6 +183,7 @@ var (
 		evidence.AppModuleBasic{},
 		transfer.AppModuleBasic{},
 		vesting.AppModuleBasic{},
+		nftMirrorModuleBasic{},
 		pylonsmodule.AppModuleBasic{},
 	)
 
@@ -193,9 +197,16 @@ var (
 		govtypes.ModuleName:                     {authtypes.Burner},
 		ibctransfertypes.ModuleName:             {authtypes.Minter, authtypes.Burner},
 		icatypes.ModuleName:                     nil,
+		nft.ModuleName:                          nil,
 		pylonsmoduletypes.FeeCollectorName:      nil,
 		pylonsmoduletypes.TradesLockerName:      nil,
 		pylonsmoduletypes.ExecutionsLockerName:  {authtypes.Burner, authtypes.Minter},
+		pylonsmoduletypes.LendingsLockerName:    nil,
+		pylonsmoduletypes.AuctionsLockerName:    nil,
+		pylonsmoduletypes.OffersLockerName:      nil,
+		pylonsmoduletypes.SwapsLockerName:       nil,
+		pylonsmoduletypes.NFTTransferEscrowName: nil,
+		pylonsmoduletypes.ContainersLockerName:  nil,
 		pylonsmoduletypes.CoinsIssuerName:       {authtypes.Minter},
 		pylonsmoduletypes.PaymentsProcessorName: {authtypes.Burner, authtypes.Minter},
 	}
@@ -244,11 +255,13 @@ type PylonsApp struct {
 	IBCKeeper      *ibckeeper.Keeper // IBC Keeper must be a pointer in the app, so we can SetRouter on it correctly
 	EvidenceKeeper evidencekeeper.Keeper
 	TransferKeeper ibctransferkeeper.Keeper
+	NFTKeeper      nftkeeper.Keeper
 
 	// make scoped keepers public for test purposes
 	ScopedIBCKeeper      capabilitykeeper.ScopedKeeper
 	ScopedTransferKeeper capabilitykeeper.ScopedKeeper
 	ScopedICAHostKeeper  capabilitykeeper.ScopedKeeper
+	ScopedPylonsKeeper   capabilitykeeper.ScopedKeeper
 
 	PylonsKeeper  pylonsmodulekeeper.Keeper
 	ICAHostKeeper icahostkeeper.Keeper
@@ -301,6 +314,7 @@ func New(
 		ibctransfertypes.StoreKey,
 		icahosttypes.StoreKey,
 		capabilitytypes.StoreKey,
+		nftkeeper.StoreKey,
 		pylonsmoduletypes.StoreKey,
 	)
 
@@ -330,6 +344,7 @@ func New(
 	scopedIBCKeeper := app.CapabilityKeeper.ScopeToModule(ibchost.ModuleName)
 	scopedTransferKeeper := app.CapabilityKeeper.ScopeToModule(ibctransfertypes.ModuleName)
 	scopedICAHostKeeper := app.CapabilityKeeper.ScopeToModule(icahosttypes.SubModuleName)
+	scopedPylonsKeeper := app.CapabilityKeeper.ScopeToModule(pylonsmoduletypes.ModuleName)
 
 	// seal capability keeper after scoping modules
 	app.CapabilityKeeper.Seal()
@@ -426,13 +441,6 @@ func New(
 	transferModule := transfer.NewAppModule(app.TransferKeeper)
 	transferIBCModule := transfer.NewIBCModule(app.TransferKeeper)
 
-	// Create static IBC router, add transfer route, then set and seal it
-	ibcRouter := ibcporttypes.NewRouter()
-	ibcRouter.AddRoute(ibctransfertypes.ModuleName, transferIBCModule).
-		AddRoute(icahosttypes.SubModuleName, icaHostIBCModule)
-
-	app.IBCKeeper.SetRouter(ibcRouter)
-
 	// Create evidence Keeper for to register the IBC light client misbehaviour evidence route
 	evidenceKeeper := evidencekeeper.NewKeeper(
 		appCodec, keys[evidencetypes.StoreKey], &app.StakingKeeper, app.SlashingKeeper,
@@ -440,6 +448,9 @@ func New(
 	// If evidence needs to be handled for the app, set routes in router here and seal
 	app.EvidenceKeeper = *evidenceKeeper
 
+	app.NFTKeeper = nftkeeper.NewKeeper(keys[nftkeeper.StoreKey], appCodec, app.AccountKeeper, app.BankKeeper)
+	nftModule := newNFTMirrorModule(appCodec, app.NFTKeeper, app.AccountKeeper, app.BankKeeper, app.interfaceRegistry)
+
 	app.PylonsKeeper = pylonsmodulekeeper.NewKeeper(
 		appCodec,
 		keys[pylonsmoduletypes.StoreKey],
@@ -447,8 +458,22 @@ func New(
 		app.BankKeeper,
 		app.AccountKeeper,
 		app.TransferKeeper,
+		app.NFTKeeper,
+		app.IBCKeeper.ChannelKeeper,
+		&app.IBCKeeper.PortKeeper,
+		scopedPylonsKeeper,
 		app.GetSubspace(pylonsmoduletypes.ModuleName),
 	)
+	pylonsIBCModule := pylonsmodule.NewIBCModule(app.PylonsKeeper)
+
+	// Create static IBC router, add transfer route, then set and seal it
+	ibcRouter := ibcporttypes.NewRouter()
+	ibcRouter.AddRoute(ibctransfertypes.ModuleName, transferIBCModule).
+		AddRoute(icahosttypes.SubModuleName, icaHostIBCModule).
+		AddRoute(pylonsmoduletypes.ModuleName, pylonsIBCModule)
+
+	app.IBCKeeper.SetRouter(ibcRouter)
+
 	// upgrade handlers
 	cfg := module.NewConfigurator(appCodec, app.MsgServiceRouter(), app.GRPCQueryRouter())
 
@@ -489,6 +514,7 @@ func New(
 		params.NewAppModule(app.ParamsKeeper),
 		transferModule,
 		icaModule,
+		nftModule,
 		pylonsModule,
 	)
 
@@ -516,6 +542,7 @@ func New(
 		vestingtypes.ModuleName,
 		icatypes.ModuleName,
 		ibchost.ModuleName,
+		nft.ModuleName,
 		pylonsmoduletypes.ModuleName,
 	)
 
@@ -539,6 +566,7 @@ func New(
 		vestingtypes.ModuleName,
 		icatypes.ModuleName,
 		ibchost.ModuleName,
+		nft.ModuleName,
 		pylonsmoduletypes.ModuleName,
 	)
 
@@ -556,6 +584,7 @@ func New(
 		slashingtypes.ModuleName,
 		govtypes.ModuleName,
 		minttypes.ModuleName,
+		nft.ModuleName,
 		pylonsmoduletypes.ModuleName,
 		crisistypes.ModuleName,
 		genutiltypes.ModuleName,
@@ -625,6 +654,7 @@ func New(
 
 	app.ScopedIBCKeeper = scopedIBCKeeper
 	app.ScopedTransferKeeper = scopedTransferKeeper
+	app.ScopedPylonsKeeper = scopedPylonsKeeper
 	return app
 }
 
@@ -774,6 +804,7 @@ func (app *PylonsApp) RegisterTendermintService(clientCtx client.Context) {
 // RegisterUpgradeHandlers returns upgrade handlers
 func (app *PylonsApp) RegisterUpgradeHandlers(cfg module.Configurator) {
 	app.UpgradeKeeper.SetUpgradeHandler(upgradev46.UpgradeName, upgradev46.CreateUpgradeHandler(app.mm, app.configurator, &app.StakingKeeper, app.keys[pylonsmoduletypes.StoreKey], app.appCodec))
+	app.UpgradeKeeper.SetUpgradeHandler(nftmirror.UpgradeName, nftmirror.CreateUpgradeHandler(app.mm, app.configurator))
 }
 
 func (app *PylonsApp) setupUpgradeStoreLoaders() {
@@ -792,6 +823,14 @@ func (app *PylonsApp) setupUpgradeStoreLoaders() {
 		// configure store loader that checks if version == upgradeHeight and applies store upgrades
 		app.SetStoreLoader(upgradetypes.UpgradeStoreLoader(upgradeInfo.Height, &storeUpgrades))
 	}
+
+	if upgradeInfo.Name == nftmirror.UpgradeName && !app.UpgradeKeeper.IsSkipHeight(upgradeInfo.Height) {
+		storeUpgrades := storetypes.StoreUpgrades{
+			Added: []string{nftkeeper.StoreKey},
+		}
+
+		app.SetStoreLoader(upgradetypes.UpgradeStoreLoader(upgradeInfo.Height, &storeUpgrades))
+	}
 }
 
 // GetMaccPerms returns a copy of the module account permissions
@@ -0,0 +1,56 @@
+package app
+
+import (
+	"encoding/json"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	"github.com/cosmos/cosmos-sdk/codec/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/module"
+	"github.com/cosmos/cosmos-sdk/x/nft"
+	nftkeeper "github.com/cosmos/cosmos-sdk/x/nft/keeper"
+	nftmodule "github.com/cosmos/cosmos-sdk/x/nft/module"
+	"github.com/spf13/cobra"
+	abci "github.com/tendermint/tendermint/abci/types"
+)
+
+// The x/nft store only mirrors the pylons cookbooks and items, it is written by the pylons keeper.
+// The module is wrapped so it serves the standard queries without any way to modify the mirror.
+
+// nftMirrorModuleBasic is the x/nft AppModuleBasic without transaction commands
+type nftMirrorModuleBasic struct {
+	nftmodule.AppModuleBasic
+}
+
+// GetTxCmd returns no transaction commands, nfts are moved through the pylons messages
+func (nftMirrorModuleBasic) GetTxCmd() *cobra.Command {
+	return nil
+}
+
+// nftMirrorModule is the x/nft AppModule serving queries only
+type nftMirrorModule struct {
+	nftmodule.AppModule
+	keeper nftkeeper.Keeper
+}
+
+func newNFTMirrorModule(cdc codec.Codec, keeper nftkeeper.Keeper, ak nft.AccountKeeper, bk nft.BankKeeper, registry types.InterfaceRegistry) nftMirrorModule {
+	return nftMirrorModule{
+		AppModule: nftmodule.NewAppModule(cdc, keeper, ak, bk, registry),
+		keeper:    keeper,
+	}
+}
+
+// RegisterServices registers the x/nft query service only, MsgSend would desync the mirror
+func (am nftMirrorModule) RegisterServices(cfg module.Configurator) {
+	nft.RegisterQueryServer(cfg.QueryServer(), am.keeper)
+}
+
+// InitGenesis does nothing, the mirror is rebuilt by the pylons genesis
+func (am nftMirrorModule) InitGenesis(_ sdk.Context, _ codec.JSONCodec, _ json.RawMessage) []abci.ValidatorUpdate {
+	return []abci.ValidatorUpdate{}
+}
+
+// ExportGenesis returns an empty genesis state, the mirror is rebuilt by the pylons genesis
+func (am nftMirrorModule) ExportGenesis(_ sdk.Context, cdc codec.JSONCodec) json.RawMessage {
+	return cdc.MustMarshalJSON(nft.DefaultGenesisState())
+}
@@ -17,11 +17,50 @@ import (
 	tmtypes "github.com/tendermint/tendermint/types"
 	dbm "github.com/tendermint/tm-db"
 
+	"github.com/cosmos/cosmos-sdk/baseapp"
+	"github.com/cosmos/cosmos-sdk/client"
 	"github.com/cosmos/cosmos-sdk/simapp"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	capabilitykeeper "github.com/cosmos/cosmos-sdk/x/capability/keeper"
+	stakingkeeper "github.com/cosmos/cosmos-sdk/x/staking/keeper"
+	ibckeeper "github.com/cosmos/ibc-go/v5/modules/core/keeper"
+	ibctesting "github.com/cosmos/ibc-go/v5/testing"
 	"github.com/cosmos/ibc-go/v5/testing/mock"
 )
 
+var _ ibctesting.TestingApp = (*PylonsApp)(nil)
+
+// GetBaseApp implements the ibctesting.TestingApp interface.
+func (app *PylonsApp) GetBaseApp() *baseapp.BaseApp {
+	return app.BaseApp
+}
+
+// GetStakingKeeper implements the ibctesting.TestingApp interface.
+func (app *PylonsApp) GetStakingKeeper() stakingkeeper.Keeper {
+	return app.StakingKeeper
+}
+
+// GetIBCKeeper implements the ibctesting.TestingApp interface.
+func (app *PylonsApp) GetIBCKeeper() *ibckeeper.Keeper {
+	return app.IBCKeeper
+}
+
+// GetScopedIBCKeeper implements the ibctesting.TestingApp interface.
+func (app *PylonsApp) GetScopedIBCKeeper() capabilitykeeper.ScopedKeeper {
+	return app.ScopedIBCKeeper
+}
+
+// GetTxConfig implements the ibctesting.TestingApp interface.
+func (app *PylonsApp) GetTxConfig() client.TxConfig {
+	return MakeEncodingConfig().TxConfig
+}
+
+// SetupTestingApp initializes a new PylonsApp for the ibctesting chains, it is meant to be
+// assigned to ibctesting.DefaultTestingAppInit.
+func SetupTestingApp() (ibctesting.TestingApp, map[string]json.RawMessage) {
+	return setup(true)
+}
+
 // Setup initializes a new PylonsApp.
 func Setup(isCheckTx bool) *PylonsApp {
 	privVal := mock.NewPV()
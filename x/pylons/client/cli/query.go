@@ -25,10 +25,15 @@ func GetQueryCmd(queryRoute string) *cobra.Command {
 	}
 
 	cmd.AddCommand(CmdListTradesByCreator())
+	cmd.AddCommand(CmdListTradesByReceiver())
+	cmd.AddCommand(CmdSearchTrades())
+	cmd.AddCommand(CmdQuoteFulfillTrade())
 	cmd.AddCommand(CmdListReferralsByAddress())
 
 	cmd.AddCommand(CmdGetRecipeHistory())
 	cmd.AddCommand(CmdGetItemHistory())
+	cmd.AddCommand(CmdGetItemAttributesHistory())
+	cmd.AddCommand(CmdGetItemProvenance())
 	cmd.AddCommand(CmdGetStripeRefund())
 
 	// this line is used by starport scaffolding # 1
@@ -58,11 +63,22 @@ func GetQueryCmd(queryRoute string) *cobra.Command {
 	cmd.AddCommand(CmdListRecipesByCookbook())
 
 	cmd.AddCommand(CmdShowItem())
+	cmd.AddCommand(CmdSearchItems())
+	cmd.AddCommand(CmdListItemsByCookbook())
+	cmd.AddCommand(CmdListItemsByRecipe())
 
 	cmd.AddCommand(CmdShowRecipe())
 
 	cmd.AddCommand(CmdListCookbooksByCreator())
 	cmd.AddCommand(CmdShowCookbook())
 
+	cmd.AddCommand(CmdRecipeStats())
+	cmd.AddCommand(CmdCookbookStats())
+	cmd.AddCommand(CmdShowLending())
+	cmd.AddCommand(CmdShowAuction())
+	cmd.AddCommand(CmdShowDutchAuction())
+	cmd.AddCommand(CmdShowItemOffer())
+	cmd.AddCommand(CmdShowSwap())
+
 	return cmd
 }
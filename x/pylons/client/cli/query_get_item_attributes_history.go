@@ -0,0 +1,41 @@
+package cli
+
+import (
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/spf13/cobra"
+
+	"github.com/Pylons-tech/pylons/x/pylons/types"
+)
+
+func CmdGetItemAttributesHistory() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "get-item-attributes-history [cookbook-id] [item-id]",
+		Short: "Get the attribute updates of an item",
+		Args:  cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) (err error) {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			params := &types.QueryGetItemAttributesHistoryRequest{
+				CookbookId: args[0],
+				ItemId:     args[1],
+			}
+
+			res, err := queryClient.GetItemAttributesHistory(cmd.Context(), params)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
@@ -0,0 +1,46 @@
+package cli
+
+import (
+	"github.com/spf13/cobra"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+
+	"github.com/Pylons-tech/pylons/x/pylons/types"
+)
+
+func CmdGetItemProvenance() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "get-item-provenance [cookbook-id] [item-id]",
+		Short: "Get the mints, modifications, transfers, locks and burn of an item",
+		Args:  cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			params := &types.QueryGetItemProvenanceRequest{
+				CookbookId: args[0],
+				ItemId:     args[1],
+				Pagination: pageReq,
+			}
+
+			res, err := queryClient.GetItemProvenance(cmd.Context(), params)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddPaginationFlagsToCmd(cmd, cmd.Use)
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
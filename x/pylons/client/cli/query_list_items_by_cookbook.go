@@ -0,0 +1,81 @@
+package cli
+
+import (
+	"github.com/spf13/cobra"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+
+	"github.com/Pylons-tech/pylons/x/pylons/types"
+)
+
+func CmdListItemsByCookbook() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "list-items-by-cookbook [cookbook-id]",
+		Short: "list items by cookbook ID",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			params := &types.QueryListItemsByCookbookRequest{
+				CookbookId: args[0],
+				Pagination: pageReq,
+			}
+
+			res, err := queryClient.ListItemsByCookbook(cmd.Context(), params)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddPaginationFlagsToCmd(cmd, cmd.Use)
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func CmdListItemsByRecipe() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "list-items-by-recipe [cookbook-id] [recipe-id]",
+		Short: "list items created by a recipe",
+		Args:  cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			params := &types.QueryListItemsByRecipeRequest{
+				CookbookId: args[0],
+				RecipeId:   args[1],
+				Pagination: pageReq,
+			}
+
+			res, err := queryClient.ListItemsByRecipe(cmd.Context(), params)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddPaginationFlagsToCmd(cmd, cmd.Use)
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
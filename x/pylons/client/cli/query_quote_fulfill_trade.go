@@ -0,0 +1,67 @@
+package cli
+
+import (
+	"encoding/json"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/spf13/cast"
+	"github.com/spf13/cobra"
+
+	"github.com/Pylons-tech/pylons/x/pylons/types"
+)
+
+func CmdQuoteFulfillTrade() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "quote-fulfill-trade [id] [fulfiller] [coin-inputs-index] [items]",
+		Short: "quote the coins paid and received by the parties of a trade fulfillment",
+		Args:  cobra.ExactArgs(4),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			argsID, err := cast.ToUint64E(args[0])
+			if err != nil {
+				return err
+			}
+			if _, err := sdk.AccAddressFromBech32(args[1]); err != nil {
+				return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, err.Error())
+			}
+			argsCoinInputsIndex, err := cast.ToUint64E(args[2])
+			if err != nil {
+				return err
+			}
+			jsonArgsItems := make([]types.ItemRef, 0)
+			err = json.Unmarshal([]byte(args[3]), &jsonArgsItems)
+			if err != nil {
+				return err
+			}
+
+			clientCtx := client.GetClientContextFromCmd(cmd)
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			params := &types.QueryQuoteFulfillTradeRequest{
+				Id:              argsID,
+				Fulfiller:       args[1],
+				CoinInputsIndex: argsCoinInputsIndex,
+				Items:           jsonArgsItems,
+			}
+			params.FillAmount, err = cmd.Flags().GetUint64(flagFillAmount)
+			if err != nil {
+				return err
+			}
+
+			res, err := queryClient.QuoteFulfillTrade(cmd.Context(), params)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	cmd.Flags().Uint64(flagFillAmount, 0, "number of units of the trade to fill")
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
@@ -0,0 +1,161 @@
+package cli
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/spf13/cast"
+	"github.com/spf13/cobra"
+
+	"github.com/Pylons-tech/pylons/x/pylons/types"
+)
+
+const (
+	flagLong         = "long"
+	flagDouble       = "double"
+	flagString       = "string"
+	flagStringPrefix = "string-prefix"
+	flagOwner        = "owner"
+	flagTradeable    = "tradeable"
+	flagRecipeID     = "recipe-id"
+)
+
+// splitAttributeFilter splits a key=value attribute filter
+func splitAttributeFilter(filter string) (string, string, error) {
+	parts := strings.SplitN(filter, "=", 2)
+	if len(parts) != 2 || parts[0] == "" {
+		return "", "", fmt.Errorf("invalid attribute filter %s, expected key=value", filter)
+	}
+	return parts[0], parts[1], nil
+}
+
+// splitRangeFilter splits a key=min:max attribute filter
+func splitRangeFilter(filter string) (string, string, string, error) {
+	key, value, err := splitAttributeFilter(filter)
+	if err != nil {
+		return "", "", "", err
+	}
+	bounds := strings.SplitN(value, ":", 2)
+	if len(bounds) != 2 {
+		return "", "", "", fmt.Errorf("invalid range filter %s, expected key=min:max", filter)
+	}
+	return key, bounds[0], bounds[1], nil
+}
+
+func CmdSearchItems() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "search-items [cookbook-id]",
+		Short: "search the items of a cookbook by indexed attributes",
+		Long: `Search the items of a cookbook by the attributes indexed by the cookbook, owner, tradeable flag and recipe.
+
+Ranges are inclusive and a bound can be left empty, ex.: --long attack=50: matches the items with an attack of at least 50.`,
+		Example: `
+pylonsd query pylons search-items loud123456 --long attack=50: --string type=sword --tradeable true
+			`,
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			params := &types.QuerySearchItemsRequest{
+				CookbookId: args[0],
+				Pagination: pageReq,
+			}
+
+			longs, err := cmd.Flags().GetStringArray(flagLong)
+			if err != nil {
+				return err
+			}
+			for _, filter := range longs {
+				key, lower, upper, err := splitRangeFilter(filter)
+				if err != nil {
+					return err
+				}
+				params.Longs = append(params.Longs, types.LongAttributeFilter{Key: key, Min: lower, Max: upper})
+			}
+
+			doubles, err := cmd.Flags().GetStringArray(flagDouble)
+			if err != nil {
+				return err
+			}
+			for _, filter := range doubles {
+				key, lower, upper, err := splitRangeFilter(filter)
+				if err != nil {
+					return err
+				}
+				params.Doubles = append(params.Doubles, types.DoubleAttributeFilter{Key: key, Min: lower, Max: upper})
+			}
+
+			strs, err := cmd.Flags().GetStringArray(flagString)
+			if err != nil {
+				return err
+			}
+			for _, filter := range strs {
+				key, value, err := splitAttributeFilter(filter)
+				if err != nil {
+					return err
+				}
+				params.Strings = append(params.Strings, types.StringAttributeFilter{Key: key, Value: value})
+			}
+
+			prefixes, err := cmd.Flags().GetStringArray(flagStringPrefix)
+			if err != nil {
+				return err
+			}
+			for _, filter := range prefixes {
+				key, value, err := splitAttributeFilter(filter)
+				if err != nil {
+					return err
+				}
+				params.Strings = append(params.Strings, types.StringAttributeFilter{Key: key, Prefix: value})
+			}
+
+			params.Owner, err = cmd.Flags().GetString(flagOwner)
+			if err != nil {
+				return err
+			}
+			params.RecipeId, err = cmd.Flags().GetString(flagRecipeID)
+			if err != nil {
+				return err
+			}
+			tradeable, err := cmd.Flags().GetString(flagTradeable)
+			if err != nil {
+				return err
+			}
+			if tradeable != "" {
+				params.FilterTradeable = true
+				params.Tradeable, err = cast.ToBoolE(tradeable)
+				if err != nil {
+					return err
+				}
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.SearchItems(cmd.Context(), params)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	cmd.Flags().StringArray(flagLong, nil, "Longs attribute range, key=min:max")
+	cmd.Flags().StringArray(flagDouble, nil, "Doubles attribute range, key=min:max")
+	cmd.Flags().StringArray(flagString, nil, "Strings attribute value, key=value")
+	cmd.Flags().StringArray(flagStringPrefix, nil, "Strings attribute prefix, key=prefix")
+	cmd.Flags().String(flagOwner, "", "owner of the items")
+	cmd.Flags().String(flagTradeable, "", "tradeable flag of the items, true or false")
+	cmd.Flags().String(flagRecipeID, "", "recipe creating the items")
+	flags.AddPaginationFlagsToCmd(cmd, cmd.Use)
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
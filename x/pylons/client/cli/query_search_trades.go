@@ -0,0 +1,124 @@
+package cli
+
+import (
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/spf13/cobra"
+
+	"github.com/Pylons-tech/pylons/x/pylons/types"
+)
+
+const (
+	flagCookbookID = "cookbook-id"
+	flagDenom      = "denom"
+	flagMinPrice   = "min-price"
+	flagMaxPrice   = "max-price"
+	flagSort       = "sort"
+)
+
+func CmdSearchTrades() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "search-trades",
+		Short: "list the open trades by cookbook, price and attributes of the items offered",
+		Long: `List the open trades offering items of a cookbook, asking a price in a denom, and offering an item matching
+attribute filters, ordered by creation or by price.
+
+Ranges are inclusive and a bound can be left empty, ex.: --long attack=50: matches the trades offering an item with an
+attack of at least 50.`,
+		Example: `
+pylonsd query pylons search-trades --cookbook-id loud123456 --denom upylon --max-price 1000 --long attack=50: --sort price
+			`,
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			params := &types.QueryListTradesRequest{
+				Pagination: pageReq,
+			}
+
+			params.CookbookId, err = cmd.Flags().GetString(flagCookbookID)
+			if err != nil {
+				return err
+			}
+			params.Denom, err = cmd.Flags().GetString(flagDenom)
+			if err != nil {
+				return err
+			}
+			params.MinPrice, err = cmd.Flags().GetString(flagMinPrice)
+			if err != nil {
+				return err
+			}
+			params.MaxPrice, err = cmd.Flags().GetString(flagMaxPrice)
+			if err != nil {
+				return err
+			}
+			params.Sort, err = cmd.Flags().GetString(flagSort)
+			if err != nil {
+				return err
+			}
+
+			longs, err := cmd.Flags().GetStringArray(flagLong)
+			if err != nil {
+				return err
+			}
+			for _, filter := range longs {
+				key, lower, upper, err := splitRangeFilter(filter)
+				if err != nil {
+					return err
+				}
+				params.Longs = append(params.Longs, types.LongAttributeFilter{Key: key, Min: lower, Max: upper})
+			}
+
+			doubles, err := cmd.Flags().GetStringArray(flagDouble)
+			if err != nil {
+				return err
+			}
+			for _, filter := range doubles {
+				key, lower, upper, err := splitRangeFilter(filter)
+				if err != nil {
+					return err
+				}
+				params.Doubles = append(params.Doubles, types.DoubleAttributeFilter{Key: key, Min: lower, Max: upper})
+			}
+
+			strs, err := cmd.Flags().GetStringArray(flagString)
+			if err != nil {
+				return err
+			}
+			for _, filter := range strs {
+				key, value, err := splitAttributeFilter(filter)
+				if err != nil {
+					return err
+				}
+				params.Strings = append(params.Strings, types.StringAttributeFilter{Key: key, Value: value})
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.ListTrades(cmd.Context(), params)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	cmd.Flags().String(flagCookbookID, "", "cookbook of the items offered by the trades")
+	cmd.Flags().String(flagDenom, "", "denom of the price of the trades")
+	cmd.Flags().String(flagMinPrice, "", "lowest price per unit in denom")
+	cmd.Flags().String(flagMaxPrice, "", "highest price per unit in denom")
+	cmd.Flags().String(flagSort, types.TradeSortCreation, "order of the trades, creation or price")
+	cmd.Flags().StringArray(flagLong, nil, "Longs attribute range of an offered item, key=min:max")
+	cmd.Flags().StringArray(flagDouble, nil, "Doubles attribute range of an offered item, key=min:max")
+	cmd.Flags().StringArray(flagString, nil, "Strings attribute value of an offered item, key=value")
+	flags.AddPaginationFlagsToCmd(cmd, cmd.Use)
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
@@ -0,0 +1,68 @@
+package cli
+
+import (
+	"context"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/spf13/cobra"
+
+	"github.com/Pylons-tech/pylons/x/pylons/types"
+)
+
+func CmdRecipeStats() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "recipe-stats [cookbook-id] [recipe-id]",
+		Short: "retrieve the execution statistics of a recipe",
+		Args:  cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			params := &types.QueryRecipeStatsRequest{
+				CookbookId: args[0],
+				RecipeId:   args[1],
+			}
+
+			res, err := queryClient.RecipeStats(context.Background(), params)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func CmdCookbookStats() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "cookbook-stats [cookbook-id]",
+		Short: "retrieve the execution statistics of a cookbook",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			params := &types.QueryCookbookStatsRequest{
+				CookbookId: args[0],
+			}
+
+			res, err := queryClient.CookbookStats(context.Background(), params)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
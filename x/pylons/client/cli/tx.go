@@ -20,8 +20,20 @@ import (
 var DefaultRelativePacketTimeoutTimestamp = uint64((time.Duration(10) * time.Minute).Nanoseconds())
 
 const (
-	// nolint: deadcode, unused
+	flagPacketTimeoutHeight    = "packet-timeout-height"
 	flagPacketTimeoutTimestamp = "packet-timeout-timestamp"
+	flagMemo                   = "memo"
+	flagBurnRefund             = "burn-refund"
+	flagIndexedAttributes      = "indexed-attributes"
+	flagAttributePermission    = "attribute-permission"
+	flagAttributeSchema        = "attribute-schema"
+	flagReceiver               = "receiver"
+	flagExpiresAtHeight        = "expires-at-height"
+	flagExpiresAt              = "expires-at"
+	flagQuantity               = "quantity"
+	flagFillAmount             = "fill-amount"
+	flagDecay                  = "decay"
+	flagItemID                 = "item-id"
 )
 
 // GetTxCmd returns the transaction commands for this module
@@ -57,9 +69,36 @@ func GetTxCmd() *cobra.Command {
 
 	cmd.AddCommand(CmdSendItems())
 
+	cmd.AddCommand(CmdBurnItems())
+
+	cmd.AddCommand(CmdCreateLending())
+	cmd.AddCommand(CmdAcceptLending())
+	cmd.AddCommand(CmdCancelLending())
+	cmd.AddCommand(CmdCreateAuction())
+	cmd.AddCommand(CmdPlaceBid())
+	cmd.AddCommand(CmdCancelAuction())
+	cmd.AddCommand(CmdCreateDutchAuction())
+	cmd.AddCommand(CmdBuyDutchAuction())
+	cmd.AddCommand(CmdCancelDutchAuction())
+	cmd.AddCommand(CmdCreateItemOffer())
+	cmd.AddCommand(CmdAcceptItemOffer())
+	cmd.AddCommand(CmdCancelItemOffer())
+	cmd.AddCommand(CmdCreateSwap())
+	cmd.AddCommand(CmdDepositSwap())
+	cmd.AddCommand(CmdAbortSwap())
+
+	cmd.AddCommand(CmdApproveItem())
+	cmd.AddCommand(CmdRevokeItemApproval())
+	cmd.AddCommand(CmdSetOperator())
+
+	cmd.AddCommand(CmdTransferItems())
+	cmd.AddCommand(CmdDepositItems())
+	cmd.AddCommand(CmdWithdrawItems())
+
 	cmd.AddCommand(CmdExecuteRecipe())
 
 	cmd.AddCommand(CmdSetItemString())
+	cmd.AddCommand(CmdUpdateItemAttributes())
 
 	cmd.AddCommand(CmdCreateRecipe())
 	cmd.AddCommand(CmdUpdateRecipe())
@@ -0,0 +1,76 @@
+package cli
+
+import (
+	"encoding/json"
+
+	"github.com/spf13/cobra"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/client/tx"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	"github.com/Pylons-tech/pylons/x/pylons/types"
+)
+
+func CmdDepositItems() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "deposit-items [cookbook-id] [container-id] [item-ids]",
+		Short:   "put items into a container item of the same cookbook",
+		Example: `pylonsd tx pylons deposit-items cookbookLOUD containerID '["itemID1","itemID2"]'`,
+		Args:    cobra.ExactArgs(3),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			jsonArgsItemIDs := make([]string, 0)
+			err := json.Unmarshal([]byte(args[2]), &jsonArgsItemIDs)
+			if err != nil {
+				return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, err.Error())
+			}
+
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgDepositItems(clientCtx.GetFromAddress().String(), args[0], args[1], jsonArgsItemIDs)
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func CmdWithdrawItems() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "withdraw-items [cookbook-id] [container-id] [item-ids]",
+		Short:   "take items out of a container item",
+		Example: `pylonsd tx pylons withdraw-items cookbookLOUD containerID '["itemID1","itemID2"]'`,
+		Args:    cobra.ExactArgs(3),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			jsonArgsItemIDs := make([]string, 0)
+			err := json.Unmarshal([]byte(args[2]), &jsonArgsItemIDs)
+			if err != nil {
+				return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, err.Error())
+			}
+
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgWithdrawItems(clientCtx.GetFromAddress().String(), args[0], args[1], jsonArgsItemIDs)
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
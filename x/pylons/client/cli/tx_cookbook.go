@@ -1,8 +1,11 @@
 package cli
 
 import (
+	"encoding/json"
 	"fmt"
+	"strings"
 
+	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 
 	"github.com/spf13/cobra"
@@ -69,12 +72,32 @@ pylonsd tx pylons create-cookbook "loud123456" "Legend of the Undead Dragon" "Co
 				clientCtx = c
 			}
 			msg := types.NewMsgCreateCookbook(clientCtx.GetFromAddress().String(), id, argsName, argsDescription, argsDeveloper, argsVersion, argsSupportEmail, argsEnabled)
+			msg.BurnRefund, err = getBurnRefundFlag(cmd)
+			if err != nil {
+				return err
+			}
+			msg.IndexedAttributes, err = cmd.Flags().GetStringSlice(flagIndexedAttributes)
+			if err != nil {
+				return err
+			}
+			msg.AttributePermissions, err = getAttributePermissionsFlag(cmd)
+			if err != nil {
+				return err
+			}
+			msg.AttributeSchema, err = getAttributeSchemaFlag(cmd)
+			if err != nil {
+				return err
+			}
 			if err := msg.ValidateBasic(); err != nil {
 				return err
 			}
 			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
 		},
 	}
+	cmd.Flags().String(flagBurnRefund, "", "coins paid by the cookbook creator for each item burned, ex.: 10upylon")
+	cmd.Flags().StringSlice(flagIndexedAttributes, nil, "item attribute keys indexed for search-items, ex.: attack,name")
+	cmd.Flags().StringArray(flagAttributePermission, nil, "item attribute key updatable with update-item-attributes and its updaters besides the cookbook creator, ex.: attack=pylo1...,pylo1...")
+	cmd.Flags().String(flagAttributeSchema, "", "JSON list of the typed attributes of the cookbook items, ex.: [{\"key\":\"image\",\"type\":\"string\",\"required\":true,\"format\":\"url\"}]")
 	flags.AddTxFlagsToCmd(cmd)
 	return cmd
 }
@@ -103,6 +126,22 @@ func CmdUpdateCookbook() *cobra.Command {
 			}
 
 			msg := types.NewMsgUpdateCookbook(clientCtx.GetFromAddress().String(), id, argsName, argsDescription, argsDeveloper, argsVersion, argsSupportEmail, argsEnabled)
+			msg.BurnRefund, err = getBurnRefundFlag(cmd)
+			if err != nil {
+				return err
+			}
+			msg.IndexedAttributes, err = cmd.Flags().GetStringSlice(flagIndexedAttributes)
+			if err != nil {
+				return err
+			}
+			msg.AttributePermissions, err = getAttributePermissionsFlag(cmd)
+			if err != nil {
+				return err
+			}
+			msg.AttributeSchema, err = getAttributeSchemaFlag(cmd)
+			if err != nil {
+				return err
+			}
 			if err := msg.ValidateBasic(); err != nil {
 				return err
 			}
@@ -110,7 +149,55 @@ func CmdUpdateCookbook() *cobra.Command {
 		},
 	}
 
+	cmd.Flags().String(flagBurnRefund, "", "coins paid by the cookbook creator for each item burned, ex.: 10upylon")
+	cmd.Flags().StringSlice(flagIndexedAttributes, nil, "item attribute keys indexed for search-items, ex.: attack,name")
+	cmd.Flags().StringArray(flagAttributePermission, nil, "item attribute key updatable with update-item-attributes and its updaters besides the cookbook creator, ex.: attack=pylo1...,pylo1...")
+	cmd.Flags().String(flagAttributeSchema, "", "JSON list of the typed attributes of the cookbook items, ex.: [{\"key\":\"image\",\"type\":\"string\",\"required\":true,\"format\":\"url\"}]")
 	flags.AddTxFlagsToCmd(cmd)
 
 	return cmd
 }
+
+// getBurnRefundFlag parses the optional burn refund of a cookbook
+func getBurnRefundFlag(cmd *cobra.Command) (sdk.Coins, error) {
+	refund, err := cmd.Flags().GetString(flagBurnRefund)
+	if err != nil || refund == "" {
+		return nil, err
+	}
+	coins, err := sdk.ParseCoinsNormalized(refund)
+	if err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrInvalidCoins, err.Error())
+	}
+	return coins, nil
+}
+
+// getAttributePermissionsFlag parses the key[=updater,...] attribute permissions
+func getAttributePermissionsFlag(cmd *cobra.Command) ([]types.ItemAttributePermission, error) {
+	values, err := cmd.Flags().GetStringArray(flagAttributePermission)
+	if err != nil {
+		return nil, err
+	}
+	permissions := make([]types.ItemAttributePermission, 0, len(values))
+	for _, value := range values {
+		parts := strings.SplitN(value, "=", 2)
+		permission := types.ItemAttributePermission{Key: parts[0]}
+		if len(parts) == 2 && parts[1] != "" {
+			permission.Updaters = strings.Split(parts[1], ",")
+		}
+		permissions = append(permissions, permission)
+	}
+	return permissions, nil
+}
+
+// getAttributeSchemaFlag parses the JSON item attribute schema of a cookbook
+func getAttributeSchemaFlag(cmd *cobra.Command) ([]types.ItemAttributeSchema, error) {
+	value, err := cmd.Flags().GetString(flagAttributeSchema)
+	if err != nil || value == "" {
+		return nil, err
+	}
+	schema := make([]types.ItemAttributeSchema, 0)
+	if err = json.Unmarshal([]byte(value), &schema); err != nil {
+		return nil, err
+	}
+	return schema, nil
+}
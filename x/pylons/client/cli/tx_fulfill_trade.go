@@ -50,6 +50,10 @@ func CmdFulfillTrade() *cobra.Command {
 			}
 
 			msg := types.NewMsgFulfillTrade(clientCtx.GetFromAddress().String(), argsID, argsCoinInputsIndex, jsonArgsItems, jsonArgsPaymentInfo)
+			msg.FillAmount, err = cmd.Flags().GetUint64(flagFillAmount)
+			if err != nil {
+				return err
+			}
 			if err := msg.ValidateBasic(); err != nil {
 				return err
 			}
@@ -57,6 +61,7 @@ func CmdFulfillTrade() *cobra.Command {
 		},
 	}
 
+	cmd.Flags().Uint64(flagFillAmount, 0, "number of units of the trade to fill")
 	flags.AddTxFlagsToCmd(cmd)
 
 	return cmd
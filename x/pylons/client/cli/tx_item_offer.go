@@ -0,0 +1,123 @@
+package cli
+
+import (
+	"encoding/json"
+	"strconv"
+
+	"github.com/spf13/cobra"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/client/tx"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	"github.com/Pylons-tech/pylons/x/pylons/types"
+)
+
+func CmdCreateItemOffer() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "create-item-offer [cookbook-id] [item-input] [price]",
+		Short: "escrow coins to buy an item of a cookbook matching an item input from its owner",
+		Args:  cobra.ExactArgs(3),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			itemInput := types.ItemInput{}
+			err := json.Unmarshal([]byte(args[1]), &itemInput)
+			if err != nil {
+				return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, err.Error())
+			}
+
+			price, err := types.ParseCoinsCLI(args[2])
+			if err != nil {
+				return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, err.Error())
+			}
+
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			itemID, err := cmd.Flags().GetString(flagItemID)
+			if err != nil {
+				return err
+			}
+			msg := types.NewMsgCreateItemOffer(clientCtx.GetFromAddress().String(), args[0], itemID, itemInput, price)
+			msg.ExpiresAtHeight, err = cmd.Flags().GetInt64(flagExpiresAtHeight)
+			if err != nil {
+				return err
+			}
+			msg.ExpiresAt, err = cmd.Flags().GetInt64(flagExpiresAt)
+			if err != nil {
+				return err
+			}
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	cmd.Flags().String(flagItemID, "", "id of the only item the offer can buy")
+	cmd.Flags().Int64(flagExpiresAtHeight, 0, "block height at which the offer is cancelled")
+	cmd.Flags().Int64(flagExpiresAt, 0, "unix timestamp at which the offer is cancelled")
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func CmdAcceptItemOffer() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "accept-item-offer [id] [item-id]",
+		Short: "sell an owned item to an item offer",
+		Args:  cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			id, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, err.Error())
+			}
+
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgAcceptItemOffer(clientCtx.GetFromAddress().String(), id, args[1])
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func CmdCancelItemOffer() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "cancel-item-offer [id]",
+		Short: "cancel an item offer, refunding its escrowed coins",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			id, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, err.Error())
+			}
+
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgCancelItemOffer(clientCtx.GetFromAddress().String(), id)
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
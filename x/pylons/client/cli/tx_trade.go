@@ -54,6 +54,22 @@ func CmdCreateTrade() *cobra.Command {
 			}
 
 			msg := types.NewMsgCreateTrade(clientCtx.GetFromAddress().String(), jsonArgsCoinInputs, jsonArgsItemInputs, jsonArgsCoinOutput, jsonArgsItemOutputs, argsExtraInfo)
+			msg.Receiver, err = cmd.Flags().GetString(flagReceiver)
+			if err != nil {
+				return err
+			}
+			msg.ExpiresAtHeight, err = cmd.Flags().GetInt64(flagExpiresAtHeight)
+			if err != nil {
+				return err
+			}
+			msg.ExpiresAt, err = cmd.Flags().GetInt64(flagExpiresAt)
+			if err != nil {
+				return err
+			}
+			msg.Quantity, err = cmd.Flags().GetUint64(flagQuantity)
+			if err != nil {
+				return err
+			}
 			if err := msg.ValidateBasic(); err != nil {
 				return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, err.Error())
 			}
@@ -61,6 +77,10 @@ func CmdCreateTrade() *cobra.Command {
 		},
 	}
 
+	cmd.Flags().String(flagReceiver, "", "address of the only account allowed to fulfill the trade")
+	cmd.Flags().Int64(flagExpiresAtHeight, 0, "block height at which the trade is cancelled")
+	cmd.Flags().Int64(flagExpiresAt, 0, "unix timestamp at which the trade is cancelled")
+	cmd.Flags().Uint64(flagQuantity, 0, "number of units offered by the trade, inputs and outputs are given per unit")
 	flags.AddTxFlagsToCmd(cmd)
 
 	return cmd
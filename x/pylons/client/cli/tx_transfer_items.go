@@ -0,0 +1,76 @@
+package cli
+
+import (
+	"encoding/json"
+	"time"
+
+	"github.com/spf13/cobra"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/client/tx"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	clienttypes "github.com/cosmos/ibc-go/v5/modules/core/02-client/types"
+
+	"github.com/Pylons-tech/pylons/x/pylons/types"
+)
+
+func CmdTransferItems() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "transfer-items [src-port] [src-channel] [receiver] [cookbook-id] [item-ids]",
+		Short: "transfer items of a cookbook to another chain through an ICS-721 channel",
+		Long: `Transfer items of a cookbook to another chain through an ICS-721 channel.
+Timeouts can be specified as an absolute height on the receiving chain or as a timestamp relative to now.`,
+		Example: `pylonsd tx pylons transfer-items nft-transfer channel-0 <receiver> cookbookLOUD '["itemID1","itemID2"]'`,
+		Args:    cobra.ExactArgs(5),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			argsItemIDs := args[4]
+			jsonArgsItemIDs := make([]string, 0)
+			err := json.Unmarshal([]byte(argsItemIDs), &jsonArgsItemIDs)
+			if err != nil {
+				return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, err.Error())
+			}
+
+			timeoutHeightStr, err := cmd.Flags().GetString(flagPacketTimeoutHeight)
+			if err != nil {
+				return err
+			}
+			timeoutHeight, err := clienttypes.ParseHeight(timeoutHeightStr)
+			if err != nil {
+				return err
+			}
+
+			timeoutTimestamp, err := cmd.Flags().GetUint64(flagPacketTimeoutTimestamp)
+			if err != nil {
+				return err
+			}
+			// the timeout timestamp is relative to the current time
+			if timeoutTimestamp != 0 {
+				timeoutTimestamp += uint64(time.Now().UnixNano())
+			}
+
+			memo, err := cmd.Flags().GetString(flagMemo)
+			if err != nil {
+				return err
+			}
+
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgTransferItems(clientCtx.GetFromAddress().String(), args[0], args[1], args[3], jsonArgsItemIDs, args[2], timeoutHeight, timeoutTimestamp, memo)
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	cmd.Flags().String(flagPacketTimeoutHeight, "0-0", "Packet timeout block height on the receiving chain. The timeout is disabled when set to 0-0.")
+	cmd.Flags().Uint64(flagPacketTimeoutTimestamp, DefaultRelativePacketTimeoutTimestamp, "Packet timeout timestamp in nanoseconds relative to the current time. The timeout is disabled when set to 0.")
+	cmd.Flags().String(flagMemo, "", "Memo sent with the packet")
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
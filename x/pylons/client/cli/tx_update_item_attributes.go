@@ -0,0 +1,92 @@
+package cli
+
+import (
+	"strconv"
+
+	"github.com/spf13/cobra"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/client/tx"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/Pylons-tech/pylons/x/pylons/types"
+)
+
+func CmdUpdateItemAttributes() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "update-item-attributes [cookbook-id] [id]",
+		Short:   "set attributes of an item, as the cookbook creator or an updater of the attributes",
+		Example: "update-item-attributes cookbookID itemID --long attack=10 --double weight=1.5 --string name=sword",
+		Args:    cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			argsCookbookID := args[0]
+			argsID := args[1]
+
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			var doubles []types.DoubleKeyValue
+			values, err := cmd.Flags().GetStringArray(flagDouble)
+			if err != nil {
+				return err
+			}
+			for _, value := range values {
+				key, v, err := splitAttributeFilter(value)
+				if err != nil {
+					return err
+				}
+				dec, err := sdk.NewDecFromStr(v)
+				if err != nil {
+					return err
+				}
+				doubles = append(doubles, types.DoubleKeyValue{Key: key, Value: dec})
+			}
+
+			var longs []types.LongKeyValue
+			values, err = cmd.Flags().GetStringArray(flagLong)
+			if err != nil {
+				return err
+			}
+			for _, value := range values {
+				key, v, err := splitAttributeFilter(value)
+				if err != nil {
+					return err
+				}
+				long, err := strconv.ParseInt(v, 10, 64)
+				if err != nil {
+					return err
+				}
+				longs = append(longs, types.LongKeyValue{Key: key, Value: long})
+			}
+
+			var strs []types.StringKeyValue
+			values, err = cmd.Flags().GetStringArray(flagString)
+			if err != nil {
+				return err
+			}
+			for _, value := range values {
+				key, v, err := splitAttributeFilter(value)
+				if err != nil {
+					return err
+				}
+				strs = append(strs, types.StringKeyValue{Key: key, Value: v})
+			}
+
+			msg := types.NewMsgUpdateItemAttributes(clientCtx.GetFromAddress().String(), argsCookbookID, argsID, doubles, longs, strs)
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	cmd.Flags().StringArray(flagDouble, nil, "Doubles attribute value, key=value")
+	cmd.Flags().StringArray(flagLong, nil, "Longs attribute value, key=value")
+	cmd.Flags().StringArray(flagString, nil, "Strings attribute value, key=value")
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
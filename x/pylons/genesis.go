@@ -35,6 +35,91 @@ func InitGenesis(ctx sdk.Context, k keeper.Keeper, genState types.GenesisState)
 	// Set trade count
 	k.SetTradeCount(ctx, genState.TradeCount)
 
+	// Set all the lending
+	for _, elem := range genState.LendingList {
+		k.SetLending(ctx, elem)
+	}
+
+	// Set lending count
+	k.SetLendingCount(ctx, genState.LendingCount)
+
+	// Set all the auction
+	for _, elem := range genState.AuctionList {
+		k.SetAuction(ctx, elem)
+	}
+
+	// Set auction count
+	k.SetAuctionCount(ctx, genState.AuctionCount)
+
+	// Set all the dutch auction
+	for _, elem := range genState.DutchAuctionList {
+		k.SetDutchAuction(ctx, elem)
+	}
+
+	// Set dutch auction count
+	k.SetDutchAuctionCount(ctx, genState.DutchAuctionCount)
+
+	// Set all the item offer
+	for _, elem := range genState.ItemOfferList {
+		k.SetItemOffer(ctx, elem)
+	}
+
+	// Set item offer count
+	k.SetItemOfferCount(ctx, genState.ItemOfferCount)
+
+	// Set all the swap
+	for _, elem := range genState.SwapList {
+		k.SetSwap(ctx, elem)
+	}
+
+	// Set swap count
+	k.SetSwapCount(ctx, genState.SwapCount)
+
+	// Set all the recipe stats
+	for _, elem := range genState.RecipeStatsList {
+		k.SetRecipeStats(ctx, elem)
+	}
+
+	// Set all the cookbook stats
+	for _, elem := range genState.CookbookStatsList {
+		k.SetCookbookStats(ctx, elem)
+	}
+
+	// Set all the recipe executors
+	for _, elem := range genState.RecipeExecutorsList {
+		k.SetRecipeExecutors(ctx, elem)
+	}
+
+	// Set all the cookbook executors
+	for _, elem := range genState.CookbookExecutorsList {
+		k.SetCookbookExecutors(ctx, elem)
+	}
+
+	// Set all the item approval
+	for _, elem := range genState.ItemApprovalList {
+		k.SetItemApproval(ctx, elem)
+	}
+
+	// Set all the item operator
+	for _, elem := range genState.ItemOperatorList {
+		k.SetItemOperator(ctx, elem)
+	}
+
+	// Set all the class trace
+	for _, elem := range genState.ClassTraceList {
+		k.SetClassTrace(ctx, elem)
+	}
+
+	// Set all the item token
+	for _, elem := range genState.ItemTokenList {
+		k.SetItemToken(ctx, elem)
+	}
+
+	// Set all the item escrow
+	for _, elem := range genState.ItemEscrowList {
+		k.SetItemEscrow(ctx, elem)
+	}
+
 	// Set all the googlIAPOrder
 	for _, elem := range genState.GoogleInAppPurchaseOrderList {
 		k.SetGoogleIAPOrder(ctx, elem)
@@ -75,6 +160,17 @@ func InitGenesis(ctx sdk.Context, k keeper.Keeper, genState types.GenesisState)
 	}
 
 	k.SetParams(ctx, genState.Params)
+
+	// Only try to bind to the nft transfer port if it is not already bound, since we may already own
+	// the port capability from a capability InitGenesis
+	if !k.IsBound(ctx, types.NFTTransferPortID) {
+		// module binds to the port on InitChain
+		// and claims the returned capability
+		err := k.BindPort(ctx, types.NFTTransferPortID)
+		if err != nil {
+			panic("could not claim port capability: " + err.Error())
+		}
+	}
 }
 
 // ExportGenesis returns the capability module's exported genesis.
@@ -109,6 +205,77 @@ func ExportGenesis(ctx sdk.Context, k keeper.Keeper) *types.GenesisState {
 	// Set the current count
 	genesis.TradeCount = k.GetTradeCount(ctx)
 
+	// Get all lending
+	lendingList := k.GetAllLending(ctx)
+	genesis.LendingList = append(genesis.LendingList, lendingList...)
+
+	// Set the current count
+	genesis.LendingCount = k.GetLendingCount(ctx)
+
+	// Get all auction
+	auctionList := k.GetAllAuction(ctx)
+	genesis.AuctionList = append(genesis.AuctionList, auctionList...)
+
+	// Set the current count
+	genesis.AuctionCount = k.GetAuctionCount(ctx)
+
+	// Get all dutch auction
+	dutchAuctionList := k.GetAllDutchAuction(ctx)
+	genesis.DutchAuctionList = append(genesis.DutchAuctionList, dutchAuctionList...)
+
+	// Set the current count
+	genesis.DutchAuctionCount = k.GetDutchAuctionCount(ctx)
+
+	// Get all item offer
+	itemOfferList := k.GetAllItemOffer(ctx)
+	genesis.ItemOfferList = append(genesis.ItemOfferList, itemOfferList...)
+
+	// Set the current count
+	genesis.ItemOfferCount = k.GetItemOfferCount(ctx)
+
+	// Get all swap
+	swapList := k.GetAllSwap(ctx)
+	genesis.SwapList = append(genesis.SwapList, swapList...)
+
+	// Set the current count
+	genesis.SwapCount = k.GetSwapCount(ctx)
+
+	// Get all recipe stats
+	recipeStatsList := k.GetAllRecipeStats(ctx)
+	genesis.RecipeStatsList = append(genesis.RecipeStatsList, recipeStatsList...)
+
+	// Get all cookbook stats
+	cookbookStatsList := k.GetAllCookbookStats(ctx)
+	genesis.CookbookStatsList = append(genesis.CookbookStatsList, cookbookStatsList...)
+
+	// Get all recipe executors
+	recipeExecutorsList := k.GetAllRecipeExecutors(ctx)
+	genesis.RecipeExecutorsList = append(genesis.RecipeExecutorsList, recipeExecutorsList...)
+
+	// Get all cookbook executors
+	cookbookExecutorsList := k.GetAllCookbookExecutors(ctx)
+	genesis.CookbookExecutorsList = append(genesis.CookbookExecutorsList, cookbookExecutorsList...)
+
+	// Get all item approval
+	itemApprovalList := k.GetAllItemApproval(ctx)
+	genesis.ItemApprovalList = append(genesis.ItemApprovalList, itemApprovalList...)
+
+	// Get all item operator
+	itemOperatorList := k.GetAllItemOperator(ctx)
+	genesis.ItemOperatorList = append(genesis.ItemOperatorList, itemOperatorList...)
+
+	// Get all class trace
+	classTraceList := k.GetAllClassTrace(ctx)
+	genesis.ClassTraceList = append(genesis.ClassTraceList, classTraceList...)
+
+	// Get all item token
+	itemTokenList := k.GetAllItemToken(ctx)
+	genesis.ItemTokenList = append(genesis.ItemTokenList, itemTokenList...)
+
+	// Get all item escrow
+	itemEscrowList := k.GetAllItemEscrow(ctx)
+	genesis.ItemEscrowList = append(genesis.ItemEscrowList, itemEscrowList...)
+
 	// Get all googlIAPOrder
 	googlIAPOrderList := k.GetAllGoogleIAPOrder(ctx)
 	genesis.GoogleInAppPurchaseOrderList = append(genesis.GoogleInAppPurchaseOrderList, googlIAPOrderList...)
@@ -0,0 +1,305 @@
+package keeper
+
+import (
+	"encoding/binary"
+	"strconv"
+
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	"github.com/Pylons-tech/pylons/x/pylons/types"
+)
+
+// GetAuctionCount get the total number of auctions
+func (k Keeper) GetAuctionCount(ctx sdk.Context) uint64 {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.AuctionCountKey))
+	byteKey := types.KeyPrefix(types.AuctionCountKey)
+	bz := store.Get(byteKey)
+
+	// Count doesn't exist: no element
+	if bz == nil {
+		return 0
+	}
+
+	// Parse bytes
+	count, err := strconv.ParseUint(string(bz), 10, 64)
+	if err != nil {
+		// Panic because the count should be always formattable to uint64
+		panic("cannot decode count")
+	}
+
+	return count
+}
+
+// SetAuctionCount set the total number of auctions
+func (k Keeper) SetAuctionCount(ctx sdk.Context, count uint64) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.AuctionCountKey))
+	byteKey := types.KeyPrefix(types.AuctionCountKey)
+	bz := []byte(strconv.FormatUint(count, 10))
+	store.Set(byteKey, bz)
+}
+
+// AppendAuction appends an auction in the store with a new id and update the count
+func (k Keeper) AppendAuction(ctx sdk.Context, auction types.Auction) uint64 {
+	count := k.GetAuctionCount(ctx)
+
+	auction.Id = count
+	k.SetAuction(ctx, auction)
+
+	k.SetAuctionCount(ctx, count+1)
+
+	return count
+}
+
+// SetAuction set a specific auction in the store, indexing it by end time
+func (k Keeper) SetAuction(ctx sdk.Context, auction types.Auction) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.AuctionKey))
+	b := k.cdc.MustMarshal(&auction)
+	store.Set(sdk.Uint64ToBigEndian(auction.Id), b)
+
+	endStore := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.AuctionEndKey))
+	endStore.Set(getAuctionEndKey(auction), sdk.Uint64ToBigEndian(auction.Id))
+}
+
+// GetAuction returns an auction from its id
+func (k Keeper) GetAuction(ctx sdk.Context, id uint64) (val types.Auction, found bool) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.AuctionKey))
+	b := store.Get(sdk.Uint64ToBigEndian(id))
+	if b == nil {
+		return val, false
+	}
+	k.cdc.MustUnmarshal(b, &val)
+	return val, true
+}
+
+// RemoveAuction removes an auction from the store along with its end time index
+func (k Keeper) RemoveAuction(ctx sdk.Context, auction types.Auction) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.AuctionKey))
+	store.Delete(sdk.Uint64ToBigEndian(auction.Id))
+
+	endStore := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.AuctionEndKey))
+	endStore.Delete(getAuctionEndKey(auction))
+}
+
+// removeAuctionEnd removes an auction from the end time index, so it is no longer settled by SettleEndedAuctions
+func (k Keeper) removeAuctionEnd(ctx sdk.Context, auction types.Auction) {
+	endStore := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.AuctionEndKey))
+	endStore.Delete(getAuctionEndKey(auction))
+}
+
+// GetAllAuction returns all auctions
+func (k Keeper) GetAllAuction(ctx sdk.Context) (list []types.Auction) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.AuctionKey))
+	iterator := sdk.KVStorePrefixIterator(store, []byte{})
+
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var val types.Auction
+		k.cdc.MustUnmarshal(iterator.Value(), &val)
+		list = append(list, val)
+	}
+
+	return
+}
+
+// GetAuctionsEndedAtTime returns at most limit auctions whose end time is lower or equal to blockTime
+func (k Keeper) GetAuctionsEndedAtTime(ctx sdk.Context, blockTime int64, limit int) (list []types.Auction) {
+	endStore := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.AuctionEndKey))
+	iterator := endStore.Iterator(nil, sdk.Uint64ToBigEndian(uint64(blockTime)+1))
+
+	defer iterator.Close()
+
+	for ; iterator.Valid() && len(list) < limit; iterator.Next() {
+		auction, found := k.GetAuction(ctx, binary.BigEndian.Uint64(iterator.Value()))
+		if found {
+			list = append(list, auction)
+		}
+	}
+
+	return
+}
+
+// lockAuctionedItems checks that the items can be auctioned by their owner for at least minPrice, and locks them. The
+// auctioned amount of a fungible item is split from it, and the returned references point to the locked items
+func (k Keeper) lockAuctionedItems(ctx sdk.Context, owner string, itemRefs []types.ItemRef, minPrice sdk.Coin) ([]types.ItemRef, error) {
+	items := make([]types.Item, 0, len(itemRefs))
+	for _, itemRef := range itemRefs {
+		item, found := k.GetItem(ctx, itemRef.CookbookId, itemRef.ItemId)
+		if !found {
+			return nil, sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "item with id %v and cookbook id %v not found", itemRef.ItemId, itemRef.CookbookId)
+		}
+		if item.Owner != owner {
+			return nil, sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "item with id %v and cookbook id %v not owned", itemRef.ItemId, itemRef.CookbookId)
+		}
+		if !item.Tradeable {
+			return nil, sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "item with id %v and cookbook id %v cannot be traded", itemRef.ItemId, itemRef.CookbookId)
+		}
+		if item.IsExpired(ctx) {
+			return nil, sdkerrors.Wrapf(types.ErrItemExpired, "item with id %v and cookbook id %v expired", itemRef.ItemId, itemRef.CookbookId)
+		}
+		if err := item.CanTransfer(ctx); err != nil {
+			return nil, err
+		}
+		items = append(items, item)
+	}
+
+	_, err := types.FindValidPaymentsPermutation(items, sdk.NewCoins(minPrice))
+	if err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrInvalidCoins, "auction price cannot satisfy items transferFees requirements")
+	}
+
+	locked := make([]types.ItemRef, 0, len(itemRefs))
+	for i, itemRef := range itemRefs {
+		item := items[i]
+		if itemRef.Amount != 0 {
+			item, err = k.SplitItem(ctx, item, itemRef.Amount)
+			if err != nil {
+				return nil, err
+			}
+			itemRef.ItemId = item.Id
+		}
+		k.LockItemForAuction(ctx, item)
+		locked = append(locked, itemRef)
+	}
+
+	return locked, nil
+}
+
+// unlockAuctionedItems gives back the items locked by an auction to their seller
+func (k Keeper) unlockAuctionedItems(ctx sdk.Context, seller string, itemRefs []types.ItemRef) {
+	for _, itemRef := range itemRefs {
+		item, _ := k.GetItem(ctx, itemRef.CookbookId, itemRef.ItemId)
+		k.UnlockItemForAuction(ctx, item, seller)
+		item.Owner = seller
+		k.MergeItem(ctx, item)
+	}
+}
+
+// SettleAuction sells the auctioned items to the highest bidder, paying the transfer fees and royalties of the items
+// out of the escrowed bid. The items are given back to the seller when no bid was placed, or when one of them expired
+// in which case the bid is refunded. The auction is removed, and the returned bool tells if the items were sold
+func (k Keeper) SettleAuction(ctx sdk.Context, auction types.Auction) (bool, error) {
+	if !auction.HasBid() {
+		k.unlockAuctionedItems(ctx, auction.Creator, auction.Items)
+		k.RemoveAuction(ctx, auction)
+		return false, nil
+	}
+
+	items := make([]types.Item, len(auction.Items))
+	for i, itemRef := range auction.Items {
+		items[i], _ = k.GetItem(ctx, itemRef.CookbookId, itemRef.ItemId)
+		if items[i].IsExpired(ctx) {
+			return false, k.refundAuction(ctx, auction)
+		}
+	}
+
+	winnerAddr, _ := sdk.AccAddressFromBech32(auction.HighestBidder)
+	price := sdk.NewCoins(auction.HighestBid)
+	err := k.UnLockCoinsForAuction(ctx, winnerAddr, price)
+	if err != nil {
+		return false, err
+	}
+	err = k.sellAuctionedItems(ctx, items, auction.Creator, auction.HighestBidder, price)
+	if err != nil {
+		return false, err
+	}
+
+	k.RemoveAuction(ctx, auction)
+	return true, nil
+}
+
+// refundAuction gives the highest bid back to its bidder and the items back to the seller, and removes the auction
+func (k Keeper) refundAuction(ctx sdk.Context, auction types.Auction) error {
+	if auction.HasBid() {
+		bidderAddr, _ := sdk.AccAddressFromBech32(auction.HighestBidder)
+		err := k.UnLockCoinsForAuction(ctx, bidderAddr, sdk.NewCoins(auction.HighestBid))
+		if err != nil {
+			return err
+		}
+	}
+	k.unlockAuctionedItems(ctx, auction.Creator, auction.Items)
+	k.RemoveAuction(ctx, auction)
+	return nil
+}
+
+// sellAuctionedItems pays the price of items locked by the auctions locker from the buyer account, the transfer fees
+// and royalties of the items going to the chain and the cookbook owners and the rest to the seller, and gives the items
+// to the buyer
+func (k Keeper) sellAuctionedItems(ctx sdk.Context, items []types.Item, seller, buyer string, price sdk.Coins) error {
+	permutation, err := types.FindValidPaymentsPermutation(items, price)
+	if err != nil {
+		return err
+	}
+	chainFees, royalties, proceeds := k.itemTransferFees(ctx, items, permutation, price)
+	sellerAddr, _ := sdk.AccAddressFromBech32(seller)
+	buyerAddr, _ := sdk.AccAddressFromBech32(buyer)
+	err = k.payItemTransferFees(ctx, buyerAddr, sellerAddr, chainFees, royalties, proceeds)
+	if err != nil {
+		return err
+	}
+
+	to, _ := k.GetUsernameByAddress(ctx, buyer)
+	from, _ := k.GetUsernameByAddress(ctx, seller)
+	for _, item := range items {
+		item.RecordTransfer(ctx)
+		k.UnlockItemForAuction(ctx, item, buyer)
+		item.Owner = buyer
+		k.SetItemHistory(ctx, item.NewItemHistory(ctx, to.Value, from.Value))
+		provenance := item.NewItemProvenance(ctx, types.ItemProvenanceAuction, seller, buyer)
+		provenance.Price = price
+		k.AppendItemProvenance(ctx, provenance)
+		k.MergeItem(ctx, item)
+	}
+
+	return nil
+}
+
+// SettleEndedAuctions settles at most limit ended auctions, returning the number of settled auctions. An auction that
+// cannot be settled is refunded. If it cannot be refunded either, it is removed from the end time index so it does
+// not take a slot of the next sweeps, and an EventSettleAuctionFailed is emitted
+func (k Keeper) SettleEndedAuctions(ctx sdk.Context, limit int) int {
+	auctions := k.GetAuctionsEndedAtTime(ctx, ctx.BlockTime().Unix(), limit)
+	settled := 0
+	for _, auction := range auctions {
+		cacheCtx, write := ctx.CacheContext()
+		sold, err := k.SettleAuction(cacheCtx, auction)
+		if err != nil {
+			k.Logger(ctx).Error("cannot settle auction, refunding it", "id", auction.Id, "error", err)
+			cacheCtx, write = ctx.CacheContext()
+			err = k.refundAuction(cacheCtx, auction)
+			if err != nil {
+				// this should never happen, it means the module account has been drained of funds illegitimately
+				k.Logger(ctx).Error("cannot refund auction", "id", auction.Id, "error", err)
+				k.removeAuctionEnd(ctx, auction)
+				_ = ctx.EventManager().EmitTypedEvent(&types.EventSettleAuctionFailed{
+					Creator: auction.Creator,
+					Id:      auction.Id,
+					Error:   err.Error(),
+				})
+				continue
+			}
+		}
+		write()
+		ctx.EventManager().EmitEvents(cacheCtx.EventManager().Events())
+		settled++
+
+		event := types.EventSettleAuction{
+			Creator: auction.Creator,
+			Id:      auction.Id,
+			Price:   sdk.NewCoin(auction.HighestBid.Denom, sdk.ZeroInt()),
+			Items:   auction.Items,
+		}
+		if sold {
+			event.Winner = auction.HighestBidder
+			event.Price = auction.HighestBid
+		}
+		_ = ctx.EventManager().EmitTypedEvent(&event)
+	}
+	return settled
+}
+
+func getAuctionEndKey(auction types.Auction) []byte {
+	return append(sdk.Uint64ToBigEndian(uint64(auction.EndTime)), sdk.Uint64ToBigEndian(auction.Id)...)
+}
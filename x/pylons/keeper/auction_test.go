@@ -0,0 +1,111 @@
+package keeper_test
+
+import (
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/Pylons-tech/pylons/x/pylons/keeper"
+	"github.com/Pylons-tech/pylons/x/pylons/types"
+)
+
+func (suite *IntegrationTestSuite) TestAuctionGet() {
+	k := suite.k
+	ctx := suite.ctx
+	require := suite.Require()
+	items := createNAuction(k, ctx, 10)
+	for _, item := range items {
+		auction, found := k.GetAuction(ctx, item.Id)
+		require.True(found)
+		require.Equal(item, auction)
+	}
+}
+
+func (suite *IntegrationTestSuite) TestAuctionRemove() {
+	k := suite.k
+	ctx := suite.ctx
+	require := suite.Require()
+	items := createNAuction(k, ctx, 10)
+	for _, item := range items {
+		k.RemoveAuction(ctx, item)
+		_, found := k.GetAuction(ctx, item.Id)
+		require.False(found)
+	}
+	require.Empty(k.GetAuctionsEndedAtTime(ctx, 2000, 10))
+}
+
+func (suite *IntegrationTestSuite) TestAuctionGetAll() {
+	k := suite.k
+	ctx := suite.ctx
+	require := suite.Require()
+	items := createNAuction(k, ctx, 10)
+	require.Equal(items, k.GetAllAuction(ctx))
+	require.Equal(uint64(len(items)), k.GetAuctionCount(ctx))
+}
+
+func (suite *IntegrationTestSuite) TestAuctionsEndedAtTime() {
+	k := suite.k
+	ctx := suite.ctx
+	require := suite.Require()
+	items := createNAuction(k, ctx, 3)
+
+	require.Empty(k.GetAuctionsEndedAtTime(ctx, 999, 10))
+	require.Equal(items[:1], k.GetAuctionsEndedAtTime(ctx, 1000, 10))
+	require.Equal(items[:2], k.GetAuctionsEndedAtTime(ctx, 1001, 10))
+	require.Equal(items[:2], k.GetAuctionsEndedAtTime(ctx, 2000, 2))
+}
+
+func (suite *IntegrationTestSuite) TestSettleEndedAuctionsWithoutBid() {
+	k := suite.k
+	ctx := suite.ctx.WithBlockTime(time.Unix(1000, 0))
+	require := suite.Require()
+
+	wctx := sdk.WrapSDKContext(ctx)
+	srv := keeper.NewMsgServerImpl(k)
+
+	cookbook := createNCookbook(k, ctx, 1)[0]
+	items := createNItemSameOwnerAndCookbook(k, ctx, 2, cookbook.Id, true)
+	seller := items[0].Owner
+	refs := []types.ItemRef{{CookbookId: cookbook.Id, ItemId: items[0].Id}, {CookbookId: cookbook.Id, ItemId: items[1].Id}}
+	reserve := sdk.NewCoin(types.PylonsCoinDenom, sdk.NewInt(200))
+
+	res, err := srv.CreateAuction(wctx, types.NewMsgCreateAuction(seller, refs, reserve, 1100))
+	require.NoError(err)
+
+	// the auction is settled once its end time is reached
+	require.Equal(0, k.SettleEndedAuctions(ctx, types.MaxSettledAuctionsPerBlock))
+	ctx = ctx.WithBlockTime(time.Unix(1100, 0))
+	require.Equal(1, k.SettleEndedAuctions(ctx, types.MaxSettledAuctionsPerBlock))
+
+	for _, ref := range refs {
+		item, _ := k.GetItem(ctx, ref.CookbookId, ref.ItemId)
+		require.Equal(seller, item.Owner)
+	}
+	_, found := k.GetAuction(ctx, res.Id)
+	require.False(found)
+
+	events := ctx.EventManager().Events()
+	require.Equal("pylons.pylons.EventSettleAuction", events[len(events)-1].Type)
+}
+
+func (suite *IntegrationTestSuite) TestSettleEndedAuctionsFailure() {
+	k := suite.k
+	ctx := suite.ctx.WithBlockTime(time.Unix(1000, 0))
+	require := suite.Require()
+
+	// the bid of the auction was never escrowed, it can be neither settled nor refunded
+	auction := createNAuction(k, ctx, 1)[0]
+	auction.HighestBidder = types.GenTestBech32FromString("bidder")
+	auction.HighestBid = sdk.NewCoin(types.PylonsCoinDenom, sdk.NewInt(200))
+	k.SetAuction(ctx, auction)
+
+	require.Equal(0, k.SettleEndedAuctions(ctx, types.MaxSettledAuctionsPerBlock))
+	// the auction no longer takes a slot of the sweep
+	require.Empty(k.GetAuctionsEndedAtTime(ctx, ctx.BlockTime().Unix(), types.MaxSettledAuctionsPerBlock))
+	stored, found := k.GetAuction(ctx, auction.Id)
+	require.True(found)
+	require.Equal(auction, stored)
+
+	events := ctx.EventManager().Events()
+	require.Equal("pylons.pylons.EventSettleAuctionFailed", events[len(events)-1].Type)
+}
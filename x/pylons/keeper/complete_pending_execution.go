@@ -117,6 +117,11 @@ func (k Keeper) CompletePendingExecution(ctx sdk.Context, pendingExecution types
 	itemOutputIds := make([]string, len(mintItems))
 	for i, item := range mintItems {
 		id := k.AppendItem(ctx, item)
+		if item.Fungible {
+			// minted units are stacked into a fungible item already held by the executor
+			item.Id = id
+			id = k.MergeItem(ctx, item).Id
+		}
 		itemOutputIds[i] = id
 		// username will always be found as checked previously
 		to, _ := k.GetUsernameByAddress(ctx, pendingExecution.Creator)
@@ -124,10 +129,22 @@ func (k Keeper) CompletePendingExecution(ctx sdk.Context, pendingExecution types
 		history := item.NewItemHistory(ctx, to.Value, from.Value)
 		history.Id = id
 		k.SetItemHistory(ctx, history)
+		provenance := item.NewItemProvenance(ctx, types.ItemProvenanceMint, cookbook.Creator, pendingExecution.Creator)
+		provenance.Id = id
+		provenance.RecipeId = recipe.Id
+		provenance.ExecutionId = pendingExecution.Id
+		k.AppendItemProvenance(ctx, provenance)
 	}
 	// update modify items in keeper
 	itemModifyOutputIds := make([]string, len(modifyItems))
 	for i, item := range modifyItems {
+		// the stored item still holds the attributes it had before the execution
+		prevItem, _ := k.GetItem(ctx, item.CookbookId, item.Id)
+		provenance := item.NewItemProvenance(ctx, types.ItemProvenanceModify, pendingExecution.Creator, pendingExecution.Creator)
+		provenance.RecipeId = recipe.Id
+		provenance.ExecutionId = pendingExecution.Id
+		provenance.SetChangedAttributes(prevItem, item)
+		k.AppendItemProvenance(ctx, provenance)
 		k.UnlockItemForExecution(ctx, item, pendingExecution.Creator)
 		itemModifyOutputIds[i] = item.Id
 	}
@@ -179,6 +196,8 @@ coinLoop:
 	pendingExecution.ItemModifyOutputIds = itemModifyOutputIds
 	pendingExecution.ItemOutputIds = itemOutputIds
 
+	k.UpdateExecutionStats(ctx, pendingExecution, recipe, outputs, transferCoins, feeCoins, burnCoins)
+
 	event := types.EventCompleteExecution{
 		Creator:       pendingExecution.Creator,
 		Id:            pendingExecution.Id,
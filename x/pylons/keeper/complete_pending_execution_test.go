@@ -89,4 +89,16 @@ func (suite *IntegrationTestSuite) TestCompletePendingExecution() {
 	require.Equal(feeCollectorBalance, sdk.NewCoins(sdk.NewCoin(types.PylonsCoinDenom, sdk.NewInt(10))))
 	// should be 100 - 10 = 90
 	require.Equal(creatorBalance, sdk.NewCoins(sdk.NewCoin(types.PylonsCoinDenom, sdk.NewInt(90))))
+
+	// verify the completed execution is aggregated in the recipe and cookbook stats
+	recipeStats, found := k.GetRecipeStats(ctx, "testCookbookID", "testRecipeID")
+	require.True(found)
+	require.Equal(uint64(1), recipeStats.Executions)
+	require.Equal(uint64(1), recipeStats.UniqueExecutors)
+	require.Equal(creatorBalance, recipeStats.Revenue)
+	require.Equal(feeCollectorBalance, recipeStats.Fees)
+	cookbookStats, found := k.GetCookbookStats(ctx, "testCookbookID")
+	require.True(found)
+	require.Equal(uint64(1), cookbookStats.Executions)
+	require.Equal(creatorBalance, cookbookStats.Revenue)
 }
@@ -0,0 +1,58 @@
+package keeper
+
+import (
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/Pylons-tech/pylons/x/pylons/types"
+)
+
+// addItemToContainer indexes an item by the container item holding it
+func (k Keeper) addItemToContainer(ctx sdk.Context, item types.Item) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.ContainerItemKey))
+	store.Set(append(types.ContainerItemIndexPrefix(item.CookbookId, item.ContainerId), []byte(item.Id)...), []byte(item.Id))
+}
+
+// removeItemFromContainer removes an item from the index of the container item holding it
+func (k Keeper) removeItemFromContainer(ctx sdk.Context, item types.Item) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.ContainerItemKey))
+	store.Delete(append(types.ContainerItemIndexPrefix(item.CookbookId, item.ContainerId), []byte(item.Id)...))
+}
+
+// GetContainerItems returns the items held by a container item
+func (k Keeper) GetContainerItems(ctx sdk.Context, cookbookID, containerID string) (list []types.Item) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.ContainerItemKey))
+	iterator := sdk.KVStorePrefixIterator(store, types.ContainerItemIndexPrefix(cookbookID, containerID))
+
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		item, _ := k.GetItem(ctx, cookbookID, string(iterator.Value()))
+		list = append(list, item)
+	}
+
+	return
+}
+
+// HasContainerItems checks if an item holds other items
+func (k Keeper) HasContainerItems(ctx sdk.Context, cookbookID, containerID string) bool {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.ContainerItemKey))
+	iterator := sdk.KVStorePrefixIterator(store, types.ContainerItemIndexPrefix(cookbookID, containerID))
+
+	defer iterator.Close()
+
+	return iterator.Valid()
+}
+
+// DepositItemInContainer locks an item in a container item
+func (k Keeper) DepositItemInContainer(ctx sdk.Context, item types.Item, containerID string) {
+	item.ContainerId = containerID
+	k.LockItemForContainer(ctx, item)
+}
+
+// WithdrawItemFromContainer unlocks an item held by a container item to the given address
+func (k Keeper) WithdrawItemFromContainer(ctx sdk.Context, item types.Item, addr string) {
+	k.removeItemFromContainer(ctx, item)
+	item.ContainerId = ""
+	k.UnlockItemForContainer(ctx, item, addr)
+}
@@ -38,9 +38,14 @@ func (k Keeper) getCookbookIdsByAddr(ctx sdk.Context, addr sdk.AccAddress) (list
 
 // SetCookbook set a specific cookbook in the store from its ID
 func (k Keeper) SetCookbook(ctx sdk.Context, cookbook types.Cookbook) {
+	prev, _ := k.GetCookbook(ctx, cookbook.Id)
 	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.CookbookKey))
 	b := k.cdc.MustMarshal(&cookbook)
 	store.Set(types.KeyPrefix(cookbook.Id), b)
+	k.setCookbookClass(ctx, cookbook)
+	if !types.IndexedAttributesEqual(prev.IndexedAttributes, cookbook.IndexedAttributes) {
+		k.reindexCookbookItems(ctx, cookbook)
+	}
 
 	// required for random seed init given how it's handled rn
 	k.IncrementEntityCount(ctx)
@@ -0,0 +1,135 @@
+package keeper
+
+import (
+	"encoding/binary"
+	"strconv"
+
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/Pylons-tech/pylons/x/pylons/types"
+)
+
+// GetDutchAuctionCount get the total number of dutch auctions
+func (k Keeper) GetDutchAuctionCount(ctx sdk.Context) uint64 {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.DutchAuctionCountKey))
+	byteKey := types.KeyPrefix(types.DutchAuctionCountKey)
+	bz := store.Get(byteKey)
+
+	// Count doesn't exist: no element
+	if bz == nil {
+		return 0
+	}
+
+	// Parse bytes
+	count, err := strconv.ParseUint(string(bz), 10, 64)
+	if err != nil {
+		// Panic because the count should be always formattable to uint64
+		panic("cannot decode count")
+	}
+
+	return count
+}
+
+// SetDutchAuctionCount set the total number of dutch auctions
+func (k Keeper) SetDutchAuctionCount(ctx sdk.Context, count uint64) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.DutchAuctionCountKey))
+	byteKey := types.KeyPrefix(types.DutchAuctionCountKey)
+	bz := []byte(strconv.FormatUint(count, 10))
+	store.Set(byteKey, bz)
+}
+
+// AppendDutchAuction appends a dutch auction in the store with a new id and update the count
+func (k Keeper) AppendDutchAuction(ctx sdk.Context, auction types.DutchAuction) uint64 {
+	count := k.GetDutchAuctionCount(ctx)
+
+	auction.Id = count
+	k.SetDutchAuction(ctx, auction)
+
+	k.SetDutchAuctionCount(ctx, count+1)
+
+	return count
+}
+
+// SetDutchAuction set a specific dutch auction in the store, indexing it by end height
+func (k Keeper) SetDutchAuction(ctx sdk.Context, auction types.DutchAuction) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.DutchAuctionKey))
+	b := k.cdc.MustMarshal(&auction)
+	store.Set(sdk.Uint64ToBigEndian(auction.Id), b)
+
+	endStore := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.DutchAuctionEndKey))
+	endStore.Set(getDutchAuctionEndKey(auction), sdk.Uint64ToBigEndian(auction.Id))
+}
+
+// GetDutchAuction returns a dutch auction from its id
+func (k Keeper) GetDutchAuction(ctx sdk.Context, id uint64) (val types.DutchAuction, found bool) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.DutchAuctionKey))
+	b := store.Get(sdk.Uint64ToBigEndian(id))
+	if b == nil {
+		return val, false
+	}
+	k.cdc.MustUnmarshal(b, &val)
+	return val, true
+}
+
+// RemoveDutchAuction removes a dutch auction from the store along with its end height index
+func (k Keeper) RemoveDutchAuction(ctx sdk.Context, auction types.DutchAuction) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.DutchAuctionKey))
+	store.Delete(sdk.Uint64ToBigEndian(auction.Id))
+
+	endStore := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.DutchAuctionEndKey))
+	endStore.Delete(getDutchAuctionEndKey(auction))
+}
+
+// GetAllDutchAuction returns all dutch auctions
+func (k Keeper) GetAllDutchAuction(ctx sdk.Context) (list []types.DutchAuction) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.DutchAuctionKey))
+	iterator := sdk.KVStorePrefixIterator(store, []byte{})
+
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var val types.DutchAuction
+		k.cdc.MustUnmarshal(iterator.Value(), &val)
+		list = append(list, val)
+	}
+
+	return
+}
+
+// GetDutchAuctionsEndedBeforeHeight returns at most limit dutch auctions whose end height is lower than height
+func (k Keeper) GetDutchAuctionsEndedBeforeHeight(ctx sdk.Context, height int64, limit int) (list []types.DutchAuction) {
+	endStore := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.DutchAuctionEndKey))
+	iterator := endStore.Iterator(nil, sdk.Uint64ToBigEndian(uint64(height)))
+
+	defer iterator.Close()
+
+	for ; iterator.Valid() && len(list) < limit; iterator.Next() {
+		auction, found := k.GetDutchAuction(ctx, binary.BigEndian.Uint64(iterator.Value()))
+		if found {
+			list = append(list, auction)
+		}
+	}
+
+	return
+}
+
+// EndDutchAuctions gives the items of at most limit unsold dutch auctions back to their sellers once their end height
+// is passed, returning the number of ended dutch auctions
+func (k Keeper) EndDutchAuctions(ctx sdk.Context, limit int) int {
+	auctions := k.GetDutchAuctionsEndedBeforeHeight(ctx, ctx.BlockHeight()+1, limit)
+	for _, auction := range auctions {
+		k.unlockAuctionedItems(ctx, auction.Creator, auction.Items)
+		k.RemoveDutchAuction(ctx, auction)
+		_ = ctx.EventManager().EmitTypedEvent(&types.EventEndDutchAuction{
+			Creator: auction.Creator,
+			Id:      auction.Id,
+			Items:   auction.Items,
+		})
+	}
+	return len(auctions)
+}
+
+func getDutchAuctionEndKey(auction types.DutchAuction) []byte {
+	return append(sdk.Uint64ToBigEndian(uint64(auction.EndHeight)), sdk.Uint64ToBigEndian(auction.Id)...)
+}
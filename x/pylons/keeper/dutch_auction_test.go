@@ -0,0 +1,46 @@
+package keeper_test
+
+func (suite *IntegrationTestSuite) TestDutchAuctionGet() {
+	k := suite.k
+	ctx := suite.ctx
+	require := suite.Require()
+	items := createNDutchAuction(k, ctx, 10)
+	for _, item := range items {
+		auction, found := k.GetDutchAuction(ctx, item.Id)
+		require.True(found)
+		require.Equal(item, auction)
+	}
+}
+
+func (suite *IntegrationTestSuite) TestDutchAuctionRemove() {
+	k := suite.k
+	ctx := suite.ctx
+	require := suite.Require()
+	items := createNDutchAuction(k, ctx, 10)
+	for _, item := range items {
+		k.RemoveDutchAuction(ctx, item)
+		_, found := k.GetDutchAuction(ctx, item.Id)
+		require.False(found)
+	}
+	require.Empty(k.GetDutchAuctionsEndedBeforeHeight(ctx, 100, 10))
+}
+
+func (suite *IntegrationTestSuite) TestDutchAuctionGetAll() {
+	k := suite.k
+	ctx := suite.ctx
+	require := suite.Require()
+	items := createNDutchAuction(k, ctx, 10)
+	require.Equal(items, k.GetAllDutchAuction(ctx))
+	require.Equal(uint64(len(items)), k.GetDutchAuctionCount(ctx))
+}
+
+func (suite *IntegrationTestSuite) TestDutchAuctionsEndedBeforeHeight() {
+	k := suite.k
+	ctx := suite.ctx
+	require := suite.Require()
+	items := createNDutchAuction(k, ctx, 3)
+
+	require.Empty(k.GetDutchAuctionsEndedBeforeHeight(ctx, 20, 10))
+	require.Equal(items, k.GetDutchAuctionsEndedBeforeHeight(ctx, 21, 10))
+	require.Equal(items[:2], k.GetDutchAuctionsEndedBeforeHeight(ctx, 21, 2))
+}
@@ -0,0 +1,25 @@
+package keeper
+
+import (
+	"context"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/Pylons-tech/pylons/x/pylons/types"
+)
+
+func (k Keeper) DutchAuction(c context.Context, req *types.QueryGetDutchAuctionRequest) (*types.QueryGetDutchAuctionResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	ctx := sdk.UnwrapSDKContext(c)
+
+	val, found := k.GetDutchAuction(ctx, req.Id)
+	if !found {
+		return nil, status.Error(codes.InvalidArgument, "not found")
+	}
+
+	return &types.QueryGetDutchAuctionResponse{DutchAuction: val, CurrentPrice: val.PriceAt(ctx.BlockHeight())}, nil
+}
@@ -0,0 +1,55 @@
+package keeper_test
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/Pylons-tech/pylons/x/pylons/types"
+)
+
+func (suite *IntegrationTestSuite) TestDutchAuctionQuerySingle() {
+	k := suite.k
+	ctx := suite.ctx.WithBlockHeight(15)
+	require := suite.Require()
+
+	wctx := sdk.WrapSDKContext(ctx)
+	msgs := createNDutchAuction(k, ctx, 2)
+	price := sdk.NewCoin(types.PylonsCoinDenom, sdk.NewInt(550))
+	for _, tc := range []struct {
+		desc     string
+		request  *types.QueryGetDutchAuctionRequest
+		response *types.QueryGetDutchAuctionResponse
+		err      error
+	}{
+		{
+			desc:     "First",
+			request:  &types.QueryGetDutchAuctionRequest{Id: msgs[0].Id},
+			response: &types.QueryGetDutchAuctionResponse{DutchAuction: msgs[0], CurrentPrice: price},
+		},
+		{
+			desc:     "Second",
+			request:  &types.QueryGetDutchAuctionRequest{Id: msgs[1].Id},
+			response: &types.QueryGetDutchAuctionResponse{DutchAuction: msgs[1], CurrentPrice: price},
+		},
+		{
+			desc:    "KeyNotFound",
+			request: &types.QueryGetDutchAuctionRequest{Id: uint64(len(msgs))},
+			err:     status.Error(codes.InvalidArgument, "not found"),
+		},
+		{
+			desc: "InvalidRequest",
+			err:  status.Error(codes.InvalidArgument, "invalid request"),
+		},
+	} {
+		tc := tc
+		suite.Run(tc.desc, func() {
+			response, err := k.DutchAuction(wctx, tc.request)
+			if tc.err != nil {
+				require.ErrorIs(err, tc.err)
+			} else {
+				require.Equal(tc.response, response)
+			}
+		})
+	}
+}
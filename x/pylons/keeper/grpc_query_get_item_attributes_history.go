@@ -0,0 +1,25 @@
+package keeper
+
+import (
+	"context"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/Pylons-tech/pylons/x/pylons/types"
+)
+
+func (k Keeper) GetItemAttributesHistory(c context.Context, req *types.QueryGetItemAttributesHistoryRequest) (*types.QueryGetItemAttributesHistoryResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	ctx := sdk.UnwrapSDKContext(c)
+
+	history := k.GetAllItemAttributesHistory(ctx, req.CookbookId, req.ItemId)
+	if history == nil {
+		history = []types.ItemAttributesHistory{}
+	}
+
+	return &types.QueryGetItemAttributesHistoryResponse{History: history}, nil
+}
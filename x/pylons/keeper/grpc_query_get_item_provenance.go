@@ -0,0 +1,26 @@
+package keeper
+
+import (
+	"context"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/Pylons-tech/pylons/x/pylons/types"
+)
+
+func (k Keeper) GetItemProvenance(goCtx context.Context, req *types.QueryGetItemProvenanceRequest) (*types.QueryGetItemProvenanceResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	provenance, pageRes, err := k.GetItemProvenancePaginated(ctx, req.CookbookId, req.ItemId, req.Pagination)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "paginate: %v", err)
+	}
+
+	return &types.QueryGetItemProvenanceResponse{Provenance: provenance, Pagination: pageRes}, nil
+}
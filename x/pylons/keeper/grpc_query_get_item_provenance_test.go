@@ -0,0 +1,72 @@
+package keeper_test
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+
+	"github.com/Pylons-tech/pylons/x/pylons/keeper"
+	"github.com/Pylons-tech/pylons/x/pylons/types"
+)
+
+func (suite *IntegrationTestSuite) TestQueryGetItemProvenance() {
+	k := suite.k
+	ctx := suite.ctx
+	wctx := sdk.WrapSDKContext(ctx)
+	require := suite.Require()
+
+	srv := keeper.NewMsgServerImpl(k)
+
+	cookbook := createNCookbook(k, ctx, 1)[0]
+	owner := types.GenTestBech32FromString("owner")
+	receiver := types.GenTestBech32FromString("receiver")
+	k.SetPylonsAccount(ctx, types.AccountAddr{Value: owner}, types.Username{Value: "owner"})
+	k.SetPylonsAccount(ctx, types.AccountAddr{Value: receiver}, types.Username{Value: "receiver"})
+	ownerAddr, _ := sdk.AccAddressFromBech32(owner)
+	require.NoError(k.MintCoinsToAddr(ctx, ownerAddr, sdk.NewCoins(sdk.NewCoin(types.PylonsCoinDenom, sdk.NewInt(1000)))))
+
+	id := k.AppendItem(ctx, types.Item{
+		Owner:           owner,
+		CookbookId:      cookbook.Id,
+		Tradeable:       true,
+		TransferFee:     []sdk.Coin{sdk.NewCoin(types.PylonsCoinDenom, sdk.NewInt(100))},
+		TradePercentage: sdk.ZeroDec(),
+	})
+	ref := types.ItemRef{CookbookId: cookbook.Id, ItemId: id}
+
+	_, err := srv.SendItems(wctx, &types.MsgSendItems{Creator: owner, Receiver: receiver, Items: []types.ItemRef{ref}})
+	require.NoError(err)
+	item, _ := k.GetItem(ctx, cookbook.Id, id)
+	k.LockItemForTrade(ctx, item)
+	item, _ = k.GetItem(ctx, cookbook.Id, id)
+	k.UnlockItemForTrade(ctx, item, receiver)
+	_, err = srv.BurnItems(wctx, &types.MsgBurnItems{Creator: receiver, Items: []types.ItemRef{ref}})
+	require.NoError(err)
+
+	// the provenance outlives the burned item and is listed oldest first
+	res, err := k.GetItemProvenance(wctx, &types.QueryGetItemProvenanceRequest{CookbookId: cookbook.Id, ItemId: id})
+	require.NoError(err)
+	events := make([]string, len(res.Provenance))
+	for i, provenance := range res.Provenance {
+		events[i] = provenance.Event
+	}
+	require.Equal([]string{types.ItemProvenanceSend, types.ItemProvenanceLock, types.ItemProvenanceUnlock, types.ItemProvenanceBurn}, events)
+	require.Equal(owner, res.Provenance[0].From)
+	require.Equal(receiver, res.Provenance[0].To)
+	require.Equal(types.TradesLockerName, res.Provenance[1].Locker)
+	require.Equal(k.TradesLockerAddress().String(), res.Provenance[1].To)
+
+	// reversed pages list the newest events first
+	res, err = k.GetItemProvenance(wctx, &types.QueryGetItemProvenanceRequest{
+		CookbookId: cookbook.Id,
+		ItemId:     id,
+		Pagination: &query.PageRequest{Limit: 2, Reverse: true, CountTotal: true},
+	})
+	require.NoError(err)
+	require.Len(res.Provenance, 2)
+	require.Equal(types.ItemProvenanceBurn, res.Provenance[0].Event)
+	require.Equal(types.ItemProvenanceUnlock, res.Provenance[1].Event)
+	require.Equal(uint64(4), res.Pagination.Total)
+
+	_, err = k.GetItemProvenance(wctx, nil)
+	require.Error(err)
+}
@@ -0,0 +1,54 @@
+package keeper_test
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/Pylons-tech/pylons/x/pylons/types"
+)
+
+func (suite *IntegrationTestSuite) TestLendingQuerySingle() {
+	k := suite.k
+	ctx := suite.ctx
+	require := suite.Require()
+
+	wctx := sdk.WrapSDKContext(ctx)
+	msgs := createNLending(k, ctx, 2)
+	for _, tc := range []struct {
+		desc     string
+		request  *types.QueryGetLendingRequest
+		response *types.QueryGetLendingResponse
+		err      error
+	}{
+		{
+			desc:     "First",
+			request:  &types.QueryGetLendingRequest{Id: msgs[0].Id},
+			response: &types.QueryGetLendingResponse{Lending: msgs[0]},
+		},
+		{
+			desc:     "Second",
+			request:  &types.QueryGetLendingRequest{Id: msgs[1].Id},
+			response: &types.QueryGetLendingResponse{Lending: msgs[1]},
+		},
+		{
+			desc:    "KeyNotFound",
+			request: &types.QueryGetLendingRequest{Id: uint64(len(msgs))},
+			err:     status.Error(codes.InvalidArgument, "not found"),
+		},
+		{
+			desc: "InvalidRequest",
+			err:  status.Error(codes.InvalidArgument, "invalid request"),
+		},
+	} {
+		tc := tc
+		suite.Run(tc.desc, func() {
+			response, err := k.Lending(wctx, tc.request)
+			if tc.err != nil {
+				require.ErrorIs(err, tc.err)
+			} else {
+				require.Equal(tc.response, response)
+			}
+		})
+	}
+}
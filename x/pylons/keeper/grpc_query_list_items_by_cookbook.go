@@ -0,0 +1,41 @@
+package keeper
+
+import (
+	"context"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/Pylons-tech/pylons/x/pylons/types"
+)
+
+func (k Keeper) ListItemsByCookbook(goCtx context.Context, req *types.QueryListItemsByCookbookRequest) (*types.QueryListItemsByCookbookResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	items, pageRes, err := k.GetItemsByCookbookPaginated(ctx, req.CookbookId, req.Pagination)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "paginate: %v", err)
+	}
+
+	return &types.QueryListItemsByCookbookResponse{Items: items, Pagination: pageRes}, nil
+}
+
+func (k Keeper) ListItemsByRecipe(goCtx context.Context, req *types.QueryListItemsByRecipeRequest) (*types.QueryListItemsByRecipeResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	items, pageRes, err := k.GetItemsByRecipePaginated(ctx, req.CookbookId, req.RecipeId, req.Pagination)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "paginate: %v", err)
+	}
+
+	return &types.QueryListItemsByRecipeResponse{Items: items, Pagination: pageRes}, nil
+}
@@ -0,0 +1,163 @@
+package keeper_test
+
+import (
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/Pylons-tech/pylons/x/pylons/keeper"
+	"github.com/Pylons-tech/pylons/x/pylons/types"
+)
+
+// createNItemForRecipes creates n items in a cookbook, alternating between two recipes
+func createNItemForRecipes(k keeper.Keeper, ctx sdk.Context, n int, cookbookID string) []types.Item {
+	items := make([]types.Item, n)
+	owners := types.GenTestBech32List(n)
+	for i := range items {
+		items[i].Owner = owners[i]
+		items[i].CookbookId = cookbookID
+		items[i].RecipeId = []string{"recipeA", "recipeB"}[i%2]
+		items[i].TradePercentage = sdk.ZeroDec()
+		items[i].Id = k.AppendItem(ctx, items[i])
+	}
+	return items
+}
+
+func (suite *IntegrationTestSuite) TestListItemsByCookbook() {
+	k := suite.k
+	ctx := suite.ctx
+	require := suite.Require()
+	wctx := sdk.WrapSDKContext(ctx)
+
+	items := createNItemForRecipes(k, ctx, 6, "testCookbook")
+	// items of a cookbook whose id extends the listed one are not returned
+	createNItemForRecipes(k, ctx, 2, "testCookbook2")
+
+	for _, tc := range []struct {
+		desc     string
+		request  *types.QueryListItemsByCookbookRequest
+		response []types.Item
+		err      error
+	}{
+		{
+			desc:     "All",
+			request:  &types.QueryListItemsByCookbookRequest{CookbookId: "testCookbook"},
+			response: items,
+		},
+		{
+			desc:     "WithLimit",
+			request:  &types.QueryListItemsByCookbookRequest{CookbookId: "testCookbook", Pagination: &query.PageRequest{Limit: 4}},
+			response: items[:4],
+		},
+		{
+			desc:     "NotFound",
+			request:  &types.QueryListItemsByCookbookRequest{CookbookId: "missing"},
+			response: []types.Item{},
+		},
+		{
+			desc: "InvalidRequest",
+			err:  status.Error(codes.InvalidArgument, "invalid request"),
+		},
+	} {
+		tc := tc
+		suite.Run(tc.desc, func() {
+			response, err := k.ListItemsByCookbook(wctx, tc.request)
+			if tc.err != nil {
+				require.ErrorIs(err, tc.err)
+			} else {
+				require.NoError(err)
+				require.Equal(tc.response, response.Items)
+			}
+		})
+	}
+
+	// removed items are removed from the index
+	k.RemoveItem(ctx, items[0].CookbookId, items[0].Id)
+	response, err := k.ListItemsByCookbook(wctx, &types.QueryListItemsByCookbookRequest{CookbookId: "testCookbook"})
+	require.NoError(err)
+	require.Equal(items[1:], response.Items)
+}
+
+func (suite *IntegrationTestSuite) TestListItemsByRecipe() {
+	k := suite.k
+	ctx := suite.ctx
+	require := suite.Require()
+	wctx := sdk.WrapSDKContext(ctx)
+
+	items := createNItemForRecipes(k, ctx, 6, "testCookbook")
+
+	for _, tc := range []struct {
+		desc     string
+		request  *types.QueryListItemsByRecipeRequest
+		response []types.Item
+		err      error
+	}{
+		{
+			desc:     "All",
+			request:  &types.QueryListItemsByRecipeRequest{CookbookId: "testCookbook", RecipeId: "recipeA"},
+			response: []types.Item{items[0], items[2], items[4]},
+		},
+		{
+			desc:     "WithLimit",
+			request:  &types.QueryListItemsByRecipeRequest{CookbookId: "testCookbook", RecipeId: "recipeB", Pagination: &query.PageRequest{Limit: 2}},
+			response: []types.Item{items[1], items[3]},
+		},
+		{
+			desc:     "NotFound",
+			request:  &types.QueryListItemsByRecipeRequest{CookbookId: "testCookbook", RecipeId: "missing"},
+			response: []types.Item{},
+		},
+		{
+			desc: "InvalidRequest",
+			err:  status.Error(codes.InvalidArgument, "invalid request"),
+		},
+	} {
+		tc := tc
+		suite.Run(tc.desc, func() {
+			response, err := k.ListItemsByRecipe(wctx, tc.request)
+			if tc.err != nil {
+				require.ErrorIs(err, tc.err)
+			} else {
+				require.NoError(err)
+				require.Equal(tc.response, response.Items)
+			}
+		})
+	}
+}
+
+func (suite *IntegrationTestSuite) TestMigrate5to6() {
+	k := suite.k
+	ctx := suite.ctx
+	require := suite.Require()
+	wctx := sdk.WrapSDKContext(ctx)
+
+	items := createNItemForRecipes(k, ctx, 4, "testCookbook")
+
+	// drop the indexes, as in a store written before consensus version 6
+	store := ctx.KVStore(suite.pylonsApp.GetKey(types.StoreKey))
+	for _, key := range []string{types.CookbookItemKey, types.RecipeItemKey} {
+		indexStore := prefix.NewStore(store, types.KeyPrefix(key))
+		iterator := indexStore.Iterator(nil, nil)
+		var keys [][]byte
+		for ; iterator.Valid(); iterator.Next() {
+			keys = append(keys, iterator.Key())
+		}
+		iterator.Close()
+		for _, key := range keys {
+			indexStore.Delete(key)
+		}
+	}
+	response, err := k.ListItemsByCookbook(wctx, &types.QueryListItemsByCookbookRequest{CookbookId: "testCookbook"})
+	require.NoError(err)
+	require.Empty(response.Items)
+
+	require.NoError(keeper.NewMigrator(k).Migrate5to6(ctx))
+	response, err = k.ListItemsByCookbook(wctx, &types.QueryListItemsByCookbookRequest{CookbookId: "testCookbook"})
+	require.NoError(err)
+	require.Equal(items, response.Items)
+	recipeResponse, err := k.ListItemsByRecipe(wctx, &types.QueryListItemsByRecipeRequest{CookbookId: "testCookbook", RecipeId: "recipeB"})
+	require.NoError(err)
+	require.Equal([]types.Item{items[1], items[3]}, recipeResponse.Items)
+}
@@ -0,0 +1,128 @@
+package keeper
+
+import (
+	"context"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/Pylons-tech/pylons/x/pylons/types"
+)
+
+// parsePriceBound parses an optional bound of the price range of the listed trades
+func parsePriceBound(bound string) (*sdk.Int, error) {
+	if bound == "" {
+		return nil, nil
+	}
+	value, ok := sdk.NewIntFromString(bound)
+	if !ok {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid price bound %s", bound)
+	}
+	return &value, nil
+}
+
+func (k Keeper) ListTrades(goCtx context.Context, req *types.QueryListTradesRequest) (*types.QueryListTradesResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	sort := req.Sort
+	if sort == "" {
+		sort = types.TradeSortCreation
+	}
+	if sort != types.TradeSortCreation && sort != types.TradeSortPrice {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid sort %s", req.Sort)
+	}
+	if req.Denom == "" && (sort == types.TradeSortPrice || req.MinPrice != "" || req.MaxPrice != "") {
+		return nil, status.Error(codes.InvalidArgument, "denom is required to filter or sort by price")
+	}
+	if req.Denom != "" {
+		if err := sdk.ValidateDenom(req.Denom); err != nil {
+			return nil, status.Error(codes.InvalidArgument, err.Error())
+		}
+	}
+	minPrice, err := parsePriceBound(req.MinPrice)
+	if err != nil {
+		return nil, err
+	}
+	maxPrice, err := parsePriceBound(req.MaxPrice)
+	if err != nil {
+		return nil, err
+	}
+
+	// a trade matches the attribute filters when one of the items it offers matches all of them
+	var itemPredicates []func(types.Item) bool
+	for _, filter := range req.Strings {
+		itemPredicates = append(itemPredicates, stringAttributePredicate(filter))
+	}
+	for _, filter := range req.Longs {
+		lower, err := parseLongBound(filter.Min)
+		if err != nil {
+			return nil, status.Errorf(codes.InvalidArgument, "invalid min of attribute %s: %v", filter.Key, err)
+		}
+		upper, err := parseLongBound(filter.Max)
+		if err != nil {
+			return nil, status.Errorf(codes.InvalidArgument, "invalid max of attribute %s: %v", filter.Key, err)
+		}
+		itemPredicates = append(itemPredicates, longAttributePredicate(filter.Key, lower, upper))
+	}
+	for _, filter := range req.Doubles {
+		lower, err := parseDoubleBound(filter.Min)
+		if err != nil {
+			return nil, status.Errorf(codes.InvalidArgument, "invalid min of attribute %s: %v", filter.Key, err)
+		}
+		upper, err := parseDoubleBound(filter.Max)
+		if err != nil {
+			return nil, status.Errorf(codes.InvalidArgument, "invalid max of attribute %s: %v", filter.Key, err)
+		}
+		itemPredicates = append(itemPredicates, doubleAttributePredicate(filter.Key, lower, upper))
+	}
+	matchItem := func(item types.Item) bool {
+		for _, predicate := range itemPredicates {
+			if !predicate(item) {
+				return false
+			}
+		}
+		return true
+	}
+
+	trades, pageRes, err := k.GetMarketTradesPaginated(ctx, req.CookbookId, req.Denom, sort, func(trade types.Trade) bool {
+		if req.CookbookId != "" {
+			offered := false
+			for _, cookbookID := range trade.OfferedCookbookIDs() {
+				offered = offered || cookbookID == req.CookbookId
+			}
+			if !offered {
+				return false
+			}
+		}
+		if req.Denom != "" {
+			price := trade.Prices().AmountOf(req.Denom)
+			if price.IsZero() || (minPrice != nil && price.LT(*minPrice)) || (maxPrice != nil && price.GT(*maxPrice)) {
+				return false
+			}
+		}
+		if len(itemPredicates) == 0 {
+			return true
+		}
+		for _, itemRef := range trade.ItemOutputs {
+			item, found := k.GetItem(ctx, itemRef.CookbookId, itemRef.ItemId)
+			if found && matchItem(item) {
+				return true
+			}
+		}
+		return false
+	}, req.Pagination)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "paginate: %v", err)
+	}
+
+	return &types.QueryListTradesResponse{
+			Trades:     trades,
+			Pagination: pageRes,
+		},
+		nil
+}
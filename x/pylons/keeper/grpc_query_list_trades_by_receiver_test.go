@@ -0,0 +1,62 @@
+package keeper_test
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/Pylons-tech/pylons/x/pylons/types"
+)
+
+func (suite *IntegrationTestSuite) TestListTradesByReceiver() {
+	k := suite.k
+	ctx := suite.ctx
+	wctx := sdk.WrapSDKContext(ctx)
+	require := suite.Require()
+
+	creator := types.GenTestBech32FromString("creator")
+	receiver := types.GenTestBech32FromString("receiver")
+	creatorAddr, _ := sdk.AccAddressFromBech32(creator)
+
+	// only the private trades are listed for their receiver
+	trades := make([]types.Trade, 0)
+	for i := 0; i < 6; i++ {
+		trade := types.Trade{Creator: creator}
+		if i%2 == 0 {
+			trade.Receiver = receiver
+		}
+		trade.Id = k.AppendTrade(ctx, trade)
+		if trade.Receiver != "" {
+			trades = append(trades, trade)
+		}
+	}
+
+	res, err := k.ListTradesByReceiver(wctx, &types.QueryListTradesByReceiverRequest{Receiver: receiver})
+	require.NoError(err)
+	require.Equal(trades, res.Trades)
+
+	res, err = k.ListTradesByReceiver(wctx, &types.QueryListTradesByReceiverRequest{
+		Receiver:   receiver,
+		Pagination: &query.PageRequest{Limit: 2, CountTotal: true},
+	})
+	require.NoError(err)
+	require.Equal(trades[:2], res.Trades)
+	require.Equal(uint64(3), res.Pagination.Total)
+
+	// removed trades leave the receiver index
+	k.RemoveTrade(ctx, trades[0].Id, creatorAddr)
+	res, err = k.ListTradesByReceiver(wctx, &types.QueryListTradesByReceiverRequest{Receiver: receiver})
+	require.NoError(err)
+	require.Equal(trades[1:], res.Trades)
+
+	res, err = k.ListTradesByReceiver(wctx, &types.QueryListTradesByReceiverRequest{Receiver: creator})
+	require.NoError(err)
+	require.Empty(res.Trades)
+
+	_, err = k.ListTradesByReceiver(wctx, &types.QueryListTradesByReceiverRequest{Receiver: "invalid"})
+	require.ErrorIs(err, status.Error(codes.InvalidArgument, "invalid address"))
+
+	_, err = k.ListTradesByReceiver(wctx, nil)
+	require.ErrorIs(err, status.Error(codes.InvalidArgument, "invalid request"))
+}
@@ -0,0 +1,182 @@
+package keeper_test
+
+import (
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/Pylons-tech/pylons/x/pylons/keeper"
+	"github.com/Pylons-tech/pylons/x/pylons/types"
+)
+
+// createMarketTrades creates trades offering items of two cookbooks at various prices, the last one being expired
+func createMarketTrades(k keeper.Keeper, ctx sdk.Context) []types.Trade {
+	creator := types.GenTestBech32FromString("creator")
+	price := func(coins ...sdk.Coin) types.CoinInput {
+		return types.CoinInput{Coins: sdk.NewCoins(coins...)}
+	}
+	offers := []struct {
+		cookbookID string
+		level      int64
+		coinInputs []types.CoinInput
+	}{
+		{"cookbookA", 1, []types.CoinInput{price(sdk.NewInt64Coin("upylon", 300))}},
+		{"cookbookB", 5, []types.CoinInput{price(sdk.NewInt64Coin("upylon", 100))}},
+		{"cookbookA", 7, []types.CoinInput{price(sdk.NewInt64Coin("upylon", 200)), price(sdk.NewInt64Coin("uatom", 50))}},
+		{"cookbookA", 9, []types.CoinInput{price(sdk.NewInt64Coin("uatom", 100))}},
+		{"cookbookA", 5, []types.CoinInput{price(sdk.NewInt64Coin("upylon", 150))}},
+	}
+	trades := make([]types.Trade, len(offers))
+	for i, offer := range offers {
+		item := types.Item{
+			Owner:      k.TradesLockerAddress().String(),
+			CookbookId: offer.cookbookID,
+			Id:         types.EncodeItemID(uint64(i)),
+			Longs:      []types.LongKeyValue{{Key: "level", Value: offer.level}},
+		}
+		k.SetItem(ctx, item)
+		trades[i] = types.Trade{
+			Creator:     creator,
+			CoinInputs:  offer.coinInputs,
+			ItemOutputs: []types.ItemRef{{CookbookId: item.CookbookId, ItemId: item.Id}},
+		}
+		if i == len(offers)-1 {
+			trades[i].ExpiresAtHeight = ctx.BlockHeight()
+		}
+		trades[i].Id = k.AppendTrade(ctx, trades[i])
+	}
+	return trades
+}
+
+func (suite *IntegrationTestSuite) TestListTrades() {
+	k := suite.k
+	ctx := suite.ctx.WithBlockHeight(5)
+	wctx := sdk.WrapSDKContext(ctx)
+	require := suite.Require()
+
+	trades := createMarketTrades(k, ctx)
+	creatorAddr, _ := sdk.AccAddressFromBech32(trades[0].Creator)
+
+	for _, tc := range []struct {
+		desc     string
+		request  *types.QueryListTradesRequest
+		response []types.Trade
+	}{
+		{
+			desc:     "All",
+			request:  &types.QueryListTradesRequest{},
+			response: trades[:4],
+		},
+		{
+			desc:     "Cookbook",
+			request:  &types.QueryListTradesRequest{CookbookId: "cookbookA"},
+			response: []types.Trade{trades[0], trades[2], trades[3]},
+		},
+		{
+			desc:     "Denom",
+			request:  &types.QueryListTradesRequest{CookbookId: "cookbookA", Denom: "upylon"},
+			response: []types.Trade{trades[0], trades[2]},
+		},
+		{
+			desc:     "SortByPrice",
+			request:  &types.QueryListTradesRequest{Denom: "upylon", Sort: types.TradeSortPrice},
+			response: []types.Trade{trades[1], trades[2], trades[0]},
+		},
+		{
+			desc:     "SortByPriceInCookbook",
+			request:  &types.QueryListTradesRequest{CookbookId: "cookbookA", Denom: "upylon", Sort: types.TradeSortPrice},
+			response: []types.Trade{trades[2], trades[0]},
+		},
+		{
+			desc:     "SortByPriceDescending",
+			request:  &types.QueryListTradesRequest{Denom: "upylon", Sort: types.TradeSortPrice, Pagination: &query.PageRequest{Reverse: true}},
+			response: []types.Trade{trades[0], trades[2], trades[1]},
+		},
+		{
+			desc:     "LowestCoinInput",
+			request:  &types.QueryListTradesRequest{Denom: "uatom", Sort: types.TradeSortPrice},
+			response: []types.Trade{trades[2], trades[3]},
+		},
+		{
+			desc:     "PriceRange",
+			request:  &types.QueryListTradesRequest{Denom: "upylon", MinPrice: "150", MaxPrice: "300"},
+			response: []types.Trade{trades[0], trades[2]},
+		},
+		{
+			desc:     "Attributes",
+			request:  &types.QueryListTradesRequest{Longs: []types.LongAttributeFilter{{Key: "level", Min: "5", Max: "8"}}},
+			response: []types.Trade{trades[1], trades[2]},
+		},
+	} {
+		tc := tc
+		suite.Run(tc.desc, func() {
+			res, err := k.ListTrades(wctx, tc.request)
+			require.NoError(err)
+			require.Equal(tc.response, res.Trades)
+		})
+	}
+
+	res, err := k.ListTrades(wctx, &types.QueryListTradesRequest{
+		Denom:      "upylon",
+		Sort:       types.TradeSortPrice,
+		Pagination: &query.PageRequest{Limit: 2, CountTotal: true},
+	})
+	require.NoError(err)
+	require.Equal([]types.Trade{trades[1], trades[2]}, res.Trades)
+	require.Equal(uint64(3), res.Pagination.Total)
+
+	// closed trades leave the indexes
+	k.RemoveTrade(ctx, trades[2].Id, creatorAddr)
+	res, err = k.ListTrades(wctx, &types.QueryListTradesRequest{Denom: "upylon", Sort: types.TradeSortPrice})
+	require.NoError(err)
+	require.Equal([]types.Trade{trades[1], trades[0]}, res.Trades)
+	res, err = k.ListTrades(wctx, &types.QueryListTradesRequest{CookbookId: "cookbookA"})
+	require.NoError(err)
+	require.Equal([]types.Trade{trades[0], trades[3]}, res.Trades)
+
+	_, err = k.ListTrades(wctx, &types.QueryListTradesRequest{Sort: "name"})
+	require.ErrorIs(err, status.Error(codes.InvalidArgument, "invalid sort name"))
+	_, err = k.ListTrades(wctx, &types.QueryListTradesRequest{Sort: types.TradeSortPrice})
+	require.ErrorIs(err, status.Error(codes.InvalidArgument, "denom is required to filter or sort by price"))
+	_, err = k.ListTrades(wctx, &types.QueryListTradesRequest{Denom: "upylon", MinPrice: "cheap"})
+	require.ErrorIs(err, status.Error(codes.InvalidArgument, "invalid price bound cheap"))
+	_, err = k.ListTrades(wctx, nil)
+	require.ErrorIs(err, status.Error(codes.InvalidArgument, "invalid request"))
+}
+
+func (suite *IntegrationTestSuite) TestMigrate6to7() {
+	k := suite.k
+	ctx := suite.ctx.WithBlockHeight(5)
+	require := suite.Require()
+	wctx := sdk.WrapSDKContext(ctx)
+
+	trades := createMarketTrades(k, ctx)
+
+	// drop the indexes, as in a store written before consensus version 7
+	store := ctx.KVStore(suite.pylonsApp.GetKey(types.StoreKey))
+	for _, key := range []string{types.CookbookTradeKey, types.PriceTradeKey} {
+		indexStore := prefix.NewStore(store, types.KeyPrefix(key))
+		iterator := indexStore.Iterator(nil, nil)
+		var keys [][]byte
+		for ; iterator.Valid(); iterator.Next() {
+			keys = append(keys, iterator.Key())
+		}
+		iterator.Close()
+		for _, key := range keys {
+			indexStore.Delete(key)
+		}
+	}
+	response, err := k.ListTrades(wctx, &types.QueryListTradesRequest{Denom: "upylon", Sort: types.TradeSortPrice})
+	require.NoError(err)
+	require.Empty(response.Trades)
+
+	require.NoError(keeper.NewMigrator(k).Migrate6to7(ctx))
+	response, err = k.ListTrades(wctx, &types.QueryListTradesRequest{Denom: "upylon", Sort: types.TradeSortPrice})
+	require.NoError(err)
+	require.Equal([]types.Trade{trades[1], trades[2], trades[0]}, response.Trades)
+	response, err = k.ListTrades(wctx, &types.QueryListTradesRequest{CookbookId: "cookbookB"})
+	require.NoError(err)
+	require.Equal([]types.Trade{trades[1]}, response.Trades)
+}
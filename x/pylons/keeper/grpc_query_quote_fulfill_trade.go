@@ -0,0 +1,79 @@
+package keeper
+
+import (
+	"context"
+	"sort"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/Pylons-tech/pylons/x/pylons/types"
+)
+
+func (k Keeper) QuoteFulfillTrade(goCtx context.Context, req *types.QueryQuoteFulfillTradeRequest) (*types.QueryQuoteFulfillTradeResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	if _, err := sdk.AccAddressFromBech32(req.Fulfiller); err != nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid address")
+	}
+	if !k.HasTrade(ctx, req.Id) {
+		return nil, status.Error(codes.NotFound, "trade not found")
+	}
+	trade := k.GetTrade(ctx, req.Id)
+	// queries are not metered, the fill is bounded before its item inputs are expanded
+	fillAmount := req.FillAmount
+	if fillAmount == 0 {
+		fillAmount = 1
+	}
+	if fillAmount > types.MaxTradeQuantity || !trade.IsFillItemsCount(fillAmount, len(req.Items)) {
+		return nil, status.Error(codes.InvalidArgument, "invalid fill amount or number of items")
+	}
+
+	fill, err := k.prepareTradeFill(ctx, req.Fulfiller, trade, int(req.CoinInputsIndex), fillAmount, req.Items)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	royalties := make(map[string]sdk.Coins)
+	for cookbookID, royalty := range fill.inputRoyalties {
+		royalties[cookbookID] = royalties[cookbookID].Add(royalty...)
+	}
+	for cookbookID, royalty := range fill.outputRoyalties {
+		royalties[cookbookID] = royalties[cookbookID].Add(royalty...)
+	}
+	cookbookIDs := make([]string, 0, len(royalties))
+	for cookbookID := range royalties {
+		cookbookIDs = append(cookbookIDs, cookbookID)
+	}
+	sort.Strings(cookbookIDs)
+	cookbookRoyalties := make([]types.CookbookRoyalty, len(cookbookIDs))
+	for i, cookbookID := range cookbookIDs {
+		cookbook, _ := k.GetCookbook(ctx, cookbookID)
+		cookbookRoyalties[i] = types.CookbookRoyalty{CookbookId: cookbookID, Owner: cookbook.Creator, Amount: royalties[cookbookID]}
+	}
+
+	// the fulfiller pays the fees of the items it provides, and the trade creator the fees of the itemOutputs on top
+	// of the locked coinOutputs
+	fulfillerPays := fill.inputChainFees.Add(fill.inputTransfers...)
+	for _, royalty := range fill.inputRoyalties {
+		fulfillerPays = fulfillerPays.Add(royalty...)
+	}
+	creatorPays := fill.coinOutputs.Add(fill.outputChainFees...).Add(fill.outputTransfers...)
+	for _, royalty := range fill.outputRoyalties {
+		creatorPays = creatorPays.Add(royalty...)
+	}
+
+	return &types.QueryQuoteFulfillTradeResponse{
+		FulfillerPays:     fulfillerPays,
+		CreatorPays:       creatorPays,
+		FulfillerReceives: fill.coinOutputs.Add(fill.outputTransfers...),
+		CreatorReceives:   fill.inputTransfers,
+		Royalties:         cookbookRoyalties,
+		ChainFees:         fill.inputChainFees.Add(fill.outputChainFees...),
+	}, nil
+}
@@ -0,0 +1,104 @@
+package keeper_test
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/Pylons-tech/pylons/x/pylons/keeper"
+	"github.com/Pylons-tech/pylons/x/pylons/types"
+)
+
+func (suite *IntegrationTestSuite) TestQuoteFulfillTrade() {
+	k := suite.k
+	bk := suite.bankKeeper
+	ctx := suite.ctx
+	require := suite.Require()
+
+	wctx := sdk.WrapSDKContext(ctx)
+	srv := keeper.NewMsgServerImpl(k)
+
+	creator := types.GenTestBech32FromString("creator")
+	creatorAddr, _ := sdk.AccAddressFromBech32(creator)
+	fulfiller := types.GenTestBech32FromString("fulfiller")
+	fulfillerAddr, _ := sdk.AccAddressFromBech32(fulfiller)
+	ownerA := types.GenTestBech32FromString("ownerA")
+	ownerAAddr, _ := sdk.AccAddressFromBech32(ownerA)
+	ownerB := types.GenTestBech32FromString("ownerB")
+	ownerBAddr, _ := sdk.AccAddressFromBech32(ownerB)
+	k.SetPylonsAccount(ctx, types.AccountAddr{Value: creator}, types.Username{Value: "creator"})
+	k.SetPylonsAccount(ctx, types.AccountAddr{Value: fulfiller}, types.Username{Value: "fulfiller"})
+	k.SetCookbook(ctx, types.Cookbook{Creator: ownerA, Id: "cookbookA"})
+	k.SetCookbook(ctx, types.Cookbook{Creator: ownerB, Id: "cookbookB"})
+
+	upylon := func(amount int64) sdk.Coins {
+		return sdk.NewCoins(sdk.NewInt64Coin(types.PylonsCoinDenom, amount))
+	}
+	require.NoError(k.MintCoinsToAddr(ctx, creatorAddr, upylon(1500)))
+	require.NoError(k.MintCoinsToAddr(ctx, fulfillerAddr, upylon(1000)))
+
+	newItem := func(owner, cookbookID, tradePercentage string) types.Item {
+		item := types.Item{
+			Owner:           owner,
+			CookbookId:      cookbookID,
+			Tradeable:       true,
+			TradePercentage: sdk.MustNewDecFromStr(tradePercentage),
+			TransferFee:     upylon(10),
+		}
+		item.Id = k.AppendItem(ctx, item)
+		return item
+	}
+	offered := newItem(creator, "cookbookA", "0.1")
+	provided := newItem(fulfiller, "cookbookB", "0.2")
+
+	// the creator trades an item and 500upylon for an item and 1000upylon
+	respCreate, err := srv.CreateTrade(wctx, &types.MsgCreateTrade{
+		Creator:     creator,
+		CoinInputs:  []types.CoinInput{{Coins: upylon(1000)}},
+		ItemInputs:  []types.ItemInput{{Id: "provided"}},
+		CoinOutputs: upylon(500),
+		ItemOutputs: []types.ItemRef{{CookbookId: offered.CookbookId, ItemId: offered.Id}},
+		ExtraInfo:   "extrainfo",
+	})
+	require.NoError(err)
+
+	items := []types.ItemRef{{CookbookId: provided.CookbookId, ItemId: provided.Id}}
+	quote, err := k.QuoteFulfillTrade(wctx, &types.QueryQuoteFulfillTradeRequest{Id: respCreate.Id, Fulfiller: fulfiller, Items: items})
+	require.NoError(err)
+	// the creator pays the fees of the offered item out of 1000upylon and the fulfiller those of the provided item out
+	// of 500upylon, the chain taking 10% of the TradePercentage of each
+	require.Equal(&types.QueryQuoteFulfillTradeResponse{
+		FulfillerPays:     upylon(500),
+		CreatorPays:       upylon(1500),
+		FulfillerReceives: upylon(500),
+		Royalties: []types.CookbookRoyalty{
+			{CookbookId: "cookbookA", Owner: ownerA, Amount: upylon(990)},
+			{CookbookId: "cookbookB", Owner: ownerB, Amount: upylon(490)},
+		},
+		ChainFees: upylon(20),
+	}, quote)
+
+	// the quote fails like the fulfillment would
+	_, err = k.QuoteFulfillTrade(wctx, &types.QueryQuoteFulfillTradeRequest{Id: respCreate.Id, Fulfiller: ownerA, Items: items})
+	require.Equal(codes.InvalidArgument, status.Code(err))
+	// the fill amount and the number of items are bounded before the itemInputs are expanded
+	_, err = k.QuoteFulfillTrade(wctx, &types.QueryQuoteFulfillTradeRequest{Id: respCreate.Id, Fulfiller: fulfiller, Items: items, FillAmount: 1 << 62})
+	require.ErrorIs(err, status.Error(codes.InvalidArgument, "invalid fill amount or number of items"))
+	_, err = k.QuoteFulfillTrade(wctx, &types.QueryQuoteFulfillTradeRequest{Id: respCreate.Id, Fulfiller: fulfiller, Items: append(items, items...)})
+	require.ErrorIs(err, status.Error(codes.InvalidArgument, "invalid fill amount or number of items"))
+
+	// the fulfillment pays the quoted amounts
+	_, err = srv.FulfillTrade(wctx, &types.MsgFulfillTrade{Creator: fulfiller, Id: respCreate.Id, Items: items})
+	require.NoError(err)
+	require.Equal(upylon(1000), bk.SpendableCoins(ctx, fulfillerAddr))
+	require.True(bk.SpendableCoins(ctx, creatorAddr).IsZero())
+	require.Equal(upylon(990), bk.SpendableCoins(ctx, ownerAAddr))
+	require.Equal(upylon(490), bk.SpendableCoins(ctx, ownerBAddr))
+
+	_, err = k.QuoteFulfillTrade(wctx, &types.QueryQuoteFulfillTradeRequest{Id: respCreate.Id, Fulfiller: fulfiller, Items: items})
+	require.ErrorIs(err, status.Error(codes.NotFound, "trade not found"))
+	_, err = k.QuoteFulfillTrade(wctx, &types.QueryQuoteFulfillTradeRequest{Id: respCreate.Id, Fulfiller: "invalid"})
+	require.ErrorIs(err, status.Error(codes.InvalidArgument, "invalid address"))
+	_, err = k.QuoteFulfillTrade(wctx, nil)
+	require.ErrorIs(err, status.Error(codes.InvalidArgument, "invalid request"))
+}
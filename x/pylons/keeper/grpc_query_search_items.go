@@ -0,0 +1,170 @@
+package keeper
+
+import (
+	"context"
+	"strconv"
+	"strings"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/Pylons-tech/pylons/x/pylons/types"
+)
+
+// parseLongBound parses an optional bound of a long attribute filter
+func parseLongBound(bound string) (*int64, error) {
+	if bound == "" {
+		return nil, nil
+	}
+	value, err := strconv.ParseInt(bound, 10, 64)
+	if err != nil {
+		return nil, err
+	}
+	return &value, nil
+}
+
+// parseDoubleBound parses an optional bound of a double attribute filter
+func parseDoubleBound(bound string) (*sdk.Dec, error) {
+	if bound == "" {
+		return nil, nil
+	}
+	value, err := sdk.NewDecFromStr(bound)
+	if err != nil {
+		return nil, err
+	}
+	return &value, nil
+}
+
+// stringAttributePredicate returns the predicate of the items matching a string attribute filter
+func stringAttributePredicate(filter types.StringAttributeFilter) func(types.Item) bool {
+	return func(item types.Item) bool {
+		value, ok := item.FindString(filter.Key)
+		if filter.Value != "" {
+			return ok && value == filter.Value
+		}
+		return ok && strings.HasPrefix(value, filter.Prefix)
+	}
+}
+
+// longAttributePredicate returns the predicate of the items with a long attribute in the range [lower, upper]
+func longAttributePredicate(key string, lower, upper *int64) func(types.Item) bool {
+	return func(item types.Item) bool {
+		value, ok := item.FindLong(key)
+		return ok && (lower == nil || int64(value) >= *lower) && (upper == nil || int64(value) <= *upper)
+	}
+}
+
+// doubleAttributePredicate returns the predicate of the items with a double attribute in the range [lower, upper]
+func doubleAttributePredicate(key string, lower, upper *sdk.Dec) func(types.Item) bool {
+	return func(item types.Item) bool {
+		value, ok := item.FindDouble(key)
+		return ok && (lower == nil || value.GTE(*lower)) && (upper == nil || value.LTE(*upper))
+	}
+}
+
+func (k Keeper) SearchItems(goCtx context.Context, req *types.QuerySearchItemsRequest) (*types.QuerySearchItemsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	cookbook, found := k.GetCookbook(ctx, req.CookbookId)
+	if !found {
+		return nil, status.Error(codes.NotFound, "cookbook not found")
+	}
+	indexed := make(map[string]bool, len(cookbook.IndexedAttributes))
+	for _, key := range cookbook.IndexedAttributes {
+		indexed[key] = true
+	}
+
+	// the first attribute filter selects the index range to iterate, every filter is then checked on the items
+	var r *itemAttributeRange
+	var predicates []func(types.Item) bool
+
+	for _, filter := range req.Strings {
+		if !indexed[filter.Key] {
+			return nil, status.Errorf(codes.InvalidArgument, "attribute %s is not indexed by cookbook %s", filter.Key, cookbook.Id)
+		}
+		if r == nil {
+			fr := stringAttributeRange(cookbook.Id, filter)
+			r = &fr
+		}
+		predicates = append(predicates, stringAttributePredicate(filter))
+	}
+
+	for _, filter := range req.Longs {
+		if !indexed[filter.Key] {
+			return nil, status.Errorf(codes.InvalidArgument, "attribute %s is not indexed by cookbook %s", filter.Key, cookbook.Id)
+		}
+		lower, err := parseLongBound(filter.Min)
+		if err != nil {
+			return nil, status.Errorf(codes.InvalidArgument, "invalid min of attribute %s: %v", filter.Key, err)
+		}
+		upper, err := parseLongBound(filter.Max)
+		if err != nil {
+			return nil, status.Errorf(codes.InvalidArgument, "invalid max of attribute %s: %v", filter.Key, err)
+		}
+		if r == nil {
+			fr := longAttributeRange(cookbook.Id, filter, lower, upper)
+			r = &fr
+		}
+		predicates = append(predicates, longAttributePredicate(filter.Key, lower, upper))
+	}
+
+	for _, filter := range req.Doubles {
+		if !indexed[filter.Key] {
+			return nil, status.Errorf(codes.InvalidArgument, "attribute %s is not indexed by cookbook %s", filter.Key, cookbook.Id)
+		}
+		lower, err := parseDoubleBound(filter.Min)
+		if err != nil {
+			return nil, status.Errorf(codes.InvalidArgument, "invalid min of attribute %s: %v", filter.Key, err)
+		}
+		upper, err := parseDoubleBound(filter.Max)
+		if err != nil {
+			return nil, status.Errorf(codes.InvalidArgument, "invalid max of attribute %s: %v", filter.Key, err)
+		}
+		if r == nil {
+			fr := doubleAttributeRange(cookbook.Id, filter, lower, upper)
+			r = &fr
+		}
+		predicates = append(predicates, doubleAttributePredicate(filter.Key, lower, upper))
+	}
+
+	if req.Owner != "" {
+		predicates = append(predicates, func(item types.Item) bool { return item.Owner == req.Owner })
+	}
+	if req.FilterTradeable {
+		predicates = append(predicates, func(item types.Item) bool { return item.Tradeable == req.Tradeable })
+	}
+	if req.RecipeId != "" {
+		predicates = append(predicates, func(item types.Item) bool { return item.RecipeId == req.RecipeId })
+	}
+
+	var nextKey []byte
+	var offset uint64
+	limit := uint64(query.DefaultLimit)
+	if req.Pagination != nil {
+		if req.Pagination.Offset > 0 && req.Pagination.Key != nil {
+			return nil, status.Error(codes.InvalidArgument, "paginate: invalid request, either offset or key is expected, got both")
+		}
+		nextKey = req.Pagination.Key
+		offset = req.Pagination.Offset
+		if req.Pagination.Limit > 0 {
+			limit = req.Pagination.Limit
+		}
+	}
+
+	items, next := k.searchItems(ctx, cookbook.Id, r, func(item types.Item) bool {
+		for _, predicate := range predicates {
+			if !predicate(item) {
+				return false
+			}
+		}
+		return true
+	}, nextKey, offset, limit)
+
+	return &types.QuerySearchItemsResponse{Items: items, Pagination: &query.PageResponse{NextKey: next}}, nil
+}
@@ -0,0 +1,204 @@
+package keeper_test
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/Pylons-tech/pylons/x/pylons/types"
+)
+
+// createSearchItems creates items with attack, weight and name attributes in the given cookbook
+func createSearchItems(suite *IntegrationTestSuite, cookbookID string) []types.Item {
+	owners := types.GenTestBech32List(2)
+	items := []types.Item{
+		{Longs: []types.LongKeyValue{{Key: "attack", Value: -10}}, Doubles: []types.DoubleKeyValue{{Key: "weight", Value: sdk.MustNewDecFromStr("-2.5")}}, Strings: []types.StringKeyValue{{Key: "name", Value: "shield"}}},
+		{Longs: []types.LongKeyValue{{Key: "attack", Value: 20}}, Doubles: []types.DoubleKeyValue{{Key: "weight", Value: sdk.MustNewDecFromStr("1.5")}}, Strings: []types.StringKeyValue{{Key: "name", Value: "sword"}}},
+		{Longs: []types.LongKeyValue{{Key: "attack", Value: 55}}, Doubles: []types.DoubleKeyValue{{Key: "weight", Value: sdk.MustNewDecFromStr("300")}}, Strings: []types.StringKeyValue{{Key: "name", Value: "swordfish"}}},
+		{Longs: []types.LongKeyValue{{Key: "attack", Value: 70}}, Doubles: []types.DoubleKeyValue{{Key: "weight", Value: sdk.MustNewDecFromStr("3")}}, Strings: []types.StringKeyValue{{Key: "name", Value: "sword"}}},
+	}
+	for i := range items {
+		items[i].CookbookId = cookbookID
+		items[i].Owner = owners[i%2]
+		items[i].Tradeable = i != 3
+		items[i].RecipeId = "recipe"
+		items[i].TradePercentage = sdk.ZeroDec()
+		items[i].Id = suite.k.AppendItem(suite.ctx, items[i])
+	}
+	return items
+}
+
+func (suite *IntegrationTestSuite) TestSearchItems() {
+	k := suite.k
+	ctx := suite.ctx
+	require := suite.Require()
+	wctx := sdk.WrapSDKContext(ctx)
+
+	creator := types.GenTestBech32FromString("creator")
+	k.SetCookbook(ctx, types.Cookbook{Creator: creator, Id: "testCookbook", IndexedAttributes: []string{"attack", "weight", "name"}})
+	items := createSearchItems(suite, "testCookbook")
+	// items of a cookbook whose id extends the searched one are not returned
+	k.SetCookbook(ctx, types.Cookbook{Creator: creator, Id: "testCookbook2", IndexedAttributes: []string{"attack"}})
+	createSearchItems(suite, "testCookbook2")
+
+	for _, tc := range []struct {
+		desc     string
+		request  *types.QuerySearchItemsRequest
+		response []types.Item
+		err      error
+	}{
+		{
+			desc:     "All",
+			request:  &types.QuerySearchItemsRequest{CookbookId: "testCookbook"},
+			response: items,
+		},
+		{
+			desc:     "LongRange",
+			request:  &types.QuerySearchItemsRequest{CookbookId: "testCookbook", Longs: []types.LongAttributeFilter{{Key: "attack", Min: "-10", Max: "55"}}},
+			response: items[:3],
+		},
+		{
+			desc:     "LongMin",
+			request:  &types.QuerySearchItemsRequest{CookbookId: "testCookbook", Longs: []types.LongAttributeFilter{{Key: "attack", Min: "50"}}},
+			response: items[2:],
+		},
+		{
+			desc:     "LongEqual",
+			request:  &types.QuerySearchItemsRequest{CookbookId: "testCookbook", Longs: []types.LongAttributeFilter{{Key: "attack", Min: "20", Max: "20"}}},
+			response: items[1:2],
+		},
+		{
+			desc:     "DoubleRange",
+			request:  &types.QuerySearchItemsRequest{CookbookId: "testCookbook", Doubles: []types.DoubleAttributeFilter{{Key: "weight", Min: "-3", Max: "3"}}},
+			response: []types.Item{items[0], items[1], items[3]},
+		},
+		{
+			desc:     "DoubleMax",
+			request:  &types.QuerySearchItemsRequest{CookbookId: "testCookbook", Doubles: []types.DoubleAttributeFilter{{Key: "weight", Max: "1.5"}}},
+			response: items[:2],
+		},
+		{
+			desc:     "StringEqual",
+			request:  &types.QuerySearchItemsRequest{CookbookId: "testCookbook", Strings: []types.StringAttributeFilter{{Key: "name", Value: "sword"}}},
+			response: []types.Item{items[1], items[3]},
+		},
+		{
+			desc:     "StringPrefix",
+			request:  &types.QuerySearchItemsRequest{CookbookId: "testCookbook", Strings: []types.StringAttributeFilter{{Key: "name", Prefix: "sword"}}},
+			response: []types.Item{items[1], items[3], items[2]},
+		},
+		{
+			desc: "Combined",
+			request: &types.QuerySearchItemsRequest{
+				CookbookId:      "testCookbook",
+				Strings:         []types.StringAttributeFilter{{Key: "name", Prefix: "sword"}},
+				Longs:           []types.LongAttributeFilter{{Key: "attack", Min: "50"}},
+				FilterTradeable: true,
+				Tradeable:       true,
+			},
+			response: items[2:3],
+		},
+		{
+			desc:     "Owner",
+			request:  &types.QuerySearchItemsRequest{CookbookId: "testCookbook", Owner: items[1].Owner, RecipeId: "recipe"},
+			response: []types.Item{items[1], items[3]},
+		},
+		{
+			desc:     "NotTradeable",
+			request:  &types.QuerySearchItemsRequest{CookbookId: "testCookbook", FilterTradeable: true},
+			response: items[3:],
+		},
+		{
+			desc:     "WithOffset",
+			request:  &types.QuerySearchItemsRequest{CookbookId: "testCookbook", Longs: []types.LongAttributeFilter{{Key: "attack"}}, Pagination: &query.PageRequest{Offset: 1, Limit: 2}},
+			response: items[1:3],
+		},
+		{
+			desc:    "NotIndexed",
+			request: &types.QuerySearchItemsRequest{CookbookId: "testCookbook", Longs: []types.LongAttributeFilter{{Key: "defense", Min: "1"}}},
+			err:     status.Error(codes.InvalidArgument, "attribute defense is not indexed by cookbook testCookbook"),
+		},
+		{
+			desc:    "InvalidBound",
+			request: &types.QuerySearchItemsRequest{CookbookId: "testCookbook", Doubles: []types.DoubleAttributeFilter{{Key: "weight", Min: "heavy"}}},
+			err:     status.Error(codes.InvalidArgument, "invalid min of attribute weight: failed to set decimal string with base 10: heavy000000000000000000"),
+		},
+		{
+			desc:    "CookbookNotFound",
+			request: &types.QuerySearchItemsRequest{CookbookId: "missing"},
+			err:     status.Error(codes.NotFound, "cookbook not found"),
+		},
+		{
+			desc: "InvalidRequest",
+			err:  status.Error(codes.InvalidArgument, "invalid request"),
+		},
+	} {
+		tc := tc
+		suite.Run(tc.desc, func() {
+			response, err := k.SearchItems(wctx, tc.request)
+			if tc.err != nil {
+				require.ErrorIs(err, tc.err)
+			} else {
+				require.NoError(err)
+				require.Equal(tc.response, response.Items)
+			}
+		})
+	}
+}
+
+func (suite *IntegrationTestSuite) TestSearchItemsPagination() {
+	k := suite.k
+	ctx := suite.ctx
+	require := suite.Require()
+	wctx := sdk.WrapSDKContext(ctx)
+
+	k.SetCookbook(ctx, types.Cookbook{Creator: types.GenTestBech32FromString("creator"), Id: "testCookbook", IndexedAttributes: []string{"attack"}})
+	items := createSearchItems(suite, "testCookbook")
+
+	request := &types.QuerySearchItemsRequest{CookbookId: "testCookbook", Longs: []types.LongAttributeFilter{{Key: "attack", Min: "0"}}, Pagination: &query.PageRequest{Limit: 2}}
+	response, err := k.SearchItems(wctx, request)
+	require.NoError(err)
+	require.Equal(items[1:3], response.Items)
+	require.NotNil(response.Pagination.NextKey)
+
+	request.Pagination.Key = response.Pagination.NextKey
+	response, err = k.SearchItems(wctx, request)
+	require.NoError(err)
+	require.Equal(items[3:], response.Items)
+	require.Nil(response.Pagination.NextKey)
+}
+
+func (suite *IntegrationTestSuite) TestItemAttributeIndexUpdates() {
+	k := suite.k
+	ctx := suite.ctx
+	require := suite.Require()
+	wctx := sdk.WrapSDKContext(ctx)
+
+	// items created before the cookbook declares its indexes are indexed when the indexes are declared
+	creator := types.GenTestBech32FromString("creator")
+	k.SetCookbook(ctx, types.Cookbook{Creator: creator, Id: "testCookbook"})
+	items := createSearchItems(suite, "testCookbook")
+	request := &types.QuerySearchItemsRequest{CookbookId: "testCookbook", Longs: []types.LongAttributeFilter{{Key: "attack", Min: "50"}}}
+	_, err := k.SearchItems(wctx, request)
+	require.Error(err)
+
+	k.SetCookbook(ctx, types.Cookbook{Creator: creator, Id: "testCookbook", IndexedAttributes: []string{"attack"}})
+	response, err := k.SearchItems(wctx, request)
+	require.NoError(err)
+	require.Equal(items[2:], response.Items)
+
+	// updated attributes are reindexed
+	item := items[0]
+	item.Longs = []types.LongKeyValue{{Key: "attack", Value: 100}}
+	k.SetItem(ctx, item)
+	response, err = k.SearchItems(wctx, request)
+	require.NoError(err)
+	require.Equal([]types.Item{items[2], items[3], item}, response.Items)
+
+	// removed items are removed from the index
+	k.RemoveItem(ctx, item.CookbookId, item.Id)
+	response, err = k.SearchItems(wctx, request)
+	require.NoError(err)
+	require.Equal(items[2:], response.Items)
+}
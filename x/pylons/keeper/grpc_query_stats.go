@@ -0,0 +1,43 @@
+package keeper
+
+import (
+	"context"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/Pylons-tech/pylons/x/pylons/types"
+)
+
+func (k Keeper) RecipeStats(c context.Context, req *types.QueryRecipeStatsRequest) (*types.QueryRecipeStatsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	ctx := sdk.UnwrapSDKContext(c)
+
+	if _, found := k.GetRecipe(ctx, req.CookbookId, req.RecipeId); !found {
+		return nil, status.Error(codes.InvalidArgument, "not found")
+	}
+
+	// a recipe that was never executed has empty stats
+	val, _ := k.GetRecipeStats(ctx, req.CookbookId, req.RecipeId)
+
+	return &types.QueryRecipeStatsResponse{Stats: val}, nil
+}
+
+func (k Keeper) CookbookStats(c context.Context, req *types.QueryCookbookStatsRequest) (*types.QueryCookbookStatsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	ctx := sdk.UnwrapSDKContext(c)
+
+	if _, found := k.GetCookbook(ctx, req.CookbookId); !found {
+		return nil, status.Error(codes.InvalidArgument, "not found")
+	}
+
+	// a cookbook whose recipes were never executed has empty stats
+	val, _ := k.GetCookbookStats(ctx, req.CookbookId)
+
+	return &types.QueryCookbookStatsResponse{Stats: val}, nil
+}
@@ -0,0 +1,119 @@
+package keeper_test
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/Pylons-tech/pylons/x/pylons/types"
+)
+
+func (suite *IntegrationTestSuite) TestRecipeStatsQuery() {
+	k := suite.k
+	ctx := suite.ctx
+	require := suite.Require()
+
+	wctx := sdk.WrapSDKContext(ctx)
+	cookbooks := createNCookbook(k, ctx, 1)
+	recipes := createNRecipe(k, ctx, cookbooks[0], 2)
+	stats := types.RecipeStats{
+		CookbookId:      recipes[0].CookbookId,
+		RecipeId:        recipes[0].Id,
+		Executions:      3,
+		UniqueExecutors: 2,
+		Revenue:         sdk.NewCoins(sdk.NewCoin(types.PylonsCoinDenom, sdk.NewInt(10))),
+		ItemsMinted:     []types.ItemMintCount{{ItemOutputId: "output", Count: 3}},
+	}
+	k.SetRecipeStats(ctx, stats)
+
+	for _, tc := range []struct {
+		desc     string
+		request  *types.QueryRecipeStatsRequest
+		response *types.QueryRecipeStatsResponse
+		err      error
+	}{
+		{
+			desc:     "Executed",
+			request:  &types.QueryRecipeStatsRequest{CookbookId: recipes[0].CookbookId, RecipeId: recipes[0].Id},
+			response: &types.QueryRecipeStatsResponse{Stats: stats},
+		},
+		{
+			desc:     "NeverExecuted",
+			request:  &types.QueryRecipeStatsRequest{CookbookId: recipes[1].CookbookId, RecipeId: recipes[1].Id},
+			response: &types.QueryRecipeStatsResponse{Stats: types.RecipeStats{CookbookId: recipes[1].CookbookId, RecipeId: recipes[1].Id}},
+		},
+		{
+			desc:    "KeyNotFound",
+			request: &types.QueryRecipeStatsRequest{CookbookId: "missing", RecipeId: "missing"},
+			err:     status.Error(codes.InvalidArgument, "not found"),
+		},
+		{
+			desc: "InvalidRequest",
+			err:  status.Error(codes.InvalidArgument, "invalid request"),
+		},
+	} {
+		tc := tc
+		suite.Run(tc.desc, func() {
+			response, err := k.RecipeStats(wctx, tc.request)
+			if tc.err != nil {
+				require.ErrorIs(err, tc.err)
+			} else {
+				require.Equal(tc.response, response)
+			}
+		})
+	}
+}
+
+func (suite *IntegrationTestSuite) TestCookbookStatsQuery() {
+	k := suite.k
+	ctx := suite.ctx
+	require := suite.Require()
+
+	wctx := sdk.WrapSDKContext(ctx)
+	cookbooks := createNCookbook(k, ctx, 2)
+	stats := types.CookbookStats{
+		CookbookId:      cookbooks[0].Id,
+		Executions:      3,
+		UniqueExecutors: 2,
+		Fees:            sdk.NewCoins(sdk.NewCoin(types.PylonsCoinDenom, sdk.NewInt(1))),
+		ItemsMinted:     3,
+	}
+	k.SetCookbookStats(ctx, stats)
+
+	for _, tc := range []struct {
+		desc     string
+		request  *types.QueryCookbookStatsRequest
+		response *types.QueryCookbookStatsResponse
+		err      error
+	}{
+		{
+			desc:     "Executed",
+			request:  &types.QueryCookbookStatsRequest{CookbookId: cookbooks[0].Id},
+			response: &types.QueryCookbookStatsResponse{Stats: stats},
+		},
+		{
+			desc:     "NeverExecuted",
+			request:  &types.QueryCookbookStatsRequest{CookbookId: cookbooks[1].Id},
+			response: &types.QueryCookbookStatsResponse{Stats: types.CookbookStats{CookbookId: cookbooks[1].Id}},
+		},
+		{
+			desc:    "KeyNotFound",
+			request: &types.QueryCookbookStatsRequest{CookbookId: "missing"},
+			err:     status.Error(codes.InvalidArgument, "not found"),
+		},
+		{
+			desc: "InvalidRequest",
+			err:  status.Error(codes.InvalidArgument, "invalid request"),
+		},
+	} {
+		tc := tc
+		suite.Run(tc.desc, func() {
+			response, err := k.CookbookStats(wctx, tc.request)
+			if tc.err != nil {
+				require.ErrorIs(err, tc.err)
+			} else {
+				require.Equal(tc.response, response)
+			}
+		})
+	}
+}
@@ -0,0 +1,54 @@
+package keeper_test
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/Pylons-tech/pylons/x/pylons/types"
+)
+
+func (suite *IntegrationTestSuite) TestSwapQuerySingle() {
+	k := suite.k
+	ctx := suite.ctx
+	require := suite.Require()
+
+	wctx := sdk.WrapSDKContext(ctx)
+	msgs := createNSwap(k, ctx, 2)
+	for _, tc := range []struct {
+		desc     string
+		request  *types.QueryGetSwapRequest
+		response *types.QueryGetSwapResponse
+		err      error
+	}{
+		{
+			desc:     "First",
+			request:  &types.QueryGetSwapRequest{Id: msgs[0].Id},
+			response: &types.QueryGetSwapResponse{Swap: msgs[0]},
+		},
+		{
+			desc:     "Second",
+			request:  &types.QueryGetSwapRequest{Id: msgs[1].Id},
+			response: &types.QueryGetSwapResponse{Swap: msgs[1]},
+		},
+		{
+			desc:    "KeyNotFound",
+			request: &types.QueryGetSwapRequest{Id: uint64(len(msgs))},
+			err:     status.Error(codes.InvalidArgument, "not found"),
+		},
+		{
+			desc: "InvalidRequest",
+			err:  status.Error(codes.InvalidArgument, "invalid request"),
+		},
+	} {
+		tc := tc
+		suite.Run(tc.desc, func() {
+			response, err := k.Swap(wctx, tc.request)
+			if tc.err != nil {
+				require.ErrorIs(err, tc.err)
+			} else {
+				require.Equal(tc.response, response)
+			}
+		})
+	}
+}
@@ -58,6 +58,7 @@ func (k Keeper) AppendItem(ctx sdk.Context, item types.Item) string {
 
 // SetItem set a specific item in the store from its index
 func (k Keeper) SetItem(ctx sdk.Context, item types.Item) {
+	k.setItemAttributeIndex(ctx, item)
 	itemsStore := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.ItemKey))
 	cookbookItemsStore := prefix.NewStore(itemsStore, types.KeyPrefix(item.CookbookId))
 	b := k.cdc.MustMarshal(&item)
@@ -65,13 +66,21 @@ func (k Keeper) SetItem(ctx sdk.Context, item types.Item) {
 
 	addr, _ := sdk.AccAddressFromBech32(item.Owner)
 	k.addItemToAddress(ctx, item.CookbookId, item.Id, addr)
+	k.addItemToRecipe(ctx, item)
+	if item.ContainerId != "" {
+		k.addItemToContainer(ctx, item)
+	}
+	k.setItemExpiry(ctx, item)
+	k.setItemNFT(ctx, item)
 	// required for random seed init given how it's handled rn
 	k.IncrementEntityCount(ctx)
 }
 
-// UpdateItem updates an item removing it from previous owner store
+// UpdateItem updates an item removing it from previous owner store. Every transfer, lock and unlock of an item goes
+// through it, so it also clears the approval granted by the previous owner
 func (k Keeper) UpdateItem(ctx sdk.Context, item types.Item, prevAddr sdk.AccAddress) {
 	k.removeItemFromAddress(ctx, item.CookbookId, item.Id, prevAddr)
+	k.RemoveItemApproval(ctx, item.CookbookId, item.Id)
 	k.SetItem(ctx, item)
 }
 
@@ -160,3 +169,76 @@ func (k Keeper) GetItemsByOwnerPaginated(ctx sdk.Context, owner sdk.AccAddress,
 
 	return items, pageRes, nil
 }
+
+// addItemToRecipe indexes an item by cookbook and by the recipe that created it
+func (k Keeper) addItemToRecipe(ctx sdk.Context, item types.Item) {
+	cookbookStore := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.CookbookItemKey))
+	cookbookStore.Set(append(types.CookbookItemIndexPrefix(item.CookbookId), []byte(item.Id)...), []byte(item.Id))
+	if item.RecipeId != "" {
+		recipeStore := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.RecipeItemKey))
+		recipeStore.Set(append(types.RecipeItemIndexPrefix(item.CookbookId, item.RecipeId), []byte(item.Id)...), []byte(item.Id))
+	}
+}
+
+// removeItemFromRecipe removes an item from the cookbook and recipe indexes
+func (k Keeper) removeItemFromRecipe(ctx sdk.Context, item types.Item) {
+	cookbookStore := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.CookbookItemKey))
+	cookbookStore.Delete(append(types.CookbookItemIndexPrefix(item.CookbookId), []byte(item.Id)...))
+	if item.RecipeId != "" {
+		recipeStore := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.RecipeItemKey))
+		recipeStore.Delete(append(types.RecipeItemIndexPrefix(item.CookbookId, item.RecipeId), []byte(item.Id)...))
+	}
+}
+
+// getItemsByIndexPaginated returns a page of the items of a cookbook referenced by an index store
+func (k Keeper) getItemsByIndexPaginated(ctx sdk.Context, store prefix.Store, cookbookID string, pagination *query.PageRequest) ([]types.Item, *query.PageResponse, error) {
+	items := make([]types.Item, 0)
+
+	pageRes, err := query.Paginate(store, pagination, func(_, value []byte) error {
+		item, _ := k.GetItem(ctx, cookbookID, string(value))
+		items = append(items, item)
+		return nil
+	})
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return items, pageRes, nil
+}
+
+// GetItemsByCookbookPaginated returns a page of the items of a cookbook
+func (k Keeper) GetItemsByCookbookPaginated(ctx sdk.Context, cookbookID string, pagination *query.PageRequest) ([]types.Item, *query.PageResponse, error) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.CookbookItemKey))
+	store = prefix.NewStore(store, types.CookbookItemIndexPrefix(cookbookID))
+	return k.getItemsByIndexPaginated(ctx, store, cookbookID, pagination)
+}
+
+// GetItemsByRecipePaginated returns a page of the items created by a recipe
+func (k Keeper) GetItemsByRecipePaginated(ctx sdk.Context, cookbookID, recipeID string, pagination *query.PageRequest) ([]types.Item, *query.PageResponse, error) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.RecipeItemKey))
+	store = prefix.NewStore(store, types.RecipeItemIndexPrefix(cookbookID, recipeID))
+	return k.getItemsByIndexPaginated(ctx, store, cookbookID, pagination)
+}
+
+// RemoveItem removes an item from the store along with its owner index
+func (k Keeper) RemoveItem(ctx sdk.Context, cookbookID, id string) {
+	item, found := k.GetItem(ctx, cookbookID, id)
+	if !found {
+		return
+	}
+	addr, _ := sdk.AccAddressFromBech32(item.Owner)
+	k.removeItemFromAddress(ctx, cookbookID, id, addr)
+	k.removeItemFromRecipe(ctx, item)
+	if item.ContainerId != "" {
+		k.removeItemFromContainer(ctx, item)
+	}
+	k.removeItemExpiry(ctx, item)
+	k.removeItemAttributeIndex(ctx, item)
+	k.RemoveItemApproval(ctx, cookbookID, id)
+	k.RemoveItemToken(ctx, cookbookID, id)
+	k.removeItemNFT(ctx, cookbookID, id)
+
+	itemsStore := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.ItemKey))
+	cookbookItemsStore := prefix.NewStore(itemsStore, types.KeyPrefix(cookbookID))
+	cookbookItemsStore.Delete(types.KeyPrefix(id))
+}
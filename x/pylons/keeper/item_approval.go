@@ -0,0 +1,106 @@
+package keeper
+
+import (
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/Pylons-tech/pylons/x/pylons/types"
+)
+
+// SetItemApproval set the approval of an item in the store, replacing any previous approval
+func (k Keeper) SetItemApproval(ctx sdk.Context, approval types.ItemApproval) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.ItemApprovalKey))
+	b := k.cdc.MustMarshal(&approval)
+	store.Set(getItemApprovalKey(approval.CookbookId, approval.ItemId), b)
+}
+
+// GetItemApproval returns the approval of an item
+func (k Keeper) GetItemApproval(ctx sdk.Context, cookbookID, itemID string) (val types.ItemApproval, found bool) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.ItemApprovalKey))
+	b := store.Get(getItemApprovalKey(cookbookID, itemID))
+	if b == nil {
+		return val, false
+	}
+	k.cdc.MustUnmarshal(b, &val)
+	return val, true
+}
+
+// RemoveItemApproval removes the approval of an item from the store
+func (k Keeper) RemoveItemApproval(ctx sdk.Context, cookbookID, itemID string) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.ItemApprovalKey))
+	store.Delete(getItemApprovalKey(cookbookID, itemID))
+}
+
+// GetAllItemApproval returns all item approvals
+func (k Keeper) GetAllItemApproval(ctx sdk.Context) (list []types.ItemApproval) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.ItemApprovalKey))
+	iterator := sdk.KVStorePrefixIterator(store, []byte{})
+
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var val types.ItemApproval
+		k.cdc.MustUnmarshal(iterator.Value(), &val)
+		list = append(list, val)
+	}
+
+	return
+}
+
+// SetItemOperator authorizes an operator for all the items of an owner in a cookbook
+func (k Keeper) SetItemOperator(ctx sdk.Context, operator types.ItemOperator) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.ItemOperatorKey))
+	b := k.cdc.MustMarshal(&operator)
+	store.Set(getItemOperatorKey(operator.Owner, operator.CookbookId, operator.Operator), b)
+}
+
+// HasItemOperator checks if operator is authorized for all the items of owner in a cookbook
+func (k Keeper) HasItemOperator(ctx sdk.Context, owner, cookbookID, operator string) bool {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.ItemOperatorKey))
+	return store.Has(getItemOperatorKey(owner, cookbookID, operator))
+}
+
+// RemoveItemOperator removes an operator authorization from the store
+func (k Keeper) RemoveItemOperator(ctx sdk.Context, owner, cookbookID, operator string) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.ItemOperatorKey))
+	store.Delete(getItemOperatorKey(owner, cookbookID, operator))
+}
+
+// GetAllItemOperator returns all operator authorizations
+func (k Keeper) GetAllItemOperator(ctx sdk.Context) (list []types.ItemOperator) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.ItemOperatorKey))
+	iterator := sdk.KVStorePrefixIterator(store, []byte{})
+
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var val types.ItemOperator
+		k.cdc.MustUnmarshal(iterator.Value(), &val)
+		list = append(list, val)
+	}
+
+	return
+}
+
+// IsItemOperator checks if operator can transfer an item on behalf of owner, either through an approval of the item
+// granted by owner or as an operator of owner in the item cookbook
+func (k Keeper) IsItemOperator(ctx sdk.Context, owner, cookbookID, itemID, operator string) bool {
+	approval, found := k.GetItemApproval(ctx, cookbookID, itemID)
+	if found && approval.Owner == owner && approval.Operator == operator {
+		return true
+	}
+	return k.HasItemOperator(ctx, owner, cookbookID, operator)
+}
+
+// IsApprovedOrOwner checks if addr owns an item or can transfer it on behalf of its owner
+func (k Keeper) IsApprovedOrOwner(ctx sdk.Context, item types.Item, addr string) bool {
+	return item.Owner == addr || k.IsItemOperator(ctx, item.Owner, item.CookbookId, item.Id, addr)
+}
+
+func getItemApprovalKey(cookbookID, itemID string) []byte {
+	return []byte(cookbookID + "-" + itemID)
+}
+
+func getItemOperatorKey(owner, cookbookID, operator string) []byte {
+	return []byte(owner + "-" + cookbookID + "-" + operator)
+}
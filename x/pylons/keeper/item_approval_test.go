@@ -0,0 +1,63 @@
+package keeper_test
+
+import (
+	"github.com/Pylons-tech/pylons/x/pylons/types"
+)
+
+func (suite *IntegrationTestSuite) TestItemApproval() {
+	k := suite.k
+	ctx := suite.ctx
+	require := suite.Require()
+
+	items := createNItem(k, ctx, 2, true)
+	operator := types.GenTestBech32FromString("operator")
+
+	approval := types.ItemApproval{Owner: items[0].Owner, CookbookId: items[0].CookbookId, ItemId: items[0].Id, Operator: operator}
+	k.SetItemApproval(ctx, approval)
+	val, found := k.GetItemApproval(ctx, items[0].CookbookId, items[0].Id)
+	require.True(found)
+	require.Equal(approval, val)
+	require.Equal([]types.ItemApproval{approval}, k.GetAllItemApproval(ctx))
+
+	require.True(k.IsApprovedOrOwner(ctx, items[0], items[0].Owner))
+	require.True(k.IsApprovedOrOwner(ctx, items[0], operator))
+	require.False(k.IsApprovedOrOwner(ctx, items[1], operator))
+
+	// the approval is only valid while the item is owned by the account that granted it
+	item := items[0]
+	item.Owner = items[1].Owner
+	require.False(k.IsApprovedOrOwner(ctx, item, operator))
+
+	// locking the item, e.g. for a lending or a recipe execution, clears its approval
+	k.SetItemApproval(ctx, approval)
+	k.LockItemForLending(ctx, items[0])
+	_, found = k.GetItemApproval(ctx, items[0].CookbookId, items[0].Id)
+	require.False(found)
+
+	// removing an item removes its approval
+	k.SetItemApproval(ctx, approval)
+	k.RemoveItem(ctx, items[0].CookbookId, items[0].Id)
+	_, found = k.GetItemApproval(ctx, items[0].CookbookId, items[0].Id)
+	require.False(found)
+}
+
+func (suite *IntegrationTestSuite) TestItemOperator() {
+	k := suite.k
+	ctx := suite.ctx
+	require := suite.Require()
+
+	items := createNItem(k, ctx, 2, true)
+	operator := types.GenTestBech32FromString("operator")
+
+	itemOperator := types.ItemOperator{Owner: items[0].Owner, CookbookId: items[0].CookbookId, Operator: operator}
+	k.SetItemOperator(ctx, itemOperator)
+	require.True(k.HasItemOperator(ctx, items[0].Owner, items[0].CookbookId, operator))
+	require.Equal([]types.ItemOperator{itemOperator}, k.GetAllItemOperator(ctx))
+
+	require.True(k.IsApprovedOrOwner(ctx, items[0], operator))
+	// operators are authorized per owner and per cookbook
+	require.False(k.IsApprovedOrOwner(ctx, items[1], operator))
+
+	k.RemoveItemOperator(ctx, items[0].Owner, items[0].CookbookId, operator)
+	require.False(k.IsApprovedOrOwner(ctx, items[0], operator))
+}
@@ -0,0 +1,245 @@
+package keeper
+
+import (
+	"encoding/binary"
+	"math"
+	"math/big"
+
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	storetypes "github.com/cosmos/cosmos-sdk/store/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/Pylons-tech/pylons/x/pylons/types"
+)
+
+// Items are indexed by the attributes their cookbook declares in IndexedAttributes. The index keys are made of
+// the cookbook id, the attribute key, the attribute type and the attribute value encoded so that the byte order
+// of the keys is the order of the values, followed by the item id.
+
+const (
+	attributeTypeDouble byte = 'd'
+	attributeTypeLong   byte = 'l'
+	attributeTypeString byte = 's'
+)
+
+// itemAttributeIndexPrefix returns the prefix of the index keys of a cookbook
+func itemAttributeIndexPrefix(cookbookID string) []byte {
+	return []byte(cookbookID + "-")
+}
+
+// itemAttributePrefix returns the prefix of the index keys of an attribute
+func itemAttributePrefix(cookbookID, key string, attributeType byte) []byte {
+	return append(append(itemAttributeIndexPrefix(cookbookID), []byte(key)...), 0, attributeType)
+}
+
+// encodeLongAttribute encodes a long value with the sign bit flipped so negative values are ordered first
+func encodeLongAttribute(value int64) []byte {
+	bz := make([]byte, 8)
+	binary.BigEndian.PutUint64(bz, uint64(value)^(1<<63))
+	return bz
+}
+
+// encodeDoubleAttribute encodes a decimal value as its sign, the length of its magnitude and its magnitude, the
+// length and magnitude bytes of negative values are inverted so larger magnitudes are ordered first
+func encodeDoubleAttribute(value sdk.Dec) []byte {
+	magnitude := new(big.Int).Abs(value.BigInt()).Bytes()
+	if !value.IsNegative() {
+		return append([]byte{1, byte(len(magnitude))}, magnitude...)
+	}
+	bz := append([]byte{0, byte(math.MaxUint8 - len(magnitude))}, magnitude...)
+	for i := 2; i < len(bz); i++ {
+		bz[i] = ^bz[i]
+	}
+	return bz
+}
+
+// encodeStringAttribute terminates a string value so a value is not a prefix of a longer one
+func encodeStringAttribute(value string) []byte {
+	return append([]byte(value), 0)
+}
+
+// itemAttributeKeys returns the index keys of the indexed attributes of an item
+func itemAttributeKeys(item types.Item, indexedAttributes []string) (keys [][]byte) {
+	indexed := make(map[string]bool, len(indexedAttributes))
+	for _, key := range indexedAttributes {
+		indexed[key] = true
+	}
+	appendKey := func(key string, attributeType byte, value []byte) {
+		if indexed[key] {
+			bz := append(itemAttributePrefix(item.CookbookId, key, attributeType), value...)
+			keys = append(keys, append(bz, []byte(item.Id)...))
+		}
+	}
+	for _, kv := range item.Doubles {
+		appendKey(kv.Key, attributeTypeDouble, encodeDoubleAttribute(kv.Value))
+	}
+	for _, kv := range item.Longs {
+		appendKey(kv.Key, attributeTypeLong, encodeLongAttribute(kv.Value))
+	}
+	for _, kv := range item.Strings {
+		appendKey(kv.Key, attributeTypeString, encodeStringAttribute(kv.Value))
+	}
+	return keys
+}
+
+// setItemAttributeIndex replaces the index entries of the previous version of an item with the ones of the item
+func (k Keeper) setItemAttributeIndex(ctx sdk.Context, item types.Item) {
+	cookbook, found := k.GetCookbook(ctx, item.CookbookId)
+	if !found || len(cookbook.IndexedAttributes) == 0 {
+		return
+	}
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.ItemAttributeIndexKey))
+	if prev, found := k.GetItem(ctx, item.CookbookId, item.Id); found {
+		for _, key := range itemAttributeKeys(prev, cookbook.IndexedAttributes) {
+			store.Delete(key)
+		}
+	}
+	for _, key := range itemAttributeKeys(item, cookbook.IndexedAttributes) {
+		store.Set(key, []byte(item.Id))
+	}
+}
+
+// removeItemAttributeIndex removes the index entries of an item
+func (k Keeper) removeItemAttributeIndex(ctx sdk.Context, item types.Item) {
+	cookbook, found := k.GetCookbook(ctx, item.CookbookId)
+	if !found || len(cookbook.IndexedAttributes) == 0 {
+		return
+	}
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.ItemAttributeIndexKey))
+	for _, key := range itemAttributeKeys(item, cookbook.IndexedAttributes) {
+		store.Delete(key)
+	}
+}
+
+// reindexCookbookItems rebuilds the attribute index of the items of a cookbook
+func (k Keeper) reindexCookbookItems(ctx sdk.Context, cookbook types.Cookbook) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.ItemAttributeIndexKey))
+	cookbookStore := prefix.NewStore(store, itemAttributeIndexPrefix(cookbook.Id))
+	iterator := cookbookStore.Iterator(nil, nil)
+	var stale [][]byte
+	for ; iterator.Valid(); iterator.Next() {
+		stale = append(stale, iterator.Key())
+	}
+	iterator.Close()
+	for _, key := range stale {
+		cookbookStore.Delete(key)
+	}
+
+	if len(cookbook.IndexedAttributes) == 0 {
+		return
+	}
+	for _, item := range k.getItemsByCookbook(ctx, cookbook.Id) {
+		for _, key := range itemAttributeKeys(item, cookbook.IndexedAttributes) {
+			store.Set(key, []byte(item.Id))
+		}
+	}
+}
+
+// getItemsByCookbook returns all the items of a cookbook
+func (k Keeper) getItemsByCookbook(ctx sdk.Context, cookbookID string) (list []types.Item) {
+	itemsStore := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.ItemKey))
+	iterator := sdk.KVStorePrefixIterator(itemsStore, types.KeyPrefix(cookbookID))
+
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var val types.Item
+		k.cdc.MustUnmarshal(iterator.Value(), &val)
+		// the store prefix of a cookbook is also the prefix of the cookbooks whose id extends it
+		if val.CookbookId == cookbookID {
+			list = append(list, val)
+		}
+	}
+
+	return
+}
+
+// itemAttributeRange is the range of index keys of the items matching an attribute filter
+type itemAttributeRange struct {
+	start []byte
+	end   []byte
+}
+
+// longAttributeRange returns the index range of a long attribute filter
+func longAttributeRange(cookbookID string, filter types.LongAttributeFilter, lower, upper *int64) itemAttributeRange {
+	attributePrefix := itemAttributePrefix(cookbookID, filter.Key, attributeTypeLong)
+	r := itemAttributeRange{start: attributePrefix, end: storetypes.PrefixEndBytes(attributePrefix)}
+	if lower != nil {
+		r.start = append(append([]byte{}, attributePrefix...), encodeLongAttribute(*lower)...)
+	}
+	if upper != nil {
+		r.end = storetypes.PrefixEndBytes(append(append([]byte{}, attributePrefix...), encodeLongAttribute(*upper)...))
+	}
+	return r
+}
+
+// doubleAttributeRange returns the index range of a double attribute filter
+func doubleAttributeRange(cookbookID string, filter types.DoubleAttributeFilter, lower, upper *sdk.Dec) itemAttributeRange {
+	attributePrefix := itemAttributePrefix(cookbookID, filter.Key, attributeTypeDouble)
+	r := itemAttributeRange{start: attributePrefix, end: storetypes.PrefixEndBytes(attributePrefix)}
+	if lower != nil {
+		r.start = append(append([]byte{}, attributePrefix...), encodeDoubleAttribute(*lower)...)
+	}
+	if upper != nil {
+		r.end = storetypes.PrefixEndBytes(append(append([]byte{}, attributePrefix...), encodeDoubleAttribute(*upper)...))
+	}
+	return r
+}
+
+// stringAttributeRange returns the index range of a string attribute filter
+func stringAttributeRange(cookbookID string, filter types.StringAttributeFilter) itemAttributeRange {
+	bz := itemAttributePrefix(cookbookID, filter.Key, attributeTypeString)
+	if filter.Value != "" {
+		bz = append(bz, encodeStringAttribute(filter.Value)...)
+	} else {
+		bz = append(bz, []byte(filter.Prefix)...)
+	}
+	return itemAttributeRange{start: bz, end: storetypes.PrefixEndBytes(bz)}
+}
+
+// searchItems returns the items of a cookbook matching a predicate, iterating the index range or all the cookbook
+// items when the range is nil. The page starts at nextKey, or after offset matching items, and holds at most
+// limit items.
+func (k Keeper) searchItems(ctx sdk.Context, cookbookID string, r *itemAttributeRange, match func(types.Item) bool, nextKey []byte, offset, limit uint64) (items []types.Item, next []byte) {
+	var iterator sdk.Iterator
+	if r != nil {
+		store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.ItemAttributeIndexKey))
+		start := r.start
+		if nextKey != nil {
+			start = nextKey
+		}
+		iterator = store.Iterator(start, r.end)
+	} else {
+		itemsStore := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.ItemKey))
+		cookbookPrefix := types.KeyPrefix(cookbookID)
+		start := cookbookPrefix
+		if nextKey != nil {
+			start = nextKey
+		}
+		iterator = itemsStore.Iterator(start, storetypes.PrefixEndBytes(cookbookPrefix))
+	}
+
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var item types.Item
+		if r != nil {
+			item, _ = k.GetItem(ctx, cookbookID, string(iterator.Value()))
+		} else {
+			k.cdc.MustUnmarshal(iterator.Value(), &item)
+		}
+		if item.CookbookId != cookbookID || !match(item) {
+			continue
+		}
+		if uint64(len(items)) == limit {
+			return items, append([]byte{}, iterator.Key()...)
+		}
+		if offset > 0 {
+			offset--
+			continue
+		}
+		items = append(items, item)
+	}
+
+	return items, nil
+}
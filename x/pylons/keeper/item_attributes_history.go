@@ -0,0 +1,38 @@
+package keeper
+
+import (
+	"encoding/binary"
+
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/Pylons-tech/pylons/x/pylons/types"
+)
+
+// AppendItemAttributesHistory records an attribute update of an item, the updates of an item are ordered by the
+// entity count when they are recorded
+func (k Keeper) AppendItemAttributesHistory(ctx sdk.Context, history types.ItemAttributesHistory) {
+	itemStore := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(history.CookbookId+history.Id))
+	historyStore := prefix.NewStore(itemStore, types.KeyPrefix(types.ItemAttributesHistoryKey))
+	key := make([]byte, 8)
+	binary.BigEndian.PutUint64(key, k.GetEntityCount(ctx))
+	historyStore.Set(key, k.cdc.MustMarshal(&history))
+
+	k.IncrementEntityCount(ctx)
+}
+
+// GetAllItemAttributesHistory returns the attribute updates of an item, oldest first
+func (k Keeper) GetAllItemAttributesHistory(ctx sdk.Context, cookbookID, id string) (list []types.ItemAttributesHistory) {
+	itemStore := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(cookbookID+id))
+	historyStore := prefix.NewStore(itemStore, types.KeyPrefix(types.ItemAttributesHistoryKey))
+	iterator := sdk.KVStorePrefixIterator(historyStore, []byte{})
+
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var val types.ItemAttributesHistory
+		k.cdc.MustUnmarshal(iterator.Value(), &val)
+		list = append(list, val)
+	}
+	return
+}
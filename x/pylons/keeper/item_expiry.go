@@ -0,0 +1,96 @@
+package keeper
+
+import (
+	"strings"
+
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/Pylons-tech/pylons/x/pylons/types"
+)
+
+// itemExpiryKey builds the index key of an item, ordered by expiry
+func itemExpiryKey(expiry int64, cookbookID, itemID string) []byte {
+	return append(sdk.Uint64ToBigEndian(uint64(expiry)), []byte(cookbookID+"-"+itemID)...)
+}
+
+// setItemExpiry indexes an item by its expiry height and time
+func (k Keeper) setItemExpiry(ctx sdk.Context, item types.Item) {
+	value := []byte(item.CookbookId + "-" + item.Id)
+	if item.ExpiresAtHeight != 0 {
+		store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.ItemExpiryHeightKey))
+		store.Set(itemExpiryKey(item.ExpiresAtHeight, item.CookbookId, item.Id), value)
+	}
+	if item.ExpiresAt != 0 {
+		store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.ItemExpiryTimeKey))
+		store.Set(itemExpiryKey(item.ExpiresAt, item.CookbookId, item.Id), value)
+	}
+}
+
+// removeItemExpiry removes an item from the expiry indexes
+func (k Keeper) removeItemExpiry(ctx sdk.Context, item types.Item) {
+	if item.ExpiresAtHeight != 0 {
+		store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.ItemExpiryHeightKey))
+		store.Delete(itemExpiryKey(item.ExpiresAtHeight, item.CookbookId, item.Id))
+	}
+	if item.ExpiresAt != 0 {
+		store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.ItemExpiryTimeKey))
+		store.Delete(itemExpiryKey(item.ExpiresAt, item.CookbookId, item.Id))
+	}
+}
+
+// getExpiredItemRefs returns at most limit items of the index whose expiry is lower or equal to the given one
+func (k Keeper) getExpiredItemRefs(ctx sdk.Context, key string, expiry int64, limit int) (list []types.ItemRef) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(key))
+	iterator := store.Iterator(nil, sdk.Uint64ToBigEndian(uint64(expiry)+1))
+
+	defer iterator.Close()
+
+	for ; iterator.Valid() && len(list) < limit; iterator.Next() {
+		idParts := strings.Split(string(iterator.Value()), "-")
+		list = append(list, types.ItemRef{CookbookId: idParts[0], ItemId: idParts[1]})
+	}
+
+	return
+}
+
+// DeleteExpiredItems deletes at most limit expired items, returning the number of deleted items. Items locked by a
+// trade, an execution, a lending, an auction, a swap, an IBC transfer or a container are only removed from the expiry
+// indexes, they are indexed again and deleted once unlocked.
+func (k Keeper) DeleteExpiredItems(ctx sdk.Context, limit int) int {
+	refs := k.getExpiredItemRefs(ctx, types.ItemExpiryHeightKey, ctx.BlockHeight(), limit)
+	refs = append(refs, k.getExpiredItemRefs(ctx, types.ItemExpiryTimeKey, ctx.BlockTime().Unix(), limit-len(refs))...)
+
+	tradesLocker := k.accountKeeper.GetModuleAddress(types.TradesLockerName).String()
+	executionsLocker := k.accountKeeper.GetModuleAddress(types.ExecutionsLockerName).String()
+	lendingsLocker := k.accountKeeper.GetModuleAddress(types.LendingsLockerName).String()
+	auctionsLocker := k.accountKeeper.GetModuleAddress(types.AuctionsLockerName).String()
+	swapsLocker := k.accountKeeper.GetModuleAddress(types.SwapsLockerName).String()
+	nftTransferEscrow := k.accountKeeper.GetModuleAddress(types.NFTTransferEscrowName).String()
+	containersLocker := k.accountKeeper.GetModuleAddress(types.ContainersLockerName).String()
+
+	deleted := 0
+	for _, ref := range refs {
+		item, found := k.GetItem(ctx, ref.CookbookId, ref.ItemId)
+		if !found {
+			continue
+		}
+		k.removeItemExpiry(ctx, item)
+		if item.Owner == tradesLocker || item.Owner == executionsLocker || item.Owner == lendingsLocker || item.Owner == auctionsLocker || item.Owner == swapsLocker || item.Owner == nftTransferEscrow || item.Owner == containersLocker {
+			continue
+		}
+		// the contents of an expired container are given back to its owner
+		for _, content := range k.GetContainerItems(ctx, item.CookbookId, item.Id) {
+			k.WithdrawItemFromContainer(ctx, content, item.Owner)
+		}
+		k.RemoveItem(ctx, item.CookbookId, item.Id)
+		_ = ctx.EventManager().EmitTypedEvent(&types.EventExpireItem{
+			Owner:      item.Owner,
+			CookbookId: item.CookbookId,
+			Id:         item.Id,
+		})
+		deleted++
+	}
+
+	return deleted
+}
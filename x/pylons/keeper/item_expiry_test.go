@@ -0,0 +1,89 @@
+package keeper_test
+
+import (
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/Pylons-tech/pylons/x/pylons/keeper"
+	"github.com/Pylons-tech/pylons/x/pylons/types"
+)
+
+func (suite *IntegrationTestSuite) TestDeleteExpiredItems() {
+	k := suite.k
+	ctx := suite.ctx.WithBlockHeight(10).WithBlockTime(time.Unix(1000, 0))
+	require := suite.Require()
+
+	owner := types.GenTestBech32FromString("owner")
+	ownerAddr, _ := sdk.AccAddressFromBech32(owner)
+	newItem := func(expiresAtHeight, expiresAt int64) types.Item {
+		item := types.Item{
+			Owner:           owner,
+			CookbookId:      "testCookbook",
+			TradePercentage: sdk.ZeroDec(),
+			ExpiresAtHeight: expiresAtHeight,
+			ExpiresAt:       expiresAt,
+		}
+		item.Id = k.AppendItem(ctx, item)
+		return item
+	}
+
+	expiredByHeight := newItem(10, 0)
+	expiredByTime := newItem(0, 900)
+	notExpired := newItem(11, 2000)
+	neverExpires := newItem(0, 0)
+	locked := newItem(5, 0)
+	k.LockItemForTrade(ctx, locked)
+
+	require.True(expiredByHeight.IsExpired(ctx))
+	require.True(expiredByTime.IsExpired(ctx))
+	require.False(notExpired.IsExpired(ctx))
+	require.False(neverExpires.IsExpired(ctx))
+
+	// the sweep is bounded by the given limit, the locked item is only removed from the index
+	require.Equal(1, k.DeleteExpiredItems(ctx, 2))
+	require.Equal(1, k.DeleteExpiredItems(ctx, 10))
+	require.False(k.HasItem(ctx, expiredByHeight.CookbookId, expiredByHeight.Id))
+	require.False(k.HasItem(ctx, expiredByTime.CookbookId, expiredByTime.Id))
+	require.True(k.HasItem(ctx, notExpired.CookbookId, notExpired.Id))
+	require.True(k.HasItem(ctx, neverExpires.CookbookId, neverExpires.Id))
+	require.True(k.HasItem(ctx, locked.CookbookId, locked.Id))
+	require.Len(k.GetAllItemByOwner(ctx, ownerAddr), 2)
+
+	// locked items are deleted once unlocked
+	lockedItem, _ := k.GetItem(ctx, locked.CookbookId, locked.Id)
+	k.UnlockItemForTrade(ctx, lockedItem, owner)
+	require.Equal(1, k.DeleteExpiredItems(ctx, 10))
+	require.False(k.HasItem(ctx, locked.CookbookId, locked.Id))
+
+	ctx = ctx.WithBlockHeight(11)
+	require.Equal(1, k.DeleteExpiredItems(ctx, 10))
+	require.Equal(0, k.DeleteExpiredItems(ctx, 10))
+	require.Len(k.GetAllItemByOwner(ctx, ownerAddr), 1)
+}
+
+func (suite *IntegrationTestSuite) TestMsgServerSendItemsExpired() {
+	k := suite.k
+	ctx := suite.ctx.WithBlockHeight(10)
+	require := suite.Require()
+
+	wctx := sdk.WrapSDKContext(ctx)
+	srv := keeper.NewMsgServerImpl(k)
+
+	owner := types.GenTestBech32FromString("owner")
+	item := types.Item{
+		Owner:           owner,
+		CookbookId:      "testCookbook",
+		Tradeable:       true,
+		TradePercentage: sdk.ZeroDec(),
+		ExpiresAtHeight: 10,
+	}
+	item.Id = k.AppendItem(ctx, item)
+
+	_, err := srv.SendItems(wctx, &types.MsgSendItems{
+		Creator:  owner,
+		Receiver: types.GenTestBech32FromString("receiver"),
+		Items:    []types.ItemRef{{CookbookId: item.CookbookId, ItemId: item.Id}},
+	})
+	require.ErrorIs(err, types.ErrItemExpired)
+}
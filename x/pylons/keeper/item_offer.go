@@ -0,0 +1,95 @@
+package keeper
+
+import (
+	"strconv"
+
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/Pylons-tech/pylons/x/pylons/types"
+)
+
+// GetItemOfferCount get the total number of item offers
+func (k Keeper) GetItemOfferCount(ctx sdk.Context) uint64 {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.ItemOfferCountKey))
+	byteKey := types.KeyPrefix(types.ItemOfferCountKey)
+	bz := store.Get(byteKey)
+
+	// Count doesn't exist: no element
+	if bz == nil {
+		return 0
+	}
+
+	// Parse bytes
+	count, err := strconv.ParseUint(string(bz), 10, 64)
+	if err != nil {
+		// Panic because the count should be always formattable to uint64
+		panic("cannot decode count")
+	}
+
+	return count
+}
+
+// SetItemOfferCount set the total number of item offers
+func (k Keeper) SetItemOfferCount(ctx sdk.Context, count uint64) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.ItemOfferCountKey))
+	byteKey := types.KeyPrefix(types.ItemOfferCountKey)
+	bz := []byte(strconv.FormatUint(count, 10))
+	store.Set(byteKey, bz)
+}
+
+// AppendItemOffer appends an item offer in the store with a new id and update the count
+func (k Keeper) AppendItemOffer(ctx sdk.Context, offer types.ItemOffer) uint64 {
+	count := k.GetItemOfferCount(ctx)
+
+	offer.Id = count
+	k.SetItemOffer(ctx, offer)
+
+	k.SetItemOfferCount(ctx, count+1)
+
+	return count
+}
+
+// SetItemOffer set a specific item offer in the store, indexing it by expiry
+func (k Keeper) SetItemOffer(ctx sdk.Context, offer types.ItemOffer) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.ItemOfferKey))
+	b := k.cdc.MustMarshal(&offer)
+	store.Set(sdk.Uint64ToBigEndian(offer.Id), b)
+
+	k.setItemOfferExpiry(ctx, offer)
+}
+
+// GetItemOffer returns an item offer from its id
+func (k Keeper) GetItemOffer(ctx sdk.Context, id uint64) (val types.ItemOffer, found bool) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.ItemOfferKey))
+	b := store.Get(sdk.Uint64ToBigEndian(id))
+	if b == nil {
+		return val, false
+	}
+	k.cdc.MustUnmarshal(b, &val)
+	return val, true
+}
+
+// RemoveItemOffer removes an item offer from the store along with its expiry indexes
+func (k Keeper) RemoveItemOffer(ctx sdk.Context, offer types.ItemOffer) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.ItemOfferKey))
+	store.Delete(sdk.Uint64ToBigEndian(offer.Id))
+
+	k.removeItemOfferExpiry(ctx, offer)
+}
+
+// GetAllItemOffer returns all item offers
+func (k Keeper) GetAllItemOffer(ctx sdk.Context) (list []types.ItemOffer) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.ItemOfferKey))
+	iterator := sdk.KVStorePrefixIterator(store, []byte{})
+
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var val types.ItemOffer
+		k.cdc.MustUnmarshal(iterator.Value(), &val)
+		list = append(list, val)
+	}
+
+	return
+}
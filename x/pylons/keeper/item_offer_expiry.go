@@ -0,0 +1,69 @@
+package keeper
+
+import (
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/Pylons-tech/pylons/x/pylons/types"
+)
+
+// setItemOfferExpiry indexes an item offer by its expiry height and time
+func (k Keeper) setItemOfferExpiry(ctx sdk.Context, offer types.ItemOffer) {
+	if offer.ExpiresAtHeight != 0 {
+		store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.ItemOfferExpiryHeightKey))
+		store.Set(expiryKey(offer.ExpiresAtHeight, offer.Id), sdk.Uint64ToBigEndian(offer.Id))
+	}
+	if offer.ExpiresAt != 0 {
+		store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.ItemOfferExpiryTimeKey))
+		store.Set(expiryKey(offer.ExpiresAt, offer.Id), sdk.Uint64ToBigEndian(offer.Id))
+	}
+}
+
+// removeItemOfferExpiry removes an item offer from the expiry indexes
+func (k Keeper) removeItemOfferExpiry(ctx sdk.Context, offer types.ItemOffer) {
+	if offer.ExpiresAtHeight != 0 {
+		store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.ItemOfferExpiryHeightKey))
+		store.Delete(expiryKey(offer.ExpiresAtHeight, offer.Id))
+	}
+	if offer.ExpiresAt != 0 {
+		store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.ItemOfferExpiryTimeKey))
+		store.Delete(expiryKey(offer.ExpiresAt, offer.Id))
+	}
+}
+
+// cancelItemOffer refunds the escrowed coins of an item offer to its creator and removes the offer
+func (k Keeper) cancelItemOffer(ctx sdk.Context, offer types.ItemOffer, reason string) error {
+	addr, _ := sdk.AccAddressFromBech32(offer.Creator)
+	err := k.UnLockCoinsForOffer(ctx, addr, offer.Price)
+	if err != nil {
+		// this should never happen, it means the module account has been drained of funds illegitimately
+		panic(err)
+	}
+
+	k.RemoveItemOffer(ctx, offer)
+
+	return ctx.EventManager().EmitTypedEvent(&types.EventCancelItemOffer{
+		Creator: offer.Creator,
+		Id:      offer.Id,
+		Reason:  reason,
+	})
+}
+
+// CancelExpiredItemOffers cancels at most limit expired item offers, returning the number of cancelled offers
+func (k Keeper) CancelExpiredItemOffers(ctx sdk.Context, limit int) int {
+	ids := k.getExpiredIDs(ctx, types.ItemOfferExpiryHeightKey, ctx.BlockHeight(), limit)
+	ids = append(ids, k.getExpiredIDs(ctx, types.ItemOfferExpiryTimeKey, ctx.BlockTime().Unix(), limit-len(ids))...)
+
+	cancelled := 0
+	for _, id := range ids {
+		// an offer expiring by height and by time can be listed twice
+		offer, found := k.GetItemOffer(ctx, id)
+		if !found {
+			continue
+		}
+		_ = k.cancelItemOffer(ctx, offer, types.ItemOfferCancelReasonExpired)
+		cancelled++
+	}
+
+	return cancelled
+}
@@ -0,0 +1,96 @@
+package keeper_test
+
+import (
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/Pylons-tech/pylons/x/pylons/keeper"
+	"github.com/Pylons-tech/pylons/x/pylons/types"
+)
+
+func (suite *IntegrationTestSuite) TestItemOfferGet() {
+	k := suite.k
+	ctx := suite.ctx
+	require := suite.Require()
+	items := createNItemOffer(k, ctx, 10)
+	for _, item := range items {
+		offer, found := k.GetItemOffer(ctx, item.Id)
+		require.True(found)
+		require.Equal(item, offer)
+	}
+}
+
+func (suite *IntegrationTestSuite) TestItemOfferRemove() {
+	k := suite.k
+	ctx := suite.ctx
+	require := suite.Require()
+	items := createNItemOffer(k, ctx, 10)
+	for _, item := range items {
+		k.RemoveItemOffer(ctx, item)
+		_, found := k.GetItemOffer(ctx, item.Id)
+		require.False(found)
+	}
+}
+
+func (suite *IntegrationTestSuite) TestItemOfferGetAll() {
+	k := suite.k
+	ctx := suite.ctx
+	require := suite.Require()
+	items := createNItemOffer(k, ctx, 10)
+	require.Equal(items, k.GetAllItemOffer(ctx))
+	require.Equal(uint64(len(items)), k.GetItemOfferCount(ctx))
+}
+
+func (suite *IntegrationTestSuite) TestCancelExpiredItemOffers() {
+	k := suite.k
+	bk := suite.bankKeeper
+	ctx := suite.ctx.WithBlockHeight(10).WithBlockTime(time.Unix(1000, 0))
+	require := suite.Require()
+
+	wctx := sdk.WrapSDKContext(ctx)
+	srv := keeper.NewMsgServerImpl(k)
+
+	cookbook := createNCookbook(k, ctx, 1)[0]
+	creator := types.GenTestBech32FromString("creator")
+	creatorAddr, _ := sdk.AccAddressFromBech32(creator)
+	price := sdk.NewCoins(sdk.NewCoin(types.PylonsCoinDenom, sdk.NewInt(100)))
+	err := k.MintCoinsToAddr(ctx, creatorAddr, price.MulInt(sdk.NewInt(4)))
+	require.NoError(err)
+	newOffer := func(expiresAtHeight, expiresAt int64) uint64 {
+		msg := types.NewMsgCreateItemOffer(creator, cookbook.Id, "", types.ItemInput{}, price)
+		msg.ExpiresAtHeight = expiresAtHeight
+		msg.ExpiresAt = expiresAt
+		res, err := srv.CreateItemOffer(wctx, msg)
+		require.NoError(err)
+		return res.Id
+	}
+
+	// an offer cannot be created already expired
+	msg := types.NewMsgCreateItemOffer(creator, cookbook.Id, "", types.ItemInput{}, price)
+	msg.ExpiresAt = 1000
+	_, err = srv.CreateItemOffer(wctx, msg)
+	require.Error(err)
+
+	expiredByHeight := newOffer(11, 0)
+	expiredByTime := newOffer(0, 1100)
+	expiredByBoth := newOffer(11, 1100)
+	notExpired := newOffer(12, 2000)
+	require.True(bk.GetBalance(ctx, creatorAddr, types.PylonsCoinDenom).Amount.IsZero())
+
+	// expired offers are refunded to their creator
+	ctx = ctx.WithBlockHeight(11).WithBlockTime(time.Unix(1100, 0))
+	require.Equal(2, k.CancelExpiredItemOffers(ctx, 2))
+	require.Equal(1, k.CancelExpiredItemOffers(ctx, 10))
+	require.Equal(0, k.CancelExpiredItemOffers(ctx, 10))
+	for _, id := range []uint64{expiredByHeight, expiredByTime, expiredByBoth} {
+		_, found := k.GetItemOffer(ctx, id)
+		require.False(found)
+	}
+	_, found := k.GetItemOffer(ctx, notExpired)
+	require.True(found)
+	require.True(bk.GetBalance(ctx, creatorAddr, types.PylonsCoinDenom).Amount.Equal(sdk.NewInt(300)))
+
+	events := ctx.EventManager().Events()
+	require.Equal("pylons.pylons.EventCancelItemOffer", events[len(events)-1].Type)
+}
@@ -0,0 +1,43 @@
+package keeper
+
+import (
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+
+	"github.com/Pylons-tech/pylons/x/pylons/types"
+)
+
+func (k Keeper) getItemProvenanceStore(ctx sdk.Context, cookbookID, id string) prefix.Store {
+	itemStore := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(cookbookID+id))
+	return prefix.NewStore(itemStore, types.KeyPrefix(types.ItemProvenanceKey))
+}
+
+// AppendItemProvenance records an event in the provenance of an item, the events of an item are ordered by the
+// entity count when they are recorded
+func (k Keeper) AppendItemProvenance(ctx sdk.Context, provenance types.ItemProvenance) {
+	store := k.getItemProvenanceStore(ctx, provenance.CookbookId, provenance.Id)
+	store.Set(sdk.Uint64ToBigEndian(k.GetEntityCount(ctx)), k.cdc.MustMarshal(&provenance))
+
+	k.IncrementEntityCount(ctx)
+}
+
+// GetItemProvenancePaginated returns a page of the provenance of an item, oldest first unless the page is reversed
+func (k Keeper) GetItemProvenancePaginated(ctx sdk.Context, cookbookID, id string, pagination *query.PageRequest) ([]types.ItemProvenance, *query.PageResponse, error) {
+	provenance := make([]types.ItemProvenance, 0)
+
+	store := k.getItemProvenanceStore(ctx, cookbookID, id)
+	pageRes, err := query.Paginate(store, pagination, func(_, value []byte) error {
+		var val types.ItemProvenance
+		if err := k.cdc.Unmarshal(value, &val); err != nil {
+			return err
+		}
+		provenance = append(provenance, val)
+		return nil
+	})
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return provenance, pageRes, nil
+}
@@ -0,0 +1,89 @@
+package keeper
+
+import (
+	"strings"
+
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	"github.com/Pylons-tech/pylons/x/pylons/types"
+)
+
+// SplitItem moves amount units of a fungible item into a new item owned by the same account.
+// The item is read back from the store, so callers holding a stale copy cannot split more units than it holds.
+// The item itself is returned when amount is its whole quantity.
+func (k Keeper) SplitItem(ctx sdk.Context, item types.Item, amount uint64) (types.Item, error) {
+	stored, found := k.GetItem(ctx, item.CookbookId, item.Id)
+	if !found {
+		return types.Item{}, sdkerrors.Wrapf(sdkerrors.ErrKeyNotFound, "item with id %s in cookbook %s not found", item.Id, item.CookbookId)
+	}
+	item = stored
+	if !item.Fungible {
+		return types.Item{}, sdkerrors.Wrapf(types.ErrItemQuantity, "item with id %s in cookbook %s is not fungible", item.Id, item.CookbookId)
+	}
+	if amount == 0 || amount > item.Quantity {
+		return types.Item{}, sdkerrors.Wrapf(types.ErrItemQuantity, "cannot split %d units from item with id %s holding %d", amount, item.Id, item.Quantity)
+	}
+	if amount == item.Quantity {
+		return item, nil
+	}
+
+	item.Quantity -= amount
+	item.UpdatedAt = ctx.BlockTime().Unix()
+	k.SetItem(ctx, item)
+
+	split := item
+	split.Quantity = amount
+	split.Id = k.AppendItem(ctx, split)
+	return split, nil
+}
+
+// getStackableItem returns an item owned by addr that item can be merged into
+func (k Keeper) getStackableItem(ctx sdk.Context, addr sdk.AccAddress, item types.Item) (val types.Item, found bool) {
+	parentStore := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.AddrItemKey))
+	addrStore := prefix.NewStore(parentStore, addr.Bytes())
+	store := prefix.NewStore(addrStore, types.KeyPrefix(item.CookbookId))
+	iterator := sdk.KVStorePrefixIterator(store, []byte{})
+
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		idParts := strings.Split(string(iterator.Value()), "-")
+		if idParts[1] == item.Id {
+			continue
+		}
+		stack, _ := k.GetItem(ctx, idParts[0], idParts[1])
+		if stack.IsStackableWith(item) {
+			return stack, true
+		}
+	}
+
+	return val, false
+}
+
+// MergeItem adds the quantity of a fungible item to a stackable item already held by its owner, removing the merged item.
+// The resulting item is returned, which is item itself if the owner holds no stackable item.
+func (k Keeper) MergeItem(ctx sdk.Context, item types.Item) types.Item {
+	if !item.Fungible {
+		return item
+	}
+	addr, _ := sdk.AccAddressFromBech32(item.Owner)
+	stack, found := k.getStackableItem(ctx, addr, item)
+	if !found {
+		return item
+	}
+
+	k.RemoveItem(ctx, item.CookbookId, item.Id)
+	stack.Quantity += item.Quantity
+	// the merged units keep the most restrictive transfer state
+	if item.TransferCount > stack.TransferCount {
+		stack.TransferCount = item.TransferCount
+	}
+	if item.LastTransferHeight > stack.LastTransferHeight {
+		stack.LastTransferHeight = item.LastTransferHeight
+	}
+	stack.UpdatedAt = ctx.BlockTime().Unix()
+	k.SetItem(ctx, stack)
+	return stack
+}
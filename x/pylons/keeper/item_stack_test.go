@@ -0,0 +1,113 @@
+package keeper_test
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/Pylons-tech/pylons/x/pylons/types"
+)
+
+func (suite *IntegrationTestSuite) TestSplitItem() {
+	k := suite.k
+	ctx := suite.ctx
+	require := suite.Require()
+
+	owner := types.GenTestBech32FromString("owner")
+	item := types.Item{
+		Owner:           owner,
+		CookbookId:      "testCookbook",
+		Tradeable:       true,
+		TradePercentage: sdk.ZeroDec(),
+		Fungible:        true,
+		Quantity:        10,
+	}
+	item.Id = k.AppendItem(ctx, item)
+
+	split, err := k.SplitItem(ctx, item, 4)
+	require.NoError(err)
+	require.NotEqual(item.Id, split.Id)
+	require.Equal(uint64(4), split.Quantity)
+	require.Equal(owner, split.Owner)
+
+	source, found := k.GetItem(ctx, item.CookbookId, item.Id)
+	require.True(found)
+	require.Equal(uint64(6), source.Quantity)
+
+	// splitting the whole quantity returns the item itself
+	whole, err := k.SplitItem(ctx, source, 6)
+	require.NoError(err)
+	require.Equal(source.Id, whole.Id)
+
+	_, err = k.SplitItem(ctx, source, 7)
+	require.ErrorIs(err, types.ErrItemQuantity)
+
+	// a stale copy cannot split units already taken out of the item
+	_, err = k.SplitItem(ctx, item, 7)
+	require.ErrorIs(err, types.ErrItemQuantity)
+
+	nonFungible := createNItem(k, ctx, 1, true)[0]
+	_, err = k.SplitItem(ctx, nonFungible, 1)
+	require.ErrorIs(err, types.ErrItemQuantity)
+}
+
+func (suite *IntegrationTestSuite) TestMergeItem() {
+	k := suite.k
+	ctx := suite.ctx
+	require := suite.Require()
+
+	owner := types.GenTestBech32FromString("owner")
+	ownerAddr, _ := sdk.AccAddressFromBech32(owner)
+	item := types.Item{
+		Owner:           owner,
+		CookbookId:      "testCookbook",
+		RecipeId:        "testRecipe",
+		Tradeable:       true,
+		TradePercentage: sdk.ZeroDec(),
+		Fungible:        true,
+		Quantity:        10,
+	}
+	stackID := k.AppendItem(ctx, item)
+
+	// an item with different properties is not stacked
+	other := item
+	other.RecipeId = "otherRecipe"
+	other.Quantity = 3
+	other.Id = k.AppendItem(ctx, other)
+	merged := k.MergeItem(ctx, other)
+	require.Equal(other.Id, merged.Id)
+
+	incoming := item
+	incoming.Quantity = 5
+	incoming.Id = k.AppendItem(ctx, incoming)
+	merged = k.MergeItem(ctx, incoming)
+	require.Equal(stackID, merged.Id)
+	require.Equal(uint64(15), merged.Quantity)
+	require.False(k.HasItem(ctx, incoming.CookbookId, incoming.Id))
+
+	ownerItems := k.GetAllItemByOwner(ctx, ownerAddr)
+	require.Len(ownerItems, 2)
+
+	// merged units keep the highest transfer count and last transfer height, so a merge does not reset them
+	transferred := item
+	transferred.Quantity = 1
+	transferred.TransferCount = 7
+	transferred.LastTransferHeight = 42
+	transferred.Id = k.AppendItem(ctx, transferred)
+	merged = k.MergeItem(ctx, transferred)
+	require.Equal(stackID, merged.Id)
+	require.Equal(uint64(7), merged.TransferCount)
+	require.Equal(int64(42), merged.LastTransferHeight)
+
+	fresh := item
+	fresh.Quantity = 1
+	fresh.Id = k.AppendItem(ctx, fresh)
+	merged = k.MergeItem(ctx, fresh)
+	require.Equal(stackID, merged.Id)
+	require.Equal(uint64(7), merged.TransferCount)
+	require.Equal(int64(42), merged.LastTransferHeight)
+
+	// an item held by a container is not stacked with the items outside of it
+	contained := item
+	contained.Quantity = 1
+	contained.ContainerId = "container"
+	require.False(merged.IsStackableWith(contained))
+}
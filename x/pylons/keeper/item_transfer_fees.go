@@ -0,0 +1,111 @@
+package keeper
+
+import (
+	"sort"
+
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/Pylons-tech/pylons/x/pylons/types"
+)
+
+// itemTransferFees splits the price paid for items between the chain fees, the royalties of the cookbook owners and
+// the proceeds of the seller. Each item gets a share of the price weighted by the transfer fee selected by the
+// permutation, the TradePercentage of that share clamped to MaxTransferFee is the royalty of the item cookbook, and
+// the chain takes ItemTransferFeePercentage of the royalty
+func (k Keeper) itemTransferFees(ctx sdk.Context, items []types.Item, permutation []int, price sdk.Coins) (chainFees sdk.Coins, royalties map[string]sdk.Coins, proceeds sdk.Coins) {
+	totalFees := sdk.NewCoins()
+	counts := make(map[string]int64)
+	for i, item := range items {
+		transferFee := item.TransferFee[permutation[i]]
+		totalFees = totalFees.Add(transferFee)
+		counts[transferFee.Denom]++
+	}
+
+	maxTransferFee := k.MaxTransferFee(ctx)
+	chainPercentage := k.ItemTransferFeePercentage(ctx)
+	chainFees = sdk.NewCoins()
+	royalties = make(map[string]sdk.Coins)
+	paid := sdk.NewCoins()
+	for i, item := range items {
+		transferFee := item.TransferFee[permutation[i]]
+		var share math.Int
+		if total := totalFees.AmountOf(transferFee.Denom); total.IsZero() {
+			// items without fee in this denom share the price evenly
+			share = price.AmountOf(transferFee.Denom).QuoRaw(counts[transferFee.Denom])
+		} else {
+			share = price.AmountOf(transferFee.Denom).Mul(transferFee.Amount).Quo(total)
+		}
+		royalty := sdk.NewDecFromInt(share).Mul(item.TradePercentage).RoundInt()
+		if royalty.GT(maxTransferFee) {
+			// clamp to maxTransferFee - maxTransferFee and minTransferFee are global (i.e. same for every coin)
+			royalty = maxTransferFee
+		}
+		chainAmt := sdk.NewDecFromInt(royalty).Mul(chainPercentage).RoundInt()
+		chainFees = chainFees.Add(sdk.NewCoin(transferFee.Denom, chainAmt))
+		royalties[item.CookbookId] = royalties[item.CookbookId].Add(sdk.NewCoin(transferFee.Denom, royalty.Sub(chainAmt)))
+		paid = paid.Add(sdk.NewCoin(transferFee.Denom, royalty))
+	}
+
+	return chainFees, royalties, price.Sub(paid...)
+}
+
+// sentItemsTransferFees splits the transfer fees of items given away between the chain fees and the royalties of the
+// cookbook owners. The transfer fees selected by the permutation are summed by cookbook and clamped between
+// MinTransferFee and MaxTransferFee, and the chain takes ItemTransferFeePercentage of them
+func (k Keeper) sentItemsTransferFees(ctx sdk.Context, items []types.Item, permutation []int) (chainFees sdk.Coins, royalties map[string]sdk.Coins) {
+	transferFees := make(map[string]sdk.Coins)
+	for i, item := range items {
+		transferFees[item.CookbookId] = transferFees[item.CookbookId].Add(item.TransferFee[permutation[i]])
+	}
+
+	minTransferFee := k.MinTransferFee(ctx)
+	maxTransferFee := k.MaxTransferFee(ctx)
+	itemTransferFeePercentage := k.ItemTransferFeePercentage(ctx)
+	chainFees = sdk.NewCoins()
+	royalties = make(map[string]sdk.Coins)
+	for cookbookID, cookbookTransferFees := range transferFees {
+		for _, coin := range cookbookTransferFees {
+			if coin.Amount.LT(minTransferFee) {
+				coin.Amount = minTransferFee
+			} else if coin.Amount.GT(maxTransferFee) {
+				coin.Amount = maxTransferFee
+			}
+			// separate fees to account for percentage to be retained by module account
+			chainAmt := sdk.NewDecFromInt(coin.Amount).Mul(itemTransferFeePercentage).RoundInt()
+			chainFees = chainFees.Add(sdk.NewCoin(coin.Denom, chainAmt))
+			royalties[cookbookID] = royalties[cookbookID].Add(sdk.NewCoin(coin.Denom, coin.Amount.Sub(chainAmt)))
+		}
+	}
+
+	return chainFees, royalties
+}
+
+// payItemTransferFees pays the chain fees and the royalties of the cookbook owners from the buyer account, and the
+// proceeds to the seller
+func (k Keeper) payItemTransferFees(ctx sdk.Context, buyer, seller sdk.AccAddress, chainFees sdk.Coins, royalties map[string]sdk.Coins, proceeds sdk.Coins) error {
+	err := k.PayFees(ctx, buyer, chainFees)
+	if err != nil {
+		return err
+	}
+
+	// cookbook owners are paid in a deterministic order
+	cookbookIDs := make([]string, 0, len(royalties))
+	for cookbookID := range royalties {
+		cookbookIDs = append(cookbookIDs, cookbookID)
+	}
+	sort.Strings(cookbookIDs)
+	for _, cookbookID := range cookbookIDs {
+		cookbook, _ := k.GetCookbook(ctx, cookbookID)
+		addr, _ := sdk.AccAddressFromBech32(cookbook.Creator)
+		err = k.bankKeeper.SendCoins(ctx, buyer, addr, royalties[cookbookID])
+		if err != nil {
+			return err
+		}
+	}
+
+	if buyer.Equals(seller) {
+		return nil
+	}
+	return k.bankKeeper.SendCoins(ctx, buyer, seller, proceeds)
+}
@@ -22,6 +22,10 @@ type (
 		bankKeeper     types.BankKeeper
 		accountKeeper  types.AccountKeeper
 		transferKeeper types.TransferKeeper
+		nftKeeper      types.NFTKeeper
+		channelKeeper  types.ChannelKeeper
+		portKeeper     types.PortKeeper
+		scopedKeeper   types.ScopedKeeper
 		paramSpace     paramtypes.Subspace
 	}
 )
@@ -33,6 +37,10 @@ func NewKeeper(
 	bk types.BankKeeper,
 	ak types.AccountKeeper,
 	tk types.TransferKeeper,
+	nk types.NFTKeeper,
+	channelKeeper types.ChannelKeeper,
+	portKeeper types.PortKeeper,
+	scopedKeeper types.ScopedKeeper,
 	paramSpace paramtypes.Subspace,
 ) Keeper {
 	// ensure pylons module accounts are set
@@ -45,6 +53,24 @@ func NewKeeper(
 	if addr := ak.GetModuleAddress(types.ExecutionsLockerName); addr == nil {
 		panic(fmt.Sprintf("%s module account has not been set", types.ExecutionsLockerName))
 	}
+	if addr := ak.GetModuleAddress(types.LendingsLockerName); addr == nil {
+		panic(fmt.Sprintf("%s module account has not been set", types.LendingsLockerName))
+	}
+	if addr := ak.GetModuleAddress(types.AuctionsLockerName); addr == nil {
+		panic(fmt.Sprintf("%s module account has not been set", types.AuctionsLockerName))
+	}
+	if addr := ak.GetModuleAddress(types.OffersLockerName); addr == nil {
+		panic(fmt.Sprintf("%s module account has not been set", types.OffersLockerName))
+	}
+	if addr := ak.GetModuleAddress(types.SwapsLockerName); addr == nil {
+		panic(fmt.Sprintf("%s module account has not been set", types.SwapsLockerName))
+	}
+	if addr := ak.GetModuleAddress(types.NFTTransferEscrowName); addr == nil {
+		panic(fmt.Sprintf("%s module account has not been set", types.NFTTransferEscrowName))
+	}
+	if addr := ak.GetModuleAddress(types.ContainersLockerName); addr == nil {
+		panic(fmt.Sprintf("%s module account has not been set", types.ContainersLockerName))
+	}
 
 	if addr := ak.GetModuleAddress(types.CoinsIssuerName); addr == nil {
 		panic(fmt.Sprintf("%s module account has not been set", types.CoinsIssuerName))
@@ -62,6 +88,10 @@ func NewKeeper(
 		bankKeeper:     bk,
 		accountKeeper:  ak,
 		transferKeeper: tk,
+		nftKeeper:      nk,
+		channelKeeper:  channelKeeper,
+		portKeeper:     portKeeper,
+		scopedKeeper:   scopedKeeper,
 		paramSpace:     paramSpace,
 	}
 }
@@ -82,6 +112,30 @@ func (k Keeper) ExecutionsLockerAddress() sdk.AccAddress {
 	return k.accountKeeper.GetModuleAddress(types.ExecutionsLockerName)
 }
 
+func (k Keeper) LendingsLockerAddress() sdk.AccAddress {
+	return k.accountKeeper.GetModuleAddress(types.LendingsLockerName)
+}
+
+func (k Keeper) AuctionsLockerAddress() sdk.AccAddress {
+	return k.accountKeeper.GetModuleAddress(types.AuctionsLockerName)
+}
+
+func (k Keeper) OffersLockerAddress() sdk.AccAddress {
+	return k.accountKeeper.GetModuleAddress(types.OffersLockerName)
+}
+
+func (k Keeper) SwapsLockerAddress() sdk.AccAddress {
+	return k.accountKeeper.GetModuleAddress(types.SwapsLockerName)
+}
+
+func (k Keeper) NFTTransferEscrowAddress() sdk.AccAddress {
+	return k.accountKeeper.GetModuleAddress(types.NFTTransferEscrowName)
+}
+
+func (k Keeper) ContainersLockerAddress() sdk.AccAddress {
+	return k.accountKeeper.GetModuleAddress(types.ContainersLockerName)
+}
+
 func (k Keeper) CoinsIssuerAddress() sdk.AccAddress {
 	return k.accountKeeper.GetModuleAddress(types.CoinsIssuerName)
 }
@@ -267,6 +267,31 @@ func createNItemSameOwnerAndCookbook(k keeper.Keeper, ctx sdk.Context, n int, co
 	return items
 }
 
+// createFungibleItem stores a tradeable fungible item holding quantity units
+func createFungibleItem(k keeper.Keeper, ctx sdk.Context, owner, cookbookID string, quantity uint64) types.Item {
+	item := types.Item{
+		Owner:           owner,
+		CookbookId:      cookbookID,
+		TransferFee:     []sdk.Coin{sdk.NewCoin(types.PylonsCoinDenom, sdk.OneInt())},
+		Tradeable:       true,
+		TradePercentage: sdk.ZeroDec(),
+		Fungible:        true,
+		Quantity:        quantity,
+	}
+	item.Id = k.AppendItem(ctx, item)
+	return item
+}
+
+// itemsQuantity returns the number of units held by all the items of a cookbook
+func itemsQuantity(k keeper.Keeper, ctx sdk.Context, cookbookID string) (quantity uint64) {
+	for _, item := range k.GetAllItem(ctx) {
+		if item.CookbookId == cookbookID {
+			quantity += item.Quantity
+		}
+	}
+	return quantity
+}
+
 func createNItemSingleOwner(k keeper.Keeper, ctx sdk.Context, n int, tradeable bool) []types.Item {
 	items := make([]types.Item, n)
 	owner := types.GenTestBech32List(1)
@@ -326,6 +351,82 @@ func createNTradeSameOwner(k keeper.Keeper, ctx sdk.Context, n int) []types.Trad
 	return items
 }
 
+func createNLending(k keeper.Keeper, ctx sdk.Context, n int) []types.Lending {
+	items := make([]types.Lending, n)
+	owners := types.GenTestBech32List(n)
+	for i := range items {
+		items[i].Lender = owners[i]
+		items[i].Item = types.ItemRef{CookbookId: fmt.Sprintf("%d", i), ItemId: fmt.Sprintf("%d", i)}
+		items[i].Duration = 10
+		items[i].Id = k.AppendLending(ctx, items[i])
+	}
+	return items
+}
+
+func createNAuction(k keeper.Keeper, ctx sdk.Context, n int) []types.Auction {
+	items := make([]types.Auction, n)
+	owners := types.GenTestBech32List(n)
+	for i := range items {
+		items[i].Creator = owners[i]
+		items[i].Items = []types.ItemRef{{CookbookId: fmt.Sprintf("%d", i), ItemId: fmt.Sprintf("%d", i)}}
+		items[i].ReservePrice = sdk.NewCoin(types.PylonsCoinDenom, sdk.NewInt(100))
+		items[i].HighestBid = sdk.NewCoin(types.PylonsCoinDenom, sdk.ZeroInt())
+		items[i].EndTime = int64(1000 + i)
+		items[i].Id = k.AppendAuction(ctx, items[i])
+	}
+	return items
+}
+
+func createNDutchAuction(k keeper.Keeper, ctx sdk.Context, n int) []types.DutchAuction {
+	items := make([]types.DutchAuction, n)
+	owners := types.GenTestBech32List(n)
+	for i := range items {
+		items[i].Creator = owners[i]
+		items[i].Items = []types.ItemRef{{CookbookId: fmt.Sprintf("%d", i), ItemId: fmt.Sprintf("%d", i)}}
+		items[i].StartPrice = sdk.NewCoin(types.PylonsCoinDenom, sdk.NewInt(1000))
+		items[i].FloorPrice = sdk.NewCoin(types.PylonsCoinDenom, sdk.NewInt(100))
+		items[i].StartHeight = 10
+		items[i].EndHeight = 20
+		items[i].Decay = types.DutchAuctionDecayLinear
+		items[i].Id = k.AppendDutchAuction(ctx, items[i])
+	}
+	return items
+}
+
+func createNItemOffer(k keeper.Keeper, ctx sdk.Context, n int) []types.ItemOffer {
+	items := make([]types.ItemOffer, n)
+	owners := types.GenTestBech32List(n)
+	for i := range items {
+		items[i].Creator = owners[i]
+		items[i].CookbookId = fmt.Sprintf("%d", i)
+		items[i].Price = sdk.NewCoins(sdk.NewCoin(types.PylonsCoinDenom, sdk.NewInt(100)))
+		items[i].ExpiresAtHeight = int64(10 + i)
+		items[i].Id = k.AppendItemOffer(ctx, items[i])
+	}
+	return items
+}
+
+func createNSwap(k keeper.Keeper, ctx sdk.Context, n int) []types.Swap {
+	items := make([]types.Swap, n)
+	participants := types.GenTestBech32List(n + 1)
+	for i := range items {
+		items[i].Creator = participants[i]
+		items[i].Participants = []types.SwapParticipant{
+			{
+				Address: participants[i],
+				Items:   []types.ItemRef{{CookbookId: fmt.Sprintf("%d", i), ItemId: "item"}},
+			},
+			{
+				Address:      participants[i+1],
+				Coins:        sdk.NewCoins(sdk.NewCoin(types.PylonsCoinDenom, sdk.NewInt(100))),
+				ReceiveItems: []types.ItemRef{{CookbookId: fmt.Sprintf("%d", i), ItemId: "item"}},
+			},
+		}
+		items[i].Id = k.AppendSwap(ctx, items[i])
+	}
+	return items
+}
+
 type IntegrationTestSuite struct {
 	suite.Suite
 
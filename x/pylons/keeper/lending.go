@@ -0,0 +1,177 @@
+package keeper
+
+import (
+	"encoding/binary"
+	"strconv"
+
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/Pylons-tech/pylons/x/pylons/types"
+)
+
+// GetLendingCount get the total number of lendings
+func (k Keeper) GetLendingCount(ctx sdk.Context) uint64 {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.LendingCountKey))
+	byteKey := types.KeyPrefix(types.LendingCountKey)
+	bz := store.Get(byteKey)
+
+	// Count doesn't exist: no element
+	if bz == nil {
+		return 0
+	}
+
+	// Parse bytes
+	count, err := strconv.ParseUint(string(bz), 10, 64)
+	if err != nil {
+		// Panic because the count should be always formattable to uint64
+		panic("cannot decode count")
+	}
+
+	return count
+}
+
+// SetLendingCount set the total number of lendings
+func (k Keeper) SetLendingCount(ctx sdk.Context, count uint64) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.LendingCountKey))
+	byteKey := types.KeyPrefix(types.LendingCountKey)
+	bz := []byte(strconv.FormatUint(count, 10))
+	store.Set(byteKey, bz)
+}
+
+// AppendLending appends a lending in the store with a new id and update the count
+func (k Keeper) AppendLending(ctx sdk.Context, lending types.Lending) uint64 {
+	count := k.GetLendingCount(ctx)
+
+	lending.Id = count
+	k.SetLending(ctx, lending)
+
+	k.SetLendingCount(ctx, count+1)
+
+	return count
+}
+
+// SetLending set a specific lending in the store, indexing it by item and by end height once accepted
+func (k Keeper) SetLending(ctx sdk.Context, lending types.Lending) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.LendingKey))
+	b := k.cdc.MustMarshal(&lending)
+	store.Set(sdk.Uint64ToBigEndian(lending.Id), b)
+
+	itemStore := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.ItemLendingKey))
+	itemStore.Set(getItemLendingKey(lending.Item), sdk.Uint64ToBigEndian(lending.Id))
+
+	if lending.EndHeight != 0 {
+		endStore := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.LendingEndKey))
+		endStore.Set(getLendingEndKey(lending), sdk.Uint64ToBigEndian(lending.Id))
+	}
+}
+
+// GetLending returns a lending from its id
+func (k Keeper) GetLending(ctx sdk.Context, id uint64) (val types.Lending, found bool) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.LendingKey))
+	b := store.Get(sdk.Uint64ToBigEndian(id))
+	if b == nil {
+		return val, false
+	}
+	k.cdc.MustUnmarshal(b, &val)
+	return val, true
+}
+
+// GetLendingByItem returns the lending of an item, found is false if the item is not lent
+func (k Keeper) GetLendingByItem(ctx sdk.Context, cookbookID, itemID string) (val types.Lending, found bool) {
+	itemStore := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.ItemLendingKey))
+	bz := itemStore.Get(getItemLendingKey(types.ItemRef{CookbookId: cookbookID, ItemId: itemID}))
+	if bz == nil {
+		return val, false
+	}
+	return k.GetLending(ctx, binary.BigEndian.Uint64(bz))
+}
+
+// RemoveLending removes a lending from the store along with its indexes
+func (k Keeper) RemoveLending(ctx sdk.Context, lending types.Lending) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.LendingKey))
+	store.Delete(sdk.Uint64ToBigEndian(lending.Id))
+
+	itemStore := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.ItemLendingKey))
+	itemStore.Delete(getItemLendingKey(lending.Item))
+
+	if lending.EndHeight != 0 {
+		endStore := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.LendingEndKey))
+		endStore.Delete(getLendingEndKey(lending))
+	}
+}
+
+// GetAllLending returns all lendings
+func (k Keeper) GetAllLending(ctx sdk.Context) (list []types.Lending) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.LendingKey))
+	iterator := sdk.KVStorePrefixIterator(store, []byte{})
+
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var val types.Lending
+		k.cdc.MustUnmarshal(iterator.Value(), &val)
+		list = append(list, val)
+	}
+
+	return
+}
+
+// GetLendingsEndedAtBlockHeight returns at most limit accepted lendings whose end height is lower or equal to
+// blockHeight
+func (k Keeper) GetLendingsEndedAtBlockHeight(ctx sdk.Context, blockHeight int64, limit int) (list []types.Lending) {
+	endStore := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.LendingEndKey))
+	iterator := endStore.Iterator(nil, sdk.Uint64ToBigEndian(uint64(blockHeight)+1))
+
+	defer iterator.Close()
+
+	for ; iterator.Valid() && len(list) < limit; iterator.Next() {
+		lending, found := k.GetLending(ctx, binary.BigEndian.Uint64(iterator.Value()))
+		if found {
+			list = append(list, lending)
+		}
+	}
+
+	return
+}
+
+// EndLending returns a lent item to its lender and removes the lending
+func (k Keeper) EndLending(ctx sdk.Context, lending types.Lending) {
+	item, found := k.GetItem(ctx, lending.Item.CookbookId, lending.Item.ItemId)
+	if found {
+		k.UnlockItemForLending(ctx, item, lending.Lender)
+		item.Owner = lending.Lender
+		k.MergeItem(ctx, item)
+	}
+	k.RemoveLending(ctx, lending)
+}
+
+// EndLendings returns the items of at most limit ended lendings to their lenders, returning the number of ended
+// lendings. The other ended lendings are left to the next blocks
+func (k Keeper) EndLendings(ctx sdk.Context, limit int) int {
+	lendings := k.GetLendingsEndedAtBlockHeight(ctx, ctx.BlockHeight(), limit)
+	for _, lending := range lendings {
+		k.EndLending(ctx, lending)
+		_ = ctx.EventManager().EmitTypedEvent(&types.EventEndLending{
+			Lender:   lending.Lender,
+			Borrower: lending.Borrower,
+			Id:       lending.Id,
+		})
+	}
+	return len(lendings)
+}
+
+// IsItemBorrowedBy checks if an item is currently lent to addr. A lending stops granting the item from its end height,
+// even if the lending has not been ended yet by EndLendings
+func (k Keeper) IsItemBorrowedBy(ctx sdk.Context, item types.Item, addr string) bool {
+	lending, found := k.GetLendingByItem(ctx, item.CookbookId, item.Id)
+	return found && lending.EndHeight != 0 && ctx.BlockHeight() < lending.EndHeight && lending.Borrower == addr
+}
+
+func getItemLendingKey(itemRef types.ItemRef) []byte {
+	return []byte(itemRef.CookbookId + "-" + itemRef.ItemId)
+}
+
+func getLendingEndKey(lending types.Lending) []byte {
+	return append(sdk.Uint64ToBigEndian(uint64(lending.EndHeight)), sdk.Uint64ToBigEndian(lending.Id)...)
+}
@@ -0,0 +1,80 @@
+package keeper_test
+
+func (suite *IntegrationTestSuite) TestLendingGet() {
+	k := suite.k
+	ctx := suite.ctx
+	require := suite.Require()
+	items := createNLending(k, ctx, 10)
+	for _, item := range items {
+		lending, found := k.GetLending(ctx, item.Id)
+		require.True(found)
+		require.Equal(item, lending)
+
+		lending, found = k.GetLendingByItem(ctx, item.Item.CookbookId, item.Item.ItemId)
+		require.True(found)
+		require.Equal(item, lending)
+	}
+}
+
+func (suite *IntegrationTestSuite) TestLendingRemove() {
+	k := suite.k
+	ctx := suite.ctx
+	require := suite.Require()
+	items := createNLending(k, ctx, 10)
+	for _, item := range items {
+		k.RemoveLending(ctx, item)
+		_, found := k.GetLending(ctx, item.Id)
+		require.False(found)
+		_, found = k.GetLendingByItem(ctx, item.Item.CookbookId, item.Item.ItemId)
+		require.False(found)
+	}
+}
+
+func (suite *IntegrationTestSuite) TestLendingGetAll() {
+	k := suite.k
+	ctx := suite.ctx
+	require := suite.Require()
+	items := createNLending(k, ctx, 10)
+	require.Equal(items, k.GetAllLending(ctx))
+	require.Equal(uint64(len(items)), k.GetLendingCount(ctx))
+}
+
+func (suite *IntegrationTestSuite) TestLendingsEndedAtBlockHeight() {
+	k := suite.k
+	ctx := suite.ctx
+	require := suite.Require()
+	items := createNLending(k, ctx, 3)
+
+	// only accepted lendings are indexed by end height
+	items[0].EndHeight = 5
+	k.SetLending(ctx, items[0])
+	items[1].EndHeight = 10
+	k.SetLending(ctx, items[1])
+
+	require.Empty(k.GetLendingsEndedAtBlockHeight(ctx, 4, 10))
+	require.Equal(items[:1], k.GetLendingsEndedAtBlockHeight(ctx, 5, 10))
+	require.Equal(items[:2], k.GetLendingsEndedAtBlockHeight(ctx, 20, 10))
+	require.Equal(items[:1], k.GetLendingsEndedAtBlockHeight(ctx, 20, 1))
+
+	k.RemoveLending(ctx, items[0])
+	require.Equal(items[1:2], k.GetLendingsEndedAtBlockHeight(ctx, 20, 10))
+}
+
+func (suite *IntegrationTestSuite) TestEndLendings() {
+	k := suite.k
+	ctx := suite.ctx.WithBlockHeight(10)
+	require := suite.Require()
+	items := createNLending(k, ctx, 3)
+	for i := range items {
+		items[i].EndHeight = 10
+		k.SetLending(ctx, items[i])
+	}
+
+	// the sweep is bounded by the given limit, and the lending of a missing item is removed
+	require.Equal(2, k.EndLendings(ctx, 2))
+	require.Equal(1, k.EndLendings(ctx, 2))
+	require.Equal(0, k.EndLendings(ctx, 2))
+	require.Empty(k.GetAllLending(ctx))
+	_, found := k.GetItem(ctx, items[0].Item.CookbookId, items[0].Item.ItemId)
+	require.False(found)
+}
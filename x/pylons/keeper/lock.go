@@ -47,6 +47,30 @@ func (k Keeper) UnLockCoinsForTrade(ctx sdk.Context, revcAddr sdk.AccAddress, am
 	return k.unlockCoins(ctx, revcAddr, amt, types.TradesLockerName)
 }
 
+func (k Keeper) LockCoinsForAuction(ctx sdk.Context, senderAddr sdk.AccAddress, amt sdk.Coins) error {
+	return k.lockCoins(ctx, senderAddr, amt, types.AuctionsLockerName)
+}
+
+func (k Keeper) UnLockCoinsForAuction(ctx sdk.Context, revcAddr sdk.AccAddress, amt sdk.Coins) error {
+	return k.unlockCoins(ctx, revcAddr, amt, types.AuctionsLockerName)
+}
+
+func (k Keeper) LockCoinsForOffer(ctx sdk.Context, senderAddr sdk.AccAddress, amt sdk.Coins) error {
+	return k.lockCoins(ctx, senderAddr, amt, types.OffersLockerName)
+}
+
+func (k Keeper) UnLockCoinsForOffer(ctx sdk.Context, revcAddr sdk.AccAddress, amt sdk.Coins) error {
+	return k.unlockCoins(ctx, revcAddr, amt, types.OffersLockerName)
+}
+
+func (k Keeper) LockCoinsForSwap(ctx sdk.Context, senderAddr sdk.AccAddress, amt sdk.Coins) error {
+	return k.lockCoins(ctx, senderAddr, amt, types.SwapsLockerName)
+}
+
+func (k Keeper) UnLockCoinsForSwap(ctx sdk.Context, revcAddr sdk.AccAddress, amt sdk.Coins) error {
+	return k.unlockCoins(ctx, revcAddr, amt, types.SwapsLockerName)
+}
+
 // LockItem sends an account's items to the provided module account
 // Changing ownership of the item in the store will unlock the item from the module account
 func (k Keeper) lockItem(ctx sdk.Context, item types.Item, modAccName string) {
@@ -55,12 +79,20 @@ func (k Keeper) lockItem(ctx sdk.Context, item types.Item, modAccName string) {
 	prevAddr, _ := sdk.AccAddressFromBech32(item.Owner)
 	item.Owner = modAcc.String()
 	k.UpdateItem(ctx, item, prevAddr)
+
+	provenance := item.NewItemProvenance(ctx, types.ItemProvenanceLock, prevAddr.String(), item.Owner)
+	provenance.Locker = modAccName
+	k.AppendItemProvenance(ctx, provenance)
 }
 
 func (k Keeper) unlockItem(ctx sdk.Context, item types.Item, modAccName, addr string) {
 	modAcc := k.accountKeeper.GetModuleAddress(modAccName)
 	item.Owner = addr
 	k.UpdateItem(ctx, item, modAcc)
+
+	provenance := item.NewItemProvenance(ctx, types.ItemProvenanceUnlock, modAcc.String(), addr)
+	provenance.Locker = modAccName
+	k.AppendItemProvenance(ctx, provenance)
 }
 
 func (k Keeper) LockItemForExecution(ctx sdk.Context, item types.Item) {
@@ -78,3 +110,43 @@ func (k Keeper) LockItemForTrade(ctx sdk.Context, item types.Item) {
 func (k Keeper) UnlockItemForTrade(ctx sdk.Context, item types.Item, addr string) {
 	k.unlockItem(ctx, item, types.TradesLockerName, addr)
 }
+
+func (k Keeper) LockItemForLending(ctx sdk.Context, item types.Item) {
+	k.lockItem(ctx, item, types.LendingsLockerName)
+}
+
+func (k Keeper) UnlockItemForLending(ctx sdk.Context, item types.Item, addr string) {
+	k.unlockItem(ctx, item, types.LendingsLockerName, addr)
+}
+
+func (k Keeper) LockItemForAuction(ctx sdk.Context, item types.Item) {
+	k.lockItem(ctx, item, types.AuctionsLockerName)
+}
+
+func (k Keeper) UnlockItemForAuction(ctx sdk.Context, item types.Item, addr string) {
+	k.unlockItem(ctx, item, types.AuctionsLockerName, addr)
+}
+
+func (k Keeper) LockItemForSwap(ctx sdk.Context, item types.Item) {
+	k.lockItem(ctx, item, types.SwapsLockerName)
+}
+
+func (k Keeper) UnlockItemForSwap(ctx sdk.Context, item types.Item, addr string) {
+	k.unlockItem(ctx, item, types.SwapsLockerName, addr)
+}
+
+func (k Keeper) LockItemForContainer(ctx sdk.Context, item types.Item) {
+	k.lockItem(ctx, item, types.ContainersLockerName)
+}
+
+func (k Keeper) UnlockItemForContainer(ctx sdk.Context, item types.Item, addr string) {
+	k.unlockItem(ctx, item, types.ContainersLockerName, addr)
+}
+
+func (k Keeper) EscrowItemForNFTTransfer(ctx sdk.Context, item types.Item) {
+	k.lockItem(ctx, item, types.NFTTransferEscrowName)
+}
+
+func (k Keeper) UnescrowItemForNFTTransfer(ctx sdk.Context, item types.Item, addr string) {
+	k.unlockItem(ctx, item, types.NFTTransferEscrowName, addr)
+}
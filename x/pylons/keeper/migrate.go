@@ -2,6 +2,11 @@ package keeper
 
 import (
 	v046 "github.com/Pylons-tech/pylons/x/pylons/migrations/v046"
+	v4 "github.com/Pylons-tech/pylons/x/pylons/migrations/v4"
+	v5 "github.com/Pylons-tech/pylons/x/pylons/migrations/v5"
+	v6 "github.com/Pylons-tech/pylons/x/pylons/migrations/v6"
+	v7 "github.com/Pylons-tech/pylons/x/pylons/migrations/v7"
+	"github.com/Pylons-tech/pylons/x/pylons/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 )
 
@@ -19,3 +24,23 @@ func NewMigrator(keeper Keeper) Migrator {
 func (m Migrator) Migrate2to3(ctx sdk.Context) error {
 	return v046.MigrateStore(ctx, m.keeper.paramSpace)
 }
+
+// Migrate3to4 migrates from version 3 to 4.
+func (m Migrator) Migrate3to4(ctx sdk.Context) error {
+	return v4.MigrateStore(ctx, m.keeper, types.NFTTransferPortID)
+}
+
+// Migrate4to5 migrates from version 4 to 5.
+func (m Migrator) Migrate4to5(ctx sdk.Context) error {
+	return v5.MigrateStore(ctx, m.keeper)
+}
+
+// Migrate5to6 migrates from version 5 to 6.
+func (m Migrator) Migrate5to6(ctx sdk.Context) error {
+	return v6.MigrateStore(ctx, m.keeper.storeKey, m.keeper.cdc)
+}
+
+// Migrate6to7 migrates from version 6 to 7.
+func (m Migrator) Migrate6to7(ctx sdk.Context) error {
+	return v7.MigrateStore(ctx, m.keeper.storeKey, m.keeper.cdc)
+}
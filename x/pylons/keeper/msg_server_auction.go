@@ -0,0 +1,119 @@
+package keeper
+
+import (
+	"context"
+
+	"github.com/cosmos/cosmos-sdk/telemetry"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	"github.com/Pylons-tech/pylons/x/pylons/types"
+)
+
+func (k msgServer) CreateAuction(goCtx context.Context, msg *types.MsgCreateAuction) (*types.MsgCreateAuctionResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	if msg.EndTime <= ctx.BlockTime().Unix() {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "auction end time already reached")
+	}
+
+	// any bid is at least the reserve price, so it covers the items transfer fees as well
+	itemRefs, err := k.lockAuctionedItems(ctx, msg.Creator, msg.Items, msg.ReservePrice)
+	if err != nil {
+		return nil, err
+	}
+
+	id := k.AppendAuction(ctx, types.Auction{
+		Creator:      msg.Creator,
+		Items:        itemRefs,
+		ReservePrice: msg.ReservePrice,
+		EndTime:      msg.EndTime,
+		HighestBid:   sdk.NewCoin(msg.ReservePrice.Denom, sdk.ZeroInt()),
+	})
+
+	err = ctx.EventManager().EmitTypedEvent(&types.EventCreateAuction{
+		Creator: msg.Creator,
+		Id:      id,
+	})
+
+	telemetry.IncrCounter(1, "auction", "create")
+
+	return &types.MsgCreateAuctionResponse{Id: id}, err
+}
+
+func (k msgServer) PlaceBid(goCtx context.Context, msg *types.MsgPlaceBid) (*types.MsgPlaceBidResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	auction, found := k.GetAuction(ctx, msg.Id)
+	if !found {
+		return nil, sdkerrors.Wrapf(sdkerrors.ErrKeyNotFound, "auction %d doesn't exist", msg.Id)
+	}
+	if auction.IsEnded(ctx) {
+		return nil, sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "auction %d ended", msg.Id)
+	}
+	if auction.Creator == msg.Creator {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "the seller cannot bid on its auction")
+	}
+	if msg.Amount.Denom != auction.ReservePrice.Denom {
+		return nil, sdkerrors.Wrapf(sdkerrors.ErrInvalidCoins, "bids must be placed in %s", auction.ReservePrice.Denom)
+	}
+	if minBid := auction.MinBid(); msg.Amount.IsLT(minBid) {
+		return nil, sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "bid must be at least %s", minBid)
+	}
+
+	// escrow the bid before refunding the previous highest bidder
+	bidderAddr, _ := sdk.AccAddressFromBech32(msg.Creator)
+	err := k.LockCoinsForAuction(ctx, bidderAddr, sdk.NewCoins(msg.Amount))
+	if err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, err.Error())
+	}
+	if auction.HasBid() {
+		prevAddr, _ := sdk.AccAddressFromBech32(auction.HighestBidder)
+		err = k.UnLockCoinsForAuction(ctx, prevAddr, sdk.NewCoins(auction.HighestBid))
+		if err != nil {
+			// this should never happen, it means the module account has been drained of funds illegitimately
+			panic(err)
+		}
+	}
+
+	auction.HighestBidder = msg.Creator
+	auction.HighestBid = msg.Amount
+	k.SetAuction(ctx, auction)
+
+	err = ctx.EventManager().EmitTypedEvent(&types.EventPlaceBid{
+		Bidder: msg.Creator,
+		Id:     msg.Id,
+		Amount: msg.Amount,
+	})
+
+	telemetry.IncrCounter(1, "auction", "bid")
+
+	return &types.MsgPlaceBidResponse{}, err
+}
+
+func (k msgServer) CancelAuction(goCtx context.Context, msg *types.MsgCancelAuction) (*types.MsgCancelAuctionResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	auction, found := k.GetAuction(ctx, msg.Id)
+	if !found {
+		return nil, sdkerrors.Wrapf(sdkerrors.ErrKeyNotFound, "auction %d doesn't exist", msg.Id)
+	}
+	if auction.Creator != msg.Creator {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrUnauthorized, "incorrect owner")
+	}
+	if auction.HasBid() {
+		return nil, sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "auction %d has bids and cannot be cancelled", msg.Id)
+	}
+
+	k.unlockAuctionedItems(ctx, msg.Creator, auction.Items)
+	k.RemoveAuction(ctx, auction)
+
+	err := ctx.EventManager().EmitTypedEvent(&types.EventCancelAuction{
+		Creator: msg.Creator,
+		Id:      msg.Id,
+	})
+
+	telemetry.IncrCounter(1, "auction", "cancel")
+
+	return &types.MsgCancelAuctionResponse{}, err
+}
@@ -0,0 +1,168 @@
+package keeper_test
+
+import (
+	"fmt"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	"github.com/Pylons-tech/pylons/x/pylons/keeper"
+	"github.com/Pylons-tech/pylons/x/pylons/types"
+)
+
+func (suite *IntegrationTestSuite) TestMsgServerAuction() {
+	k := suite.k
+	bk := suite.bankKeeper
+	ctx := suite.ctx.WithBlockTime(time.Unix(1000, 0))
+	require := suite.Require()
+
+	wctx := sdk.WrapSDKContext(ctx)
+	srv := keeper.NewMsgServerImpl(k)
+
+	cookbook := createNCookbook(k, ctx, 1)[0]
+	cookbookAddr, _ := sdk.AccAddressFromBech32(cookbook.Creator)
+	items := createNItemSameOwnerAndCookbook(k, ctx, 2, cookbook.Id, true)
+	for i := range items {
+		items[i].TradePercentage = sdk.NewDecWithPrec(1, 1)
+		k.SetItem(ctx, items[i])
+	}
+	seller := items[0].Owner
+	sellerAddr, _ := sdk.AccAddressFromBech32(seller)
+	bidders := []string{types.GenTestBech32FromString("bidder0"), types.GenTestBech32FromString("bidder1")}
+	bidderAddrs := make([]sdk.AccAddress, len(bidders))
+	k.SetPylonsAccount(ctx, types.AccountAddr{Value: seller}, types.Username{Value: "seller"})
+	for i, bidder := range bidders {
+		k.SetPylonsAccount(ctx, types.AccountAddr{Value: bidder}, types.Username{Value: fmt.Sprintf("bidder%d", i)})
+		bidderAddrs[i], _ = sdk.AccAddressFromBech32(bidder)
+		err := k.MintCoinsToAddr(ctx, bidderAddrs[i], sdk.NewCoins(sdk.NewCoin(types.PylonsCoinDenom, sdk.NewInt(500))))
+		require.NoError(err)
+	}
+	lockerAddr := k.AuctionsLockerAddress().String()
+	ref := types.ItemRef{CookbookId: cookbook.Id, ItemId: items[0].Id}
+	reserve := sdk.NewCoin(types.PylonsCoinDenom, sdk.NewInt(100))
+
+	_, err := srv.CreateAuction(wctx, types.NewMsgCreateAuction(bidders[0], []types.ItemRef{ref}, reserve, 2000))
+	require.ErrorIs(err, sdkerrors.ErrInvalidRequest)
+	_, err = srv.CreateAuction(wctx, types.NewMsgCreateAuction(seller, []types.ItemRef{ref}, reserve, 1000))
+	require.ErrorIs(err, sdkerrors.ErrInvalidRequest)
+	_, err = srv.CreateAuction(wctx, types.NewMsgCreateAuction(seller, []types.ItemRef{ref}, sdk.NewCoin(types.PylonsCoinDenom, sdk.NewInt(50)), 2000))
+	require.ErrorIs(err, sdkerrors.ErrInvalidCoins)
+
+	res, err := srv.CreateAuction(wctx, types.NewMsgCreateAuction(seller, []types.ItemRef{ref}, reserve, 2000))
+	require.NoError(err)
+	item, _ := k.GetItem(ctx, cookbook.Id, items[0].Id)
+	require.Equal(lockerAddr, item.Owner)
+
+	// bids must be in the reserve denom and higher than the reserve and the highest bid
+	_, err = srv.PlaceBid(wctx, types.NewMsgPlaceBid(seller, res.Id, reserve))
+	require.ErrorIs(err, sdkerrors.ErrInvalidRequest)
+	_, err = srv.PlaceBid(wctx, types.NewMsgPlaceBid(bidders[0], res.Id, sdk.NewCoin("other", sdk.NewInt(100))))
+	require.ErrorIs(err, sdkerrors.ErrInvalidCoins)
+	_, err = srv.PlaceBid(wctx, types.NewMsgPlaceBid(bidders[0], res.Id, sdk.NewCoin(types.PylonsCoinDenom, sdk.NewInt(99))))
+	require.ErrorIs(err, sdkerrors.ErrInvalidRequest)
+	_, err = srv.PlaceBid(wctx, types.NewMsgPlaceBid(bidders[0], res.Id+1, reserve))
+	require.ErrorIs(err, sdkerrors.ErrKeyNotFound)
+
+	_, err = srv.PlaceBid(wctx, types.NewMsgPlaceBid(bidders[0], res.Id, reserve))
+	require.NoError(err)
+	require.True(bk.GetBalance(ctx, bidderAddrs[0], types.PylonsCoinDenom).Amount.Equal(sdk.NewInt(400)))
+	_, err = srv.PlaceBid(wctx, types.NewMsgPlaceBid(bidders[1], res.Id, reserve))
+	require.ErrorIs(err, sdkerrors.ErrInvalidRequest)
+
+	// outbidding refunds the previous highest bidder
+	bid := sdk.NewCoin(types.PylonsCoinDenom, sdk.NewInt(150))
+	_, err = srv.PlaceBid(wctx, types.NewMsgPlaceBid(bidders[1], res.Id, bid))
+	require.NoError(err)
+	require.True(bk.GetBalance(ctx, bidderAddrs[0], types.PylonsCoinDenom).Amount.Equal(sdk.NewInt(500)))
+	require.True(bk.GetBalance(ctx, bidderAddrs[1], types.PylonsCoinDenom).Amount.Equal(sdk.NewInt(350)))
+	auction, _ := k.GetAuction(ctx, res.Id)
+	require.Equal(bidders[1], auction.HighestBidder)
+	require.Equal(bid, auction.HighestBid)
+
+	_, err = srv.CancelAuction(wctx, types.NewMsgCancelAuction(seller, res.Id))
+	require.ErrorIs(err, sdkerrors.ErrInvalidRequest)
+
+	// no bid can be placed once the auction ended
+	ctx = ctx.WithBlockTime(time.Unix(2000, 0))
+	_, err = srv.PlaceBid(sdk.WrapSDKContext(ctx), types.NewMsgPlaceBid(bidders[0], res.Id, sdk.NewCoin(types.PylonsCoinDenom, sdk.NewInt(200))))
+	require.ErrorIs(err, sdkerrors.ErrInvalidRequest)
+
+	// the winner pays the royalty of the cookbook and the chain fees out of the bid
+	require.Equal(1, k.SettleEndedAuctions(ctx, types.MaxSettledAuctionsPerBlock))
+	item, _ = k.GetItem(ctx, cookbook.Id, items[0].Id)
+	require.Equal(bidders[1], item.Owner)
+	royalty := sdk.NewInt(15)
+	chainFee := sdk.NewDecFromInt(royalty).Mul(k.ItemTransferFeePercentage(ctx)).RoundInt()
+	require.True(bk.GetBalance(ctx, sellerAddr, types.PylonsCoinDenom).Amount.Equal(bid.Amount.Sub(royalty)))
+	require.True(bk.GetBalance(ctx, cookbookAddr, types.PylonsCoinDenom).Amount.Equal(royalty.Sub(chainFee)))
+	require.True(bk.GetBalance(ctx, bidderAddrs[1], types.PylonsCoinDenom).Amount.Equal(sdk.NewInt(350)))
+	_, found := k.GetAuction(ctx, res.Id)
+	require.False(found)
+
+	// an auction whose item expired refunds the highest bid and gives the item back to the seller
+	ref = types.ItemRef{CookbookId: cookbook.Id, ItemId: items[1].Id}
+	res, err = srv.CreateAuction(sdk.WrapSDKContext(ctx), types.NewMsgCreateAuction(seller, []types.ItemRef{ref}, reserve, 3000))
+	require.NoError(err)
+	_, err = srv.PlaceBid(sdk.WrapSDKContext(ctx), types.NewMsgPlaceBid(bidders[0], res.Id, reserve))
+	require.NoError(err)
+	item, _ = k.GetItem(ctx, cookbook.Id, items[1].Id)
+	item.ExpiresAt = 2500
+	k.SetItem(ctx, item)
+	ctx = ctx.WithBlockTime(time.Unix(3000, 0))
+	require.Equal(1, k.SettleEndedAuctions(ctx, types.MaxSettledAuctionsPerBlock))
+	item, _ = k.GetItem(ctx, cookbook.Id, items[1].Id)
+	require.Equal(seller, item.Owner)
+	require.True(bk.GetBalance(ctx, bidderAddrs[0], types.PylonsCoinDenom).Amount.Equal(sdk.NewInt(500)))
+	_, found = k.GetAuction(ctx, res.Id)
+	require.False(found)
+}
+
+func (suite *IntegrationTestSuite) TestMsgServerAuctionDuplicateItems() {
+	k := suite.k
+	ctx := suite.ctx.WithBlockTime(time.Unix(1000, 0))
+	require := suite.Require()
+
+	wctx := sdk.WrapSDKContext(ctx)
+	srv := keeper.NewMsgServerImpl(k)
+
+	seller := types.GenTestBech32FromString("seller")
+	item := createFungibleItem(k, ctx, seller, "testCookbook", 6)
+	ref := types.ItemRef{CookbookId: item.CookbookId, ItemId: item.Id, Amount: 4}
+	msg := types.NewMsgCreateAuction(seller, []types.ItemRef{ref, ref}, sdk.NewCoin(types.PylonsCoinDenom, sdk.NewInt(100)), 2000)
+	require.ErrorIs(msg.ValidateBasic(), sdkerrors.ErrInvalidRequest)
+
+	// units are split out of the stored item, so listing it twice cannot auction more units than it holds
+	_, err := srv.CreateAuction(wctx, msg)
+	require.ErrorIs(err, types.ErrItemQuantity)
+	require.Equal(uint64(6), itemsQuantity(k, ctx, item.CookbookId))
+}
+
+func (suite *IntegrationTestSuite) TestMsgServerCancelAuction() {
+	k := suite.k
+	ctx := suite.ctx.WithBlockTime(time.Unix(1000, 0))
+	require := suite.Require()
+
+	wctx := sdk.WrapSDKContext(ctx)
+	srv := keeper.NewMsgServerImpl(k)
+
+	cookbook := createNCookbook(k, ctx, 1)[0]
+	items := createNItemSameOwnerAndCookbook(k, ctx, 1, cookbook.Id, true)
+	seller := items[0].Owner
+	ref := types.ItemRef{CookbookId: cookbook.Id, ItemId: items[0].Id}
+
+	res, err := srv.CreateAuction(wctx, types.NewMsgCreateAuction(seller, []types.ItemRef{ref}, sdk.NewCoin(types.PylonsCoinDenom, sdk.NewInt(100)), 2000))
+	require.NoError(err)
+
+	_, err = srv.CancelAuction(wctx, types.NewMsgCancelAuction(types.GenTestBech32FromString("other"), res.Id))
+	require.ErrorIs(err, sdkerrors.ErrUnauthorized)
+	_, err = srv.CancelAuction(wctx, types.NewMsgCancelAuction(seller, res.Id+1))
+	require.ErrorIs(err, sdkerrors.ErrKeyNotFound)
+	_, err = srv.CancelAuction(wctx, types.NewMsgCancelAuction(seller, res.Id))
+	require.NoError(err)
+
+	item, _ := k.GetItem(ctx, cookbook.Id, items[0].Id)
+	require.Equal(seller, item.Owner)
+	_, found := k.GetAuction(ctx, res.Id)
+	require.False(found)
+}
@@ -0,0 +1,97 @@
+package keeper
+
+import (
+	"context"
+
+	"github.com/cosmos/cosmos-sdk/telemetry"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	"github.com/Pylons-tech/pylons/x/pylons/types"
+)
+
+func (k msgServer) BurnItems(goCtx context.Context, msg *types.MsgBurnItems) (*types.MsgBurnItemsResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	addr, _ := sdk.AccAddressFromBech32(msg.Creator)
+	from, _ := k.GetUsernameByAddress(ctx, msg.Creator)
+
+	refunds := make(map[string]sdk.Coins)
+	cookbookIDs := make([]string, 0)
+	for _, itemRef := range msg.Items {
+		item, found := k.GetItem(ctx, itemRef.CookbookId, itemRef.ItemId)
+		if !found {
+			return nil, sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "item in cookbook %v with ID %v does not exist", itemRef.CookbookId, itemRef.ItemId)
+		}
+		if item.Owner != msg.Creator {
+			return nil, sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "item in cookbook %v with ID %v not owned by sender", itemRef.CookbookId, itemRef.ItemId)
+		}
+		if k.HasContainerItems(ctx, item.CookbookId, item.Id) {
+			return nil, sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "item in cookbook %v with ID %v holds items", itemRef.CookbookId, itemRef.ItemId)
+		}
+
+		// a fungible item can be partially burned, the remaining units are kept by the owner
+		units := uint64(1)
+		if item.Fungible {
+			units = item.Quantity
+		}
+		if itemRef.Amount != 0 {
+			var err error
+			item, err = k.SplitItem(ctx, item, itemRef.Amount)
+			if err != nil {
+				return nil, err
+			}
+			units = itemRef.Amount
+		}
+
+		k.RemoveItem(ctx, item.CookbookId, item.Id)
+		history := item.NewItemHistory(ctx, types.BurnedItemHistoryReceiver, from.Value)
+		k.SetItemHistory(ctx, history)
+		k.AppendItemProvenance(ctx, item.NewItemProvenance(ctx, types.ItemProvenanceBurn, msg.Creator, ""))
+
+		cookbook, _ := k.GetCookbook(ctx, item.CookbookId)
+		if cookbook.BurnRefund.Empty() {
+			continue
+		}
+		if _, ok := refunds[cookbook.Id]; !ok {
+			cookbookIDs = append(cookbookIDs, cookbook.Id)
+		}
+		for _, coin := range cookbook.BurnRefund {
+			refunds[cookbook.Id] = refunds[cookbook.Id].Add(sdk.NewCoin(coin.Denom, coin.Amount.MulRaw(int64(units))))
+		}
+	}
+
+	// refunds are paid by the cookbook creators, the items are burned anyway when a creator cannot pay
+	totalRefund := sdk.NewCoins()
+	for _, cookbookID := range cookbookIDs {
+		cookbook, _ := k.GetCookbook(ctx, cookbookID)
+		cookbookOwnerAddr, _ := sdk.AccAddressFromBech32(cookbook.Creator)
+		cacheCtx, write := ctx.CacheContext()
+		err := k.bankKeeper.SendCoins(cacheCtx, cookbookOwnerAddr, addr, refunds[cookbookID])
+		if err != nil {
+			k.Logger(ctx).Info("cannot pay burn refund", "cookbook", cookbookID, "error", err)
+			err = ctx.EventManager().EmitTypedEvent(&types.EventBurnRefundUnpaid{
+				Burner:     msg.Creator,
+				CookbookId: cookbookID,
+				Refund:     refunds[cookbookID],
+			})
+			if err != nil {
+				return nil, err
+			}
+			continue
+		}
+		write()
+		ctx.EventManager().EmitEvents(cacheCtx.EventManager().Events())
+		totalRefund = totalRefund.Add(refunds[cookbookID]...)
+	}
+
+	err := ctx.EventManager().EmitTypedEvent(&types.EventBurnItems{
+		Burner: msg.Creator,
+		Items:  msg.Items,
+		Refund: totalRefund,
+	})
+
+	telemetry.IncrCounter(float32(len(msg.Items)), "item", "burn")
+
+	return &types.MsgBurnItemsResponse{}, err
+}
@@ -0,0 +1,137 @@
+package keeper_test
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	"github.com/Pylons-tech/pylons/x/pylons/keeper"
+	"github.com/Pylons-tech/pylons/x/pylons/types"
+)
+
+func (suite *IntegrationTestSuite) TestMsgServerBurnItems() {
+	k := suite.k
+	bk := suite.bankKeeper
+	ctx := suite.ctx
+	require := suite.Require()
+
+	wctx := sdk.WrapSDKContext(ctx)
+	srv := keeper.NewMsgServerImpl(k)
+
+	// the cookbook refunds 10upylon for each burned item unit
+	cookbook := createNCookbook(k, ctx, 1)[0]
+	cookbook.BurnRefund = sdk.NewCoins(sdk.NewCoin(types.PylonsCoinDenom, sdk.NewInt(10)))
+	k.SetCookbook(ctx, cookbook)
+	cookbookOwnerAddr, _ := sdk.AccAddressFromBech32(cookbook.Creator)
+	err := k.MintCoinsToAddr(ctx, cookbookOwnerAddr, sdk.NewCoins(sdk.NewCoin(types.PylonsCoinDenom, sdk.NewInt(1000))))
+	require.NoError(err)
+
+	items := createNItemSameOwnerAndCookbook(k, ctx, 2, cookbook.Id, true)
+	owner := items[0].Owner
+	ownerAddr, _ := sdk.AccAddressFromBech32(owner)
+	fungible := types.Item{
+		Owner:           owner,
+		CookbookId:      cookbook.Id,
+		Tradeable:       true,
+		TradePercentage: sdk.ZeroDec(),
+		Fungible:        true,
+		Quantity:        10,
+	}
+	fungible.Id = k.AppendItem(ctx, fungible)
+
+	for _, tc := range []struct {
+		desc           string
+		request        *types.MsgBurnItems
+		remainingUnits uint64
+		refund         sdk.Int
+		err            error
+	}{
+		{
+			desc: "Unauthorized",
+			request: &types.MsgBurnItems{
+				Creator: types.GenTestBech32FromString("wrong_owner"),
+				Items:   []types.ItemRef{{CookbookId: cookbook.Id, ItemId: items[0].Id}},
+			},
+			err: sdkerrors.ErrInvalidRequest,
+		},
+		{
+			desc: "NotFound",
+			request: &types.MsgBurnItems{
+				Creator: owner,
+				Items:   []types.ItemRef{{CookbookId: cookbook.Id, ItemId: "not_found"}},
+			},
+			err: sdkerrors.ErrInvalidRequest,
+		},
+		{
+			desc: "Valid",
+			request: &types.MsgBurnItems{
+				Creator: owner,
+				Items:   []types.ItemRef{{CookbookId: cookbook.Id, ItemId: items[0].Id}, {CookbookId: cookbook.Id, ItemId: items[1].Id}},
+			},
+			remainingUnits: 10,
+			refund:         sdk.NewInt(20),
+		},
+		{
+			desc: "PartialFungible",
+			request: &types.MsgBurnItems{
+				Creator: owner,
+				Items:   []types.ItemRef{{CookbookId: cookbook.Id, ItemId: fungible.Id, Amount: 4}},
+			},
+			remainingUnits: 6,
+			refund:         sdk.NewInt(60),
+		},
+	} {
+		tc := tc
+		suite.Run(tc.desc, func() {
+			_, err := srv.BurnItems(wctx, tc.request)
+			if tc.err != nil {
+				require.ErrorIs(err, tc.err)
+				return
+			}
+			require.NoError(err)
+
+			if tc.request.Items[0].Amount == 0 {
+				for _, itemRef := range tc.request.Items {
+					require.False(k.HasItem(ctx, itemRef.CookbookId, itemRef.ItemId))
+				}
+			}
+			ownerItems := k.GetAllItemByOwner(ctx, ownerAddr)
+			require.Len(ownerItems, 1)
+			require.Equal(tc.remainingUnits, ownerItems[0].Quantity)
+			require.Equal(tc.refund, bk.SpendableCoins(ctx, ownerAddr).AmountOf(types.PylonsCoinDenom))
+		})
+	}
+}
+
+func (suite *IntegrationTestSuite) TestMsgServerBurnItemsUnpaidRefund() {
+	k := suite.k
+	bk := suite.bankKeeper
+	ctx := suite.ctx
+	require := suite.Require()
+
+	wctx := sdk.WrapSDKContext(ctx)
+	srv := keeper.NewMsgServerImpl(k)
+
+	// the cookbook creator holds no coins to pay the refund
+	cookbook := createNCookbook(k, ctx, 1)[0]
+	cookbook.BurnRefund = sdk.NewCoins(sdk.NewCoin(types.PylonsCoinDenom, sdk.NewInt(10)))
+	k.SetCookbook(ctx, cookbook)
+
+	item := createNItemSameOwnerAndCookbook(k, ctx, 1, cookbook.Id, true)[0]
+	ownerAddr, _ := sdk.AccAddressFromBech32(item.Owner)
+
+	_, err := srv.BurnItems(wctx, &types.MsgBurnItems{
+		Creator: item.Owner,
+		Items:   []types.ItemRef{{CookbookId: cookbook.Id, ItemId: item.Id}},
+	})
+	require.NoError(err)
+	require.False(k.HasItem(ctx, cookbook.Id, item.Id))
+	require.True(bk.SpendableCoins(ctx, ownerAddr).IsZero())
+
+	found := false
+	for _, event := range ctx.EventManager().Events() {
+		if event.Type == "pylons.pylons.EventBurnRefundUnpaid" {
+			found = true
+		}
+	}
+	require.True(found)
+}
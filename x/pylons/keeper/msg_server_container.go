@@ -0,0 +1,94 @@
+package keeper
+
+import (
+	"context"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	"github.com/Pylons-tech/pylons/x/pylons/types"
+)
+
+// getOwnedContainer returns a container item owned by the message creator
+func (k msgServer) getOwnedContainer(ctx sdk.Context, creator, cookbookID, containerID string) (types.Item, error) {
+	container, found := k.GetItem(ctx, cookbookID, containerID)
+	if !found {
+		return container, sdkerrors.Wrapf(sdkerrors.ErrKeyNotFound, "container with id %v and cookbook id %v not found", containerID, cookbookID)
+	}
+	if container.Owner != creator {
+		return container, sdkerrors.Wrapf(sdkerrors.ErrUnauthorized, "container with id %v and cookbook id %v not owned by sender", containerID, cookbookID)
+	}
+	return container, nil
+}
+
+func (k msgServer) DepositItems(goCtx context.Context, msg *types.MsgDepositItems) (*types.MsgDepositItemsResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	container, err := k.getOwnedContainer(ctx, msg.Creator, msg.CookbookId, msg.ContainerId)
+	if err != nil {
+		return nil, err
+	}
+	// a fungible item can be split, which would duplicate its contents
+	if container.Fungible {
+		return nil, sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "fungible item with id %v cannot hold items", container.Id)
+	}
+	if container.IsExpired(ctx) {
+		return nil, sdkerrors.Wrapf(types.ErrItemExpired, "container with id %v expired", container.Id)
+	}
+	if len(k.GetContainerItems(ctx, msg.CookbookId, msg.ContainerId))+len(msg.ItemIds) > types.MaxContainerItems {
+		return nil, sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "a container holds at most %d items", types.MaxContainerItems)
+	}
+
+	for _, itemID := range msg.ItemIds {
+		item, found := k.GetItem(ctx, msg.CookbookId, itemID)
+		if !found {
+			return nil, sdkerrors.Wrapf(sdkerrors.ErrKeyNotFound, "item with id %v and cookbook id %v not found", itemID, msg.CookbookId)
+		}
+		if item.Owner != msg.Creator {
+			return nil, sdkerrors.Wrapf(sdkerrors.ErrUnauthorized, "item with id %v and cookbook id %v not owned by sender", itemID, msg.CookbookId)
+		}
+		if item.IsExpired(ctx) {
+			return nil, sdkerrors.Wrapf(types.ErrItemExpired, "item with id %v and cookbook id %v expired", itemID, msg.CookbookId)
+		}
+		// the contents of a tradeable container are traded along with it
+		if container.Tradeable && (!item.Tradeable || item.HasTransferRestrictions()) {
+			return nil, sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "item with id %v and cookbook id %v cannot be deposited in a tradeable container", itemID, msg.CookbookId)
+		}
+		k.DepositItemInContainer(ctx, item, container.Id)
+	}
+
+	err = ctx.EventManager().EmitTypedEvent(&types.EventDepositItems{
+		Owner:       msg.Creator,
+		CookbookId:  msg.CookbookId,
+		ContainerId: msg.ContainerId,
+		ItemIds:     msg.ItemIds,
+	})
+
+	return &types.MsgDepositItemsResponse{}, err
+}
+
+func (k msgServer) WithdrawItems(goCtx context.Context, msg *types.MsgWithdrawItems) (*types.MsgWithdrawItemsResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	container, err := k.getOwnedContainer(ctx, msg.Creator, msg.CookbookId, msg.ContainerId)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, itemID := range msg.ItemIds {
+		item, found := k.GetItem(ctx, msg.CookbookId, itemID)
+		if !found || item.ContainerId != container.Id {
+			return nil, sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "item with id %v not held by container with id %v", itemID, container.Id)
+		}
+		k.WithdrawItemFromContainer(ctx, item, msg.Creator)
+	}
+
+	err = ctx.EventManager().EmitTypedEvent(&types.EventWithdrawItems{
+		Owner:       msg.Creator,
+		CookbookId:  msg.CookbookId,
+		ContainerId: msg.ContainerId,
+		ItemIds:     msg.ItemIds,
+	})
+
+	return &types.MsgWithdrawItemsResponse{}, err
+}
@@ -0,0 +1,152 @@
+package keeper_test
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	"github.com/Pylons-tech/pylons/x/pylons/keeper"
+	"github.com/Pylons-tech/pylons/x/pylons/types"
+)
+
+func (suite *IntegrationTestSuite) TestMsgServerDepositItems() {
+	k := suite.k
+	ctx := suite.ctx
+	require := suite.Require()
+
+	srv := keeper.NewMsgServerImpl(k)
+	wctx := sdk.WrapSDKContext(ctx)
+
+	items := createNItemSameOwnerAndCookbook(k, ctx, 4, "testCookbook", true)
+	owner := items[0].Owner
+	container := items[0]
+	other := types.GenTestBech32FromString("other")
+	otherItem := types.Item{Owner: other, CookbookId: "testCookbook", Tradeable: true}
+	otherItem.Id = k.AppendItem(ctx, otherItem)
+	untradeable := types.Item{Owner: owner, CookbookId: "testCookbook"}
+	untradeable.Id = k.AppendItem(ctx, untradeable)
+
+	for _, tc := range []struct {
+		desc string
+		msg  *types.MsgDepositItems
+		err  error
+	}{
+		{
+			desc: "ContainerNotFound",
+			msg:  types.NewMsgDepositItems(owner, "testCookbook", "missing", []string{items[1].Id}),
+			err:  sdkerrors.ErrKeyNotFound,
+		},
+		{
+			desc: "ContainerNotOwned",
+			msg:  types.NewMsgDepositItems(other, "testCookbook", container.Id, []string{otherItem.Id}),
+			err:  sdkerrors.ErrUnauthorized,
+		},
+		{
+			desc: "ItemNotOwned",
+			msg:  types.NewMsgDepositItems(owner, "testCookbook", container.Id, []string{items[1].Id, otherItem.Id}),
+			err:  sdkerrors.ErrUnauthorized,
+		},
+		{
+			desc: "NotTradeableInTradeableContainer",
+			msg:  types.NewMsgDepositItems(owner, "testCookbook", container.Id, []string{untradeable.Id}),
+			err:  sdkerrors.ErrInvalidRequest,
+		},
+	} {
+		tc := tc
+		suite.Run(tc.desc, func() {
+			cacheCtx, _ := ctx.CacheContext()
+			_, err := srv.DepositItems(sdk.WrapSDKContext(cacheCtx), tc.msg)
+			require.ErrorIs(err, tc.err)
+		})
+	}
+
+	_, err := srv.DepositItems(wctx, types.NewMsgDepositItems(owner, "testCookbook", container.Id, []string{items[1].Id, items[2].Id}))
+	require.NoError(err)
+
+	// the contents are locked and show up in the container query
+	contents := k.GetContainerItems(ctx, "testCookbook", container.Id)
+	require.Len(contents, 2)
+	for _, content := range contents {
+		require.Equal(k.ContainersLockerAddress().String(), content.Owner)
+		require.Equal(container.Id, content.ContainerId)
+	}
+	response, err := k.Item(wctx, &types.QueryGetItemRequest{CookbookId: "testCookbook", Id: container.Id})
+	require.NoError(err)
+	require.Equal(contents, response.Contents)
+	ownerAddr, _ := sdk.AccAddressFromBech32(owner)
+	require.Len(k.GetAllItemByOwner(ctx, ownerAddr), 3)
+
+	// a container holding items cannot be burned
+	_, err = srv.BurnItems(wctx, &types.MsgBurnItems{Creator: owner, Items: []types.ItemRef{{CookbookId: "testCookbook", ItemId: container.Id}}})
+	require.ErrorIs(err, sdkerrors.ErrInvalidRequest)
+
+	// the contents of a contained item are not reachable by the owner
+	_, err = srv.WithdrawItems(wctx, types.NewMsgWithdrawItems(owner, "testCookbook", items[1].Id, []string{items[2].Id}))
+	require.ErrorIs(err, sdkerrors.ErrUnauthorized)
+	_, err = srv.WithdrawItems(wctx, types.NewMsgWithdrawItems(owner, "testCookbook", container.Id, []string{items[3].Id}))
+	require.ErrorIs(err, sdkerrors.ErrInvalidRequest)
+}
+
+func (suite *IntegrationTestSuite) TestMsgServerContainerTransfer() {
+	k := suite.k
+	ctx := suite.ctx
+	require := suite.Require()
+
+	srv := keeper.NewMsgServerImpl(k)
+	wctx := sdk.WrapSDKContext(ctx)
+
+	cookbook := createNCookbook(k, ctx, 1)[0]
+	items := createNItemSameOwnerAndCookbook(k, ctx, 3, cookbook.Id, true)
+	owner := items[0].Owner
+	container := items[0]
+	receiver := types.GenTestBech32FromString("receiver")
+	k.SetPylonsAccount(ctx, types.AccountAddr{Value: owner}, types.Username{Value: "owner"})
+	k.SetPylonsAccount(ctx, types.AccountAddr{Value: receiver}, types.Username{Value: "receiver"})
+	ownerAddr, _ := sdk.AccAddressFromBech32(owner)
+	require.NoError(k.MintCoinsToAddr(ctx, ownerAddr, sdk.NewCoins(sdk.NewCoin(types.PylonsCoinDenom, sdk.NewInt(100)))))
+
+	_, err := srv.DepositItems(wctx, types.NewMsgDepositItems(owner, cookbook.Id, container.Id, []string{items[1].Id, items[2].Id}))
+	require.NoError(err)
+
+	// sending the container moves its contents along with it
+	_, err = srv.SendItems(wctx, &types.MsgSendItems{Creator: owner, Receiver: receiver, Items: []types.ItemRef{{CookbookId: cookbook.Id, ItemId: container.Id}}})
+	require.NoError(err)
+	response, err := k.Item(wctx, &types.QueryGetItemRequest{CookbookId: cookbook.Id, Id: container.Id})
+	require.NoError(err)
+	require.Equal(receiver, response.Item.Owner)
+	require.Len(response.Contents, 2)
+
+	// the previous owner cannot withdraw the contents anymore, the new owner can
+	_, err = srv.WithdrawItems(wctx, types.NewMsgWithdrawItems(owner, cookbook.Id, container.Id, []string{items[1].Id}))
+	require.ErrorIs(err, sdkerrors.ErrUnauthorized)
+	_, err = srv.WithdrawItems(wctx, types.NewMsgWithdrawItems(receiver, cookbook.Id, container.Id, []string{items[1].Id}))
+	require.NoError(err)
+
+	withdrawn, _ := k.GetItem(ctx, cookbook.Id, items[1].Id)
+	require.Equal(receiver, withdrawn.Owner)
+	require.Empty(withdrawn.ContainerId)
+	contents := k.GetContainerItems(ctx, cookbook.Id, container.Id)
+	require.Len(contents, 1)
+	require.Equal(items[2].Id, contents[0].Id)
+}
+
+func (suite *IntegrationTestSuite) TestExpiredContainerReleasesItems() {
+	k := suite.k
+	ctx := suite.ctx.WithBlockHeight(10)
+	require := suite.Require()
+
+	srv := keeper.NewMsgServerImpl(k)
+
+	items := createNItemSameOwnerAndCookbook(k, ctx, 2, "testCookbook", true)
+	container := items[0]
+	container.ExpiresAtHeight = 20
+	k.SetItem(ctx, container)
+	_, err := srv.DepositItems(sdk.WrapSDKContext(ctx), types.NewMsgDepositItems(container.Owner, "testCookbook", container.Id, []string{items[1].Id}))
+	require.NoError(err)
+
+	require.Equal(1, k.DeleteExpiredItems(ctx.WithBlockHeight(20), 10))
+	require.False(k.HasItem(ctx, "testCookbook", container.Id))
+	released, found := k.GetItem(ctx, "testCookbook", items[1].Id)
+	require.True(found)
+	require.Equal(container.Owner, released.Owner)
+	require.Empty(released.ContainerId)
+}
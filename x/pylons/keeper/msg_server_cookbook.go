@@ -2,6 +2,7 @@ package keeper
 
 import (
 	"context"
+	"strings"
 
 	"github.com/cosmos/cosmos-sdk/telemetry"
 	sdk "github.com/cosmos/cosmos-sdk/types"
@@ -12,6 +13,10 @@ import (
 
 func (k msgServer) CreateCookbook(goCtx context.Context, msg *types.MsgCreateCookbook) (*types.MsgCreateCookbookResponse, error) {
 	ctx := sdk.UnwrapSDKContext(goCtx)
+	// the prefix is reserved for the cookbooks holding items received over IBC
+	if strings.HasPrefix(msg.Id, types.IBCCookbookPrefix) {
+		return nil, sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "ID %v cannot start with %v", msg.Id, types.IBCCookbookPrefix)
+	}
 	// Check if the value already exists
 	_, isFound := k.GetCookbook(ctx, msg.Id)
 	if isFound {
@@ -19,15 +24,19 @@ func (k msgServer) CreateCookbook(goCtx context.Context, msg *types.MsgCreateCoo
 	}
 
 	cookbook := types.Cookbook{
-		Id:           msg.Id,
-		Creator:      msg.Creator,
-		NodeVersion:  k.EngineVersion(ctx),
-		Name:         msg.Name,
-		Description:  msg.Description,
-		Developer:    msg.Developer,
-		Version:      msg.Version,
-		SupportEmail: msg.SupportEmail,
-		Enabled:      msg.Enabled,
+		Id:                   msg.Id,
+		Creator:              msg.Creator,
+		NodeVersion:          k.EngineVersion(ctx),
+		Name:                 msg.Name,
+		Description:          msg.Description,
+		Developer:            msg.Developer,
+		Version:              msg.Version,
+		SupportEmail:         msg.SupportEmail,
+		Enabled:              msg.Enabled,
+		BurnRefund:           msg.BurnRefund,
+		IndexedAttributes:    msg.IndexedAttributes,
+		AttributePermissions: msg.AttributePermissions,
+		AttributeSchema:      msg.AttributeSchema,
 	}
 
 	k.SetCookbook(
@@ -60,14 +69,18 @@ func (k msgServer) UpdateCookbook(goCtx context.Context, msg *types.MsgUpdateCoo
 	}
 
 	updatedCookbook := types.Cookbook{
-		Id:           msg.Id,
-		Creator:      msg.Creator,
-		NodeVersion:  k.EngineVersion(ctx),
-		Name:         msg.Name,
-		Description:  msg.Description,
-		Developer:    msg.Developer,
-		Version:      msg.Version,
-		SupportEmail: msg.SupportEmail,
+		Id:                   msg.Id,
+		Creator:              msg.Creator,
+		NodeVersion:          k.EngineVersion(ctx),
+		Name:                 msg.Name,
+		Description:          msg.Description,
+		Developer:            msg.Developer,
+		Version:              msg.Version,
+		SupportEmail:         msg.SupportEmail,
+		BurnRefund:           msg.BurnRefund,
+		IndexedAttributes:    msg.IndexedAttributes,
+		AttributePermissions: msg.AttributePermissions,
+		AttributeSchema:      msg.AttributeSchema,
 	}
 
 	modified, err := types.CookbookModified(origCookbook, updatedCookbook)
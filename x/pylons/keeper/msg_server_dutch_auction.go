@@ -0,0 +1,123 @@
+package keeper
+
+import (
+	"context"
+
+	"github.com/cosmos/cosmos-sdk/telemetry"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	"github.com/Pylons-tech/pylons/x/pylons/types"
+)
+
+func (k msgServer) CreateDutchAuction(goCtx context.Context, msg *types.MsgCreateDutchAuction) (*types.MsgCreateDutchAuctionResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	if msg.EndHeight <= ctx.BlockHeight() {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "dutch auction end height already reached")
+	}
+
+	// the price never goes below the floor price, so it always covers the items transfer fees
+	itemRefs, err := k.lockAuctionedItems(ctx, msg.Creator, msg.Items, msg.FloorPrice)
+	if err != nil {
+		return nil, err
+	}
+
+	id := k.AppendDutchAuction(ctx, types.DutchAuction{
+		Creator:     msg.Creator,
+		Items:       itemRefs,
+		StartPrice:  msg.StartPrice,
+		FloorPrice:  msg.FloorPrice,
+		StartHeight: msg.StartHeight,
+		EndHeight:   msg.EndHeight,
+		Decay:       msg.Decay,
+	})
+
+	err = ctx.EventManager().EmitTypedEvent(&types.EventCreateDutchAuction{
+		Creator: msg.Creator,
+		Id:      id,
+	})
+
+	telemetry.IncrCounter(1, "dutch_auction", "create")
+
+	return &types.MsgCreateDutchAuctionResponse{Id: id}, err
+}
+
+func (k msgServer) BuyDutchAuction(goCtx context.Context, msg *types.MsgBuyDutchAuction) (*types.MsgBuyDutchAuctionResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	auction, found := k.GetDutchAuction(ctx, msg.Id)
+	if !found {
+		return nil, sdkerrors.Wrapf(sdkerrors.ErrKeyNotFound, "dutch auction %d doesn't exist", msg.Id)
+	}
+	if !auction.IsStarted(ctx) {
+		return nil, sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "dutch auction %d starts at height %d", msg.Id, auction.StartHeight)
+	}
+	if auction.IsEnded(ctx) {
+		return nil, sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "dutch auction %d ended at height %d", msg.Id, auction.EndHeight)
+	}
+	if auction.Creator == msg.Creator {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "the seller cannot buy its auction")
+	}
+
+	price := auction.PriceAt(ctx.BlockHeight())
+	if msg.MaxPrice.Denom != price.Denom || msg.MaxPrice.IsLT(price) {
+		return nil, sdkerrors.Wrapf(sdkerrors.ErrInvalidCoins, "current price %s is greater than max price %s", price, msg.MaxPrice)
+	}
+
+	// check that sender has enough balance to pay the price
+	buyerAddr, _ := sdk.AccAddressFromBech32(msg.Creator)
+	balance := k.bankKeeper.SpendableCoins(ctx, buyerAddr)
+	if !balance.IsAllGTE(sdk.NewCoins(price)) {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "not enough balance to pay for dutch auction price")
+	}
+
+	items := make([]types.Item, len(auction.Items))
+	for i, itemRef := range auction.Items {
+		items[i], _ = k.GetItem(ctx, itemRef.CookbookId, itemRef.ItemId)
+		if items[i].IsExpired(ctx) {
+			return nil, sdkerrors.Wrapf(types.ErrItemExpired, "item with id %v and cookbook id %v expired", itemRef.ItemId, itemRef.CookbookId)
+		}
+	}
+	err := k.sellAuctionedItems(ctx, items, auction.Creator, msg.Creator, sdk.NewCoins(price))
+	if err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, err.Error())
+	}
+	k.RemoveDutchAuction(ctx, auction)
+
+	err = ctx.EventManager().EmitTypedEvent(&types.EventBuyDutchAuction{
+		Creator: auction.Creator,
+		Id:      auction.Id,
+		Buyer:   msg.Creator,
+		Price:   price,
+		Items:   auction.Items,
+	})
+
+	telemetry.IncrCounter(1, "dutch_auction", "buy")
+
+	return &types.MsgBuyDutchAuctionResponse{Price: price}, err
+}
+
+func (k msgServer) CancelDutchAuction(goCtx context.Context, msg *types.MsgCancelDutchAuction) (*types.MsgCancelDutchAuctionResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	auction, found := k.GetDutchAuction(ctx, msg.Id)
+	if !found {
+		return nil, sdkerrors.Wrapf(sdkerrors.ErrKeyNotFound, "dutch auction %d doesn't exist", msg.Id)
+	}
+	if auction.Creator != msg.Creator {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrUnauthorized, "incorrect owner")
+	}
+
+	k.unlockAuctionedItems(ctx, msg.Creator, auction.Items)
+	k.RemoveDutchAuction(ctx, auction)
+
+	err := ctx.EventManager().EmitTypedEvent(&types.EventCancelDutchAuction{
+		Creator: msg.Creator,
+		Id:      msg.Id,
+	})
+
+	telemetry.IncrCounter(1, "dutch_auction", "cancel")
+
+	return &types.MsgCancelDutchAuctionResponse{}, err
+}
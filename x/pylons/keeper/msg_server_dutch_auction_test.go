@@ -0,0 +1,175 @@
+package keeper_test
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	"github.com/Pylons-tech/pylons/x/pylons/keeper"
+	"github.com/Pylons-tech/pylons/x/pylons/types"
+)
+
+func (suite *IntegrationTestSuite) TestMsgServerDutchAuction() {
+	k := suite.k
+	bk := suite.bankKeeper
+	ctx := suite.ctx.WithBlockHeight(5)
+	require := suite.Require()
+
+	srv := keeper.NewMsgServerImpl(k)
+
+	cookbook := createNCookbook(k, ctx, 1)[0]
+	cookbookAddr, _ := sdk.AccAddressFromBech32(cookbook.Creator)
+	items := createNItemSameOwnerAndCookbook(k, ctx, 1, cookbook.Id, true)
+	items[0].TradePercentage = sdk.NewDecWithPrec(1, 1)
+	k.SetItem(ctx, items[0])
+	seller := items[0].Owner
+	sellerAddr, _ := sdk.AccAddressFromBech32(seller)
+	buyer := types.GenTestBech32FromString("buyer")
+	buyerAddr, _ := sdk.AccAddressFromBech32(buyer)
+	poor := types.GenTestBech32FromString("poor")
+	poorAddr, _ := sdk.AccAddressFromBech32(poor)
+	k.SetPylonsAccount(ctx, types.AccountAddr{Value: seller}, types.Username{Value: "seller"})
+	k.SetPylonsAccount(ctx, types.AccountAddr{Value: buyer}, types.Username{Value: "buyer"})
+	err := k.MintCoinsToAddr(ctx, buyerAddr, sdk.NewCoins(sdk.NewCoin(types.PylonsCoinDenom, sdk.NewInt(600))))
+	require.NoError(err)
+	err = k.MintCoinsToAddr(ctx, poorAddr, sdk.NewCoins(sdk.NewCoin(types.PylonsCoinDenom, sdk.NewInt(100))))
+	require.NoError(err)
+	refs := []types.ItemRef{{CookbookId: cookbook.Id, ItemId: items[0].Id}}
+	startPrice := sdk.NewCoin(types.PylonsCoinDenom, sdk.NewInt(1000))
+	floorPrice := sdk.NewCoin(types.PylonsCoinDenom, sdk.NewInt(100))
+
+	_, err = srv.CreateDutchAuction(sdk.WrapSDKContext(ctx), types.NewMsgCreateDutchAuction(seller, refs, startPrice, floorPrice, 1, 5, types.DutchAuctionDecayLinear))
+	require.ErrorIs(err, sdkerrors.ErrInvalidRequest)
+	_, err = srv.CreateDutchAuction(sdk.WrapSDKContext(ctx), types.NewMsgCreateDutchAuction(seller, refs, startPrice, sdk.NewCoin(types.PylonsCoinDenom, sdk.NewInt(50)), 10, 20, types.DutchAuctionDecayLinear))
+	require.ErrorIs(err, sdkerrors.ErrInvalidCoins)
+
+	res, err := srv.CreateDutchAuction(sdk.WrapSDKContext(ctx), types.NewMsgCreateDutchAuction(seller, refs, startPrice, floorPrice, 10, 20, types.DutchAuctionDecayLinear))
+	require.NoError(err)
+	item, _ := k.GetItem(ctx, cookbook.Id, items[0].Id)
+	require.Equal(k.AuctionsLockerAddress().String(), item.Owner)
+
+	// the auction cannot be bought before its start height
+	_, err = srv.BuyDutchAuction(sdk.WrapSDKContext(ctx), types.NewMsgBuyDutchAuction(buyer, res.Id, startPrice))
+	require.ErrorIs(err, sdkerrors.ErrInvalidRequest)
+
+	ctx = ctx.WithBlockHeight(15)
+	wctx := sdk.WrapSDKContext(ctx)
+	price := sdk.NewCoin(types.PylonsCoinDenom, sdk.NewInt(550))
+	_, err = srv.BuyDutchAuction(wctx, types.NewMsgBuyDutchAuction(seller, res.Id, startPrice))
+	require.ErrorIs(err, sdkerrors.ErrInvalidRequest)
+	_, err = srv.BuyDutchAuction(wctx, types.NewMsgBuyDutchAuction(buyer, res.Id, price.SubAmount(sdk.OneInt())))
+	require.ErrorIs(err, sdkerrors.ErrInvalidCoins)
+	_, err = srv.BuyDutchAuction(wctx, types.NewMsgBuyDutchAuction(buyer, res.Id, sdk.NewCoin("other", sdk.NewInt(1000))))
+	require.ErrorIs(err, sdkerrors.ErrInvalidCoins)
+	_, err = srv.BuyDutchAuction(wctx, types.NewMsgBuyDutchAuction(poor, res.Id, startPrice))
+	require.ErrorIs(err, sdkerrors.ErrInvalidRequest)
+
+	// the buyer pays the current price, the royalty of the cookbook and the chain fees being taken out of it
+	buyRes, err := srv.BuyDutchAuction(wctx, types.NewMsgBuyDutchAuction(buyer, res.Id, startPrice))
+	require.NoError(err)
+	require.Equal(price, buyRes.Price)
+	item, _ = k.GetItem(ctx, cookbook.Id, items[0].Id)
+	require.Equal(buyer, item.Owner)
+	royalty := sdk.NewInt(55)
+	chainFee := sdk.NewDecFromInt(royalty).Mul(k.ItemTransferFeePercentage(ctx)).RoundInt()
+	require.True(bk.GetBalance(ctx, buyerAddr, types.PylonsCoinDenom).Amount.Equal(sdk.NewInt(50)))
+	require.True(bk.GetBalance(ctx, sellerAddr, types.PylonsCoinDenom).Amount.Equal(price.Amount.Sub(royalty)))
+	require.True(bk.GetBalance(ctx, cookbookAddr, types.PylonsCoinDenom).Amount.Equal(royalty.Sub(chainFee)))
+
+	// the first buyer wins
+	_, err = srv.BuyDutchAuction(wctx, types.NewMsgBuyDutchAuction(poor, res.Id, startPrice))
+	require.ErrorIs(err, sdkerrors.ErrKeyNotFound)
+}
+
+func (suite *IntegrationTestSuite) TestMsgServerDutchAuctionDuplicateItems() {
+	k := suite.k
+	ctx := suite.ctx.WithBlockHeight(5)
+	require := suite.Require()
+
+	srv := keeper.NewMsgServerImpl(k)
+
+	seller := types.GenTestBech32FromString("seller")
+	item := createFungibleItem(k, ctx, seller, "testCookbook", 6)
+	ref := types.ItemRef{CookbookId: item.CookbookId, ItemId: item.Id, Amount: 4}
+	startPrice := sdk.NewCoin(types.PylonsCoinDenom, sdk.NewInt(1000))
+	floorPrice := sdk.NewCoin(types.PylonsCoinDenom, sdk.NewInt(100))
+	msg := types.NewMsgCreateDutchAuction(seller, []types.ItemRef{ref, ref}, startPrice, floorPrice, 10, 20, types.DutchAuctionDecayLinear)
+	require.ErrorIs(msg.ValidateBasic(), sdkerrors.ErrInvalidRequest)
+
+	// units are split out of the stored item, so listing it twice cannot auction more units than it holds
+	_, err := srv.CreateDutchAuction(sdk.WrapSDKContext(ctx), msg)
+	require.ErrorIs(err, types.ErrItemQuantity)
+	require.Equal(uint64(6), itemsQuantity(k, ctx, item.CookbookId))
+}
+
+func (suite *IntegrationTestSuite) TestMsgServerCancelDutchAuction() {
+	k := suite.k
+	ctx := suite.ctx.WithBlockHeight(5)
+	require := suite.Require()
+
+	wctx := sdk.WrapSDKContext(ctx)
+	srv := keeper.NewMsgServerImpl(k)
+
+	cookbook := createNCookbook(k, ctx, 1)[0]
+	items := createNItemSameOwnerAndCookbook(k, ctx, 1, cookbook.Id, true)
+	seller := items[0].Owner
+	refs := []types.ItemRef{{CookbookId: cookbook.Id, ItemId: items[0].Id}}
+
+	res, err := srv.CreateDutchAuction(wctx, types.NewMsgCreateDutchAuction(seller, refs, sdk.NewCoin(types.PylonsCoinDenom, sdk.NewInt(1000)), sdk.NewCoin(types.PylonsCoinDenom, sdk.NewInt(100)), 10, 20, types.DutchAuctionDecayExponential))
+	require.NoError(err)
+
+	_, err = srv.CancelDutchAuction(wctx, types.NewMsgCancelDutchAuction(types.GenTestBech32FromString("other"), res.Id))
+	require.ErrorIs(err, sdkerrors.ErrUnauthorized)
+	_, err = srv.CancelDutchAuction(wctx, types.NewMsgCancelDutchAuction(seller, res.Id+1))
+	require.ErrorIs(err, sdkerrors.ErrKeyNotFound)
+	_, err = srv.CancelDutchAuction(wctx, types.NewMsgCancelDutchAuction(seller, res.Id))
+	require.NoError(err)
+
+	item, _ := k.GetItem(ctx, cookbook.Id, items[0].Id)
+	require.Equal(seller, item.Owner)
+	_, found := k.GetDutchAuction(ctx, res.Id)
+	require.False(found)
+}
+
+func (suite *IntegrationTestSuite) TestMsgServerEndDutchAuction() {
+	k := suite.k
+	ctx := suite.ctx.WithBlockHeight(5)
+	require := suite.Require()
+
+	srv := keeper.NewMsgServerImpl(k)
+
+	cookbook := createNCookbook(k, ctx, 1)[0]
+	items := createNItemSameOwnerAndCookbook(k, ctx, 1, cookbook.Id, true)
+	seller := items[0].Owner
+	buyer := types.GenTestBech32FromString("buyer")
+	buyerAddr, _ := sdk.AccAddressFromBech32(buyer)
+	err := k.MintCoinsToAddr(ctx, buyerAddr, sdk.NewCoins(sdk.NewCoin(types.PylonsCoinDenom, sdk.NewInt(1000))))
+	require.NoError(err)
+	refs := []types.ItemRef{{CookbookId: cookbook.Id, ItemId: items[0].Id}}
+	startPrice := sdk.NewCoin(types.PylonsCoinDenom, sdk.NewInt(1000))
+
+	res, err := srv.CreateDutchAuction(sdk.WrapSDKContext(ctx), types.NewMsgCreateDutchAuction(seller, refs, startPrice, sdk.NewCoin(types.PylonsCoinDenom, sdk.NewInt(100)), 10, 20, types.DutchAuctionDecayLinear))
+	require.NoError(err)
+
+	// an expired item cannot be bought
+	item, _ := k.GetItem(ctx, cookbook.Id, items[0].Id)
+	item.ExpiresAtHeight = 18
+	k.SetItem(ctx, item)
+	ctx = ctx.WithBlockHeight(18)
+	_, err = srv.BuyDutchAuction(sdk.WrapSDKContext(ctx), types.NewMsgBuyDutchAuction(buyer, res.Id, startPrice))
+	require.ErrorIs(err, types.ErrItemExpired)
+
+	// the auction can be bought until its end height, and is ended at the end of that block
+	item.ExpiresAtHeight = 0
+	k.SetItem(ctx, item)
+	ctx = ctx.WithBlockHeight(19)
+	require.Equal(0, k.EndDutchAuctions(ctx, types.MaxEndedDutchAuctionsPerBlock))
+	ctx = ctx.WithBlockHeight(21)
+	_, err = srv.BuyDutchAuction(sdk.WrapSDKContext(ctx), types.NewMsgBuyDutchAuction(buyer, res.Id, startPrice))
+	require.ErrorIs(err, sdkerrors.ErrInvalidRequest)
+	require.Equal(1, k.EndDutchAuctions(ctx, types.MaxEndedDutchAuctionsPerBlock))
+
+	item, _ = k.GetItem(ctx, cookbook.Id, items[0].Id)
+	require.Equal(seller, item.Owner)
+	_, found := k.GetDutchAuction(ctx, res.Id)
+	require.False(found)
+}
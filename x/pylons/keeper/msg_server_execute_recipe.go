@@ -31,13 +31,21 @@ func (k Keeper) MatchItemInputsForExecution(ctx sdk.Context, creatorAddr string,
 				if !found {
 					return nil, sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "item with id %v not found", id)
 				}
-				if inputItem.Owner != creatorAddr {
+				// a borrowed item can be used by the borrower as a non-consumed input, an approved operator can use the
+				// items of their owner
+				if !k.IsApprovedOrOwner(ctx, inputItem, creatorAddr) && !k.IsItemBorrowedBy(ctx, inputItem, creatorAddr) {
 					modAcc := k.accountKeeper.GetModuleAddress(types.ExecutionsLockerName)
 					if inputItem.Owner == modAcc.String() {
 						return nil, sdkerrors.Wrapf(types.ErrItemLocked, "item with id %s locked", inputItem.Id)
 					}
 					return nil, sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "item with id %s not owned by sender", inputItem.Id)
 				}
+				if inputItem.IsExpired(ctx) {
+					return nil, sdkerrors.Wrapf(types.ErrItemExpired, "item with id %s expired", inputItem.Id)
+				}
+				if k.HasContainerItems(ctx, inputItem.CookbookId, inputItem.Id) {
+					return nil, sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "item with id %s holds items", inputItem.Id)
+				}
 			}
 			inputItemMap[id] = inputItem
 			// match
@@ -134,11 +142,40 @@ func (k msgServer) ExecuteRecipe(goCtx context.Context, msg *types.MsgExecuteRec
 	// create ItemRecord list
 	itemRecords := make([]types.ItemRecord, len(matchedItems))
 	for i, item := range matchedItems {
+		// borrowed items are neither locked nor consumed, so the recipe cannot modify them
+		if k.IsItemBorrowedBy(ctx, item, msg.Creator) {
+			if recipe.ItemInputs[i].Amount != 0 {
+				return nil, sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "borrowed item with id %s cannot be consumed", item.Id)
+			}
+			for _, itemModifyOutput := range recipe.Entries.ItemModifyOutputs {
+				if itemModifyOutput.ItemInputRef == recipe.ItemInputs[i].Id {
+					return nil, sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "borrowed item with id %s cannot be modified", item.Id)
+				}
+			}
+			itemRecords[i] = types.ItemRecord{
+				Id:       item.Id,
+				Doubles:  item.Doubles,
+				Longs:    item.Longs,
+				Strings:  item.Strings,
+				Borrowed: true,
+				Owner:    item.Owner,
+			}
+			continue
+		}
+
+		// only the amount required by the recipe is consumed out of a fungible item
+		if recipe.ItemInputs[i].Amount != 0 {
+			item, err = k.SplitItem(ctx, item, recipe.ItemInputs[i].Amount)
+			if err != nil {
+				return nil, err
+			}
+		}
 		itemRecords[i] = types.ItemRecord{
 			Id:      item.Id,
 			Doubles: item.Doubles,
 			Longs:   item.Longs,
 			Strings: item.Strings,
+			Owner:   item.Owner,
 		}
 
 		// lock input item for the execution - they are not unlocked if execution completes successfully, which means
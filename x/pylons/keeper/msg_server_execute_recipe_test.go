@@ -412,6 +412,33 @@ func (suite *IntegrationTestSuite) TestExecuteRecipe2() {
 	types.DefaultPaymentProcessors = types.DefaultPaymentProcessors[:1]
 }
 
+func (suite *IntegrationTestSuite) TestExecuteRecipeDuplicateItems() {
+	k := suite.k
+	ctx := suite.ctx
+	require := suite.Require()
+
+	wctx := sdk.WrapSDKContext(ctx)
+	srv := keeper.NewMsgServerImpl(k)
+
+	cookbook := types.Cookbook{Creator: types.GenTestBech32FromString("cookbookOwner"), Id: "testCookbook", Enabled: true}
+	k.SetCookbook(ctx, cookbook)
+	k.SetRecipe(ctx, types.Recipe{
+		CookbookId: cookbook.Id,
+		Id:         "testRecipe",
+		ItemInputs: []types.ItemInput{{Id: "first", Amount: 4}, {Id: "second", Amount: 4}},
+		Enabled:    true,
+	})
+	executor := types.GenTestBech32FromString("executor")
+	item := createFungibleItem(k, ctx, executor, cookbook.Id, 6)
+	msg := types.NewMsgExecuteRecipe(executor, cookbook.Id, "testRecipe", 0, []string{item.Id, item.Id}, nil)
+	require.ErrorIs(msg.ValidateBasic(), sdkerrors.ErrInvalidRequest)
+
+	// units are split out of the stored item, so giving it twice cannot consume more units than it holds
+	_, err := srv.ExecuteRecipe(wctx, msg)
+	require.ErrorIs(err, types.ErrItemQuantity)
+	require.Equal(uint64(6), itemsQuantity(k, ctx, cookbook.Id))
+}
+
 func (suite *IntegrationTestSuite) TestMatchItemInputsForExecution() {
 	k := suite.k
 	ctx := suite.ctx
@@ -13,12 +13,22 @@ import (
 	"github.com/Pylons-tech/pylons/x/pylons/types"
 )
 
-func (k msgServer) MatchItemInputsForTrade(ctx sdk.Context, creatorAddr string, itemRefs []types.ItemRef, trade types.Trade) ([]types.Item, error) {
+func (k Keeper) MatchItemInputsForTrade(ctx sdk.Context, creatorAddr string, itemRefs []types.ItemRef, trade types.Trade) ([]types.Item, error) {
 	if len(itemRefs) != len(trade.ItemInputs) {
 		return nil, sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "size mismatch between provided input items and items required by trade")
 	}
 	matchedInputItems := make([]types.Item, len(trade.ItemInputs))
 
+	// an item given twice would be matched from the cache below as if it held its units twice
+	seen := make(map[string]bool)
+	for _, itemRef := range itemRefs {
+		key := itemRef.CookbookId + "-" + itemRef.ItemId
+		if seen[key] {
+			return nil, sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "item %s in cookbook %s is duplicated", itemRef.ItemId, itemRef.CookbookId)
+		}
+		seen[key] = true
+	}
+
 	// build Item list from inputItemIds
 	inputItemMap := make(map[types.ItemRef]types.Item)
 	checkedInputItems := make([]bool, len(itemRefs))
@@ -38,6 +48,9 @@ func (k msgServer) MatchItemInputsForTrade(ctx sdk.Context, creatorAddr string,
 				if inputItem.Owner != creatorAddr {
 					return nil, sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "item with id %s not owned by sender", inputItem.Id)
 				}
+				if inputItem.IsExpired(ctx) {
+					return nil, sdkerrors.Wrapf(types.ErrItemExpired, "item with id %s expired", inputItem.Id)
+				}
 			}
 			inputItemMap[itemRef] = inputItem
 			// match
@@ -60,198 +73,256 @@ func (k msgServer) MatchItemInputsForTrade(ctx sdk.Context, creatorAddr string,
 	return matchedInputItems, nil
 }
 
-func (k msgServer) FulfillTrade(goCtx context.Context, msg *types.MsgFulfillTrade) (*types.MsgFulfillTradeResponse, error) {
-	ctx := sdk.UnwrapSDKContext(goCtx)
+// tradeFill is a fill of units of a trade, with the items exchanged and the fees they pay
+type tradeFill struct {
+	fillAmount  uint64
+	itemInputs  []types.ItemInput
+	coinInputs  sdk.Coins
+	coinOutputs sdk.Coins
+	inputItems  []types.Item
+	outputItems []types.Item
+	// the fulfiller pays the fees of the items it provides out of the coinOutputs, and the trade creator the fees of the
+	// itemOutputs out of the coinInputs
+	inputChainFees  sdk.Coins
+	inputRoyalties  map[string]sdk.Coins
+	inputTransfers  sdk.Coins
+	outputChainFees sdk.Coins
+	outputRoyalties map[string]sdk.Coins
+	outputTransfers sdk.Coins
+}
 
-	// get the trade from keeper
-	if !k.HasTrade(ctx, msg.Id) {
-		return nil, sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "trade does not exist")
-	}
-	trade := k.GetTrade(ctx, msg.Id)
-	coinInputsIndex := int(msg.CoinInputsIndex)
-	var coinInputs sdk.Coins
-	// nolint: gocritic
-	if coinInputsIndex >= len(trade.CoinInputs) && coinInputsIndex != 0 && len(trade.CoinInputs) != 0 {
-		return nil, sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "invalid coinInputs index")
-	} else if coinInputsIndex == 0 && len(trade.CoinInputs) == 0 {
-		coinInputs = sdk.Coins{} // empty coins but valid
-	} else {
-		coinInputs = trade.CoinInputs[coinInputsIndex].Coins
+// tradeTransferFees computes the chain fees, the amounts paid to the cookbook owners and the amount transferred to the
+// other party of a trade for items paid with price
+func (k Keeper) tradeTransferFees(ctx sdk.Context, items []types.Item, permutation []int, price sdk.Coins) (chainFees sdk.Coins, royalties map[string]sdk.Coins, transfers sdk.Coins) {
+	minTransferFees := sdk.NewCoins()
+	for i := range items {
+		minTransferFees = minTransferFees.Add(items[i].TransferFee[permutation[i]])
 	}
 
-	addr, _ := sdk.AccAddressFromBech32(msg.Creator)
-
-	// check that coinInputs does not contain an unsendable paymentProcessor coin without a receipt
-	err := k.ValidatePaymentInfo(ctx, msg.PaymentInfos, coinInputs)
-	if err != nil {
-		return nil, sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, err.Error())
+	// calculate item "weights" as a relative percentage of the total sum of items transferFees
+	weights := make([]math.Int, len(items))
+	for i, item := range items {
+		transferFee := item.TransferFee[permutation[i]]
+		weights[i] = transferFee.Amount.Quo(minTransferFees.AmountOf(transferFee.Denom))
 	}
 
-	if len(msg.PaymentInfos) != 0 {
-		// client is providing payments receipts
-		err := k.ProcessPaymentInfos(ctx, msg.PaymentInfos, addr)
-		if err != nil {
-			return nil, sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, err.Error())
+	// use the determined weights to calculate fees to be paid to cookbook owners and the network
+	// item.TradePercentage is used to calculate the residual fee from the item sale
+	// This fee gets clamped between minTransferFee and maxTransferFee
+	maxTransferFee := k.MaxTransferFee(ctx)
+	chainFees = sdk.NewCoins()
+	transfers = sdk.NewCoins()
+	royalties = make(map[string]sdk.Coins)
+	for i, item := range items {
+		baseItemTransferFee := item.TransferFee[permutation[i]]
+		itemTransferFeeAmt := price.AmountOf(baseItemTransferFee.Denom).Mul(weights[i])
+		tmpCookbookAmt := sdk.NewCoin(baseItemTransferFee.Denom, sdk.NewDecFromInt(itemTransferFeeAmt).Mul(item.TradePercentage).RoundInt())
+		if tmpCookbookAmt.Amount.GT(maxTransferFee) {
+			// clamp to maxTransferFee - maxTransferFee and minTransferFee are global (i.e. same for every coin)
+			tmpCookbookAmt.Amount = maxTransferFee
 		}
+		chainAmt := sdk.NewCoin(baseItemTransferFee.Denom, sdk.NewDecFromInt(tmpCookbookAmt.Amount).Mul(k.ItemTransferFeePercentage(ctx)).RoundInt())
+		cookbookAmt := sdk.NewCoin(baseItemTransferFee.Denom, itemTransferFeeAmt.Sub(chainAmt.Amount))
+		transferAmt := sdk.NewCoin(baseItemTransferFee.Denom, itemTransferFeeAmt.Sub(cookbookAmt.Amount).Sub(chainAmt.Amount))
+		chainFees = chainFees.Add(chainAmt)
+		transfers = transfers.Add(transferAmt)
+		royalties[item.CookbookId] = royalties[item.CookbookId].Add(cookbookAmt)
 	}
 
-	// check that sender has enough balance to pay coinInputs
-	balance := k.bankKeeper.SpendableCoins(ctx, addr)
-	if !balance.IsAllGTE(coinInputs) {
-		return nil, sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "not enough balance to pay for trade coinInputs")
+	return chainFees, royalties, transfers
+}
+
+// prepareTradeFill matches the items provided by the fulfiller to a fill of units of a trade and computes the
+// transfer fees and royalties of the items exchanged
+func (k Keeper) prepareTradeFill(ctx sdk.Context, fulfiller string, trade types.Trade, coinInputsIndex int, fillAmount uint64, itemRefs []types.ItemRef) (tradeFill, error) {
+	if trade.Receiver != "" && trade.Receiver != fulfiller {
+		return tradeFill{}, sdkerrors.Wrapf(sdkerrors.ErrUnauthorized, "trade %d can only be fulfilled by %s", trade.Id, trade.Receiver)
 	}
+	if trade.IsExpired(ctx) {
+		return tradeFill{}, sdkerrors.Wrapf(types.ErrTradeExpired, "trade %d expired", trade.Id)
+	}
+	if fillAmount == 0 {
+		fillAmount = 1
+	}
+	if fillAmount > trade.RemainingUnits() {
+		return tradeFill{}, sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "cannot fill %d units of trade %d with %d units left", fillAmount, trade.Id, trade.RemainingUnits())
+	}
+	fill := tradeFill{fillAmount: fillAmount}
+	fillQuantity := sdk.NewIntFromUint64(fillAmount)
+	// nolint: gocritic
+	if coinInputsIndex >= len(trade.CoinInputs) && coinInputsIndex != 0 && len(trade.CoinInputs) != 0 {
+		return tradeFill{}, sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "invalid coinInputs index")
+	} else if coinInputsIndex == 0 && len(trade.CoinInputs) == 0 {
+		fill.coinInputs = sdk.Coins{} // empty coins but valid
+	} else {
+		fill.coinInputs = trade.CoinInputs[coinInputsIndex].Coins.MulInt(fillQuantity)
+	}
+	fill.coinOutputs = trade.CoinOutputs.MulInt(fillQuantity)
 
-	// match msg items to trade itemInputs
-	matchedInputItems, err := k.MatchItemInputsForTrade(ctx, msg.Creator, msg.Items, trade)
+	// the number of items bounds the expansion of the trade itemInputs
+	if !trade.IsFillItemsCount(fillAmount, len(itemRefs)) {
+		return tradeFill{}, sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "size mismatch between provided input items and items required by trade")
+	}
+
+	// match the items to the trade itemInputs of all the filled units
+	fillTrade := trade
+	fillTrade.ItemInputs = trade.FillItemInputs(fillAmount)
+	fill.itemInputs = fillTrade.ItemInputs
+	var err error
+	fill.inputItems, err = k.MatchItemInputsForTrade(ctx, fulfiller, itemRefs, fillTrade)
 	if err != nil {
-		return nil, err
+		return tradeFill{}, err
 	}
 
 	// check coinOutput is GTE amount to pay (from flat fees of itemInputs)
-	for _, item := range matchedInputItems {
+	for _, item := range fill.inputItems {
 		if !item.Tradeable {
-			return nil, sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "item with id %v and cookbook id %v cannot be traded", item.Id, item.CookbookId)
+			return tradeFill{}, sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "item with id %v and cookbook id %v cannot be traded", item.Id, item.CookbookId)
+		}
+		if err := item.CanTransfer(ctx); err != nil {
+			return tradeFill{}, err
 		}
 	}
 
-	minItemInputsTransferFees := sdk.NewCoins()
-	itemInputsTransferFeePermutation, err := types.FindValidPaymentsPermutation(matchedInputItems, trade.CoinOutputs)
+	itemInputsTransferFeePermutation, err := types.FindValidPaymentsPermutation(fill.inputItems, fill.coinOutputs)
 	if err != nil {
-		return nil, sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "cannot use coinOutputs to pay for the items provided")
-	}
-	for i := range matchedInputItems {
-		minItemInputsTransferFees = minItemInputsTransferFees.Add(matchedInputItems[i].TransferFee[itemInputsTransferFeePermutation[i]])
+		return tradeFill{}, sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "cannot use coinOutputs to pay for the items provided")
 	}
 
-	outputItems := make([]types.Item, len(trade.ItemOutputs))
+	fill.outputItems = make([]types.Item, len(trade.ItemOutputs))
 	for i, itemRef := range trade.ItemOutputs {
 		item, _ := k.GetItem(ctx, itemRef.CookbookId, itemRef.ItemId)
-		outputItems[i] = item
+		if item.IsExpired(ctx) {
+			return tradeFill{}, sdkerrors.Wrapf(types.ErrItemExpired, "item with id %v and cookbook id %v expired", item.Id, item.CookbookId)
+		}
+		fill.outputItems[i] = item
 	}
 
-	minItemOutputsTransferFees := sdk.NewCoins()
-	itemOutputsTransferFeePermutation, err := types.FindValidPaymentsPermutation(outputItems, coinInputs)
+	itemOutputsTransferFeePermutation, err := types.FindValidPaymentsPermutation(fill.outputItems, fill.coinInputs)
 	if err != nil {
-		return nil, sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "coinInputs not sufficient to pay transfer fees")
-	}
-	for i := range outputItems {
-		minItemOutputsTransferFees = minItemOutputsTransferFees.Add(outputItems[i].TransferFee[itemOutputsTransferFeePermutation[i]])
+		return tradeFill{}, sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "coinInputs not sufficient to pay transfer fees")
 	}
 
-	// calculate item "weights" as a relative percentage of the total sum of items transferFees
-	inputItemWeights := make([]math.Int, len(matchedInputItems))
-	for i, item := range matchedInputItems {
-		transferFee := item.TransferFee[itemInputsTransferFeePermutation[i]]
-		weight := transferFee.Amount.Quo(minItemInputsTransferFees.AmountOf(transferFee.Denom))
-		inputItemWeights[i] = weight
+	fill.inputChainFees, fill.inputRoyalties, fill.inputTransfers = k.tradeTransferFees(ctx, fill.inputItems, itemInputsTransferFeePermutation, fill.coinOutputs)
+	fill.outputChainFees, fill.outputRoyalties, fill.outputTransfers = k.tradeTransferFees(ctx, fill.outputItems, itemOutputsTransferFeePermutation, fill.coinInputs)
+
+	return fill, nil
+}
+
+func (k msgServer) FulfillTrade(goCtx context.Context, msg *types.MsgFulfillTrade) (*types.MsgFulfillTradeResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	// get the trade from keeper
+	if !k.HasTrade(ctx, msg.Id) {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "trade does not exist")
 	}
-	outputItemWeights := make([]math.Int, len(outputItems))
-	for i, item := range outputItems {
-		transferFee := item.TransferFee[itemOutputsTransferFeePermutation[i]]
-		weight := transferFee.Amount.Quo(minItemOutputsTransferFees.AmountOf(transferFee.Denom))
-		outputItemWeights[i] = weight
+	trade := k.GetTrade(ctx, msg.Id)
+	fill, err := k.prepareTradeFill(ctx, msg.Creator, trade, int(msg.CoinInputsIndex), msg.FillAmount, msg.Items)
+	if err != nil {
+		return nil, err
 	}
+	fillAmount := fill.fillAmount
+	coinInputs := fill.coinInputs
+	coinOutputs := fill.coinOutputs
 
-	// use the determined weights to calculate fees to be paid to cookbook owners and the network
-	// item.TradePercentage is used to calculate the residual fee from the item sale
-	// This fee gets clamped between minTransferFee and maxTransferFee
-	maxTransferFee := k.MaxTransferFee(ctx)
-	inputChainTotAmt := sdk.NewCoins()
-	inputTransferTotAmt := sdk.NewCoins()
-	inputCookbookOwnersTotAmtMap := make(map[string]sdk.Coins)
-	coinOutputs := trade.CoinOutputs
-	for i, item := range matchedInputItems {
-		baseItemTransferFee := item.TransferFee[itemInputsTransferFeePermutation[i]]
-		itemTransferFeeAmt := coinOutputs.AmountOf(baseItemTransferFee.Denom).Mul(inputItemWeights[i])
-		tmpCookbookAmt := sdk.NewCoin(baseItemTransferFee.Denom, sdk.NewDecFromInt(itemTransferFeeAmt).Mul(item.TradePercentage).RoundInt())
-		if tmpCookbookAmt.Amount.GT(maxTransferFee) {
-			// clamp to maxTransferFee - maxTransferFee and minTransferFee are global (i.e. same for every coin)
-			tmpCookbookAmt.Amount = maxTransferFee
-		}
-		chainAmt := sdk.NewCoin(baseItemTransferFee.Denom, sdk.NewDecFromInt(tmpCookbookAmt.Amount).Mul(k.ItemTransferFeePercentage(ctx)).RoundInt())
-		cookbookAmt := sdk.NewCoin(baseItemTransferFee.Denom, itemTransferFeeAmt.Sub(chainAmt.Amount))
-		transferAmt := sdk.NewCoin(baseItemTransferFee.Denom, itemTransferFeeAmt.Sub(cookbookAmt.Amount).Sub(chainAmt.Amount))
-		inputChainTotAmt = inputChainTotAmt.Add(chainAmt)
-		inputTransferTotAmt = inputTransferTotAmt.Add(transferAmt)
-		inputCookbookOwnersTotAmtMap[item.CookbookId] = inputCookbookOwnersTotAmtMap[item.CookbookId].Add(cookbookAmt)
+	addr, _ := sdk.AccAddressFromBech32(msg.Creator)
+
+	// check that coinInputs does not contain an unsendable paymentProcessor coin without a receipt
+	err = k.ValidatePaymentInfo(ctx, msg.PaymentInfos, coinInputs)
+	if err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, err.Error())
 	}
-	outputChainTotAmt := sdk.NewCoins()
-	outputTransferTotAmt := sdk.NewCoins()
-	outputCookbookOwnersTotAmtMap := make(map[string]sdk.Coins)
-	for i, item := range outputItems {
-		baseItemTransferFee := item.TransferFee[itemOutputsTransferFeePermutation[i]]
-		itemTransferFeeAmt := coinInputs.AmountOf(baseItemTransferFee.Denom).Mul(outputItemWeights[i])
-		tmpCookbookAmt := sdk.NewCoin(baseItemTransferFee.Denom, sdk.NewDecFromInt(itemTransferFeeAmt).Mul(item.TradePercentage).RoundInt())
-		if tmpCookbookAmt.Amount.GT(maxTransferFee) {
-			// clamp to maxTransferFee - maxTransferFee and minTransferFee are global (i.e. same for every coin)
-			tmpCookbookAmt.Amount = maxTransferFee
+
+	if len(msg.PaymentInfos) != 0 {
+		// client is providing payments receipts
+		err := k.ProcessPaymentInfos(ctx, msg.PaymentInfos, addr)
+		if err != nil {
+			return nil, sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, err.Error())
 		}
-		chainAmt := sdk.NewCoin(baseItemTransferFee.Denom, sdk.NewDecFromInt(tmpCookbookAmt.Amount).Mul(k.ItemTransferFeePercentage(ctx)).RoundInt())
-		cookbookAmt := sdk.NewCoin(baseItemTransferFee.Denom, itemTransferFeeAmt.Sub(chainAmt.Amount))
-		transferAmt := sdk.NewCoin(baseItemTransferFee.Denom, itemTransferFeeAmt.Sub(cookbookAmt.Amount).Sub(chainAmt.Amount))
-		outputChainTotAmt = outputChainTotAmt.Add(chainAmt)
-		outputTransferTotAmt = outputTransferTotAmt.Add(transferAmt)
-		outputCookbookOwnersTotAmtMap[item.CookbookId] = outputCookbookOwnersTotAmtMap[item.CookbookId].Add(cookbookAmt)
+	}
+
+	// check that sender has enough balance to pay coinInputs
+	balance := k.bankKeeper.SpendableCoins(ctx, addr)
+	if !balance.IsAllGTE(coinInputs) {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "not enough balance to pay for trade coinInputs")
 	}
 
 	tradeCreatorAddr, _ := sdk.AccAddressFromBech32(trade.Creator)
 	tradeFulfillerAddr, _ := sdk.AccAddressFromBech32(msg.Creator)
 	// transfer ownership of items
-	for _, item := range matchedInputItems {
+	for i, item := range fill.inputItems {
+		// only the amount required by the trade is taken out of a fungible item
+		if fill.itemInputs[i].Amount != 0 {
+			item, err = k.SplitItem(ctx, item, fill.itemInputs[i].Amount)
+			if err != nil {
+				return nil, err
+			}
+		}
 		item.Owner = trade.Creator
+		item.RecordTransfer(ctx)
 		k.UpdateItem(ctx, item, tradeFulfillerAddr)
+		item = k.MergeItem(ctx, item)
 		to, _ := k.GetUsernameByAddress(ctx, trade.Creator)
 		from, _ := k.GetUsernameByAddress(ctx, msg.Creator)
 		history := item.NewItemHistory(ctx, to.Value, from.Value)
 		k.SetItemHistory(ctx, history)
+		provenance := item.NewItemProvenance(ctx, types.ItemProvenanceTrade, msg.Creator, trade.Creator)
+		provenance.TradeId = trade.Id
+		provenance.Price = coinOutputs
+		k.AppendItemProvenance(ctx, provenance)
 	}
-	for _, item := range outputItems {
+	lockerAddr := k.TradesLockerAddress()
+	itemOutputsRefs := make([]types.ItemRef, len(fill.outputItems))
+	for i, item := range fill.outputItems {
+		// the filled units are taken out of a locked fungible item
+		if trade.ItemOutputs[i].Amount != 0 {
+			item, err = k.SplitItem(ctx, item, trade.ItemOutputs[i].Amount*fillAmount)
+			if err != nil {
+				return nil, err
+			}
+		}
+		itemOutputsRefs[i] = types.ItemRef{CookbookId: item.CookbookId, ItemId: item.Id, Amount: trade.ItemOutputs[i].Amount * fillAmount}
 		item.Owner = msg.Creator
-		k.UpdateItem(ctx, item, tradeCreatorAddr)
+		item.RecordTransfer(ctx)
+		k.UpdateItem(ctx, item, lockerAddr)
+		item = k.MergeItem(ctx, item)
 		to, _ := k.GetUsernameByAddress(ctx, msg.Creator)
 		from, _ := k.GetUsernameByAddress(ctx, trade.Creator)
 		history := item.NewItemHistory(ctx, to.Value, from.Value)
 		k.SetItemHistory(ctx, history)
+		provenance := item.NewItemProvenance(ctx, types.ItemProvenanceTrade, trade.Creator, msg.Creator)
+		provenance.TradeId = trade.Id
+		provenance.Price = coinInputs
+		k.AppendItemProvenance(ctx, provenance)
 	}
 
-	// send payments
-	err = k.PayFees(ctx, tradeFulfillerAddr, inputChainTotAmt)
+	// release the locked coinOutputs of the filled units
+	err = k.UnLockCoinsForTrade(ctx, tradeFulfillerAddr, coinOutputs)
 	if err != nil {
 		return nil, err
 	}
-	err = k.bankKeeper.SendCoins(ctx, tradeFulfillerAddr, tradeCreatorAddr, inputTransferTotAmt)
-	if err != nil {
-		return nil, err
-	}
-	for cookbookID, amt := range inputCookbookOwnersTotAmtMap {
-		cookbook, _ := k.GetCookbook(ctx, cookbookID)
-		addr, _ := sdk.AccAddressFromBech32(cookbook.Creator)
-		err = k.bankKeeper.SendCoins(ctx, tradeFulfillerAddr, addr, amt)
-		if err != nil {
-			return nil, err
-		}
-	}
 
-	err = k.PayFees(ctx, tradeCreatorAddr, outputChainTotAmt)
+	// send payments
+	err = k.payItemTransferFees(ctx, tradeFulfillerAddr, tradeCreatorAddr, fill.inputChainFees, fill.inputRoyalties, fill.inputTransfers)
 	if err != nil {
 		return nil, err
 	}
-	err = k.bankKeeper.SendCoins(ctx, tradeCreatorAddr, tradeFulfillerAddr, outputTransferTotAmt)
+	err = k.payItemTransferFees(ctx, tradeCreatorAddr, tradeFulfillerAddr, fill.outputChainFees, fill.outputRoyalties, fill.outputTransfers)
 	if err != nil {
 		return nil, err
 	}
-	for cookbookID, amt := range outputCookbookOwnersTotAmtMap {
-		cookbook, _ := k.GetCookbook(ctx, cookbookID)
-		addr, _ := sdk.AccAddressFromBech32(cookbook.Creator)
-		err = k.bankKeeper.SendCoins(ctx, tradeCreatorAddr, addr, amt)
-		if err != nil {
-			return nil, err
-		}
+
+	// a trade is closed once all its units are filled
+	if fillAmount == trade.RemainingUnits() {
+		k.RemoveTrade(ctx, trade.Id, tradeCreatorAddr)
+	} else {
+		trade.RemainingQuantity -= fillAmount
+		k.SetTrade(ctx, trade)
 	}
 
-	itemInputsRefs := make([]types.ItemRef, len(matchedInputItems))
-	for i, item := range matchedInputItems {
-		itemInputsRefs[i] = types.ItemRef{CookbookId: item.CookbookId, ItemId: item.Id}
+	itemInputsRefs := make([]types.ItemRef, len(fill.inputItems))
+	for i, item := range fill.inputItems {
+		itemInputsRefs[i] = types.ItemRef{CookbookId: item.CookbookId, ItemId: item.Id, Amount: fill.itemInputs[i].Amount}
 	}
 	err = ctx.EventManager().EmitTypedEvent(&types.EventFulfillTrade{
 		Id:           trade.Id,
@@ -259,9 +330,10 @@ func (k msgServer) FulfillTrade(goCtx context.Context, msg *types.MsgFulfillTrad
 		Fulfiller:    msg.Creator,
 		ItemInputs:   itemInputsRefs,
 		CoinInputs:   coinInputs,
-		ItemOutputs:  trade.ItemOutputs,
+		ItemOutputs:  itemOutputsRefs,
 		CoinOutputs:  coinOutputs,
 		PaymentInfos: msg.PaymentInfos,
+		FillAmount:   fillAmount,
 	})
 
 	telemetry.IncrCounter(1, "trade", "fulfill")
@@ -2,6 +2,7 @@ package keeper_test
 
 import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 
 	"github.com/Pylons-tech/pylons/x/pylons/keeper"
 	"github.com/Pylons-tech/pylons/x/pylons/types"
@@ -43,3 +44,274 @@ func (suite *IntegrationTestSuite) TestFulfillTradeMsgServerSimple() {
 		require.NoError(err)
 	}
 }
+
+func (suite *IntegrationTestSuite) TestFulfillTradeMsgServerPrivate() {
+	k := suite.k
+	ctx := suite.ctx
+	require := suite.Require()
+
+	wctx := sdk.WrapSDKContext(ctx)
+	srv := keeper.NewMsgServerImpl(k)
+
+	creator := types.GenTestBech32FromString("creator")
+	receiver := types.GenTestBech32FromString("receiver")
+	other := types.GenTestBech32FromString("other")
+
+	respCreate, err := srv.CreateTrade(wctx, &types.MsgCreateTrade{
+		Creator:   creator,
+		ExtraInfo: "extrainfo",
+		Receiver:  receiver,
+	})
+	require.NoError(err)
+	require.Equal(receiver, k.GetTrade(ctx, respCreate.Id).Receiver)
+
+	// only the receiver of a private trade can fulfill it
+	_, err = srv.FulfillTrade(wctx, &types.MsgFulfillTrade{Creator: other, Id: respCreate.Id})
+	require.ErrorIs(err, sdkerrors.ErrUnauthorized)
+	_, err = srv.FulfillTrade(wctx, &types.MsgFulfillTrade{Creator: receiver, Id: respCreate.Id})
+	require.NoError(err)
+
+	// a fulfilled trade is closed
+	require.False(k.HasTrade(ctx, respCreate.Id))
+	_, err = srv.FulfillTrade(wctx, &types.MsgFulfillTrade{Creator: receiver, Id: respCreate.Id})
+	require.ErrorIs(err, sdkerrors.ErrInvalidRequest)
+}
+
+func (suite *IntegrationTestSuite) TestFulfillTradeMsgServerExpired() {
+	k := suite.k
+	ctx := suite.ctx.WithBlockHeight(10)
+	require := suite.Require()
+
+	srv := keeper.NewMsgServerImpl(k)
+
+	creator := types.GenTestBech32FromString("creator")
+	fulfiller := types.GenTestBech32FromString("fulfiller")
+
+	respCreate, err := srv.CreateTrade(sdk.WrapSDKContext(ctx), &types.MsgCreateTrade{
+		Creator:         creator,
+		ExtraInfo:       "extrainfo",
+		ExpiresAtHeight: 11,
+	})
+	require.NoError(err)
+
+	// the trade can be fulfilled until it is cancelled at the end of its expiry block
+	ctx = ctx.WithBlockHeight(11)
+	_, err = srv.FulfillTrade(sdk.WrapSDKContext(ctx), &types.MsgFulfillTrade{Creator: fulfiller, Id: respCreate.Id})
+	require.ErrorIs(err, types.ErrTradeExpired)
+}
+
+func (suite *IntegrationTestSuite) TestFulfillTradeMsgServerPartialFill() {
+	k := suite.k
+	ctx := suite.ctx
+	require := suite.Require()
+
+	wctx := sdk.WrapSDKContext(ctx)
+	srv := keeper.NewMsgServerImpl(k)
+
+	creator := types.GenTestBech32FromString("creator")
+	creatorAddr, _ := sdk.AccAddressFromBech32(creator)
+	fulfiller := types.GenTestBech32FromString("fulfiller")
+	fulfillerAddr, _ := sdk.AccAddressFromBech32(fulfiller)
+	k.SetPylonsAccount(ctx, types.AccountAddr{Value: creator}, types.Username{Value: "creator"})
+	k.SetPylonsAccount(ctx, types.AccountAddr{Value: fulfiller}, types.Username{Value: "fulfiller"})
+	k.SetCookbook(ctx, types.Cookbook{Creator: creator, Id: "testCookbook"})
+
+	price := sdk.NewCoins(sdk.NewCoin("testCookbook/gold", sdk.NewInt(3)))
+	require.NoError(k.MintCoinsToAddr(ctx, creatorAddr, price.MulInt(sdk.NewInt(10))))
+	require.NoError(k.MintCoinsToAddr(ctx, fulfillerAddr, price.MulInt(sdk.NewInt(10))))
+
+	item := types.Item{
+		Owner:           creator,
+		CookbookId:      "testCookbook",
+		Tradeable:       true,
+		TradePercentage: sdk.ZeroDec(),
+		TransferFee:     price,
+		Fungible:        true,
+		Quantity:        10,
+	}
+	item.Id = k.AppendItem(ctx, item)
+
+	// the trade offers 3 units of 2 items for 3 coins each
+	respCreate, err := srv.CreateTrade(wctx, &types.MsgCreateTrade{
+		Creator:     creator,
+		CoinInputs:  []types.CoinInput{{Coins: price}},
+		ItemOutputs: []types.ItemRef{{CookbookId: item.CookbookId, ItemId: item.Id, Amount: 2}},
+		ExtraInfo:   "extrainfo",
+		Quantity:    3,
+	})
+	require.NoError(err)
+	source, _ := k.GetItem(ctx, item.CookbookId, item.Id)
+	require.Equal(uint64(4), source.Quantity)
+
+	_, err = srv.FulfillTrade(wctx, &types.MsgFulfillTrade{Creator: fulfiller, Id: respCreate.Id, FillAmount: 4})
+	require.ErrorIs(err, sdkerrors.ErrInvalidRequest)
+
+	_, err = srv.FulfillTrade(wctx, &types.MsgFulfillTrade{Creator: fulfiller, Id: respCreate.Id, FillAmount: 2})
+	require.NoError(err)
+	trade := k.GetTrade(ctx, respCreate.Id)
+	require.Equal(uint64(3), trade.Quantity)
+	require.Equal(uint64(1), trade.RemainingQuantity)
+	fulfillerItems := k.GetAllItemByOwner(ctx, fulfillerAddr)
+	require.Len(fulfillerItems, 1)
+	require.Equal(uint64(4), fulfillerItems[0].Quantity)
+
+	// the last unit closes the trade
+	_, err = srv.FulfillTrade(wctx, &types.MsgFulfillTrade{Creator: fulfiller, Id: respCreate.Id})
+	require.NoError(err)
+	require.False(k.HasTrade(ctx, respCreate.Id))
+	fulfillerItems = k.GetAllItemByOwner(ctx, fulfillerAddr)
+	require.Len(fulfillerItems, 1)
+	require.Equal(uint64(6), fulfillerItems[0].Quantity)
+	require.Len(k.GetAllItemByOwner(ctx, k.TradesLockerAddress()), 0)
+}
+
+func (suite *IntegrationTestSuite) TestFulfillTradeMsgServerPartialFillDuplicateItems() {
+	k := suite.k
+	ctx := suite.ctx
+	require := suite.Require()
+
+	wctx := sdk.WrapSDKContext(ctx)
+	srv := keeper.NewMsgServerImpl(k)
+
+	creator := types.GenTestBech32FromString("creator")
+	fulfiller := types.GenTestBech32FromString("fulfiller")
+	item := createFungibleItem(k, ctx, fulfiller, "testCookbook", 6)
+	id := k.AppendTrade(ctx, types.Trade{
+		Creator:           creator,
+		ItemInputs:        []types.ItemInput{{Id: "units", Amount: 4}},
+		CoinOutputs:       sdk.NewCoins(sdk.NewCoin(types.PylonsCoinDenom, sdk.NewInt(100))),
+		Quantity:          2,
+		RemainingQuantity: 2,
+	})
+
+	// the item holds enough units for a single fill, listing it twice for both fills is rejected
+	ref := types.ItemRef{CookbookId: item.CookbookId, ItemId: item.Id}
+	msg := &types.MsgFulfillTrade{Creator: fulfiller, Id: id, Items: []types.ItemRef{ref, ref}, FillAmount: 2}
+	require.ErrorIs(msg.ValidateBasic(), sdkerrors.ErrInvalidRequest)
+	_, err := srv.FulfillTrade(wctx, msg)
+	require.ErrorIs(err, sdkerrors.ErrInvalidRequest)
+	_, err = k.QuoteFulfillTrade(wctx, &types.QueryQuoteFulfillTradeRequest{Fulfiller: fulfiller, Id: id, Items: msg.Items, FillAmount: 2})
+	require.Error(err)
+	require.Equal(uint64(6), itemsQuantity(k, ctx, item.CookbookId))
+	require.Equal(uint64(2), k.GetTrade(ctx, id).RemainingQuantity)
+}
+
+func (suite *IntegrationTestSuite) TestFulfillTradeMsgServerDuplicateItems() {
+	k := suite.k
+	ctx := suite.ctx
+	require := suite.Require()
+
+	wctx := sdk.WrapSDKContext(ctx)
+	srv := keeper.NewMsgServerImpl(k)
+
+	creator := types.GenTestBech32FromString("creator")
+	fulfiller := types.GenTestBech32FromString("fulfiller")
+	k.SetPylonsAccount(ctx, types.AccountAddr{Value: creator}, types.Username{Value: "creator"})
+	k.SetPylonsAccount(ctx, types.AccountAddr{Value: fulfiller}, types.Username{Value: "fulfiller"})
+	item := createFungibleItem(k, ctx, fulfiller, "testCookbook", 6)
+	id := k.AppendTrade(ctx, types.Trade{
+		Creator:           creator,
+		ItemInputs:        []types.ItemInput{{Id: "first", Amount: 4}, {Id: "second", Amount: 4}},
+		CoinOutputs:       sdk.NewCoins(sdk.NewCoin(types.PylonsCoinDenom, sdk.NewInt(100))),
+		Quantity:          1,
+		RemainingQuantity: 1,
+	})
+	ref := types.ItemRef{CookbookId: item.CookbookId, ItemId: item.Id}
+	msg := &types.MsgFulfillTrade{Creator: fulfiller, Id: id, Items: []types.ItemRef{ref, ref}}
+	require.ErrorIs(msg.ValidateBasic(), sdkerrors.ErrInvalidRequest)
+
+	// the item is not matched twice to the trade itemInputs either
+	_, err := srv.FulfillTrade(wctx, msg)
+	require.ErrorIs(err, sdkerrors.ErrInvalidRequest)
+	require.Equal(uint64(6), itemsQuantity(k, ctx, item.CookbookId))
+}
+
+func (suite *IntegrationTestSuite) TestMatchItemInputsForTrade() {
+	k := suite.k
+	ctx := suite.ctx
+	require := suite.Require()
+
+	cookbooks := createNCookbook(k, ctx, 2)
+	fulfiller := types.GenTestBech32FromString("fulfiller")
+	newItem := func(cookbookID string, attack int64) types.ItemRef {
+		item := types.Item{
+			Owner:      fulfiller,
+			CookbookId: cookbookID,
+			Longs:      []types.LongKeyValue{{Key: "attack", Value: attack}},
+		}
+		item.Id = k.AppendItem(ctx, item)
+		return types.ItemRef{CookbookId: cookbookID, ItemId: item.Id}
+	}
+	weak := newItem(cookbooks[1].Id, 20)
+	strong := newItem(cookbooks[1].Id, 40)
+	other := newItem(cookbooks[0].Id, 40)
+
+	// any item of the second cookbook with attack > 30, and any item of the first cookbook
+	trade := types.Trade{
+		Id: 0,
+		ItemInputs: []types.ItemInput{
+			{CookbookId: cookbooks[1].Id, Condition: "attack > 30"},
+			{CookbookId: cookbooks[0].Id},
+		},
+	}
+
+	for _, tc := range []struct {
+		desc     string
+		itemRefs []types.ItemRef
+		matched  []types.ItemRef
+	}{
+		{desc: "Match", itemRefs: []types.ItemRef{strong, other}, matched: []types.ItemRef{strong, other}},
+		{desc: "MatchInAnyOrder", itemRefs: []types.ItemRef{other, strong}, matched: []types.ItemRef{strong, other}},
+		{desc: "ConditionNotMet", itemRefs: []types.ItemRef{weak, other}},
+		{desc: "WrongCookbook", itemRefs: []types.ItemRef{other, other}},
+	} {
+		tc := tc
+		suite.Run(tc.desc, func() {
+			items, err := k.MatchItemInputsForTrade(ctx, fulfiller, tc.itemRefs, trade)
+			if tc.matched == nil {
+				require.ErrorIs(err, sdkerrors.ErrInvalidRequest)
+				return
+			}
+			require.NoError(err)
+			for i, ref := range tc.matched {
+				require.Equal(ref.CookbookId, items[i].CookbookId)
+				require.Equal(ref.ItemId, items[i].Id)
+			}
+		})
+	}
+}
+
+func (suite *IntegrationTestSuite) TestFulfillTradeMsgServerItemsCount() {
+	k := suite.k
+	ctx := suite.ctx
+	require := suite.Require()
+
+	wctx := sdk.WrapSDKContext(ctx)
+	srv := keeper.NewMsgServerImpl(k)
+
+	creator := types.GenTestBech32FromString("creator")
+	fulfiller := types.GenTestBech32FromString("fulfiller")
+
+	// the items of the fulfiller bound the expansion of the trade itemInputs, whatever the trade quantity
+	id := k.AppendTrade(ctx, types.Trade{
+		Creator:           creator,
+		ItemInputs:        []types.ItemInput{{Id: "sword"}},
+		Quantity:          1 << 62,
+		RemainingQuantity: 1 << 62,
+	})
+	_, err := srv.FulfillTrade(wctx, &types.MsgFulfillTrade{Creator: fulfiller, Id: id, FillAmount: 1 << 61})
+	require.ErrorIs(err, sdkerrors.ErrInvalidRequest)
+	_, err = srv.FulfillTrade(wctx, &types.MsgFulfillTrade{
+		Creator:    fulfiller,
+		Id:         id,
+		Items:      []types.ItemRef{{CookbookId: "testCookbook", ItemId: "missing"}},
+		FillAmount: 1 << 61,
+	})
+	require.ErrorIs(err, sdkerrors.ErrInvalidRequest)
+
+	msg := types.NewMsgCreateTrade(creator, nil, nil, nil, nil, "extrainfo")
+	msg.Quantity = types.MaxTradeQuantity + 1
+	require.ErrorIs(msg.ValidateBasic(), sdkerrors.ErrInvalidRequest)
+	msg.Quantity = types.MaxTradeQuantity
+	require.NoError(msg.ValidateBasic())
+}
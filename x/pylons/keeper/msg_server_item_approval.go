@@ -0,0 +1,95 @@
+package keeper
+
+import (
+	"context"
+
+	"github.com/cosmos/cosmos-sdk/telemetry"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	"github.com/Pylons-tech/pylons/x/pylons/types"
+)
+
+func (k msgServer) ApproveItem(goCtx context.Context, msg *types.MsgApproveItem) (*types.MsgApproveItemResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	item, found := k.GetItem(ctx, msg.CookbookId, msg.ItemId)
+	if !found {
+		return nil, sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "item with id %v and cookbook id %v not found", msg.ItemId, msg.CookbookId)
+	}
+	if item.Owner != msg.Creator {
+		return nil, sdkerrors.Wrapf(sdkerrors.ErrUnauthorized, "item with id %v and cookbook id %v not owned", msg.ItemId, msg.CookbookId)
+	}
+
+	k.SetItemApproval(ctx, types.ItemApproval{
+		Owner:      msg.Creator,
+		CookbookId: msg.CookbookId,
+		ItemId:     msg.ItemId,
+		Operator:   msg.Operator,
+	})
+
+	err := ctx.EventManager().EmitTypedEvent(&types.EventApproveItem{
+		Owner:      msg.Creator,
+		CookbookId: msg.CookbookId,
+		ItemId:     msg.ItemId,
+		Operator:   msg.Operator,
+	})
+
+	telemetry.IncrCounter(1, "item", "approve")
+
+	return &types.MsgApproveItemResponse{}, err
+}
+
+func (k msgServer) RevokeItemApproval(goCtx context.Context, msg *types.MsgRevokeItemApproval) (*types.MsgRevokeItemApprovalResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	approval, found := k.GetItemApproval(ctx, msg.CookbookId, msg.ItemId)
+	if !found {
+		return nil, sdkerrors.Wrapf(sdkerrors.ErrKeyNotFound, "no approval for item with id %v and cookbook id %v", msg.ItemId, msg.CookbookId)
+	}
+	item, _ := k.GetItem(ctx, msg.CookbookId, msg.ItemId)
+	// the approval can be revoked by the current item owner, even if granted by a previous owner
+	if approval.Owner != msg.Creator && item.Owner != msg.Creator {
+		return nil, sdkerrors.Wrapf(sdkerrors.ErrUnauthorized, "item with id %v and cookbook id %v not owned", msg.ItemId, msg.CookbookId)
+	}
+
+	k.RemoveItemApproval(ctx, msg.CookbookId, msg.ItemId)
+
+	err := ctx.EventManager().EmitTypedEvent(&types.EventRevokeItemApproval{
+		Owner:      msg.Creator,
+		CookbookId: msg.CookbookId,
+		ItemId:     msg.ItemId,
+	})
+
+	telemetry.IncrCounter(1, "item", "revoke_approval")
+
+	return &types.MsgRevokeItemApprovalResponse{}, err
+}
+
+func (k msgServer) SetOperator(goCtx context.Context, msg *types.MsgSetOperator) (*types.MsgSetOperatorResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	if msg.Approved {
+		if _, found := k.GetCookbook(ctx, msg.CookbookId); !found {
+			return nil, sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "cookbook with id %v not found", msg.CookbookId)
+		}
+		k.SetItemOperator(ctx, types.ItemOperator{
+			Owner:      msg.Creator,
+			CookbookId: msg.CookbookId,
+			Operator:   msg.Operator,
+		})
+	} else {
+		k.RemoveItemOperator(ctx, msg.Creator, msg.CookbookId, msg.Operator)
+	}
+
+	err := ctx.EventManager().EmitTypedEvent(&types.EventSetOperator{
+		Owner:      msg.Creator,
+		CookbookId: msg.CookbookId,
+		Operator:   msg.Operator,
+		Approved:   msg.Approved,
+	})
+
+	telemetry.IncrCounter(1, "item", "set_operator")
+
+	return &types.MsgSetOperatorResponse{}, err
+}
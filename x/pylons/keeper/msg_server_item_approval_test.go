@@ -0,0 +1,130 @@
+package keeper_test
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	"github.com/Pylons-tech/pylons/x/pylons/keeper"
+	"github.com/Pylons-tech/pylons/x/pylons/types"
+)
+
+func (suite *IntegrationTestSuite) TestMsgServerApproveItem() {
+	k := suite.k
+	ctx := suite.ctx
+	require := suite.Require()
+
+	wctx := sdk.WrapSDKContext(ctx)
+	srv := keeper.NewMsgServerImpl(k)
+
+	cookbook := createNCookbook(k, ctx, 1)[0]
+	items := createNItemSameOwnerAndCookbook(k, ctx, 1, cookbook.Id, true)
+	owner := items[0].Owner
+	operator := types.GenTestBech32FromString("operator")
+
+	_, err := srv.ApproveItem(wctx, types.NewMsgApproveItem(operator, cookbook.Id, items[0].Id, operator))
+	require.ErrorIs(err, sdkerrors.ErrUnauthorized)
+	_, err = srv.ApproveItem(wctx, types.NewMsgApproveItem(owner, cookbook.Id, items[0].Id, operator))
+	require.NoError(err)
+	require.True(k.IsApprovedOrOwner(ctx, items[0], operator))
+
+	_, err = srv.RevokeItemApproval(wctx, types.NewMsgRevokeItemApproval(operator, cookbook.Id, items[0].Id))
+	require.ErrorIs(err, sdkerrors.ErrUnauthorized)
+	_, err = srv.RevokeItemApproval(wctx, types.NewMsgRevokeItemApproval(owner, cookbook.Id, items[0].Id))
+	require.NoError(err)
+	require.False(k.IsApprovedOrOwner(ctx, items[0], operator))
+	_, err = srv.RevokeItemApproval(wctx, types.NewMsgRevokeItemApproval(owner, cookbook.Id, items[0].Id))
+	require.ErrorIs(err, sdkerrors.ErrKeyNotFound)
+
+	_, err = srv.SetOperator(wctx, types.NewMsgSetOperator(owner, "not_found", operator, true))
+	require.ErrorIs(err, sdkerrors.ErrInvalidRequest)
+	_, err = srv.SetOperator(wctx, types.NewMsgSetOperator(owner, cookbook.Id, operator, true))
+	require.NoError(err)
+	require.True(k.IsApprovedOrOwner(ctx, items[0], operator))
+	_, err = srv.SetOperator(wctx, types.NewMsgSetOperator(owner, cookbook.Id, operator, false))
+	require.NoError(err)
+	require.False(k.IsApprovedOrOwner(ctx, items[0], operator))
+}
+
+func (suite *IntegrationTestSuite) TestMsgServerSendItemsOperator() {
+	k := suite.k
+	ctx := suite.ctx
+	require := suite.Require()
+
+	wctx := sdk.WrapSDKContext(ctx)
+	srv := keeper.NewMsgServerImpl(k)
+
+	cookbook := createNCookbook(k, ctx, 1)[0]
+	items := createNItemSameOwnerAndCookbook(k, ctx, 1, cookbook.Id, true)
+	owner := items[0].Owner
+	operator := types.GenTestBech32FromString("operator")
+	receiver := types.GenTestBech32FromString("receiver")
+	operatorAddr, _ := sdk.AccAddressFromBech32(operator)
+	k.SetPylonsAccount(ctx, types.AccountAddr{Value: owner}, types.Username{Value: "owner"})
+	k.SetPylonsAccount(ctx, types.AccountAddr{Value: receiver}, types.Username{Value: "receiver"})
+	// the operator pays the transfer fees
+	err := k.MintCoinsToAddr(ctx, operatorAddr, sdk.NewCoins(sdk.NewCoin(types.PylonsCoinDenom, sdk.NewInt(1000))))
+	require.NoError(err)
+
+	msg := &types.MsgSendItems{
+		Creator:  operator,
+		Receiver: receiver,
+		Items:    []types.ItemRef{{CookbookId: cookbook.Id, ItemId: items[0].Id}},
+	}
+	_, err = srv.SendItems(wctx, msg)
+	require.ErrorIs(err, sdkerrors.ErrInvalidRequest)
+
+	_, err = srv.ApproveItem(wctx, types.NewMsgApproveItem(owner, cookbook.Id, items[0].Id, operator))
+	require.NoError(err)
+	_, err = srv.SendItems(wctx, msg)
+	require.NoError(err)
+
+	item, _ := k.GetItem(ctx, cookbook.Id, items[0].Id)
+	require.Equal(receiver, item.Owner)
+	ownerAddr, _ := sdk.AccAddressFromBech32(owner)
+	require.Empty(k.GetAllItemByOwner(ctx, ownerAddr))
+	// the approval does not survive the transfer
+	_, found := k.GetItemApproval(ctx, cookbook.Id, items[0].Id)
+	require.False(found)
+}
+
+func (suite *IntegrationTestSuite) TestMsgServerCreateTradeOperator() {
+	k := suite.k
+	ctx := suite.ctx
+	require := suite.Require()
+
+	wctx := sdk.WrapSDKContext(ctx)
+	srv := keeper.NewMsgServerImpl(k)
+
+	cookbook := createNCookbook(k, ctx, 1)[0]
+	items := createNItemSameOwnerAndCookbook(k, ctx, 1, cookbook.Id, true)
+	owner := items[0].Owner
+	operator := types.GenTestBech32FromString("operator")
+	_, err := srv.SetOperator(wctx, types.NewMsgSetOperator(owner, cookbook.Id, operator, true))
+	require.NoError(err)
+
+	coinInputs := []types.CoinInput{{Coins: sdk.NewCoins(sdk.NewCoin(types.PylonsCoinDenom, sdk.NewInt(100)))}}
+	msg := &types.MsgCreateTrade{
+		Creator:     operator,
+		CoinInputs:  coinInputs,
+		ItemOutputs: []types.ItemRef{{CookbookId: cookbook.Id, ItemId: items[0].Id}},
+		CoinOutputs: sdk.NewCoins(sdk.NewCoin(types.PylonsCoinDenom, sdk.NewInt(10))),
+	}
+	// an operator cannot offer coins on behalf of the owner
+	_, err = srv.CreateTrade(wctx, msg)
+	require.ErrorIs(err, sdkerrors.ErrInvalidRequest)
+
+	msg.CoinOutputs = nil
+	// the trade would be created by the owner, so the owner cannot be its receiver
+	msg.Receiver = owner
+	_, err = srv.CreateTrade(wctx, msg)
+	require.ErrorIs(err, sdkerrors.ErrInvalidRequest)
+
+	msg.Receiver = ""
+	res, err := srv.CreateTrade(wctx, msg)
+	require.NoError(err)
+	// the trade is created on behalf of the owner, who receives its proceeds
+	trade := k.GetTrade(ctx, res.Id)
+	require.Equal(owner, trade.Creator)
+	item, _ := k.GetItem(ctx, cookbook.Id, items[0].Id)
+	require.Equal(k.TradesLockerAddress().String(), item.Owner)
+}
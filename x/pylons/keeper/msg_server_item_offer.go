@@ -0,0 +1,179 @@
+package keeper
+
+import (
+	"context"
+	"strconv"
+
+	"github.com/cosmos/cosmos-sdk/telemetry"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	"github.com/Pylons-tech/pylons/x/pylons/types"
+)
+
+func (k msgServer) CreateItemOffer(goCtx context.Context, msg *types.MsgCreateItemOffer) (*types.MsgCreateItemOfferResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	offer := types.ItemOffer{
+		Creator:         msg.Creator,
+		CookbookId:      msg.CookbookId,
+		ItemId:          msg.ItemId,
+		ItemInput:       msg.ItemInput,
+		Price:           msg.Price,
+		ExpiresAtHeight: msg.ExpiresAtHeight,
+		ExpiresAt:       msg.ExpiresAt,
+	}
+	if offer.IsExpired(ctx) {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "offer expiry already reached")
+	}
+
+	if _, found := k.GetCookbook(ctx, msg.CookbookId); !found {
+		return nil, sdkerrors.Wrapf(sdkerrors.ErrKeyNotFound, "cookbook %s doesn't exist", msg.CookbookId)
+	}
+	if msg.ItemId != "" {
+		item, found := k.GetItem(ctx, msg.CookbookId, msg.ItemId)
+		if !found {
+			return nil, sdkerrors.Wrapf(sdkerrors.ErrKeyNotFound, "item with id %v and cookbook id %v not found", msg.ItemId, msg.CookbookId)
+		}
+		if item.Owner == msg.Creator {
+			return nil, sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "cannot make an offer on an owned item")
+		}
+		if !item.Tradeable {
+			return nil, sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "item with id %v and cookbook id %v cannot be traded", msg.ItemId, msg.CookbookId)
+		}
+		if _, err := types.FindValidPaymentsPermutation([]types.Item{item}, msg.Price); err != nil {
+			return nil, sdkerrors.Wrap(sdkerrors.ErrInvalidCoins, "price cannot satisfy item transferFees requirements")
+		}
+	}
+
+	addr, _ := sdk.AccAddressFromBech32(msg.Creator)
+	err := k.LockCoinsForOffer(ctx, addr, msg.Price)
+	if err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, err.Error())
+	}
+
+	id := k.AppendItemOffer(ctx, offer)
+
+	err = ctx.EventManager().EmitTypedEvent(&types.EventCreateItemOffer{
+		Creator: msg.Creator,
+		Id:      id,
+	})
+
+	telemetry.IncrCounter(1, "item_offer", "create")
+
+	return &types.MsgCreateItemOfferResponse{Id: id}, err
+}
+
+func (k msgServer) AcceptItemOffer(goCtx context.Context, msg *types.MsgAcceptItemOffer) (*types.MsgAcceptItemOfferResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	offer, found := k.GetItemOffer(ctx, msg.Id)
+	if !found {
+		return nil, sdkerrors.Wrapf(sdkerrors.ErrKeyNotFound, "item offer %d doesn't exist", msg.Id)
+	}
+	if offer.IsExpired(ctx) {
+		return nil, sdkerrors.Wrapf(types.ErrItemOfferExpired, "item offer %d expired", msg.Id)
+	}
+	if offer.ItemId != "" && offer.ItemId != msg.ItemId {
+		return nil, sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "item offer %d is made for item with id %v", msg.Id, offer.ItemId)
+	}
+	if offer.Creator == msg.Creator {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "cannot accept an owned offer")
+	}
+
+	item, found := k.GetItem(ctx, offer.CookbookId, msg.ItemId)
+	if !found {
+		return nil, sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "item with id %v and cookbook id %v not found", msg.ItemId, offer.CookbookId)
+	}
+	if item.Owner != msg.Creator {
+		return nil, sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "item with id %v and cookbook id %v not owned", msg.ItemId, offer.CookbookId)
+	}
+	if !item.Tradeable {
+		return nil, sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "item with id %v and cookbook id %v cannot be traded", msg.ItemId, offer.CookbookId)
+	}
+	if item.IsExpired(ctx) {
+		return nil, sdkerrors.Wrapf(types.ErrItemExpired, "item with id %v and cookbook id %v expired", msg.ItemId, offer.CookbookId)
+	}
+	if err := item.CanTransfer(ctx); err != nil {
+		return nil, err
+	}
+
+	ec, err := k.NewCelEnvCollectionFromItem(ctx, "", strconv.FormatUint(offer.Id, 10), item)
+	if err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, err.Error())
+	}
+	if err = offer.ItemInput.MatchItem(item, ec); err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, err.Error())
+	}
+
+	permutation, err := types.FindValidPaymentsPermutation([]types.Item{item}, offer.Price)
+	if err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "offer price cannot pay for the item transfer fees")
+	}
+
+	// only the amount required by the offer is taken out of a fungible item
+	if offer.ItemInput.Amount != 0 {
+		item, err = k.SplitItem(ctx, item, offer.ItemInput.Amount)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	// the escrowed price pays the transfer fees and royalties of the item like an auction, and the rest goes to the seller
+	buyerAddr, _ := sdk.AccAddressFromBech32(offer.Creator)
+	sellerAddr, _ := sdk.AccAddressFromBech32(msg.Creator)
+	chainFees, royalties, proceeds := k.itemTransferFees(ctx, []types.Item{item}, permutation, offer.Price)
+	err = k.UnLockCoinsForOffer(ctx, buyerAddr, offer.Price)
+	if err != nil {
+		// this should never happen, it means the module account has been drained of funds illegitimately
+		panic(err)
+	}
+	err = k.payItemTransferFees(ctx, buyerAddr, sellerAddr, chainFees, royalties, proceeds)
+	if err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, err.Error())
+	}
+
+	itemRef := types.ItemRef{CookbookId: item.CookbookId, ItemId: item.Id, Amount: offer.ItemInput.Amount}
+	item.Owner = offer.Creator
+	item.RecordTransfer(ctx)
+	k.UpdateItem(ctx, item, sellerAddr)
+	item = k.MergeItem(ctx, item)
+	to, _ := k.GetUsernameByAddress(ctx, offer.Creator)
+	from, _ := k.GetUsernameByAddress(ctx, msg.Creator)
+	k.SetItemHistory(ctx, item.NewItemHistory(ctx, to.Value, from.Value))
+	provenance := item.NewItemProvenance(ctx, types.ItemProvenanceOffer, msg.Creator, offer.Creator)
+	provenance.Price = offer.Price
+	k.AppendItemProvenance(ctx, provenance)
+
+	k.RemoveItemOffer(ctx, offer)
+
+	err = ctx.EventManager().EmitTypedEvent(&types.EventAcceptItemOffer{
+		Creator: offer.Creator,
+		Id:      offer.Id,
+		Seller:  msg.Creator,
+		Item:    itemRef,
+		Price:   offer.Price,
+	})
+
+	telemetry.IncrCounter(1, "item_offer", "accept")
+
+	return &types.MsgAcceptItemOfferResponse{}, err
+}
+
+func (k msgServer) CancelItemOffer(goCtx context.Context, msg *types.MsgCancelItemOffer) (*types.MsgCancelItemOfferResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	offer, found := k.GetItemOffer(ctx, msg.Id)
+	if !found {
+		return nil, sdkerrors.Wrapf(sdkerrors.ErrKeyNotFound, "item offer %d doesn't exist", msg.Id)
+	}
+	if offer.Creator != msg.Creator {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrUnauthorized, "incorrect owner")
+	}
+
+	err := k.cancelItemOffer(ctx, offer, types.ItemOfferCancelReasonCancelled)
+
+	telemetry.IncrCounter(1, "item_offer", "cancel")
+
+	return &types.MsgCancelItemOfferResponse{}, err
+}
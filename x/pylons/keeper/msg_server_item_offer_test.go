@@ -0,0 +1,117 @@
+package keeper_test
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	"github.com/Pylons-tech/pylons/x/pylons/keeper"
+	"github.com/Pylons-tech/pylons/x/pylons/types"
+)
+
+func (suite *IntegrationTestSuite) TestMsgServerItemOffer() {
+	k := suite.k
+	bk := suite.bankKeeper
+	ctx := suite.ctx
+	require := suite.Require()
+
+	wctx := sdk.WrapSDKContext(ctx)
+	srv := keeper.NewMsgServerImpl(k)
+
+	cookbook := createNCookbook(k, ctx, 1)[0]
+	cookbookAddr, _ := sdk.AccAddressFromBech32(cookbook.Creator)
+	items := createNItemSameOwnerAndCookbook(k, ctx, 3, cookbook.Id, true)
+	for i := range items {
+		items[i].TradePercentage = sdk.NewDecWithPrec(1, 1)
+		items[i].Longs = []types.LongKeyValue{{Key: "level", Value: int64(1 + 4*i)}}
+		k.SetItem(ctx, items[i])
+	}
+	seller := items[0].Owner
+	sellerAddr, _ := sdk.AccAddressFromBech32(seller)
+	buyer := types.GenTestBech32FromString("buyer")
+	buyerAddr, _ := sdk.AccAddressFromBech32(buyer)
+	k.SetPylonsAccount(ctx, types.AccountAddr{Value: seller}, types.Username{Value: "seller"})
+	k.SetPylonsAccount(ctx, types.AccountAddr{Value: buyer}, types.Username{Value: "buyer"})
+	err := k.MintCoinsToAddr(ctx, buyerAddr, sdk.NewCoins(sdk.NewCoin(types.PylonsCoinDenom, sdk.NewInt(1000))))
+	require.NoError(err)
+	price := sdk.NewCoins(sdk.NewCoin(types.PylonsCoinDenom, sdk.NewInt(200)))
+	chainFee := func(royalty sdk.Int) sdk.Int {
+		return sdk.NewDecFromInt(royalty).Mul(k.ItemTransferFeePercentage(ctx)).RoundInt()
+	}
+
+	// an offer on a specific item must pay its transfer fee
+	_, err = srv.CreateItemOffer(wctx, types.NewMsgCreateItemOffer(buyer, cookbook.Id, items[0].Id, types.ItemInput{}, sdk.NewCoins(sdk.NewCoin(types.PylonsCoinDenom, sdk.NewInt(50)))))
+	require.ErrorIs(err, sdkerrors.ErrInvalidCoins)
+	_, err = srv.CreateItemOffer(wctx, types.NewMsgCreateItemOffer(seller, cookbook.Id, items[0].Id, types.ItemInput{}, price))
+	require.ErrorIs(err, sdkerrors.ErrInvalidRequest)
+	_, err = srv.CreateItemOffer(wctx, types.NewMsgCreateItemOffer(buyer, "missing", "", types.ItemInput{}, price))
+	require.ErrorIs(err, sdkerrors.ErrKeyNotFound)
+
+	res, err := srv.CreateItemOffer(wctx, types.NewMsgCreateItemOffer(buyer, cookbook.Id, items[0].Id, types.ItemInput{}, price))
+	require.NoError(err)
+	require.True(bk.GetBalance(ctx, buyerAddr, types.PylonsCoinDenom).Amount.Equal(sdk.NewInt(800)))
+
+	_, err = srv.AcceptItemOffer(wctx, types.NewMsgAcceptItemOffer(seller, res.Id, items[1].Id))
+	require.ErrorIs(err, sdkerrors.ErrInvalidRequest)
+	_, err = srv.AcceptItemOffer(wctx, types.NewMsgAcceptItemOffer(buyer, res.Id, items[0].Id))
+	require.ErrorIs(err, sdkerrors.ErrInvalidRequest)
+	_, err = srv.AcceptItemOffer(wctx, types.NewMsgAcceptItemOffer(seller, res.Id, items[0].Id))
+	require.NoError(err)
+	item, _ := k.GetItem(ctx, cookbook.Id, items[0].Id)
+	require.Equal(buyer, item.Owner)
+	require.True(bk.GetBalance(ctx, sellerAddr, types.PylonsCoinDenom).Amount.Equal(sdk.NewInt(180)))
+	require.True(bk.GetBalance(ctx, cookbookAddr, types.PylonsCoinDenom).Amount.Equal(sdk.NewInt(20).Sub(chainFee(sdk.NewInt(20)))))
+	_, found := k.GetItemOffer(ctx, res.Id)
+	require.False(found)
+
+	// an offer on the cookbook can be accepted with any item matching its item input
+	itemInput := types.ItemInput{Longs: []types.LongInputParam{{Key: "level", MinValue: 3, MaxValue: 6}}}
+	res, err = srv.CreateItemOffer(wctx, types.NewMsgCreateItemOffer(buyer, cookbook.Id, "", itemInput, price))
+	require.NoError(err)
+	_, err = srv.AcceptItemOffer(wctx, types.NewMsgAcceptItemOffer(seller, res.Id, items[0].Id))
+	require.ErrorIs(err, sdkerrors.ErrInvalidRequest)
+	_, err = srv.AcceptItemOffer(wctx, types.NewMsgAcceptItemOffer(seller, res.Id, items[2].Id))
+	require.ErrorIs(err, sdkerrors.ErrInvalidRequest)
+	_, err = srv.AcceptItemOffer(wctx, types.NewMsgAcceptItemOffer(seller, res.Id, items[1].Id))
+	require.NoError(err)
+	item, _ = k.GetItem(ctx, cookbook.Id, items[1].Id)
+	require.Equal(buyer, item.Owner)
+	require.True(bk.GetBalance(ctx, buyerAddr, types.PylonsCoinDenom).Amount.Equal(sdk.NewInt(600)))
+	require.True(bk.GetBalance(ctx, sellerAddr, types.PylonsCoinDenom).Amount.Equal(sdk.NewInt(360)))
+
+	provenance, _, err := k.GetItemProvenancePaginated(ctx, cookbook.Id, items[1].Id, nil)
+	require.NoError(err)
+	require.Equal(types.ItemProvenanceOffer, provenance[len(provenance)-1].Event)
+}
+
+func (suite *IntegrationTestSuite) TestMsgServerCancelItemOffer() {
+	k := suite.k
+	bk := suite.bankKeeper
+	ctx := suite.ctx
+	require := suite.Require()
+
+	wctx := sdk.WrapSDKContext(ctx)
+	srv := keeper.NewMsgServerImpl(k)
+
+	cookbook := createNCookbook(k, ctx, 1)[0]
+	buyer := types.GenTestBech32FromString("buyer")
+	buyerAddr, _ := sdk.AccAddressFromBech32(buyer)
+	price := sdk.NewCoins(sdk.NewCoin(types.PylonsCoinDenom, sdk.NewInt(200)))
+	err := k.MintCoinsToAddr(ctx, buyerAddr, price)
+	require.NoError(err)
+
+	res, err := srv.CreateItemOffer(wctx, types.NewMsgCreateItemOffer(buyer, cookbook.Id, "", types.ItemInput{}, price))
+	require.NoError(err)
+	_, err = srv.CreateItemOffer(wctx, types.NewMsgCreateItemOffer(buyer, cookbook.Id, "", types.ItemInput{}, price))
+	require.ErrorIs(err, sdkerrors.ErrInvalidRequest)
+
+	_, err = srv.CancelItemOffer(wctx, types.NewMsgCancelItemOffer(types.GenTestBech32FromString("other"), res.Id))
+	require.ErrorIs(err, sdkerrors.ErrUnauthorized)
+	_, err = srv.CancelItemOffer(wctx, types.NewMsgCancelItemOffer(buyer, res.Id+1))
+	require.ErrorIs(err, sdkerrors.ErrKeyNotFound)
+	_, err = srv.CancelItemOffer(wctx, types.NewMsgCancelItemOffer(buyer, res.Id))
+	require.NoError(err)
+
+	require.True(bk.GetBalance(ctx, buyerAddr, types.PylonsCoinDenom).Amount.Equal(price.AmountOf(types.PylonsCoinDenom)))
+	_, found := k.GetItemOffer(ctx, res.Id)
+	require.False(found)
+}
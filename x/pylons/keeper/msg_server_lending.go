@@ -0,0 +1,128 @@
+package keeper
+
+import (
+	"context"
+
+	"github.com/cosmos/cosmos-sdk/telemetry"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	"github.com/Pylons-tech/pylons/x/pylons/types"
+)
+
+func (k msgServer) CreateLending(goCtx context.Context, msg *types.MsgCreateLending) (*types.MsgCreateLendingResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	itemRef := msg.Item
+	item, found := k.GetItem(ctx, itemRef.CookbookId, itemRef.ItemId)
+	if !found {
+		return nil, sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "item with id %v and cookbook id %v not found", itemRef.ItemId, itemRef.CookbookId)
+	}
+	if item.Owner != msg.Creator {
+		return nil, sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "item with id %v and cookbook id %v not owned", itemRef.ItemId, itemRef.CookbookId)
+	}
+	if !item.Tradeable {
+		return nil, sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "item with id %v and cookbook id %v cannot be lent", itemRef.ItemId, itemRef.CookbookId)
+	}
+	if item.IsExpired(ctx) {
+		return nil, sdkerrors.Wrapf(types.ErrItemExpired, "item with id %v and cookbook id %v expired", itemRef.ItemId, itemRef.CookbookId)
+	}
+	if err := item.CanTransfer(ctx); err != nil {
+		return nil, err
+	}
+	if k.HasContainerItems(ctx, item.CookbookId, item.Id) {
+		return nil, sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "item with id %v and cookbook id %v holds items", itemRef.ItemId, itemRef.CookbookId)
+	}
+	// only the lent amount of a fungible item is locked, the lending references the split item
+	if itemRef.Amount != 0 {
+		var err error
+		item, err = k.SplitItem(ctx, item, itemRef.Amount)
+		if err != nil {
+			return nil, err
+		}
+		itemRef.ItemId = item.Id
+	}
+	k.LockItemForLending(ctx, item)
+
+	id := k.AppendLending(ctx, types.Lending{
+		Lender:   msg.Creator,
+		Borrower: msg.Borrower,
+		Item:     itemRef,
+		Price:    msg.Price,
+		Duration: msg.Duration,
+	})
+
+	err := ctx.EventManager().EmitTypedEvent(&types.EventCreateLending{
+		Creator: msg.Creator,
+		Id:      id,
+	})
+
+	telemetry.IncrCounter(1, "lending", "create")
+
+	return &types.MsgCreateLendingResponse{Id: id}, err
+}
+
+func (k msgServer) AcceptLending(goCtx context.Context, msg *types.MsgAcceptLending) (*types.MsgAcceptLendingResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	lending, found := k.GetLending(ctx, msg.Id)
+	if !found {
+		return nil, sdkerrors.Wrapf(sdkerrors.ErrKeyNotFound, "lending %d doesn't exist", msg.Id)
+	}
+	if lending.EndHeight != 0 {
+		return nil, sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "lending %d already accepted", msg.Id)
+	}
+	if lending.Lender == msg.Creator {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "an item cannot be lent to its owner")
+	}
+	if lending.Borrower != "" && lending.Borrower != msg.Creator {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrUnauthorized, "lending reserved to another borrower")
+	}
+
+	borrowerAddr, _ := sdk.AccAddressFromBech32(msg.Creator)
+	lenderAddr, _ := sdk.AccAddressFromBech32(lending.Lender)
+	err := k.bankKeeper.SendCoins(ctx, borrowerAddr, lenderAddr, lending.Price)
+	if err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, err.Error())
+	}
+
+	lending.Borrower = msg.Creator
+	lending.EndHeight = ctx.BlockHeight() + lending.Duration
+	k.SetLending(ctx, lending)
+
+	err = ctx.EventManager().EmitTypedEvent(&types.EventAcceptLending{
+		Borrower: msg.Creator,
+		Id:       msg.Id,
+	})
+
+	telemetry.IncrCounter(1, "lending", "accept")
+
+	return &types.MsgAcceptLendingResponse{}, err
+}
+
+func (k msgServer) CancelLending(goCtx context.Context, msg *types.MsgCancelLending) (*types.MsgCancelLendingResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	lending, found := k.GetLending(ctx, msg.Id)
+	if !found {
+		return nil, sdkerrors.Wrapf(sdkerrors.ErrKeyNotFound, "lending %d doesn't exist", msg.Id)
+	}
+	if lending.Lender != msg.Creator {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrUnauthorized, "incorrect owner")
+	}
+	// an accepted lending ends at its end height
+	if lending.EndHeight != 0 {
+		return nil, sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "lending %d already accepted", msg.Id)
+	}
+
+	k.EndLending(ctx, lending)
+
+	err := ctx.EventManager().EmitTypedEvent(&types.EventCancelLending{
+		Creator: msg.Creator,
+		Id:      msg.Id,
+	})
+
+	telemetry.IncrCounter(1, "lending", "cancel")
+
+	return &types.MsgCancelLendingResponse{}, err
+}
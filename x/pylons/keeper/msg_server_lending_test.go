@@ -0,0 +1,94 @@
+package keeper_test
+
+import (
+	"math"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	"github.com/Pylons-tech/pylons/x/pylons/keeper"
+	"github.com/Pylons-tech/pylons/x/pylons/types"
+)
+
+func (suite *IntegrationTestSuite) TestMsgServerLending() {
+	k := suite.k
+	bk := suite.bankKeeper
+	ctx := suite.ctx
+	require := suite.Require()
+
+	wctx := sdk.WrapSDKContext(ctx)
+	srv := keeper.NewMsgServerImpl(k)
+
+	cookbook := createNCookbook(k, ctx, 1)[0]
+	items := createNItemSameOwnerAndCookbook(k, ctx, 4, cookbook.Id, true)
+	lender := items[0].Owner
+	lenderAddr, _ := sdk.AccAddressFromBech32(lender)
+	borrower := types.GenTestBech32FromString("borrower")
+	borrowerAddr, _ := sdk.AccAddressFromBech32(borrower)
+	price := sdk.NewCoins(sdk.NewCoin(types.PylonsCoinDenom, sdk.NewInt(50)))
+	err := k.MintCoinsToAddr(ctx, borrowerAddr, price)
+	require.NoError(err)
+	lockerAddr := k.LendingsLockerAddress().String()
+
+	// a lending reserved to another borrower cannot be accepted
+	res, err := srv.CreateLending(wctx, types.NewMsgCreateLending(lender, types.ItemRef{CookbookId: cookbook.Id, ItemId: items[1].Id}, types.GenTestBech32FromString("other"), nil, 10))
+	require.NoError(err)
+	_, err = srv.AcceptLending(wctx, types.NewMsgAcceptLending(borrower, res.Id))
+	require.ErrorIs(err, sdkerrors.ErrUnauthorized)
+	// cancelling returns the item to the lender
+	_, err = srv.CancelLending(wctx, types.NewMsgCancelLending(borrower, res.Id))
+	require.ErrorIs(err, sdkerrors.ErrUnauthorized)
+	_, err = srv.CancelLending(wctx, types.NewMsgCancelLending(lender, res.Id))
+	require.NoError(err)
+	item, _ := k.GetItem(ctx, cookbook.Id, items[1].Id)
+	require.Equal(lender, item.Owner)
+	_, found := k.GetLending(ctx, res.Id)
+	require.False(found)
+
+	_, err = srv.CreateLending(wctx, types.NewMsgCreateLending(borrower, types.ItemRef{CookbookId: cookbook.Id, ItemId: items[0].Id}, "", price, 10))
+	require.ErrorIs(err, sdkerrors.ErrInvalidRequest)
+	// a container holding items cannot be lent
+	_, err = srv.DepositItems(wctx, types.NewMsgDepositItems(lender, cookbook.Id, items[2].Id, []string{items[3].Id}))
+	require.NoError(err)
+	_, err = srv.CreateLending(wctx, types.NewMsgCreateLending(lender, types.ItemRef{CookbookId: cookbook.Id, ItemId: items[2].Id}, "", price, 10))
+	require.ErrorIs(err, sdkerrors.ErrInvalidRequest)
+
+	// the end height of a lending cannot overflow
+	msg := types.NewMsgCreateLending(lender, types.ItemRef{CookbookId: "testCookbook", ItemId: items[0].Id}, "", price, math.MaxInt64)
+	require.ErrorIs(msg.ValidateBasic(), sdkerrors.ErrInvalidRequest)
+	msg.Duration = types.MaxLendingDuration
+	require.NoError(msg.ValidateBasic())
+
+	res, err = srv.CreateLending(wctx, types.NewMsgCreateLending(lender, types.ItemRef{CookbookId: cookbook.Id, ItemId: items[0].Id}, "", price, 10))
+	require.NoError(err)
+	item, _ = k.GetItem(ctx, cookbook.Id, items[0].Id)
+	require.Equal(lockerAddr, item.Owner)
+
+	_, err = srv.AcceptLending(wctx, types.NewMsgAcceptLending(lender, res.Id))
+	require.ErrorIs(err, sdkerrors.ErrInvalidRequest)
+	_, err = srv.AcceptLending(wctx, types.NewMsgAcceptLending(borrower, res.Id))
+	require.NoError(err)
+	require.True(bk.GetBalance(ctx, lenderAddr, types.PylonsCoinDenom).Amount.Equal(sdk.NewInt(50)))
+	require.True(bk.GetBalance(ctx, borrowerAddr, types.PylonsCoinDenom).Amount.IsZero())
+
+	lending, found := k.GetLending(ctx, res.Id)
+	require.True(found)
+	require.Equal(borrower, lending.Borrower)
+	require.Equal(ctx.BlockHeight()+10, lending.EndHeight)
+	require.True(k.IsItemBorrowedBy(ctx, item, borrower))
+	require.False(k.IsItemBorrowedBy(ctx, item, lender))
+	// the item is no longer borrowed from the end height, before the lending is ended
+	require.False(k.IsItemBorrowedBy(ctx.WithBlockHeight(lending.EndHeight), item, borrower))
+
+	// an accepted lending can be neither accepted again nor cancelled
+	_, err = srv.AcceptLending(wctx, types.NewMsgAcceptLending(borrower, res.Id))
+	require.ErrorIs(err, sdkerrors.ErrInvalidRequest)
+	_, err = srv.CancelLending(wctx, types.NewMsgCancelLending(lender, res.Id))
+	require.ErrorIs(err, sdkerrors.ErrInvalidRequest)
+
+	require.Equal([]types.Lending{lending}, k.GetLendingsEndedAtBlockHeight(ctx, lending.EndHeight, types.MaxEndedLendingsPerBlock))
+	k.EndLending(ctx, lending)
+	item, _ = k.GetItem(ctx, cookbook.Id, items[0].Id)
+	require.Equal(lender, item.Owner)
+	require.False(k.IsItemBorrowedBy(ctx, item, borrower))
+}
@@ -29,6 +29,9 @@ func (k msgServer) CreateRecipe(goCtx context.Context, msg *types.MsgCreateRecip
 	if cookbook.Creator != msg.Creator {
 		return nil, sdkerrors.Wrap(sdkerrors.ErrUnauthorized, "incorrect owner")
 	}
+	if err = cookbook.ValidateRecipeEntries(msg.Entries); err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, err.Error())
+	}
 
 	recipe := types.Recipe{
 		Id:            msg.Id,
@@ -86,6 +89,9 @@ func (k msgServer) UpdateRecipe(goCtx context.Context, msg *types.MsgUpdateRecip
 	if cookbook.Creator != msg.Creator {
 		return nil, sdkerrors.Wrap(sdkerrors.ErrUnauthorized, "user does not own the cookbook")
 	}
+	if err := cookbook.ValidateRecipeEntries(msg.Entries); err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, err.Error())
+	}
 
 	updatedRecipe := types.Recipe{
 		Id:            msg.Id,
@@ -320,3 +320,110 @@ func (suite *IntegrationTestSuite) TestRecipeMsgServerUpdate() {
 		})
 	}
 }
+
+func (suite *IntegrationTestSuite) TestRecipeMsgServerAttributeSchema() {
+	k := suite.k
+	ctx := suite.ctx
+	require := suite.Require()
+
+	srv := keeper.NewMsgServerImpl(k)
+	wctx := sdk.WrapSDKContext(ctx)
+
+	creator := types.GenTestBech32FromString("schema")
+	cookbookID := "schemaCookbook"
+	_, err := srv.CreateCookbook(wctx, &types.MsgCreateCookbook{
+		Creator:      creator,
+		Id:           cookbookID,
+		Name:         "testCookbookName",
+		Description:  "descdescdescdescdescdesc",
+		Version:      "v0.0.1",
+		SupportEmail: "test@email.com",
+		AttributeSchema: []types.ItemAttributeSchema{
+			{Key: "image", Type: types.AttributeTypeString, Required: true, Format: types.AttributeFormatURL},
+			{Key: "level", Type: types.AttributeTypeLong, Min: "1", Max: "10"},
+			{Key: "nickname", Type: types.AttributeTypeString},
+		},
+	})
+	require.NoError(err)
+
+	itemOutput := func(image string, level int64, extra ...types.StringParam) types.ItemOutput {
+		return types.ItemOutput{
+			Id:      "sword",
+			Longs:   []types.LongParam{{Key: "level", WeightRanges: []types.IntWeightRange{{Lower: level, Upper: level, Weight: 1}}}},
+			Strings: append([]types.StringParam{{Key: "image", Value: image}}, extra...),
+		}
+	}
+	createRecipe := func(id string, output types.ItemOutput) error {
+		_, err := srv.CreateRecipe(wctx, &types.MsgCreateRecipe{
+			Creator:      creator,
+			CookbookId:   cookbookID,
+			Id:           id,
+			Name:         "testRecipeName",
+			Description:  "decdescdescdescdescdescdescdesc",
+			Version:      "v0.0.1",
+			Entries:      types.EntriesList{ItemOutputs: []types.ItemOutput{output}},
+			CostPerBlock: sdk.Coin{Denom: "test", Amount: sdk.ZeroInt()},
+		})
+		return err
+	}
+
+	for _, tc := range []struct {
+		desc   string
+		output types.ItemOutput
+		err    error
+	}{
+		{
+			desc:   "Valid",
+			output: itemOutput("https://example.com/sword.png", 5),
+		},
+		{
+			desc:   "UndeclaredKey",
+			output: itemOutput("https://example.com/sword.png", 5, types.StringParam{Key: "imgae", Value: "https://example.com/sword.png"}),
+			err:    sdkerrors.ErrInvalidRequest,
+		},
+		{
+			desc:   "WrongType",
+			output: types.ItemOutput{Id: "sword", Strings: []types.StringParam{{Key: "image", Value: "https://example.com/sword.png"}, {Key: "level", Value: "5"}}},
+			err:    sdkerrors.ErrInvalidRequest,
+		},
+		{
+			desc:   "OutOfBounds",
+			output: itemOutput("https://example.com/sword.png", 11),
+			err:    sdkerrors.ErrInvalidRequest,
+		},
+		{
+			desc:   "InvalidURL",
+			output: itemOutput("sword.png", 5),
+			err:    sdkerrors.ErrInvalidRequest,
+		},
+		{
+			desc:   "MissingRequired",
+			output: types.ItemOutput{Id: "sword"},
+			err:    sdkerrors.ErrInvalidRequest,
+		},
+	} {
+		tc := tc
+		suite.Run(tc.desc, func() {
+			err := createRecipe(tc.desc, tc.output)
+			if tc.err != nil {
+				require.ErrorIs(err, tc.err)
+			} else {
+				require.NoError(err)
+			}
+		})
+	}
+
+	// mutable strings set by the item owner are validated against the schema
+	creatorAddr, _ := sdk.AccAddressFromBech32(creator)
+	require.NoError(k.MintCoinsToAddr(ctx, creatorAddr, sdk.NewCoins(k.UpdateItemStringFee(ctx))))
+	id := k.AppendItem(ctx, types.Item{
+		Owner:          creator,
+		CookbookId:     cookbookID,
+		Strings:        []types.StringKeyValue{{Key: "image", Value: "https://example.com/sword.png"}},
+		MutableStrings: []types.StringKeyValue{{Key: "nickname"}, {Key: "title"}},
+	})
+	_, err = srv.SetItemString(wctx, &types.MsgSetItemString{Creator: creator, CookbookId: cookbookID, Id: id, Field: "title", Value: "hero"})
+	require.ErrorIs(err, sdkerrors.ErrInvalidRequest)
+	_, err = srv.SetItemString(wctx, &types.MsgSetItemString{Creator: creator, CookbookId: cookbookID, Id: id, Field: "nickname", Value: "hero"})
+	require.NoError(err)
+}
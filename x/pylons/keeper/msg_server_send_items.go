@@ -17,6 +17,7 @@ func (k msgServer) SendItems(goCtx context.Context, msg *types.MsgSendItems) (*t
 	// STATEFUL CHECKS
 	itemsByCookbook := make(map[string][]types.Item)
 	items := make([]types.Item, 0)
+	amounts := make([]uint64, 0)
 	for _, itemRef := range msg.Items {
 		// check it item exists and if it is owned by message creator
 		item, found := k.Keeper.GetItem(ctx, itemRef.CookbookId, itemRef.ItemId)
@@ -24,8 +25,8 @@ func (k msgServer) SendItems(goCtx context.Context, msg *types.MsgSendItems) (*t
 			return nil, sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "item in cookbook %v with ID %v does not exist", itemRef.CookbookId, itemRef.ItemId)
 		}
 
-		// check if item is owned by msg.Creator if not ERROR
-		if item.Owner != msg.Creator {
+		// check if item is owned by msg.Creator or if msg.Creator is an approved operator, if not ERROR
+		if !k.IsApprovedOrOwner(ctx, item, msg.Creator) {
 			return nil, sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "Item in cookbook %v with ID %v not owned by sender", item.CookbookId, item.Id)
 		}
 
@@ -33,11 +34,24 @@ func (k msgServer) SendItems(goCtx context.Context, msg *types.MsgSendItems) (*t
 			return nil, sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "Item in cookbook %v with ID %v cannot be traded", item.CookbookId, item.Id)
 		}
 
+		if item.IsExpired(ctx) {
+			return nil, sdkerrors.Wrapf(types.ErrItemExpired, "Item in cookbook %v with ID %v expired", item.CookbookId, item.Id)
+		}
+
+		if err := item.CanTransfer(ctx); err != nil {
+			return nil, err
+		}
+
+		// a partial amount can only be sent out of a fungible item
+		if itemRef.Amount != 0 && (!item.Fungible || itemRef.Amount > item.Quantity) {
+			return nil, sdkerrors.Wrapf(types.ErrItemQuantity, "cannot send %d units of item in cookbook %v with ID %v", itemRef.Amount, item.CookbookId, item.Id)
+		}
+
 		itemsByCookbook[item.CookbookId] = append(itemsByCookbook[item.CookbookId], item)
 		items = append(items, item)
+		amounts = append(amounts, itemRef.Amount)
 	}
 
-	transferFees := make(map[string]sdk.Coins)
 	// get sender balance
 	addr, _ := sdk.AccAddressFromBech32(msg.Creator)
 	balance := k.bankKeeper.SpendableCoins(ctx, addr)
@@ -48,54 +62,34 @@ func (k msgServer) SendItems(goCtx context.Context, msg *types.MsgSendItems) (*t
 
 	// change owner of items to receiver and re-set in store
 	for idx, item := range items {
+		if amounts[idx] != 0 {
+			item, err = k.SplitItem(ctx, item, amounts[idx])
+			if err != nil {
+				return nil, err
+			}
+		}
+		// the item is sent from its owner, which is not the sender when sent by an operator
+		owner := item.Owner
 		item.Owner = msg.Receiver
-		senderAddr, err := sdk.AccAddressFromBech32(msg.Creator)
+		item.RecordTransfer(ctx)
+		ownerAddr, err := sdk.AccAddressFromBech32(owner)
 		if err != nil {
-			return nil, sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, "invalid sender address")
+			return nil, sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, "invalid owner address")
 		}
-		k.Keeper.UpdateItem(ctx, item, senderAddr)
+		k.Keeper.UpdateItem(ctx, item, ownerAddr)
+		item = k.MergeItem(ctx, item)
 		to, _ := k.GetUsernameByAddress(ctx, msg.Receiver)
-		from, _ := k.GetUsernameByAddress(ctx, msg.Creator)
+		from, _ := k.GetUsernameByAddress(ctx, owner)
 		history := item.NewItemHistory(ctx, to.Value, from.Value)
 		k.SetItemHistory(ctx, history)
-		transferFeeIdx := permutation[idx]
-		transferFees[item.CookbookId] = transferFees[item.CookbookId].Add(item.TransferFee[transferFeeIdx])
+		k.AppendItemProvenance(ctx, item.NewItemProvenance(ctx, types.ItemProvenanceSend, owner, msg.Receiver))
 	}
 
-	// Calculate fee and pay it to cookbook owners and module account
-	for cookbookID, cookbookTransferFees := range transferFees {
-		var cookbookOwnerFees, modAccFees sdk.Coins
-		cookbook, _ := k.GetCookbook(ctx, cookbookID)
-		cookbookOwnerAddr, _ := sdk.AccAddressFromBech32(cookbook.Creator)
-		senderAddr, _ := sdk.AccAddressFromBech32(msg.Creator)
-		// clamp transferFee between min and max allowed and separate amounts to be sent to payee and module account
-
-		minTransferFee := k.MinTransferFee(ctx)
-		maxTransferFee := k.MaxTransferFee(ctx)
-		itemTransferFeePercentage := k.ItemTransferFeePercentage(ctx)
-
-		for _, coin := range cookbookTransferFees {
-			if coin.Amount.LT(minTransferFee) {
-				coin.Amount = minTransferFee
-			} else if coin.Amount.GT(maxTransferFee) {
-				coin.Amount = maxTransferFee
-			}
-			// separate fees to account for percentage to be retained by module account
-			modAccAmt := sdk.NewDecFromInt(coin.Amount).Mul(itemTransferFeePercentage).RoundInt()
-			coin.Amount = coin.Amount.Sub(modAccAmt)
-			cookbookOwnerFees = cookbookOwnerFees.Add(coin)
-			coin.Amount = modAccAmt
-			modAccFees = modAccFees.Add(coin)
-		}
-
-		err := k.bankKeeper.SendCoins(ctx, senderAddr, cookbookOwnerAddr, cookbookOwnerFees)
-		if err != nil {
-			return nil, sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, err.Error())
-		}
-		err = k.PayFees(ctx, senderAddr, modAccFees)
-		if err != nil {
-			return nil, sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, err.Error())
-		}
+	// pay the transfer fees to the cookbook owners and module account
+	chainFees, royalties := k.sentItemsTransferFees(ctx, items, permutation)
+	err = k.payItemTransferFees(ctx, addr, addr, chainFees, royalties, nil)
+	if err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, err.Error())
 	}
 
 	err = ctx.EventManager().EmitTypedEvent(&types.EventSendItems{
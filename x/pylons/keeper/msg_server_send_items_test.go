@@ -208,3 +208,119 @@ func (suite *IntegrationTestSuite) TestMsgServerSendItemsNonTradable() {
 		})
 	}
 }
+
+func (suite *IntegrationTestSuite) TestMsgServerSendItemsFungible() {
+	k := suite.k
+	ctx := suite.ctx
+	require := suite.Require()
+
+	wctx := sdk.WrapSDKContext(ctx)
+	srv := keeper.NewMsgServerImpl(k)
+
+	cookbook := createNCookbook(k, ctx, 1)[0]
+	owner := types.GenTestBech32FromString("owner")
+	receiver := types.GenTestBech32FromString("receiver")
+	ownerAddr, _ := sdk.AccAddressFromBech32(owner)
+	receiverAddr, _ := sdk.AccAddressFromBech32(receiver)
+	k.SetPylonsAccount(ctx, types.AccountAddr{Value: owner}, types.Username{Value: "owner"})
+	k.SetPylonsAccount(ctx, types.AccountAddr{Value: receiver}, types.Username{Value: "receiver"})
+	err := k.MintCoinsToAddr(ctx, ownerAddr, sdk.NewCoins(sdk.NewCoin(types.PylonsCoinDenom, sdk.NewInt(1000))))
+	require.NoError(err)
+
+	item := types.Item{
+		Owner:           owner,
+		CookbookId:      cookbook.Id,
+		TransferFee:     []sdk.Coin{sdk.NewCoin(types.PylonsCoinDenom, sdk.NewInt(100))},
+		Tradeable:       true,
+		TradePercentage: sdk.ZeroDec(),
+		Fungible:        true,
+		Quantity:        10,
+	}
+	item.Id = k.AppendItem(ctx, item)
+
+	for _, tc := range []struct {
+		desc             string
+		amount           uint64
+		ownerQuantity    uint64
+		receiverQuantity uint64
+		err              error
+	}{
+		{desc: "AmountExceedsQuantity", amount: 11, err: types.ErrItemQuantity},
+		{desc: "Partial", amount: 4, ownerQuantity: 6, receiverQuantity: 4},
+		{desc: "PartialStacked", amount: 5, ownerQuantity: 1, receiverQuantity: 9},
+	} {
+		tc := tc
+		suite.Run(tc.desc, func() {
+			_, err := srv.SendItems(wctx, &types.MsgSendItems{
+				Creator:  owner,
+				Receiver: receiver,
+				Items:    []types.ItemRef{{CookbookId: item.CookbookId, ItemId: item.Id, Amount: tc.amount}},
+			})
+			if tc.err != nil {
+				require.ErrorIs(err, tc.err)
+				return
+			}
+			require.NoError(err)
+
+			source, _ := k.GetItem(ctx, item.CookbookId, item.Id)
+			require.Equal(tc.ownerQuantity, source.Quantity)
+			// units received are always stacked in a single item
+			receiverItems := k.GetAllItemByOwner(ctx, receiverAddr)
+			require.Len(receiverItems, 1)
+			require.Equal(tc.receiverQuantity, receiverItems[0].Quantity)
+		})
+	}
+}
+
+func (suite *IntegrationTestSuite) TestMsgServerSendItemsTransferPolicy() {
+	k := suite.k
+	ctx := suite.ctx.WithBlockHeight(10)
+	require := suite.Require()
+
+	srv := keeper.NewMsgServerImpl(k)
+
+	cookbook := createNCookbook(k, ctx, 1)[0]
+	owner := types.GenTestBech32FromString("owner")
+	receiver := types.GenTestBech32FromString("receiver")
+	k.SetPylonsAccount(ctx, types.AccountAddr{Value: owner}, types.Username{Value: "owner"})
+	k.SetPylonsAccount(ctx, types.AccountAddr{Value: receiver}, types.Username{Value: "receiver"})
+	for _, addr := range []string{owner, receiver} {
+		acc, _ := sdk.AccAddressFromBech32(addr)
+		require.NoError(k.MintCoinsToAddr(ctx, acc, sdk.NewCoins(sdk.NewCoin(types.PylonsCoinDenom, sdk.NewInt(1000)))))
+	}
+
+	newItem := func(policy types.ItemTransferPolicy) types.ItemRef {
+		item := types.Item{
+			Owner:              owner,
+			CookbookId:         cookbook.Id,
+			Tradeable:          true,
+			TransferFee:        []sdk.Coin{sdk.NewCoin(types.PylonsCoinDenom, sdk.NewInt(100))},
+			TradePercentage:    sdk.ZeroDec(),
+			TransferPolicy:     policy,
+			LastTransferHeight: ctx.BlockHeight(),
+		}
+		return types.ItemRef{CookbookId: cookbook.Id, ItemId: k.AppendItem(ctx, item)}
+	}
+	send := func(ctx sdk.Context, from, to string, ref types.ItemRef) error {
+		_, err := srv.SendItems(sdk.WrapSDKContext(ctx), &types.MsgSendItems{Creator: from, Receiver: to, Items: []types.ItemRef{ref}})
+		return err
+	}
+
+	// soulbound items never leave their first owner
+	soulbound := newItem(types.ItemTransferPolicy{Soulbound: true})
+	require.ErrorIs(send(ctx, owner, receiver, soulbound), types.ErrItemTransferRestricted)
+
+	// items with a maximum transfer count stop moving once reached
+	limited := newItem(types.ItemTransferPolicy{MaxTransfers: 1})
+	require.NoError(send(ctx, owner, receiver, limited))
+	item, _ := k.GetItem(ctx, limited.CookbookId, limited.ItemId)
+	require.Equal(uint64(1), item.TransferCount)
+	require.ErrorIs(send(ctx, receiver, owner, limited), types.ErrItemTransferRestricted)
+
+	// transfer locked items can move again once the lock ends, which restarts the lock
+	locked := newItem(types.ItemTransferPolicy{LockBlocks: 5})
+	require.ErrorIs(send(ctx, owner, receiver, locked), types.ErrItemTransferRestricted)
+	require.NoError(send(ctx.WithBlockHeight(15), owner, receiver, locked))
+	require.ErrorIs(send(ctx.WithBlockHeight(19), receiver, owner, locked), types.ErrItemTransferRestricted)
+	require.NoError(send(ctx.WithBlockHeight(20), receiver, owner, locked))
+}
@@ -21,6 +21,11 @@ func (k msgServer) SetItemString(goCtx context.Context, msg *types.MsgSetItemStr
 		return nil, sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "unauthorized")
 	}
 
+	cookbook, _ := k.GetCookbook(ctx, msg.CookbookId)
+	if err := cookbook.ValidateStringAttribute(msg.Field, msg.Value); err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, err.Error())
+	}
+
 	originalMutableStrings := make([]types.StringKeyValue, len(item.MutableStrings))
 	copy(originalMutableStrings, item.MutableStrings)
 
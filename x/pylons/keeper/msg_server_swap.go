@@ -0,0 +1,277 @@
+package keeper
+
+import (
+	"context"
+	"sort"
+
+	"github.com/cosmos/cosmos-sdk/telemetry"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	"github.com/Pylons-tech/pylons/x/pylons/types"
+)
+
+// depositSwap checks that a participant owns its committed items and coins, and locks them along with the transfer
+// fees of the items until the swap is settled or aborted. The committed amount of a fungible item is split from it,
+// and the locked items are recorded in the deposited items of the participant
+func (k Keeper) depositSwap(ctx sdk.Context, participant *types.SwapParticipant) error {
+	items := make([]types.Item, 0, len(participant.Items))
+	for _, itemRef := range participant.Items {
+		item, found := k.GetItem(ctx, itemRef.CookbookId, itemRef.ItemId)
+		if !found {
+			return sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "item with id %v and cookbook id %v not found", itemRef.ItemId, itemRef.CookbookId)
+		}
+		if item.Owner != participant.Address {
+			return sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "item with id %v and cookbook id %v not owned", itemRef.ItemId, itemRef.CookbookId)
+		}
+		if !item.Tradeable {
+			return sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "item with id %v and cookbook id %v cannot be traded", itemRef.ItemId, itemRef.CookbookId)
+		}
+		if item.IsExpired(ctx) {
+			return sdkerrors.Wrapf(types.ErrItemExpired, "item with id %v and cookbook id %v expired", itemRef.ItemId, itemRef.CookbookId)
+		}
+		if err := item.CanTransfer(ctx); err != nil {
+			return err
+		}
+		// a partial amount can only be swapped out of a fungible item
+		if itemRef.Amount != 0 && (!item.Fungible || itemRef.Amount > item.Quantity) {
+			return sdkerrors.Wrapf(types.ErrItemQuantity, "cannot swap %d units of item in cookbook %v with ID %v", itemRef.Amount, item.CookbookId, item.Id)
+		}
+		items = append(items, item)
+	}
+
+	addr, _ := sdk.AccAddressFromBech32(participant.Address)
+	available, hasNeg := k.bankKeeper.SpendableCoins(ctx, addr).SafeSub(participant.Coins...)
+	if hasNeg {
+		return sdkerrors.Wrapf(sdkerrors.ErrInsufficientFunds, "insufficient balance to commit %v", participant.Coins)
+	}
+
+	// the transfer fees are fixed on deposit, the items being given away as sent items
+	participant.ChainFees = sdk.NewCoins()
+	participant.Royalties = nil
+	if len(items) > 0 {
+		permutation, err := types.FindValidPaymentsPermutation(items, available)
+		if err != nil {
+			return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, err.Error())
+		}
+		chainFees, royalties := k.sentItemsTransferFees(ctx, items, permutation)
+		participant.ChainFees = chainFees
+		for cookbookID, amount := range royalties {
+			participant.Royalties = append(participant.Royalties, types.SwapRoyalty{CookbookId: cookbookID, Amount: amount})
+		}
+		sort.Slice(participant.Royalties, func(i, j int) bool {
+			return participant.Royalties[i].CookbookId < participant.Royalties[j].CookbookId
+		})
+	}
+
+	err := k.LockCoinsForSwap(ctx, addr, participant.Coins.Add(participant.TransferFees()...))
+	if err != nil {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, err.Error())
+	}
+
+	participant.DepositedItems = make([]types.ItemRef, 0, len(items))
+	for i, itemRef := range participant.Items {
+		item := items[i]
+		if itemRef.Amount != 0 {
+			item, err = k.SplitItem(ctx, item, itemRef.Amount)
+			if err != nil {
+				return err
+			}
+			itemRef.ItemId = item.Id
+		}
+		k.LockItemForSwap(ctx, item)
+		participant.DepositedItems = append(participant.DepositedItems, itemRef)
+	}
+	participant.Deposited = true
+
+	return nil
+}
+
+// hasExpiredDeposit checks if an item deposited to the swap expired
+func (k Keeper) hasExpiredDeposit(ctx sdk.Context, swap types.Swap) bool {
+	for _, participant := range swap.Participants {
+		for _, itemRef := range participant.DepositedItems {
+			item, _ := k.GetItem(ctx, itemRef.CookbookId, itemRef.ItemId)
+			if item.IsExpired(ctx) {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// settleSwap pays the transfer fees of the swapped items, gives the committed items and coins to the participants
+// receiving them and removes the swap
+func (k Keeper) settleSwap(ctx sdk.Context, swap types.Swap) error {
+	for _, participant := range swap.Participants {
+		addr, _ := sdk.AccAddressFromBech32(participant.Address)
+		err := k.UnLockCoinsForSwap(ctx, addr, participant.TransferFees().Add(participant.ReceiveCoins...))
+		if err != nil {
+			return err
+		}
+		err = k.payItemTransferFees(ctx, addr, addr, participant.ChainFees, participant.RoyaltiesByCookbook(), nil)
+		if err != nil {
+			return err
+		}
+	}
+
+	for _, receiver := range swap.Participants {
+		to, _ := k.GetUsernameByAddress(ctx, receiver.Address)
+		for _, itemRef := range receiver.ReceiveItems {
+			i, j := swap.FindCommittedItem(itemRef)
+			committer := swap.Participants[i]
+			locked := committer.DepositedItems[j]
+			item, _ := k.GetItem(ctx, locked.CookbookId, locked.ItemId)
+			item.RecordTransfer(ctx)
+			k.UnlockItemForSwap(ctx, item, receiver.Address)
+			item.Owner = receiver.Address
+			from, _ := k.GetUsernameByAddress(ctx, committer.Address)
+			k.SetItemHistory(ctx, item.NewItemHistory(ctx, to.Value, from.Value))
+			k.AppendItemProvenance(ctx, item.NewItemProvenance(ctx, types.ItemProvenanceSwap, committer.Address, receiver.Address))
+			k.MergeItem(ctx, item)
+		}
+	}
+
+	k.RemoveSwap(ctx, swap.Id)
+	return nil
+}
+
+// abortSwap refunds the deposited coins and transfer fees, gives back the deposited items and removes the swap
+func (k Keeper) abortSwap(ctx sdk.Context, swap types.Swap) error {
+	for _, participant := range swap.Participants {
+		if !participant.Deposited {
+			continue
+		}
+		addr, _ := sdk.AccAddressFromBech32(participant.Address)
+		err := k.UnLockCoinsForSwap(ctx, addr, participant.Coins.Add(participant.TransferFees()...))
+		if err != nil {
+			return err
+		}
+		for _, itemRef := range participant.DepositedItems {
+			item, _ := k.GetItem(ctx, itemRef.CookbookId, itemRef.ItemId)
+			k.UnlockItemForSwap(ctx, item, participant.Address)
+			item.Owner = participant.Address
+			k.MergeItem(ctx, item)
+		}
+	}
+
+	k.RemoveSwap(ctx, swap.Id)
+	return nil
+}
+
+func (k msgServer) CreateSwap(goCtx context.Context, msg *types.MsgCreateSwap) (*types.MsgCreateSwapResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	swap := types.Swap{
+		Creator:      msg.Creator,
+		Participants: msg.Participants,
+	}
+	err := k.depositSwap(ctx, &swap.Participants[swap.ParticipantIndex(msg.Creator)])
+	if err != nil {
+		return nil, err
+	}
+
+	id := k.AppendSwap(ctx, swap)
+
+	err = ctx.EventManager().EmitTypedEvent(&types.EventCreateSwap{
+		Creator: msg.Creator,
+		Id:      id,
+	})
+
+	telemetry.IncrCounter(1, "swap", "create")
+
+	return &types.MsgCreateSwapResponse{Id: id}, err
+}
+
+func (k msgServer) DepositSwap(goCtx context.Context, msg *types.MsgDepositSwap) (*types.MsgDepositSwapResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	swap, found := k.GetSwap(ctx, msg.Id)
+	if !found {
+		return nil, sdkerrors.Wrapf(sdkerrors.ErrKeyNotFound, "swap %d doesn't exist", msg.Id)
+	}
+	idx := swap.ParticipantIndex(msg.Creator)
+	if idx < 0 {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrUnauthorized, "not a participant of the swap")
+	}
+	if swap.Participants[idx].Deposited {
+		return nil, sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "commitment to swap %d already deposited", msg.Id)
+	}
+
+	err := k.depositSwap(ctx, &swap.Participants[idx])
+	if err != nil {
+		return nil, err
+	}
+
+	err = ctx.EventManager().EmitTypedEvent(&types.EventDepositSwap{
+		Creator: msg.Creator,
+		Id:      msg.Id,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	// the last deposit settles the swap
+	if !swap.AllDeposited() {
+		k.SetSwap(ctx, swap)
+		telemetry.IncrCounter(1, "swap", "deposit")
+		return &types.MsgDepositSwapResponse{}, nil
+	}
+
+	// an item that expired since its deposit cannot be swapped, every deposit is refunded instead
+	if k.hasExpiredDeposit(ctx, swap) {
+		err = k.abortSwap(ctx, swap)
+		if err != nil {
+			return nil, sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, err.Error())
+		}
+
+		err = ctx.EventManager().EmitTypedEvent(&types.EventAbortSwap{
+			Creator: msg.Creator,
+			Id:      msg.Id,
+		})
+
+		telemetry.IncrCounter(1, "swap", "abort")
+
+		return &types.MsgDepositSwapResponse{}, err
+	}
+
+	err = k.settleSwap(ctx, swap)
+	if err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, err.Error())
+	}
+
+	err = ctx.EventManager().EmitTypedEvent(&types.EventSettleSwap{
+		Id:           swap.Id,
+		Participants: swap.Participants,
+	})
+
+	telemetry.IncrCounter(1, "swap", "settle")
+
+	return &types.MsgDepositSwapResponse{}, err
+}
+
+func (k msgServer) AbortSwap(goCtx context.Context, msg *types.MsgAbortSwap) (*types.MsgAbortSwapResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	swap, found := k.GetSwap(ctx, msg.Id)
+	if !found {
+		return nil, sdkerrors.Wrapf(sdkerrors.ErrKeyNotFound, "swap %d doesn't exist", msg.Id)
+	}
+	if swap.ParticipantIndex(msg.Creator) < 0 {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrUnauthorized, "not a participant of the swap")
+	}
+
+	err := k.abortSwap(ctx, swap)
+	if err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, err.Error())
+	}
+
+	err = ctx.EventManager().EmitTypedEvent(&types.EventAbortSwap{
+		Creator: msg.Creator,
+		Id:      msg.Id,
+	})
+
+	telemetry.IncrCounter(1, "swap", "abort")
+
+	return &types.MsgAbortSwapResponse{}, err
+}
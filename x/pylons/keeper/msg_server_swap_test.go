@@ -0,0 +1,210 @@
+package keeper_test
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	"github.com/Pylons-tech/pylons/x/pylons/keeper"
+	"github.com/Pylons-tech/pylons/x/pylons/types"
+)
+
+// createSwapItems creates an item with a transfer fee of 100 owned by each owner, the last one being a fungible item
+// of 5 units
+func createSwapItems(k keeper.Keeper, ctx sdk.Context, cookbookID string, owners []string) []types.Item {
+	items := make([]types.Item, len(owners))
+	for i, owner := range owners {
+		items[i].Owner = owner
+		items[i].CookbookId = cookbookID
+		items[i].TransferFee = []sdk.Coin{sdk.NewCoin(types.PylonsCoinDenom, sdk.NewInt(100))}
+		items[i].TradePercentage = sdk.ZeroDec()
+		items[i].Tradeable = true
+		items[i].Quantity = 1
+		items[i].Id = k.AppendItem(ctx, items[i])
+	}
+	last := &items[len(items)-1]
+	last.Fungible = true
+	last.Quantity = 5
+	k.SetItem(ctx, *last)
+	return items
+}
+
+func (suite *IntegrationTestSuite) TestMsgServerSwap() {
+	k := suite.k
+	bk := suite.bankKeeper
+	ctx := suite.ctx
+	require := suite.Require()
+
+	wctx := sdk.WrapSDKContext(ctx)
+	srv := keeper.NewMsgServerImpl(k)
+
+	cookbook := createNCookbook(k, ctx, 1)[0]
+	cookbookAddr, _ := sdk.AccAddressFromBech32(cookbook.Creator)
+	owners := []string{types.GenTestBech32FromString("alice"), types.GenTestBech32FromString("bob"), types.GenTestBech32FromString("carol")}
+	addrs := make([]sdk.AccAddress, len(owners))
+	for i, owner := range owners {
+		addrs[i], _ = sdk.AccAddressFromBech32(owner)
+		k.SetPylonsAccount(ctx, types.AccountAddr{Value: owner}, types.Username{Value: owner})
+		err := k.MintCoinsToAddr(ctx, addrs[i], sdk.NewCoins(sdk.NewCoin(types.PylonsCoinDenom, sdk.NewInt(1000))))
+		require.NoError(err)
+	}
+	items := createSwapItems(k, ctx, cookbook.Id, owners)
+	ref := func(i int) types.ItemRef {
+		return types.ItemRef{CookbookId: cookbook.Id, ItemId: items[i].Id}
+	}
+	coins := sdk.NewCoins(sdk.NewCoin(types.PylonsCoinDenom, sdk.NewInt(50)))
+
+	// alice gives her item to bob, bob gives his item to carol, and carol gives 2 units of her item and coins to alice
+	partial := ref(2)
+	partial.Amount = 2
+	participants := []types.SwapParticipant{
+		{Address: owners[0], Items: []types.ItemRef{ref(0)}, ReceiveItems: []types.ItemRef{partial}, ReceiveCoins: coins},
+		{Address: owners[1], Items: []types.ItemRef{ref(1)}, ReceiveItems: []types.ItemRef{ref(0)}},
+		{Address: owners[2], Items: []types.ItemRef{partial}, Coins: coins, ReceiveItems: []types.ItemRef{ref(1)}},
+	}
+
+	_, err := srv.CreateSwap(wctx, types.NewMsgCreateSwap(owners[0], []types.SwapParticipant{
+		{Address: owners[0], Items: []types.ItemRef{ref(1)}, ReceiveItems: []types.ItemRef{partial}, ReceiveCoins: coins},
+		participants[1],
+		participants[2],
+	}))
+	require.ErrorIs(err, sdkerrors.ErrInvalidRequest)
+
+	res, err := srv.CreateSwap(wctx, types.NewMsgCreateSwap(owners[0], participants))
+	require.NoError(err)
+	item, _ := k.GetItem(ctx, cookbook.Id, items[0].Id)
+	require.Equal(k.SwapsLockerAddress().String(), item.Owner)
+	require.True(bk.GetBalance(ctx, addrs[0], types.PylonsCoinDenom).Amount.Equal(sdk.NewInt(900)))
+
+	_, err = srv.DepositSwap(wctx, types.NewMsgDepositSwap(owners[0], res.Id))
+	require.ErrorIs(err, sdkerrors.ErrInvalidRequest)
+	_, err = srv.DepositSwap(wctx, types.NewMsgDepositSwap(types.GenTestBech32FromString("other"), res.Id))
+	require.ErrorIs(err, sdkerrors.ErrUnauthorized)
+	_, err = srv.DepositSwap(wctx, types.NewMsgDepositSwap(owners[1], res.Id+1))
+	require.ErrorIs(err, sdkerrors.ErrKeyNotFound)
+
+	_, err = srv.DepositSwap(wctx, types.NewMsgDepositSwap(owners[1], res.Id))
+	require.NoError(err)
+	swap, found := k.GetSwap(ctx, res.Id)
+	require.True(found)
+	require.True(swap.Participants[1].Deposited)
+	require.False(swap.AllDeposited())
+
+	// the last deposit settles the swap
+	_, err = srv.DepositSwap(wctx, types.NewMsgDepositSwap(owners[2], res.Id))
+	require.NoError(err)
+	_, found = k.GetSwap(ctx, res.Id)
+	require.False(found)
+
+	item, _ = k.GetItem(ctx, cookbook.Id, items[0].Id)
+	require.Equal(owners[1], item.Owner)
+	item, _ = k.GetItem(ctx, cookbook.Id, items[1].Id)
+	require.Equal(owners[2], item.Owner)
+	item, _ = k.GetItem(ctx, cookbook.Id, items[2].Id)
+	require.Equal(owners[2], item.Owner)
+	require.Equal(uint64(3), item.Quantity)
+	received := k.GetAllItemByOwner(ctx, addrs[0])
+	require.Len(received, 1)
+	require.Equal(uint64(2), received[0].Quantity)
+
+	provenance, _, err := k.GetItemProvenancePaginated(ctx, cookbook.Id, items[0].Id, nil)
+	require.NoError(err)
+	require.Equal(types.ItemProvenanceSwap, provenance[len(provenance)-1].Event)
+
+	// every participant pays the transfer fee of its item, 10% of which is retained by the chain
+	require.True(bk.GetBalance(ctx, addrs[0], types.PylonsCoinDenom).Amount.Equal(sdk.NewInt(950)))
+	require.True(bk.GetBalance(ctx, addrs[1], types.PylonsCoinDenom).Amount.Equal(sdk.NewInt(900)))
+	require.True(bk.GetBalance(ctx, addrs[2], types.PylonsCoinDenom).Amount.Equal(sdk.NewInt(850)))
+	require.True(bk.GetBalance(ctx, cookbookAddr, types.PylonsCoinDenom).Amount.Equal(sdk.NewInt(270)))
+	require.True(bk.GetBalance(ctx, k.SwapsLockerAddress(), types.PylonsCoinDenom).IsZero())
+}
+
+func (suite *IntegrationTestSuite) TestMsgServerAbortSwap() {
+	k := suite.k
+	bk := suite.bankKeeper
+	ctx := suite.ctx
+	require := suite.Require()
+
+	wctx := sdk.WrapSDKContext(ctx)
+	srv := keeper.NewMsgServerImpl(k)
+
+	cookbook := createNCookbook(k, ctx, 1)[0]
+	owners := []string{types.GenTestBech32FromString("alice"), types.GenTestBech32FromString("bob")}
+	addrs := make([]sdk.AccAddress, len(owners))
+	for i, owner := range owners {
+		addrs[i], _ = sdk.AccAddressFromBech32(owner)
+		err := k.MintCoinsToAddr(ctx, addrs[i], sdk.NewCoins(sdk.NewCoin(types.PylonsCoinDenom, sdk.NewInt(1000))))
+		require.NoError(err)
+	}
+	items := createSwapItems(k, ctx, cookbook.Id, owners[:1])
+	partial := types.ItemRef{CookbookId: cookbook.Id, ItemId: items[0].Id, Amount: 2}
+	coins := sdk.NewCoins(sdk.NewCoin(types.PylonsCoinDenom, sdk.NewInt(300)))
+
+	res, err := srv.CreateSwap(wctx, types.NewMsgCreateSwap(owners[0], []types.SwapParticipant{
+		{Address: owners[0], Items: []types.ItemRef{partial}, ReceiveCoins: coins},
+		{Address: owners[1], Coins: coins, ReceiveItems: []types.ItemRef{partial}},
+	}))
+	require.NoError(err)
+	item, _ := k.GetItem(ctx, cookbook.Id, items[0].Id)
+	require.Equal(uint64(3), item.Quantity)
+	require.True(bk.GetBalance(ctx, addrs[0], types.PylonsCoinDenom).Amount.Equal(sdk.NewInt(900)))
+
+	_, err = srv.AbortSwap(wctx, types.NewMsgAbortSwap(types.GenTestBech32FromString("other"), res.Id))
+	require.ErrorIs(err, sdkerrors.ErrUnauthorized)
+	_, err = srv.AbortSwap(wctx, types.NewMsgAbortSwap(owners[1], res.Id+1))
+	require.ErrorIs(err, sdkerrors.ErrKeyNotFound)
+
+	// any participant can abort the swap, the deposited items being merged back and the fees refunded
+	_, err = srv.AbortSwap(wctx, types.NewMsgAbortSwap(owners[1], res.Id))
+	require.NoError(err)
+	_, found := k.GetSwap(ctx, res.Id)
+	require.False(found)
+	item, _ = k.GetItem(ctx, cookbook.Id, items[0].Id)
+	require.Equal(owners[0], item.Owner)
+	require.Equal(uint64(5), item.Quantity)
+	require.Len(k.GetAllItemByOwner(ctx, addrs[0]), 1)
+	require.True(bk.GetBalance(ctx, addrs[0], types.PylonsCoinDenom).Amount.Equal(sdk.NewInt(1000)))
+	require.True(bk.GetBalance(ctx, addrs[1], types.PylonsCoinDenom).Amount.Equal(sdk.NewInt(1000)))
+	require.True(bk.GetBalance(ctx, k.SwapsLockerAddress(), types.PylonsCoinDenom).IsZero())
+}
+
+func (suite *IntegrationTestSuite) TestMsgServerSwapExpiredItem() {
+	k := suite.k
+	bk := suite.bankKeeper
+	ctx := suite.ctx.WithBlockHeight(10)
+	require := suite.Require()
+
+	srv := keeper.NewMsgServerImpl(k)
+
+	cookbook := createNCookbook(k, ctx, 1)[0]
+	owners := []string{types.GenTestBech32FromString("alice"), types.GenTestBech32FromString("bob")}
+	addrs := make([]sdk.AccAddress, len(owners))
+	for i, owner := range owners {
+		addrs[i], _ = sdk.AccAddressFromBech32(owner)
+		err := k.MintCoinsToAddr(ctx, addrs[i], sdk.NewCoins(sdk.NewCoin(types.PylonsCoinDenom, sdk.NewInt(1000))))
+		require.NoError(err)
+	}
+	items := createSwapItems(k, ctx, cookbook.Id, owners)
+	ref := types.ItemRef{CookbookId: cookbook.Id, ItemId: items[0].Id}
+	coins := sdk.NewCoins(sdk.NewCoin(types.PylonsCoinDenom, sdk.NewInt(300)))
+
+	res, err := srv.CreateSwap(sdk.WrapSDKContext(ctx), types.NewMsgCreateSwap(owners[0], []types.SwapParticipant{
+		{Address: owners[0], Items: []types.ItemRef{ref}, ReceiveCoins: coins},
+		{Address: owners[1], Coins: coins, ReceiveItems: []types.ItemRef{ref}},
+	}))
+	require.NoError(err)
+	item, _ := k.GetItem(ctx, cookbook.Id, items[0].Id)
+	item.ExpiresAtHeight = 11
+	k.SetItem(ctx, item)
+
+	// the last deposit aborts the swap since the deposited item expired
+	ctx = ctx.WithBlockHeight(11)
+	_, err = srv.DepositSwap(sdk.WrapSDKContext(ctx), types.NewMsgDepositSwap(owners[1], res.Id))
+	require.NoError(err)
+	_, found := k.GetSwap(ctx, res.Id)
+	require.False(found)
+	item, _ = k.GetItem(ctx, cookbook.Id, items[0].Id)
+	require.Equal(owners[0], item.Owner)
+	require.True(bk.GetBalance(ctx, addrs[0], types.PylonsCoinDenom).Amount.Equal(sdk.NewInt(1000)))
+	require.True(bk.GetBalance(ctx, addrs[1], types.PylonsCoinDenom).Amount.Equal(sdk.NewInt(1000)))
+	require.True(bk.GetBalance(ctx, k.SwapsLockerAddress(), types.PylonsCoinDenom).IsZero())
+}
@@ -14,8 +14,18 @@ import (
 func (k msgServer) CreateTrade(goCtx context.Context, msg *types.MsgCreateTrade) (*types.MsgCreateTradeResponse, error) {
 	ctx := sdk.UnwrapSDKContext(goCtx)
 
+	if (msg.ExpiresAtHeight != 0 && msg.ExpiresAtHeight <= ctx.BlockHeight()) || (msg.ExpiresAt != 0 && msg.ExpiresAt <= ctx.BlockTime().Unix()) {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "trade expiry already reached")
+	}
+
+	quantity := msg.Quantity
+	if quantity == 0 {
+		quantity = 1
+	}
+
 	addr, _ := sdk.AccAddressFromBech32(msg.Creator)
 	items := make([]types.Item, 0)
+	itemOutputs := make([]types.ItemRef, 0)
 
 	// coins with send_enable to false cannot be added to CoinOutputs
 	err := k.bankKeeper.IsSendEnabledCoins(ctx, msg.CoinOutputs...)
@@ -40,19 +50,47 @@ func (k msgServer) CreateTrade(goCtx context.Context, msg *types.MsgCreateTrade)
 	}
 
 	// check that each item provided for trade is owned by sender, and lock it
-	for _, itemRef := range msg.ItemOutputs {
+	// items can be listed by an approved operator, in which case the trade is created on behalf of their owner
+	owner := msg.Creator
+	for i, itemRef := range msg.ItemOutputs {
 		item, found := k.GetItem(ctx, itemRef.CookbookId, itemRef.ItemId)
 		if !found {
 			return nil, sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "item with id %v and cookbook id %v not found", itemRef.ItemId, itemRef.CookbookId)
 		}
-		if item.Owner != msg.Creator {
+		if i == 0 {
+			owner = item.Owner
+			// an operator cannot offer coins on behalf of the items owner
+			if owner != msg.Creator && !msg.CoinOutputs.Empty() {
+				return nil, sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "coinOutputs cannot be provided when trading items on behalf of their owner")
+			}
+			// the trade is created by the owner, who cannot be its receiver
+			if msg.Receiver != "" && msg.Receiver == owner {
+				return nil, sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "receiver cannot be the trade creator")
+			}
+		}
+		if item.Owner != owner || !k.IsApprovedOrOwner(ctx, item, msg.Creator) {
 			return nil, sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "item with id %v and cookbook id %v not owned", itemRef.ItemId, itemRef.CookbookId)
 		}
 		if !item.Tradeable {
 			return nil, sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "item with id %v and cookbook id %v cannot be traded", itemRef.ItemId, itemRef.CookbookId)
 		}
+		if item.IsExpired(ctx) {
+			return nil, sdkerrors.Wrapf(types.ErrItemExpired, "item with id %v and cookbook id %v expired", itemRef.ItemId, itemRef.CookbookId)
+		}
+		if err = item.CanTransfer(ctx); err != nil {
+			return nil, err
+		}
+		// only the traded amount of a fungible item is locked for all the units, the trade references the split item
+		if itemRef.Amount != 0 {
+			item, err = k.SplitItem(ctx, item, itemRef.Amount*quantity)
+			if err != nil {
+				return nil, err
+			}
+			itemRef.ItemId = item.Id
+		}
 		k.LockItemForTrade(ctx, item)
 		items = append(items, item)
+		itemOutputs = append(itemOutputs, itemRef)
 	}
 	if len(items) != 0 {
 		for i, coinInputs := range msg.CoinInputs {
@@ -62,19 +100,24 @@ func (k msgServer) CreateTrade(goCtx context.Context, msg *types.MsgCreateTrade)
 			}
 		}
 	}
-	// lock coins for trade
-	err = k.LockCoinsForTrade(ctx, addr, msg.CoinOutputs)
+	// lock coins for all the units of the trade
+	err = k.LockCoinsForTrade(ctx, addr, msg.CoinOutputs.MulInt(sdk.NewIntFromUint64(quantity)))
 	if err != nil {
 		return nil, sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, err.Error())
 	}
 
 	trade := types.Trade{
-		Creator:     msg.Creator,
-		CoinInputs:  msg.CoinInputs,
-		ItemInputs:  msg.ItemInputs,
-		CoinOutputs: msg.CoinOutputs,
-		ItemOutputs: msg.ItemOutputs,
-		ExtraInfo:   msg.ExtraInfo,
+		Creator:           owner,
+		CoinInputs:        msg.CoinInputs,
+		ItemInputs:        msg.ItemInputs,
+		CoinOutputs:       msg.CoinOutputs,
+		ItemOutputs:       itemOutputs,
+		ExtraInfo:         msg.ExtraInfo,
+		Receiver:          msg.Receiver,
+		ExpiresAtHeight:   msg.ExpiresAtHeight,
+		ExpiresAt:         msg.ExpiresAt,
+		Quantity:          quantity,
+		RemainingQuantity: quantity,
 	}
 
 	id := k.AppendTrade(
@@ -83,8 +126,9 @@ func (k msgServer) CreateTrade(goCtx context.Context, msg *types.MsgCreateTrade)
 	)
 
 	err = ctx.EventManager().EmitTypedEvent(&types.EventCreateTrade{
-		Creator: msg.Creator,
-		Id:      id,
+		Creator:  owner,
+		Id:       id,
+		Receiver: msg.Receiver,
 	})
 
 	telemetry.IncrCounter(1, "trade", "create")
@@ -105,27 +149,7 @@ func (k msgServer) CancelTrade(goCtx context.Context, msg *types.MsgCancelTrade)
 		return nil, sdkerrors.Wrap(sdkerrors.ErrUnauthorized, "incorrect owner")
 	}
 
-	// unlock locked items
-	for _, itemRef := range trade.ItemOutputs {
-		// checks where passed at trade creation, we just need to unlock
-		item, _ := k.GetItem(ctx, itemRef.CookbookId, itemRef.ItemId)
-		k.UnlockItemForTrade(ctx, item, msg.Creator)
-	}
-
-	// unlock locked coins
-	addr, _ := sdk.AccAddressFromBech32(msg.Creator)
-	err := k.UnLockCoinsForTrade(ctx, addr, trade.CoinOutputs)
-	if err != nil {
-		// this should never happen, it means the module account has been drained of funds illegitimately
-		panic(err)
-	}
-
-	k.RemoveTrade(ctx, msg.Id, addr)
-
-	err = ctx.EventManager().EmitTypedEvent(&types.EventCancelTrade{
-		Creator: msg.Creator,
-		Id:      msg.Id,
-	})
+	err := k.cancelTrade(ctx, trade, types.TradeCancelReasonCancelled)
 
 	telemetry.IncrCounter(1, "trade", "cancel")
 
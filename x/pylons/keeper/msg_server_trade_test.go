@@ -265,3 +265,66 @@ func (suite *IntegrationTestSuite) TestTradeMsgServerCancel() {
 		})
 	}
 }
+
+func (suite *IntegrationTestSuite) TestTradeMsgServerCancelPartiallyFilled() {
+	k := suite.k
+	ctx := suite.ctx
+	require := suite.Require()
+	bk := suite.bankKeeper
+
+	wctx := sdk.WrapSDKContext(ctx)
+	srv := keeper.NewMsgServerImpl(k)
+
+	creator := types.GenTestBech32FromString("creator")
+	creatorAddr, _ := sdk.AccAddressFromBech32(creator)
+	fulfiller := types.GenTestBech32FromString("fulfiller")
+	fulfillerAddr, _ := sdk.AccAddressFromBech32(fulfiller)
+	k.SetPylonsAccount(ctx, types.AccountAddr{Value: creator}, types.Username{Value: "creator"})
+	k.SetPylonsAccount(ctx, types.AccountAddr{Value: fulfiller}, types.Username{Value: "fulfiller"})
+	k.SetCookbook(ctx, types.Cookbook{Creator: creator, Id: "testCookbook"})
+
+	price := sdk.NewCoins(sdk.NewCoin("testCookbook/gold", sdk.NewInt(3)))
+	require.NoError(k.MintCoinsToAddr(ctx, creatorAddr, price.MulInt(sdk.NewInt(10))))
+	require.NoError(k.MintCoinsToAddr(ctx, fulfillerAddr, price.MulInt(sdk.NewInt(10))))
+
+	// the coinOutputs of all the units are locked
+	respCoins, err := srv.CreateTrade(wctx, &types.MsgCreateTrade{
+		Creator:     creator,
+		CoinOutputs: price,
+		ExtraInfo:   "extrainfo",
+		Quantity:    4,
+	})
+	require.NoError(err)
+	require.Equal(sdk.NewInt(18), bk.SpendableCoins(ctx, creatorAddr).AmountOf("testCookbook/gold"))
+	_, err = srv.CancelTrade(wctx, &types.MsgCancelTrade{Creator: creator, Id: respCoins.Id})
+	require.NoError(err)
+	require.Equal(sdk.NewInt(30), bk.SpendableCoins(ctx, creatorAddr).AmountOf("testCookbook/gold"))
+
+	item := types.Item{
+		Owner:           creator,
+		CookbookId:      "testCookbook",
+		Tradeable:       true,
+		TradePercentage: sdk.ZeroDec(),
+		TransferFee:     price,
+		Fungible:        true,
+		Quantity:        10,
+	}
+	item.Id = k.AppendItem(ctx, item)
+	respItems, err := srv.CreateTrade(wctx, &types.MsgCreateTrade{
+		Creator:     creator,
+		CoinInputs:  []types.CoinInput{{Coins: price}},
+		ItemOutputs: []types.ItemRef{{CookbookId: item.CookbookId, ItemId: item.Id, Amount: 1}},
+		ExtraInfo:   "extrainfo",
+		Quantity:    4,
+	})
+	require.NoError(err)
+	_, err = srv.FulfillTrade(wctx, &types.MsgFulfillTrade{Creator: fulfiller, Id: respItems.Id})
+	require.NoError(err)
+
+	// only the units left to fill are given back
+	_, err = srv.CancelTrade(wctx, &types.MsgCancelTrade{Creator: creator, Id: respItems.Id})
+	require.NoError(err)
+	creatorItems := k.GetAllItemByOwner(ctx, creatorAddr)
+	require.Len(creatorItems, 1)
+	require.Equal(uint64(9), creatorItems[0].Quantity)
+}
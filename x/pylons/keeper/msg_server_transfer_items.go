@@ -0,0 +1,31 @@
+package keeper
+
+import (
+	"context"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/Pylons-tech/pylons/x/pylons/types"
+)
+
+func (k msgServer) TransferItems(goCtx context.Context, msg *types.MsgTransferItems) (*types.MsgTransferItemsResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	sequence, err := k.Keeper.TransferItems(
+		ctx,
+		msg.Creator,
+		msg.SourcePort,
+		msg.SourceChannel,
+		msg.CookbookId,
+		msg.ItemIds,
+		msg.Receiver,
+		msg.TimeoutHeight,
+		msg.TimeoutTimestamp,
+		msg.Memo,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.MsgTransferItemsResponse{Sequence: sequence}, nil
+}
@@ -0,0 +1,121 @@
+package keeper
+
+import (
+	"context"
+
+	"github.com/cosmos/cosmos-sdk/telemetry"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	"github.com/Pylons-tech/pylons/x/pylons/types"
+)
+
+func (k msgServer) UpdateItemAttributes(goCtx context.Context, msg *types.MsgUpdateItemAttributes) (*types.MsgUpdateItemAttributesResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	cookbook, found := k.GetCookbook(ctx, msg.CookbookId)
+	if !found {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrKeyNotFound, "cookbook not found")
+	}
+	item, found := k.GetItem(ctx, msg.CookbookId, msg.Id)
+	if !found {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrKeyNotFound, "item not found")
+	}
+
+	history := types.ItemAttributesHistory{
+		CookbookId:  msg.CookbookId,
+		Id:          msg.Id,
+		Updater:     msg.Creator,
+		Doubles:     msg.Doubles,
+		Longs:       msg.Longs,
+		Strings:     msg.Strings,
+		BlockHeight: ctx.BlockHeight(),
+		CreatedAt:   ctx.BlockTime().Unix(),
+	}
+
+	// checkKey verifies the creator can set the attribute and the item does not hold it with another type
+	checkKey := func(key string, attributeType string) error {
+		if !cookbook.CanUpdateAttribute(key, msg.Creator) {
+			return sdkerrors.Wrapf(sdkerrors.ErrUnauthorized, "%s cannot update attribute %s of cookbook %s", msg.Creator, key, cookbook.Id)
+		}
+		_, isDouble := item.FindDoubleKey(key)
+		_, isLong := item.FindLongKey(key)
+		_, isString := item.FindStringKey(key)
+		if (isDouble && attributeType != types.AttributeTypeDouble) || (isLong && attributeType != types.AttributeTypeLong) || (isString && attributeType != types.AttributeTypeString) {
+			return sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "attribute %s of item %s is not a %s", key, item.Id, attributeType)
+		}
+		return nil
+	}
+
+	doubles := make([]types.DoubleKeyValue, len(item.Doubles))
+	copy(doubles, item.Doubles)
+	for _, kv := range msg.Doubles {
+		if err := checkKey(kv.Key, types.AttributeTypeDouble); err != nil {
+			return nil, err
+		}
+		if err := cookbook.ValidateDoubleAttribute(kv.Key, kv.Value); err != nil {
+			return nil, sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, err.Error())
+		}
+		if i, ok := item.FindDoubleKey(kv.Key); ok {
+			history.OriginalDoubles = append(history.OriginalDoubles, doubles[i])
+			doubles[i] = kv
+		} else {
+			doubles = append(doubles, kv)
+		}
+	}
+
+	longs := make([]types.LongKeyValue, len(item.Longs))
+	copy(longs, item.Longs)
+	for _, kv := range msg.Longs {
+		if err := checkKey(kv.Key, types.AttributeTypeLong); err != nil {
+			return nil, err
+		}
+		if err := cookbook.ValidateLongAttribute(kv.Key, kv.Value); err != nil {
+			return nil, sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, err.Error())
+		}
+		if i, ok := item.FindLongKey(kv.Key); ok {
+			history.OriginalLongs = append(history.OriginalLongs, longs[i])
+			longs[i] = kv
+		} else {
+			longs = append(longs, kv)
+		}
+	}
+
+	strings := make([]types.StringKeyValue, len(item.Strings))
+	copy(strings, item.Strings)
+	for _, kv := range msg.Strings {
+		if err := checkKey(kv.Key, types.AttributeTypeString); err != nil {
+			return nil, err
+		}
+		if err := cookbook.ValidateStringAttribute(kv.Key, kv.Value); err != nil {
+			return nil, sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, err.Error())
+		}
+		if i, ok := item.FindStringKey(kv.Key); ok {
+			history.OriginalStrings = append(history.OriginalStrings, strings[i])
+			strings[i] = kv
+		} else {
+			strings = append(strings, kv)
+		}
+	}
+
+	item.Doubles = doubles
+	item.Longs = longs
+	item.Strings = strings
+	item.LastUpdate = ctx.BlockHeight()
+	item.UpdatedAt = ctx.BlockTime().Unix()
+	k.SetItem(ctx, item)
+	k.AppendItemAttributesHistory(ctx, history)
+	provenance := item.NewItemProvenance(ctx, types.ItemProvenanceUpdate, msg.Creator, item.Owner)
+	provenance.Doubles = msg.Doubles
+	provenance.Longs = msg.Longs
+	provenance.Strings = msg.Strings
+	k.AppendItemProvenance(ctx, provenance)
+
+	telemetry.IncrCounter(1, "item", "attributes", "update")
+
+	err := ctx.EventManager().EmitTypedEvent(&types.EventUpdateItemAttributes{
+		Update: history,
+	})
+
+	return &types.MsgUpdateItemAttributesResponse{}, err
+}
@@ -0,0 +1,125 @@
+package keeper_test
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	"github.com/Pylons-tech/pylons/x/pylons/keeper"
+	"github.com/Pylons-tech/pylons/x/pylons/types"
+)
+
+func (suite *IntegrationTestSuite) TestMsgServerUpdateItemAttributes() {
+	k := suite.k
+	ctx := suite.ctx
+	require := suite.Require()
+
+	srv := keeper.NewMsgServerImpl(k)
+	wctx := sdk.WrapSDKContext(ctx)
+
+	creator := types.GenTestBech32FromString("creator")
+	server := types.GenTestBech32FromString("server")
+	other := types.GenTestBech32FromString("other")
+	k.SetCookbook(ctx, types.Cookbook{
+		Creator: creator,
+		Id:      "testCookbook",
+		AttributePermissions: []types.ItemAttributePermission{
+			{Key: "attack", Updaters: []string{server}},
+			{Key: "weight"},
+			{Key: "name", Updaters: []string{server}},
+		},
+	})
+	item := types.Item{
+		Owner:           other,
+		CookbookId:      "testCookbook",
+		Longs:           []types.LongKeyValue{{Key: "attack", Value: 10}, {Key: "level", Value: 1}},
+		Doubles:         []types.DoubleKeyValue{{Key: "weight", Value: sdk.MustNewDecFromStr("1.5")}},
+		TradePercentage: sdk.ZeroDec(),
+	}
+	item.Id = k.AppendItem(ctx, item)
+
+	for _, tc := range []struct {
+		desc string
+		msg  *types.MsgUpdateItemAttributes
+		err  error
+	}{
+		{
+			desc: "CookbookNotFound",
+			msg:  &types.MsgUpdateItemAttributes{Creator: creator, CookbookId: "missing", Id: item.Id, Longs: []types.LongKeyValue{{Key: "attack", Value: 1}}},
+			err:  sdkerrors.ErrKeyNotFound,
+		},
+		{
+			desc: "ItemNotFound",
+			msg:  &types.MsgUpdateItemAttributes{Creator: creator, CookbookId: "testCookbook", Id: "missing", Longs: []types.LongKeyValue{{Key: "attack", Value: 1}}},
+			err:  sdkerrors.ErrKeyNotFound,
+		},
+		{
+			desc: "UndeclaredKey",
+			msg:  &types.MsgUpdateItemAttributes{Creator: creator, CookbookId: "testCookbook", Id: item.Id, Longs: []types.LongKeyValue{{Key: "level", Value: 2}}},
+			err:  sdkerrors.ErrUnauthorized,
+		},
+		{
+			desc: "NotUpdater",
+			msg:  &types.MsgUpdateItemAttributes{Creator: server, CookbookId: "testCookbook", Id: item.Id, Doubles: []types.DoubleKeyValue{{Key: "weight", Value: sdk.OneDec()}}},
+			err:  sdkerrors.ErrUnauthorized,
+		},
+		{
+			desc: "Owner",
+			msg:  &types.MsgUpdateItemAttributes{Creator: other, CookbookId: "testCookbook", Id: item.Id, Longs: []types.LongKeyValue{{Key: "attack", Value: 1}}},
+			err:  sdkerrors.ErrUnauthorized,
+		},
+		{
+			desc: "WrongType",
+			msg:  &types.MsgUpdateItemAttributes{Creator: creator, CookbookId: "testCookbook", Id: item.Id, Doubles: []types.DoubleKeyValue{{Key: "attack", Value: sdk.OneDec()}}},
+			err:  sdkerrors.ErrInvalidRequest,
+		},
+	} {
+		tc := tc
+		suite.Run(tc.desc, func() {
+			_, err := srv.UpdateItemAttributes(wctx, tc.msg)
+			require.ErrorIs(err, tc.err)
+		})
+	}
+
+	// failed updates do not change the item
+	got, _ := k.GetItem(ctx, item.CookbookId, item.Id)
+	require.Equal(item, got)
+
+	// the updaters set their keys, new keys are appended
+	_, err := srv.UpdateItemAttributes(wctx, &types.MsgUpdateItemAttributes{
+		Creator:    server,
+		CookbookId: "testCookbook",
+		Id:         item.Id,
+		Longs:      []types.LongKeyValue{{Key: "attack", Value: 20}},
+		Strings:    []types.StringKeyValue{{Key: "name", Value: "sword"}},
+	})
+	require.NoError(err)
+	// the cookbook creator sets every declared key
+	_, err = srv.UpdateItemAttributes(wctx, &types.MsgUpdateItemAttributes{
+		Creator:    creator,
+		CookbookId: "testCookbook",
+		Id:         item.Id,
+		Doubles:    []types.DoubleKeyValue{{Key: "weight", Value: sdk.MustNewDecFromStr("2.5")}},
+		Longs:      []types.LongKeyValue{{Key: "attack", Value: 30}},
+	})
+	require.NoError(err)
+
+	got, _ = k.GetItem(ctx, item.CookbookId, item.Id)
+	require.Equal([]types.LongKeyValue{{Key: "attack", Value: 30}, {Key: "level", Value: 1}}, got.Longs)
+	require.Equal([]types.DoubleKeyValue{{Key: "weight", Value: sdk.MustNewDecFromStr("2.5")}}, got.Doubles)
+	require.Equal([]types.StringKeyValue{{Key: "name", Value: "sword"}}, got.Strings)
+
+	history := k.GetAllItemAttributesHistory(ctx, item.CookbookId, item.Id)
+	require.Len(history, 2)
+	require.Equal(server, history[0].Updater)
+	require.Equal([]types.LongKeyValue{{Key: "attack", Value: 10}}, history[0].OriginalLongs)
+	require.Equal([]types.LongKeyValue{{Key: "attack", Value: 20}}, history[0].Longs)
+	require.Empty(history[0].OriginalStrings)
+	require.Equal([]types.StringKeyValue{{Key: "name", Value: "sword"}}, history[0].Strings)
+	require.Equal(creator, history[1].Updater)
+	require.Equal([]types.LongKeyValue{{Key: "attack", Value: 20}}, history[1].OriginalLongs)
+	require.Equal([]types.DoubleKeyValue{{Key: "weight", Value: sdk.MustNewDecFromStr("1.5")}}, history[1].OriginalDoubles)
+
+	response, err := k.GetItemAttributesHistory(wctx, &types.QueryGetItemAttributesHistoryRequest{CookbookId: item.CookbookId, ItemId: item.Id})
+	require.NoError(err)
+	require.Equal(history, response.History)
+}
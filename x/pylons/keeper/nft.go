@@ -0,0 +1,87 @@
+package keeper
+
+import (
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/nft"
+
+	"github.com/Pylons-tech/pylons/x/pylons/types"
+)
+
+// The x/nft module holds a read-only mirror of the cookbooks and items, so that clients of the standard
+// x/nft queries can see them. Every cookbook is a class and every item is an NFT carrying the item as data.
+// The mirror is only written by this keeper and is rebuilt from the pylons state on genesis.
+
+// setCookbookClass saves or updates the class mirroring a cookbook
+func (k Keeper) setCookbookClass(ctx sdk.Context, cookbook types.Cookbook) {
+	class := nft.Class{
+		Id:          types.NFTClassID(cookbook.Id),
+		Name:        cookbook.Name,
+		Description: cookbook.Description,
+	}
+	var err error
+	if k.nftKeeper.HasClass(ctx, class.Id) {
+		err = k.nftKeeper.UpdateClass(ctx, class)
+	} else {
+		err = k.nftKeeper.SaveClass(ctx, class)
+	}
+	if err != nil {
+		panic(err)
+	}
+}
+
+// setItemNFT mints or updates the NFT mirroring an item, transferring it if the item owner changed
+func (k Keeper) setItemNFT(ctx sdk.Context, item types.Item) {
+	classID := types.NFTClassID(item.CookbookId)
+	// items are imported before their cookbook on genesis, the class is completed when the cookbook is set
+	if !k.nftKeeper.HasClass(ctx, classID) {
+		if err := k.nftKeeper.SaveClass(ctx, nft.Class{Id: classID}); err != nil {
+			panic(err)
+		}
+	}
+
+	data, err := codectypes.NewAnyWithValue(&item)
+	if err != nil {
+		panic(err)
+	}
+	token := nft.NFT{
+		ClassId: classID,
+		Id:      types.NFTID(item.Id),
+		Data:    data,
+	}
+	owner, _ := sdk.AccAddressFromBech32(item.Owner)
+
+	if !k.nftKeeper.HasNFT(ctx, token.ClassId, token.Id) {
+		err = k.nftKeeper.Mint(ctx, token, owner)
+	} else {
+		err = k.nftKeeper.Update(ctx, token)
+		if err == nil && !k.nftKeeper.GetOwner(ctx, token.ClassId, token.Id).Equals(owner) {
+			err = k.nftKeeper.Transfer(ctx, token.ClassId, token.Id, owner)
+		}
+	}
+	if err != nil {
+		panic(err)
+	}
+}
+
+// removeItemNFT burns the NFT mirroring an item
+func (k Keeper) removeItemNFT(ctx sdk.Context, cookbookID, itemID string) {
+	classID := types.NFTClassID(cookbookID)
+	nftID := types.NFTID(itemID)
+	if !k.nftKeeper.HasNFT(ctx, classID, nftID) {
+		return
+	}
+	if err := k.nftKeeper.Burn(ctx, classID, nftID); err != nil {
+		panic(err)
+	}
+}
+
+// SyncNFTMirror saves the x/nft class of every cookbook and the NFT of every item
+func (k Keeper) SyncNFTMirror(ctx sdk.Context) {
+	for _, cookbook := range k.GetAllCookbook(ctx) {
+		k.setCookbookClass(ctx, cookbook)
+	}
+	for _, item := range k.GetAllItem(ctx) {
+		k.setItemNFT(ctx, item)
+	}
+}
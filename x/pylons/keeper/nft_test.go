@@ -0,0 +1,74 @@
+package keeper_test
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/Pylons-tech/pylons/x/pylons/types"
+)
+
+func (suite *IntegrationTestSuite) TestNFTMirrorCookbookClass() {
+	k := suite.k
+	nk := suite.pylonsApp.NFTKeeper
+	require := suite.Require()
+
+	cookbook := createNCookbook(k, suite.ctx, 1)[0]
+	class, found := nk.GetClass(suite.ctx, types.NFTClassID(cookbook.Id))
+	require.True(found)
+	require.Equal("", class.Name)
+
+	cookbook.Name = "testCookbookName"
+	cookbook.Description = "descdescdescdescdescdesc"
+	k.SetCookbook(suite.ctx, cookbook)
+	class, found = nk.GetClass(suite.ctx, types.NFTClassID(cookbook.Id))
+	require.True(found)
+	require.Equal(cookbook.Name, class.Name)
+	require.Equal(cookbook.Description, class.Description)
+}
+
+func (suite *IntegrationTestSuite) TestNFTMirrorItem() {
+	k := suite.k
+	nk := suite.pylonsApp.NFTKeeper
+	require := suite.Require()
+
+	item := createNItemSameOwnerAndCookbook(k, suite.ctx, 1, "testCookbook", true)[0]
+	classID := types.NFTClassID(item.CookbookId)
+	nftID := types.NFTID(item.Id)
+	require.True(nk.HasClass(suite.ctx, classID))
+	token, found := nk.GetNFT(suite.ctx, classID, nftID)
+	require.True(found)
+	var data types.Item
+	require.Equal("/pylons.pylons.Item", token.Data.TypeUrl)
+	require.NoError(suite.pylonsApp.AppCodec().Unmarshal(token.Data.Value, &data))
+	require.Equal(item.Id, data.Id)
+	require.Equal(item.Owner, nk.GetOwner(suite.ctx, classID, nftID).String())
+
+	// updating the item transfers the nft to the new owner
+	prevAddr, err := sdk.AccAddressFromBech32(item.Owner)
+	require.NoError(err)
+	item.Owner = types.GenTestBech32FromString("newOwner")
+	item.Strings = []types.StringKeyValue{{Key: "name", Value: "sword"}}
+	k.UpdateItem(suite.ctx, item, prevAddr)
+	require.Equal(item.Owner, nk.GetOwner(suite.ctx, classID, nftID).String())
+	token, _ = nk.GetNFT(suite.ctx, classID, nftID)
+	require.NoError(suite.pylonsApp.AppCodec().Unmarshal(token.Data.Value, &data))
+	require.Equal(item.Strings, data.Strings)
+
+	// removing the item burns the nft
+	k.RemoveItem(suite.ctx, item.CookbookId, item.Id)
+	require.False(nk.HasNFT(suite.ctx, classID, nftID))
+}
+
+func (suite *IntegrationTestSuite) TestSyncNFTMirror() {
+	k := suite.k
+	nk := suite.pylonsApp.NFTKeeper
+	require := suite.Require()
+
+	items := createNItemSameOwnerAndCookbook(k, suite.ctx, 3, "testCookbook", true)
+	classID := types.NFTClassID("testCookbook")
+	for _, item := range items {
+		require.NoError(nk.Burn(suite.ctx, classID, types.NFTID(item.Id)))
+	}
+
+	k.SyncNFTMirror(suite.ctx)
+	require.Len(nk.GetNFTsOfClass(suite.ctx, classID), len(items))
+}
@@ -0,0 +1,170 @@
+package keeper
+
+import (
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	capabilitytypes "github.com/cosmos/cosmos-sdk/x/capability/types"
+	host "github.com/cosmos/ibc-go/v5/modules/core/24-host"
+
+	"github.com/Pylons-tech/pylons/x/pylons/types"
+)
+
+// IsBound checks if the module is already bound to the desired port
+func (k Keeper) IsBound(ctx sdk.Context, portID string) bool {
+	_, ok := k.scopedKeeper.GetCapability(ctx, host.PortPath(portID))
+	return ok
+}
+
+// BindPort binds to a port and claims the returned capability
+func (k Keeper) BindPort(ctx sdk.Context, portID string) error {
+	capability := k.portKeeper.BindPort(ctx, portID)
+	return k.ClaimCapability(ctx, capability, host.PortPath(portID))
+}
+
+// AuthenticateCapability wraps the scopedKeeper's AuthenticateCapability function
+func (k Keeper) AuthenticateCapability(ctx sdk.Context, cap *capabilitytypes.Capability, name string) bool {
+	return k.scopedKeeper.AuthenticateCapability(ctx, cap, name)
+}
+
+// ClaimCapability allows the module to claim a capability that the IBC module passes to it
+func (k Keeper) ClaimCapability(ctx sdk.Context, cap *capabilitytypes.Capability, name string) error {
+	return k.scopedKeeper.ClaimCapability(ctx, cap, name)
+}
+
+// SetClassTrace set the class path of a cookbook holding items received over IBC
+func (k Keeper) SetClassTrace(ctx sdk.Context, trace types.ClassTrace) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.ClassTraceKey))
+	b := k.cdc.MustMarshal(&trace)
+	store.Set([]byte(trace.CookbookId), b)
+}
+
+// GetClassTrace returns the class path of a cookbook holding items received over IBC
+func (k Keeper) GetClassTrace(ctx sdk.Context, cookbookID string) (val types.ClassTrace, found bool) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.ClassTraceKey))
+	b := store.Get([]byte(cookbookID))
+	if b == nil {
+		return val, false
+	}
+	k.cdc.MustUnmarshal(b, &val)
+	return val, true
+}
+
+// GetAllClassTrace returns all class traces
+func (k Keeper) GetAllClassTrace(ctx sdk.Context) (list []types.ClassTrace) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.ClassTraceKey))
+	iterator := sdk.KVStorePrefixIterator(store, []byte{})
+
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var val types.ClassTrace
+		k.cdc.MustUnmarshal(iterator.Value(), &val)
+		list = append(list, val)
+	}
+
+	return
+}
+
+// SetItemToken set the token id of an item received over IBC, indexing the item by token id
+func (k Keeper) SetItemToken(ctx sdk.Context, token types.ItemToken) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.ItemTokenKey))
+	b := k.cdc.MustMarshal(&token)
+	store.Set(getItemTokenKey(token.CookbookId, token.ItemId), b)
+
+	tokenStore := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.TokenItemKey))
+	tokenStore.Set(getItemTokenKey(token.CookbookId, token.TokenId), []byte(token.ItemId))
+}
+
+// GetItemToken returns the token id of an item received over IBC
+func (k Keeper) GetItemToken(ctx sdk.Context, cookbookID, itemID string) (val types.ItemToken, found bool) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.ItemTokenKey))
+	b := store.Get(getItemTokenKey(cookbookID, itemID))
+	if b == nil {
+		return val, false
+	}
+	k.cdc.MustUnmarshal(b, &val)
+	return val, true
+}
+
+// GetItemIDByToken returns the id of the item minted for a token
+func (k Keeper) GetItemIDByToken(ctx sdk.Context, cookbookID, tokenID string) (string, bool) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.TokenItemKey))
+	b := store.Get(getItemTokenKey(cookbookID, tokenID))
+	if b == nil {
+		return "", false
+	}
+	return string(b), true
+}
+
+// RemoveItemToken removes the token id of an item from the store
+func (k Keeper) RemoveItemToken(ctx sdk.Context, cookbookID, itemID string) {
+	token, found := k.GetItemToken(ctx, cookbookID, itemID)
+	if !found {
+		return
+	}
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.ItemTokenKey))
+	store.Delete(getItemTokenKey(cookbookID, itemID))
+
+	tokenStore := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.TokenItemKey))
+	tokenStore.Delete(getItemTokenKey(cookbookID, token.TokenId))
+}
+
+// GetAllItemToken returns all item tokens
+func (k Keeper) GetAllItemToken(ctx sdk.Context) (list []types.ItemToken) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.ItemTokenKey))
+	iterator := sdk.KVStorePrefixIterator(store, []byte{})
+
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var val types.ItemToken
+		k.cdc.MustUnmarshal(iterator.Value(), &val)
+		list = append(list, val)
+	}
+
+	return
+}
+
+// SetItemEscrow records the channel an item was escrowed for
+func (k Keeper) SetItemEscrow(ctx sdk.Context, escrow types.ItemEscrow) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.ItemEscrowKey))
+	b := k.cdc.MustMarshal(&escrow)
+	store.Set(getItemTokenKey(escrow.CookbookId, escrow.ItemId), b)
+}
+
+// GetItemEscrow returns the channel an item was escrowed for
+func (k Keeper) GetItemEscrow(ctx sdk.Context, cookbookID, itemID string) (val types.ItemEscrow, found bool) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.ItemEscrowKey))
+	b := store.Get(getItemTokenKey(cookbookID, itemID))
+	if b == nil {
+		return val, false
+	}
+	k.cdc.MustUnmarshal(b, &val)
+	return val, true
+}
+
+// RemoveItemEscrow removes the escrow record of an item from the store
+func (k Keeper) RemoveItemEscrow(ctx sdk.Context, cookbookID, itemID string) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.ItemEscrowKey))
+	store.Delete(getItemTokenKey(cookbookID, itemID))
+}
+
+// GetAllItemEscrow returns all item escrow records
+func (k Keeper) GetAllItemEscrow(ctx sdk.Context) (list []types.ItemEscrow) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.ItemEscrowKey))
+	iterator := sdk.KVStorePrefixIterator(store, []byte{})
+
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var val types.ItemEscrow
+		k.cdc.MustUnmarshal(iterator.Value(), &val)
+		list = append(list, val)
+	}
+
+	return
+}
+
+func getItemTokenKey(cookbookID, id string) []byte {
+	return []byte(cookbookID + "-" + id)
+}
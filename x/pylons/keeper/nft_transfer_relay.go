@@ -0,0 +1,356 @@
+package keeper
+
+import (
+	"encoding/base64"
+	"strings"
+
+	"github.com/cosmos/cosmos-sdk/telemetry"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	clienttypes "github.com/cosmos/ibc-go/v5/modules/core/02-client/types"
+	channeltypes "github.com/cosmos/ibc-go/v5/modules/core/04-channel/types"
+	host "github.com/cosmos/ibc-go/v5/modules/core/24-host"
+
+	"github.com/Pylons-tech/pylons/x/pylons/types"
+)
+
+// TransferItems sends items of a cookbook to another chain as ICS-721 tokens and returns the packet sequence.
+// Items of this chain are escrowed, items previously received through the source channel are burned.
+func (k Keeper) TransferItems(
+	ctx sdk.Context,
+	sender string,
+	sourcePort,
+	sourceChannel,
+	cookbookID string,
+	itemIDs []string,
+	receiver string,
+	timeoutHeight clienttypes.Height,
+	timeoutTimestamp uint64,
+	memo string,
+) (uint64, error) {
+	sourceChannelEnd, found := k.channelKeeper.GetChannel(ctx, sourcePort, sourceChannel)
+	if !found {
+		return 0, sdkerrors.Wrapf(channeltypes.ErrChannelNotFound, "port ID (%s) channel ID (%s)", sourcePort, sourceChannel)
+	}
+	destinationPort := sourceChannelEnd.GetCounterparty().GetPortID()
+	destinationChannel := sourceChannelEnd.GetCounterparty().GetChannelID()
+
+	sequence, found := k.channelKeeper.GetNextSequenceSend(ctx, sourcePort, sourceChannel)
+	if !found {
+		return 0, sdkerrors.Wrapf(channeltypes.ErrSequenceSendNotFound, "source port: %s, source channel: %s", sourcePort, sourceChannel)
+	}
+
+	channelCap, ok := k.scopedKeeper.GetCapability(ctx, host.ChannelCapabilityPath(sourcePort, sourceChannel))
+	if !ok {
+		return 0, sdkerrors.Wrap(channeltypes.ErrChannelCapabilityNotFound, "module does not own channel capability")
+	}
+
+	cookbook, found := k.GetCookbook(ctx, cookbookID)
+	if !found {
+		return 0, sdkerrors.Wrapf(sdkerrors.ErrKeyNotFound, "cookbook with id %v not found", cookbookID)
+	}
+
+	classID := cookbookID
+	if trace, found := k.GetClassTrace(ctx, cookbookID); found {
+		classID = trace.Path
+	}
+	// items received through the source channel go back to the chain they came from
+	returning := strings.HasPrefix(classID, types.ClassPathPrefix(sourcePort, sourceChannel))
+
+	var owner string
+	tokenIDs := make([]string, len(itemIDs))
+	tokenData := make([]string, len(itemIDs))
+	for i, itemID := range itemIDs {
+		item, found := k.GetItem(ctx, cookbookID, itemID)
+		if !found {
+			return 0, sdkerrors.Wrapf(sdkerrors.ErrKeyNotFound, "item with id %v and cookbook id %v not found", itemID, cookbookID)
+		}
+		if i == 0 {
+			owner = item.Owner
+		}
+		if item.Owner != owner {
+			return 0, sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "all items must have the same owner")
+		}
+		if !k.IsApprovedOrOwner(ctx, item, sender) {
+			return 0, sdkerrors.Wrapf(sdkerrors.ErrUnauthorized, "item with id %v and cookbook id %v not owned by sender", itemID, cookbookID)
+		}
+		if !item.Tradeable {
+			return 0, sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "item with id %v and cookbook id %v cannot be transferred", itemID, cookbookID)
+		}
+		if item.IsExpired(ctx) {
+			return 0, sdkerrors.Wrapf(types.ErrItemExpired, "item with id %v and cookbook id %v expired", itemID, cookbookID)
+		}
+		if err := item.CanTransfer(ctx); err != nil {
+			return 0, err
+		}
+		if k.HasContainerItems(ctx, cookbookID, itemID) {
+			return 0, sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "item with id %v and cookbook id %v holds items", itemID, cookbookID)
+		}
+
+		tokenIDs[i] = itemID
+		if token, found := k.GetItemToken(ctx, cookbookID, itemID); found {
+			tokenIDs[i] = token.TokenId
+		}
+		bz, err := k.cdc.MarshalJSON(&item)
+		if err != nil {
+			return 0, err
+		}
+		tokenData[i] = base64.StdEncoding.EncodeToString(bz)
+
+		if returning {
+			k.RemoveItem(ctx, cookbookID, itemID)
+		} else {
+			k.EscrowItemForNFTTransfer(ctx, item)
+			k.SetItemEscrow(ctx, types.ItemEscrow{
+				CookbookId: cookbookID,
+				ItemId:     itemID,
+				PortId:     sourcePort,
+				ChannelId:  sourceChannel,
+			})
+		}
+	}
+
+	classData, err := k.cdc.MarshalJSON(&cookbook)
+	if err != nil {
+		return 0, err
+	}
+	packetData := types.NonFungibleTokenPacketData{
+		ClassID:   classID,
+		ClassData: base64.StdEncoding.EncodeToString(classData),
+		TokenIDs:  tokenIDs,
+		TokenData: tokenData,
+		Sender:    owner,
+		Receiver:  receiver,
+		Memo:      memo,
+	}
+	packet := channeltypes.NewPacket(
+		packetData.GetBytes(),
+		sequence,
+		sourcePort,
+		sourceChannel,
+		destinationPort,
+		destinationChannel,
+		timeoutHeight,
+		timeoutTimestamp,
+	)
+	if err := k.channelKeeper.SendPacket(ctx, channelCap, packet); err != nil {
+		return 0, err
+	}
+
+	err = ctx.EventManager().EmitTypedEvent(&types.EventTransferItems{
+		Sender:        owner,
+		Receiver:      receiver,
+		SourcePort:    sourcePort,
+		SourceChannel: sourceChannel,
+		ClassId:       classID,
+		TokenIds:      tokenIDs,
+	})
+
+	telemetry.IncrCounter(float32(len(itemIDs)), "nft_transfer", "send")
+
+	return sequence, err
+}
+
+// OnRecvPacket releases the escrowed items of tokens coming back to this chain
+// or mints new items in the cookbook of the received class
+func (k Keeper) OnRecvPacket(ctx sdk.Context, packet channeltypes.Packet, data types.NonFungibleTokenPacketData) error {
+	if err := data.ValidateBasic(); err != nil {
+		return err
+	}
+	if _, err := sdk.AccAddressFromBech32(data.Receiver); err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid receiver address (%s)", err)
+	}
+
+	var cookbookID string
+	itemIDs := make([]string, len(data.TokenIDs))
+	sourcePrefix := types.ClassPathPrefix(packet.GetSourcePort(), packet.GetSourceChannel())
+	if strings.HasPrefix(data.ClassID, sourcePrefix) {
+		// the class was sent from this chain, release the escrowed items
+		cookbookID = classCookbookID(strings.TrimPrefix(data.ClassID, sourcePrefix))
+		for i, tokenID := range data.TokenIDs {
+			itemID, err := k.unescrowToken(ctx, cookbookID, tokenID, packet.GetDestPort(), packet.GetDestChannel(), data.Receiver)
+			if err != nil {
+				return err
+			}
+			itemIDs[i] = itemID
+		}
+	} else {
+		classPath := types.ClassPathPrefix(packet.GetDestPort(), packet.GetDestChannel()) + data.ClassID
+		cookbookID = k.getOrCreateClassCookbook(ctx, classPath, data.ClassData)
+		for i, tokenID := range data.TokenIDs {
+			itemID, err := k.mintToken(ctx, cookbookID, tokenID, tokenURI(data, i), tokenDataAt(data, i), data.Receiver)
+			if err != nil {
+				return err
+			}
+			itemIDs[i] = itemID
+		}
+	}
+
+	telemetry.IncrCounter(float32(len(itemIDs)), "nft_transfer", "receive")
+
+	return ctx.EventManager().EmitTypedEvent(&types.EventReceiveItems{
+		Sender:     data.Sender,
+		Receiver:   data.Receiver,
+		ClassId:    data.ClassID,
+		CookbookId: cookbookID,
+		ItemIds:    itemIDs,
+	})
+}
+
+// OnAcknowledgementPacket refunds the sender when the receiving chain failed to process the packet
+func (k Keeper) OnAcknowledgementPacket(ctx sdk.Context, packet channeltypes.Packet, data types.NonFungibleTokenPacketData, ack channeltypes.Acknowledgement) error {
+	switch ack.Response.(type) {
+	case *channeltypes.Acknowledgement_Error:
+		return k.refundPacketItems(ctx, packet, data)
+	default:
+		// the items were received, nothing to do
+		return nil
+	}
+}
+
+// OnTimeoutPacket refunds the sender of a packet that timed out
+func (k Keeper) OnTimeoutPacket(ctx sdk.Context, packet channeltypes.Packet, data types.NonFungibleTokenPacketData) error {
+	return k.refundPacketItems(ctx, packet, data)
+}
+
+// refundPacketItems releases the escrowed items or mints back the burned items of a packet
+func (k Keeper) refundPacketItems(ctx sdk.Context, packet channeltypes.Packet, data types.NonFungibleTokenPacketData) error {
+	cookbookID := classCookbookID(data.ClassID)
+	if strings.HasPrefix(data.ClassID, types.ClassPathPrefix(packet.GetSourcePort(), packet.GetSourceChannel())) {
+		for i, tokenID := range data.TokenIDs {
+			if _, err := k.mintToken(ctx, cookbookID, tokenID, tokenURI(data, i), tokenDataAt(data, i), data.Sender); err != nil {
+				return err
+			}
+		}
+	} else {
+		for _, tokenID := range data.TokenIDs {
+			if _, err := k.unescrowToken(ctx, cookbookID, tokenID, packet.GetSourcePort(), packet.GetSourceChannel(), data.Sender); err != nil {
+				return err
+			}
+		}
+	}
+
+	telemetry.IncrCounter(float32(len(data.TokenIDs)), "nft_transfer", "refund")
+
+	return ctx.EventManager().EmitTypedEvent(&types.EventRefundItems{
+		Sender:   data.Sender,
+		ClassId:  data.ClassID,
+		TokenIds: data.TokenIDs,
+	})
+}
+
+// unescrowToken releases the item of a token escrowed for a channel to addr
+func (k Keeper) unescrowToken(ctx sdk.Context, cookbookID, tokenID, portID, channelID, addr string) (string, error) {
+	itemID, found := k.GetItemIDByToken(ctx, cookbookID, tokenID)
+	if !found {
+		itemID = tokenID
+	}
+	escrow, found := k.GetItemEscrow(ctx, cookbookID, itemID)
+	if !found || escrow.PortId != portID || escrow.ChannelId != channelID {
+		return "", sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "token %v of cookbook %v not escrowed for channel %v", tokenID, cookbookID, channelID)
+	}
+	item, found := k.GetItem(ctx, cookbookID, itemID)
+	if !found {
+		return "", sdkerrors.Wrapf(sdkerrors.ErrKeyNotFound, "item with id %v and cookbook id %v not found", itemID, cookbookID)
+	}
+	k.RemoveItemEscrow(ctx, cookbookID, itemID)
+	k.UnescrowItemForNFTTransfer(ctx, item, addr)
+	return itemID, nil
+}
+
+// mintToken mints the item of a token in a cookbook holding items received over IBC.
+// The item attributes are restored when the token data was sent by a pylons chain.
+func (k Keeper) mintToken(ctx sdk.Context, cookbookID, tokenID, uri, data, owner string) (string, error) {
+	if _, found := k.GetItemIDByToken(ctx, cookbookID, tokenID); found {
+		return "", sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "token %v of cookbook %v already exists", tokenID, cookbookID)
+	}
+
+	item := types.Item{
+		Tradeable:       true,
+		TradePercentage: sdk.ZeroDec(),
+		Quantity:        1,
+	}
+	// only the attributes are restored from the token data, the economic properties of the item are not validated by
+	// this chain so a minted item is always a single tradeable unit without transfer fee, royalties nor expiry
+	if bz, err := base64.StdEncoding.DecodeString(data); err == nil && len(bz) != 0 {
+		var sourceItem types.Item
+		if err := k.cdc.UnmarshalJSON(bz, &sourceItem); err == nil {
+			item.Doubles = sourceItem.Doubles
+			item.Longs = sourceItem.Longs
+			item.Strings = sourceItem.Strings
+			item.MutableStrings = sourceItem.MutableStrings
+		}
+	}
+	if uri != "" {
+		item.Strings = append(item.Strings, types.StringKeyValue{Key: "tokenUri", Value: uri})
+	}
+	item.TransferFee = []sdk.Coin{sdk.NewCoin(types.PylonsCoinDenom, sdk.ZeroInt())}
+	item.Owner = owner
+	item.CookbookId = cookbookID
+	item.NodeVersion = k.EngineVersion(ctx)
+	item.LastUpdate = ctx.BlockHeight()
+	item.CreatedAt = ctx.BlockTime().Unix()
+	item.UpdatedAt = ctx.BlockTime().Unix()
+
+	id := k.AppendItem(ctx, item)
+	k.SetItemToken(ctx, types.ItemToken{
+		CookbookId: cookbookID,
+		ItemId:     id,
+		TokenId:    tokenID,
+	})
+	return id, nil
+}
+
+// getOrCreateClassCookbook returns the id of the cookbook holding the items of a class path, creating it on first receipt.
+// These cookbooks are owned by the fee collector so no recipe can be added to them.
+func (k Keeper) getOrCreateClassCookbook(ctx sdk.Context, classPath, classData string) string {
+	cookbookID := types.IBCClassCookbookID(classPath)
+	if _, found := k.GetCookbook(ctx, cookbookID); found {
+		return cookbookID
+	}
+
+	cookbook := types.Cookbook{
+		Creator:     k.FeeCollectorAddress().String(),
+		Id:          cookbookID,
+		NodeVersion: k.EngineVersion(ctx),
+		Name:        classPath,
+		Version:     "v0.0.1",
+		Enabled:     true,
+	}
+	if bz, err := base64.StdEncoding.DecodeString(classData); err == nil && len(bz) != 0 {
+		var sourceCookbook types.Cookbook
+		if err := k.cdc.UnmarshalJSON(bz, &sourceCookbook); err == nil {
+			cookbook.Description = sourceCookbook.Description
+			cookbook.Developer = sourceCookbook.Developer
+			cookbook.SupportEmail = sourceCookbook.SupportEmail
+		}
+	}
+	k.SetCookbook(ctx, cookbook)
+	k.SetClassTrace(ctx, types.ClassTrace{
+		CookbookId: cookbookID,
+		Path:       classPath,
+	})
+	return cookbookID
+}
+
+// classCookbookID returns the id of the cookbook of a class id on this chain
+func classCookbookID(classID string) string {
+	if strings.Contains(classID, "/") {
+		return types.IBCClassCookbookID(classID)
+	}
+	return classID
+}
+
+func tokenURI(data types.NonFungibleTokenPacketData, i int) string {
+	if len(data.TokenURIs) == 0 {
+		return ""
+	}
+	return data.TokenURIs[i]
+}
+
+func tokenDataAt(data types.NonFungibleTokenPacketData, i int) string {
+	if len(data.TokenData) == 0 {
+		return ""
+	}
+	return data.TokenData[i]
+}
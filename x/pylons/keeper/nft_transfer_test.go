@@ -0,0 +1,403 @@
+package keeper_test
+
+import (
+	"encoding/base64"
+	"testing"
+	"time"
+
+	"github.com/cosmos/cosmos-sdk/crypto/keys/secp256k1"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	clienttypes "github.com/cosmos/ibc-go/v5/modules/core/02-client/types"
+	channeltypes "github.com/cosmos/ibc-go/v5/modules/core/04-channel/types"
+	ibctesting "github.com/cosmos/ibc-go/v5/testing"
+	"github.com/cosmos/ibc-go/v5/testing/mock"
+	"github.com/stretchr/testify/require"
+	"github.com/stretchr/testify/suite"
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+	tmtypes "github.com/tendermint/tendermint/types"
+
+	"github.com/Pylons-tech/pylons/app"
+	"github.com/Pylons-tech/pylons/x/pylons/keeper"
+	"github.com/Pylons-tech/pylons/x/pylons/types"
+)
+
+const (
+	testCounterpartyChannel = "channel-7"
+	testChannel             = "channel-0"
+)
+
+// newTestNFTPacket returns a packet received on testChannel from testCounterpartyChannel
+func newTestNFTPacket(data types.NonFungibleTokenPacketData) channeltypes.Packet {
+	return channeltypes.NewPacket(data.GetBytes(), 1, types.NFTTransferPortID, testCounterpartyChannel, types.NFTTransferPortID, testChannel, clienttypes.NewHeight(0, 100), 0)
+}
+
+func (suite *IntegrationTestSuite) TestNFTTransferPortBound() {
+	suite.Require().True(suite.k.IsBound(suite.ctx, types.NFTTransferPortID))
+}
+
+func (suite *IntegrationTestSuite) TestTransferItemsChannelNotFound() {
+	k := suite.k
+	ctx := sdk.WrapSDKContext(suite.ctx)
+	srv := keeper.NewMsgServerImpl(k)
+	require := suite.Require()
+
+	items := createNItemSameOwnerAndCookbook(k, suite.ctx, 1, "testCookbook", true)
+	msg := types.NewMsgTransferItems(items[0].Owner, types.NFTTransferPortID, testChannel, items[0].CookbookId, []string{items[0].Id}, types.GenTestBech32FromString("receiver"), clienttypes.NewHeight(0, 100), 0, "")
+	_, err := srv.TransferItems(ctx, msg)
+	require.ErrorIs(err, channeltypes.ErrChannelNotFound)
+}
+
+func (suite *IntegrationTestSuite) TestOnRecvPacketMint() {
+	k := suite.k
+	require := suite.Require()
+
+	sourceItem := types.Item{
+		Owner:           types.GenTestBech32FromString("sender"),
+		CookbookId:      "remoteCookbook",
+		Id:              "remoteItem",
+		Strings:         []types.StringKeyValue{{Key: "name", Value: "sword"}},
+		Longs:           []types.LongKeyValue{{Key: "level", Value: 3}},
+		Tradeable:       false,
+		TransferFee:     []sdk.Coin{sdk.NewCoin(types.PylonsCoinDenom, sdk.NewInt(10))},
+		TradePercentage: sdk.MustNewDecFromStr("0.1"),
+		Fungible:        true,
+		Quantity:        1000,
+		ExpiresAt:       1,
+	}
+	bz, err := suite.pylonsApp.AppCodec().MarshalJSON(&sourceItem)
+	require.NoError(err)
+	receiver := types.GenTestBech32FromString("receiver")
+	data := types.NonFungibleTokenPacketData{
+		ClassID:   "remoteCookbook",
+		TokenIDs:  []string{"remoteItem", "otherToken"},
+		TokenURIs: []string{"", "https://example.com/token"},
+		TokenData: []string{base64.StdEncoding.EncodeToString(bz), ""},
+		Sender:    sourceItem.Owner,
+		Receiver:  receiver,
+	}
+	require.NoError(k.OnRecvPacket(suite.ctx, newTestNFTPacket(data), data))
+
+	classPath := types.ClassPathPrefix(types.NFTTransferPortID, testChannel) + "remoteCookbook"
+	cookbookID := types.IBCClassCookbookID(classPath)
+	trace, found := k.GetClassTrace(suite.ctx, cookbookID)
+	require.True(found)
+	require.Equal(classPath, trace.Path)
+	cookbook, found := k.GetCookbook(suite.ctx, cookbookID)
+	require.True(found)
+	require.Equal(k.FeeCollectorAddress().String(), cookbook.Creator)
+
+	itemID, found := k.GetItemIDByToken(suite.ctx, cookbookID, "remoteItem")
+	require.True(found)
+	item, found := k.GetItem(suite.ctx, cookbookID, itemID)
+	require.True(found)
+	require.Equal(receiver, item.Owner)
+	require.Equal(sourceItem.Strings, item.Strings)
+	require.Equal(sourceItem.Longs, item.Longs)
+	// the economic properties of the source item are ignored
+	require.True(item.Tradeable)
+	require.False(item.Fungible)
+	require.Equal(uint64(1), item.Quantity)
+	require.Zero(item.ExpiresAt)
+	require.Equal([]sdk.Coin{sdk.NewCoin(types.PylonsCoinDenom, sdk.ZeroInt())}, item.TransferFee)
+	require.True(item.TradePercentage.IsZero())
+
+	itemID, found = k.GetItemIDByToken(suite.ctx, cookbookID, "otherToken")
+	require.True(found)
+	item, found = k.GetItem(suite.ctx, cookbookID, itemID)
+	require.True(found)
+	require.True(item.Tradeable)
+	require.Equal([]types.StringKeyValue{{Key: "tokenUri", Value: "https://example.com/token"}}, item.Strings)
+
+	// the same token cannot be received twice
+	require.Error(k.OnRecvPacket(suite.ctx, newTestNFTPacket(data), data))
+}
+
+func (suite *IntegrationTestSuite) TestOnRecvPacketUnescrow() {
+	k := suite.k
+	require := suite.Require()
+
+	items := createNItemSameOwnerAndCookbook(k, suite.ctx, 1, "testCookbook", true)
+	item := items[0]
+	k.EscrowItemForNFTTransfer(suite.ctx, item)
+	k.SetItemEscrow(suite.ctx, types.ItemEscrow{CookbookId: item.CookbookId, ItemId: item.Id, PortId: types.NFTTransferPortID, ChannelId: testChannel})
+
+	receiver := types.GenTestBech32FromString("receiver")
+	data := types.NonFungibleTokenPacketData{
+		ClassID:  types.ClassPathPrefix(types.NFTTransferPortID, testCounterpartyChannel) + item.CookbookId,
+		TokenIDs: []string{item.Id},
+		Sender:   types.GenTestBech32FromString("sender"),
+		Receiver: receiver,
+	}
+	require.NoError(k.OnRecvPacket(suite.ctx, newTestNFTPacket(data), data))
+
+	item, found := k.GetItem(suite.ctx, item.CookbookId, item.Id)
+	require.True(found)
+	require.Equal(receiver, item.Owner)
+	_, found = k.GetItemEscrow(suite.ctx, item.CookbookId, item.Id)
+	require.False(found)
+
+	// the item is no longer escrowed
+	require.Error(k.OnRecvPacket(suite.ctx, newTestNFTPacket(data), data))
+}
+
+func (suite *IntegrationTestSuite) TestOnTimeoutPacketRefund() {
+	k := suite.k
+	require := suite.Require()
+
+	items := createNItemSameOwnerAndCookbook(k, suite.ctx, 1, "testCookbook", true)
+	item := items[0]
+	k.EscrowItemForNFTTransfer(suite.ctx, item)
+	k.SetItemEscrow(suite.ctx, types.ItemEscrow{CookbookId: item.CookbookId, ItemId: item.Id, PortId: types.NFTTransferPortID, ChannelId: testChannel})
+
+	data := types.NonFungibleTokenPacketData{
+		ClassID:  item.CookbookId,
+		TokenIDs: []string{item.Id},
+		Sender:   item.Owner,
+		Receiver: types.GenTestBech32FromString("receiver"),
+	}
+	packet := channeltypes.NewPacket(data.GetBytes(), 1, types.NFTTransferPortID, testChannel, types.NFTTransferPortID, testCounterpartyChannel, clienttypes.NewHeight(0, 100), 0)
+
+	// a successful acknowledgement leaves the item escrowed
+	require.NoError(k.OnAcknowledgementPacket(suite.ctx, packet, data, channeltypes.NewResultAcknowledgement([]byte{byte(1)})))
+	escrowed, found := k.GetItem(suite.ctx, item.CookbookId, item.Id)
+	require.True(found)
+	require.Equal(k.NFTTransferEscrowAddress().String(), escrowed.Owner)
+
+	require.NoError(k.OnTimeoutPacket(suite.ctx, packet, data))
+	refunded, found := k.GetItem(suite.ctx, item.CookbookId, item.Id)
+	require.True(found)
+	require.Equal(item.Owner, refunded.Owner)
+	_, found = k.GetItemEscrow(suite.ctx, item.CookbookId, item.Id)
+	require.False(found)
+}
+
+func (suite *IntegrationTestSuite) TestOnAcknowledgementPacketRefundVoucher() {
+	k := suite.k
+	require := suite.Require()
+
+	// a voucher sent back to its source chain is burned, an error acknowledgement mints it back
+	classID := types.ClassPathPrefix(types.NFTTransferPortID, testChannel) + "remoteCookbook"
+	cookbookID := types.IBCClassCookbookID(classID)
+	sender := types.GenTestBech32FromString("sender")
+	data := types.NonFungibleTokenPacketData{
+		ClassID:  classID,
+		TokenIDs: []string{"remoteItem"},
+		Sender:   sender,
+		Receiver: types.GenTestBech32FromString("receiver"),
+	}
+	packet := channeltypes.NewPacket(data.GetBytes(), 1, types.NFTTransferPortID, testChannel, types.NFTTransferPortID, testCounterpartyChannel, clienttypes.NewHeight(0, 100), 0)
+
+	require.NoError(k.OnAcknowledgementPacket(suite.ctx, packet, data, channeltypes.NewErrorAcknowledgement(types.ErrInvalidVersion)))
+	itemID, found := k.GetItemIDByToken(suite.ctx, cookbookID, "remoteItem")
+	require.True(found)
+	item, found := k.GetItem(suite.ctx, cookbookID, itemID)
+	require.True(found)
+	require.Equal(sender, item.Owner)
+}
+
+func (suite *IntegrationTestSuite) TestCreateCookbookIBCPrefix() {
+	ctx := sdk.WrapSDKContext(suite.ctx)
+	srv := keeper.NewMsgServerImpl(suite.k)
+
+	_, err := srv.CreateCookbook(ctx, &types.MsgCreateCookbook{
+		Creator:      types.GenTestBech32FromString("creator"),
+		Id:           types.IBCCookbookPrefix + "cookbook",
+		Name:         "testCookbookName",
+		Description:  "descdescdescdescdescdesc",
+		Version:      "v0.0.1",
+		SupportEmail: "test@email.com",
+		Enabled:      true,
+	})
+	suite.Require().ErrorIs(err, sdkerrors.ErrInvalidRequest)
+}
+
+// NFTTransferTestSuite relays ICS-721 packets between two pylons chains
+type NFTTransferTestSuite struct {
+	suite.Suite
+
+	coordinator *ibctesting.Coordinator
+	chainA      *ibctesting.TestChain
+	chainB      *ibctesting.TestChain
+	path        *ibctesting.Path
+}
+
+// newNFTTransferTestChain builds an ibctesting chain running a PylonsApp. ibctesting.NewTestChain
+// cannot be used as it expects the chain to run the ibc-go SimApp.
+func newNFTTransferTestChain(t *testing.T, coord *ibctesting.Coordinator, chainID string) *ibctesting.TestChain {
+	privVal := mock.NewPV()
+	pubKey, err := privVal.GetPubKey()
+	require.NoError(t, err)
+	valSet := tmtypes.NewValidatorSet([]*tmtypes.Validator{tmtypes.NewValidator(pubKey, 1)})
+	signers := map[string]tmtypes.PrivValidator{pubKey.Address().String(): privVal}
+
+	genAccs := make([]authtypes.GenesisAccount, ibctesting.MaxAccounts)
+	genBals := make([]banktypes.Balance, ibctesting.MaxAccounts)
+	senderAccs := make([]ibctesting.SenderAccount, ibctesting.MaxAccounts)
+	for i := range senderAccs {
+		senderPrivKey := secp256k1.GenPrivKey()
+		acc := authtypes.NewBaseAccount(senderPrivKey.PubKey().Address().Bytes(), senderPrivKey.PubKey(), uint64(i), 0)
+		genAccs[i] = acc
+		genBals[i] = banktypes.Balance{
+			Address: acc.GetAddress().String(),
+			Coins:   sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, sdk.NewInt(100000000000000))),
+		}
+		senderAccs[i] = ibctesting.SenderAccount{SenderAccount: acc, SenderPrivKey: senderPrivKey}
+	}
+
+	testingApp := ibctesting.SetupWithGenesisValSet(t, valSet, genAccs, chainID, sdk.DefaultPowerReduction, genBals...)
+	chain := &ibctesting.TestChain{
+		T:           t,
+		Coordinator: coord,
+		ChainID:     chainID,
+		App:         testingApp,
+		CurrentHeader: tmproto.Header{
+			ChainID: chainID,
+			Height:  1,
+			Time:    coord.CurrentTime.UTC(),
+		},
+		QueryServer:    testingApp.GetIBCKeeper(),
+		TxConfig:       testingApp.GetTxConfig(),
+		Codec:          testingApp.AppCodec(),
+		Vals:           valSet,
+		NextVals:       valSet,
+		Signers:        signers,
+		SenderPrivKey:  senderAccs[0].SenderPrivKey,
+		SenderAccount:  senderAccs[0].SenderAccount,
+		SenderAccounts: senderAccs,
+	}
+	coord.CommitBlock(chain)
+	return chain
+}
+
+func (suite *NFTTransferTestSuite) SetupTest() {
+	ibctesting.DefaultTestingAppInit = app.SetupTestingApp
+
+	suite.coordinator = &ibctesting.Coordinator{
+		T:           suite.T(),
+		CurrentTime: time.Date(2020, 1, 2, 0, 0, 0, 0, time.UTC),
+	}
+	suite.chainA = newNFTTransferTestChain(suite.T(), suite.coordinator, ibctesting.GetChainID(1))
+	suite.chainB = newNFTTransferTestChain(suite.T(), suite.coordinator, ibctesting.GetChainID(2))
+	suite.coordinator.Chains = map[string]*ibctesting.TestChain{
+		suite.chainA.ChainID: suite.chainA,
+		suite.chainB.ChainID: suite.chainB,
+	}
+
+	suite.path = ibctesting.NewPath(suite.chainA, suite.chainB)
+	for _, endpoint := range []*ibctesting.Endpoint{suite.path.EndpointA, suite.path.EndpointB} {
+		endpoint.ChannelConfig.PortID = types.NFTTransferPortID
+		endpoint.ChannelConfig.Version = types.NFTTransferVersion
+		endpoint.ChannelConfig.Order = channeltypes.UNORDERED
+	}
+	suite.coordinator.Setup(suite.path)
+}
+
+// pylonsKeeper returns the pylons keeper of a chain
+func pylonsKeeper(chain *ibctesting.TestChain) keeper.Keeper {
+	return chain.App.(*app.PylonsApp).PylonsKeeper
+}
+
+// createTransferableItem stores a tradeable item owned by the sender account of a chain
+func (suite *NFTTransferTestSuite) createTransferableItem(chain *ibctesting.TestChain, cookbookID string) types.Item {
+	k := pylonsKeeper(chain)
+	ctx := chain.GetContext()
+	owner := chain.SenderAccount.GetAddress().String()
+	if _, found := k.GetCookbook(ctx, cookbookID); !found {
+		k.SetCookbook(ctx, types.Cookbook{
+			Creator: owner,
+			Id:      cookbookID,
+			Name:    "testCookbookName",
+			Version: "v0.0.1",
+			Enabled: true,
+		})
+	}
+	item := types.Item{
+		Owner:           owner,
+		CookbookId:      cookbookID,
+		Strings:         []types.StringKeyValue{{Key: "name", Value: "sword"}},
+		TransferFee:     []sdk.Coin{sdk.NewCoin(types.PylonsCoinDenom, sdk.ZeroInt())},
+		TradePercentage: sdk.ZeroDec(),
+		Tradeable:       true,
+		Quantity:        1,
+	}
+	item.Id = k.AppendItem(ctx, item)
+	suite.coordinator.CommitBlock(chain)
+	return item
+}
+
+// sendItem transfers an item of chainA over the path and returns the packet sent
+func (suite *NFTTransferTestSuite) sendItem(item types.Item, receiver string, timeoutHeight clienttypes.Height) channeltypes.Packet {
+	msg := types.NewMsgTransferItems(item.Owner, suite.path.EndpointA.ChannelConfig.PortID, suite.path.EndpointA.ChannelID, item.CookbookId, []string{item.Id}, receiver, timeoutHeight, 0, "")
+	res, err := suite.chainA.SendMsgs(msg)
+	suite.Require().NoError(err)
+	packet, err := ibctesting.ParsePacketFromEvents(res.GetEvents())
+	suite.Require().NoError(err)
+	return packet
+}
+
+func (suite *NFTTransferTestSuite) TestRelayTransferAndAck() {
+	require := suite.Require()
+	kA, kB := pylonsKeeper(suite.chainA), pylonsKeeper(suite.chainB)
+
+	item := suite.createTransferableItem(suite.chainA, "testCookbook")
+	receiver := suite.chainB.SenderAccount.GetAddress().String()
+	packet := suite.sendItem(item, receiver, clienttypes.NewHeight(0, 110))
+
+	escrowed, found := kA.GetItem(suite.chainA.GetContext(), item.CookbookId, item.Id)
+	require.True(found)
+	require.Equal(kA.NFTTransferEscrowAddress().String(), escrowed.Owner)
+
+	// receive on chainB and acknowledge on chainA
+	require.NoError(suite.path.RelayPacket(packet))
+
+	classPath := types.ClassPathPrefix(suite.path.EndpointB.ChannelConfig.PortID, suite.path.EndpointB.ChannelID) + item.CookbookId
+	cookbookID := types.IBCClassCookbookID(classPath)
+	trace, found := kB.GetClassTrace(suite.chainB.GetContext(), cookbookID)
+	require.True(found)
+	require.Equal(classPath, trace.Path)
+	itemID, found := kB.GetItemIDByToken(suite.chainB.GetContext(), cookbookID, item.Id)
+	require.True(found)
+	received, found := kB.GetItem(suite.chainB.GetContext(), cookbookID, itemID)
+	require.True(found)
+	require.Equal(receiver, received.Owner)
+	require.Equal(item.Strings, received.Strings)
+
+	// the successful acknowledgement leaves the item escrowed on chainA
+	escrowed, found = kA.GetItem(suite.chainA.GetContext(), item.CookbookId, item.Id)
+	require.True(found)
+	require.Equal(kA.NFTTransferEscrowAddress().String(), escrowed.Owner)
+	_, found = kA.GetItemEscrow(suite.chainA.GetContext(), item.CookbookId, item.Id)
+	require.True(found)
+}
+
+func (suite *NFTTransferTestSuite) TestRelayTransferTimeout() {
+	require := suite.Require()
+	kA, kB := pylonsKeeper(suite.chainA), pylonsKeeper(suite.chainB)
+
+	item := suite.createTransferableItem(suite.chainA, "testCookbook")
+	// the packet times out once the block chainB is building is committed
+	timeoutHeight := clienttypes.GetSelfHeight(suite.chainB.GetContext())
+	packet := suite.sendItem(item, suite.chainB.SenderAccount.GetAddress().String(), timeoutHeight)
+
+	suite.coordinator.CommitBlock(suite.chainB)
+	require.NoError(suite.path.EndpointA.UpdateClient())
+	require.NoError(suite.path.EndpointA.TimeoutPacket(packet))
+
+	refunded, found := kA.GetItem(suite.chainA.GetContext(), item.CookbookId, item.Id)
+	require.True(found)
+	require.Equal(item.Owner, refunded.Owner)
+	_, found = kA.GetItemEscrow(suite.chainA.GetContext(), item.CookbookId, item.Id)
+	require.False(found)
+
+	// nothing was received on chainB
+	classPath := types.ClassPathPrefix(suite.path.EndpointB.ChannelConfig.PortID, suite.path.EndpointB.ChannelID) + item.CookbookId
+	_, found = kB.GetClassTrace(suite.chainB.GetContext(), types.IBCClassCookbookID(classPath))
+	require.False(found)
+}
+
+func TestNFTTransferTestSuite(t *testing.T) {
+	suite.Run(t, new(NFTTransferTestSuite))
+}
@@ -0,0 +1,217 @@
+package keeper
+
+import (
+	"sort"
+
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/Pylons-tech/pylons/x/pylons/types"
+)
+
+// SetRecipeStats set the stats of a specific recipe in the store
+func (k Keeper) SetRecipeStats(ctx sdk.Context, stats types.RecipeStats) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.RecipeStatsKey))
+	b := k.cdc.MustMarshal(&stats)
+	store.Set(types.RecipeStatsStoreKey(stats.CookbookId, stats.RecipeId), b)
+}
+
+// GetRecipeStats returns the stats of a recipe, found is false if the recipe was never executed
+func (k Keeper) GetRecipeStats(ctx sdk.Context, cookbookID, recipeID string) (val types.RecipeStats, found bool) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.RecipeStatsKey))
+	b := store.Get(types.RecipeStatsStoreKey(cookbookID, recipeID))
+	if b == nil {
+		return types.RecipeStats{CookbookId: cookbookID, RecipeId: recipeID}, false
+	}
+	k.cdc.MustUnmarshal(b, &val)
+	return val, true
+}
+
+// GetAllRecipeStats returns the stats of all recipes
+func (k Keeper) GetAllRecipeStats(ctx sdk.Context) (list []types.RecipeStats) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.RecipeStatsKey))
+	iterator := sdk.KVStorePrefixIterator(store, []byte{})
+
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var val types.RecipeStats
+		k.cdc.MustUnmarshal(iterator.Value(), &val)
+		list = append(list, val)
+	}
+
+	return
+}
+
+// SetCookbookStats set the stats of a specific cookbook in the store
+func (k Keeper) SetCookbookStats(ctx sdk.Context, stats types.CookbookStats) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.CookbookStatsKey))
+	b := k.cdc.MustMarshal(&stats)
+	store.Set(types.KeyPrefix(stats.CookbookId), b)
+}
+
+// GetCookbookStats returns the stats of a cookbook, found is false if no recipe of the cookbook was ever executed
+func (k Keeper) GetCookbookStats(ctx sdk.Context, cookbookID string) (val types.CookbookStats, found bool) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.CookbookStatsKey))
+	b := store.Get(types.KeyPrefix(cookbookID))
+	if b == nil {
+		return types.CookbookStats{CookbookId: cookbookID}, false
+	}
+	k.cdc.MustUnmarshal(b, &val)
+	return val, true
+}
+
+// GetAllCookbookStats returns the stats of all cookbooks
+func (k Keeper) GetAllCookbookStats(ctx sdk.Context) (list []types.CookbookStats) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.CookbookStatsKey))
+	iterator := sdk.KVStorePrefixIterator(store, []byte{})
+
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var val types.CookbookStats
+		k.cdc.MustUnmarshal(iterator.Value(), &val)
+		list = append(list, val)
+	}
+
+	return
+}
+
+// getExecutors returns the addresses recorded in an executors store
+func getExecutors(store prefix.Store) []string {
+	iterator := sdk.KVStorePrefixIterator(store, []byte{})
+
+	defer iterator.Close()
+
+	executors := []string{}
+	for ; iterator.Valid(); iterator.Next() {
+		executors = append(executors, sdk.AccAddress(iterator.Key()).String())
+	}
+
+	return executors
+}
+
+// SetRecipeExecutors records the executors of a recipe
+func (k Keeper) SetRecipeExecutors(ctx sdk.Context, executors types.RecipeExecutors) {
+	for _, executor := range executors.Executors {
+		addr, _ := sdk.AccAddressFromBech32(executor)
+		k.addRecipeExecutor(ctx, executors.CookbookId, executors.RecipeId, addr)
+	}
+}
+
+// GetAllRecipeExecutors returns the executors of all executed recipes
+func (k Keeper) GetAllRecipeExecutors(ctx sdk.Context) (list []types.RecipeExecutors) {
+	parentStore := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.RecipeExecutorKey))
+	for _, stats := range k.GetAllRecipeStats(ctx) {
+		store := prefix.NewStore(parentStore, types.RecipeExecutorIndexPrefix(stats.CookbookId, stats.RecipeId))
+		list = append(list, types.RecipeExecutors{
+			CookbookId: stats.CookbookId,
+			RecipeId:   stats.RecipeId,
+			Executors:  getExecutors(store),
+		})
+	}
+	return
+}
+
+// SetCookbookExecutors records the executors of a cookbook
+func (k Keeper) SetCookbookExecutors(ctx sdk.Context, executors types.CookbookExecutors) {
+	for _, executor := range executors.Executors {
+		addr, _ := sdk.AccAddressFromBech32(executor)
+		k.addCookbookExecutor(ctx, executors.CookbookId, addr)
+	}
+}
+
+// GetAllCookbookExecutors returns the executors of all cookbooks with an executed recipe
+func (k Keeper) GetAllCookbookExecutors(ctx sdk.Context) (list []types.CookbookExecutors) {
+	parentStore := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.CookbookExecutorKey))
+	for _, stats := range k.GetAllCookbookStats(ctx) {
+		store := prefix.NewStore(parentStore, types.CookbookExecutorIndexPrefix(stats.CookbookId))
+		list = append(list, types.CookbookExecutors{
+			CookbookId: stats.CookbookId,
+			Executors:  getExecutors(store),
+		})
+	}
+	return
+}
+
+// addRecipeExecutor records addr as an executor of the recipe, returns false if addr was already recorded
+func (k Keeper) addRecipeExecutor(ctx sdk.Context, cookbookID, recipeID string, addr sdk.AccAddress) bool {
+	parentStore := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.RecipeExecutorKey))
+	store := prefix.NewStore(parentStore, types.RecipeExecutorIndexPrefix(cookbookID, recipeID))
+	if store.Has(addr.Bytes()) {
+		return false
+	}
+	store.Set(addr.Bytes(), addr.Bytes())
+	return true
+}
+
+// addCookbookExecutor records addr as an executor of the cookbook, returns false if addr was already recorded
+func (k Keeper) addCookbookExecutor(ctx sdk.Context, cookbookID string, addr sdk.AccAddress) bool {
+	parentStore := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.CookbookExecutorKey))
+	store := prefix.NewStore(parentStore, types.CookbookExecutorIndexPrefix(cookbookID))
+	if store.Has(addr.Bytes()) {
+		return false
+	}
+	store.Set(addr.Bytes(), addr.Bytes())
+	return true
+}
+
+// UpdateExecutionStats aggregates the results of a completed execution into the stats of its recipe and cookbook.
+// entryIDs are the recipe entries actualized by the execution, revenue, fees and burned are the coins respectively
+// transferred to the cookbook owner, paid to the chain and burned.
+func (k Keeper) UpdateExecutionStats(ctx sdk.Context, execution types.Execution, recipe types.Recipe, entryIDs []string, revenue, fees, burned sdk.Coins) {
+	addr, _ := sdk.AccAddressFromBech32(execution.Creator)
+
+	itemOutputIDs := make(map[string]bool)
+	for _, itemOutput := range recipe.Entries.ItemOutputs {
+		itemOutputIDs[itemOutput.Id] = true
+	}
+	mintedByOutput := make(map[string]uint64)
+	var totalMinted uint64
+	for _, id := range entryIDs {
+		if itemOutputIDs[id] {
+			mintedByOutput[id]++
+			totalMinted++
+		}
+	}
+
+	recipeStats, _ := k.GetRecipeStats(ctx, recipe.CookbookId, recipe.Id)
+	recipeStats.Executions++
+	if k.addRecipeExecutor(ctx, recipe.CookbookId, recipe.Id, addr) {
+		recipeStats.UniqueExecutors++
+	}
+	recipeStats.Revenue = recipeStats.Revenue.Add(revenue...)
+	recipeStats.Fees = recipeStats.Fees.Add(fees...)
+	recipeStats.Burned = recipeStats.Burned.Add(burned...)
+	for id, count := range mintedByOutput {
+		found := false
+		for i := range recipeStats.ItemsMinted {
+			if recipeStats.ItemsMinted[i].ItemOutputId == id {
+				recipeStats.ItemsMinted[i].Count += count
+				found = true
+				break
+			}
+		}
+		if !found {
+			recipeStats.ItemsMinted = append(recipeStats.ItemsMinted, types.ItemMintCount{ItemOutputId: id, Count: count})
+		}
+	}
+	// keep a deterministic ordering since mintedByOutput is a map
+	sort.Slice(recipeStats.ItemsMinted, func(i, j int) bool {
+		return recipeStats.ItemsMinted[i].ItemOutputId < recipeStats.ItemsMinted[j].ItemOutputId
+	})
+	recipeStats.LastExecutionHeight = ctx.BlockHeight()
+	k.SetRecipeStats(ctx, recipeStats)
+
+	cookbookStats, _ := k.GetCookbookStats(ctx, recipe.CookbookId)
+	cookbookStats.Executions++
+	if k.addCookbookExecutor(ctx, recipe.CookbookId, addr) {
+		cookbookStats.UniqueExecutors++
+	}
+	cookbookStats.Revenue = cookbookStats.Revenue.Add(revenue...)
+	cookbookStats.Fees = cookbookStats.Fees.Add(fees...)
+	cookbookStats.Burned = cookbookStats.Burned.Add(burned...)
+	cookbookStats.ItemsMinted += totalMinted
+	cookbookStats.LastExecutionHeight = ctx.BlockHeight()
+	k.SetCookbookStats(ctx, cookbookStats)
+}
@@ -0,0 +1,138 @@
+package keeper_test
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+
+	"github.com/Pylons-tech/pylons/app"
+	"github.com/Pylons-tech/pylons/x/pylons"
+	"github.com/Pylons-tech/pylons/x/pylons/types"
+)
+
+func (suite *IntegrationTestSuite) TestUpdateExecutionStats() {
+	k := suite.k
+	ctx := suite.ctx
+	require := suite.Require()
+
+	cookbook := createNCookbook(k, ctx, 1)[0]
+	recipe := types.Recipe{
+		CookbookId: cookbook.Id,
+		Id:         "recipe",
+		Entries: types.EntriesList{
+			CoinOutputs: []types.CoinOutput{{Id: "coin"}},
+			ItemOutputs: []types.ItemOutput{{Id: "sword"}, {Id: "shield"}},
+		},
+	}
+	otherRecipe := types.Recipe{CookbookId: cookbook.Id, Id: "other"}
+	executors := types.GenTestBech32List(2)
+	revenue := sdk.NewCoins(sdk.NewCoin(types.PylonsCoinDenom, sdk.NewInt(90)))
+	fees := sdk.NewCoins(sdk.NewCoin(types.PylonsCoinDenom, sdk.NewInt(10)))
+	burned := sdk.NewCoins(sdk.NewCoin("cookbook/denom", sdk.NewInt(5)))
+
+	_, found := k.GetRecipeStats(ctx, recipe.CookbookId, recipe.Id)
+	require.False(found)
+
+	k.UpdateExecutionStats(ctx, types.Execution{Creator: executors[0]}, recipe, []string{"coin", "sword"}, revenue, fees, nil)
+	k.UpdateExecutionStats(ctx, types.Execution{Creator: executors[0]}, recipe, []string{"shield", "sword"}, revenue, fees, burned)
+	k.UpdateExecutionStats(ctx, types.Execution{Creator: executors[1]}, recipe, []string{"sword"}, nil, nil, nil)
+	k.UpdateExecutionStats(ctx, types.Execution{Creator: executors[1]}, otherRecipe, nil, revenue, nil, nil)
+
+	recipeStats, found := k.GetRecipeStats(ctx, recipe.CookbookId, recipe.Id)
+	require.True(found)
+	require.Equal(uint64(3), recipeStats.Executions)
+	require.Equal(uint64(2), recipeStats.UniqueExecutors)
+	require.Equal(revenue.Add(revenue...), recipeStats.Revenue)
+	require.Equal(fees.Add(fees...), recipeStats.Fees)
+	require.Equal(burned, recipeStats.Burned)
+	require.Equal([]types.ItemMintCount{
+		{ItemOutputId: "shield", Count: 1},
+		{ItemOutputId: "sword", Count: 3},
+	}, recipeStats.ItemsMinted)
+
+	otherStats, found := k.GetRecipeStats(ctx, otherRecipe.CookbookId, otherRecipe.Id)
+	require.True(found)
+	require.Equal(uint64(1), otherStats.Executions)
+	require.Equal(uint64(1), otherStats.UniqueExecutors)
+
+	cookbookStats, found := k.GetCookbookStats(ctx, cookbook.Id)
+	require.True(found)
+	require.Equal(uint64(4), cookbookStats.Executions)
+	require.Equal(uint64(2), cookbookStats.UniqueExecutors)
+	require.Equal(revenue.Add(revenue...).Add(revenue...), cookbookStats.Revenue)
+	require.Equal(uint64(4), cookbookStats.ItemsMinted)
+}
+
+func (suite *IntegrationTestSuite) TestExecutionStatsKeyCollision() {
+	k := suite.k
+	ctx := suite.ctx
+	require := suite.Require()
+
+	// the concatenations of the cookbook and recipe IDs of both recipes are equal
+	recipe := types.Recipe{CookbookId: "a", Id: "bc"}
+	otherRecipe := types.Recipe{CookbookId: "ab", Id: "c"}
+	executors := types.GenTestBech32List(2)
+	k.UpdateExecutionStats(ctx, types.Execution{Creator: executors[0]}, recipe, nil, nil, nil, nil)
+	k.UpdateExecutionStats(ctx, types.Execution{Creator: executors[1]}, otherRecipe, nil, nil, nil, nil)
+	k.UpdateExecutionStats(ctx, types.Execution{Creator: executors[1]}, otherRecipe, nil, nil, nil, nil)
+
+	recipeStats, found := k.GetRecipeStats(ctx, recipe.CookbookId, recipe.Id)
+	require.True(found)
+	require.Equal(recipe.CookbookId, recipeStats.CookbookId)
+	require.Equal(uint64(1), recipeStats.Executions)
+	require.Equal(uint64(1), recipeStats.UniqueExecutors)
+	otherStats, found := k.GetRecipeStats(ctx, otherRecipe.CookbookId, otherRecipe.Id)
+	require.True(found)
+	require.Equal(otherRecipe.CookbookId, otherStats.CookbookId)
+	require.Equal(uint64(2), otherStats.Executions)
+	require.Equal(uint64(1), otherStats.UniqueExecutors)
+
+	require.ElementsMatch([]types.RecipeExecutors{
+		{CookbookId: recipe.CookbookId, RecipeId: recipe.Id, Executors: []string{executors[0]}},
+		{CookbookId: otherRecipe.CookbookId, RecipeId: otherRecipe.Id, Executors: []string{executors[1]}},
+	}, k.GetAllRecipeExecutors(ctx))
+	require.ElementsMatch([]types.CookbookExecutors{
+		{CookbookId: recipe.CookbookId, Executors: []string{executors[0]}},
+		{CookbookId: otherRecipe.CookbookId, Executors: []string{executors[1]}},
+	}, k.GetAllCookbookExecutors(ctx))
+}
+
+func (suite *IntegrationTestSuite) TestStatsGenesis() {
+	k := suite.k
+	ctx := suite.ctx
+	require := suite.Require()
+
+	cookbook := createNCookbook(k, ctx, 1)[0]
+	executors := types.GenTestBech32List(2)
+	// the IDs of the recipes share a prefix, their executors must not be mixed up
+	recipe := types.Recipe{CookbookId: cookbook.Id, Id: "recipe"}
+	otherRecipe := types.Recipe{CookbookId: cookbook.Id, Id: "recipe1"}
+	k.UpdateExecutionStats(ctx, types.Execution{Creator: executors[0]}, recipe, nil, nil, nil, nil)
+	k.UpdateExecutionStats(ctx, types.Execution{Creator: executors[1]}, otherRecipe, nil, nil, nil, nil)
+
+	genesis := pylons.ExportGenesis(ctx, k)
+	require.NoError(genesis.Validate())
+	require.ElementsMatch([]types.RecipeExecutors{
+		{CookbookId: cookbook.Id, RecipeId: recipe.Id, Executors: executors[:1]},
+		{CookbookId: cookbook.Id, RecipeId: otherRecipe.Id, Executors: executors[1:]},
+	}, genesis.RecipeExecutorsList)
+	require.Len(genesis.CookbookExecutorsList, 1)
+	require.ElementsMatch(executors, genesis.CookbookExecutorsList[0].Executors)
+
+	// the imported stats and executors are exported unchanged
+	imported := app.Setup(false)
+	importedCtx := imported.BaseApp.NewContext(false, tmproto.Header{})
+	pylons.InitGenesis(importedCtx, imported.PylonsKeeper, *genesis)
+	reexported := pylons.ExportGenesis(importedCtx, imported.PylonsKeeper)
+	require.Equal(genesis.RecipeStatsList, reexported.RecipeStatsList)
+	require.Equal(genesis.CookbookStatsList, reexported.CookbookStatsList)
+	require.Equal(genesis.RecipeExecutorsList, reexported.RecipeExecutorsList)
+	require.Equal(genesis.CookbookExecutorsList, reexported.CookbookExecutorsList)
+
+	// an imported executor is not counted again
+	imported.PylonsKeeper.UpdateExecutionStats(importedCtx, types.Execution{Creator: executors[0]}, recipe, nil, nil, nil, nil)
+	stats, _ := imported.PylonsKeeper.GetRecipeStats(importedCtx, recipe.CookbookId, recipe.Id)
+	require.Equal(uint64(2), stats.Executions)
+	require.Equal(uint64(1), stats.UniqueExecutors)
+	cookbookStats, _ := imported.PylonsKeeper.GetCookbookStats(importedCtx, cookbook.Id)
+	require.Equal(uint64(2), cookbookStats.UniqueExecutors)
+}
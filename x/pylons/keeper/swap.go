@@ -0,0 +1,91 @@
+package keeper
+
+import (
+	"strconv"
+
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/Pylons-tech/pylons/x/pylons/types"
+)
+
+// GetSwapCount get the total number of swaps
+func (k Keeper) GetSwapCount(ctx sdk.Context) uint64 {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.SwapCountKey))
+	byteKey := types.KeyPrefix(types.SwapCountKey)
+	bz := store.Get(byteKey)
+
+	// Count doesn't exist: no element
+	if bz == nil {
+		return 0
+	}
+
+	// Parse bytes
+	count, err := strconv.ParseUint(string(bz), 10, 64)
+	if err != nil {
+		// Panic because the count should be always formattable to uint64
+		panic("cannot decode count")
+	}
+
+	return count
+}
+
+// SetSwapCount set the total number of swaps
+func (k Keeper) SetSwapCount(ctx sdk.Context, count uint64) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.SwapCountKey))
+	byteKey := types.KeyPrefix(types.SwapCountKey)
+	bz := []byte(strconv.FormatUint(count, 10))
+	store.Set(byteKey, bz)
+}
+
+// AppendSwap appends a swap in the store with a new id and update the count
+func (k Keeper) AppendSwap(ctx sdk.Context, swap types.Swap) uint64 {
+	count := k.GetSwapCount(ctx)
+
+	swap.Id = count
+	k.SetSwap(ctx, swap)
+
+	k.SetSwapCount(ctx, count+1)
+
+	return count
+}
+
+// SetSwap set a specific swap in the store
+func (k Keeper) SetSwap(ctx sdk.Context, swap types.Swap) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.SwapKey))
+	b := k.cdc.MustMarshal(&swap)
+	store.Set(sdk.Uint64ToBigEndian(swap.Id), b)
+}
+
+// GetSwap returns a swap from its id
+func (k Keeper) GetSwap(ctx sdk.Context, id uint64) (val types.Swap, found bool) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.SwapKey))
+	b := store.Get(sdk.Uint64ToBigEndian(id))
+	if b == nil {
+		return val, false
+	}
+	k.cdc.MustUnmarshal(b, &val)
+	return val, true
+}
+
+// RemoveSwap removes a swap from the store
+func (k Keeper) RemoveSwap(ctx sdk.Context, id uint64) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.SwapKey))
+	store.Delete(sdk.Uint64ToBigEndian(id))
+}
+
+// GetAllSwap returns all swaps
+func (k Keeper) GetAllSwap(ctx sdk.Context) (list []types.Swap) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.SwapKey))
+	iterator := sdk.KVStorePrefixIterator(store, []byte{})
+
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var val types.Swap
+		k.cdc.MustUnmarshal(iterator.Value(), &val)
+		list = append(list, val)
+	}
+
+	return
+}
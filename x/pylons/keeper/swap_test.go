@@ -0,0 +1,34 @@
+package keeper_test
+
+func (suite *IntegrationTestSuite) TestSwapGet() {
+	k := suite.k
+	ctx := suite.ctx
+	require := suite.Require()
+	items := createNSwap(k, ctx, 10)
+	for _, item := range items {
+		swap, found := k.GetSwap(ctx, item.Id)
+		require.True(found)
+		require.Equal(item, swap)
+	}
+}
+
+func (suite *IntegrationTestSuite) TestSwapRemove() {
+	k := suite.k
+	ctx := suite.ctx
+	require := suite.Require()
+	items := createNSwap(k, ctx, 10)
+	for _, item := range items {
+		k.RemoveSwap(ctx, item.Id)
+		_, found := k.GetSwap(ctx, item.Id)
+		require.False(found)
+	}
+}
+
+func (suite *IntegrationTestSuite) TestSwapGetAll() {
+	k := suite.k
+	ctx := suite.ctx
+	require := suite.Require()
+	items := createNSwap(k, ctx, 10)
+	require.Equal(items, k.GetAllSwap(ctx))
+	require.Equal(uint64(len(items)), k.GetSwapCount(ctx))
+}
@@ -66,6 +66,12 @@ func (k Keeper) SetTrade(ctx sdk.Context, trade types.Trade) {
 
 	addr, _ := sdk.AccAddressFromBech32(trade.Creator)
 	k.addTradeToAddress(ctx, getTradeIDBytes(trade.Id), addr)
+	if trade.Receiver != "" {
+		receiver, _ := sdk.AccAddressFromBech32(trade.Receiver)
+		k.addTradeToReceiver(ctx, getTradeIDBytes(trade.Id), receiver)
+	}
+	k.addTradeToMarket(ctx, trade)
+	k.setTradeExpiry(ctx, trade)
 
 	store.Set(getTradeIDBytes(trade.Id), b)
 }
@@ -92,7 +98,14 @@ func (k Keeper) GetTradeOwner(ctx sdk.Context, id uint64) string {
 // RemoveTrade removes a trade from the store
 func (k Keeper) RemoveTrade(ctx sdk.Context, id uint64, creator sdk.AccAddress) {
 	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.TradeKey))
+	trade := k.GetTrade(ctx, id)
 	k.removeTradeFromAddress(ctx, getTradeIDBytes(id), creator)
+	if trade.Receiver != "" {
+		receiverAddr, _ := sdk.AccAddressFromBech32(trade.Receiver)
+		k.removeTradeFromReceiver(ctx, getTradeIDBytes(id), receiverAddr)
+	}
+	k.removeTradeFromMarket(ctx, trade)
+	k.removeTradeExpiry(ctx, trade)
 	store.Delete(getTradeIDBytes(id))
 }
 
@@ -130,11 +143,60 @@ func (k Keeper) removeTradeFromAddress(ctx sdk.Context, tradeIDBytes []byte, add
 	addrStore.Delete(tradeIDBytes)
 }
 
-func (k Keeper) GetTradesByCreatorPaginated(ctx sdk.Context, creator sdk.AccAddress, pagination *query.PageRequest) ([]types.Trade, *query.PageResponse, error) {
-	trades := make([]types.Trade, 0)
+// addTradeToReceiver indexes a private trade by the address allowed to fulfill it
+func (k Keeper) addTradeToReceiver(ctx sdk.Context, tradeIDBytes []byte, addr sdk.AccAddress) {
+	parentStore := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.ReceiverTradeKey))
+	addrStore := prefix.NewStore(parentStore, addr.Bytes())
+	addrStore.Set(tradeIDBytes, tradeIDBytes)
+}
+
+func (k Keeper) removeTradeFromReceiver(ctx sdk.Context, tradeIDBytes []byte, addr sdk.AccAddress) {
+	parentStore := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.ReceiverTradeKey))
+	addrStore := prefix.NewStore(parentStore, addr.Bytes())
+	addrStore.Delete(tradeIDBytes)
+}
+
+// addTradeToMarket indexes a trade by the cookbooks of the items it offers and by its price in each denom
+func (k Keeper) addTradeToMarket(ctx sdk.Context, trade types.Trade) {
+	tradeIDBytes := getTradeIDBytes(trade.Id)
+	cookbookStore := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.CookbookTradeKey))
+	for _, cookbookID := range trade.OfferedCookbookIDs() {
+		cookbookStore.Set(append(types.CookbookTradeIndexPrefix(cookbookID), tradeIDBytes...), tradeIDBytes)
+	}
+	priceStore := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.PriceTradeKey))
+	for _, price := range trade.Prices() {
+		priceStore.Set(types.PriceTradeIndexKey(price.Denom, price.Amount, trade.Id), tradeIDBytes)
+	}
+}
+
+func (k Keeper) removeTradeFromMarket(ctx sdk.Context, trade types.Trade) {
+	tradeIDBytes := getTradeIDBytes(trade.Id)
+	cookbookStore := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.CookbookTradeKey))
+	for _, cookbookID := range trade.OfferedCookbookIDs() {
+		cookbookStore.Delete(append(types.CookbookTradeIndexPrefix(cookbookID), tradeIDBytes...))
+	}
+	priceStore := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.PriceTradeKey))
+	for _, price := range trade.Prices() {
+		priceStore.Delete(types.PriceTradeIndexKey(price.Denom, price.Amount, trade.Id))
+	}
+}
 
+func (k Keeper) GetTradesByCreatorPaginated(ctx sdk.Context, creator sdk.AccAddress, pagination *query.PageRequest) ([]types.Trade, *query.PageResponse, error) {
 	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.AddrTradeKey))
 	store = prefix.NewStore(store, creator.Bytes())
+	return k.getTradesByIndexPaginated(ctx, store, pagination)
+}
+
+// GetTradesByReceiverPaginated returns a page of the private trades that can be fulfilled by receiver
+func (k Keeper) GetTradesByReceiverPaginated(ctx sdk.Context, receiver sdk.AccAddress, pagination *query.PageRequest) ([]types.Trade, *query.PageResponse, error) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.ReceiverTradeKey))
+	store = prefix.NewStore(store, receiver.Bytes())
+	return k.getTradesByIndexPaginated(ctx, store, pagination)
+}
+
+// getTradesByIndexPaginated returns a page of the trades referenced by an index store
+func (k Keeper) getTradesByIndexPaginated(ctx sdk.Context, store prefix.Store, pagination *query.PageRequest) ([]types.Trade, *query.PageResponse, error) {
+	trades := make([]types.Trade, 0)
 
 	pageRes, err := query.Paginate(store, pagination, func(_, value []byte) error {
 		id := binary.BigEndian.Uint64(value)
@@ -148,3 +210,44 @@ func (k Keeper) GetTradesByCreatorPaginated(ctx sdk.Context, creator sdk.AccAddr
 
 	return trades, pageRes, nil
 }
+
+// GetMarketTradesPaginated returns a page of the open trades matching a predicate. The trades are ordered by price
+// in denom when sorted by price, and by creation otherwise, iterating only the trades offering items of cookbookID
+// when it is set.
+func (k Keeper) GetMarketTradesPaginated(ctx sdk.Context, cookbookID, denom, sort string, match func(types.Trade) bool, pagination *query.PageRequest) ([]types.Trade, *query.PageResponse, error) {
+	// the values of the index stores are the trade ids, and the values of the trades store the trades
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.TradeKey))
+	indexed := true
+	switch {
+	case sort == types.TradeSortPrice:
+		store = prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.PriceTradeKey))
+		store = prefix.NewStore(store, types.PriceTradeIndexPrefix(denom))
+	case cookbookID != "":
+		store = prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.CookbookTradeKey))
+		store = prefix.NewStore(store, types.CookbookTradeIndexPrefix(cookbookID))
+	default:
+		indexed = false
+	}
+
+	trades := make([]types.Trade, 0)
+	pageRes, err := query.FilteredPaginate(store, pagination, func(_, value []byte, accumulate bool) (bool, error) {
+		var trade types.Trade
+		if indexed {
+			trade = k.GetTrade(ctx, binary.BigEndian.Uint64(value))
+		} else {
+			k.cdc.MustUnmarshal(value, &trade)
+		}
+		if trade.IsExpired(ctx) || !match(trade) {
+			return false, nil
+		}
+		if accumulate {
+			trades = append(trades, trade)
+		}
+		return true, nil
+	})
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return trades, pageRes, nil
+}
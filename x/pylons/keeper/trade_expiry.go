@@ -0,0 +1,114 @@
+package keeper
+
+import (
+	"encoding/binary"
+
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/Pylons-tech/pylons/x/pylons/types"
+)
+
+// expiryKey builds the index key of a trade or an item offer, ordered by expiry
+func expiryKey(expiry int64, id uint64) []byte {
+	return append(sdk.Uint64ToBigEndian(uint64(expiry)), getTradeIDBytes(id)...)
+}
+
+// setTradeExpiry indexes a trade by its expiry height and time
+func (k Keeper) setTradeExpiry(ctx sdk.Context, trade types.Trade) {
+	if trade.ExpiresAtHeight != 0 {
+		store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.TradeExpiryHeightKey))
+		store.Set(expiryKey(trade.ExpiresAtHeight, trade.Id), getTradeIDBytes(trade.Id))
+	}
+	if trade.ExpiresAt != 0 {
+		store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.TradeExpiryTimeKey))
+		store.Set(expiryKey(trade.ExpiresAt, trade.Id), getTradeIDBytes(trade.Id))
+	}
+}
+
+// removeTradeExpiry removes a trade from the expiry indexes
+func (k Keeper) removeTradeExpiry(ctx sdk.Context, trade types.Trade) {
+	if trade.ExpiresAtHeight != 0 {
+		store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.TradeExpiryHeightKey))
+		store.Delete(expiryKey(trade.ExpiresAtHeight, trade.Id))
+	}
+	if trade.ExpiresAt != 0 {
+		store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.TradeExpiryTimeKey))
+		store.Delete(expiryKey(trade.ExpiresAt, trade.Id))
+	}
+}
+
+// getExpiredIDs returns at most limit ids of the expiry index whose expiry is lower or equal to the given one
+func (k Keeper) getExpiredIDs(ctx sdk.Context, key string, expiry int64, limit int) (list []uint64) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(key))
+	iterator := store.Iterator(nil, sdk.Uint64ToBigEndian(uint64(expiry)+1))
+
+	defer iterator.Close()
+
+	for ; iterator.Valid() && len(list) < limit; iterator.Next() {
+		list = append(list, binary.BigEndian.Uint64(iterator.Value()))
+	}
+
+	return
+}
+
+// cancelTrade gives the locked items and coins of a trade back to its creator and removes the trade
+func (k Keeper) cancelTrade(ctx sdk.Context, trade types.Trade, reason string) error {
+	// the coins of the units left to fill are still locked
+	addr, _ := sdk.AccAddressFromBech32(trade.Creator)
+	err := k.UnLockCoinsForTrade(ctx, addr, trade.CoinOutputs.MulInt(sdk.NewIntFromUint64(trade.RemainingUnits())))
+	if err != nil {
+		return err
+	}
+
+	for _, itemRef := range trade.ItemOutputs {
+		// checks were passed at trade creation, we just need to unlock
+		item, _ := k.GetItem(ctx, itemRef.CookbookId, itemRef.ItemId)
+		k.UnlockItemForTrade(ctx, item, trade.Creator)
+		item.Owner = trade.Creator
+		k.MergeItem(ctx, item)
+	}
+
+	k.RemoveTrade(ctx, trade.Id, addr)
+
+	return ctx.EventManager().EmitTypedEvent(&types.EventCancelTrade{
+		Creator: trade.Creator,
+		Id:      trade.Id,
+		Reason:  reason,
+	})
+}
+
+// CancelExpiredTrades cancels at most limit expired trades, returning the number of cancelled trades. A trade that
+// cannot be cancelled is removed from the expiry indexes so it does not take a slot of the next sweeps, and an
+// EventCancelTradeFailed is emitted
+func (k Keeper) CancelExpiredTrades(ctx sdk.Context, limit int) int {
+	ids := k.getExpiredIDs(ctx, types.TradeExpiryHeightKey, ctx.BlockHeight(), limit)
+	ids = append(ids, k.getExpiredIDs(ctx, types.TradeExpiryTimeKey, ctx.BlockTime().Unix(), limit-len(ids))...)
+
+	cancelled := 0
+	for _, id := range ids {
+		// a trade expiring by height and by time can be listed twice
+		if !k.HasTrade(ctx, id) {
+			continue
+		}
+		trade := k.GetTrade(ctx, id)
+		cacheCtx, write := ctx.CacheContext()
+		err := k.cancelTrade(cacheCtx, trade, types.TradeCancelReasonExpired)
+		if err != nil {
+			// this should never happen, it means the module account has been drained of funds illegitimately
+			k.Logger(ctx).Error("cannot cancel expired trade", "id", id, "error", err)
+			k.removeTradeExpiry(ctx, trade)
+			_ = ctx.EventManager().EmitTypedEvent(&types.EventCancelTradeFailed{
+				Creator: trade.Creator,
+				Id:      trade.Id,
+				Error:   err.Error(),
+			})
+			continue
+		}
+		write()
+		ctx.EventManager().EmitEvents(cacheCtx.EventManager().Events())
+		cancelled++
+	}
+
+	return cancelled
+}
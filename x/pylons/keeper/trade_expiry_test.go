@@ -0,0 +1,111 @@
+package keeper_test
+
+import (
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	"github.com/Pylons-tech/pylons/x/pylons/keeper"
+	"github.com/Pylons-tech/pylons/x/pylons/types"
+)
+
+func (suite *IntegrationTestSuite) TestCancelExpiredTrades() {
+	k := suite.k
+	ctx := suite.ctx.WithBlockHeight(10).WithBlockTime(time.Unix(1000, 0))
+	require := suite.Require()
+
+	wctx := sdk.WrapSDKContext(ctx)
+	srv := keeper.NewMsgServerImpl(k)
+
+	creator := types.GenTestBech32FromString("creator")
+	creatorAddr, _ := sdk.AccAddressFromBech32(creator)
+	newTrade := func(expiresAtHeight, expiresAt int64) (types.Item, uint64) {
+		item := types.Item{
+			Owner:           creator,
+			CookbookId:      "testCookbook",
+			Tradeable:       true,
+			TradePercentage: sdk.ZeroDec(),
+		}
+		item.Id = k.AppendItem(ctx, item)
+		resp, err := srv.CreateTrade(wctx, &types.MsgCreateTrade{
+			Creator:         creator,
+			ItemOutputs:     []types.ItemRef{{CookbookId: item.CookbookId, ItemId: item.Id}},
+			ExpiresAtHeight: expiresAtHeight,
+			ExpiresAt:       expiresAt,
+		})
+		require.NoError(err)
+		return item, resp.Id
+	}
+
+	// a trade cannot be created already expired
+	_, err := srv.CreateTrade(wctx, &types.MsgCreateTrade{Creator: creator, ExpiresAtHeight: 10})
+	require.ErrorIs(err, sdkerrors.ErrInvalidRequest)
+
+	expiredByHeightItem, expiredByHeight := newTrade(11, 0)
+	expiredByTimeItem, expiredByTime := newTrade(0, 1100)
+	_, expiredByBoth := newTrade(11, 1100)
+	_, notExpired := newTrade(12, 2000)
+	_, neverExpires := newTrade(0, 0)
+	require.Len(k.GetAllItemByOwner(ctx, creatorAddr), 0)
+
+	ctx = ctx.WithBlockHeight(11).WithBlockTime(time.Unix(1100, 0))
+	require.True(k.GetTrade(ctx, expiredByHeight).IsExpired(ctx))
+	require.False(k.GetTrade(ctx, notExpired).IsExpired(ctx))
+
+	// the sweep is bounded by the given limit
+	require.Equal(2, k.CancelExpiredTrades(ctx, 2))
+	require.Equal(1, k.CancelExpiredTrades(ctx, 10))
+	require.Equal(0, k.CancelExpiredTrades(ctx, 10))
+	require.False(k.HasTrade(ctx, expiredByHeight))
+	require.False(k.HasTrade(ctx, expiredByTime))
+	require.False(k.HasTrade(ctx, expiredByBoth))
+	require.True(k.HasTrade(ctx, notExpired))
+	require.True(k.HasTrade(ctx, neverExpires))
+
+	// the items of the cancelled trades are given back to their owner
+	require.Len(k.GetAllItemByOwner(ctx, creatorAddr), 3)
+	item, _ := k.GetItem(ctx, expiredByHeightItem.CookbookId, expiredByHeightItem.Id)
+	require.Equal(creator, item.Owner)
+	item, _ = k.GetItem(ctx, expiredByTimeItem.CookbookId, expiredByTimeItem.Id)
+	require.Equal(creator, item.Owner)
+
+	// cancelled trades are reported as expired
+	found := false
+	for _, event := range ctx.EventManager().Events() {
+		if event.Type != "pylons.pylons.EventCancelTrade" {
+			continue
+		}
+		for _, attr := range event.Attributes {
+			if string(attr.Key) == "reason" {
+				require.Equal("\""+types.TradeCancelReasonExpired+"\"", string(attr.Value))
+				found = true
+			}
+		}
+	}
+	require.True(found)
+
+	// a trade whose coins cannot be unlocked is kept, but dropped from the expiry indexes
+	coins := sdk.NewCoins(sdk.NewCoin(types.PylonsCoinDenom, sdk.NewInt(100)))
+	require.NoError(k.MintCoinsToAddr(ctx, creatorAddr, coins))
+	resp, err := srv.CreateTrade(sdk.WrapSDKContext(ctx), &types.MsgCreateTrade{Creator: creator, CoinOutputs: coins, ExpiresAtHeight: 12})
+	require.NoError(err)
+	drainAddr, _ := sdk.AccAddressFromBech32(types.GenTestBech32FromString("drain"))
+	require.NoError(suite.bankKeeper.SendCoinsFromModuleToAccount(ctx, types.TradesLockerName, drainAddr, coins))
+	ctx = ctx.WithBlockHeight(12).WithEventManager(sdk.NewEventManager())
+	require.Equal(1, k.CancelExpiredTrades(ctx, 10))
+	require.True(k.HasTrade(ctx, resp.Id))
+	require.False(k.HasTrade(ctx, notExpired))
+	failed := 0
+	for _, event := range ctx.EventManager().Events() {
+		if event.Type == "pylons.pylons.EventCancelTradeFailed" {
+			failed++
+		}
+	}
+	require.Equal(1, failed)
+
+	// the failed trade is not retried by the next sweeps
+	ctx = ctx.WithBlockHeight(13).WithEventManager(sdk.NewEventManager())
+	require.Equal(0, k.CancelExpiredTrades(ctx, 10))
+	require.Empty(ctx.EventManager().Events())
+}
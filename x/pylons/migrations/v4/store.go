@@ -0,0 +1,22 @@
+package v4
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// PortBinder binds the module to an IBC port
+type PortBinder interface {
+	IsBound(ctx sdk.Context, portID string) bool
+	BindPort(ctx sdk.Context, portID string) error
+}
+
+// MigrateStore performs in-place store migrations from consensus version 3 to 4. The
+// migration includes:
+//
+// - Bind the ICS-721 nft transfer port, only bound by InitGenesis on new chains.
+func MigrateStore(ctx sdk.Context, binder PortBinder, portID string) error {
+	if binder.IsBound(ctx, portID) {
+		return nil
+	}
+	return binder.BindPort(ctx, portID)
+}
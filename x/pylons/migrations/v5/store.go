@@ -0,0 +1,19 @@
+package v5
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// NFTMirror writes the x/nft mirror of the cookbooks and items
+type NFTMirror interface {
+	SyncNFTMirror(ctx sdk.Context)
+}
+
+// MigrateStore performs in-place store migrations from consensus version 4 to 5. The
+// migration includes:
+//
+// - Mint the x/nft class of every cookbook and the NFT of every item.
+func MigrateStore(ctx sdk.Context, mirror NFTMirror) error {
+	mirror.SyncNFTMirror(ctx)
+	return nil
+}
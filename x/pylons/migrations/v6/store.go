@@ -0,0 +1,37 @@
+package v6
+
+import (
+	"github.com/cosmos/cosmos-sdk/codec"
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	storetypes "github.com/cosmos/cosmos-sdk/store/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/Pylons-tech/pylons/x/pylons/types"
+)
+
+// MigrateStore performs in-place store migrations from consensus version 5 to 6. The
+// migration includes:
+//
+// - Index the existing items by cookbook and by the recipe that created them.
+func MigrateStore(ctx sdk.Context, storeKey storetypes.StoreKey, cdc codec.BinaryCodec) error {
+	store := ctx.KVStore(storeKey)
+	itemsStore := prefix.NewStore(store, types.KeyPrefix(types.ItemKey))
+	cookbookStore := prefix.NewStore(store, types.KeyPrefix(types.CookbookItemKey))
+	recipeStore := prefix.NewStore(store, types.KeyPrefix(types.RecipeItemKey))
+
+	iterator := itemsStore.Iterator(nil, nil)
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var item types.Item
+		if err := cdc.Unmarshal(iterator.Value(), &item); err != nil {
+			return err
+		}
+		cookbookStore.Set(append(types.CookbookItemIndexPrefix(item.CookbookId), []byte(item.Id)...), []byte(item.Id))
+		if item.RecipeId != "" {
+			recipeStore.Set(append(types.RecipeItemIndexPrefix(item.CookbookId, item.RecipeId), []byte(item.Id)...), []byte(item.Id))
+		}
+	}
+
+	return nil
+}
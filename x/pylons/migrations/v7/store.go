@@ -0,0 +1,41 @@
+package v7
+
+import (
+	"github.com/cosmos/cosmos-sdk/codec"
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	storetypes "github.com/cosmos/cosmos-sdk/store/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/Pylons-tech/pylons/x/pylons/types"
+)
+
+// MigrateStore performs in-place store migrations from consensus version 6 to 7. The
+// migration includes:
+//
+// - Index the open trades by the cookbooks of the items they offer and by their price in each denom.
+func MigrateStore(ctx sdk.Context, storeKey storetypes.StoreKey, cdc codec.BinaryCodec) error {
+	store := ctx.KVStore(storeKey)
+	tradesStore := prefix.NewStore(store, types.KeyPrefix(types.TradeKey))
+	cookbookStore := prefix.NewStore(store, types.KeyPrefix(types.CookbookTradeKey))
+	priceStore := prefix.NewStore(store, types.KeyPrefix(types.PriceTradeKey))
+
+	iterator := tradesStore.Iterator(nil, nil)
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var trade types.Trade
+		if err := cdc.Unmarshal(iterator.Value(), &trade); err != nil {
+			return err
+		}
+		// the keys of the trades store are the trade ids
+		tradeIDBytes := append([]byte{}, iterator.Key()...)
+		for _, cookbookID := range trade.OfferedCookbookIDs() {
+			cookbookStore.Set(append(types.CookbookTradeIndexPrefix(cookbookID), tradeIDBytes...), tradeIDBytes)
+		}
+		for _, price := range trade.Prices() {
+			priceStore.Set(types.PriceTradeIndexKey(price.Denom, price.Amount, trade.Id), tradeIDBytes)
+		}
+	}
+
+	return nil
+}
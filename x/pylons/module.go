@@ -142,6 +142,22 @@ func (am AppModule) RegisterServices(cfg module.Configurator) {
 	if err != nil {
 		panic(err)
 	}
+	err = cfg.RegisterMigration(types.ModuleName, 3, m.Migrate3to4)
+	if err != nil {
+		panic(err)
+	}
+	err = cfg.RegisterMigration(types.ModuleName, 4, m.Migrate4to5)
+	if err != nil {
+		panic(err)
+	}
+	err = cfg.RegisterMigration(types.ModuleName, 5, m.Migrate5to6)
+	if err != nil {
+		panic(err)
+	}
+	err = cfg.RegisterMigration(types.ModuleName, 6, m.Migrate6to7)
+	if err != nil {
+		panic(err)
+	}
 }
 
 // RegisterInvariants registers the capability module's invariants.
@@ -187,14 +203,25 @@ func (am AppModule) EndBlock(ctx sdk.Context, _ abci.RequestEndBlock) []abci.Val
 			if err != nil {
 				panic(err.Error())
 			}
-			// make sure locked item's ownership is set back to the execution creator
+			// make sure locked item's ownership is set back to its owner, who is not the execution creator when
+			// the execution was submitted by an operator
 			for _, itemRecord := range pendingExec.ItemInputs {
+				// borrowed items were never locked for the execution
+				if itemRecord.Borrowed {
+					continue
+				}
 				item, found := am.keeper.GetItem(ctx, pendingExec.CookbookId, itemRecord.Id)
 				if !found {
 					panic(fmt.Errorf("item with ID %v in cookbook with ID %v not found", itemRecord.Id, pendingExec.CookbookId))
 				}
-				item.Owner = pendingExec.Creator
-				am.keeper.UnlockItemForExecution(ctx, item, pendingExec.Creator)
+				owner := itemRecord.Owner
+				if owner == "" {
+					// executions submitted before the owner was recorded
+					owner = pendingExec.Creator
+				}
+				item.Owner = owner
+				am.keeper.UnlockItemForExecution(ctx, item, owner)
+				am.keeper.MergeItem(ctx, item)
 			}
 
 			am.keeper.ActualizeExecution(ctx, pendingExec)
@@ -211,11 +238,18 @@ func (am AppModule) EndBlock(ctx sdk.Context, _ abci.RequestEndBlock) []abci.Val
 		_ = ctx.EventManager().EmitTypedEvent(&event)
 	}
 
+	am.keeper.EndLendings(ctx, types.MaxEndedLendingsPerBlock)
+	am.keeper.DeleteExpiredItems(ctx, types.MaxExpiredItemsPerBlock)
+	am.keeper.CancelExpiredTrades(ctx, types.MaxExpiredTradesPerBlock)
+	am.keeper.SettleEndedAuctions(ctx, types.MaxSettledAuctionsPerBlock)
+	am.keeper.EndDutchAuctions(ctx, types.MaxEndedDutchAuctionsPerBlock)
+	am.keeper.CancelExpiredItemOffers(ctx, types.MaxExpiredItemOffersPerBlock)
+
 	return []abci.ValidatorUpdate{}
 }
 
 // ConsensusVersion implements AppModule/ConsensusVersion.
-func (AppModule) ConsensusVersion() uint64 { return 3 }
+func (AppModule) ConsensusVersion() uint64 { return 7 }
 
 // ____________________________________________________________________________
 
@@ -0,0 +1,272 @@
+package types
+
+import (
+	"fmt"
+	"net/url"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// Types of the item attributes declared by the attribute schema of a cookbook
+const (
+	AttributeTypeDouble = "double"
+	AttributeTypeLong   = "long"
+	AttributeTypeString = "string"
+)
+
+// AttributeFormatURL restricts a string attribute to absolute URLs, such as the image or the animation of an item
+const AttributeFormatURL = "url"
+
+// MaxAttributeSchemaKeys is the maximum number of item attributes declared by the attribute schema of a cookbook
+const MaxAttributeSchemaKeys = 64
+
+// ValidateAttributeSchema checks the item attributes declared by a cookbook are unique and have a valid type, bounds
+// and format
+func ValidateAttributeSchema(schema []ItemAttributeSchema) error {
+	if len(schema) > MaxAttributeSchemaKeys {
+		return fmt.Errorf("cannot declare more than %d attributes", MaxAttributeSchemaKeys)
+	}
+	seen := make(map[string]bool, len(schema))
+	for _, s := range schema {
+		if s.Key == "" {
+			return fmt.Errorf("empty attribute schema key")
+		}
+		if seen[s.Key] {
+			return fmt.Errorf("attribute %s declared twice", s.Key)
+		}
+		seen[s.Key] = true
+
+		switch s.Type {
+		case AttributeTypeDouble, AttributeTypeLong:
+			if s.Format != "" {
+				return fmt.Errorf("attribute %s of type %s cannot have a format", s.Key, s.Type)
+			}
+		case AttributeTypeString:
+			if s.Min != "" || s.Max != "" {
+				return fmt.Errorf("attribute %s of type %s cannot have bounds", s.Key, s.Type)
+			}
+			if s.Format != "" && s.Format != AttributeFormatURL {
+				return fmt.Errorf("invalid format %s of attribute %s", s.Format, s.Key)
+			}
+		default:
+			return fmt.Errorf("invalid type %s of attribute %s", s.Type, s.Key)
+		}
+
+		min, max, err := s.bounds()
+		if err != nil {
+			return fmt.Errorf("invalid bounds of attribute %s: %w", s.Key, err)
+		}
+		if min != nil && max != nil && min.GT(*max) {
+			return fmt.Errorf("min of attribute %s is greater than its max", s.Key)
+		}
+	}
+	return nil
+}
+
+// AttributeSchemaEqual checks two cookbooks declare the same item attributes
+func AttributeSchemaEqual(a, b []ItemAttributeSchema) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	schema := make(map[string]ItemAttributeSchema, len(a))
+	for _, s := range a {
+		schema[s.Key] = s
+	}
+	for _, s := range b {
+		if original, ok := schema[s.Key]; !ok || original != s {
+			return false
+		}
+	}
+	return true
+}
+
+// bounds returns the parsed bounds of a double or long attribute, nil when unbounded
+func (s ItemAttributeSchema) bounds() (min, max *sdk.Dec, err error) {
+	if s.Min != "" {
+		dec, err := sdk.NewDecFromStr(s.Min)
+		if err != nil {
+			return nil, nil, err
+		}
+		min = &dec
+	}
+	if s.Max != "" {
+		dec, err := sdk.NewDecFromStr(s.Max)
+		if err != nil {
+			return nil, nil, err
+		}
+		max = &dec
+	}
+	return min, max, nil
+}
+
+func (s ItemAttributeSchema) checkBounds(value sdk.Dec) error {
+	min, max, err := s.bounds()
+	if err != nil {
+		return err
+	}
+	if min != nil && value.LT(*min) {
+		return fmt.Errorf("attribute %s value %s is lower than %s", s.Key, value, s.Min)
+	}
+	if max != nil && value.GT(*max) {
+		return fmt.Errorf("attribute %s value %s is greater than %s", s.Key, value, s.Max)
+	}
+	return nil
+}
+
+func (s ItemAttributeSchema) checkFormat(value string) error {
+	if s.Format != AttributeFormatURL {
+		return nil
+	}
+	u, err := url.ParseRequestURI(value)
+	if err != nil || u.Scheme == "" || u.Host == "" {
+		return fmt.Errorf("attribute %s value %s is not a url", s.Key, value)
+	}
+	return nil
+}
+
+// attributeSchema returns the schema of an attribute of the cookbook items, a cookbook without schema accepts any
+// attribute and returns nil
+func (cb Cookbook) attributeSchema(key, attributeType string) (*ItemAttributeSchema, error) {
+	if len(cb.AttributeSchema) == 0 {
+		return nil, nil
+	}
+	for i := range cb.AttributeSchema {
+		s := &cb.AttributeSchema[i]
+		if s.Key != key {
+			continue
+		}
+		if s.Type != attributeType {
+			return nil, fmt.Errorf("attribute %s of cookbook %s is a %s", key, cb.Id, s.Type)
+		}
+		return s, nil
+	}
+	return nil, fmt.Errorf("attribute %s is not declared by the schema of cookbook %s", key, cb.Id)
+}
+
+// ValidateDoubleAttribute checks a double attribute value of an item against the attribute schema of its cookbook
+func (cb Cookbook) ValidateDoubleAttribute(key string, value sdk.Dec) error {
+	s, err := cb.attributeSchema(key, AttributeTypeDouble)
+	if err != nil || s == nil {
+		return err
+	}
+	return s.checkBounds(value)
+}
+
+// ValidateLongAttribute checks a long attribute value of an item against the attribute schema of its cookbook
+func (cb Cookbook) ValidateLongAttribute(key string, value int64) error {
+	s, err := cb.attributeSchema(key, AttributeTypeLong)
+	if err != nil || s == nil {
+		return err
+	}
+	return s.checkBounds(sdk.NewDec(value))
+}
+
+// ValidateStringAttribute checks a string or mutable string attribute value of an item against the attribute
+// schema of its cookbook
+func (cb Cookbook) ValidateStringAttribute(key, value string) error {
+	s, err := cb.attributeSchema(key, AttributeTypeString)
+	if err != nil || s == nil {
+		return err
+	}
+	return s.checkFormat(value)
+}
+
+// ValidateRecipeEntries checks the item outputs and item modify outputs of a recipe against the attribute schema of
+// the cookbook, the values computed by a program are only checked to be declared with the right type
+func (cb Cookbook) ValidateRecipeEntries(entries EntriesList) error {
+	if len(cb.AttributeSchema) == 0 {
+		return nil
+	}
+	for _, output := range entries.ItemOutputs {
+		if err := cb.validateItemParams(output.Doubles, output.Longs, output.Strings, output.MutableStrings); err != nil {
+			return fmt.Errorf("item output %s: %w", output.Id, err)
+		}
+		keys := make(map[string]bool)
+		for _, param := range output.Doubles {
+			keys[param.Key] = true
+		}
+		for _, param := range output.Longs {
+			keys[param.Key] = true
+		}
+		for _, param := range output.Strings {
+			keys[param.Key] = true
+		}
+		for _, kv := range output.MutableStrings {
+			keys[kv.Key] = true
+		}
+		for _, s := range cb.AttributeSchema {
+			if s.Required && !keys[s.Key] {
+				return fmt.Errorf("item output %s does not set required attribute %s", output.Id, s.Key)
+			}
+		}
+	}
+	for _, output := range entries.ItemModifyOutputs {
+		if err := cb.validateItemParams(output.Doubles, output.Longs, output.Strings, output.MutableStrings); err != nil {
+			return fmt.Errorf("item modify output %s: %w", output.Id, err)
+		}
+	}
+	return nil
+}
+
+func (cb Cookbook) validateItemParams(doubles []DoubleParam, longs []LongParam, strings []StringParam, mutableStrings []StringKeyValue) error {
+	for _, param := range doubles {
+		s, err := cb.attributeSchema(param.Key, AttributeTypeDouble)
+		if err != nil {
+			return err
+		}
+		if param.Program != "" {
+			continue
+		}
+		for _, weightRange := range param.WeightRanges {
+			if err = s.checkBounds(weightRange.Lower); err != nil {
+				return err
+			}
+			if err = s.checkBounds(weightRange.Upper); err != nil {
+				return err
+			}
+		}
+	}
+	for _, param := range longs {
+		s, err := cb.attributeSchema(param.Key, AttributeTypeLong)
+		if err != nil {
+			return err
+		}
+		if param.Program != "" {
+			continue
+		}
+		for _, weightRange := range param.WeightRanges {
+			if err = s.checkBounds(sdk.NewDec(weightRange.Lower)); err != nil {
+				return err
+			}
+			if err = s.checkBounds(sdk.NewDec(weightRange.Upper)); err != nil {
+				return err
+			}
+		}
+	}
+	for _, param := range strings {
+		s, err := cb.attributeSchema(param.Key, AttributeTypeString)
+		if err != nil {
+			return err
+		}
+		if param.Program != "" {
+			continue
+		}
+		if err = s.checkFormat(param.Value); err != nil {
+			return err
+		}
+	}
+	for _, kv := range mutableStrings {
+		s, err := cb.attributeSchema(kv.Key, AttributeTypeString)
+		if err != nil {
+			return err
+		}
+		// mutable strings can be left empty for the item owner to set them
+		if kv.Value == "" {
+			continue
+		}
+		if err = s.checkFormat(kv.Value); err != nil {
+			return err
+		}
+	}
+	return nil
+}
@@ -0,0 +1,67 @@
+package types
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestValidateAttributeSchema(t *testing.T) {
+	for _, tc := range []struct {
+		desc   string
+		schema []ItemAttributeSchema
+		valid  bool
+	}{
+		{
+			desc: "Valid",
+			schema: []ItemAttributeSchema{
+				{Key: "image", Type: AttributeTypeString, Required: true, Format: AttributeFormatURL},
+				{Key: "speed", Type: AttributeTypeDouble, Min: "0.5", Max: "2"},
+				{Key: "level", Type: AttributeTypeLong, Min: "1"},
+			},
+			valid: true,
+		},
+		{
+			desc:   "EmptyKey",
+			schema: []ItemAttributeSchema{{Type: AttributeTypeLong}},
+		},
+		{
+			desc:   "DuplicateKey",
+			schema: []ItemAttributeSchema{{Key: "level", Type: AttributeTypeLong}, {Key: "level", Type: AttributeTypeDouble}},
+		},
+		{
+			desc:   "InvalidType",
+			schema: []ItemAttributeSchema{{Key: "level", Type: "int"}},
+		},
+		{
+			desc:   "InvalidFormat",
+			schema: []ItemAttributeSchema{{Key: "image", Type: AttributeTypeString, Format: "png"}},
+		},
+		{
+			desc:   "FormatOnLong",
+			schema: []ItemAttributeSchema{{Key: "level", Type: AttributeTypeLong, Format: AttributeFormatURL}},
+		},
+		{
+			desc:   "BoundsOnString",
+			schema: []ItemAttributeSchema{{Key: "name", Type: AttributeTypeString, Max: "10"}},
+		},
+		{
+			desc:   "InvalidBound",
+			schema: []ItemAttributeSchema{{Key: "speed", Type: AttributeTypeDouble, Min: "fast"}},
+		},
+		{
+			desc:   "MinGreaterThanMax",
+			schema: []ItemAttributeSchema{{Key: "speed", Type: AttributeTypeDouble, Min: "2", Max: "1"}},
+		},
+	} {
+		tc := tc
+		t.Run(tc.desc, func(t *testing.T) {
+			err := ValidateAttributeSchema(tc.schema)
+			if tc.valid {
+				require.NoError(t, err)
+			} else {
+				require.Error(t, err)
+			}
+		})
+	}
+}
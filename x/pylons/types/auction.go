@@ -0,0 +1,26 @@
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// MaxSettledAuctionsPerBlock bounds the number of ended auctions settled at the end of each block
+const MaxSettledAuctionsPerBlock = 100
+
+// IsEnded checks if the auction reached its end time
+func (a Auction) IsEnded(ctx sdk.Context) bool {
+	return ctx.BlockTime().Unix() >= a.EndTime
+}
+
+// HasBid checks if a bid was placed on the auction
+func (a Auction) HasBid() bool {
+	return a.HighestBidder != ""
+}
+
+// MinBid returns the lowest amount accepted by the auction for the next bid, bids must be greater than the highest bid
+func (a Auction) MinBid() sdk.Coin {
+	if !a.HasBid() {
+		return a.ReservePrice
+	}
+	return a.HighestBid.AddAmount(sdk.OneInt())
+}
@@ -5,6 +5,7 @@ import (
 	cdctypes "github.com/cosmos/cosmos-sdk/codec/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/types/msgservice"
+	"github.com/gogo/protobuf/proto"
 )
 
 func RegisterCodec(cdc *codec.LegacyAmino) {
@@ -28,9 +29,34 @@ func RegisterCodec(cdc *codec.LegacyAmino) {
 
 	cdc.RegisterConcrete(&MsgSendItems{}, "pylons/SendItems", nil)
 
+	cdc.RegisterConcrete(&MsgBurnItems{}, "pylons/BurnItems", nil)
+
+	cdc.RegisterConcrete(&MsgCreateLending{}, "pylons/CreateLending", nil)
+	cdc.RegisterConcrete(&MsgAcceptLending{}, "pylons/AcceptLending", nil)
+	cdc.RegisterConcrete(&MsgCancelLending{}, "pylons/CancelLending", nil)
+	cdc.RegisterConcrete(&MsgCreateAuction{}, "pylons/CreateAuction", nil)
+	cdc.RegisterConcrete(&MsgPlaceBid{}, "pylons/PlaceBid", nil)
+	cdc.RegisterConcrete(&MsgCancelAuction{}, "pylons/CancelAuction", nil)
+	cdc.RegisterConcrete(&MsgCreateDutchAuction{}, "pylons/CreateDutchAuction", nil)
+	cdc.RegisterConcrete(&MsgBuyDutchAuction{}, "pylons/BuyDutchAuction", nil)
+	cdc.RegisterConcrete(&MsgCancelDutchAuction{}, "pylons/CancelDutchAuction", nil)
+	cdc.RegisterConcrete(&MsgCreateItemOffer{}, "pylons/CreateItemOffer", nil)
+	cdc.RegisterConcrete(&MsgAcceptItemOffer{}, "pylons/AcceptItemOffer", nil)
+	cdc.RegisterConcrete(&MsgCancelItemOffer{}, "pylons/CancelItemOffer", nil)
+	cdc.RegisterConcrete(&MsgCreateSwap{}, "pylons/CreateSwap", nil)
+	cdc.RegisterConcrete(&MsgDepositSwap{}, "pylons/DepositSwap", nil)
+	cdc.RegisterConcrete(&MsgAbortSwap{}, "pylons/AbortSwap", nil)
+	cdc.RegisterConcrete(&MsgApproveItem{}, "pylons/ApproveItem", nil)
+	cdc.RegisterConcrete(&MsgRevokeItemApproval{}, "pylons/RevokeItemApproval", nil)
+	cdc.RegisterConcrete(&MsgSetOperator{}, "pylons/SetOperator", nil)
+	cdc.RegisterConcrete(&MsgTransferItems{}, "pylons/TransferItems", nil)
+	cdc.RegisterConcrete(&MsgDepositItems{}, "pylons/DepositItems", nil)
+	cdc.RegisterConcrete(&MsgWithdrawItems{}, "pylons/WithdrawItems", nil)
+
 	cdc.RegisterConcrete(&MsgExecuteRecipe{}, "pylons/ExecuteRecipe", nil)
 
 	cdc.RegisterConcrete(&MsgSetItemString{}, "pylons/SetItemString", nil)
+	cdc.RegisterConcrete(&MsgUpdateItemAttributes{}, "pylons/UpdateItemAttributes", nil)
 
 	cdc.RegisterConcrete(&MsgCreateRecipe{}, "pylons/CreateRecipe", nil)
 	cdc.RegisterConcrete(&MsgUpdateRecipe{}, "pylons/UpdateRecipe", nil)
@@ -75,11 +101,38 @@ func RegisterInterfaces(registry cdctypes.InterfaceRegistry) {
 	registry.RegisterImplementations((*sdk.Msg)(nil),
 		&MsgSendItems{},
 	)
+	registry.RegisterImplementations((*sdk.Msg)(nil),
+		&MsgBurnItems{},
+	)
+	registry.RegisterImplementations((*sdk.Msg)(nil),
+		&MsgCreateLending{},
+		&MsgAcceptLending{},
+		&MsgCancelLending{},
+		&MsgCreateAuction{},
+		&MsgPlaceBid{},
+		&MsgCancelAuction{},
+		&MsgCreateDutchAuction{},
+		&MsgBuyDutchAuction{},
+		&MsgCancelDutchAuction{},
+		&MsgCreateItemOffer{},
+		&MsgAcceptItemOffer{},
+		&MsgCancelItemOffer{},
+		&MsgCreateSwap{},
+		&MsgDepositSwap{},
+		&MsgAbortSwap{},
+		&MsgApproveItem{},
+		&MsgRevokeItemApproval{},
+		&MsgSetOperator{},
+		&MsgTransferItems{},
+		&MsgDepositItems{},
+		&MsgWithdrawItems{},
+	)
 	registry.RegisterImplementations((*sdk.Msg)(nil),
 		&MsgExecuteRecipe{},
 	)
 	registry.RegisterImplementations((*sdk.Msg)(nil),
 		&MsgSetItemString{},
+		&MsgUpdateItemAttributes{},
 	)
 	registry.RegisterImplementations((*sdk.Msg)(nil),
 		&MsgCreateRecipe{},
@@ -90,6 +143,11 @@ func RegisterInterfaces(registry cdctypes.InterfaceRegistry) {
 		&MsgUpdateCookbook{},
 	)
 
+	// items are the data of their x/nft mirror
+	registry.RegisterImplementations((*proto.Message)(nil),
+		&Item{},
+	)
+
 	msgservice.RegisterMsgServiceDesc(registry, &_Msg_serviceDesc)
 }
 
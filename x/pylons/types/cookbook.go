@@ -1,6 +1,9 @@
 package types
 
 import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 	"github.com/rogpeppe/go-internal/semver"
 )
@@ -30,6 +33,22 @@ func CookbookModified(original, updated Cookbook) (bool, error) {
 		modified = true
 	}
 
+	if !original.BurnRefund.IsEqual(updated.BurnRefund) {
+		modified = true
+	}
+
+	if !IndexedAttributesEqual(original.IndexedAttributes, updated.IndexedAttributes) {
+		modified = true
+	}
+
+	if !AttributePermissionsEqual(original.AttributePermissions, updated.AttributePermissions) {
+		modified = true
+	}
+
+	if !AttributeSchemaEqual(original.AttributeSchema, updated.AttributeSchema) {
+		modified = true
+	}
+
 	if modified {
 		comp := semver.Compare(original.Version, updated.Version)
 		if comp != -1 {
@@ -38,3 +57,105 @@ func CookbookModified(original, updated Cookbook) (bool, error) {
 	}
 	return modified, nil
 }
+
+// MaxIndexedAttributes is the maximum number of item attributes indexed by a cookbook
+const MaxIndexedAttributes = 8
+
+// ValidateIndexedAttributes checks the item attribute keys indexed by a cookbook are set and unique
+func ValidateIndexedAttributes(keys []string) error {
+	if len(keys) > MaxIndexedAttributes {
+		return fmt.Errorf("cannot index more than %d attributes", MaxIndexedAttributes)
+	}
+	seen := make(map[string]bool, len(keys))
+	for _, key := range keys {
+		if key == "" {
+			return fmt.Errorf("empty indexed attribute key")
+		}
+		if seen[key] {
+			return fmt.Errorf("attribute %s indexed twice", key)
+		}
+		seen[key] = true
+	}
+	return nil
+}
+
+// IndexedAttributesEqual checks two cookbooks index the same item attributes
+func IndexedAttributesEqual(a, b []string) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	keys := make(map[string]bool, len(a))
+	for _, key := range a {
+		keys[key] = true
+	}
+	for _, key := range b {
+		if !keys[key] {
+			return false
+		}
+	}
+	return true
+}
+
+// MaxAttributeUpdaters is the maximum number of updaters of an item attribute besides the cookbook creator
+const MaxAttributeUpdaters = 8
+
+// ValidateAttributePermissions checks the item attribute keys updatable by MsgUpdateItemAttributes are set and
+// unique, and their updaters are valid addresses
+func ValidateAttributePermissions(permissions []ItemAttributePermission) error {
+	seen := make(map[string]bool, len(permissions))
+	for _, permission := range permissions {
+		if permission.Key == "" {
+			return fmt.Errorf("empty attribute permission key")
+		}
+		if seen[permission.Key] {
+			return fmt.Errorf("attribute %s permission declared twice", permission.Key)
+		}
+		seen[permission.Key] = true
+		if len(permission.Updaters) > MaxAttributeUpdaters {
+			return fmt.Errorf("attribute %s cannot have more than %d updaters", permission.Key, MaxAttributeUpdaters)
+		}
+		for _, updater := range permission.Updaters {
+			if _, err := sdk.AccAddressFromBech32(updater); err != nil {
+				return fmt.Errorf("invalid updater address of attribute %s: %w", permission.Key, err)
+			}
+		}
+	}
+	return nil
+}
+
+// AttributePermissionsEqual checks two cookbooks allow the same updaters to set the same item attributes
+func AttributePermissionsEqual(a, b []ItemAttributePermission) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	updaters := make(map[string][]string, len(a))
+	for _, permission := range a {
+		updaters[permission.Key] = permission.Updaters
+	}
+	for _, permission := range b {
+		original, ok := updaters[permission.Key]
+		if !ok || !IndexedAttributesEqual(original, permission.Updaters) {
+			return false
+		}
+	}
+	return true
+}
+
+// CanUpdateAttribute checks an address can set an attribute of the cookbook items with MsgUpdateItemAttributes
+func (cb Cookbook) CanUpdateAttribute(key, addr string) bool {
+	for _, permission := range cb.AttributePermissions {
+		if permission.Key != key {
+			continue
+		}
+		if addr == cb.Creator {
+			return true
+		}
+		for _, updater := range permission.Updaters {
+			if updater == addr {
+				return true
+			}
+		}
+		return false
+	}
+	return false
+}
@@ -5,7 +5,8 @@ package types
 
 import (
 	fmt "fmt"
-	_ "github.com/cosmos/cosmos-sdk/types"
+	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
+	types "github.com/cosmos/cosmos-sdk/types"
 	_ "github.com/gogo/protobuf/gogoproto"
 	proto "github.com/gogo/protobuf/proto"
 	io "io"
@@ -34,6 +35,15 @@ type Cookbook struct {
 	Version      string `protobuf:"bytes,7,opt,name=version,proto3" json:"version,omitempty"`
 	SupportEmail string `protobuf:"bytes,8,opt,name=support_email,json=supportEmail,proto3" json:"support_email,omitempty"`
 	Enabled      bool   `protobuf:"varint,9,opt,name=enabled,proto3" json:"enabled,omitempty"`
+	// coins paid by the cookbook creator for each item unit burned with MsgBurnItems, the items are burned without
+	// refund when the creator cannot pay it
+	BurnRefund github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,10,rep,name=burn_refund,json=burnRefund,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"burn_refund"`
+	// attribute keys of the Doubles, Longs and Strings of the cookbook items indexed for SearchItems
+	IndexedAttributes []string `protobuf:"bytes,11,rep,name=indexed_attributes,json=indexedAttributes,proto3" json:"indexed_attributes,omitempty"`
+	// attribute keys of the cookbook items that can be set with MsgUpdateItemAttributes
+	AttributePermissions []ItemAttributePermission `protobuf:"bytes,12,rep,name=attribute_permissions,json=attributePermissions,proto3" json:"attribute_permissions"`
+	// typed attributes of the cookbook items, when set the cookbook items can only hold the declared attributes
+	AttributeSchema []ItemAttributeSchema `protobuf:"bytes,13,rep,name=attribute_schema,json=attributeSchema,proto3" json:"attribute_schema"`
 }
 
 func (m *Cookbook) Reset()         { *m = Cookbook{} }
@@ -132,34 +142,223 @@ func (m *Cookbook) GetEnabled() bool {
 	return false
 }
 
+func (m *Cookbook) GetBurnRefund() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.BurnRefund
+	}
+	return nil
+}
+
+func (m *Cookbook) GetIndexedAttributes() []string {
+	if m != nil {
+		return m.IndexedAttributes
+	}
+	return nil
+}
+
+func (m *Cookbook) GetAttributePermissions() []ItemAttributePermission {
+	if m != nil {
+		return m.AttributePermissions
+	}
+	return nil
+}
+
+func (m *Cookbook) GetAttributeSchema() []ItemAttributeSchema {
+	if m != nil {
+		return m.AttributeSchema
+	}
+	return nil
+}
+
+// ItemAttributePermission allows the cookbook creator and the updaters to set an attribute of the cookbook items
+type ItemAttributePermission struct {
+	Key string `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`
+	// addresses, such as game servers, allowed to set the attribute besides the cookbook creator
+	Updaters []string `protobuf:"bytes,2,rep,name=updaters,proto3" json:"updaters,omitempty"`
+}
+
+func (m *ItemAttributePermission) Reset()         { *m = ItemAttributePermission{} }
+func (m *ItemAttributePermission) String() string { return proto.CompactTextString(m) }
+func (*ItemAttributePermission) ProtoMessage()    {}
+func (*ItemAttributePermission) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3974a4f725435df2, []int{1}
+}
+func (m *ItemAttributePermission) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ItemAttributePermission) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ItemAttributePermission.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ItemAttributePermission) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ItemAttributePermission.Merge(m, src)
+}
+func (m *ItemAttributePermission) XXX_Size() int {
+	return m.Size()
+}
+func (m *ItemAttributePermission) XXX_DiscardUnknown() {
+	xxx_messageInfo_ItemAttributePermission.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ItemAttributePermission proto.InternalMessageInfo
+
+func (m *ItemAttributePermission) GetKey() string {
+	if m != nil {
+		return m.Key
+	}
+	return ""
+}
+
+func (m *ItemAttributePermission) GetUpdaters() []string {
+	if m != nil {
+		return m.Updaters
+	}
+	return nil
+}
+
+// ItemAttributeSchema declares a typed attribute of the cookbook items
+type ItemAttributeSchema struct {
+	Key string `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`
+	// type of the attribute, one of double, long or string
+	Type string `protobuf:"bytes,2,opt,name=type,proto3" json:"type,omitempty"`
+	// required attributes are set by every item output of the cookbook recipes
+	Required bool `protobuf:"varint,3,opt,name=required,proto3" json:"required,omitempty"`
+	// inclusive decimal bounds of a double or long attribute, the attribute is unbounded when empty
+	Min string `protobuf:"bytes,4,opt,name=min,proto3" json:"min,omitempty"`
+	Max string `protobuf:"bytes,5,opt,name=max,proto3" json:"max,omitempty"`
+	// format of a string attribute, empty for any string or url
+	Format string `protobuf:"bytes,6,opt,name=format,proto3" json:"format,omitempty"`
+}
+
+func (m *ItemAttributeSchema) Reset()         { *m = ItemAttributeSchema{} }
+func (m *ItemAttributeSchema) String() string { return proto.CompactTextString(m) }
+func (*ItemAttributeSchema) ProtoMessage()    {}
+func (*ItemAttributeSchema) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3974a4f725435df2, []int{2}
+}
+func (m *ItemAttributeSchema) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ItemAttributeSchema) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ItemAttributeSchema.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ItemAttributeSchema) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ItemAttributeSchema.Merge(m, src)
+}
+func (m *ItemAttributeSchema) XXX_Size() int {
+	return m.Size()
+}
+func (m *ItemAttributeSchema) XXX_DiscardUnknown() {
+	xxx_messageInfo_ItemAttributeSchema.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ItemAttributeSchema proto.InternalMessageInfo
+
+func (m *ItemAttributeSchema) GetKey() string {
+	if m != nil {
+		return m.Key
+	}
+	return ""
+}
+
+func (m *ItemAttributeSchema) GetType() string {
+	if m != nil {
+		return m.Type
+	}
+	return ""
+}
+
+func (m *ItemAttributeSchema) GetRequired() bool {
+	if m != nil {
+		return m.Required
+	}
+	return false
+}
+
+func (m *ItemAttributeSchema) GetMin() string {
+	if m != nil {
+		return m.Min
+	}
+	return ""
+}
+
+func (m *ItemAttributeSchema) GetMax() string {
+	if m != nil {
+		return m.Max
+	}
+	return ""
+}
+
+func (m *ItemAttributeSchema) GetFormat() string {
+	if m != nil {
+		return m.Format
+	}
+	return ""
+}
+
 func init() {
 	proto.RegisterType((*Cookbook)(nil), "pylons.pylons.Cookbook")
+	proto.RegisterType((*ItemAttributePermission)(nil), "pylons.pylons.ItemAttributePermission")
+	proto.RegisterType((*ItemAttributeSchema)(nil), "pylons.pylons.ItemAttributeSchema")
 }
 
 func init() { proto.RegisterFile("pylons/pylons/cookbook.proto", fileDescriptor_3974a4f725435df2) }
 
 var fileDescriptor_3974a4f725435df2 = []byte{
-	// 318 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x4c, 0x91, 0xbf, 0x4e, 0xc3, 0x30,
-	0x10, 0xc6, 0xeb, 0x50, 0xfa, 0xc7, 0x6d, 0x19, 0x2c, 0x06, 0xab, 0xaa, 0xac, 0x00, 0x4b, 0x07,
-	0x68, 0x54, 0xf1, 0x06, 0x20, 0x98, 0x51, 0x07, 0x06, 0x96, 0x2a, 0x71, 0x4e, 0xad, 0xd5, 0x24,
-	0x67, 0xd9, 0x6e, 0x45, 0x77, 0x1e, 0x80, 0xc7, 0x62, 0xec, 0xc8, 0x88, 0xda, 0x17, 0x41, 0x71,
-	0x12, 0xc1, 0x74, 0xf7, 0xfd, 0x3e, 0x7f, 0xf6, 0xe9, 0x4c, 0x27, 0x7a, 0x9f, 0x61, 0x61, 0xa3,
-	0xba, 0x48, 0xc4, 0x4d, 0x82, 0xb8, 0x99, 0x69, 0x83, 0x0e, 0xd9, 0xa8, 0xc2, 0xb3, 0xaa, 0x8c,
-	0x2f, 0x57, 0xb8, 0x42, 0xef, 0x44, 0x65, 0x57, 0x1d, 0x1a, 0x0b, 0x89, 0x36, 0x47, 0x1b, 0x25,
-	0xb1, 0x85, 0x68, 0x37, 0x4f, 0xc0, 0xc5, 0xf3, 0x48, 0xa2, 0x2a, 0x2a, 0xff, 0xfa, 0x23, 0xa0,
-	0xbd, 0xc7, 0xfa, 0x5e, 0xc6, 0x69, 0x57, 0x1a, 0x88, 0x1d, 0x1a, 0x4e, 0x42, 0x32, 0xed, 0x2f,
-	0x1a, 0xc9, 0x2e, 0x68, 0xa0, 0x52, 0x1e, 0x78, 0x18, 0xa8, 0x94, 0x5d, 0xd1, 0x61, 0x81, 0x29,
-	0x2c, 0x77, 0x60, 0xac, 0xc2, 0x82, 0x9f, 0x85, 0x64, 0xda, 0x5e, 0x0c, 0x4a, 0xf6, 0x5a, 0x21,
-	0xc6, 0x68, 0xbb, 0x88, 0x73, 0xe0, 0x6d, 0x1f, 0xf2, 0x3d, 0x0b, 0xe9, 0x20, 0x05, 0x2b, 0x8d,
-	0xd2, 0xae, 0x4c, 0x9d, 0x7b, 0xeb, 0x3f, 0x62, 0x13, 0xda, 0x4f, 0x61, 0x07, 0x19, 0x6a, 0x30,
-	0xbc, 0xe3, 0xfd, 0x3f, 0x50, 0x0e, 0xd8, 0xbc, 0xd8, 0xad, 0x06, 0xac, 0x25, 0xbb, 0xa1, 0x23,
-	0xbb, 0xd5, 0x1a, 0x8d, 0x5b, 0x42, 0x1e, 0xab, 0x8c, 0xf7, 0xbc, 0x3f, 0xac, 0xe1, 0x53, 0xc9,
-	0xca, 0x38, 0x14, 0x71, 0x92, 0x41, 0xca, 0xfb, 0x21, 0x99, 0xf6, 0x16, 0x8d, 0x7c, 0x78, 0xfe,
-	0x3a, 0x0a, 0x72, 0x38, 0x0a, 0xf2, 0x73, 0x14, 0xe4, 0xf3, 0x24, 0x5a, 0x87, 0x93, 0x68, 0x7d,
-	0x9f, 0x44, 0xeb, 0xed, 0x76, 0xa5, 0xdc, 0x7a, 0x9b, 0xcc, 0x24, 0xe6, 0xd1, 0x8b, 0xdf, 0xf4,
-	0x9d, 0x03, 0xb9, 0x6e, 0xfe, 0xe4, 0xbd, 0x69, 0xdc, 0x5e, 0x83, 0x4d, 0x3a, 0x7e, 0xab, 0xf7,
-	0xbf, 0x01, 0x00, 0x00, 0xff, 0xff, 0xdb, 0x5c, 0xab, 0xcb, 0xba, 0x01, 0x00, 0x00,
+	// 561 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x7c, 0x53, 0xd1, 0x6e, 0xd3, 0x30,
+	0x14, 0x6d, 0xda, 0xb0, 0xb5, 0xee, 0x06, 0xc3, 0x0c, 0x30, 0xd3, 0x94, 0x85, 0x22, 0xa1, 0x3c,
+	0xb0, 0x84, 0xc1, 0x17, 0xb0, 0x09, 0x10, 0x6f, 0x53, 0x26, 0xf1, 0xc0, 0x4b, 0xe5, 0xc4, 0x77,
+	0x9b, 0xd5, 0x26, 0x0e, 0xb6, 0x33, 0xad, 0x7f, 0x81, 0xc4, 0x5f, 0xf0, 0x25, 0x7b, 0xdc, 0x23,
+	0xbc, 0x00, 0x6a, 0x7f, 0x04, 0xd9, 0x71, 0xb3, 0x4d, 0x0c, 0x9e, 0x7c, 0xef, 0x39, 0xbe, 0xc7,
+	0x37, 0x47, 0x27, 0x68, 0xbb, 0x9a, 0x4d, 0x45, 0xa9, 0x12, 0x77, 0xe4, 0x42, 0x4c, 0x32, 0x21,
+	0x26, 0x71, 0x25, 0x85, 0x16, 0x78, 0xbd, 0x81, 0xe3, 0xe6, 0xd8, 0xda, 0x3c, 0x11, 0x27, 0xc2,
+	0x32, 0x89, 0xa9, 0x9a, 0x4b, 0x5b, 0x41, 0x2e, 0x54, 0x21, 0x54, 0x92, 0x51, 0x05, 0xc9, 0xd9,
+	0x5e, 0x06, 0x9a, 0xee, 0x25, 0xb9, 0xe0, 0x65, 0xc3, 0x8f, 0x7e, 0xf8, 0xa8, 0x7f, 0xe0, 0x74,
+	0x31, 0x41, 0xab, 0xb9, 0x04, 0xaa, 0x85, 0x24, 0x5e, 0xe8, 0x45, 0x83, 0x74, 0xd9, 0xe2, 0xbb,
+	0xa8, 0xcb, 0x19, 0xe9, 0x5a, 0xb0, 0xcb, 0x19, 0x7e, 0x8a, 0xd6, 0x4a, 0xc1, 0x60, 0x7c, 0x06,
+	0x52, 0x71, 0x51, 0x92, 0x5e, 0xe8, 0x45, 0x7e, 0x3a, 0x34, 0xd8, 0xc7, 0x06, 0xc2, 0x18, 0xf9,
+	0x25, 0x2d, 0x80, 0xf8, 0x76, 0xc8, 0xd6, 0x38, 0x44, 0x43, 0x06, 0x2a, 0x97, 0xbc, 0xd2, 0x66,
+	0xea, 0x8e, 0xa5, 0xae, 0x43, 0x78, 0x1b, 0x0d, 0x18, 0x9c, 0xc1, 0x54, 0x54, 0x20, 0xc9, 0x8a,
+	0xe5, 0xaf, 0x00, 0xb3, 0xe0, 0xf2, 0xc5, 0xd5, 0x66, 0x41, 0xd7, 0xe2, 0x67, 0x68, 0x5d, 0xd5,
+	0x55, 0x25, 0xa4, 0x1e, 0x43, 0x41, 0xf9, 0x94, 0xf4, 0x2d, 0xbf, 0xe6, 0xc0, 0xb7, 0x06, 0x33,
+	0xe3, 0x50, 0xd2, 0x6c, 0x0a, 0x8c, 0x0c, 0x42, 0x2f, 0xea, 0xa7, 0xcb, 0x16, 0x4f, 0xd1, 0x30,
+	0xab, 0x65, 0x39, 0x96, 0x70, 0x5c, 0x97, 0x8c, 0xa0, 0xb0, 0x17, 0x0d, 0x5f, 0x3d, 0x89, 0x1b,
+	0xf3, 0x62, 0x63, 0x5e, 0xec, 0xcc, 0x8b, 0x0f, 0x04, 0x2f, 0xf7, 0x5f, 0x5e, 0xfc, 0xdc, 0xe9,
+	0x7c, 0xfb, 0xb5, 0x13, 0x9d, 0x70, 0x7d, 0x5a, 0x67, 0x71, 0x2e, 0x8a, 0xc4, 0x39, 0xdd, 0x1c,
+	0xbb, 0x8a, 0x4d, 0x12, 0x3d, 0xab, 0x40, 0xd9, 0x01, 0x95, 0x22, 0xa3, 0x9f, 0x5a, 0x79, 0xbc,
+	0x8b, 0x30, 0x2f, 0x19, 0x9c, 0x03, 0x1b, 0x53, 0xad, 0x25, 0xcf, 0x6a, 0x0d, 0x8a, 0x0c, 0xc3,
+	0x5e, 0x34, 0x48, 0xef, 0x3b, 0xe6, 0x4d, 0x4b, 0x60, 0x8a, 0x1e, 0xb6, 0xd7, 0xc6, 0x15, 0xc8,
+	0x82, 0x2b, 0xf3, 0xcd, 0x8a, 0xac, 0xd9, 0x35, 0x9f, 0xc7, 0x37, 0x82, 0x10, 0x7f, 0xd0, 0x50,
+	0xb4, 0xd3, 0x87, 0xed, 0xf5, 0x7d, 0xdf, 0xec, 0x9c, 0x6e, 0xd2, 0xbf, 0x29, 0x85, 0x8f, 0xd0,
+	0xc6, 0xd5, 0x13, 0x2a, 0x3f, 0x85, 0x82, 0x92, 0x75, 0xab, 0x3e, 0xfa, 0x9f, 0xfa, 0x91, 0xbd,
+	0xe9, 0x94, 0xef, 0xd1, 0x9b, 0xf0, 0xe8, 0x3d, 0x7a, 0xfc, 0x8f, 0x5d, 0xf0, 0x06, 0xea, 0x4d,
+	0x60, 0xe6, 0x52, 0x66, 0x4a, 0xbc, 0x85, 0xfa, 0x75, 0xc5, 0xa8, 0x06, 0xa9, 0x48, 0xd7, 0x3a,
+	0xd1, 0xf6, 0xa3, 0xaf, 0x1e, 0x7a, 0x70, 0xcb, 0xbb, 0xb7, 0xa8, 0x60, 0xe4, 0x1b, 0xd3, 0x5d,
+	0x52, 0x6d, 0x6d, 0x94, 0x25, 0x7c, 0xae, 0xb9, 0x04, 0x66, 0x73, 0xda, 0x4f, 0xdb, 0xde, 0x28,
+	0x14, 0xbc, 0x74, 0x19, 0x35, 0xa5, 0x45, 0xe8, 0xb9, 0x8b, 0xa6, 0x29, 0xf1, 0x23, 0xb4, 0x72,
+	0x2c, 0x64, 0x41, 0xb5, 0xcb, 0xa3, 0xeb, 0xf6, 0xdf, 0x5d, 0xcc, 0x03, 0xef, 0x72, 0x1e, 0x78,
+	0xbf, 0xe7, 0x81, 0xf7, 0x65, 0x11, 0x74, 0x2e, 0x17, 0x41, 0xe7, 0xfb, 0x22, 0xe8, 0x7c, 0x7a,
+	0x71, 0x2d, 0x15, 0x87, 0xd6, 0xb6, 0x5d, 0x0d, 0xf9, 0xe9, 0xf2, 0x3f, 0x3e, 0x5f, 0x16, 0x36,
+	0x1f, 0xd9, 0x8a, 0xfd, 0x13, 0x5f, 0xff, 0x19, 0x00, 0xf9, 0x9a, 0x57, 0xe1, 0xee, 0x03, 0x00,
+	0x00,
 }
 
 func (m *Cookbook) Marshal() (dAtA []byte, err error) {
@@ -182,6 +381,57 @@ func (m *Cookbook) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.AttributeSchema) > 0 {
+		for iNdEx := len(m.AttributeSchema) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.AttributeSchema[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintCookbook(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x6a
+		}
+	}
+	if len(m.AttributePermissions) > 0 {
+		for iNdEx := len(m.AttributePermissions) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.AttributePermissions[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintCookbook(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x62
+		}
+	}
+	if len(m.IndexedAttributes) > 0 {
+		for iNdEx := len(m.IndexedAttributes) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.IndexedAttributes[iNdEx])
+			copy(dAtA[i:], m.IndexedAttributes[iNdEx])
+			i = encodeVarintCookbook(dAtA, i, uint64(len(m.IndexedAttributes[iNdEx])))
+			i--
+			dAtA[i] = 0x5a
+		}
+	}
+	if len(m.BurnRefund) > 0 {
+		for iNdEx := len(m.BurnRefund) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.BurnRefund[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintCookbook(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x52
+		}
+	}
 	if m.Enabled {
 		i--
 		if m.Enabled {
@@ -249,6 +499,113 @@ func (m *Cookbook) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *ItemAttributePermission) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ItemAttributePermission) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ItemAttributePermission) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Updaters) > 0 {
+		for iNdEx := len(m.Updaters) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Updaters[iNdEx])
+			copy(dAtA[i:], m.Updaters[iNdEx])
+			i = encodeVarintCookbook(dAtA, i, uint64(len(m.Updaters[iNdEx])))
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.Key) > 0 {
+		i -= len(m.Key)
+		copy(dAtA[i:], m.Key)
+		i = encodeVarintCookbook(dAtA, i, uint64(len(m.Key)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ItemAttributeSchema) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ItemAttributeSchema) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ItemAttributeSchema) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Format) > 0 {
+		i -= len(m.Format)
+		copy(dAtA[i:], m.Format)
+		i = encodeVarintCookbook(dAtA, i, uint64(len(m.Format)))
+		i--
+		dAtA[i] = 0x32
+	}
+	if len(m.Max) > 0 {
+		i -= len(m.Max)
+		copy(dAtA[i:], m.Max)
+		i = encodeVarintCookbook(dAtA, i, uint64(len(m.Max)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.Min) > 0 {
+		i -= len(m.Min)
+		copy(dAtA[i:], m.Min)
+		i = encodeVarintCookbook(dAtA, i, uint64(len(m.Min)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.Required {
+		i--
+		if m.Required {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.Type) > 0 {
+		i -= len(m.Type)
+		copy(dAtA[i:], m.Type)
+		i = encodeVarintCookbook(dAtA, i, uint64(len(m.Type)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Key) > 0 {
+		i -= len(m.Key)
+		copy(dAtA[i:], m.Key)
+		i = encodeVarintCookbook(dAtA, i, uint64(len(m.Key)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintCookbook(dAtA []byte, offset int, v uint64) int {
 	offset -= sovCookbook(v)
 	base := offset
@@ -300,6 +657,81 @@ func (m *Cookbook) Size() (n int) {
 	if m.Enabled {
 		n += 2
 	}
+	if len(m.BurnRefund) > 0 {
+		for _, e := range m.BurnRefund {
+			l = e.Size()
+			n += 1 + l + sovCookbook(uint64(l))
+		}
+	}
+	if len(m.IndexedAttributes) > 0 {
+		for _, s := range m.IndexedAttributes {
+			l = len(s)
+			n += 1 + l + sovCookbook(uint64(l))
+		}
+	}
+	if len(m.AttributePermissions) > 0 {
+		for _, e := range m.AttributePermissions {
+			l = e.Size()
+			n += 1 + l + sovCookbook(uint64(l))
+		}
+	}
+	if len(m.AttributeSchema) > 0 {
+		for _, e := range m.AttributeSchema {
+			l = e.Size()
+			n += 1 + l + sovCookbook(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *ItemAttributePermission) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Key)
+	if l > 0 {
+		n += 1 + l + sovCookbook(uint64(l))
+	}
+	if len(m.Updaters) > 0 {
+		for _, s := range m.Updaters {
+			l = len(s)
+			n += 1 + l + sovCookbook(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *ItemAttributeSchema) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Key)
+	if l > 0 {
+		n += 1 + l + sovCookbook(uint64(l))
+	}
+	l = len(m.Type)
+	if l > 0 {
+		n += 1 + l + sovCookbook(uint64(l))
+	}
+	if m.Required {
+		n += 2
+	}
+	l = len(m.Min)
+	if l > 0 {
+		n += 1 + l + sovCookbook(uint64(l))
+	}
+	l = len(m.Max)
+	if l > 0 {
+		n += 1 + l + sovCookbook(uint64(l))
+	}
+	l = len(m.Format)
+	if l > 0 {
+		n += 1 + l + sovCookbook(uint64(l))
+	}
 	return n
 }
 
@@ -601,6 +1033,484 @@ func (m *Cookbook) Unmarshal(dAtA []byte) error {
 				}
 			}
 			m.Enabled = bool(v != 0)
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BurnRefund", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCookbook
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthCookbook
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthCookbook
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.BurnRefund = append(m.BurnRefund, types.Coin{})
+			if err := m.BurnRefund[len(m.BurnRefund)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IndexedAttributes", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCookbook
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthCookbook
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCookbook
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.IndexedAttributes = append(m.IndexedAttributes, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 12:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AttributePermissions", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCookbook
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthCookbook
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthCookbook
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AttributePermissions = append(m.AttributePermissions, ItemAttributePermission{})
+			if err := m.AttributePermissions[len(m.AttributePermissions)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 13:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AttributeSchema", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCookbook
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthCookbook
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthCookbook
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AttributeSchema = append(m.AttributeSchema, ItemAttributeSchema{})
+			if err := m.AttributeSchema[len(m.AttributeSchema)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipCookbook(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthCookbook
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ItemAttributePermission) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowCookbook
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ItemAttributePermission: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ItemAttributePermission: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Key", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCookbook
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthCookbook
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCookbook
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Key = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Updaters", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCookbook
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthCookbook
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCookbook
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Updaters = append(m.Updaters, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipCookbook(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthCookbook
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ItemAttributeSchema) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowCookbook
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ItemAttributeSchema: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ItemAttributeSchema: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Key", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCookbook
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthCookbook
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCookbook
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Key = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Type", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCookbook
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthCookbook
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCookbook
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Type = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Required", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCookbook
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Required = bool(v != 0)
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Min", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCookbook
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthCookbook
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCookbook
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Min = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Max", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCookbook
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthCookbook
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCookbook
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Max = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Format", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCookbook
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthCookbook
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCookbook
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Format = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipCookbook(dAtA[iNdEx:])
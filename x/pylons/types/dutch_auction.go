@@ -0,0 +1,53 @@
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// MaxEndedDutchAuctionsPerBlock bounds the number of ended dutch auctions closed at the end of each block
+const MaxEndedDutchAuctionsPerBlock = 100
+
+const (
+	// DutchAuctionDecayLinear decreases the price by the same amount at each block
+	DutchAuctionDecayLinear = "linear"
+	// DutchAuctionDecayExponential decreases the price by the same ratio at each block
+	DutchAuctionDecayExponential = "exponential"
+)
+
+// IsStarted checks if the dutch auction can be bought at the current block height
+func (a DutchAuction) IsStarted(ctx sdk.Context) bool {
+	return ctx.BlockHeight() >= a.StartHeight
+}
+
+// IsEnded checks if the dutch auction can no longer be bought, it can be bought at the floor price until its end height
+func (a DutchAuction) IsEnded(ctx sdk.Context) bool {
+	return ctx.BlockHeight() > a.EndHeight
+}
+
+// PriceAt returns the price of the dutch auction at a block height. The price is the start price until the start
+// height and the floor price from the end height, and decays in between. Linear prices are rounded up, and exponential
+// prices are rounded to the nearest integer to absorb the approximation of the root
+func (a DutchAuction) PriceAt(height int64) sdk.Coin {
+	switch {
+	case height <= a.StartHeight:
+		return a.StartPrice
+	case height >= a.EndHeight:
+		return a.FloorPrice
+	}
+
+	elapsed := height - a.StartHeight
+	duration := a.EndHeight - a.StartHeight
+	if a.Decay == DutchAuctionDecayExponential {
+		// the price is multiplied at each block by the root of floor/start such that it reaches the floor at the end height
+		ratio := sdk.NewDecFromInt(a.FloorPrice.Amount).QuoInt(a.StartPrice.Amount)
+		perBlock, err := ratio.ApproxRoot(uint64(duration))
+		if err != nil {
+			return a.FloorPrice
+		}
+		amount := sdk.NewDecFromInt(a.StartPrice.Amount).Mul(perBlock.Power(uint64(elapsed))).RoundInt()
+		return sdk.NewCoin(a.StartPrice.Denom, sdk.MaxInt(amount, a.FloorPrice.Amount))
+	}
+
+	decrease := a.StartPrice.Amount.Sub(a.FloorPrice.Amount).MulRaw(elapsed).QuoRaw(duration)
+	return sdk.NewCoin(a.StartPrice.Denom, a.StartPrice.Amount.Sub(decrease))
+}
@@ -0,0 +1,43 @@
+package types
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDutchAuctionPriceAt(t *testing.T) {
+	coin := func(amount int64) sdk.Coin {
+		return sdk.NewCoin(PylonsCoinDenom, sdk.NewInt(amount))
+	}
+	for _, tc := range []struct {
+		desc   string
+		decay  string
+		height int64
+		price  sdk.Coin
+	}{
+		{desc: "LinearBeforeStart", decay: DutchAuctionDecayLinear, height: 5, price: coin(1000)},
+		{desc: "LinearAtStart", decay: DutchAuctionDecayLinear, height: 10, price: coin(1000)},
+		{desc: "LinearRoundedUp", decay: DutchAuctionDecayLinear, height: 11, price: coin(813)},
+		{desc: "LinearHalfway", decay: DutchAuctionDecayLinear, height: 12, price: coin(625)},
+		{desc: "LinearAtEnd", decay: DutchAuctionDecayLinear, height: 14, price: coin(250)},
+		{desc: "LinearAfterEnd", decay: DutchAuctionDecayLinear, height: 20, price: coin(250)},
+		{desc: "ExponentialAtStart", decay: DutchAuctionDecayExponential, height: 10, price: coin(1000)},
+		{desc: "ExponentialRounded", decay: DutchAuctionDecayExponential, height: 11, price: coin(707)},
+		{desc: "ExponentialHalfway", decay: DutchAuctionDecayExponential, height: 12, price: coin(500)},
+		{desc: "ExponentialAtEnd", decay: DutchAuctionDecayExponential, height: 14, price: coin(250)},
+	} {
+		tc := tc
+		t.Run(tc.desc, func(t *testing.T) {
+			auction := DutchAuction{
+				StartPrice:  coin(1000),
+				FloorPrice:  coin(250),
+				StartHeight: 10,
+				EndHeight:   14,
+				Decay:       tc.decay,
+			}
+			require.Equal(t, tc.price, auction.PriceAt(tc.height))
+		})
+	}
+}
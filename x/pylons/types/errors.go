@@ -16,4 +16,10 @@ var (
 	ErrItemLocked              = sdkerrors.Register(ModuleName, 1105, "item locked")
 	ErrReceiptAlreadyUsed      = sdkerrors.Register(ModuleName, 1106, "receipt already used")
 	ErrReferralUserNotFound    = sdkerrors.Register(ModuleName, 1107, "referral user not found")
+	ErrItemQuantity            = sdkerrors.Register(ModuleName, 1108, "insufficient item quantity")
+	ErrItemExpired             = sdkerrors.Register(ModuleName, 1109, "item expired")
+	ErrInvalidVersion          = sdkerrors.Register(ModuleName, 1110, "invalid ICS-721 version")
+	ErrItemTransferRestricted  = sdkerrors.Register(ModuleName, 1111, "item transfer restricted")
+	ErrTradeExpired            = sdkerrors.Register(ModuleName, 1112, "trade expired")
+	ErrItemOfferExpired        = sdkerrors.Register(ModuleName, 1113, "item offer expired")
 )
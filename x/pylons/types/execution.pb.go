@@ -30,6 +30,10 @@ type ItemRecord struct {
 	Doubles []DoubleKeyValue `protobuf:"bytes,2,rep,name=doubles,proto3" json:"doubles"`
 	Longs   []LongKeyValue   `protobuf:"bytes,3,rep,name=longs,proto3" json:"longs"`
 	Strings []StringKeyValue `protobuf:"bytes,4,rep,name=strings,proto3" json:"strings"`
+	// borrowed items are used by the execution without being locked nor consumed
+	Borrowed bool `protobuf:"varint,5,opt,name=borrowed,proto3" json:"borrowed,omitempty"`
+	// owner of the item when the execution was submitted, the item is returned to it if the execution fails
+	Owner string `protobuf:"bytes,6,opt,name=owner,proto3" json:"owner,omitempty"`
 }
 
 func (m *ItemRecord) Reset()         { *m = ItemRecord{} }
@@ -93,6 +97,20 @@ func (m *ItemRecord) GetStrings() []StringKeyValue {
 	return nil
 }
 
+func (m *ItemRecord) GetBorrowed() bool {
+	if m != nil {
+		return m.Borrowed
+	}
+	return false
+}
+
+func (m *ItemRecord) GetOwner() string {
+	if m != nil {
+		return m.Owner
+	}
+	return ""
+}
+
 type Execution struct {
 	Creator             string                                   `protobuf:"bytes,1,opt,name=creator,proto3" json:"creator,omitempty"`
 	Id                  string                                   `protobuf:"bytes,2,opt,name=id,proto3" json:"id,omitempty"`
@@ -233,42 +251,44 @@ func init() {
 func init() { proto.RegisterFile("pylons/pylons/execution.proto", fileDescriptor_a4ba7e747c28b3a9) }
 
 var fileDescriptor_a4ba7e747c28b3a9 = []byte{
-	// 557 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x53, 0xcd, 0x6e, 0xd3, 0x40,
-	0x10, 0x8e, 0x93, 0xb4, 0x69, 0xd6, 0x49, 0x91, 0x16, 0x84, 0x4c, 0xaa, 0x3a, 0xa1, 0x12, 0x92,
-	0x0f, 0xad, 0x4d, 0xe1, 0x80, 0x38, 0x20, 0xa1, 0xf0, 0x23, 0x22, 0x40, 0xa0, 0x20, 0xf5, 0xc0,
-	0x25, 0x8a, 0xbd, 0x8b, 0xb3, 0x8a, 0xb3, 0x13, 0x79, 0xd7, 0x55, 0xf3, 0x16, 0x3c, 0x07, 0x4f,
-	0xd2, 0x63, 0x25, 0x2e, 0x9c, 0x00, 0x25, 0x27, 0xde, 0x02, 0xed, 0x8f, 0xdb, 0x24, 0xe2, 0xc8,
-	0x69, 0xed, 0xef, 0x67, 0x3e, 0xcf, 0xec, 0x18, 0x1d, 0xce, 0x17, 0x19, 0x70, 0x11, 0xd9, 0x83,
-	0x5e, 0xd0, 0xa4, 0x90, 0x0c, 0x78, 0x38, 0xcf, 0x41, 0x02, 0x6e, 0x1b, 0x3c, 0x34, 0x47, 0xe7,
-	0x4e, 0x0a, 0x29, 0x68, 0x26, 0x52, 0x4f, 0x46, 0xd4, 0xf1, 0x13, 0x10, 0x33, 0x10, 0x51, 0x3c,
-	0x16, 0x34, 0x3a, 0x3f, 0x8d, 0xa9, 0x1c, 0x9f, 0x46, 0x09, 0x30, 0x5b, 0xa4, 0xe3, 0x6d, 0x66,
-	0x30, 0x49, 0x67, 0x96, 0xe9, 0x6c, 0x32, 0x39, 0x4d, 0xd8, 0x9c, 0x1a, 0xee, 0xe8, 0xbb, 0x83,
-	0xd0, 0x40, 0xd2, 0xd9, 0x90, 0x26, 0x90, 0x13, 0xbc, 0x8f, 0xaa, 0x8c, 0x78, 0x4e, 0xcf, 0x09,
-	0x9a, 0xc3, 0x2a, 0x23, 0xf8, 0x19, 0x6a, 0x10, 0x28, 0xe2, 0x8c, 0x0a, 0xaf, 0xda, 0xab, 0x05,
-	0xee, 0xa3, 0xc3, 0x70, 0xe3, 0x5b, 0xc3, 0x97, 0x9a, 0x7d, 0x4b, 0x17, 0x67, 0xe3, 0xac, 0xa0,
-	0xfd, 0xfa, 0xe5, 0xcf, 0x6e, 0x65, 0x58, 0x7a, 0xf0, 0x13, 0xb4, 0x93, 0x01, 0x4f, 0x85, 0x57,
-	0xd3, 0xe6, 0x83, 0x2d, 0xf3, 0x3b, 0xe0, 0xe9, 0x96, 0xd5, 0xe8, 0x55, 0xae, 0x90, 0x39, 0x53,
-	0xd6, 0xfa, 0x3f, 0x73, 0x3f, 0x69, 0x76, 0x3b, 0xd7, 0x7a, 0x8e, 0xfe, 0xd4, 0x51, 0xf3, 0x55,
-	0x39, 0x64, 0xec, 0xa1, 0x46, 0x92, 0xd3, 0xb1, 0x84, 0xdc, 0x76, 0x56, 0xbe, 0xda, 0x76, 0xab,
-	0xd7, 0xed, 0x1e, 0xa0, 0xa6, 0x99, 0xce, 0x88, 0x11, 0xaf, 0xa6, 0xe1, 0x3d, 0x03, 0x0c, 0x08,
-	0xee, 0x22, 0x37, 0x01, 0x98, 0xc6, 0x00, 0x53, 0x45, 0xd7, 0x35, 0x8d, 0x4a, 0x68, 0x40, 0xf0,
-	0x03, 0xb4, 0x6f, 0xdd, 0xe7, 0x34, 0x17, 0x0c, 0xb8, 0xb7, 0xa3, 0x35, 0x6d, 0x83, 0x9e, 0x19,
-	0x10, 0xdf, 0x47, 0x2d, 0x0e, 0xe4, 0x46, 0xb4, 0xdb, 0x73, 0x82, 0xfa, 0xd0, 0x55, 0xd8, 0x9a,
-	0x24, 0xce, 0x20, 0x99, 0x8e, 0x26, 0x94, 0xa5, 0x13, 0xe9, 0x35, 0x7a, 0x4e, 0x50, 0x1b, 0xba,
-	0x1a, 0x7b, 0xa3, 0x21, 0xfc, 0x1c, 0xb9, 0xea, 0x8a, 0x47, 0x8c, 0xcf, 0x0b, 0x29, 0xbc, 0x3d,
-	0x3d, 0xa5, 0x7b, 0x5b, 0x53, 0xba, 0xb9, 0x59, 0x3b, 0x21, 0xa4, 0x3c, 0x03, 0x6d, 0xc1, 0x99,
-	0xea, 0x87, 0xf1, 0xb2, 0x42, 0xd3, 0x56, 0x30, 0x6b, 0x16, 0xaa, 0x35, 0x0b, 0xed, 0x9a, 0x85,
-	0x2f, 0x80, 0xf1, 0xfe, 0x43, 0x55, 0xe1, 0xdb, 0xaf, 0x6e, 0x90, 0x32, 0x39, 0x29, 0xe2, 0x30,
-	0x81, 0x59, 0x64, 0x77, 0xd2, 0x1c, 0x27, 0x82, 0x4c, 0x23, 0xb9, 0x98, 0x53, 0xa1, 0x0d, 0x42,
-	0x0d, 0x87, 0x71, 0x9b, 0xc6, 0x51, 0x4b, 0xa7, 0x41, 0x21, 0x75, 0x1c, 0xfa, 0xff, 0x71, 0xba,
-	0x9d, 0x0f, 0xa6, 0x3e, 0x3e, 0x46, 0xb7, 0xf4, 0x7c, 0x4c, 0xde, 0x88, 0x11, 0xe1, 0xb9, 0xbd,
-	0x5a, 0xd0, 0xb4, 0x83, 0x68, 0x2b, 0xd2, 0x68, 0x07, 0x44, 0xe0, 0xa7, 0xe8, 0xae, 0x56, 0xcf,
-	0x80, 0xb0, 0x2f, 0x8b, 0x75, 0x53, 0x6b, 0xcd, 0x74, 0x5b, 0x69, 0xde, 0x6b, 0xc9, 0xb5, 0xb5,
-	0xff, 0xfa, 0x72, 0xe9, 0x3b, 0x57, 0x4b, 0xdf, 0xf9, 0xbd, 0xf4, 0x9d, 0xaf, 0x2b, 0xbf, 0x72,
-	0xb5, 0xf2, 0x2b, 0x3f, 0x56, 0x7e, 0xe5, 0xf3, 0xf1, 0xda, 0x97, 0x7f, 0xd4, 0x17, 0x72, 0x22,
-	0x69, 0x32, 0x29, 0xff, 0xc3, 0x8b, 0xf2, 0x41, 0xf7, 0x10, 0xef, 0xea, 0x1f, 0xf2, 0xf1, 0xdf,
-	0x00, 0x00, 0x00, 0xff, 0xff, 0xc0, 0x35, 0xbf, 0x86, 0x2c, 0x04, 0x00, 0x00,
+	// 584 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x53, 0xcd, 0x6e, 0xd3, 0x4c,
+	0x14, 0x8d, 0x93, 0xb4, 0x8d, 0xc7, 0x6d, 0x3f, 0x69, 0xbe, 0x0a, 0x99, 0x54, 0x75, 0x43, 0x25,
+	0x24, 0x2f, 0x5a, 0x9b, 0xc2, 0x02, 0xb1, 0x40, 0x42, 0xe5, 0x47, 0x44, 0x80, 0x40, 0x46, 0xea,
+	0x82, 0x4d, 0x14, 0x7b, 0x06, 0x67, 0x14, 0x67, 0x6e, 0xe4, 0x19, 0xb7, 0xcd, 0x03, 0xb0, 0xe7,
+	0x39, 0x78, 0x92, 0x2e, 0xbb, 0x64, 0x05, 0xa8, 0x5d, 0xf1, 0x16, 0x68, 0x7e, 0x9c, 0x26, 0x11,
+	0x4b, 0x56, 0xe3, 0x39, 0xe7, 0x9e, 0x7b, 0x66, 0x8e, 0xef, 0xa0, 0xbd, 0xe9, 0xac, 0x00, 0x2e,
+	0x62, 0xbb, 0xd0, 0x0b, 0x9a, 0x55, 0x92, 0x01, 0x8f, 0xa6, 0x25, 0x48, 0xc0, 0x5b, 0x06, 0x8f,
+	0xcc, 0xd2, 0xdd, 0xc9, 0x21, 0x07, 0xcd, 0xc4, 0xea, 0xcb, 0x14, 0x75, 0x83, 0x0c, 0xc4, 0x04,
+	0x44, 0x9c, 0x0e, 0x05, 0x8d, 0xcf, 0x8e, 0x53, 0x2a, 0x87, 0xc7, 0x71, 0x06, 0xcc, 0x36, 0xe9,
+	0xfa, 0xcb, 0x1e, 0x4c, 0xd2, 0x89, 0x65, 0xba, 0xcb, 0x4c, 0x49, 0x33, 0x36, 0xa5, 0x86, 0x3b,
+	0xf8, 0xd2, 0x44, 0xa8, 0x2f, 0xe9, 0x24, 0xa1, 0x19, 0x94, 0x04, 0x6f, 0xa3, 0x26, 0x23, 0xbe,
+	0xd3, 0x73, 0x42, 0x37, 0x69, 0x32, 0x82, 0x9f, 0xa2, 0x0d, 0x02, 0x55, 0x5a, 0x50, 0xe1, 0x37,
+	0x7b, 0xad, 0xd0, 0x7b, 0xb8, 0x17, 0x2d, 0x9d, 0x35, 0x7a, 0xa1, 0xd9, 0x37, 0x74, 0x76, 0x3a,
+	0x2c, 0x2a, 0x7a, 0xd2, 0xbe, 0xfc, 0xb1, 0xdf, 0x48, 0x6a, 0x0d, 0x7e, 0x8c, 0xd6, 0x0a, 0xe0,
+	0xb9, 0xf0, 0x5b, 0x5a, 0xbc, 0xbb, 0x22, 0x7e, 0x0b, 0x3c, 0x5f, 0x91, 0x9a, 0x7a, 0xe5, 0x2b,
+	0x64, 0xc9, 0x94, 0xb4, 0xfd, 0x57, 0xdf, 0x8f, 0x9a, 0x5d, 0xf5, 0xb5, 0x1a, 0xdc, 0x45, 0x9d,
+	0x14, 0xca, 0x12, 0xce, 0x29, 0xf1, 0xd7, 0x7a, 0x4e, 0xd8, 0x49, 0xe6, 0x7b, 0xbc, 0x83, 0xd6,
+	0xe0, 0x9c, 0xd3, 0xd2, 0x5f, 0xd7, 0xb7, 0x34, 0x9b, 0x83, 0xdf, 0x6d, 0xe4, 0xbe, 0xac, 0x7f,
+	0x0b, 0xf6, 0xd1, 0x46, 0x56, 0xd2, 0xa1, 0x84, 0xd2, 0x66, 0x51, 0x6f, 0x6d, 0x40, 0xcd, 0x79,
+	0x40, 0xbb, 0xc8, 0x35, 0x79, 0x0e, 0x18, 0xf1, 0x5b, 0x1a, 0xee, 0x18, 0xa0, 0x4f, 0xf0, 0x3e,
+	0xf2, 0x32, 0x80, 0x71, 0x0a, 0x30, 0x56, 0x74, 0x5b, 0xd3, 0xa8, 0x86, 0xfa, 0x04, 0xdf, 0x47,
+	0xdb, 0x56, 0x7d, 0x46, 0x4b, 0xc1, 0x80, 0xeb, 0xd3, 0xba, 0xc9, 0x96, 0x41, 0x4f, 0x0d, 0x88,
+	0xef, 0xa1, 0x4d, 0x0e, 0xe4, 0xb6, 0x48, 0x9d, 0xbc, 0x9d, 0x78, 0x0a, 0x5b, 0x28, 0x49, 0x0b,
+	0xc8, 0xc6, 0x83, 0x11, 0x65, 0xf9, 0x48, 0xfa, 0x1b, 0x3d, 0x27, 0x6c, 0x25, 0x9e, 0xc6, 0x5e,
+	0x6b, 0x08, 0x3f, 0x43, 0x9e, 0x1a, 0x8a, 0x01, 0xe3, 0xd3, 0x4a, 0x0a, 0xbf, 0xa3, 0x73, 0xbd,
+	0xbb, 0x92, 0xeb, 0xed, 0x2c, 0xd8, 0x4c, 0x91, 0xd2, 0xf4, 0xb5, 0x04, 0x17, 0xea, 0x3e, 0x8c,
+	0xd7, 0x1d, 0x5c, 0xdb, 0xc1, 0x0c, 0x66, 0xa4, 0x06, 0x33, 0xb2, 0x83, 0x19, 0x3d, 0x07, 0xc6,
+	0x4f, 0x1e, 0xa8, 0x0e, 0xdf, 0x7e, 0xee, 0x87, 0x39, 0x93, 0xa3, 0x2a, 0x8d, 0x32, 0x98, 0xc4,
+	0x76, 0x8a, 0xcd, 0x72, 0x24, 0xc8, 0x38, 0x96, 0xb3, 0x29, 0x15, 0x5a, 0x20, 0x54, 0x38, 0x8c,
+	0x5b, 0x37, 0x8e, 0x36, 0xb5, 0x1b, 0x54, 0x52, 0xdb, 0xa1, 0x7f, 0x6f, 0xa7, 0xaf, 0xf3, 0xde,
+	0xf4, 0xc7, 0x87, 0xe8, 0x3f, 0x9d, 0x8f, 0xf1, 0x1b, 0x30, 0x22, 0x7c, 0xaf, 0xd7, 0x0a, 0x5d,
+	0x1b, 0xc4, 0x96, 0x22, 0x4d, 0x6d, 0x9f, 0x08, 0xfc, 0x04, 0xdd, 0xd1, 0xd5, 0x13, 0x20, 0xec,
+	0xf3, 0x6c, 0x51, 0xb4, 0xb9, 0x20, 0xfa, 0x5f, 0xd5, 0xbc, 0xd3, 0x25, 0x73, 0xe9, 0xc9, 0xab,
+	0xcb, 0xeb, 0xc0, 0xb9, 0xba, 0x0e, 0x9c, 0x5f, 0xd7, 0x81, 0xf3, 0xf5, 0x26, 0x68, 0x5c, 0xdd,
+	0x04, 0x8d, 0xef, 0x37, 0x41, 0xe3, 0xd3, 0xe1, 0xc2, 0xc9, 0x3f, 0xe8, 0x1f, 0x72, 0x24, 0x69,
+	0x36, 0xaa, 0x5f, 0xee, 0x45, 0xfd, 0xa1, 0xef, 0x90, 0xae, 0xeb, 0x27, 0xfc, 0xe8, 0xcf, 0x00,
+	0xc2, 0x1f, 0xc8, 0x02, 0x5e, 0x04, 0x00, 0x00,
 }
 
 func (m *ItemRecord) Marshal() (dAtA []byte, err error) {
@@ -291,6 +311,23 @@ func (m *ItemRecord) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.Owner) > 0 {
+		i -= len(m.Owner)
+		copy(dAtA[i:], m.Owner)
+		i = encodeVarintExecution(dAtA, i, uint64(len(m.Owner)))
+		i--
+		dAtA[i] = 0x32
+	}
+	if m.Borrowed {
+		i--
+		if m.Borrowed {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x28
+	}
 	if len(m.Strings) > 0 {
 		for iNdEx := len(m.Strings) - 1; iNdEx >= 0; iNdEx-- {
 			{
@@ -510,6 +547,13 @@ func (m *ItemRecord) Size() (n int) {
 			n += 1 + l + sovExecution(uint64(l))
 		}
 	}
+	if m.Borrowed {
+		n += 2
+	}
+	l = len(m.Owner)
+	if l > 0 {
+		n += 1 + l + sovExecution(uint64(l))
+	}
 	return n
 }
 
@@ -747,6 +791,58 @@ func (m *ItemRecord) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Borrowed", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowExecution
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Borrowed = bool(v != 0)
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Owner", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowExecution
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthExecution
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthExecution
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Owner = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipExecution(dAtA[iNdEx:])
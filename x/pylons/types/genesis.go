@@ -2,6 +2,8 @@ package types
 
 import (
 	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
 )
 
 // DefaultIndex is the default capability global index
@@ -14,6 +16,20 @@ func DefaultGenesis() *GenesisState {
 		PaymentInfoList:              []PaymentInfo{},
 		AccountList:                  []UserMap{},
 		TradeList:                    []Trade{},
+		LendingList:                  []Lending{},
+		AuctionList:                  []Auction{},
+		DutchAuctionList:             []DutchAuction{},
+		ItemOfferList:                []ItemOffer{},
+		SwapList:                     []Swap{},
+		RecipeStatsList:              []RecipeStats{},
+		CookbookStatsList:            []CookbookStats{},
+		RecipeExecutorsList:          []RecipeExecutors{},
+		CookbookExecutorsList:        []CookbookExecutors{},
+		ItemApprovalList:             []ItemApproval{},
+		ItemOperatorList:             []ItemOperator{},
+		ClassTraceList:               []ClassTrace{},
+		ItemTokenList:                []ItemToken{},
+		ItemEscrowList:               []ItemEscrow{},
 		GoogleInAppPurchaseOrderList: []GoogleInAppPurchaseOrder{},
 		PendingExecutionList:         []Execution{},
 		ExecutionList:                []Execution{},
@@ -29,6 +45,20 @@ func NetworkTestGenesis() *GenesisState {
 	return &GenesisState{
 		AccountList:                  []UserMap{},
 		TradeList:                    []Trade{},
+		LendingList:                  []Lending{},
+		AuctionList:                  []Auction{},
+		DutchAuctionList:             []DutchAuction{},
+		ItemOfferList:                []ItemOffer{},
+		SwapList:                     []Swap{},
+		RecipeStatsList:              []RecipeStats{},
+		CookbookStatsList:            []CookbookStats{},
+		RecipeExecutorsList:          []RecipeExecutors{},
+		CookbookExecutorsList:        []CookbookExecutors{},
+		ItemApprovalList:             []ItemApproval{},
+		ItemOperatorList:             []ItemOperator{},
+		ClassTraceList:               []ClassTrace{},
+		ItemTokenList:                []ItemToken{},
+		ItemEscrowList:               []ItemEscrow{},
 		GoogleInAppPurchaseOrderList: []GoogleInAppPurchaseOrder{},
 		PendingExecutionList:         []Execution{},
 		ExecutionList:                []Execution{},
@@ -86,7 +116,108 @@ func (gs GenesisState) Validate() error {
 		}
 		tradeIDMap[elem.Id] = true
 	}
-	// Check for duplicated ID in googleIAPOrder
+	// Check for duplicated ID in lending
+	lendingIDMap := make(map[uint64]bool)
+
+	for _, elem := range gs.LendingList {
+		if _, ok := lendingIDMap[elem.Id]; ok {
+			return fmt.Errorf("duplicated id for lending")
+		}
+		lendingIDMap[elem.Id] = true
+	}
+	// Check for duplicated ID in auction
+	auctionIDMap := make(map[uint64]bool)
+
+	for _, elem := range gs.AuctionList {
+		if _, ok := auctionIDMap[elem.Id]; ok {
+			return fmt.Errorf("duplicated id for auction")
+		}
+		auctionIDMap[elem.Id] = true
+	}
+	// Check for duplicated ID in dutch auction
+	dutchAuctionIDMap := make(map[uint64]bool)
+
+	for _, elem := range gs.DutchAuctionList {
+		if _, ok := dutchAuctionIDMap[elem.Id]; ok {
+			return fmt.Errorf("duplicated id for dutch auction")
+		}
+		dutchAuctionIDMap[elem.Id] = true
+	}
+	// Check for duplicated ID in item offer
+	itemOfferIDMap := make(map[uint64]bool)
+
+	for _, elem := range gs.ItemOfferList {
+		if _, ok := itemOfferIDMap[elem.Id]; ok {
+			return fmt.Errorf("duplicated id for item offer")
+		}
+		itemOfferIDMap[elem.Id] = true
+	}
+	// Check for duplicated ID in swap
+	swapIDMap := make(map[uint64]bool)
+
+	for _, elem := range gs.SwapList {
+		if _, ok := swapIDMap[elem.Id]; ok {
+			return fmt.Errorf("duplicated id for swap")
+		}
+		swapIDMap[elem.Id] = true
+	}
+	// Check for duplicated recipe in recipe stats
+	recipeStatsIndexMap := make(map[string]bool)
+
+	for _, elem := range gs.RecipeStatsList {
+		index := elem.CookbookId + "/" + elem.RecipeId
+		if _, ok := recipeStatsIndexMap[index]; ok {
+			return fmt.Errorf("duplicated recipe for recipe stats")
+		}
+		recipeStatsIndexMap[index] = true
+	}
+	// Check for duplicated cookbook in cookbook stats
+	cookbookStatsIndexMap := make(map[string]bool)
+
+	for _, elem := range gs.CookbookStatsList {
+		if _, ok := cookbookStatsIndexMap[elem.CookbookId]; ok {
+			return fmt.Errorf("duplicated cookbook for cookbook stats")
+		}
+		cookbookStatsIndexMap[elem.CookbookId] = true
+	}
+	// Check for duplicated recipe and invalid addresses in recipe executors
+	recipeExecutorsIndexMap := make(map[string]bool)
+
+	for _, elem := range gs.RecipeExecutorsList {
+		index := elem.CookbookId + "/" + elem.RecipeId
+		if _, ok := recipeExecutorsIndexMap[index]; ok {
+			return fmt.Errorf("duplicated recipe for recipe executors")
+		}
+		recipeExecutorsIndexMap[index] = true
+		for _, executor := range elem.Executors {
+			if _, err := sdk.AccAddressFromBech32(executor); err != nil {
+				return fmt.Errorf("invalid recipe executor address %s: %w", executor, err)
+			}
+		}
+	}
+	// Check for duplicated cookbook and invalid addresses in cookbook executors
+	cookbookExecutorsIndexMap := make(map[string]bool)
+
+	for _, elem := range gs.CookbookExecutorsList {
+		if _, ok := cookbookExecutorsIndexMap[elem.CookbookId]; ok {
+			return fmt.Errorf("duplicated cookbook for cookbook executors")
+		}
+		cookbookExecutorsIndexMap[elem.CookbookId] = true
+		for _, executor := range elem.Executors {
+			if _, err := sdk.AccAddressFromBech32(executor); err != nil {
+				return fmt.Errorf("invalid cookbook executor address %s: %w", executor, err)
+			}
+		}
+	}
+	// Check for duplicated cookbook in class trace
+	classTraceIndexMap := make(map[string]bool)
+
+	for _, elem := range gs.ClassTraceList {
+		if _, ok := classTraceIndexMap[elem.CookbookId]; ok {
+			return fmt.Errorf("duplicated cookbook for class trace")
+		}
+		classTraceIndexMap[elem.CookbookId] = true
+	}
 	googleIAPOrderIDMap := make(map[string]bool)
 
 	for _, elem := range gs.GoogleInAppPurchaseOrderList {
@@ -26,6 +26,25 @@ const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 // GenesisState defines the pylons module's genesis state.
 type GenesisState struct {
 	// this line is used by starport scaffolding # genesis/proto/state
+	CookbookExecutorsList        []CookbookExecutors        `protobuf:"bytes,35,rep,name=cookbook_executors_list,json=cookbookExecutorsList,proto3" json:"cookbook_executors_list"`
+	RecipeExecutorsList          []RecipeExecutors          `protobuf:"bytes,34,rep,name=recipe_executors_list,json=recipeExecutorsList,proto3" json:"recipe_executors_list"`
+	CookbookStatsList            []CookbookStats            `protobuf:"bytes,33,rep,name=cookbook_stats_list,json=cookbookStatsList,proto3" json:"cookbook_stats_list"`
+	RecipeStatsList              []RecipeStats              `protobuf:"bytes,32,rep,name=recipe_stats_list,json=recipeStatsList,proto3" json:"recipe_stats_list"`
+	SwapCount                    uint64                     `protobuf:"varint,31,opt,name=swap_count,json=swapCount,proto3" json:"swap_count,omitempty"`
+	SwapList                     []Swap                     `protobuf:"bytes,30,rep,name=swap_list,json=swapList,proto3" json:"swap_list"`
+	ItemOfferCount               uint64                     `protobuf:"varint,29,opt,name=item_offer_count,json=itemOfferCount,proto3" json:"item_offer_count,omitempty"`
+	ItemOfferList                []ItemOffer                `protobuf:"bytes,28,rep,name=item_offer_list,json=itemOfferList,proto3" json:"item_offer_list"`
+	DutchAuctionCount            uint64                     `protobuf:"varint,27,opt,name=dutch_auction_count,json=dutchAuctionCount,proto3" json:"dutch_auction_count,omitempty"`
+	DutchAuctionList             []DutchAuction             `protobuf:"bytes,26,rep,name=dutch_auction_list,json=dutchAuctionList,proto3" json:"dutch_auction_list"`
+	AuctionCount                 uint64                     `protobuf:"varint,25,opt,name=auction_count,json=auctionCount,proto3" json:"auction_count,omitempty"`
+	AuctionList                  []Auction                  `protobuf:"bytes,24,rep,name=auction_list,json=auctionList,proto3" json:"auction_list"`
+	ItemEscrowList               []ItemEscrow               `protobuf:"bytes,23,rep,name=item_escrow_list,json=itemEscrowList,proto3" json:"item_escrow_list"`
+	ItemTokenList                []ItemToken                `protobuf:"bytes,22,rep,name=item_token_list,json=itemTokenList,proto3" json:"item_token_list"`
+	ClassTraceList               []ClassTrace               `protobuf:"bytes,21,rep,name=class_trace_list,json=classTraceList,proto3" json:"class_trace_list"`
+	ItemOperatorList             []ItemOperator             `protobuf:"bytes,20,rep,name=item_operator_list,json=itemOperatorList,proto3" json:"item_operator_list"`
+	ItemApprovalList             []ItemApproval             `protobuf:"bytes,19,rep,name=item_approval_list,json=itemApprovalList,proto3" json:"item_approval_list"`
+	LendingCount                 uint64                     `protobuf:"varint,18,opt,name=lending_count,json=lendingCount,proto3" json:"lending_count,omitempty"`
+	LendingList                  []Lending                  `protobuf:"bytes,17,rep,name=lending_list,json=lendingList,proto3" json:"lending_list"`
 	RedeemInfoList               []RedeemInfo               `protobuf:"bytes,16,rep,name=redeem_info_list,json=redeemInfoList,proto3" json:"redeem_info_list"`
 	PaymentInfoList              []PaymentInfo              `protobuf:"bytes,15,rep,name=payment_info_list,json=paymentInfoList,proto3" json:"payment_info_list"`
 	AccountList                  []UserMap                  `protobuf:"bytes,14,rep,name=account_list,json=accountList,proto3" json:"account_list"`
@@ -77,6 +96,139 @@ func (m *GenesisState) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_GenesisState proto.InternalMessageInfo
 
+func (m *GenesisState) GetCookbookExecutorsList() []CookbookExecutors {
+	if m != nil {
+		return m.CookbookExecutorsList
+	}
+	return nil
+}
+
+func (m *GenesisState) GetRecipeExecutorsList() []RecipeExecutors {
+	if m != nil {
+		return m.RecipeExecutorsList
+	}
+	return nil
+}
+
+func (m *GenesisState) GetCookbookStatsList() []CookbookStats {
+	if m != nil {
+		return m.CookbookStatsList
+	}
+	return nil
+}
+
+func (m *GenesisState) GetRecipeStatsList() []RecipeStats {
+	if m != nil {
+		return m.RecipeStatsList
+	}
+	return nil
+}
+
+func (m *GenesisState) GetSwapCount() uint64 {
+	if m != nil {
+		return m.SwapCount
+	}
+	return 0
+}
+
+func (m *GenesisState) GetSwapList() []Swap {
+	if m != nil {
+		return m.SwapList
+	}
+	return nil
+}
+
+func (m *GenesisState) GetItemOfferCount() uint64 {
+	if m != nil {
+		return m.ItemOfferCount
+	}
+	return 0
+}
+
+func (m *GenesisState) GetItemOfferList() []ItemOffer {
+	if m != nil {
+		return m.ItemOfferList
+	}
+	return nil
+}
+
+func (m *GenesisState) GetDutchAuctionCount() uint64 {
+	if m != nil {
+		return m.DutchAuctionCount
+	}
+	return 0
+}
+
+func (m *GenesisState) GetDutchAuctionList() []DutchAuction {
+	if m != nil {
+		return m.DutchAuctionList
+	}
+	return nil
+}
+
+func (m *GenesisState) GetAuctionCount() uint64 {
+	if m != nil {
+		return m.AuctionCount
+	}
+	return 0
+}
+
+func (m *GenesisState) GetAuctionList() []Auction {
+	if m != nil {
+		return m.AuctionList
+	}
+	return nil
+}
+
+func (m *GenesisState) GetItemEscrowList() []ItemEscrow {
+	if m != nil {
+		return m.ItemEscrowList
+	}
+	return nil
+}
+
+func (m *GenesisState) GetItemTokenList() []ItemToken {
+	if m != nil {
+		return m.ItemTokenList
+	}
+	return nil
+}
+
+func (m *GenesisState) GetClassTraceList() []ClassTrace {
+	if m != nil {
+		return m.ClassTraceList
+	}
+	return nil
+}
+
+func (m *GenesisState) GetItemOperatorList() []ItemOperator {
+	if m != nil {
+		return m.ItemOperatorList
+	}
+	return nil
+}
+
+func (m *GenesisState) GetItemApprovalList() []ItemApproval {
+	if m != nil {
+		return m.ItemApprovalList
+	}
+	return nil
+}
+
+func (m *GenesisState) GetLendingCount() uint64 {
+	if m != nil {
+		return m.LendingCount
+	}
+	return 0
+}
+
+func (m *GenesisState) GetLendingList() []Lending {
+	if m != nil {
+		return m.LendingList
+	}
+	return nil
+}
+
 func (m *GenesisState) GetRedeemInfoList() []RedeemInfo {
 	if m != nil {
 		return m.RedeemInfoList
@@ -196,47 +348,71 @@ func init() {
 func init() { proto.RegisterFile("pylons/pylons/genesis.proto", fileDescriptor_f41fd395b1a4953e) }
 
 var fileDescriptor_f41fd395b1a4953e = []byte{
-	// 630 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x94, 0x4f, 0x6f, 0xda, 0x4c,
-	0x10, 0xc6, 0xf1, 0x9b, 0xbc, 0x69, 0xb2, 0x86, 0x24, 0x25, 0x40, 0x08, 0x4d, 0x0d, 0xa9, 0x2a,
-	0x85, 0x43, 0x0b, 0x52, 0x90, 0x22, 0x55, 0xaa, 0x54, 0x95, 0x88, 0x46, 0x48, 0xa9, 0x8a, 0x68,
-	0x7a, 0xe9, 0xc5, 0x32, 0x66, 0x63, 0xac, 0xe0, 0xdd, 0x95, 0xbd, 0x54, 0xe1, 0xd8, 0x6f, 0xd0,
-	0x8f, 0x95, 0x63, 0x8e, 0x3d, 0x55, 0x15, 0x7c, 0x91, 0xca, 0x33, 0x6b, 0xc0, 0x4b, 0x2a, 0xf5,
-	0x64, 0x6b, 0xe6, 0xf9, 0x3d, 0x33, 0xb3, 0xff, 0xc8, 0x33, 0x31, 0x1d, 0x73, 0x16, 0x35, 0xd5,
-	0xc7, 0xa3, 0x8c, 0x46, 0x7e, 0xd4, 0x10, 0x21, 0x97, 0x3c, 0x9f, 0xc3, 0x68, 0x03, 0x3f, 0x95,
-	0x6a, 0x5a, 0x1b, 0xd2, 0x21, 0xa5, 0x81, 0xed, 0xb3, 0x1b, 0x8e, 0xfa, 0x4a, 0x2d, 0x2d, 0x10,
-	0xce, 0x34, 0xa0, 0x4c, 0xae, 0x2a, 0x8e, 0xd3, 0x0a, 0xc7, 0x75, 0xf9, 0x84, 0x49, 0x55, 0xaf,
-	0x72, 0x94, 0xce, 0xca, 0xd0, 0x19, 0x52, 0x95, 0x7a, 0xa9, 0xf5, 0xc9, 0xb9, 0x37, 0xa6, 0xb6,
-	0xef, 0x08, 0x9b, 0x87, 0x43, 0x1a, 0x2a, 0xd5, 0xf3, 0xb4, 0x8a, 0xde, 0x51, 0x77, 0x22, 0x7d,
-	0xce, 0x54, 0xba, 0x9c, 0x4e, 0xfb, 0x92, 0x06, 0x2a, 0x53, 0xd1, 0x47, 0x73, 0x7d, 0x41, 0x1f,
-	0xef, 0xd9, 0xe5, 0xfc, 0x76, 0xc0, 0xf9, 0xed, 0xe3, 0xa4, 0x70, 0x42, 0x27, 0x48, 0xe6, 0x29,
-	0x78, 0xdc, 0xe3, 0xf0, 0xdb, 0x8c, 0xff, 0x30, 0xfa, 0xe2, 0xfb, 0x36, 0xc9, 0x5e, 0xe2, 0x3a,
-	0x7f, 0x96, 0x8e, 0xa4, 0xf9, 0x2e, 0xd9, 0x5f, 0x59, 0x4b, 0x7b, 0xec, 0x47, 0xb2, 0xbc, 0x5f,
-	0xdb, 0xa8, 0x9b, 0x67, 0x47, 0x8d, 0xd4, 0x0e, 0x34, 0xfa, 0x20, 0xeb, 0xb2, 0x1b, 0xde, 0xde,
-	0xbc, 0xff, 0x55, 0xcd, 0xf4, 0x77, 0xc3, 0x45, 0xe4, 0xca, 0x8f, 0x64, 0xfe, 0x8a, 0x3c, 0x5d,
-	0x5d, 0x75, 0xf4, 0xda, 0x03, 0xaf, 0x8a, 0xe6, 0xd5, 0x43, 0xdd, 0x8a, 0xd9, 0x9e, 0x58, 0x86,
-	0xc0, 0xed, 0x1d, 0xc9, 0xaa, 0x1d, 0x42, 0xa3, 0x5d, 0x30, 0x2a, 0x69, 0x46, 0x5f, 0x22, 0x1a,
-	0x7e, 0x74, 0x84, 0x32, 0x31, 0x15, 0x01, 0x06, 0x6f, 0x08, 0x81, 0x4d, 0x44, 0x3c, 0x07, 0x78,
-	0x41, 0xc3, 0xaf, 0x63, 0x81, 0x82, 0x77, 0x40, 0x0d, 0x68, 0x95, 0x98, 0x88, 0x82, 0x5b, 0x39,
-	0x5b, 0x33, 0xea, 0x9b, 0x7d, 0x74, 0xbb, 0x88, 0x23, 0xf9, 0x13, 0x92, 0xa5, 0x4c, 0xfa, 0x72,
-	0xaa, 0x14, 0x26, 0x28, 0x4c, 0x8c, 0xa1, 0xa4, 0x45, 0xb6, 0x70, 0x3f, 0xca, 0xa4, 0x66, 0xd4,
-	0xcd, 0xb3, 0xe2, 0xda, 0x12, 0xc4, 0x49, 0x55, 0x5b, 0x49, 0xf3, 0xdf, 0xc8, 0x49, 0x72, 0xba,
-	0x98, 0xed, 0x08, 0x61, 0x8b, 0x49, 0xe8, 0x8e, 0x9c, 0x88, 0xe2, 0x49, 0xc3, 0x51, 0xb6, 0x61,
-	0x94, 0x53, 0xcd, 0xef, 0x12, 0xb8, 0x2e, 0x7b, 0x2f, 0x44, 0x4f, 0x41, 0x9f, 0x62, 0x46, 0x55,
-	0x38, 0xf6, 0xfe, 0x92, 0x87, 0x81, 0x5b, 0xa4, 0xa4, 0x9f, 0x6a, 0x35, 0xd9, 0x0e, 0x4c, 0x76,
-	0xa0, 0x68, 0x47, 0x00, 0x83, 0x13, 0x76, 0xc8, 0xee, 0xe2, 0x90, 0x63, 0x67, 0x4f, 0xa0, 0xb3,
-	0xb2, 0xd6, 0x59, 0x27, 0x11, 0xa9, 0x56, 0x72, 0x0b, 0x0a, 0x6a, 0x9f, 0x92, 0xbd, 0xa5, 0x0d,
-	0x16, 0xdd, 0x82, 0xa2, 0x4b, 0x77, 0xac, 0x77, 0x4d, 0x4a, 0x82, 0xb2, 0xa1, 0xcf, 0x3c, 0x5b,
-	0xab, 0xfb, 0xff, 0x3f, 0xd5, 0x2d, 0x28, 0xba, 0x93, 0x2a, 0x7f, 0x4e, 0x0e, 0xd7, 0x5d, 0xb1,
-	0x8d, 0x4d, 0x68, 0xa3, 0xa8, 0x63, 0xd8, 0xcd, 0x39, 0xd9, 0x89, 0xef, 0x30, 0x36, 0xb0, 0x01,
-	0x0d, 0x1c, 0x68, 0x0d, 0x74, 0x25, 0x0d, 0x54, 0xed, 0xed, 0x58, 0x0b, 0xf5, 0xde, 0x12, 0x13,
-	0x6f, 0x38, 0x92, 0xff, 0x01, 0x59, 0x5c, 0xbb, 0x6b, 0xb1, 0x42, 0xb1, 0x04, 0xf5, 0x40, 0xb7,
-	0x49, 0x2e, 0x79, 0x03, 0x90, 0x37, 0x80, 0x3f, 0xd4, 0xf8, 0x0b, 0xa5, 0x51, 0x0e, 0xd9, 0x84,
-	0x89, 0x3d, 0xda, 0x1f, 0xee, 0x67, 0x96, 0xf1, 0x30, 0xb3, 0x8c, 0xdf, 0x33, 0xcb, 0xf8, 0x31,
-	0xb7, 0x32, 0x0f, 0x73, 0x2b, 0xf3, 0x73, 0x6e, 0x65, 0xbe, 0xbe, 0xf2, 0x7c, 0x39, 0x9a, 0x0c,
-	0x1a, 0x2e, 0x0f, 0x9a, 0x3d, 0x70, 0x7a, 0x2d, 0xa9, 0x3b, 0x4a, 0xde, 0x97, 0xbb, 0xc5, 0xe3,
-	0x38, 0x15, 0x34, 0x1a, 0x6c, 0xc1, 0x93, 0xd2, 0xfa, 0x13, 0x00, 0x00, 0xff, 0xff, 0x48, 0x5b,
-	0xff, 0x37, 0xc7, 0x05, 0x00, 0x00,
+	// 1021 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x56, 0x5f, 0x4f, 0x1b, 0x47,
+	0x10, 0xc7, 0x85, 0xd2, 0xb0, 0x36, 0x18, 0xdb, 0x18, 0x8c, 0x81, 0xc3, 0x24, 0x95, 0xc2, 0x43,
+	0x6b, 0xa4, 0x20, 0x45, 0xaa, 0x54, 0xa9, 0x02, 0xea, 0x44, 0x48, 0x54, 0x20, 0x87, 0x4a, 0x55,
+	0x1f, 0x7a, 0x5a, 0xce, 0x6b, 0x73, 0xc2, 0xbe, 0x5d, 0xdd, 0xad, 0x43, 0xf8, 0x16, 0xfd, 0x58,
+	0x79, 0xcc, 0x63, 0x9f, 0xaa, 0x0a, 0x3e, 0x42, 0xbf, 0x40, 0xb5, 0x33, 0xb3, 0xe7, 0xbb, 0x63,
+	0x2d, 0xf5, 0x89, 0x63, 0xe6, 0xf7, 0x67, 0x76, 0xf6, 0xcf, 0x98, 0xed, 0xa8, 0x87, 0xb1, 0x8c,
+	0x92, 0x23, 0xfa, 0x33, 0x12, 0x91, 0x48, 0xc2, 0xa4, 0xab, 0x62, 0xa9, 0x65, 0x7d, 0x15, 0xa3,
+	0x5d, 0xfc, 0xd3, 0xde, 0xcf, 0x63, 0x63, 0x31, 0x10, 0x62, 0xe2, 0x87, 0xd1, 0x50, 0x22, 0xbe,
+	0xdd, 0xc9, 0x03, 0x14, 0x7f, 0x98, 0x88, 0x48, 0x67, 0x11, 0xbb, 0x79, 0x04, 0x0f, 0x02, 0x39,
+	0x8d, 0x34, 0xf9, 0xb5, 0xb7, 0xf3, 0x59, 0x1d, 0xf3, 0x81, 0xa0, 0x54, 0xa1, 0xce, 0xb1, 0x88,
+	0x06, 0x61, 0x34, 0x72, 0x27, 0xf9, 0x34, 0xd0, 0xa1, 0x8c, 0x28, 0xe9, 0xe5, 0x93, 0xa1, 0x16,
+	0x13, 0x5f, 0x0e, 0x87, 0x22, 0xa6, 0x7c, 0x2b, 0x9f, 0x4f, 0xee, 0xb9, 0x72, 0x97, 0x93, 0x68,
+	0x9e, 0x56, 0x7a, 0xe0, 0x10, 0xe5, 0x4a, 0xc5, 0xf2, 0x23, 0x1f, 0xbb, 0x9b, 0x11, 0x0d, 0xb5,
+	0xaf, 0x63, 0x1e, 0x25, 0x33, 0xe7, 0x6f, 0x0b, 0xbd, 0x97, 0x72, 0x34, 0x16, 0x7e, 0xc8, 0x95,
+	0x2f, 0xe3, 0x41, 0x8a, 0xda, 0xcb, 0xa3, 0xc4, 0x27, 0x11, 0x4c, 0x33, 0xcb, 0x6b, 0x3d, 0xaf,
+	0x84, 0x32, 0xed, 0xe2, 0x76, 0x05, 0xa1, 0x12, 0xee, 0x7d, 0x08, 0xa4, 0xbc, 0xbb, 0x91, 0xf2,
+	0xce, 0xcd, 0x54, 0x3c, 0xe6, 0x13, 0xbb, 0xf2, 0x8d, 0x91, 0x1c, 0x49, 0xf8, 0x3c, 0x32, 0x5f,
+	0x18, 0x7d, 0xf9, 0x6f, 0x8d, 0x55, 0xde, 0xe3, 0xd9, 0xf9, 0xa0, 0xb9, 0x16, 0xf5, 0x3f, 0xd8,
+	0x96, 0x15, 0xf5, 0xb1, 0x64, 0x19, 0x27, 0xfe, 0x38, 0x4c, 0x74, 0xeb, 0x55, 0x67, 0xf1, 0xb0,
+	0xfc, 0xa6, 0xd3, 0xcd, 0x1d, 0xae, 0xee, 0x19, 0xa1, 0x7b, 0x16, 0x7c, 0xba, 0xf4, 0xf9, 0xef,
+	0xfd, 0x85, 0x7e, 0x33, 0x28, 0x26, 0x2e, 0xc2, 0x44, 0xd7, 0x7f, 0x63, 0x4d, 0x5c, 0x50, 0x51,
+	0xfd, 0x25, 0xa8, 0x7b, 0x05, 0xf5, 0x3e, 0x60, 0x8b, 0xda, 0x8d, 0x38, 0x1f, 0x06, 0xe5, 0x3e,
+	0x6b, 0xa4, 0x95, 0xc3, 0x96, 0xa3, 0xee, 0x01, 0xe8, 0xee, 0xce, 0xa9, 0xda, 0x2c, 0xda, 0xaa,
+	0xd6, 0x82, 0x6c, 0x10, 0x34, 0x2f, 0x58, 0x8d, 0xaa, 0xcd, 0x28, 0x76, 0x40, 0xb1, 0xed, 0xac,
+	0x34, 0xab, 0x57, 0x8d, 0x67, 0x21, 0x50, 0xdb, 0x63, 0xcc, 0x9c, 0x52, 0x1f, 0xee, 0x4e, 0x6b,
+	0xbf, 0x53, 0x3a, 0x5c, 0xea, 0xaf, 0x98, 0xc8, 0x99, 0x09, 0xd4, 0xdf, 0x32, 0xf8, 0x07, 0x4d,
+	0x3c, 0x30, 0x69, 0x14, 0x4c, 0x3e, 0xdc, 0x73, 0x45, 0xea, 0x2f, 0x0c, 0x16, 0x64, 0x0f, 0xd9,
+	0xfa, 0xec, 0x72, 0x90, 0xf8, 0x1e, 0x88, 0xaf, 0x99, 0xf8, 0xa5, 0x09, 0xa3, 0xc3, 0x3b, 0x56,
+	0xcd, 0x20, 0xc1, 0x67, 0x17, 0x7c, 0x5a, 0x05, 0x9f, 0x73, 0xcb, 0x23, 0xb3, 0xd5, 0x54, 0x08,
+	0x1c, 0xbb, 0xac, 0x31, 0x98, 0xea, 0xe0, 0xd6, 0xa7, 0x1b, 0x4b, 0xa6, 0x3b, 0x60, 0x5a, 0x83,
+	0xd4, 0x09, 0x66, 0xd0, 0xf7, 0x92, 0xd5, 0xf3, 0x78, 0xb0, 0x6e, 0x83, 0xf5, 0x4e, 0xc1, 0xfa,
+	0xe7, 0x0c, 0x9b, 0xdc, 0xd7, 0xb3, 0x8a, 0x50, 0xc0, 0x2b, 0xb6, 0x9a, 0xb7, 0xde, 0x06, 0xeb,
+	0x0a, 0xcf, 0xba, 0xfe, 0xc4, 0x2a, 0x39, 0xbf, 0x16, 0xf8, 0x6d, 0x16, 0xfc, 0xf2, 0x56, 0x65,
+	0x9e, 0x71, 0x39, 0xa7, 0xc6, 0x8a, 0x24, 0x88, 0xe5, 0x3d, 0x8a, 0x6c, 0x81, 0xc8, 0xb6, 0xa3,
+	0x5f, 0x3d, 0x40, 0x91, 0xce, 0x5a, 0x98, 0x46, 0x40, 0xca, 0x76, 0x5e, 0xcb, 0x3b, 0x41, 0xe5,
+	0x6c, 0xce, 0xed, 0xfc, 0xb5, 0x01, 0x65, 0x3b, 0x0f, 0x01, 0x5b, 0x52, 0x30, 0xe6, 0x49, 0x62,
+	0x9e, 0xa4, 0x40, 0xa0, 0x50, 0xd3, 0x59, 0xd2, 0x99, 0x81, 0x5d, 0x1b, 0x94, 0x2d, 0x29, 0x48,
+	0x23, 0x20, 0x75, 0xc9, 0xea, 0x78, 0x18, 0x94, 0x88, 0xb9, 0x96, 0x74, 0x1e, 0x36, 0x9c, 0x9b,
+	0x02, 0xe7, 0x81, 0x70, 0x76, 0x53, 0xc2, 0x4c, 0x2c, 0x27, 0x68, 0xdf, 0x53, 0x14, 0x6c, 0xcc,
+	0x15, 0x3c, 0x21, 0x5c, 0x56, 0xd0, 0xc6, 0xec, 0x2e, 0xd3, 0xbc, 0xa0, 0x5d, 0xae, 0xe3, 0x2e,
+	0x53, 0x30, 0xdd, 0x65, 0x0b, 0x02, 0xbf, 0x9a, 0x73, 0x97, 0x2f, 0x10, 0x62, 0x77, 0x99, 0x18,
+	0xb6, 0xa5, 0x99, 0x89, 0x88, 0x22, 0xeb, 0xce, 0x96, 0xf6, 0x01, 0x76, 0x1e, 0x0d, 0xa5, 0x6d,
+	0x69, 0x9c, 0x46, 0xec, 0x73, 0x91, 0x9d, 0x9d, 0xa8, 0x55, 0x75, 0x3e, 0x17, 0x57, 0x88, 0xcb,
+	0x88, 0x55, 0xd5, 0x2c, 0x04, 0x6a, 0xe6, 0xfc, 0xe2, 0x9c, 0x45, 0xa1, 0x35, 0xe7, 0xca, 0x7e,
+	0x4d, 0x44, 0xfc, 0x4b, 0xfa, 0x2a, 0x94, 0x89, 0x01, 0x02, 0x3f, 0x30, 0x06, 0xa3, 0x18, 0xe9,
+	0xab, 0x40, 0xdf, 0x28, 0xd0, 0xaf, 0x0d, 0x80, 0xc8, 0x2b, 0x80, 0x06, 0xea, 0x3e, 0x2b, 0x23,
+	0x15, 0x1b, 0x5f, 0x81, 0xc6, 0xa3, 0x1a, 0xb6, 0xfd, 0x80, 0x55, 0x44, 0xa4, 0x43, 0xfd, 0x40,
+	0x88, 0x32, 0x20, 0xca, 0x18, 0x43, 0xc8, 0x31, 0x5b, 0xc6, 0x09, 0xd4, 0x62, 0x9d, 0xd2, 0x61,
+	0xf9, 0x4d, 0xf3, 0x59, 0x0b, 0x4c, 0x92, 0xbc, 0x09, 0x5a, 0xff, 0xc8, 0x0e, 0xec, 0x3c, 0x8d,
+	0xcc, 0x49, 0xf2, 0xd5, 0x34, 0x0e, 0x6e, 0x79, 0x22, 0x70, 0xb6, 0xe2, 0x52, 0x5e, 0xc0, 0x52,
+	0x5e, 0x17, 0xf4, 0xde, 0x03, 0xef, 0x3c, 0x3a, 0x51, 0xea, 0x8a, 0x48, 0x97, 0x86, 0x43, 0x0e,
+	0xbb, 0xa3, 0x39, 0x79, 0x58, 0xf0, 0x31, 0xdb, 0x2c, 0xce, 0x71, 0x5a, 0xd9, 0x0a, 0xac, 0xac,
+	0x41, 0x6c, 0xae, 0x80, 0x83, 0x2b, 0xec, 0xb1, 0xb5, 0x74, 0xac, 0x63, 0x65, 0xdf, 0x38, 0x2f,
+	0x75, 0xcf, 0x82, 0xec, 0xa5, 0x4e, 0x59, 0xe0, 0xfd, 0x9a, 0x55, 0x67, 0x32, 0x68, 0xba, 0x8c,
+	0xef, 0x77, 0x1a, 0x46, 0xbf, 0x6b, 0xb6, 0xa9, 0xe8, 0xac, 0x17, 0x7c, 0xbf, 0xfe, 0x5f, 0xbe,
+	0x1b, 0xc4, 0xee, 0xe5, 0xec, 0xdf, 0xb2, 0xad, 0xe7, 0xaa, 0x58, 0xc6, 0x12, 0x94, 0xd1, 0x2c,
+	0xd2, 0xd2, 0x79, 0x05, 0xf7, 0x1d, 0x0a, 0x58, 0x74, 0xce, 0x2b, 0x73, 0xcd, 0xed, 0xbc, 0x32,
+	0x58, 0xf0, 0xfb, 0x91, 0x95, 0x69, 0xa8, 0x02, 0xf3, 0xab, 0xce, 0xa2, 0xe3, 0x70, 0xe0, 0x38,
+	0x25, 0x2e, 0x43, 0x3c, 0xb0, 0x4f, 0xd9, 0x6a, 0x3a, 0xe6, 0x81, 0x5f, 0x02, 0xfe, 0xd6, 0x9c,
+	0x01, 0x4f, 0x0a, 0x15, 0xcb, 0x31, 0x1a, 0xa7, 0xef, 0x3e, 0x3f, 0x7a, 0xa5, 0x2f, 0x8f, 0x5e,
+	0xe9, 0x9f, 0x47, 0xaf, 0xf4, 0xe7, 0x93, 0xb7, 0xf0, 0xe5, 0xc9, 0x5b, 0xf8, 0xeb, 0xc9, 0x5b,
+	0xf8, 0xfd, 0xbb, 0x51, 0xa8, 0x6f, 0xa7, 0x37, 0xdd, 0x40, 0x4e, 0x8e, 0xae, 0x40, 0xe9, 0x7b,
+	0x2d, 0x82, 0x5b, 0xfb, 0x8b, 0xea, 0x93, 0xfd, 0xd0, 0x0f, 0x4a, 0x24, 0x37, 0xcb, 0xf0, 0x23,
+	0xea, 0xf8, 0xbf, 0x01, 0x00, 0xf1, 0x2c, 0x02, 0x49, 0x8d, 0x0b, 0x00, 0x00,
 }
 
 func (m *GenesisState) Marshal() (dAtA []byte, err error) {
@@ -259,10 +435,10 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
-	if len(m.RedeemInfoList) > 0 {
-		for iNdEx := len(m.RedeemInfoList) - 1; iNdEx >= 0; iNdEx-- {
+	if len(m.CookbookExecutorsList) > 0 {
+		for iNdEx := len(m.CookbookExecutorsList) - 1; iNdEx >= 0; iNdEx-- {
 			{
-				size, err := m.RedeemInfoList[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				size, err := m.CookbookExecutorsList[iNdEx].MarshalToSizedBuffer(dAtA[:i])
 				if err != nil {
 					return 0, err
 				}
@@ -270,15 +446,15 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 				i = encodeVarintGenesis(dAtA, i, uint64(size))
 			}
 			i--
-			dAtA[i] = 0x1
+			dAtA[i] = 0x2
 			i--
-			dAtA[i] = 0x82
+			dAtA[i] = 0x9a
 		}
 	}
-	if len(m.PaymentInfoList) > 0 {
-		for iNdEx := len(m.PaymentInfoList) - 1; iNdEx >= 0; iNdEx-- {
+	if len(m.RecipeExecutorsList) > 0 {
+		for iNdEx := len(m.RecipeExecutorsList) - 1; iNdEx >= 0; iNdEx-- {
 			{
-				size, err := m.PaymentInfoList[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				size, err := m.RecipeExecutorsList[iNdEx].MarshalToSizedBuffer(dAtA[:i])
 				if err != nil {
 					return 0, err
 				}
@@ -286,13 +462,15 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 				i = encodeVarintGenesis(dAtA, i, uint64(size))
 			}
 			i--
-			dAtA[i] = 0x7a
+			dAtA[i] = 0x2
+			i--
+			dAtA[i] = 0x92
 		}
 	}
-	if len(m.AccountList) > 0 {
-		for iNdEx := len(m.AccountList) - 1; iNdEx >= 0; iNdEx-- {
+	if len(m.CookbookStatsList) > 0 {
+		for iNdEx := len(m.CookbookStatsList) - 1; iNdEx >= 0; iNdEx-- {
 			{
-				size, err := m.AccountList[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				size, err := m.CookbookStatsList[iNdEx].MarshalToSizedBuffer(dAtA[:i])
 				if err != nil {
 					return 0, err
 				}
@@ -300,13 +478,15 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 				i = encodeVarintGenesis(dAtA, i, uint64(size))
 			}
 			i--
-			dAtA[i] = 0x72
+			dAtA[i] = 0x2
+			i--
+			dAtA[i] = 0x8a
 		}
 	}
-	if len(m.TradeList) > 0 {
-		for iNdEx := len(m.TradeList) - 1; iNdEx >= 0; iNdEx-- {
+	if len(m.RecipeStatsList) > 0 {
+		for iNdEx := len(m.RecipeStatsList) - 1; iNdEx >= 0; iNdEx-- {
 			{
-				size, err := m.TradeList[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				size, err := m.RecipeStatsList[iNdEx].MarshalToSizedBuffer(dAtA[:i])
 				if err != nil {
 					return 0, err
 				}
@@ -314,38 +494,22 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 				i = encodeVarintGenesis(dAtA, i, uint64(size))
 			}
 			i--
-			dAtA[i] = 0x6a
+			dAtA[i] = 0x2
+			i--
+			dAtA[i] = 0x82
 		}
 	}
-	if m.TradeCount != 0 {
-		i = encodeVarintGenesis(dAtA, i, uint64(m.TradeCount))
-		i--
-		dAtA[i] = 0x60
-	}
-	if m.EntityCount != 0 {
-		i = encodeVarintGenesis(dAtA, i, uint64(m.EntityCount))
+	if m.SwapCount != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.SwapCount))
 		i--
-		dAtA[i] = 0x58
-	}
-	{
-		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
-		if err != nil {
-			return 0, err
-		}
-		i -= size
-		i = encodeVarintGenesis(dAtA, i, uint64(size))
-	}
-	i--
-	dAtA[i] = 0x52
-	if m.GoogleIapOrderCount != 0 {
-		i = encodeVarintGenesis(dAtA, i, uint64(m.GoogleIapOrderCount))
+		dAtA[i] = 0x1
 		i--
-		dAtA[i] = 0x48
+		dAtA[i] = 0xf8
 	}
-	if len(m.GoogleInAppPurchaseOrderList) > 0 {
-		for iNdEx := len(m.GoogleInAppPurchaseOrderList) - 1; iNdEx >= 0; iNdEx-- {
+	if len(m.SwapList) > 0 {
+		for iNdEx := len(m.SwapList) - 1; iNdEx >= 0; iNdEx-- {
 			{
-				size, err := m.GoogleInAppPurchaseOrderList[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				size, err := m.SwapList[iNdEx].MarshalToSizedBuffer(dAtA[:i])
 				if err != nil {
 					return 0, err
 				}
@@ -353,13 +517,22 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 				i = encodeVarintGenesis(dAtA, i, uint64(size))
 			}
 			i--
-			dAtA[i] = 0x42
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0xf2
 		}
 	}
-	if len(m.ExecutionList) > 0 {
-		for iNdEx := len(m.ExecutionList) - 1; iNdEx >= 0; iNdEx-- {
+	if m.ItemOfferCount != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.ItemOfferCount))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xe8
+	}
+	if len(m.ItemOfferList) > 0 {
+		for iNdEx := len(m.ItemOfferList) - 1; iNdEx >= 0; iNdEx-- {
 			{
-				size, err := m.ExecutionList[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				size, err := m.ItemOfferList[iNdEx].MarshalToSizedBuffer(dAtA[:i])
 				if err != nil {
 					return 0, err
 				}
@@ -367,18 +540,22 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 				i = encodeVarintGenesis(dAtA, i, uint64(size))
 			}
 			i--
-			dAtA[i] = 0x3a
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0xe2
 		}
 	}
-	if m.ExecutionCount != 0 {
-		i = encodeVarintGenesis(dAtA, i, uint64(m.ExecutionCount))
+	if m.DutchAuctionCount != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.DutchAuctionCount))
 		i--
-		dAtA[i] = 0x30
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xd8
 	}
-	if len(m.PendingExecutionList) > 0 {
-		for iNdEx := len(m.PendingExecutionList) - 1; iNdEx >= 0; iNdEx-- {
+	if len(m.DutchAuctionList) > 0 {
+		for iNdEx := len(m.DutchAuctionList) - 1; iNdEx >= 0; iNdEx-- {
 			{
-				size, err := m.PendingExecutionList[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				size, err := m.DutchAuctionList[iNdEx].MarshalToSizedBuffer(dAtA[:i])
 				if err != nil {
 					return 0, err
 				}
@@ -386,18 +563,22 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 				i = encodeVarintGenesis(dAtA, i, uint64(size))
 			}
 			i--
-			dAtA[i] = 0x2a
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0xd2
 		}
 	}
-	if m.PendingExecutionCount != 0 {
-		i = encodeVarintGenesis(dAtA, i, uint64(m.PendingExecutionCount))
+	if m.AuctionCount != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.AuctionCount))
 		i--
-		dAtA[i] = 0x20
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xc8
 	}
-	if len(m.ItemList) > 0 {
-		for iNdEx := len(m.ItemList) - 1; iNdEx >= 0; iNdEx-- {
+	if len(m.AuctionList) > 0 {
+		for iNdEx := len(m.AuctionList) - 1; iNdEx >= 0; iNdEx-- {
 			{
-				size, err := m.ItemList[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				size, err := m.AuctionList[iNdEx].MarshalToSizedBuffer(dAtA[:i])
 				if err != nil {
 					return 0, err
 				}
@@ -405,13 +586,15 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 				i = encodeVarintGenesis(dAtA, i, uint64(size))
 			}
 			i--
-			dAtA[i] = 0x1a
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0xc2
 		}
 	}
-	if len(m.RecipeList) > 0 {
-		for iNdEx := len(m.RecipeList) - 1; iNdEx >= 0; iNdEx-- {
+	if len(m.ItemEscrowList) > 0 {
+		for iNdEx := len(m.ItemEscrowList) - 1; iNdEx >= 0; iNdEx-- {
 			{
-				size, err := m.RecipeList[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				size, err := m.ItemEscrowList[iNdEx].MarshalToSizedBuffer(dAtA[:i])
 				if err != nil {
 					return 0, err
 				}
@@ -419,13 +602,15 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 				i = encodeVarintGenesis(dAtA, i, uint64(size))
 			}
 			i--
-			dAtA[i] = 0x12
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0xba
 		}
 	}
-	if len(m.CookbookList) > 0 {
-		for iNdEx := len(m.CookbookList) - 1; iNdEx >= 0; iNdEx-- {
+	if len(m.ItemTokenList) > 0 {
+		for iNdEx := len(m.ItemTokenList) - 1; iNdEx >= 0; iNdEx-- {
 			{
-				size, err := m.CookbookList[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				size, err := m.ItemTokenList[iNdEx].MarshalToSizedBuffer(dAtA[:i])
 				if err != nil {
 					return 0, err
 				}
@@ -433,55 +618,305 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 				i = encodeVarintGenesis(dAtA, i, uint64(size))
 			}
 			i--
-			dAtA[i] = 0xa
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0xb2
 		}
 	}
-	return len(dAtA) - i, nil
-}
-
-func encodeVarintGenesis(dAtA []byte, offset int, v uint64) int {
-	offset -= sovGenesis(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
-	}
-	dAtA[offset] = uint8(v)
-	return base
-}
-func (m *GenesisState) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if len(m.CookbookList) > 0 {
-		for _, e := range m.CookbookList {
-			l = e.Size()
-			n += 1 + l + sovGenesis(uint64(l))
+	if len(m.ClassTraceList) > 0 {
+		for iNdEx := len(m.ClassTraceList) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ClassTraceList[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0xaa
 		}
 	}
-	if len(m.RecipeList) > 0 {
-		for _, e := range m.RecipeList {
-			l = e.Size()
-			n += 1 + l + sovGenesis(uint64(l))
+	if len(m.ItemOperatorList) > 0 {
+		for iNdEx := len(m.ItemOperatorList) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ItemOperatorList[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0xa2
 		}
 	}
-	if len(m.ItemList) > 0 {
-		for _, e := range m.ItemList {
-			l = e.Size()
-			n += 1 + l + sovGenesis(uint64(l))
+	if len(m.ItemApprovalList) > 0 {
+		for iNdEx := len(m.ItemApprovalList) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ItemApprovalList[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0x9a
 		}
 	}
-	if m.PendingExecutionCount != 0 {
-		n += 1 + sovGenesis(uint64(m.PendingExecutionCount))
+	if m.LendingCount != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.LendingCount))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x90
 	}
-	if len(m.PendingExecutionList) > 0 {
-		for _, e := range m.PendingExecutionList {
-			l = e.Size()
-			n += 1 + l + sovGenesis(uint64(l))
-		}
+	if len(m.LendingList) > 0 {
+		for iNdEx := len(m.LendingList) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.LendingList[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0x8a
+		}
+	}
+	if len(m.RedeemInfoList) > 0 {
+		for iNdEx := len(m.RedeemInfoList) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.RedeemInfoList[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0x82
+		}
+	}
+	if len(m.PaymentInfoList) > 0 {
+		for iNdEx := len(m.PaymentInfoList) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.PaymentInfoList[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x7a
+		}
+	}
+	if len(m.AccountList) > 0 {
+		for iNdEx := len(m.AccountList) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.AccountList[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x72
+		}
+	}
+	if len(m.TradeList) > 0 {
+		for iNdEx := len(m.TradeList) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.TradeList[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x6a
+		}
+	}
+	if m.TradeCount != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.TradeCount))
+		i--
+		dAtA[i] = 0x60
+	}
+	if m.EntityCount != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.EntityCount))
+		i--
+		dAtA[i] = 0x58
+	}
+	{
+		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintGenesis(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x52
+	if m.GoogleIapOrderCount != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.GoogleIapOrderCount))
+		i--
+		dAtA[i] = 0x48
+	}
+	if len(m.GoogleInAppPurchaseOrderList) > 0 {
+		for iNdEx := len(m.GoogleInAppPurchaseOrderList) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.GoogleInAppPurchaseOrderList[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x42
+		}
+	}
+	if len(m.ExecutionList) > 0 {
+		for iNdEx := len(m.ExecutionList) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ExecutionList[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x3a
+		}
+	}
+	if m.ExecutionCount != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.ExecutionCount))
+		i--
+		dAtA[i] = 0x30
+	}
+	if len(m.PendingExecutionList) > 0 {
+		for iNdEx := len(m.PendingExecutionList) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.PendingExecutionList[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
+	if m.PendingExecutionCount != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.PendingExecutionCount))
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.ItemList) > 0 {
+		for iNdEx := len(m.ItemList) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ItemList[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.RecipeList) > 0 {
+		for iNdEx := len(m.RecipeList) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.RecipeList[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.CookbookList) > 0 {
+		for iNdEx := len(m.CookbookList) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.CookbookList[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintGenesis(dAtA []byte, offset int, v uint64) int {
+	offset -= sovGenesis(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *GenesisState) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.CookbookList) > 0 {
+		for _, e := range m.CookbookList {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.RecipeList) > 0 {
+		for _, e := range m.RecipeList {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.ItemList) > 0 {
+		for _, e := range m.ItemList {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if m.PendingExecutionCount != 0 {
+		n += 1 + sovGenesis(uint64(m.PendingExecutionCount))
+	}
+	if len(m.PendingExecutionList) > 0 {
+		for _, e := range m.PendingExecutionList {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
 	}
 	if m.ExecutionCount != 0 {
 		n += 1 + sovGenesis(uint64(m.ExecutionCount))
@@ -533,47 +968,546 @@ func (m *GenesisState) Size() (n int) {
 			n += 2 + l + sovGenesis(uint64(l))
 		}
 	}
-	return n
-}
-
-func sovGenesis(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozGenesis(x uint64) (n int) {
-	return sovGenesis(uint64((x << 1) ^ uint64((int64(x) >> 63))))
-}
-func (m *GenesisState) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowGenesis
+	if len(m.LendingList) > 0 {
+		for _, e := range m.LendingList {
+			l = e.Size()
+			n += 2 + l + sovGenesis(uint64(l))
+		}
+	}
+	if m.LendingCount != 0 {
+		n += 2 + sovGenesis(uint64(m.LendingCount))
+	}
+	if len(m.ItemApprovalList) > 0 {
+		for _, e := range m.ItemApprovalList {
+			l = e.Size()
+			n += 2 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.ItemOperatorList) > 0 {
+		for _, e := range m.ItemOperatorList {
+			l = e.Size()
+			n += 2 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.ClassTraceList) > 0 {
+		for _, e := range m.ClassTraceList {
+			l = e.Size()
+			n += 2 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.ItemTokenList) > 0 {
+		for _, e := range m.ItemTokenList {
+			l = e.Size()
+			n += 2 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.ItemEscrowList) > 0 {
+		for _, e := range m.ItemEscrowList {
+			l = e.Size()
+			n += 2 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.AuctionList) > 0 {
+		for _, e := range m.AuctionList {
+			l = e.Size()
+			n += 2 + l + sovGenesis(uint64(l))
+		}
+	}
+	if m.AuctionCount != 0 {
+		n += 2 + sovGenesis(uint64(m.AuctionCount))
+	}
+	if len(m.DutchAuctionList) > 0 {
+		for _, e := range m.DutchAuctionList {
+			l = e.Size()
+			n += 2 + l + sovGenesis(uint64(l))
+		}
+	}
+	if m.DutchAuctionCount != 0 {
+		n += 2 + sovGenesis(uint64(m.DutchAuctionCount))
+	}
+	if len(m.ItemOfferList) > 0 {
+		for _, e := range m.ItemOfferList {
+			l = e.Size()
+			n += 2 + l + sovGenesis(uint64(l))
+		}
+	}
+	if m.ItemOfferCount != 0 {
+		n += 2 + sovGenesis(uint64(m.ItemOfferCount))
+	}
+	if len(m.SwapList) > 0 {
+		for _, e := range m.SwapList {
+			l = e.Size()
+			n += 2 + l + sovGenesis(uint64(l))
+		}
+	}
+	if m.SwapCount != 0 {
+		n += 2 + sovGenesis(uint64(m.SwapCount))
+	}
+	if len(m.RecipeStatsList) > 0 {
+		for _, e := range m.RecipeStatsList {
+			l = e.Size()
+			n += 2 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.CookbookStatsList) > 0 {
+		for _, e := range m.CookbookStatsList {
+			l = e.Size()
+			n += 2 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.RecipeExecutorsList) > 0 {
+		for _, e := range m.RecipeExecutorsList {
+			l = e.Size()
+			n += 2 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.CookbookExecutorsList) > 0 {
+		for _, e := range m.CookbookExecutorsList {
+			l = e.Size()
+			n += 2 + l + sovGenesis(uint64(l))
+		}
+	}
+	return n
+}
+
+func sovGenesis(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozGenesis(x uint64) (n int) {
+	return sovGenesis(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *GenesisState) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenesis
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GenesisState: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GenesisState: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CookbookList", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CookbookList = append(m.CookbookList, Cookbook{})
+			if err := m.CookbookList[len(m.CookbookList)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RecipeList", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RecipeList = append(m.RecipeList, Recipe{})
+			if err := m.RecipeList[len(m.RecipeList)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ItemList", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ItemList = append(m.ItemList, Item{})
+			if err := m.ItemList[len(m.ItemList)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PendingExecutionCount", wireType)
+			}
+			m.PendingExecutionCount = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PendingExecutionCount |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PendingExecutionList", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PendingExecutionList = append(m.PendingExecutionList, Execution{})
+			if err := m.PendingExecutionList[len(m.PendingExecutionList)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExecutionCount", wireType)
+			}
+			m.ExecutionCount = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ExecutionCount |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExecutionList", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ExecutionList = append(m.ExecutionList, Execution{})
+			if err := m.ExecutionList[len(m.ExecutionList)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GoogleInAppPurchaseOrderList", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.GoogleInAppPurchaseOrderList = append(m.GoogleInAppPurchaseOrderList, GoogleInAppPurchaseOrder{})
+			if err := m.GoogleInAppPurchaseOrderList[len(m.GoogleInAppPurchaseOrderList)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 9:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GoogleIapOrderCount", wireType)
+			}
+			m.GoogleIapOrderCount = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.GoogleIapOrderCount |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 11:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EntityCount", wireType)
+			}
+			m.EntityCount = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.EntityCount |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 12:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TradeCount", wireType)
+			}
+			m.TradeCount = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TradeCount |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 13:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TradeList", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TradeList = append(m.TradeList, Trade{})
+			if err := m.TradeList[len(m.TradeList)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 14:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AccountList", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			if iNdEx >= l {
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			m.AccountList = append(m.AccountList, UserMap{})
+			if err := m.AccountList[len(m.AccountList)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: GenesisState: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: GenesisState: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			iNdEx = postIndex
+		case 15:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CookbookList", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field PaymentInfoList", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -600,14 +1534,14 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.CookbookList = append(m.CookbookList, Cookbook{})
-			if err := m.CookbookList[len(m.CookbookList)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.PaymentInfoList = append(m.PaymentInfoList, PaymentInfo{})
+			if err := m.PaymentInfoList[len(m.PaymentInfoList)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 2:
+		case 16:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RecipeList", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field RedeemInfoList", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -634,14 +1568,14 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.RecipeList = append(m.RecipeList, Recipe{})
-			if err := m.RecipeList[len(m.RecipeList)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.RedeemInfoList = append(m.RedeemInfoList, RedeemInfo{})
+			if err := m.RedeemInfoList[len(m.RedeemInfoList)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 3:
+		case 17:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ItemList", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field LendingList", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -668,16 +1602,16 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ItemList = append(m.ItemList, Item{})
-			if err := m.ItemList[len(m.ItemList)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.LendingList = append(m.LendingList, Lending{})
+			if err := m.LendingList[len(m.LendingList)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 4:
+		case 18:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PendingExecutionCount", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field LendingCount", wireType)
 			}
-			m.PendingExecutionCount = 0
+			m.LendingCount = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenesis
@@ -687,14 +1621,14 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.PendingExecutionCount |= uint64(b&0x7F) << shift
+				m.LendingCount |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 5:
+		case 19:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PendingExecutionList", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ItemApprovalList", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -721,16 +1655,16 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.PendingExecutionList = append(m.PendingExecutionList, Execution{})
-			if err := m.PendingExecutionList[len(m.PendingExecutionList)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.ItemApprovalList = append(m.ItemApprovalList, ItemApproval{})
+			if err := m.ItemApprovalList[len(m.ItemApprovalList)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 6:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ExecutionCount", wireType)
+		case 20:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ItemOperatorList", wireType)
 			}
-			m.ExecutionCount = 0
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenesis
@@ -740,14 +1674,29 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.ExecutionCount |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 7:
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ItemOperatorList = append(m.ItemOperatorList, ItemOperator{})
+			if err := m.ItemOperatorList[len(m.ItemOperatorList)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 21:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ExecutionList", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ClassTraceList", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -774,14 +1723,14 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ExecutionList = append(m.ExecutionList, Execution{})
-			if err := m.ExecutionList[len(m.ExecutionList)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.ClassTraceList = append(m.ClassTraceList, ClassTrace{})
+			if err := m.ClassTraceList[len(m.ClassTraceList)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 8:
+		case 22:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field GoogleInAppPurchaseOrderList", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ItemTokenList", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -808,16 +1757,84 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.GoogleInAppPurchaseOrderList = append(m.GoogleInAppPurchaseOrderList, GoogleInAppPurchaseOrder{})
-			if err := m.GoogleInAppPurchaseOrderList[len(m.GoogleInAppPurchaseOrderList)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.ItemTokenList = append(m.ItemTokenList, ItemToken{})
+			if err := m.ItemTokenList[len(m.ItemTokenList)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 9:
+		case 23:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ItemEscrowList", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ItemEscrowList = append(m.ItemEscrowList, ItemEscrow{})
+			if err := m.ItemEscrowList[len(m.ItemEscrowList)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 24:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AuctionList", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AuctionList = append(m.AuctionList, Auction{})
+			if err := m.AuctionList[len(m.AuctionList)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 25:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field GoogleIapOrderCount", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field AuctionCount", wireType)
 			}
-			m.GoogleIapOrderCount = 0
+			m.AuctionCount = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenesis
@@ -827,14 +1844,14 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.GoogleIapOrderCount |= uint64(b&0x7F) << shift
+				m.AuctionCount |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 10:
+		case 26:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field DutchAuctionList", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -861,15 +1878,16 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.DutchAuctionList = append(m.DutchAuctionList, DutchAuction{})
+			if err := m.DutchAuctionList[len(m.DutchAuctionList)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 11:
+		case 27:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field EntityCount", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field DutchAuctionCount", wireType)
 			}
-			m.EntityCount = 0
+			m.DutchAuctionCount = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenesis
@@ -879,16 +1897,50 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.EntityCount |= uint64(b&0x7F) << shift
+				m.DutchAuctionCount |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 12:
+		case 28:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ItemOfferList", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ItemOfferList = append(m.ItemOfferList, ItemOffer{})
+			if err := m.ItemOfferList[len(m.ItemOfferList)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 29:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TradeCount", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ItemOfferCount", wireType)
 			}
-			m.TradeCount = 0
+			m.ItemOfferCount = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenesis
@@ -898,14 +1950,14 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.TradeCount |= uint64(b&0x7F) << shift
+				m.ItemOfferCount |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 13:
+		case 30:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TradeList", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field SwapList", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -932,14 +1984,33 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.TradeList = append(m.TradeList, Trade{})
-			if err := m.TradeList[len(m.TradeList)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.SwapList = append(m.SwapList, Swap{})
+			if err := m.SwapList[len(m.SwapList)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 14:
+		case 31:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SwapCount", wireType)
+			}
+			m.SwapCount = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SwapCount |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 32:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AccountList", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field RecipeStatsList", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -966,14 +2037,14 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.AccountList = append(m.AccountList, UserMap{})
-			if err := m.AccountList[len(m.AccountList)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.RecipeStatsList = append(m.RecipeStatsList, RecipeStats{})
+			if err := m.RecipeStatsList[len(m.RecipeStatsList)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 15:
+		case 33:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PaymentInfoList", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field CookbookStatsList", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -1000,14 +2071,14 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.PaymentInfoList = append(m.PaymentInfoList, PaymentInfo{})
-			if err := m.PaymentInfoList[len(m.PaymentInfoList)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.CookbookStatsList = append(m.CookbookStatsList, CookbookStats{})
+			if err := m.CookbookStatsList[len(m.CookbookStatsList)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 16:
+		case 34:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RedeemInfoList", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field RecipeExecutorsList", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -1034,8 +2105,42 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.RedeemInfoList = append(m.RedeemInfoList, RedeemInfo{})
-			if err := m.RedeemInfoList[len(m.RedeemInfoList)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.RecipeExecutorsList = append(m.RecipeExecutorsList, RecipeExecutors{})
+			if err := m.RecipeExecutorsList[len(m.RecipeExecutorsList)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 35:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CookbookExecutorsList", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CookbookExecutorsList = append(m.CookbookExecutorsList, CookbookExecutors{})
+			if err := m.CookbookExecutorsList[len(m.CookbookExecutorsList)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
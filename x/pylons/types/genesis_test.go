@@ -14,4 +14,12 @@ func TestValidateGenesis(t *testing.T) {
 	genesis = NetworkTestGenesis()
 	err = genesis.Validate()
 	require.NoError(t, err)
+
+	genesis = DefaultGenesis()
+	genesis.RecipeStatsList = []RecipeStats{{CookbookId: "cookbook", RecipeId: "recipe"}, {CookbookId: "cookbook", RecipeId: "recipe"}}
+	require.Error(t, genesis.Validate())
+
+	genesis = DefaultGenesis()
+	genesis.CookbookExecutorsList = []CookbookExecutors{{CookbookId: "cookbook", Executors: []string{"invalid"}}}
+	require.Error(t, genesis.Validate())
 }
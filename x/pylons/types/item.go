@@ -83,6 +83,16 @@ func (it Item) FindStringKey(key string) (int, bool) {
 	return 0, false
 }
 
+// FindMutableString is a function to get a mutable string attribute from an item
+func (it Item) FindMutableString(key string) (string, bool) {
+	for _, v := range it.MutableStrings {
+		if v.Key == key {
+			return v.Value, true
+		}
+	}
+	return "", false
+}
+
 // Actualize function actualize an item from item output data
 func (io ItemOutput) Actualize(ctx sdk.Context, cookbookID, recipeID string, addr sdk.AccAddress, ec CelEnvCollection, nodeVersion uint64) (Item, error) {
 	dblActualize, err := DoubleParamList(io.Doubles).Actualize(ec)
@@ -98,6 +108,22 @@ func (io ItemOutput) Actualize(ctx sdk.Context, cookbookID, recipeID string, add
 		return Item{}, err
 	}
 
+	var quantity uint64
+	if io.Fungible {
+		quantity = io.Amount
+		if quantity == 0 {
+			quantity = 1
+		}
+	}
+
+	var expiresAtHeight, expiresAt int64
+	if io.ExpiryBlocks != 0 {
+		expiresAtHeight = ctx.BlockHeight() + io.ExpiryBlocks
+	}
+	if io.ExpirySeconds != 0 {
+		expiresAt = ctx.BlockTime().Unix() + io.ExpirySeconds
+	}
+
 	return Item{
 		// ID not set - it's handled internally
 		Owner:           addr.String(),
@@ -114,9 +140,104 @@ func (io ItemOutput) Actualize(ctx sdk.Context, cookbookID, recipeID string, add
 		RecipeId:        recipeID,
 		CreatedAt:       ctx.BlockTime().Unix(),
 		UpdatedAt:       ctx.BlockTime().Unix(),
+		Fungible:        io.Fungible,
+		Quantity:        quantity,
+		ExpiresAtHeight: expiresAtHeight,
+		ExpiresAt:       expiresAt,
+		TransferPolicy:  io.TransferPolicy,
+		// the transfer lock of the item starts at its mint
+		LastTransferHeight: ctx.BlockHeight(),
 	}, nil
 }
 
+// IsExpired checks if the item reached its expiry height or time
+func (it Item) IsExpired(ctx sdk.Context) bool {
+	if it.ExpiresAtHeight != 0 && ctx.BlockHeight() >= it.ExpiresAtHeight {
+		return true
+	}
+	return it.ExpiresAt != 0 && ctx.BlockTime().Unix() >= it.ExpiresAt
+}
+
+// HasTransferRestrictions checks if the transfer policy of the item restricts its transfers
+func (it Item) HasTransferRestrictions() bool {
+	return it.TransferPolicy.Soulbound || it.TransferPolicy.MaxTransfers != 0 || it.TransferPolicy.LockBlocks != 0
+}
+
+// CanTransfer checks the transfer policy of the item allows it to change owner at the current block height
+func (it Item) CanTransfer(ctx sdk.Context) error {
+	if it.TransferPolicy.Soulbound {
+		return sdkerrors.Wrapf(ErrItemTransferRestricted, "item with id %s in cookbook %s is soulbound", it.Id, it.CookbookId)
+	}
+	if it.TransferPolicy.MaxTransfers != 0 && it.TransferCount >= it.TransferPolicy.MaxTransfers {
+		return sdkerrors.Wrapf(ErrItemTransferRestricted, "item with id %s in cookbook %s reached its maximum of %d transfers", it.Id, it.CookbookId, it.TransferPolicy.MaxTransfers)
+	}
+	if unlockHeight := it.LastTransferHeight + it.TransferPolicy.LockBlocks; ctx.BlockHeight() < unlockHeight {
+		return sdkerrors.Wrapf(ErrItemTransferRestricted, "item with id %s in cookbook %s cannot be transferred before height %d", it.Id, it.CookbookId, unlockHeight)
+	}
+	return nil
+}
+
+// RecordTransfer counts a change of owner of the item, starting a new transfer lock
+func (it *Item) RecordTransfer(ctx sdk.Context) {
+	it.TransferCount++
+	it.LastTransferHeight = ctx.BlockHeight()
+}
+
+// IsStackableWith checks if two fungible items hold identical units that can be merged in a single item. The transfer
+// count and last transfer height can differ, the merged item keeping the most restrictive ones
+func (it Item) IsStackableWith(other Item) bool {
+	if !it.Fungible || !other.Fungible {
+		return false
+	}
+	if it.CookbookId != other.CookbookId || it.RecipeId != other.RecipeId || it.Tradeable != other.Tradeable {
+		return false
+	}
+	if it.ContainerId != other.ContainerId {
+		return false
+	}
+	if it.ExpiresAtHeight != other.ExpiresAtHeight || it.ExpiresAt != other.ExpiresAt {
+		return false
+	}
+	if it.TransferPolicy != other.TransferPolicy {
+		return false
+	}
+	if !it.TradePercentage.IsNil() && !other.TradePercentage.IsNil() {
+		if !it.TradePercentage.Equal(other.TradePercentage) {
+			return false
+		}
+	} else if it.TradePercentage.IsNil() != other.TradePercentage.IsNil() {
+		return false
+	}
+	if !sdk.Coins(it.TransferFee).IsEqual(other.TransferFee) || len(it.TransferFee) != len(other.TransferFee) {
+		return false
+	}
+	if len(it.Doubles) != len(other.Doubles) || len(it.Longs) != len(other.Longs) ||
+		len(it.Strings) != len(other.Strings) || len(it.MutableStrings) != len(other.MutableStrings) {
+		return false
+	}
+	for i := range it.Doubles {
+		if it.Doubles[i].Key != other.Doubles[i].Key || !it.Doubles[i].Value.Equal(other.Doubles[i].Value) {
+			return false
+		}
+	}
+	for i := range it.Longs {
+		if it.Longs[i] != other.Longs[i] {
+			return false
+		}
+	}
+	for i := range it.Strings {
+		if it.Strings[i] != other.Strings[i] {
+			return false
+		}
+	}
+	for i := range it.MutableStrings {
+		if it.MutableStrings[i] != other.MutableStrings[i] {
+			return false
+		}
+	}
+	return true
+}
+
 // Actualize is used to update an existing item from an ItemModifyOutout
 func (io ItemModifyOutput) Actualize(targetItem *Item, ctx sdk.Context, addr sdk.AccAddress, ec CelEnvCollection) error {
 	if io.Doubles != nil {
@@ -195,6 +316,19 @@ func (io ItemModifyOutput) Actualize(targetItem *Item, ctx sdk.Context, addr sdk
 
 // MatchItem checks if all the constraint match the given item
 func (itemInput ItemInput) MatchItem(item Item, ec CelEnvCollection) error {
+	if itemInput.CookbookId != "" && item.CookbookId != itemInput.CookbookId {
+		return sdkerrors.Wrapf(ErrItemMatch, "item is not from cookbook %s: item_id=%s", itemInput.CookbookId, item.Id)
+	}
+
+	if itemInput.Amount != 0 {
+		if !item.Fungible {
+			return sdkerrors.Wrapf(ErrItemMatch, "item is not fungible: item_id=%s", item.Id)
+		}
+		if item.Quantity < itemInput.Amount {
+			return sdkerrors.Wrapf(ErrItemMatch, "item quantity %d lower than required amount %d: item_id=%s", item.Quantity, itemInput.Amount, item.Id)
+		}
+	}
+
 	if itemInput.Doubles != nil {
 		for _, param := range itemInput.Doubles {
 			double, ok := item.FindDouble(param.Key)
@@ -232,6 +366,16 @@ func (itemInput ItemInput) MatchItem(item Item, ec CelEnvCollection) error {
 			}
 		}
 	}
+
+	if itemInput.Condition != "" {
+		ok, err := ec.EvalBool(itemInput.Condition)
+		if err != nil {
+			return sdkerrors.Wrapf(ErrItemMatch, "condition cannot be evaluated: item_id=%s: %s", item.Id, err.Error())
+		}
+		if !ok {
+			return sdkerrors.Wrapf(ErrItemMatch, "condition does not match: item_id=%s", item.Id)
+		}
+	}
 	return nil
 }
 
@@ -351,6 +495,12 @@ func FindValidPaymentsPermutation(items []Item, balance sdk.Coins) ([]int, error
 	return nil, errors.New("no valid set of items' transferFees exists that can be covered by the provided balance")
 }
 
+// MaxExpiredItemsPerBlock bounds the number of expired items deleted at the end of each block
+const MaxExpiredItemsPerBlock = 100
+
+// BurnedItemHistoryReceiver is recorded as the receiver in the final history entry of a burned item
+const BurnedItemHistoryReceiver = "burned"
+
 func (it Item) NewItemHistory(ctx sdk.Context, to, from string) ItemHistory {
 	return ItemHistory{
 		CookbookId: it.CookbookId,
@@ -360,3 +510,55 @@ func (it Item) NewItemHistory(ctx sdk.Context, to, from string) ItemHistory {
 		CreatedAt:  ctx.BlockTime().Unix(),
 	}
 }
+
+// Kinds of events recorded in the provenance of an item
+const (
+	ItemProvenanceMint    = "mint"
+	ItemProvenanceModify  = "modify"
+	ItemProvenanceUpdate  = "update"
+	ItemProvenanceSend    = "send"
+	ItemProvenanceTrade   = "trade"
+	ItemProvenanceAuction = "auction"
+	ItemProvenanceOffer   = "offer"
+	ItemProvenanceSwap    = "swap"
+	ItemProvenanceLock    = "lock"
+	ItemProvenanceUnlock  = "unlock"
+	ItemProvenanceBurn    = "burn"
+)
+
+func (it Item) NewItemProvenance(ctx sdk.Context, event, from, to string) ItemProvenance {
+	return ItemProvenance{
+		CookbookId:  it.CookbookId,
+		Id:          it.Id,
+		Event:       event,
+		From:        from,
+		To:          to,
+		BlockHeight: ctx.BlockHeight(),
+		CreatedAt:   ctx.BlockTime().Unix(),
+	}
+}
+
+// SetChangedAttributes records the attributes of the item whose value differs from the attributes of prev
+func (p *ItemProvenance) SetChangedAttributes(prev, it Item) {
+	p.Doubles, p.Longs, p.Strings, p.MutableStrings = nil, nil, nil, nil
+	for _, kv := range it.Doubles {
+		if v, found := prev.FindDouble(kv.Key); !found || !v.Equal(kv.Value) {
+			p.Doubles = append(p.Doubles, kv)
+		}
+	}
+	for _, kv := range it.Longs {
+		if v, found := prev.FindLong(kv.Key); !found || int64(v) != kv.Value {
+			p.Longs = append(p.Longs, kv)
+		}
+	}
+	for _, kv := range it.Strings {
+		if v, found := prev.FindString(kv.Key); !found || v != kv.Value {
+			p.Strings = append(p.Strings, kv)
+		}
+	}
+	for _, kv := range it.MutableStrings {
+		if v, found := prev.FindMutableString(kv.Key); !found || v != kv.Value {
+			p.MutableStrings = append(p.MutableStrings, kv)
+		}
+	}
+}
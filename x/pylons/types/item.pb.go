@@ -194,6 +194,22 @@ type Item struct {
 	CreatedAt       int64                                  `protobuf:"varint,13,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
 	UpdatedAt       int64                                  `protobuf:"varint,14,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
 	RecipeId        string                                 `protobuf:"bytes,15,opt,name=recipe_id,json=recipeId,proto3" json:"recipe_id,omitempty"`
+	// fungible items are stackable, identical units are merged in a single item record holding a quantity
+	Fungible bool `protobuf:"varint,16,opt,name=fungible,proto3" json:"fungible,omitempty"`
+	// the number of units held by a fungible item
+	Quantity uint64 `protobuf:"varint,17,opt,name=quantity,proto3" json:"quantity,omitempty"`
+	// block height at which the item expires, 0 if the item does not expire by height
+	ExpiresAtHeight int64 `protobuf:"varint,18,opt,name=expires_at_height,json=expiresAtHeight,proto3" json:"expires_at_height,omitempty"`
+	// unix time at which the item expires, 0 if the item does not expire by time
+	ExpiresAt int64 `protobuf:"varint,19,opt,name=expires_at,json=expiresAt,proto3" json:"expires_at,omitempty"`
+	// id of the container item of the same cookbook holding the item, the contents of a container are owned by the
+	// containers locker module account and move with the container
+	ContainerId    string             `protobuf:"bytes,20,opt,name=container_id,json=containerId,proto3" json:"container_id,omitempty"`
+	TransferPolicy ItemTransferPolicy `protobuf:"bytes,21,opt,name=transfer_policy,json=transferPolicy,proto3" json:"transfer_policy"`
+	// number of times the item changed owner since it was minted
+	TransferCount uint64 `protobuf:"varint,22,opt,name=transfer_count,json=transferCount,proto3" json:"transfer_count,omitempty"`
+	// block height at which the item was minted or last changed owner
+	LastTransferHeight int64 `protobuf:"varint,23,opt,name=last_transfer_height,json=lastTransferHeight,proto3" json:"last_transfer_height,omitempty"`
 }
 
 func (m *Item) Reset()         { *m = Item{} }
@@ -327,6 +343,126 @@ func (m *Item) GetRecipeId() string {
 	return ""
 }
 
+func (m *Item) GetFungible() bool {
+	if m != nil {
+		return m.Fungible
+	}
+	return false
+}
+
+func (m *Item) GetQuantity() uint64 {
+	if m != nil {
+		return m.Quantity
+	}
+	return 0
+}
+
+func (m *Item) GetExpiresAtHeight() int64 {
+	if m != nil {
+		return m.ExpiresAtHeight
+	}
+	return 0
+}
+
+func (m *Item) GetExpiresAt() int64 {
+	if m != nil {
+		return m.ExpiresAt
+	}
+	return 0
+}
+
+func (m *Item) GetContainerId() string {
+	if m != nil {
+		return m.ContainerId
+	}
+	return ""
+}
+
+func (m *Item) GetTransferPolicy() ItemTransferPolicy {
+	if m != nil {
+		return m.TransferPolicy
+	}
+	return ItemTransferPolicy{}
+}
+
+func (m *Item) GetTransferCount() uint64 {
+	if m != nil {
+		return m.TransferCount
+	}
+	return 0
+}
+
+func (m *Item) GetLastTransferHeight() int64 {
+	if m != nil {
+		return m.LastTransferHeight
+	}
+	return 0
+}
+
+// ItemTransferPolicy restricts the transfers of a tradeable item
+type ItemTransferPolicy struct {
+	// soulbound items are never transferred from the account they are minted to
+	Soulbound bool `protobuf:"varint,1,opt,name=soulbound,proto3" json:"soulbound,omitempty"`
+	// maximum number of transfers of the item, 0 if unlimited
+	MaxTransfers uint64 `protobuf:"varint,2,opt,name=max_transfers,json=maxTransfers,proto3" json:"max_transfers,omitempty,string"`
+	// number of blocks after the mint or the last transfer of the item during which it cannot be transferred
+	LockBlocks int64 `protobuf:"varint,3,opt,name=lock_blocks,json=lockBlocks,proto3" json:"lock_blocks,omitempty,string"`
+}
+
+func (m *ItemTransferPolicy) Reset()         { *m = ItemTransferPolicy{} }
+func (m *ItemTransferPolicy) String() string { return proto.CompactTextString(m) }
+func (*ItemTransferPolicy) ProtoMessage()    {}
+func (*ItemTransferPolicy) Descriptor() ([]byte, []int) {
+	return fileDescriptor_52fde63720867e69, []int{4}
+}
+func (m *ItemTransferPolicy) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ItemTransferPolicy) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ItemTransferPolicy.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ItemTransferPolicy) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ItemTransferPolicy.Merge(m, src)
+}
+func (m *ItemTransferPolicy) XXX_Size() int {
+	return m.Size()
+}
+func (m *ItemTransferPolicy) XXX_DiscardUnknown() {
+	xxx_messageInfo_ItemTransferPolicy.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ItemTransferPolicy proto.InternalMessageInfo
+
+func (m *ItemTransferPolicy) GetSoulbound() bool {
+	if m != nil {
+		return m.Soulbound
+	}
+	return false
+}
+
+func (m *ItemTransferPolicy) GetMaxTransfers() uint64 {
+	if m != nil {
+		return m.MaxTransfers
+	}
+	return 0
+}
+
+func (m *ItemTransferPolicy) GetLockBlocks() int64 {
+	if m != nil {
+		return m.LockBlocks
+	}
+	return 0
+}
+
 type ItemHistory struct {
 	CookbookId string `protobuf:"bytes,2,opt,name=cookbook_id,json=cookbookId,proto3" json:"cookbook_id,omitempty"`
 	Id         string `protobuf:"bytes,3,opt,name=id,proto3" json:"id,omitempty"`
@@ -339,7 +475,7 @@ func (m *ItemHistory) Reset()         { *m = ItemHistory{} }
 func (m *ItemHistory) String() string { return proto.CompactTextString(m) }
 func (*ItemHistory) ProtoMessage()    {}
 func (*ItemHistory) Descriptor() ([]byte, []int) {
-	return fileDescriptor_52fde63720867e69, []int{4}
+	return fileDescriptor_52fde63720867e69, []int{5}
 }
 func (m *ItemHistory) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -403,332 +539,393 @@ func (m *ItemHistory) GetCreatedAt() int64 {
 	return 0
 }
 
-func init() {
-	proto.RegisterType((*DoubleKeyValue)(nil), "pylons.pylons.DoubleKeyValue")
-	proto.RegisterType((*LongKeyValue)(nil), "pylons.pylons.LongKeyValue")
-	proto.RegisterType((*StringKeyValue)(nil), "pylons.pylons.StringKeyValue")
-	proto.RegisterType((*Item)(nil), "pylons.pylons.Item")
-	proto.RegisterType((*ItemHistory)(nil), "pylons.pylons.ItemHistory")
+// ItemAttributesHistory records an update of the attributes of an item with MsgUpdateItemAttributes, the original
+// values hold the values of the updated keys before the update, keys added by the update have no original value
+type ItemAttributesHistory struct {
+	CookbookId      string           `protobuf:"bytes,1,opt,name=cookbook_id,json=cookbookId,proto3" json:"cookbook_id,omitempty"`
+	Id              string           `protobuf:"bytes,2,opt,name=id,proto3" json:"id,omitempty"`
+	Updater         string           `protobuf:"bytes,3,opt,name=updater,proto3" json:"updater,omitempty"`
+	OriginalDoubles []DoubleKeyValue `protobuf:"bytes,4,rep,name=original_doubles,json=originalDoubles,proto3" json:"original_doubles"`
+	OriginalLongs   []LongKeyValue   `protobuf:"bytes,5,rep,name=original_longs,json=originalLongs,proto3" json:"original_longs"`
+	OriginalStrings []StringKeyValue `protobuf:"bytes,6,rep,name=original_strings,json=originalStrings,proto3" json:"original_strings"`
+	Doubles         []DoubleKeyValue `protobuf:"bytes,7,rep,name=doubles,proto3" json:"doubles"`
+	Longs           []LongKeyValue   `protobuf:"bytes,8,rep,name=longs,proto3" json:"longs"`
+	Strings         []StringKeyValue `protobuf:"bytes,9,rep,name=strings,proto3" json:"strings"`
+	BlockHeight     int64            `protobuf:"varint,10,opt,name=block_height,json=blockHeight,proto3" json:"block_height,omitempty"`
+	CreatedAt       int64            `protobuf:"varint,11,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
 }
 
-func init() { proto.RegisterFile("pylons/pylons/item.proto", fileDescriptor_52fde63720867e69) }
-
-var fileDescriptor_52fde63720867e69 = []byte{
-	// 605 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x54, 0xcd, 0x4e, 0x13, 0x51,
-	0x14, 0xee, 0xd0, 0x16, 0x98, 0xd3, 0x52, 0xc8, 0x0d, 0x8b, 0x2b, 0xc8, 0x50, 0x59, 0x98, 0x2e,
-	0x64, 0x26, 0x68, 0xa2, 0x6e, 0x5c, 0x50, 0x09, 0x91, 0xc8, 0x82, 0x94, 0x48, 0xa2, 0x9b, 0xc9,
-	0xfc, 0x1c, 0xca, 0xa4, 0xed, 0x9c, 0x66, 0xee, 0x2d, 0xda, 0xa5, 0x6f, 0xe0, 0x93, 0xf8, 0x1c,
-	0x2c, 0x59, 0x1a, 0x17, 0xc4, 0xc0, 0x8b, 0x98, 0xfb, 0x33, 0x0a, 0x8d, 0x31, 0xe8, 0xea, 0x9e,
-	0xf9, 0xce, 0xf9, 0xce, 0x39, 0xf3, 0xdd, 0x2f, 0x17, 0xf8, 0x78, 0x3a, 0xa4, 0x5c, 0x04, 0xf6,
-	0xc8, 0x24, 0x8e, 0xfc, 0x71, 0x41, 0x92, 0xd8, 0x92, 0x81, 0x7c, 0x73, 0xac, 0xad, 0xf6, 0xa9,
-	0x4f, 0x3a, 0x13, 0xa8, 0xc8, 0x14, 0xad, 0x79, 0x09, 0x89, 0x11, 0x89, 0x20, 0x8e, 0x04, 0x06,
-	0xe7, 0x3b, 0x31, 0xca, 0x68, 0x27, 0x48, 0x28, 0xcb, 0x4d, 0x7e, 0xeb, 0x0c, 0x5a, 0x7b, 0x34,
-	0x89, 0x87, 0xf8, 0x16, 0xa7, 0x27, 0xd1, 0x70, 0x82, 0x6c, 0x05, 0xaa, 0x03, 0x9c, 0x72, 0xa7,
-	0xed, 0x74, 0xdc, 0x9e, 0x0a, 0xd9, 0x1e, 0xd4, 0xcf, 0x55, 0x8a, 0xcf, 0x29, 0xac, 0xeb, 0x5f,
-	0x5c, 0x6d, 0x56, 0xbe, 0x5f, 0x6d, 0x3e, 0xee, 0x67, 0xf2, 0x6c, 0x12, 0xfb, 0x09, 0x8d, 0x02,
-	0x3b, 0xc5, 0x1c, 0xdb, 0x22, 0x1d, 0x04, 0x72, 0x3a, 0x46, 0xe1, 0xef, 0x61, 0xd2, 0x33, 0xe4,
-	0xad, 0xe7, 0xd0, 0x3c, 0xa4, 0xbc, 0xff, 0x97, 0x39, 0xab, 0xb7, 0xe7, 0x54, 0x4b, 0xde, 0x4b,
-	0x68, 0x1d, 0xcb, 0x22, 0xbb, 0x3f, 0xd3, 0x2d, 0x99, 0x5f, 0xeb, 0x50, 0x3b, 0x90, 0x38, 0x52,
-	0x69, 0xfa, 0x98, 0x63, 0x61, 0x29, 0xe6, 0x83, 0x6d, 0x42, 0x23, 0x21, 0x1a, 0xc4, 0x44, 0x83,
-	0x30, 0x4b, 0x2d, 0x15, 0x4a, 0xe8, 0x20, 0x65, 0x2d, 0x98, 0xcb, 0x52, 0x5e, 0xd5, 0xf8, 0x5c,
-	0x96, 0xb2, 0x47, 0xd0, 0xcc, 0x29, 0xc5, 0xf0, 0x1c, 0x0b, 0x91, 0x51, 0xce, 0x6b, 0x6d, 0xa7,
-	0x53, 0xeb, 0x35, 0x14, 0x76, 0x62, 0x20, 0xf6, 0x0a, 0x16, 0x52, 0x2d, 0xa7, 0xe0, 0xf5, 0x76,
-	0xb5, 0xd3, 0x78, 0xba, 0xe1, 0xdf, 0xb9, 0x25, 0xff, 0xae, 0xd8, 0xdd, 0x9a, 0xd2, 0xb2, 0x57,
-	0x72, 0xd8, 0x0b, 0xa8, 0x0f, 0x29, 0xef, 0x0b, 0x3e, 0xaf, 0xc9, 0xeb, 0x33, 0xe4, 0xdb, 0xfa,
-	0x59, 0xaa, 0xa9, 0x57, 0x73, 0x85, 0x16, 0x49, 0xf0, 0x85, 0x3f, 0xce, 0xbd, 0x2b, 0x61, 0x39,
-	0xd7, 0x72, 0xd8, 0x21, 0x2c, 0x8f, 0x26, 0x32, 0x8a, 0x87, 0x18, 0x96, 0x6d, 0x16, 0xef, 0xdf,
-	0xa6, 0x65, 0xb9, 0xc7, 0xb6, 0xdb, 0x43, 0x70, 0x65, 0x11, 0xa5, 0xa8, 0x30, 0xee, 0xb6, 0x9d,
-	0xce, 0x62, 0xef, 0x37, 0xa0, 0x64, 0x1f, 0x46, 0x42, 0x86, 0x93, 0x71, 0x1a, 0x49, 0xe4, 0xa0,
-	0xef, 0x1a, 0x14, 0xf4, 0x4e, 0x23, 0xac, 0x0b, 0x4d, 0x59, 0x44, 0xb9, 0x38, 0xc5, 0x22, 0x3c,
-	0x45, 0xe4, 0x0d, 0xbd, 0xc9, 0x03, 0xdf, 0x98, 0xcb, 0x57, 0x4e, 0xf6, 0xad, 0x93, 0xfd, 0xd7,
-	0x94, 0xe5, 0x76, 0x8b, 0x46, 0x49, 0xda, 0x47, 0x64, 0xef, 0x61, 0x45, 0x4f, 0x0c, 0xc7, 0x58,
-	0x24, 0x98, 0xcb, 0xa8, 0x8f, 0xbc, 0xf9, 0x5f, 0xee, 0x5d, 0xd6, 0x7d, 0x8e, 0x7e, 0xb5, 0x61,
-	0x1b, 0x00, 0x49, 0x81, 0x91, 0xc4, 0x34, 0x8c, 0x24, 0x5f, 0xd2, 0xeb, 0xbb, 0x16, 0xd9, 0x95,
-	0x2a, 0x6d, 0xfe, 0x4c, 0xa7, 0x5b, 0x26, 0x6d, 0x91, 0x5d, 0xc9, 0xd6, 0xc1, 0x2d, 0x30, 0xc9,
-	0xc6, 0xa8, 0x2c, 0xb7, 0xac, 0xad, 0xb5, 0x68, 0x80, 0x83, 0x74, 0xeb, 0xb3, 0x03, 0x0d, 0x65,
-	0xd8, 0x37, 0x99, 0x90, 0x54, 0x4c, 0xff, 0xdd, 0xa1, 0x0c, 0x6a, 0xa7, 0x05, 0x8d, 0xb4, 0x33,
-	0xdd, 0x9e, 0x8e, 0x55, 0x8d, 0x24, 0x5e, 0x37, 0x35, 0x92, 0x66, 0xf6, 0x9f, 0x9f, 0xd9, 0xbf,
-	0xbb, 0x7f, 0x71, 0xed, 0x39, 0x97, 0xd7, 0x9e, 0xf3, 0xe3, 0xda, 0x73, 0xbe, 0xdc, 0x78, 0x95,
-	0xcb, 0x1b, 0xaf, 0xf2, 0xed, 0xc6, 0xab, 0x7c, 0x78, 0x72, 0x4b, 0xb1, 0x23, 0x6d, 0x87, 0x6d,
-	0x89, 0xc9, 0x59, 0xf9, 0x32, 0x7d, 0x2a, 0x03, 0xad, 0x5d, 0x3c, 0xaf, 0xdf, 0x97, 0x67, 0x3f,
-	0x03, 0x00, 0x00, 0xff, 0xff, 0x1e, 0x02, 0xff, 0xd3, 0xc0, 0x04, 0x00, 0x00,
+func (m *ItemAttributesHistory) Reset()         { *m = ItemAttributesHistory{} }
+func (m *ItemAttributesHistory) String() string { return proto.CompactTextString(m) }
+func (*ItemAttributesHistory) ProtoMessage()    {}
+func (*ItemAttributesHistory) Descriptor() ([]byte, []int) {
+	return fileDescriptor_52fde63720867e69, []int{6}
 }
-
-func (m *DoubleKeyValue) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *ItemAttributesHistory) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ItemAttributesHistory) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ItemAttributesHistory.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return dAtA[:n], nil
 }
-
-func (m *DoubleKeyValue) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *ItemAttributesHistory) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ItemAttributesHistory.Merge(m, src)
+}
+func (m *ItemAttributesHistory) XXX_Size() int {
+	return m.Size()
+}
+func (m *ItemAttributesHistory) XXX_DiscardUnknown() {
+	xxx_messageInfo_ItemAttributesHistory.DiscardUnknown(m)
 }
 
-func (m *DoubleKeyValue) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size := m.Value.Size()
-		i -= size
-		if _, err := m.Value.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
-		}
-		i = encodeVarintItem(dAtA, i, uint64(size))
+var xxx_messageInfo_ItemAttributesHistory proto.InternalMessageInfo
+
+func (m *ItemAttributesHistory) GetCookbookId() string {
+	if m != nil {
+		return m.CookbookId
 	}
-	i--
-	dAtA[i] = 0x12
-	if len(m.Key) > 0 {
-		i -= len(m.Key)
-		copy(dAtA[i:], m.Key)
-		i = encodeVarintItem(dAtA, i, uint64(len(m.Key)))
-		i--
-		dAtA[i] = 0xa
+	return ""
+}
+
+func (m *ItemAttributesHistory) GetId() string {
+	if m != nil {
+		return m.Id
 	}
-	return len(dAtA) - i, nil
+	return ""
 }
 
-func (m *LongKeyValue) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *ItemAttributesHistory) GetUpdater() string {
+	if m != nil {
+		return m.Updater
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *LongKeyValue) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *ItemAttributesHistory) GetOriginalDoubles() []DoubleKeyValue {
+	if m != nil {
+		return m.OriginalDoubles
+	}
+	return nil
 }
 
-func (m *LongKeyValue) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Value != 0 {
-		i = encodeVarintItem(dAtA, i, uint64(m.Value))
-		i--
-		dAtA[i] = 0x10
+func (m *ItemAttributesHistory) GetOriginalLongs() []LongKeyValue {
+	if m != nil {
+		return m.OriginalLongs
 	}
-	if len(m.Key) > 0 {
-		i -= len(m.Key)
-		copy(dAtA[i:], m.Key)
-		i = encodeVarintItem(dAtA, i, uint64(len(m.Key)))
-		i--
-		dAtA[i] = 0xa
+	return nil
+}
+
+func (m *ItemAttributesHistory) GetOriginalStrings() []StringKeyValue {
+	if m != nil {
+		return m.OriginalStrings
 	}
-	return len(dAtA) - i, nil
+	return nil
 }
 
-func (m *StringKeyValue) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *ItemAttributesHistory) GetDoubles() []DoubleKeyValue {
+	if m != nil {
+		return m.Doubles
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *StringKeyValue) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *ItemAttributesHistory) GetLongs() []LongKeyValue {
+	if m != nil {
+		return m.Longs
+	}
+	return nil
 }
 
-func (m *StringKeyValue) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Value) > 0 {
-		i -= len(m.Value)
-		copy(dAtA[i:], m.Value)
-		i = encodeVarintItem(dAtA, i, uint64(len(m.Value)))
-		i--
-		dAtA[i] = 0x12
+func (m *ItemAttributesHistory) GetStrings() []StringKeyValue {
+	if m != nil {
+		return m.Strings
 	}
-	if len(m.Key) > 0 {
-		i -= len(m.Key)
-		copy(dAtA[i:], m.Key)
-		i = encodeVarintItem(dAtA, i, uint64(len(m.Key)))
-		i--
-		dAtA[i] = 0xa
+	return nil
+}
+
+func (m *ItemAttributesHistory) GetBlockHeight() int64 {
+	if m != nil {
+		return m.BlockHeight
 	}
-	return len(dAtA) - i, nil
+	return 0
 }
 
-func (m *Item) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *ItemAttributesHistory) GetCreatedAt() int64 {
+	if m != nil {
+		return m.CreatedAt
 	}
-	return dAtA[:n], nil
+	return 0
 }
 
-func (m *Item) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// ItemProvenance records an event in the chain of custody of an item, the events of an item are ordered by the
+// entity count when they are recorded
+type ItemProvenance struct {
+	CookbookId string `protobuf:"bytes,1,opt,name=cookbook_id,json=cookbookId,proto3" json:"cookbook_id,omitempty"`
+	Id         string `protobuf:"bytes,2,opt,name=id,proto3" json:"id,omitempty"`
+	// kind of the event, one of mint, modify, update, send, trade, lock, unlock or burn
+	Event string `protobuf:"bytes,3,opt,name=event,proto3" json:"event,omitempty"`
+	// addresses of the previous and the new owner of the item, the cookbook creator is the previous owner of a minted
+	// item and the account executing the recipe or updating the attributes is the previous owner of a modified item
+	From string `protobuf:"bytes,4,opt,name=from,proto3" json:"from,omitempty"`
+	To   string `protobuf:"bytes,5,opt,name=to,proto3" json:"to,omitempty"`
+	// recipe and execution minting or modifying the item
+	RecipeId    string `protobuf:"bytes,6,opt,name=recipe_id,json=recipeId,proto3" json:"recipe_id,omitempty"`
+	ExecutionId string `protobuf:"bytes,7,opt,name=execution_id,json=executionId,proto3" json:"execution_id,omitempty"`
+	// trade transferring the item and the coins paid for it by its new owner
+	TradeId uint64                                   `protobuf:"varint,8,opt,name=trade_id,json=tradeId,proto3" json:"trade_id,omitempty,string"`
+	Price   github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,9,rep,name=price,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"price"`
+	// attributes changed by the event, holding their new values
+	Doubles        []DoubleKeyValue `protobuf:"bytes,10,rep,name=doubles,proto3" json:"doubles"`
+	Longs          []LongKeyValue   `protobuf:"bytes,11,rep,name=longs,proto3" json:"longs"`
+	Strings        []StringKeyValue `protobuf:"bytes,12,rep,name=strings,proto3" json:"strings"`
+	MutableStrings []StringKeyValue `protobuf:"bytes,13,rep,name=mutable_strings,json=mutableStrings,proto3" json:"mutable_strings"`
+	// module account locking or unlocking the item
+	Locker      string `protobuf:"bytes,14,opt,name=locker,proto3" json:"locker,omitempty"`
+	BlockHeight int64  `protobuf:"varint,15,opt,name=block_height,json=blockHeight,proto3" json:"block_height,omitempty"`
+	CreatedAt   int64  `protobuf:"varint,16,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
 }
 
-func (m *Item) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.RecipeId) > 0 {
-		i -= len(m.RecipeId)
-		copy(dAtA[i:], m.RecipeId)
-		i = encodeVarintItem(dAtA, i, uint64(len(m.RecipeId)))
-		i--
-		dAtA[i] = 0x7a
+func (m *ItemProvenance) Reset()         { *m = ItemProvenance{} }
+func (m *ItemProvenance) String() string { return proto.CompactTextString(m) }
+func (*ItemProvenance) ProtoMessage()    {}
+func (*ItemProvenance) Descriptor() ([]byte, []int) {
+	return fileDescriptor_52fde63720867e69, []int{7}
+}
+func (m *ItemProvenance) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ItemProvenance) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ItemProvenance.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	if m.UpdatedAt != 0 {
-		i = encodeVarintItem(dAtA, i, uint64(m.UpdatedAt))
-		i--
-		dAtA[i] = 0x70
+}
+func (m *ItemProvenance) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ItemProvenance.Merge(m, src)
+}
+func (m *ItemProvenance) XXX_Size() int {
+	return m.Size()
+}
+func (m *ItemProvenance) XXX_DiscardUnknown() {
+	xxx_messageInfo_ItemProvenance.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ItemProvenance proto.InternalMessageInfo
+
+func (m *ItemProvenance) GetCookbookId() string {
+	if m != nil {
+		return m.CookbookId
 	}
-	if m.CreatedAt != 0 {
-		i = encodeVarintItem(dAtA, i, uint64(m.CreatedAt))
-		i--
-		dAtA[i] = 0x68
+	return ""
+}
+
+func (m *ItemProvenance) GetId() string {
+	if m != nil {
+		return m.Id
 	}
-	{
-		size := m.TradePercentage.Size()
-		i -= size
-		if _, err := m.TradePercentage.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
-		}
-		i = encodeVarintItem(dAtA, i, uint64(size))
+	return ""
+}
+
+func (m *ItemProvenance) GetEvent() string {
+	if m != nil {
+		return m.Event
 	}
-	i--
-	dAtA[i] = 0x62
-	if len(m.TransferFee) > 0 {
-		for iNdEx := len(m.TransferFee) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.TransferFee[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintItem(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x5a
-		}
+	return ""
+}
+
+func (m *ItemProvenance) GetFrom() string {
+	if m != nil {
+		return m.From
 	}
-	if m.LastUpdate != 0 {
-		i = encodeVarintItem(dAtA, i, uint64(m.LastUpdate))
-		i--
-		dAtA[i] = 0x50
+	return ""
+}
+
+func (m *ItemProvenance) GetTo() string {
+	if m != nil {
+		return m.To
 	}
-	if m.Tradeable {
-		i--
-		if m.Tradeable {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
-		}
-		i--
-		dAtA[i] = 0x48
+	return ""
+}
+
+func (m *ItemProvenance) GetRecipeId() string {
+	if m != nil {
+		return m.RecipeId
 	}
-	if len(m.MutableStrings) > 0 {
-		for iNdEx := len(m.MutableStrings) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.MutableStrings[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintItem(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x42
-		}
+	return ""
+}
+
+func (m *ItemProvenance) GetExecutionId() string {
+	if m != nil {
+		return m.ExecutionId
 	}
-	if len(m.Strings) > 0 {
-		for iNdEx := len(m.Strings) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Strings[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintItem(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x3a
-		}
+	return ""
+}
+
+func (m *ItemProvenance) GetTradeId() uint64 {
+	if m != nil {
+		return m.TradeId
 	}
-	if len(m.Longs) > 0 {
-		for iNdEx := len(m.Longs) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Longs[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintItem(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x32
-		}
+	return 0
+}
+
+func (m *ItemProvenance) GetPrice() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.Price
 	}
-	if len(m.Doubles) > 0 {
-		for iNdEx := len(m.Doubles) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Doubles[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintItem(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x2a
-		}
+	return nil
+}
+
+func (m *ItemProvenance) GetDoubles() []DoubleKeyValue {
+	if m != nil {
+		return m.Doubles
 	}
-	if m.NodeVersion != 0 {
-		i = encodeVarintItem(dAtA, i, uint64(m.NodeVersion))
-		i--
-		dAtA[i] = 0x20
+	return nil
+}
+
+func (m *ItemProvenance) GetLongs() []LongKeyValue {
+	if m != nil {
+		return m.Longs
 	}
-	if len(m.Id) > 0 {
-		i -= len(m.Id)
-		copy(dAtA[i:], m.Id)
-		i = encodeVarintItem(dAtA, i, uint64(len(m.Id)))
-		i--
-		dAtA[i] = 0x1a
+	return nil
+}
+
+func (m *ItemProvenance) GetStrings() []StringKeyValue {
+	if m != nil {
+		return m.Strings
 	}
-	if len(m.CookbookId) > 0 {
-		i -= len(m.CookbookId)
-		copy(dAtA[i:], m.CookbookId)
-		i = encodeVarintItem(dAtA, i, uint64(len(m.CookbookId)))
-		i--
-		dAtA[i] = 0x12
+	return nil
+}
+
+func (m *ItemProvenance) GetMutableStrings() []StringKeyValue {
+	if m != nil {
+		return m.MutableStrings
 	}
-	if len(m.Owner) > 0 {
-		i -= len(m.Owner)
-		copy(dAtA[i:], m.Owner)
-		i = encodeVarintItem(dAtA, i, uint64(len(m.Owner)))
-		i--
-		dAtA[i] = 0xa
+	return nil
+}
+
+func (m *ItemProvenance) GetLocker() string {
+	if m != nil {
+		return m.Locker
 	}
-	return len(dAtA) - i, nil
+	return ""
 }
 
-func (m *ItemHistory) Marshal() (dAtA []byte, err error) {
+func (m *ItemProvenance) GetBlockHeight() int64 {
+	if m != nil {
+		return m.BlockHeight
+	}
+	return 0
+}
+
+func (m *ItemProvenance) GetCreatedAt() int64 {
+	if m != nil {
+		return m.CreatedAt
+	}
+	return 0
+}
+
+func init() {
+	proto.RegisterType((*DoubleKeyValue)(nil), "pylons.pylons.DoubleKeyValue")
+	proto.RegisterType((*LongKeyValue)(nil), "pylons.pylons.LongKeyValue")
+	proto.RegisterType((*StringKeyValue)(nil), "pylons.pylons.StringKeyValue")
+	proto.RegisterType((*Item)(nil), "pylons.pylons.Item")
+	proto.RegisterType((*ItemTransferPolicy)(nil), "pylons.pylons.ItemTransferPolicy")
+	proto.RegisterType((*ItemHistory)(nil), "pylons.pylons.ItemHistory")
+	proto.RegisterType((*ItemAttributesHistory)(nil), "pylons.pylons.ItemAttributesHistory")
+	proto.RegisterType((*ItemProvenance)(nil), "pylons.pylons.ItemProvenance")
+}
+
+func init() { proto.RegisterFile("pylons/pylons/item.proto", fileDescriptor_52fde63720867e69) }
+
+var fileDescriptor_52fde63720867e69 = []byte{
+	// 1110 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x56, 0xcf, 0x6e, 0x1b, 0xb7,
+	0x13, 0xb6, 0x6c, 0xfd, 0x9d, 0xd5, 0x1f, 0x87, 0x3f, 0x27, 0x3f, 0xc6, 0x89, 0x25, 0x59, 0x40,
+	0x0b, 0xa1, 0x70, 0x56, 0x49, 0x0a, 0xb4, 0xb9, 0xf4, 0x60, 0xc5, 0x48, 0x2d, 0x34, 0x28, 0x0c,
+	0xa5, 0x0d, 0xd0, 0x5e, 0x16, 0xab, 0xdd, 0xb1, 0x4c, 0x58, 0x5a, 0xaa, 0x4b, 0xae, 0x6b, 0x1d,
+	0xfb, 0x06, 0x7d, 0x8e, 0xbe, 0x40, 0x6f, 0xbd, 0x15, 0xc8, 0x31, 0xc7, 0xa2, 0x07, 0xb7, 0xb0,
+	0x6f, 0x7e, 0x89, 0x16, 0x24, 0x77, 0xd7, 0x92, 0xdc, 0x34, 0x8a, 0xd3, 0x8b, 0xb4, 0xfc, 0x66,
+	0x86, 0x33, 0x1c, 0x7e, 0xfc, 0x48, 0xa0, 0x93, 0xe9, 0x88, 0x07, 0xa2, 0x13, 0xff, 0x31, 0x89,
+	0x63, 0x7b, 0x12, 0x72, 0xc9, 0x49, 0xc5, 0x40, 0xb6, 0xf9, 0xdb, 0xdc, 0x18, 0xf2, 0x21, 0xd7,
+	0x96, 0x8e, 0xfa, 0x32, 0x4e, 0x9b, 0x75, 0x8f, 0x8b, 0x31, 0x17, 0x9d, 0x81, 0x2b, 0xb0, 0x73,
+	0xf2, 0x68, 0x80, 0xd2, 0x7d, 0xd4, 0xf1, 0x38, 0x0b, 0x8c, 0xbd, 0x75, 0x04, 0xd5, 0x3d, 0x1e,
+	0x0d, 0x46, 0xf8, 0x05, 0x4e, 0x5f, 0xba, 0xa3, 0x08, 0xc9, 0x3a, 0xac, 0x1d, 0xe3, 0x94, 0x66,
+	0x9a, 0x99, 0x76, 0xa9, 0xaf, 0x3e, 0xc9, 0x1e, 0xe4, 0x4e, 0x94, 0x89, 0xae, 0x2a, 0xac, 0x6b,
+	0xbf, 0x3a, 0x6b, 0xac, 0xfc, 0x7e, 0xd6, 0xf8, 0x70, 0xc8, 0xe4, 0x51, 0x34, 0xb0, 0x3d, 0x3e,
+	0xee, 0xc4, 0x59, 0xcc, 0xdf, 0x03, 0xe1, 0x1f, 0x77, 0xe4, 0x74, 0x82, 0xc2, 0xde, 0x43, 0xaf,
+	0x6f, 0x82, 0x5b, 0x9f, 0x40, 0xf9, 0x39, 0x0f, 0x86, 0xff, 0x92, 0x67, 0x63, 0x36, 0xcf, 0x5a,
+	0x12, 0xf7, 0x04, 0xaa, 0x2f, 0x64, 0xc8, 0x96, 0x8f, 0x2c, 0x25, 0x91, 0x7f, 0x15, 0x20, 0xdb,
+	0x93, 0x38, 0x56, 0x66, 0xfe, 0x7d, 0x80, 0x61, 0x1c, 0x62, 0x06, 0xa4, 0x01, 0x96, 0xc7, 0xf9,
+	0xf1, 0x80, 0xf3, 0x63, 0x87, 0xf9, 0x71, 0x28, 0x24, 0x50, 0xcf, 0x27, 0x55, 0x58, 0x65, 0x3e,
+	0x5d, 0xd3, 0xf8, 0x2a, 0xf3, 0xc9, 0x36, 0x94, 0x03, 0xee, 0xa3, 0x73, 0x82, 0xa1, 0x60, 0x3c,
+	0xa0, 0xd9, 0x66, 0xa6, 0x9d, 0xed, 0x5b, 0x0a, 0x7b, 0x69, 0x20, 0xf2, 0x19, 0x14, 0x7c, 0xdd,
+	0x4e, 0x41, 0x73, 0xcd, 0xb5, 0xb6, 0xf5, 0x78, 0xcb, 0x9e, 0xdb, 0x25, 0x7b, 0xbe, 0xd9, 0xdd,
+	0xac, 0xea, 0x65, 0x3f, 0x89, 0x21, 0x9f, 0x42, 0x6e, 0xc4, 0x83, 0xa1, 0xa0, 0x79, 0x1d, 0x7c,
+	0x6f, 0x21, 0x78, 0xb6, 0x7f, 0x71, 0xa8, 0xf1, 0x57, 0x79, 0x85, 0x6e, 0x92, 0xa0, 0x85, 0x7f,
+	0xcc, 0x3b, 0xdf, 0xc2, 0x24, 0x6f, 0x1c, 0x43, 0x9e, 0x43, 0x6d, 0x1c, 0x49, 0x77, 0x30, 0x42,
+	0x27, 0x99, 0xa6, 0xb8, 0xfc, 0x34, 0xd5, 0x38, 0xf6, 0x45, 0x3c, 0xdb, 0x7d, 0x28, 0xc9, 0xd0,
+	0xf5, 0x51, 0x61, 0xb4, 0xd4, 0xcc, 0xb4, 0x8b, 0xfd, 0x2b, 0x40, 0xb5, 0x7d, 0xe4, 0x0a, 0xe9,
+	0x44, 0x13, 0xdf, 0x95, 0x48, 0x41, 0xef, 0x35, 0x28, 0xe8, 0x6b, 0x8d, 0x90, 0x2e, 0x94, 0x65,
+	0xe8, 0x06, 0xe2, 0x10, 0x43, 0xe7, 0x10, 0x91, 0x5a, 0xba, 0x92, 0xbb, 0xb6, 0x21, 0x97, 0xad,
+	0x98, 0x6c, 0xc7, 0x4c, 0xb6, 0x9f, 0x72, 0x16, 0xc4, 0x55, 0x58, 0x49, 0xd0, 0x33, 0x44, 0xf2,
+	0x0d, 0xac, 0xeb, 0x8c, 0xce, 0x04, 0x43, 0x0f, 0x03, 0xe9, 0x0e, 0x91, 0x96, 0x6f, 0xc4, 0xde,
+	0x9a, 0x9e, 0xe7, 0x20, 0x9d, 0x86, 0x6c, 0x01, 0x78, 0x21, 0xba, 0x12, 0x7d, 0xc7, 0x95, 0xb4,
+	0xa2, 0xcb, 0x2f, 0xc5, 0xc8, 0xae, 0x54, 0x66, 0xb3, 0x32, 0x6d, 0xae, 0x1a, 0x73, 0x8c, 0xec,
+	0x4a, 0x72, 0x0f, 0x4a, 0x21, 0x7a, 0x6c, 0x82, 0x8a, 0x72, 0x35, 0x4d, 0xad, 0xa2, 0x01, 0x7a,
+	0x3e, 0xd9, 0x84, 0xe2, 0x61, 0x14, 0x0c, 0x99, 0xea, 0xdb, 0xba, 0xee, 0x5b, 0x3a, 0x56, 0xb6,
+	0xef, 0x22, 0x37, 0x90, 0x4c, 0x4e, 0xe9, 0x2d, 0x4d, 0xbc, 0x74, 0x4c, 0x3e, 0x82, 0x5b, 0x78,
+	0x3a, 0x61, 0x21, 0x0a, 0xc7, 0x95, 0xce, 0x11, 0xb2, 0xe1, 0x91, 0xa4, 0x44, 0xa7, 0xae, 0xc5,
+	0x86, 0x5d, 0xb9, 0xaf, 0x61, 0x55, 0xdf, 0x95, 0x2f, 0xfd, 0x9f, 0xa9, 0x2f, 0x75, 0x52, 0x1c,
+	0xf7, 0x78, 0x20, 0x5d, 0x16, 0x60, 0xa8, 0x4a, 0xdc, 0xd0, 0x25, 0x5a, 0x29, 0xd6, 0xf3, 0xc9,
+	0x01, 0xd4, 0xd2, 0xfd, 0x99, 0xf0, 0x11, 0xf3, 0xa6, 0xf4, 0x76, 0x33, 0xd3, 0xb6, 0x1e, 0x6f,
+	0x2f, 0x90, 0x45, 0x9d, 0xbd, 0xaf, 0x62, 0xcf, 0x03, 0xed, 0x98, 0x10, 0x46, 0xce, 0xa1, 0xe4,
+	0x03, 0x48, 0x11, 0xc7, 0xe3, 0x51, 0x20, 0xe9, 0x1d, 0xbd, 0xc2, 0x4a, 0x82, 0x3e, 0x55, 0x20,
+	0x79, 0x08, 0x1b, 0x9a, 0x39, 0xa9, 0x6f, 0xbc, 0xd2, 0xff, 0xeb, 0x45, 0x10, 0x65, 0x4b, 0xd2,
+	0x99, 0xc5, 0xb6, 0x7e, 0xc9, 0x00, 0xb9, 0x5e, 0x85, 0x22, 0xa8, 0xe0, 0xd1, 0x68, 0xc0, 0xa3,
+	0xc0, 0xd7, 0x9a, 0x50, 0xec, 0x5f, 0x01, 0xe4, 0x73, 0xa8, 0x8c, 0xdd, 0xd3, 0x34, 0x8b, 0xd0,
+	0xca, 0x90, 0xed, 0xb6, 0x2e, 0xcf, 0x1a, 0xf5, 0x39, 0xc3, 0x0e, 0x1f, 0x2b, 0x39, 0x9e, 0xc8,
+	0xe9, 0x8e, 0x39, 0x35, 0xfd, 0xf2, 0xd8, 0x3d, 0x4d, 0x72, 0x09, 0xb2, 0x0b, 0xd6, 0x88, 0x7b,
+	0xc7, 0xce, 0x40, 0xfd, 0x0a, 0x2d, 0x24, 0x6b, 0xdd, 0xe6, 0xe5, 0x59, 0xe3, 0xfe, 0x0c, 0x7c,
+	0x7d, 0x12, 0x50, 0x78, 0x57, 0x1b, 0x5b, 0x3f, 0x64, 0xc0, 0x52, 0x0b, 0xd8, 0x67, 0x42, 0xf2,
+	0x70, 0xfa, 0xee, 0x9a, 0x45, 0x20, 0x7b, 0x18, 0xf2, 0xb1, 0xd6, 0xaa, 0x52, 0x5f, 0x7f, 0x2b,
+	0x1f, 0xc9, 0x69, 0xce, 0xf8, 0x48, 0xbe, 0xc0, 0xe8, 0xfc, 0x02, 0xa3, 0x5b, 0xbf, 0x66, 0xe1,
+	0xb6, 0xaa, 0x61, 0x57, 0xca, 0x90, 0x0d, 0x22, 0x89, 0xe2, 0x0d, 0xd5, 0x64, 0xde, 0x50, 0xcd,
+	0x6a, 0x5a, 0x0d, 0x85, 0x82, 0x39, 0x0a, 0x61, 0x5c, 0x62, 0x32, 0x24, 0x5f, 0xc2, 0x3a, 0x0f,
+	0xd9, 0x90, 0x05, 0xee, 0xc8, 0x49, 0x14, 0x34, 0xbb, 0xbc, 0x82, 0xd6, 0x92, 0x60, 0x63, 0x15,
+	0x64, 0x1f, 0xaa, 0xe9, 0x7c, 0x46, 0x52, 0x73, 0xcb, 0x4a, 0x6a, 0x25, 0x09, 0x54, 0x36, 0x31,
+	0x57, 0x59, 0x22, 0x8e, 0xf9, 0xe5, 0xc5, 0x31, 0xad, 0x2c, 0x51, 0xc7, 0x99, 0x2b, 0xa2, 0xf0,
+	0x3e, 0x57, 0x44, 0xf1, 0xe6, 0x57, 0x44, 0xe9, 0x06, 0x57, 0xc4, 0x36, 0x94, 0x35, 0x61, 0x93,
+	0x43, 0x67, 0x74, 0xdb, 0xd2, 0xd8, 0x95, 0xb4, 0xcc, 0xf0, 0xc8, 0x5a, 0xe4, 0xd1, 0xcf, 0x39,
+	0xa8, 0x2a, 0x1e, 0x1d, 0x84, 0xfc, 0x04, 0x03, 0x37, 0xf0, 0xf0, 0xdd, 0x09, 0xb4, 0x01, 0x39,
+	0x3c, 0xc1, 0x40, 0xc6, 0xf4, 0x31, 0x83, 0xa5, 0x48, 0x3e, 0x27, 0xbc, 0xf9, 0x05, 0xe1, 0xdd,
+	0x86, 0x32, 0x9e, 0xa2, 0x17, 0x49, 0xc6, 0x03, 0x65, 0x2f, 0x18, 0xd5, 0x4b, 0xb1, 0x9e, 0x4f,
+	0x9e, 0x40, 0xd1, 0xdc, 0x28, 0xcc, 0xa7, 0x45, 0x2d, 0x08, 0x5b, 0x97, 0x67, 0x8d, 0xbb, 0x09,
+	0x76, 0xfd, 0x18, 0x17, 0xb4, 0xa9, 0xe7, 0x13, 0x17, 0x72, 0x93, 0x90, 0x79, 0x48, 0x4b, 0x6f,
+	0xbb, 0xc8, 0x1e, 0xaa, 0x96, 0xff, 0xf4, 0x47, 0xa3, 0xbd, 0xc4, 0xdd, 0xa4, 0x02, 0x44, 0xdf,
+	0xcc, 0x3c, 0xcb, 0x29, 0x78, 0x1f, 0x4e, 0x59, 0x37, 0xe7, 0x54, 0xf9, 0xbf, 0x79, 0x76, 0x54,
+	0x6e, 0xfe, 0xec, 0xb8, 0x03, 0x79, 0x45, 0x46, 0x0c, 0xf5, 0xad, 0x5b, 0xea, 0xc7, 0xa3, 0x6b,
+	0xcc, 0xad, 0xbd, 0x8d, 0xb9, 0xeb, 0x0b, 0xcc, 0xed, 0x3e, 0x7b, 0x75, 0x5e, 0xcf, 0xbc, 0x3e,
+	0xaf, 0x67, 0xfe, 0x3c, 0xaf, 0x67, 0x7e, 0xbc, 0xa8, 0xaf, 0xbc, 0xbe, 0xa8, 0xaf, 0xfc, 0x76,
+	0x51, 0x5f, 0xf9, 0x76, 0x67, 0x66, 0xa7, 0x0e, 0x74, 0xad, 0x0f, 0x24, 0x7a, 0x47, 0xc9, 0x6b,
+	0xfd, 0x34, 0xf9, 0xd0, 0x7b, 0x36, 0xc8, 0xeb, 0x37, 0xf7, 0xc7, 0x7f, 0x0f, 0x00, 0xcf, 0x9b,
+	0x08, 0xaf, 0xd4, 0x0b, 0x00, 0x00,
+}
+
+func (m *DoubleKeyValue) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -738,249 +935,1849 @@ func (m *ItemHistory) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *ItemHistory) MarshalTo(dAtA []byte) (int, error) {
+func (m *DoubleKeyValue) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *ItemHistory) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *DoubleKeyValue) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if m.CreatedAt != 0 {
-		i = encodeVarintItem(dAtA, i, uint64(m.CreatedAt))
-		i--
-		dAtA[i] = 0x30
+	{
+		size := m.Value.Size()
+		i -= size
+		if _, err := m.Value.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintItem(dAtA, i, uint64(size))
 	}
-	if len(m.To) > 0 {
-		i -= len(m.To)
-		copy(dAtA[i:], m.To)
-		i = encodeVarintItem(dAtA, i, uint64(len(m.To)))
+	i--
+	dAtA[i] = 0x12
+	if len(m.Key) > 0 {
+		i -= len(m.Key)
+		copy(dAtA[i:], m.Key)
+		i = encodeVarintItem(dAtA, i, uint64(len(m.Key)))
 		i--
-		dAtA[i] = 0x2a
+		dAtA[i] = 0xa
 	}
-	if len(m.From) > 0 {
-		i -= len(m.From)
-		copy(dAtA[i:], m.From)
-		i = encodeVarintItem(dAtA, i, uint64(len(m.From)))
-		i--
-		dAtA[i] = 0x22
+	return len(dAtA) - i, nil
+}
+
+func (m *LongKeyValue) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	if len(m.Id) > 0 {
-		i -= len(m.Id)
-		copy(dAtA[i:], m.Id)
-		i = encodeVarintItem(dAtA, i, uint64(len(m.Id)))
+	return dAtA[:n], nil
+}
+
+func (m *LongKeyValue) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *LongKeyValue) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Value != 0 {
+		i = encodeVarintItem(dAtA, i, uint64(m.Value))
 		i--
-		dAtA[i] = 0x1a
+		dAtA[i] = 0x10
 	}
-	if len(m.CookbookId) > 0 {
-		i -= len(m.CookbookId)
-		copy(dAtA[i:], m.CookbookId)
-		i = encodeVarintItem(dAtA, i, uint64(len(m.CookbookId)))
+	if len(m.Key) > 0 {
+		i -= len(m.Key)
+		copy(dAtA[i:], m.Key)
+		i = encodeVarintItem(dAtA, i, uint64(len(m.Key)))
 		i--
-		dAtA[i] = 0x12
+		dAtA[i] = 0xa
 	}
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintItem(dAtA []byte, offset int, v uint64) int {
-	offset -= sovItem(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (m *StringKeyValue) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return dAtA[:n], nil
 }
-func (m *DoubleKeyValue) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Key)
-	if l > 0 {
-		n += 1 + l + sovItem(uint64(l))
-	}
-	l = m.Value.Size()
-	n += 1 + l + sovItem(uint64(l))
-	return n
+
+func (m *StringKeyValue) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *LongKeyValue) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *StringKeyValue) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Key)
-	if l > 0 {
-		n += 1 + l + sovItem(uint64(l))
+	if len(m.Value) > 0 {
+		i -= len(m.Value)
+		copy(dAtA[i:], m.Value)
+		i = encodeVarintItem(dAtA, i, uint64(len(m.Value)))
+		i--
+		dAtA[i] = 0x12
 	}
-	if m.Value != 0 {
-		n += 1 + sovItem(uint64(m.Value))
+	if len(m.Key) > 0 {
+		i -= len(m.Key)
+		copy(dAtA[i:], m.Key)
+		i = encodeVarintItem(dAtA, i, uint64(len(m.Key)))
+		i--
+		dAtA[i] = 0xa
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *StringKeyValue) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Key)
-	if l > 0 {
-		n += 1 + l + sovItem(uint64(l))
-	}
-	l = len(m.Value)
-	if l > 0 {
-		n += 1 + l + sovItem(uint64(l))
+func (m *Item) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *Item) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *Item) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Item) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Owner)
-	if l > 0 {
-		n += 1 + l + sovItem(uint64(l))
-	}
-	l = len(m.CookbookId)
-	if l > 0 {
-		n += 1 + l + sovItem(uint64(l))
-	}
-	l = len(m.Id)
-	if l > 0 {
-		n += 1 + l + sovItem(uint64(l))
-	}
-	if m.NodeVersion != 0 {
-		n += 1 + sovItem(uint64(m.NodeVersion))
+	if m.LastTransferHeight != 0 {
+		i = encodeVarintItem(dAtA, i, uint64(m.LastTransferHeight))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xb8
 	}
-	if len(m.Doubles) > 0 {
-		for _, e := range m.Doubles {
-			l = e.Size()
-			n += 1 + l + sovItem(uint64(l))
-		}
+	if m.TransferCount != 0 {
+		i = encodeVarintItem(dAtA, i, uint64(m.TransferCount))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xb0
 	}
-	if len(m.Longs) > 0 {
-		for _, e := range m.Longs {
-			l = e.Size()
-			n += 1 + l + sovItem(uint64(l))
+	{
+		size, err := m.TransferPolicy.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
 		}
+		i -= size
+		i = encodeVarintItem(dAtA, i, uint64(size))
 	}
-	if len(m.Strings) > 0 {
-		for _, e := range m.Strings {
-			l = e.Size()
-			n += 1 + l + sovItem(uint64(l))
-		}
+	i--
+	dAtA[i] = 0x1
+	i--
+	dAtA[i] = 0xaa
+	if len(m.ContainerId) > 0 {
+		i -= len(m.ContainerId)
+		copy(dAtA[i:], m.ContainerId)
+		i = encodeVarintItem(dAtA, i, uint64(len(m.ContainerId)))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xa2
 	}
-	if len(m.MutableStrings) > 0 {
-		for _, e := range m.MutableStrings {
-			l = e.Size()
-			n += 1 + l + sovItem(uint64(l))
-		}
+	if m.ExpiresAt != 0 {
+		i = encodeVarintItem(dAtA, i, uint64(m.ExpiresAt))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x98
 	}
-	if m.Tradeable {
-		n += 2
+	if m.ExpiresAtHeight != 0 {
+		i = encodeVarintItem(dAtA, i, uint64(m.ExpiresAtHeight))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x90
 	}
-	if m.LastUpdate != 0 {
-		n += 1 + sovItem(uint64(m.LastUpdate))
+	if m.Quantity != 0 {
+		i = encodeVarintItem(dAtA, i, uint64(m.Quantity))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x88
 	}
-	if len(m.TransferFee) > 0 {
-		for _, e := range m.TransferFee {
-			l = e.Size()
-			n += 1 + l + sovItem(uint64(l))
+	if m.Fungible {
+		i--
+		if m.Fungible {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
 		}
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x80
 	}
-	l = m.TradePercentage.Size()
-	n += 1 + l + sovItem(uint64(l))
-	if m.CreatedAt != 0 {
-		n += 1 + sovItem(uint64(m.CreatedAt))
+	if len(m.RecipeId) > 0 {
+		i -= len(m.RecipeId)
+		copy(dAtA[i:], m.RecipeId)
+		i = encodeVarintItem(dAtA, i, uint64(len(m.RecipeId)))
+		i--
+		dAtA[i] = 0x7a
 	}
 	if m.UpdatedAt != 0 {
-		n += 1 + sovItem(uint64(m.UpdatedAt))
-	}
-	l = len(m.RecipeId)
-	if l > 0 {
-		n += 1 + l + sovItem(uint64(l))
+		i = encodeVarintItem(dAtA, i, uint64(m.UpdatedAt))
+		i--
+		dAtA[i] = 0x70
 	}
-	return n
-}
-
-func (m *ItemHistory) Size() (n int) {
-	if m == nil {
-		return 0
+	if m.CreatedAt != 0 {
+		i = encodeVarintItem(dAtA, i, uint64(m.CreatedAt))
+		i--
+		dAtA[i] = 0x68
 	}
-	var l int
-	_ = l
-	l = len(m.CookbookId)
-	if l > 0 {
-		n += 1 + l + sovItem(uint64(l))
+	{
+		size := m.TradePercentage.Size()
+		i -= size
+		if _, err := m.TradePercentage.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintItem(dAtA, i, uint64(size))
 	}
-	l = len(m.Id)
-	if l > 0 {
-		n += 1 + l + sovItem(uint64(l))
+	i--
+	dAtA[i] = 0x62
+	if len(m.TransferFee) > 0 {
+		for iNdEx := len(m.TransferFee) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.TransferFee[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintItem(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x5a
+		}
 	}
-	l = len(m.From)
-	if l > 0 {
-		n += 1 + l + sovItem(uint64(l))
+	if m.LastUpdate != 0 {
+		i = encodeVarintItem(dAtA, i, uint64(m.LastUpdate))
+		i--
+		dAtA[i] = 0x50
 	}
-	l = len(m.To)
-	if l > 0 {
-		n += 1 + l + sovItem(uint64(l))
+	if m.Tradeable {
+		i--
+		if m.Tradeable {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x48
+	}
+	if len(m.MutableStrings) > 0 {
+		for iNdEx := len(m.MutableStrings) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.MutableStrings[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintItem(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x42
+		}
+	}
+	if len(m.Strings) > 0 {
+		for iNdEx := len(m.Strings) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Strings[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintItem(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x3a
+		}
+	}
+	if len(m.Longs) > 0 {
+		for iNdEx := len(m.Longs) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Longs[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintItem(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x32
+		}
+	}
+	if len(m.Doubles) > 0 {
+		for iNdEx := len(m.Doubles) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Doubles[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintItem(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
+	if m.NodeVersion != 0 {
+		i = encodeVarintItem(dAtA, i, uint64(m.NodeVersion))
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.Id) > 0 {
+		i -= len(m.Id)
+		copy(dAtA[i:], m.Id)
+		i = encodeVarintItem(dAtA, i, uint64(len(m.Id)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.CookbookId) > 0 {
+		i -= len(m.CookbookId)
+		copy(dAtA[i:], m.CookbookId)
+		i = encodeVarintItem(dAtA, i, uint64(len(m.CookbookId)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Owner) > 0 {
+		i -= len(m.Owner)
+		copy(dAtA[i:], m.Owner)
+		i = encodeVarintItem(dAtA, i, uint64(len(m.Owner)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ItemTransferPolicy) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ItemTransferPolicy) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ItemTransferPolicy) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.LockBlocks != 0 {
+		i = encodeVarintItem(dAtA, i, uint64(m.LockBlocks))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.MaxTransfers != 0 {
+		i = encodeVarintItem(dAtA, i, uint64(m.MaxTransfers))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.Soulbound {
+		i--
+		if m.Soulbound {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ItemHistory) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *ItemHistory) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ItemHistory) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
 	if m.CreatedAt != 0 {
-		n += 1 + sovItem(uint64(m.CreatedAt))
+		i = encodeVarintItem(dAtA, i, uint64(m.CreatedAt))
+		i--
+		dAtA[i] = 0x30
 	}
-	return n
+	if len(m.To) > 0 {
+		i -= len(m.To)
+		copy(dAtA[i:], m.To)
+		i = encodeVarintItem(dAtA, i, uint64(len(m.To)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.From) > 0 {
+		i -= len(m.From)
+		copy(dAtA[i:], m.From)
+		i = encodeVarintItem(dAtA, i, uint64(len(m.From)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.Id) > 0 {
+		i -= len(m.Id)
+		copy(dAtA[i:], m.Id)
+		i = encodeVarintItem(dAtA, i, uint64(len(m.Id)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.CookbookId) > 0 {
+		i -= len(m.CookbookId)
+		copy(dAtA[i:], m.CookbookId)
+		i = encodeVarintItem(dAtA, i, uint64(len(m.CookbookId)))
+		i--
+		dAtA[i] = 0x12
+	}
+	return len(dAtA) - i, nil
 }
 
-func sovItem(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
+func (m *ItemAttributesHistory) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
 }
-func sozItem(x uint64) (n int) {
-	return sovItem(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+
+func (m *ItemAttributesHistory) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
-func (m *DoubleKeyValue) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowItem
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+
+func (m *ItemAttributesHistory) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.CreatedAt != 0 {
+		i = encodeVarintItem(dAtA, i, uint64(m.CreatedAt))
+		i--
+		dAtA[i] = 0x58
+	}
+	if m.BlockHeight != 0 {
+		i = encodeVarintItem(dAtA, i, uint64(m.BlockHeight))
+		i--
+		dAtA[i] = 0x50
+	}
+	if len(m.Strings) > 0 {
+		for iNdEx := len(m.Strings) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Strings[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintItem(dAtA, i, uint64(size))
 			}
+			i--
+			dAtA[i] = 0x4a
 		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: DoubleKeyValue: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: DoubleKeyValue: illegal tag %d (wire type %d)", fieldNum, wire)
+	}
+	if len(m.Longs) > 0 {
+		for iNdEx := len(m.Longs) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Longs[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintItem(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x42
 		}
-		switch fieldNum {
-		case 1:
+	}
+	if len(m.Doubles) > 0 {
+		for iNdEx := len(m.Doubles) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Doubles[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintItem(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x3a
+		}
+	}
+	if len(m.OriginalStrings) > 0 {
+		for iNdEx := len(m.OriginalStrings) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.OriginalStrings[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintItem(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x32
+		}
+	}
+	if len(m.OriginalLongs) > 0 {
+		for iNdEx := len(m.OriginalLongs) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.OriginalLongs[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintItem(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
+	if len(m.OriginalDoubles) > 0 {
+		for iNdEx := len(m.OriginalDoubles) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.OriginalDoubles[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintItem(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if len(m.Updater) > 0 {
+		i -= len(m.Updater)
+		copy(dAtA[i:], m.Updater)
+		i = encodeVarintItem(dAtA, i, uint64(len(m.Updater)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Id) > 0 {
+		i -= len(m.Id)
+		copy(dAtA[i:], m.Id)
+		i = encodeVarintItem(dAtA, i, uint64(len(m.Id)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.CookbookId) > 0 {
+		i -= len(m.CookbookId)
+		copy(dAtA[i:], m.CookbookId)
+		i = encodeVarintItem(dAtA, i, uint64(len(m.CookbookId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ItemProvenance) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ItemProvenance) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ItemProvenance) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.CreatedAt != 0 {
+		i = encodeVarintItem(dAtA, i, uint64(m.CreatedAt))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x80
+	}
+	if m.BlockHeight != 0 {
+		i = encodeVarintItem(dAtA, i, uint64(m.BlockHeight))
+		i--
+		dAtA[i] = 0x78
+	}
+	if len(m.Locker) > 0 {
+		i -= len(m.Locker)
+		copy(dAtA[i:], m.Locker)
+		i = encodeVarintItem(dAtA, i, uint64(len(m.Locker)))
+		i--
+		dAtA[i] = 0x72
+	}
+	if len(m.MutableStrings) > 0 {
+		for iNdEx := len(m.MutableStrings) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.MutableStrings[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintItem(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x6a
+		}
+	}
+	if len(m.Strings) > 0 {
+		for iNdEx := len(m.Strings) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Strings[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintItem(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x62
+		}
+	}
+	if len(m.Longs) > 0 {
+		for iNdEx := len(m.Longs) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Longs[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintItem(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x5a
+		}
+	}
+	if len(m.Doubles) > 0 {
+		for iNdEx := len(m.Doubles) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Doubles[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintItem(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x52
+		}
+	}
+	if len(m.Price) > 0 {
+		for iNdEx := len(m.Price) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Price[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintItem(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x4a
+		}
+	}
+	if m.TradeId != 0 {
+		i = encodeVarintItem(dAtA, i, uint64(m.TradeId))
+		i--
+		dAtA[i] = 0x40
+	}
+	if len(m.ExecutionId) > 0 {
+		i -= len(m.ExecutionId)
+		copy(dAtA[i:], m.ExecutionId)
+		i = encodeVarintItem(dAtA, i, uint64(len(m.ExecutionId)))
+		i--
+		dAtA[i] = 0x3a
+	}
+	if len(m.RecipeId) > 0 {
+		i -= len(m.RecipeId)
+		copy(dAtA[i:], m.RecipeId)
+		i = encodeVarintItem(dAtA, i, uint64(len(m.RecipeId)))
+		i--
+		dAtA[i] = 0x32
+	}
+	if len(m.To) > 0 {
+		i -= len(m.To)
+		copy(dAtA[i:], m.To)
+		i = encodeVarintItem(dAtA, i, uint64(len(m.To)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.From) > 0 {
+		i -= len(m.From)
+		copy(dAtA[i:], m.From)
+		i = encodeVarintItem(dAtA, i, uint64(len(m.From)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.Event) > 0 {
+		i -= len(m.Event)
+		copy(dAtA[i:], m.Event)
+		i = encodeVarintItem(dAtA, i, uint64(len(m.Event)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Id) > 0 {
+		i -= len(m.Id)
+		copy(dAtA[i:], m.Id)
+		i = encodeVarintItem(dAtA, i, uint64(len(m.Id)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.CookbookId) > 0 {
+		i -= len(m.CookbookId)
+		copy(dAtA[i:], m.CookbookId)
+		i = encodeVarintItem(dAtA, i, uint64(len(m.CookbookId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintItem(dAtA []byte, offset int, v uint64) int {
+	offset -= sovItem(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *DoubleKeyValue) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Key)
+	if l > 0 {
+		n += 1 + l + sovItem(uint64(l))
+	}
+	l = m.Value.Size()
+	n += 1 + l + sovItem(uint64(l))
+	return n
+}
+
+func (m *LongKeyValue) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Key)
+	if l > 0 {
+		n += 1 + l + sovItem(uint64(l))
+	}
+	if m.Value != 0 {
+		n += 1 + sovItem(uint64(m.Value))
+	}
+	return n
+}
+
+func (m *StringKeyValue) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Key)
+	if l > 0 {
+		n += 1 + l + sovItem(uint64(l))
+	}
+	l = len(m.Value)
+	if l > 0 {
+		n += 1 + l + sovItem(uint64(l))
+	}
+	return n
+}
+
+func (m *Item) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Owner)
+	if l > 0 {
+		n += 1 + l + sovItem(uint64(l))
+	}
+	l = len(m.CookbookId)
+	if l > 0 {
+		n += 1 + l + sovItem(uint64(l))
+	}
+	l = len(m.Id)
+	if l > 0 {
+		n += 1 + l + sovItem(uint64(l))
+	}
+	if m.NodeVersion != 0 {
+		n += 1 + sovItem(uint64(m.NodeVersion))
+	}
+	if len(m.Doubles) > 0 {
+		for _, e := range m.Doubles {
+			l = e.Size()
+			n += 1 + l + sovItem(uint64(l))
+		}
+	}
+	if len(m.Longs) > 0 {
+		for _, e := range m.Longs {
+			l = e.Size()
+			n += 1 + l + sovItem(uint64(l))
+		}
+	}
+	if len(m.Strings) > 0 {
+		for _, e := range m.Strings {
+			l = e.Size()
+			n += 1 + l + sovItem(uint64(l))
+		}
+	}
+	if len(m.MutableStrings) > 0 {
+		for _, e := range m.MutableStrings {
+			l = e.Size()
+			n += 1 + l + sovItem(uint64(l))
+		}
+	}
+	if m.Tradeable {
+		n += 2
+	}
+	if m.LastUpdate != 0 {
+		n += 1 + sovItem(uint64(m.LastUpdate))
+	}
+	if len(m.TransferFee) > 0 {
+		for _, e := range m.TransferFee {
+			l = e.Size()
+			n += 1 + l + sovItem(uint64(l))
+		}
+	}
+	l = m.TradePercentage.Size()
+	n += 1 + l + sovItem(uint64(l))
+	if m.CreatedAt != 0 {
+		n += 1 + sovItem(uint64(m.CreatedAt))
+	}
+	if m.UpdatedAt != 0 {
+		n += 1 + sovItem(uint64(m.UpdatedAt))
+	}
+	l = len(m.RecipeId)
+	if l > 0 {
+		n += 1 + l + sovItem(uint64(l))
+	}
+	if m.Fungible {
+		n += 3
+	}
+	if m.Quantity != 0 {
+		n += 2 + sovItem(uint64(m.Quantity))
+	}
+	if m.ExpiresAtHeight != 0 {
+		n += 2 + sovItem(uint64(m.ExpiresAtHeight))
+	}
+	if m.ExpiresAt != 0 {
+		n += 2 + sovItem(uint64(m.ExpiresAt))
+	}
+	l = len(m.ContainerId)
+	if l > 0 {
+		n += 2 + l + sovItem(uint64(l))
+	}
+	l = m.TransferPolicy.Size()
+	n += 2 + l + sovItem(uint64(l))
+	if m.TransferCount != 0 {
+		n += 2 + sovItem(uint64(m.TransferCount))
+	}
+	if m.LastTransferHeight != 0 {
+		n += 2 + sovItem(uint64(m.LastTransferHeight))
+	}
+	return n
+}
+
+func (m *ItemTransferPolicy) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Soulbound {
+		n += 2
+	}
+	if m.MaxTransfers != 0 {
+		n += 1 + sovItem(uint64(m.MaxTransfers))
+	}
+	if m.LockBlocks != 0 {
+		n += 1 + sovItem(uint64(m.LockBlocks))
+	}
+	return n
+}
+
+func (m *ItemHistory) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.CookbookId)
+	if l > 0 {
+		n += 1 + l + sovItem(uint64(l))
+	}
+	l = len(m.Id)
+	if l > 0 {
+		n += 1 + l + sovItem(uint64(l))
+	}
+	l = len(m.From)
+	if l > 0 {
+		n += 1 + l + sovItem(uint64(l))
+	}
+	l = len(m.To)
+	if l > 0 {
+		n += 1 + l + sovItem(uint64(l))
+	}
+	if m.CreatedAt != 0 {
+		n += 1 + sovItem(uint64(m.CreatedAt))
+	}
+	return n
+}
+
+func (m *ItemAttributesHistory) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.CookbookId)
+	if l > 0 {
+		n += 1 + l + sovItem(uint64(l))
+	}
+	l = len(m.Id)
+	if l > 0 {
+		n += 1 + l + sovItem(uint64(l))
+	}
+	l = len(m.Updater)
+	if l > 0 {
+		n += 1 + l + sovItem(uint64(l))
+	}
+	if len(m.OriginalDoubles) > 0 {
+		for _, e := range m.OriginalDoubles {
+			l = e.Size()
+			n += 1 + l + sovItem(uint64(l))
+		}
+	}
+	if len(m.OriginalLongs) > 0 {
+		for _, e := range m.OriginalLongs {
+			l = e.Size()
+			n += 1 + l + sovItem(uint64(l))
+		}
+	}
+	if len(m.OriginalStrings) > 0 {
+		for _, e := range m.OriginalStrings {
+			l = e.Size()
+			n += 1 + l + sovItem(uint64(l))
+		}
+	}
+	if len(m.Doubles) > 0 {
+		for _, e := range m.Doubles {
+			l = e.Size()
+			n += 1 + l + sovItem(uint64(l))
+		}
+	}
+	if len(m.Longs) > 0 {
+		for _, e := range m.Longs {
+			l = e.Size()
+			n += 1 + l + sovItem(uint64(l))
+		}
+	}
+	if len(m.Strings) > 0 {
+		for _, e := range m.Strings {
+			l = e.Size()
+			n += 1 + l + sovItem(uint64(l))
+		}
+	}
+	if m.BlockHeight != 0 {
+		n += 1 + sovItem(uint64(m.BlockHeight))
+	}
+	if m.CreatedAt != 0 {
+		n += 1 + sovItem(uint64(m.CreatedAt))
+	}
+	return n
+}
+
+func (m *ItemProvenance) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.CookbookId)
+	if l > 0 {
+		n += 1 + l + sovItem(uint64(l))
+	}
+	l = len(m.Id)
+	if l > 0 {
+		n += 1 + l + sovItem(uint64(l))
+	}
+	l = len(m.Event)
+	if l > 0 {
+		n += 1 + l + sovItem(uint64(l))
+	}
+	l = len(m.From)
+	if l > 0 {
+		n += 1 + l + sovItem(uint64(l))
+	}
+	l = len(m.To)
+	if l > 0 {
+		n += 1 + l + sovItem(uint64(l))
+	}
+	l = len(m.RecipeId)
+	if l > 0 {
+		n += 1 + l + sovItem(uint64(l))
+	}
+	l = len(m.ExecutionId)
+	if l > 0 {
+		n += 1 + l + sovItem(uint64(l))
+	}
+	if m.TradeId != 0 {
+		n += 1 + sovItem(uint64(m.TradeId))
+	}
+	if len(m.Price) > 0 {
+		for _, e := range m.Price {
+			l = e.Size()
+			n += 1 + l + sovItem(uint64(l))
+		}
+	}
+	if len(m.Doubles) > 0 {
+		for _, e := range m.Doubles {
+			l = e.Size()
+			n += 1 + l + sovItem(uint64(l))
+		}
+	}
+	if len(m.Longs) > 0 {
+		for _, e := range m.Longs {
+			l = e.Size()
+			n += 1 + l + sovItem(uint64(l))
+		}
+	}
+	if len(m.Strings) > 0 {
+		for _, e := range m.Strings {
+			l = e.Size()
+			n += 1 + l + sovItem(uint64(l))
+		}
+	}
+	if len(m.MutableStrings) > 0 {
+		for _, e := range m.MutableStrings {
+			l = e.Size()
+			n += 1 + l + sovItem(uint64(l))
+		}
+	}
+	l = len(m.Locker)
+	if l > 0 {
+		n += 1 + l + sovItem(uint64(l))
+	}
+	if m.BlockHeight != 0 {
+		n += 1 + sovItem(uint64(m.BlockHeight))
+	}
+	if m.CreatedAt != 0 {
+		n += 2 + sovItem(uint64(m.CreatedAt))
+	}
+	return n
+}
+
+func sovItem(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozItem(x uint64) (n int) {
+	return sovItem(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *DoubleKeyValue) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowItem
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DoubleKeyValue: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DoubleKeyValue: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Key", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowItem
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthItem
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthItem
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Key = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Value", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowItem
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthItem
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthItem
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Value.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipItem(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthItem
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *LongKeyValue) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowItem
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: LongKeyValue: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: LongKeyValue: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Key", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowItem
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthItem
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthItem
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Key = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Value", wireType)
+			}
+			m.Value = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowItem
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Value |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipItem(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthItem
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *StringKeyValue) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowItem
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: StringKeyValue: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: StringKeyValue: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Key", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowItem
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthItem
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthItem
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Key = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Value", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowItem
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthItem
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthItem
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Value = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipItem(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthItem
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Item) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowItem
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Item: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Item: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Owner", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowItem
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthItem
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthItem
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Owner = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CookbookId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowItem
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthItem
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthItem
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CookbookId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowItem
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthItem
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthItem
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Id = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NodeVersion", wireType)
+			}
+			m.NodeVersion = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowItem
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.NodeVersion |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Doubles", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowItem
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthItem
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthItem
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Doubles = append(m.Doubles, DoubleKeyValue{})
+			if err := m.Doubles[len(m.Doubles)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Key", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Longs", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowItem
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthItem
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthItem
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Longs = append(m.Longs, LongKeyValue{})
+			if err := m.Longs[len(m.Longs)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Strings", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowItem
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthItem
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthItem
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Strings = append(m.Strings, StringKeyValue{})
+			if err := m.Strings[len(m.Strings)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MutableStrings", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowItem
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthItem
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthItem
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MutableStrings = append(m.MutableStrings, StringKeyValue{})
+			if err := m.MutableStrings[len(m.MutableStrings)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 9:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Tradeable", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowItem
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Tradeable = bool(v != 0)
+		case 10:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LastUpdate", wireType)
+			}
+			m.LastUpdate = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowItem
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.LastUpdate |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TransferFee", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowItem
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthItem
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthItem
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TransferFee = append(m.TransferFee, types.Coin{})
+			if err := m.TransferFee[len(m.TransferFee)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 12:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TradePercentage", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowItem
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthItem
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthItem
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.TradePercentage.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 13:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CreatedAt", wireType)
+			}
+			m.CreatedAt = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowItem
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.CreatedAt |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 14:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UpdatedAt", wireType)
+			}
+			m.UpdatedAt = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowItem
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.UpdatedAt |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 15:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RecipeId", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1008,11 +2805,88 @@ func (m *DoubleKeyValue) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Key = string(dAtA[iNdEx:postIndex])
+			m.RecipeId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 2:
+		case 16:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Fungible", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowItem
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Fungible = bool(v != 0)
+		case 17:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Quantity", wireType)
+			}
+			m.Quantity = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowItem
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Quantity |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 18:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExpiresAtHeight", wireType)
+			}
+			m.ExpiresAtHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowItem
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ExpiresAtHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 19:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExpiresAt", wireType)
+			}
+			m.ExpiresAt = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowItem
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ExpiresAt |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 20:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Value", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ContainerId", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1040,10 +2914,79 @@ func (m *DoubleKeyValue) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Value.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.ContainerId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 21:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TransferPolicy", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowItem
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthItem
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthItem
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.TransferPolicy.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
+		case 22:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TransferCount", wireType)
+			}
+			m.TransferCount = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowItem
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TransferCount |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 23:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LastTransferHeight", wireType)
+			}
+			m.LastTransferHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowItem
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.LastTransferHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipItem(dAtA[iNdEx:])
@@ -1065,7 +3008,7 @@ func (m *DoubleKeyValue) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *LongKeyValue) Unmarshal(dAtA []byte) error {
+func (m *ItemTransferPolicy) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1088,17 +3031,17 @@ func (m *LongKeyValue) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: LongKeyValue: wiretype end group for non-group")
+			return fmt.Errorf("proto: ItemTransferPolicy: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: LongKeyValue: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ItemTransferPolicy: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Key", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Soulbound", wireType)
 			}
-			var stringLen uint64
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowItem
@@ -1108,29 +3051,36 @@ func (m *LongKeyValue) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				v |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthItem
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthItem
+			m.Soulbound = bool(v != 0)
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxTransfers", wireType)
 			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
+			m.MaxTransfers = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowItem
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxTransfers |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			m.Key = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 2:
+		case 3:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Value", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field LockBlocks", wireType)
 			}
-			m.Value = 0
+			m.LockBlocks = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowItem
@@ -1140,7 +3090,7 @@ func (m *LongKeyValue) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Value |= int64(b&0x7F) << shift
+				m.LockBlocks |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
@@ -1166,7 +3116,7 @@ func (m *LongKeyValue) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *StringKeyValue) Unmarshal(dAtA []byte) error {
+func (m *ItemHistory) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1189,15 +3139,15 @@ func (m *StringKeyValue) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: StringKeyValue: wiretype end group for non-group")
+			return fmt.Errorf("proto: ItemHistory: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: StringKeyValue: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ItemHistory: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
-		case 1:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Key", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field CookbookId", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1225,11 +3175,75 @@ func (m *StringKeyValue) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Key = string(dAtA[iNdEx:postIndex])
+			m.CookbookId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 2:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Value", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowItem
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthItem
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthItem
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Id = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field From", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowItem
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthItem
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthItem
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.From = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field To", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1257,8 +3271,27 @@ func (m *StringKeyValue) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Value = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
+			m.To = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CreatedAt", wireType)
+			}
+			m.CreatedAt = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowItem
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.CreatedAt |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipItem(dAtA[iNdEx:])
@@ -1280,7 +3313,7 @@ func (m *StringKeyValue) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *Item) Unmarshal(dAtA []byte) error {
+func (m *ItemAttributesHistory) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1303,15 +3336,15 @@ func (m *Item) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Item: wiretype end group for non-group")
+			return fmt.Errorf("proto: ItemAttributesHistory: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Item: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ItemAttributesHistory: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Owner", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field CookbookId", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1339,11 +3372,11 @@ func (m *Item) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Owner = string(dAtA[iNdEx:postIndex])
+			m.CookbookId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CookbookId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1371,11 +3404,11 @@ func (m *Item) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.CookbookId = string(dAtA[iNdEx:postIndex])
+			m.Id = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Updater", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1403,13 +3436,13 @@ func (m *Item) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Id = string(dAtA[iNdEx:postIndex])
+			m.Updater = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 4:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field NodeVersion", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OriginalDoubles", wireType)
 			}
-			m.NodeVersion = 0
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowItem
@@ -1419,12 +3452,95 @@ func (m *Item) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.NodeVersion |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			if msglen < 0 {
+				return ErrInvalidLengthItem
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthItem
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.OriginalDoubles = append(m.OriginalDoubles, DoubleKeyValue{})
+			if err := m.OriginalDoubles[len(m.OriginalDoubles)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OriginalLongs", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowItem
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthItem
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthItem
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.OriginalLongs = append(m.OriginalLongs, LongKeyValue{})
+			if err := m.OriginalLongs[len(m.OriginalLongs)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OriginalStrings", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowItem
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthItem
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthItem
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.OriginalStrings = append(m.OriginalStrings, StringKeyValue{})
+			if err := m.OriginalStrings[len(m.OriginalStrings)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field Doubles", wireType)
 			}
@@ -1458,7 +3574,7 @@ func (m *Item) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
-		case 6:
+		case 8:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field Longs", wireType)
 			}
@@ -1492,7 +3608,7 @@ func (m *Item) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
-		case 7:
+		case 9:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field Strings", wireType)
 			}
@@ -1526,11 +3642,163 @@ func (m *Item) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
-		case 8:
+		case 10:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlockHeight", wireType)
+			}
+			m.BlockHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowItem
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BlockHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 11:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CreatedAt", wireType)
+			}
+			m.CreatedAt = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowItem
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.CreatedAt |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipItem(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthItem
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ItemProvenance) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowItem
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ItemProvenance: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ItemProvenance: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CookbookId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowItem
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthItem
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthItem
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CookbookId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowItem
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthItem
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthItem
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Id = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MutableStrings", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Event", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowItem
@@ -1540,31 +3808,29 @@ func (m *Item) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthItem
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthItem
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.MutableStrings = append(m.MutableStrings, StringKeyValue{})
-			if err := m.MutableStrings[len(m.MutableStrings)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Event = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 9:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Tradeable", wireType)
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field From", wireType)
 			}
-			var v int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowItem
@@ -1574,17 +3840,29 @@ func (m *Item) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.Tradeable = bool(v != 0)
-		case 10:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field LastUpdate", wireType)
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthItem
 			}
-			m.LastUpdate = 0
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthItem
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.From = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field To", wireType)
+			}
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowItem
@@ -1594,16 +3872,29 @@ func (m *Item) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.LastUpdate |= int64(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 11:
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthItem
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthItem
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.To = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TransferFee", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field RecipeId", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowItem
@@ -1613,29 +3904,27 @@ func (m *Item) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthItem
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthItem
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.TransferFee = append(m.TransferFee, types.Coin{})
-			if err := m.TransferFee[len(m.TransferFee)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.RecipeId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 12:
+		case 7:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TradePercentage", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ExecutionId", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1663,15 +3952,13 @@ func (m *Item) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.TradePercentage.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.ExecutionId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 13:
+		case 8:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CreatedAt", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TradeId", wireType)
 			}
-			m.CreatedAt = 0
+			m.TradeId = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowItem
@@ -1681,16 +3968,16 @@ func (m *Item) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.CreatedAt |= int64(b&0x7F) << shift
+				m.TradeId |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 14:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field UpdatedAt", wireType)
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Price", wireType)
 			}
-			m.UpdatedAt = 0
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowItem
@@ -1700,16 +3987,31 @@ func (m *Item) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.UpdatedAt |= int64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 15:
+			if msglen < 0 {
+				return ErrInvalidLengthItem
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthItem
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Price = append(m.Price, types.Coin{})
+			if err := m.Price[len(m.Price)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 10:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RecipeId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Doubles", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowItem
@@ -1719,79 +4021,31 @@ func (m *Item) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthItem
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthItem
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.RecipeId = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipItem(dAtA[iNdEx:])
-			if err != nil {
+			m.Doubles = append(m.Doubles, DoubleKeyValue{})
+			if err := m.Doubles[len(m.Doubles)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthItem
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *ItemHistory) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowItem
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: ItemHistory: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ItemHistory: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 2:
+			iNdEx = postIndex
+		case 11:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CookbookId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Longs", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowItem
@@ -1801,29 +4055,31 @@ func (m *ItemHistory) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthItem
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthItem
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.CookbookId = string(dAtA[iNdEx:postIndex])
+			m.Longs = append(m.Longs, LongKeyValue{})
+			if err := m.Longs[len(m.Longs)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 3:
+		case 12:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Strings", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowItem
@@ -1833,29 +4089,31 @@ func (m *ItemHistory) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthItem
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthItem
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Id = string(dAtA[iNdEx:postIndex])
+			m.Strings = append(m.Strings, StringKeyValue{})
+			if err := m.Strings[len(m.Strings)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 4:
+		case 13:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field From", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field MutableStrings", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowItem
@@ -1865,27 +4123,29 @@ func (m *ItemHistory) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthItem
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthItem
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.From = string(dAtA[iNdEx:postIndex])
+			m.MutableStrings = append(m.MutableStrings, StringKeyValue{})
+			if err := m.MutableStrings[len(m.MutableStrings)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 5:
+		case 14:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field To", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Locker", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1913,9 +4173,28 @@ func (m *ItemHistory) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.To = string(dAtA[iNdEx:postIndex])
+			m.Locker = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 6:
+		case 15:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlockHeight", wireType)
+			}
+			m.BlockHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowItem
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BlockHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 16:
 			if wireType != 0 {
 				return fmt.Errorf("proto: wrong wireType = %d for field CreatedAt", wireType)
 			}
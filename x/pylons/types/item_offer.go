@@ -0,0 +1,22 @@
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// MaxExpiredItemOffersPerBlock bounds the number of expired item offers cancelled at the end of each block
+const MaxExpiredItemOffersPerBlock = 100
+
+// Reasons of the cancellation of an item offer
+const (
+	ItemOfferCancelReasonCancelled = "cancelled"
+	ItemOfferCancelReasonExpired   = "expired"
+)
+
+// IsExpired checks if the item offer reached its expiry height or time
+func (o ItemOffer) IsExpired(ctx sdk.Context) bool {
+	if o.ExpiresAtHeight != 0 && ctx.BlockHeight() >= o.ExpiresAtHeight {
+		return true
+	}
+	return o.ExpiresAt != 0 && ctx.BlockTime().Unix() >= o.ExpiresAt
+}
@@ -0,0 +1,658 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: pylons/pylons/item_offer.proto
+
+package types
+
+import (
+	fmt "fmt"
+	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
+	types "github.com/cosmos/cosmos-sdk/types"
+	_ "github.com/gogo/protobuf/gogoproto"
+	proto "github.com/gogo/protobuf/proto"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// ItemOffer escrows the coins of a buyer to buy an item of a cookbook from its owner
+type ItemOffer struct {
+	Id         uint64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Creator    string `protobuf:"bytes,2,opt,name=creator,proto3" json:"creator,omitempty"`
+	CookbookId string `protobuf:"bytes,3,opt,name=cookbook_id,json=cookbookId,proto3" json:"cookbook_id,omitempty"`
+	// id of the wanted item, any item of the cookbook matching item_input can be sold when empty
+	ItemId    string                                   `protobuf:"bytes,4,opt,name=item_id,json=itemId,proto3" json:"item_id,omitempty"`
+	ItemInput ItemInput                                `protobuf:"bytes,5,opt,name=item_input,json=itemInput,proto3" json:"item_input"`
+	Price     github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,6,rep,name=price,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"price"`
+	// block height at which the offer is cancelled, 0 if the offer does not expire by height
+	ExpiresAtHeight int64 `protobuf:"varint,7,opt,name=expires_at_height,json=expiresAtHeight,proto3" json:"expires_at_height,omitempty"`
+	// unix time at which the offer is cancelled, 0 if the offer does not expire by time
+	ExpiresAt int64 `protobuf:"varint,8,opt,name=expires_at,json=expiresAt,proto3" json:"expires_at,omitempty"`
+}
+
+func (m *ItemOffer) Reset()         { *m = ItemOffer{} }
+func (m *ItemOffer) String() string { return proto.CompactTextString(m) }
+func (*ItemOffer) ProtoMessage()    {}
+func (*ItemOffer) Descriptor() ([]byte, []int) {
+	return fileDescriptor_19d2f7a7bc2e7e50, []int{0}
+}
+func (m *ItemOffer) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ItemOffer) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ItemOffer.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ItemOffer) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ItemOffer.Merge(m, src)
+}
+func (m *ItemOffer) XXX_Size() int {
+	return m.Size()
+}
+func (m *ItemOffer) XXX_DiscardUnknown() {
+	xxx_messageInfo_ItemOffer.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ItemOffer proto.InternalMessageInfo
+
+func (m *ItemOffer) GetId() uint64 {
+	if m != nil {
+		return m.Id
+	}
+	return 0
+}
+
+func (m *ItemOffer) GetCreator() string {
+	if m != nil {
+		return m.Creator
+	}
+	return ""
+}
+
+func (m *ItemOffer) GetCookbookId() string {
+	if m != nil {
+		return m.CookbookId
+	}
+	return ""
+}
+
+func (m *ItemOffer) GetItemId() string {
+	if m != nil {
+		return m.ItemId
+	}
+	return ""
+}
+
+func (m *ItemOffer) GetItemInput() ItemInput {
+	if m != nil {
+		return m.ItemInput
+	}
+	return ItemInput{}
+}
+
+func (m *ItemOffer) GetPrice() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.Price
+	}
+	return nil
+}
+
+func (m *ItemOffer) GetExpiresAtHeight() int64 {
+	if m != nil {
+		return m.ExpiresAtHeight
+	}
+	return 0
+}
+
+func (m *ItemOffer) GetExpiresAt() int64 {
+	if m != nil {
+		return m.ExpiresAt
+	}
+	return 0
+}
+
+func init() {
+	proto.RegisterType((*ItemOffer)(nil), "pylons.pylons.ItemOffer")
+}
+
+func init() { proto.RegisterFile("pylons/pylons/item_offer.proto", fileDescriptor_19d2f7a7bc2e7e50) }
+
+var fileDescriptor_19d2f7a7bc2e7e50 = []byte{
+	// 386 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x54, 0x51, 0xc1, 0x8e, 0xd3, 0x30,
+	0x10, 0x8d, 0xd3, 0x6e, 0x4b, 0x5c, 0x01, 0xc2, 0x42, 0xc2, 0x54, 0xc2, 0x8d, 0x38, 0x45, 0x88,
+	0x75, 0xd8, 0xe5, 0xcc, 0x81, 0x22, 0x21, 0x72, 0x02, 0xe5, 0xc8, 0x25, 0x4a, 0x1c, 0x6f, 0x62,
+	0x95, 0x64, 0xa2, 0xd8, 0x8b, 0x76, 0xff, 0x82, 0xef, 0xe0, 0x4b, 0xf6, 0xb8, 0x47, 0x0e, 0x08,
+	0x50, 0xfb, 0x23, 0x28, 0x76, 0x02, 0xdb, 0xd3, 0x8c, 0xdf, 0x7b, 0xe3, 0x99, 0x79, 0x83, 0x59,
+	0x77, 0xfd, 0x05, 0x5a, 0x1d, 0x8f, 0x41, 0x19, 0xd9, 0x64, 0x70, 0x71, 0x21, 0x7b, 0xde, 0xf5,
+	0x60, 0x80, 0xdc, 0x77, 0x04, 0x77, 0x61, 0xcd, 0x04, 0xe8, 0x06, 0x74, 0x5c, 0xe4, 0x5a, 0xc6,
+	0x5f, 0xcf, 0x0a, 0x69, 0xf2, 0xb3, 0x58, 0x80, 0x6a, 0x9d, 0x7c, 0xfd, 0xb8, 0x82, 0x0a, 0x6c,
+	0x1a, 0x0f, 0xd9, 0x88, 0xae, 0x8f, 0x9b, 0xf4, 0x52, 0xa8, 0x4e, 0x3a, 0xee, 0xf9, 0x4f, 0x1f,
+	0x07, 0x89, 0x91, 0xcd, 0xc7, 0xa1, 0x29, 0x79, 0x80, 0x7d, 0x55, 0x52, 0x14, 0xa2, 0x68, 0x9e,
+	0xfa, 0xaa, 0x24, 0x14, 0x2f, 0x45, 0x2f, 0x73, 0x03, 0x3d, 0xf5, 0x43, 0x14, 0x05, 0xe9, 0xf4,
+	0x24, 0x1b, 0xbc, 0x12, 0x00, 0xbb, 0x02, 0x60, 0x97, 0xa9, 0x92, 0xce, 0x2c, 0x8b, 0x27, 0x28,
+	0x29, 0xc9, 0x13, 0xbc, 0xb4, 0xdb, 0xa8, 0x92, 0xce, 0x2d, 0xb9, 0x18, 0x9e, 0x49, 0x49, 0xde,
+	0x60, 0xec, 0x88, 0xb6, 0xbb, 0x34, 0xf4, 0x24, 0x44, 0xd1, 0xea, 0x9c, 0xf2, 0xa3, 0x3d, 0xf9,
+	0x30, 0x51, 0x32, 0xf0, 0xdb, 0xf9, 0xcd, 0xaf, 0x8d, 0x97, 0x06, 0x6a, 0x02, 0x48, 0x8e, 0x4f,
+	0xba, 0x5e, 0x09, 0x49, 0x17, 0xe1, 0x2c, 0x5a, 0x9d, 0x3f, 0xe5, 0xce, 0x12, 0x3e, 0x58, 0xc2,
+	0x47, 0x4b, 0xf8, 0x3b, 0x50, 0xed, 0xf6, 0xd5, 0x50, 0xfa, 0xfd, 0xf7, 0x26, 0xaa, 0x94, 0xa9,
+	0x2f, 0x0b, 0x2e, 0xa0, 0x89, 0x47, 0xff, 0x5c, 0x38, 0xd5, 0xe5, 0x2e, 0x36, 0xd7, 0x9d, 0xd4,
+	0xb6, 0x40, 0xa7, 0xee, 0x67, 0xf2, 0x02, 0x3f, 0x92, 0x57, 0x9d, 0xea, 0xa5, 0xce, 0x72, 0x93,
+	0xd5, 0x52, 0x55, 0xb5, 0xa1, 0xcb, 0x10, 0x45, 0xb3, 0xf4, 0xe1, 0x48, 0xbc, 0x35, 0x1f, 0x2c,
+	0x4c, 0x9e, 0x61, 0xfc, 0x5f, 0x4b, 0xef, 0x59, 0x51, 0xf0, 0x4f, 0xb4, 0x7d, 0x7f, 0xb3, 0x67,
+	0xe8, 0x76, 0xcf, 0xd0, 0x9f, 0x3d, 0x43, 0xdf, 0x0e, 0xcc, 0xbb, 0x3d, 0x30, 0xef, 0xc7, 0x81,
+	0x79, 0x9f, 0x5f, 0xde, 0x99, 0xea, 0x93, 0xdd, 0xfa, 0xd4, 0x48, 0x51, 0x4f, 0x47, 0xba, 0x9a,
+	0x12, 0x3b, 0x5f, 0xb1, 0xb0, 0xd7, 0x7a, 0xfd, 0x77, 0x00, 0xf8, 0xd7, 0xc2, 0x28, 0x30, 0x02,
+	0x00, 0x00,
+}
+
+func (m *ItemOffer) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ItemOffer) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ItemOffer) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.ExpiresAt != 0 {
+		i = encodeVarintItemOffer(dAtA, i, uint64(m.ExpiresAt))
+		i--
+		dAtA[i] = 0x40
+	}
+	if m.ExpiresAtHeight != 0 {
+		i = encodeVarintItemOffer(dAtA, i, uint64(m.ExpiresAtHeight))
+		i--
+		dAtA[i] = 0x38
+	}
+	if len(m.Price) > 0 {
+		for iNdEx := len(m.Price) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Price[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintItemOffer(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x32
+		}
+	}
+	{
+		size, err := m.ItemInput.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintItemOffer(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x2a
+	if len(m.ItemId) > 0 {
+		i -= len(m.ItemId)
+		copy(dAtA[i:], m.ItemId)
+		i = encodeVarintItemOffer(dAtA, i, uint64(len(m.ItemId)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.CookbookId) > 0 {
+		i -= len(m.CookbookId)
+		copy(dAtA[i:], m.CookbookId)
+		i = encodeVarintItemOffer(dAtA, i, uint64(len(m.CookbookId)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Creator) > 0 {
+		i -= len(m.Creator)
+		copy(dAtA[i:], m.Creator)
+		i = encodeVarintItemOffer(dAtA, i, uint64(len(m.Creator)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Id != 0 {
+		i = encodeVarintItemOffer(dAtA, i, uint64(m.Id))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintItemOffer(dAtA []byte, offset int, v uint64) int {
+	offset -= sovItemOffer(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *ItemOffer) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Id != 0 {
+		n += 1 + sovItemOffer(uint64(m.Id))
+	}
+	l = len(m.Creator)
+	if l > 0 {
+		n += 1 + l + sovItemOffer(uint64(l))
+	}
+	l = len(m.CookbookId)
+	if l > 0 {
+		n += 1 + l + sovItemOffer(uint64(l))
+	}
+	l = len(m.ItemId)
+	if l > 0 {
+		n += 1 + l + sovItemOffer(uint64(l))
+	}
+	l = m.ItemInput.Size()
+	n += 1 + l + sovItemOffer(uint64(l))
+	if len(m.Price) > 0 {
+		for _, e := range m.Price {
+			l = e.Size()
+			n += 1 + l + sovItemOffer(uint64(l))
+		}
+	}
+	if m.ExpiresAtHeight != 0 {
+		n += 1 + sovItemOffer(uint64(m.ExpiresAtHeight))
+	}
+	if m.ExpiresAt != 0 {
+		n += 1 + sovItemOffer(uint64(m.ExpiresAt))
+	}
+	return n
+}
+
+func sovItemOffer(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozItemOffer(x uint64) (n int) {
+	return sovItemOffer(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *ItemOffer) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowItemOffer
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ItemOffer: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ItemOffer: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			m.Id = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowItemOffer
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Id |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowItemOffer
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthItemOffer
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthItemOffer
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Creator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CookbookId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowItemOffer
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthItemOffer
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthItemOffer
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CookbookId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ItemId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowItemOffer
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthItemOffer
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthItemOffer
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ItemId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ItemInput", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowItemOffer
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthItemOffer
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthItemOffer
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ItemInput.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Price", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowItemOffer
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthItemOffer
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthItemOffer
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Price = append(m.Price, types.Coin{})
+			if err := m.Price[len(m.Price)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExpiresAtHeight", wireType)
+			}
+			m.ExpiresAtHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowItemOffer
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ExpiresAtHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExpiresAt", wireType)
+			}
+			m.ExpiresAt = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowItemOffer
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ExpiresAt |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipItemOffer(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthItemOffer
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipItemOffer(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowItemOffer
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowItemOffer
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowItemOffer
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthItemOffer
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupItemOffer
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthItemOffer
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthItemOffer        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowItemOffer          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupItemOffer = fmt.Errorf("proto: unexpected end of group")
+)
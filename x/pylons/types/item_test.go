@@ -9,6 +9,7 @@ import (
 	sdkerrors "cosmossdk.io/errors"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/google/cel-go/cel"
 
 	"github.com/stretchr/testify/require"
 )
@@ -763,6 +764,67 @@ func TestMatchItem(t *testing.T) {
 			},
 			expectedError: sdkerrors.Wrapf(ErrItemMatch, "%s key value does not match: item_id=%s", "stringtwo", "test1"),
 		},
+		{
+			desc: "Cookbook Match Successful",
+			itemInputToMatch: ItemInput{
+				Id:         "test1",
+				CookbookId: "swords",
+			},
+			itemToMatch: Item{
+				Id:         "test1",
+				CookbookId: "swords",
+			},
+			expectedError: nil,
+		},
+		{
+			desc: "Cookbook Does Not Match",
+			itemInputToMatch: ItemInput{
+				Id:         "test1",
+				CookbookId: "swords",
+			},
+			itemToMatch: Item{
+				Id:         "test1",
+				CookbookId: "shields",
+			},
+			expectedError: sdkerrors.Wrapf(ErrItemMatch, "item is not from cookbook %s: item_id=%s", "swords", "test1"),
+		},
+		{
+			desc: "Amount Match Successful",
+			itemInputToMatch: ItemInput{
+				Id:     "test1",
+				Amount: 5,
+			},
+			itemToMatch: Item{
+				Id:       "test1",
+				Fungible: true,
+				Quantity: 10,
+			},
+			expectedError: nil,
+		},
+		{
+			desc: "Amount On Non Fungible Item",
+			itemInputToMatch: ItemInput{
+				Id:     "test1",
+				Amount: 5,
+			},
+			itemToMatch: Item{
+				Id: "test1",
+			},
+			expectedError: sdkerrors.Wrapf(ErrItemMatch, "item is not fungible: item_id=%s", "test1"),
+		},
+		{
+			desc: "Amount Greater Than Quantity",
+			itemInputToMatch: ItemInput{
+				Id:     "test1",
+				Amount: 15,
+			},
+			itemToMatch: Item{
+				Id:       "test1",
+				Fungible: true,
+				Quantity: 10,
+			},
+			expectedError: sdkerrors.Wrapf(ErrItemMatch, "item quantity %d lower than required amount %d: item_id=%s", 10, 15, "test1"),
+		},
 	} {
 		tc := tc
 		t.Run(tc.desc, func(t *testing.T) {
@@ -776,3 +838,112 @@ func TestMatchItem(t *testing.T) {
 		})
 	}
 }
+
+func TestMatchItemCondition(t *testing.T) {
+	item := Item{
+		Id:         "test1",
+		CookbookId: "swords",
+		Longs:      []LongKeyValue{{Key: "attack", Value: 40}},
+		Strings:    []StringKeyValue{{Key: "kind", Value: "sword"}},
+	}
+	varDefs, variables := AddVariableFromItem(BasicVarDefs(), BasicVariables(1, "", "0"), "", item)
+	env, err := cel.NewEnv(cel.Declarations(varDefs...))
+	require.NoError(t, err)
+	ec := NewCelEnvCollection(env, variables, cel.Functions()) //nolint:staticcheck
+
+	for _, tc := range []struct {
+		desc          string
+		condition     string
+		expectedError error
+	}{
+		{desc: "Match Successful", condition: `kind == "sword" && attack > 30`},
+		{desc: "Condition False", condition: "attack > 50", expectedError: ErrItemMatch},
+		{desc: "Not A Bool", condition: "attack + 1", expectedError: ErrItemMatch},
+		{desc: "Unknown Attribute", condition: "defense > 30", expectedError: ErrItemMatch},
+	} {
+		tc := tc
+		t.Run(tc.desc, func(t *testing.T) {
+			err := ItemInput{Id: "test1", CookbookId: "swords", Condition: tc.condition}.MatchItem(item, ec)
+			if tc.expectedError != nil {
+				require.ErrorIs(t, err, tc.expectedError)
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestItemCanTransfer(t *testing.T) {
+	ctx := sdk.Context{}.WithBlockHeight(100)
+	for _, tc := range []struct {
+		desc string
+		item Item
+		err  error
+	}{
+		{
+			desc: "Unrestricted",
+			item: Item{TransferCount: 10, LastTransferHeight: 99},
+		},
+		{
+			desc: "Soulbound",
+			item: Item{TransferPolicy: ItemTransferPolicy{Soulbound: true}},
+			err:  ErrItemTransferRestricted,
+		},
+		{
+			desc: "BelowMaxTransfers",
+			item: Item{TransferPolicy: ItemTransferPolicy{MaxTransfers: 2}, TransferCount: 1},
+		},
+		{
+			desc: "MaxTransfersReached",
+			item: Item{TransferPolicy: ItemTransferPolicy{MaxTransfers: 2}, TransferCount: 2},
+			err:  ErrItemTransferRestricted,
+		},
+		{
+			desc: "Locked",
+			item: Item{TransferPolicy: ItemTransferPolicy{LockBlocks: 10}, LastTransferHeight: 91},
+			err:  ErrItemTransferRestricted,
+		},
+		{
+			desc: "LockEnded",
+			item: Item{TransferPolicy: ItemTransferPolicy{LockBlocks: 10}, LastTransferHeight: 90},
+		},
+	} {
+		tc := tc
+		t.Run(tc.desc, func(t *testing.T) {
+			err := tc.item.CanTransfer(ctx)
+			if tc.err != nil {
+				require.ErrorIs(t, err, tc.err)
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+
+	item := Item{TransferPolicy: ItemTransferPolicy{MaxTransfers: 1, LockBlocks: 10}}
+	item.RecordTransfer(ctx)
+	require.Equal(t, uint64(1), item.TransferCount)
+	require.Equal(t, int64(100), item.LastTransferHeight)
+	require.ErrorIs(t, item.CanTransfer(ctx.WithBlockHeight(200)), ErrItemTransferRestricted)
+}
+
+func TestItemProvenanceSetChangedAttributes(t *testing.T) {
+	prev := Item{
+		Doubles:        []DoubleKeyValue{{Key: "speed", Value: sdk.NewDec(1)}, {Key: "weight", Value: sdk.NewDec(2)}},
+		Longs:          []LongKeyValue{{Key: "level", Value: 1}},
+		Strings:        []StringKeyValue{{Key: "name", Value: "sword"}},
+		MutableStrings: []StringKeyValue{{Key: "nickname", Value: "old"}},
+	}
+	it := Item{
+		Doubles:        []DoubleKeyValue{{Key: "speed", Value: sdk.NewDec(1)}, {Key: "weight", Value: sdk.NewDec(3)}},
+		Longs:          []LongKeyValue{{Key: "level", Value: 1}, {Key: "xp", Value: 10}},
+		Strings:        []StringKeyValue{{Key: "name", Value: "sword"}},
+		MutableStrings: []StringKeyValue{{Key: "nickname", Value: "new"}},
+	}
+
+	var provenance ItemProvenance
+	provenance.SetChangedAttributes(prev, it)
+	require.Equal(t, []DoubleKeyValue{{Key: "weight", Value: sdk.NewDec(3)}}, provenance.Doubles)
+	require.Equal(t, []LongKeyValue{{Key: "xp", Value: 10}}, provenance.Longs)
+	require.Empty(t, provenance.Strings)
+	require.Equal(t, []StringKeyValue{{Key: "nickname", Value: "new"}}, provenance.MutableStrings)
+}
@@ -1,5 +1,11 @@
 package types
 
+import (
+	"encoding/binary"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
 const (
 	// ModuleName defines the module name
 	ModuleName = "pylons"
@@ -25,6 +31,56 @@ func KeyPrefix(p string) []byte {
 	return []byte(p)
 }
 
+// CookbookItemIndexPrefix returns the prefix of the cookbook items index keys of a cookbook
+func CookbookItemIndexPrefix(cookbookID string) []byte {
+	return []byte(cookbookID + "-")
+}
+
+// RecipeItemIndexPrefix returns the prefix of the recipe items index keys of a recipe
+func RecipeItemIndexPrefix(cookbookID, recipeID string) []byte {
+	return []byte(cookbookID + "-" + recipeID + "-")
+}
+
+// ContainerItemIndexPrefix returns the prefix of the container items index keys of a container item
+func ContainerItemIndexPrefix(cookbookID, containerID string) []byte {
+	return []byte(cookbookID + "-" + containerID + "-")
+}
+
+// RecipeStatsStoreKey returns the key of the stats of a recipe
+func RecipeStatsStoreKey(cookbookID, recipeID string) []byte {
+	return []byte(cookbookID + "-" + recipeID)
+}
+
+// RecipeExecutorIndexPrefix returns the prefix of the executors index keys of a recipe
+func RecipeExecutorIndexPrefix(cookbookID, recipeID string) []byte {
+	return []byte(cookbookID + "-" + recipeID + "-")
+}
+
+// CookbookExecutorIndexPrefix returns the prefix of the executors index keys of a cookbook
+func CookbookExecutorIndexPrefix(cookbookID string) []byte {
+	return []byte(cookbookID + "-")
+}
+
+// CookbookTradeIndexPrefix returns the prefix of the cookbook trades index keys of a cookbook
+func CookbookTradeIndexPrefix(cookbookID string) []byte {
+	return []byte(cookbookID + "-")
+}
+
+// PriceTradeIndexPrefix returns the prefix of the price trades index keys of a denom, denoms cannot contain a 0 byte
+func PriceTradeIndexPrefix(denom string) []byte {
+	return append([]byte(denom), 0)
+}
+
+// PriceTradeIndexKey returns the price trades index key of a trade, the price is prefixed by its length so the byte
+// order of the keys is the order of the prices
+func PriceTradeIndexKey(denom string, price sdk.Int, tradeID uint64) []byte {
+	magnitude := price.BigInt().Bytes()
+	id := make([]byte, 8)
+	binary.BigEndian.PutUint64(id, tradeID)
+	bz := append(append(PriceTradeIndexPrefix(denom), byte(len(magnitude))), magnitude...)
+	return append(bz, id...)
+}
+
 const (
 	// CookbookKey is a string key used as a prefix to the KVStore
 	CookbookKey = "Cookbook-value-"
@@ -42,6 +98,10 @@ const (
 	ItemCountKey = "Item-count-"
 	// AddrItemKey is a string key used as a prefix to the KVStore
 	AddrItemKey = "Address-item-"
+	// CookbookItemKey is a string key used as a prefix to the KVStore
+	CookbookItemKey = "Cookbook-item-"
+	// RecipeItemKey is a string key used as a prefix to the KVStore
+	RecipeItemKey = "Recipe-item-"
 	// ExecutionKey is a string key used as a prefix to the KVStore
 	ExecutionKey = "Execution-value-"
 	// ExecutionCountKey is a string key used as a prefix to the KVStore
@@ -68,6 +128,16 @@ const (
 	TradeKey = "Trade-value-"
 	// AddrTradeKey is a string key used as a prefix to the KVStore
 	AddrTradeKey = "Address-trade-"
+	// ReceiverTradeKey is a string key used as a prefix to the KVStore
+	ReceiverTradeKey = "Receiver-trade-"
+	// CookbookTradeKey is a string key used as a prefix to the KVStore
+	CookbookTradeKey = "Cookbook-trade-"
+	// PriceTradeKey is a string key used as a prefix to the KVStore
+	PriceTradeKey = "Price-trade-"
+	// TradeExpiryHeightKey is a string key used as a prefix to the KVStore
+	TradeExpiryHeightKey = "Trade-expiry-height-"
+	// TradeExpiryTimeKey is a string key used as a prefix to the KVStore
+	TradeExpiryTimeKey = "Trade-expiry-time-"
 	// TradeCountKey is a string used as prefix to the KVStore
 	TradeCountKey = "Trade-count-"
 	// UsernameKey is a string used as prefix to the KVStore
@@ -82,6 +152,70 @@ const (
 	CreateExecutionKey = "create_execution"
 	// StripeRefundKey used to store stripe refund records
 	StripeRefundKey = "StripeRefund-value-"
+	// RecipeStatsKey is a string key used as a prefix to the KVStore
+	RecipeStatsKey = "RecipeStats-value-"
+	// RecipeExecutorKey is a string key used as a prefix to the KVStore
+	RecipeExecutorKey = "RecipeStats-executor-"
+	// CookbookStatsKey is a string key used as a prefix to the KVStore
+	CookbookStatsKey = "CookbookStats-value-"
+	// CookbookExecutorKey is a string key used as a prefix to the KVStore
+	CookbookExecutorKey = "CookbookStats-executor-"
+	// ItemExpiryHeightKey is a string key used as a prefix to the KVStore
+	ItemExpiryHeightKey = "Item-expiry-height-"
+	// ItemExpiryTimeKey is a string key used as a prefix to the KVStore
+	ItemExpiryTimeKey = "Item-expiry-time-"
+	// ItemAttributeIndexKey is a string key used as a prefix to the KVStore
+	ItemAttributeIndexKey = "Item-attribute-index-"
+	// ItemAttributesHistoryKey is a string key used as a prefix to the KVStore
+	ItemAttributesHistoryKey = "Item-attributes-history-"
+	// ItemProvenanceKey is a string key used as a prefix to the KVStore
+	ItemProvenanceKey = "Item-provenance-"
+	// ContainerItemKey is a string key used as a prefix to the KVStore
+	ContainerItemKey = "Container-item-"
+	// LendingKey is a string key used as a prefix to the KVStore
+	LendingKey = "Lending-value-"
+	// LendingCountKey is a string key used as a prefix to the KVStore
+	LendingCountKey = "Lending-count-"
+	// ItemLendingKey is a string key used as a prefix to the KVStore
+	ItemLendingKey = "Lending-item-"
+	// LendingEndKey is a string key used as a prefix to the KVStore
+	LendingEndKey = "Lending-end-"
+	// AuctionKey is a string key used as a prefix to the KVStore
+	AuctionKey = "Auction-value-"
+	// AuctionCountKey is a string key used as a prefix to the KVStore
+	AuctionCountKey = "Auction-count-"
+	// AuctionEndKey is a string key used as a prefix to the KVStore
+	AuctionEndKey = "Auction-end-"
+	// DutchAuctionKey is a string key used as a prefix to the KVStore
+	DutchAuctionKey = "DutchAuction-value-"
+	// DutchAuctionCountKey is a string key used as a prefix to the KVStore
+	DutchAuctionCountKey = "DutchAuction-count-"
+	// DutchAuctionEndKey is a string key used as a prefix to the KVStore
+	DutchAuctionEndKey = "DutchAuction-end-"
+	// ItemOfferKey is a string key used as a prefix to the KVStore
+	ItemOfferKey = "ItemOffer-value-"
+	// ItemOfferCountKey is a string key used as a prefix to the KVStore
+	ItemOfferCountKey = "ItemOffer-count-"
+	// ItemOfferExpiryHeightKey is a string key used as a prefix to the KVStore
+	ItemOfferExpiryHeightKey = "ItemOffer-expiry-height-"
+	// ItemOfferExpiryTimeKey is a string key used as a prefix to the KVStore
+	ItemOfferExpiryTimeKey = "ItemOffer-expiry-time-"
+	// SwapKey is a string key used as a prefix to the KVStore
+	SwapKey = "Swap-value-"
+	// SwapCountKey is a string key used as a prefix to the KVStore
+	SwapCountKey = "Swap-count-"
+	// ItemApprovalKey is a string key used as a prefix to the KVStore
+	ItemApprovalKey = "Item-approval-"
+	// ItemOperatorKey is a string key used as a prefix to the KVStore
+	ItemOperatorKey = "Item-operator-"
+	// ClassTraceKey is a string key used as a prefix to the KVStore
+	ClassTraceKey = "IBC-class-trace-"
+	// ItemTokenKey is a string key used as a prefix to the KVStore
+	ItemTokenKey = "IBC-item-token-"
+	// TokenItemKey is a string key used as a prefix to the KVStore
+	TokenItemKey = "IBC-token-item-"
+	// ItemEscrowKey is a string key used as a prefix to the KVStore
+	ItemEscrowKey = "IBC-item-escrow-"
 )
 
 const (
@@ -0,0 +1,8 @@
+package types
+
+// MaxEndedLendingsPerBlock bounds the number of ended lendings whose item is returned at the end of each block
+const MaxEndedLendingsPerBlock = 100
+
+// MaxLendingDuration bounds the duration of a lending in blocks, about ten years of 5 seconds blocks, so that its end
+// height cannot overflow
+const MaxLendingDuration = 63_072_000
@@ -0,0 +1,605 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: pylons/pylons/lending.proto
+
+package types
+
+import (
+	fmt "fmt"
+	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
+	types "github.com/cosmos/cosmos-sdk/types"
+	_ "github.com/gogo/protobuf/gogoproto"
+	proto "github.com/gogo/protobuf/proto"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// Lending lends an item locked by its lender to a borrower for a number of blocks
+type Lending struct {
+	Id     uint64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Lender string `protobuf:"bytes,2,opt,name=lender,proto3" json:"lender,omitempty"`
+	// only the borrower can accept the lending, any account can accept it if empty
+	Borrower string  `protobuf:"bytes,3,opt,name=borrower,proto3" json:"borrower,omitempty"`
+	Item     ItemRef `protobuf:"bytes,4,opt,name=item,proto3" json:"item"`
+	// price paid by the borrower to the lender
+	Price github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,5,rep,name=price,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"price"`
+	// number of blocks the item is lent for
+	Duration int64 `protobuf:"varint,6,opt,name=duration,proto3" json:"duration,omitempty"`
+	// block height at which the item returns to the lender, 0 until the lending is accepted
+	EndHeight int64 `protobuf:"varint,7,opt,name=end_height,json=endHeight,proto3" json:"end_height,omitempty"`
+}
+
+func (m *Lending) Reset()         { *m = Lending{} }
+func (m *Lending) String() string { return proto.CompactTextString(m) }
+func (*Lending) ProtoMessage()    {}
+func (*Lending) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a49d8b5fd1f33d89, []int{0}
+}
+func (m *Lending) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Lending) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Lending.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *Lending) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Lending.Merge(m, src)
+}
+func (m *Lending) XXX_Size() int {
+	return m.Size()
+}
+func (m *Lending) XXX_DiscardUnknown() {
+	xxx_messageInfo_Lending.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Lending proto.InternalMessageInfo
+
+func (m *Lending) GetId() uint64 {
+	if m != nil {
+		return m.Id
+	}
+	return 0
+}
+
+func (m *Lending) GetLender() string {
+	if m != nil {
+		return m.Lender
+	}
+	return ""
+}
+
+func (m *Lending) GetBorrower() string {
+	if m != nil {
+		return m.Borrower
+	}
+	return ""
+}
+
+func (m *Lending) GetItem() ItemRef {
+	if m != nil {
+		return m.Item
+	}
+	return ItemRef{}
+}
+
+func (m *Lending) GetPrice() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.Price
+	}
+	return nil
+}
+
+func (m *Lending) GetDuration() int64 {
+	if m != nil {
+		return m.Duration
+	}
+	return 0
+}
+
+func (m *Lending) GetEndHeight() int64 {
+	if m != nil {
+		return m.EndHeight
+	}
+	return 0
+}
+
+func init() {
+	proto.RegisterType((*Lending)(nil), "pylons.pylons.Lending")
+}
+
+func init() { proto.RegisterFile("pylons/pylons/lending.proto", fileDescriptor_a49d8b5fd1f33d89) }
+
+var fileDescriptor_a49d8b5fd1f33d89 = []byte{
+	// 352 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x54, 0x50, 0xc1, 0x4e, 0xea, 0x40,
+	0x14, 0xed, 0x94, 0x02, 0x8f, 0x21, 0xcf, 0x45, 0x63, 0x48, 0xc1, 0x58, 0x1a, 0x57, 0x5d, 0xc8,
+	0x14, 0xf0, 0x0f, 0x30, 0x31, 0x9a, 0xb8, 0x30, 0x5d, 0xba, 0x31, 0x6d, 0x67, 0x6c, 0x27, 0xd2,
+	0x99, 0x66, 0x3a, 0xa8, 0xfc, 0x85, 0x3f, 0xe0, 0x0f, 0xf8, 0x25, 0x2c, 0x59, 0xba, 0x52, 0x03,
+	0x3f, 0x62, 0x3a, 0x53, 0x88, 0xac, 0xee, 0xbd, 0xe7, 0xcc, 0x9d, 0x7b, 0xce, 0x81, 0x27, 0xc5,
+	0x72, 0xce, 0x59, 0x19, 0xd4, 0x65, 0x4e, 0x18, 0xa6, 0x2c, 0x45, 0x85, 0xe0, 0x92, 0xdb, 0xff,
+	0x35, 0x8a, 0x74, 0x19, 0xb8, 0x09, 0x2f, 0x73, 0x5e, 0x06, 0x71, 0x54, 0x92, 0xe0, 0x79, 0x12,
+	0x13, 0x19, 0x4d, 0x82, 0x84, 0x53, 0xa6, 0x9f, 0x0f, 0x8e, 0x53, 0x9e, 0x72, 0xd5, 0x06, 0x55,
+	0x57, 0xa3, 0xfd, 0xc3, 0x0b, 0x52, 0x44, 0x98, 0x68, 0xea, 0xec, 0xdd, 0x84, 0xed, 0x5b, 0x7d,
+	0xd1, 0x3e, 0x82, 0x26, 0xc5, 0x0e, 0xf0, 0x80, 0x6f, 0x85, 0x26, 0xc5, 0x76, 0x0f, 0xb6, 0x2a,
+	0x31, 0x44, 0x38, 0xa6, 0x07, 0xfc, 0x4e, 0x58, 0x4f, 0xf6, 0x00, 0xfe, 0x8b, 0xb9, 0x10, 0xfc,
+	0x85, 0x08, 0xa7, 0xa1, 0x98, 0xfd, 0x6c, 0x8f, 0xa1, 0x45, 0x25, 0xc9, 0x1d, 0xcb, 0x03, 0x7e,
+	0x77, 0xda, 0x43, 0x07, 0xf2, 0xd1, 0x8d, 0x24, 0x79, 0x48, 0x1e, 0x67, 0xd6, 0xea, 0x6b, 0x68,
+	0x84, 0xea, 0xa5, 0x1d, 0xc1, 0x66, 0x21, 0x68, 0x42, 0x9c, 0xa6, 0xd7, 0xf0, 0xbb, 0xd3, 0x3e,
+	0xd2, 0x16, 0x51, 0x65, 0x11, 0xd5, 0x16, 0xd1, 0x25, 0xa7, 0x6c, 0x36, 0xae, 0xb6, 0x3e, 0xbe,
+	0x87, 0x7e, 0x4a, 0x65, 0xb6, 0x88, 0x51, 0xc2, 0xf3, 0xa0, 0xce, 0x43, 0x97, 0x51, 0x89, 0x9f,
+	0x02, 0xb9, 0x2c, 0x48, 0xa9, 0x16, 0xca, 0x50, 0xff, 0x5c, 0x09, 0xc6, 0x0b, 0x11, 0x49, 0xca,
+	0x99, 0xd3, 0xf2, 0x80, 0xdf, 0x08, 0xf7, 0xb3, 0x7d, 0x0a, 0x21, 0x61, 0xf8, 0x21, 0x23, 0x34,
+	0xcd, 0xa4, 0xd3, 0x56, 0x6c, 0x87, 0x30, 0x7c, 0xad, 0x80, 0xd9, 0xd5, 0x6a, 0xe3, 0x82, 0xf5,
+	0xc6, 0x05, 0x3f, 0x1b, 0x17, 0xbc, 0x6d, 0x5d, 0x63, 0xbd, 0x75, 0x8d, 0xcf, 0xad, 0x6b, 0xdc,
+	0x9f, 0xff, 0x51, 0x71, 0xa7, 0xec, 0x8d, 0x24, 0x49, 0xb2, 0x5d, 0xc8, 0xaf, 0xbb, 0x46, 0xe9,
+	0x89, 0x5b, 0x2a, 0xee, 0x8b, 0xdf, 0x01, 0x00, 0xef, 0xa2, 0x26, 0x5e, 0xed, 0x01, 0x00, 0x00,
+}
+
+func (m *Lending) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Lending) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Lending) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.EndHeight != 0 {
+		i = encodeVarintLending(dAtA, i, uint64(m.EndHeight))
+		i--
+		dAtA[i] = 0x38
+	}
+	if m.Duration != 0 {
+		i = encodeVarintLending(dAtA, i, uint64(m.Duration))
+		i--
+		dAtA[i] = 0x30
+	}
+	if len(m.Price) > 0 {
+		for iNdEx := len(m.Price) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Price[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintLending(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
+	{
+		size, err := m.Item.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintLending(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	if len(m.Borrower) > 0 {
+		i -= len(m.Borrower)
+		copy(dAtA[i:], m.Borrower)
+		i = encodeVarintLending(dAtA, i, uint64(len(m.Borrower)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Lender) > 0 {
+		i -= len(m.Lender)
+		copy(dAtA[i:], m.Lender)
+		i = encodeVarintLending(dAtA, i, uint64(len(m.Lender)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Id != 0 {
+		i = encodeVarintLending(dAtA, i, uint64(m.Id))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintLending(dAtA []byte, offset int, v uint64) int {
+	offset -= sovLending(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *Lending) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Id != 0 {
+		n += 1 + sovLending(uint64(m.Id))
+	}
+	l = len(m.Lender)
+	if l > 0 {
+		n += 1 + l + sovLending(uint64(l))
+	}
+	l = len(m.Borrower)
+	if l > 0 {
+		n += 1 + l + sovLending(uint64(l))
+	}
+	l = m.Item.Size()
+	n += 1 + l + sovLending(uint64(l))
+	if len(m.Price) > 0 {
+		for _, e := range m.Price {
+			l = e.Size()
+			n += 1 + l + sovLending(uint64(l))
+		}
+	}
+	if m.Duration != 0 {
+		n += 1 + sovLending(uint64(m.Duration))
+	}
+	if m.EndHeight != 0 {
+		n += 1 + sovLending(uint64(m.EndHeight))
+	}
+	return n
+}
+
+func sovLending(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozLending(x uint64) (n int) {
+	return sovLending(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *Lending) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowLending
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Lending: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Lending: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			m.Id = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLending
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Id |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Lender", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLending
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthLending
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthLending
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Lender = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Borrower", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLending
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthLending
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthLending
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Borrower = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Item", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLending
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthLending
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthLending
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Item.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Price", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLending
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthLending
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthLending
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Price = append(m.Price, types.Coin{})
+			if err := m.Price[len(m.Price)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Duration", wireType)
+			}
+			m.Duration = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLending
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Duration |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EndHeight", wireType)
+			}
+			m.EndHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLending
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.EndHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipLending(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthLending
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipLending(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowLending
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowLending
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowLending
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthLending
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupLending
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthLending
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthLending        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowLending          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupLending = fmt.Errorf("proto: unexpected end of group")
+)
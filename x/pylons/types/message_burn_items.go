@@ -0,0 +1,63 @@
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+var _ sdk.Msg = &MsgBurnItems{}
+
+func NewMsgBurnItems(creator string, items []ItemRef) *MsgBurnItems {
+	return &MsgBurnItems{
+		Creator: creator,
+		Items:   items,
+	}
+}
+
+func (msg *MsgBurnItems) Route() string {
+	return RouterKey
+}
+
+func (msg *MsgBurnItems) Type() string {
+	return "BurnItems"
+}
+
+func (msg *MsgBurnItems) GetSigners() []sdk.AccAddress {
+	creator, err := sdk.AccAddressFromBech32(msg.Creator)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{creator}
+}
+
+func (msg *MsgBurnItems) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(msg)
+	return sdk.MustSortJSON(bz)
+}
+
+func (msg *MsgBurnItems) ValidateBasic() error {
+	_, err := sdk.AccAddressFromBech32(msg.Creator)
+	if err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid creator address (%s)", err)
+	}
+
+	if len(msg.Items) == 0 {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "no items provided")
+	}
+
+	seen := make(map[string]bool)
+	for _, itemRef := range msg.Items {
+		if err = ValidateID(itemRef.CookbookId); err != nil {
+			return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, err.Error())
+		}
+		if err = ValidateItemID(itemRef.ItemId); err != nil {
+			return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, err.Error())
+		}
+		key := itemRef.CookbookId + "-" + itemRef.ItemId
+		if seen[key] {
+			return sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "item %s in cookbook %s is duplicated", itemRef.ItemId, itemRef.CookbookId)
+		}
+		seen[key] = true
+	}
+	return nil
+}
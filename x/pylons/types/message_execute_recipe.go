@@ -51,10 +51,15 @@ func (msg *MsgExecuteRecipe) ValidateBasic() error {
 		return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, err.Error())
 	}
 
+	seen := make(map[string]bool)
 	for _, id := range msg.ItemIds {
 		if err = ValidateItemID(id); err != nil {
 			return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, err.Error())
 		}
+		if seen[id] {
+			return sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "item %s is duplicated", id)
+		}
+		seen[id] = true
 	}
 
 	for _, pi := range msg.PaymentInfos {
@@ -44,6 +44,7 @@ func (msg *MsgFulfillTrade) ValidateBasic() error {
 		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid creator address (%s)", err)
 	}
 
+	seen := make(map[string]bool)
 	for _, item := range msg.Items {
 		err = ValidateItemID(item.ItemId)
 		if err != nil {
@@ -53,6 +54,11 @@ func (msg *MsgFulfillTrade) ValidateBasic() error {
 		if err != nil {
 			return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, err.Error())
 		}
+		key := item.CookbookId + "-" + item.ItemId
+		if seen[key] {
+			return sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "item %s in cookbook %s is duplicated", item.ItemId, item.CookbookId)
+		}
+		seen[key] = true
 	}
 
 	for _, pi := range msg.PaymentInfos {
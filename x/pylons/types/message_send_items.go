@@ -48,6 +48,7 @@ func (msg *MsgSendItems) ValidateBasic() error {
 		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid receiver address (%s)", err)
 	}
 
+	seen := make(map[string]bool)
 	for _, itemRef := range msg.Items {
 		if err = ValidateID(itemRef.CookbookId); err != nil {
 			return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, err.Error())
@@ -55,6 +56,12 @@ func (msg *MsgSendItems) ValidateBasic() error {
 		if err = ValidateItemID(itemRef.ItemId); err != nil {
 			return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, err.Error())
 		}
+		// amounts are split from the stored item, so each item can only be referenced once
+		key := itemRef.CookbookId + "-" + itemRef.ItemId
+		if seen[key] {
+			return sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "item %s in cookbook %s is duplicated", itemRef.ItemId, itemRef.CookbookId)
+		}
+		seen[key] = true
 	}
 	return nil
 }
@@ -0,0 +1,90 @@
+package types
+
+import (
+	"strings"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	clienttypes "github.com/cosmos/ibc-go/v5/modules/core/02-client/types"
+	host "github.com/cosmos/ibc-go/v5/modules/core/24-host"
+)
+
+var _ sdk.Msg = &MsgTransferItems{}
+
+func NewMsgTransferItems(creator, sourcePort, sourceChannel, cookbookID string, itemIDs []string, receiver string, timeoutHeight clienttypes.Height, timeoutTimestamp uint64, memo string) *MsgTransferItems {
+	return &MsgTransferItems{
+		Creator:          creator,
+		SourcePort:       sourcePort,
+		SourceChannel:    sourceChannel,
+		CookbookId:       cookbookID,
+		ItemIds:          itemIDs,
+		Receiver:         receiver,
+		TimeoutHeight:    timeoutHeight,
+		TimeoutTimestamp: timeoutTimestamp,
+		Memo:             memo,
+	}
+}
+
+func (msg *MsgTransferItems) Route() string {
+	return RouterKey
+}
+
+func (msg *MsgTransferItems) Type() string {
+	return "TransferItems"
+}
+
+func (msg *MsgTransferItems) GetSigners() []sdk.AccAddress {
+	creator, err := sdk.AccAddressFromBech32(msg.Creator)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{creator}
+}
+
+func (msg *MsgTransferItems) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(msg)
+	return sdk.MustSortJSON(bz)
+}
+
+func (msg *MsgTransferItems) ValidateBasic() error {
+	_, err := sdk.AccAddressFromBech32(msg.Creator)
+	if err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid creator address (%s)", err)
+	}
+
+	if err = host.PortIdentifierValidator(msg.SourcePort); err != nil {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, err.Error())
+	}
+	if err = host.ChannelIdentifierValidator(msg.SourceChannel); err != nil {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, err.Error())
+	}
+
+	if err = ValidateID(msg.CookbookId); err != nil {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, err.Error())
+	}
+
+	if len(msg.ItemIds) == 0 {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "no items provided")
+	}
+	itemIDs := make(map[string]bool)
+	for _, itemID := range msg.ItemIds {
+		if err = ValidateItemID(itemID); err != nil {
+			return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, err.Error())
+		}
+		if itemIDs[itemID] {
+			return sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "duplicate item %s", itemID)
+		}
+		itemIDs[itemID] = true
+	}
+
+	// the receiver is an address of the counterparty chain, so it is not checked against the bech32 prefix of this chain
+	if strings.TrimSpace(msg.Receiver) == "" {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, "missing receiver address")
+	}
+
+	if msg.TimeoutHeight.IsZero() && msg.TimeoutTimestamp == 0 {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "timeout height and timeout timestamp cannot both be 0")
+	}
+
+	return nil
+}
@@ -0,0 +1,89 @@
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+var _ sdk.Msg = &MsgUpdateItemAttributes{}
+
+func NewMsgUpdateItemAttributes(creator, cookbookID, id string, doubles []DoubleKeyValue, longs []LongKeyValue, strings []StringKeyValue) *MsgUpdateItemAttributes {
+	return &MsgUpdateItemAttributes{
+		Creator:    creator,
+		CookbookId: cookbookID,
+		Id:         id,
+		Doubles:    doubles,
+		Longs:      longs,
+		Strings:    strings,
+	}
+}
+
+func (msg *MsgUpdateItemAttributes) Route() string {
+	return RouterKey
+}
+
+func (msg *MsgUpdateItemAttributes) Type() string {
+	return "UpdateItemAttributes"
+}
+
+func (msg *MsgUpdateItemAttributes) GetSigners() []sdk.AccAddress {
+	creator, err := sdk.AccAddressFromBech32(msg.Creator)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{creator}
+}
+
+func (msg *MsgUpdateItemAttributes) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(msg)
+	return sdk.MustSortJSON(bz)
+}
+
+func (msg *MsgUpdateItemAttributes) ValidateBasic() error {
+	_, err := sdk.AccAddressFromBech32(msg.Creator)
+	if err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid creator address (%s)", err)
+	}
+	if err = ValidateID(msg.CookbookId); err != nil {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, err.Error())
+	}
+	if err = ValidateItemID(msg.Id); err != nil {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, err.Error())
+	}
+
+	if len(msg.Doubles)+len(msg.Longs)+len(msg.Strings) == 0 {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "no attributes to update")
+	}
+
+	keys := make(map[string]bool)
+	checkKey := func(key string) error {
+		if key == "" {
+			return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "empty attribute key")
+		}
+		if keys[key] {
+			return sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "attribute %s updated twice", key)
+		}
+		keys[key] = true
+		return nil
+	}
+	for _, kv := range msg.Doubles {
+		if err = checkKey(kv.Key); err != nil {
+			return err
+		}
+		if kv.Value.IsNil() {
+			return sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "empty value of attribute %s", kv.Key)
+		}
+	}
+	for _, kv := range msg.Longs {
+		if err = checkKey(kv.Key); err != nil {
+			return err
+		}
+	}
+	for _, kv := range msg.Strings {
+		if err = checkKey(kv.Key); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
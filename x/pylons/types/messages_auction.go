@@ -0,0 +1,155 @@
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+var _ sdk.Msg = &MsgCreateAuction{}
+
+func NewMsgCreateAuction(creator string, items []ItemRef, reservePrice sdk.Coin, endTime int64) *MsgCreateAuction {
+	return &MsgCreateAuction{
+		Creator:      creator,
+		Items:        items,
+		ReservePrice: reservePrice,
+		EndTime:      endTime,
+	}
+}
+
+func (msg *MsgCreateAuction) Route() string {
+	return RouterKey
+}
+
+func (msg *MsgCreateAuction) Type() string {
+	return "CreateAuction"
+}
+
+func (msg *MsgCreateAuction) GetSigners() []sdk.AccAddress {
+	creator, err := sdk.AccAddressFromBech32(msg.Creator)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{creator}
+}
+
+func (msg *MsgCreateAuction) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(msg)
+	return sdk.MustSortJSON(bz)
+}
+
+func (msg *MsgCreateAuction) ValidateBasic() error {
+	_, err := sdk.AccAddressFromBech32(msg.Creator)
+	if err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid creator address (%s)", err)
+	}
+
+	if len(msg.Items) == 0 {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "an auction must sell at least one item")
+	}
+	seen := make(map[string]bool)
+	for _, item := range msg.Items {
+		if err = ValidateID(item.CookbookId); err != nil {
+			return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, err.Error())
+		}
+		if err = ValidateItemID(item.ItemId); err != nil {
+			return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, err.Error())
+		}
+		key := item.CookbookId + "-" + item.ItemId
+		if seen[key] {
+			return sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "item %s in cookbook %s is duplicated", item.ItemId, item.CookbookId)
+		}
+		seen[key] = true
+	}
+
+	if !msg.ReservePrice.IsValid() || !msg.ReservePrice.IsPositive() {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidCoins, "invalid reserve price")
+	}
+
+	if msg.EndTime <= 0 {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "end time must be positive")
+	}
+
+	return nil
+}
+
+var _ sdk.Msg = &MsgPlaceBid{}
+
+func NewMsgPlaceBid(creator string, id uint64, amount sdk.Coin) *MsgPlaceBid {
+	return &MsgPlaceBid{
+		Creator: creator,
+		Id:      id,
+		Amount:  amount,
+	}
+}
+
+func (msg *MsgPlaceBid) Route() string {
+	return RouterKey
+}
+
+func (msg *MsgPlaceBid) Type() string {
+	return "PlaceBid"
+}
+
+func (msg *MsgPlaceBid) GetSigners() []sdk.AccAddress {
+	creator, err := sdk.AccAddressFromBech32(msg.Creator)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{creator}
+}
+
+func (msg *MsgPlaceBid) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(msg)
+	return sdk.MustSortJSON(bz)
+}
+
+func (msg *MsgPlaceBid) ValidateBasic() error {
+	_, err := sdk.AccAddressFromBech32(msg.Creator)
+	if err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid creator address (%s)", err)
+	}
+
+	if !msg.Amount.IsValid() || !msg.Amount.IsPositive() {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidCoins, "invalid bid amount")
+	}
+
+	return nil
+}
+
+var _ sdk.Msg = &MsgCancelAuction{}
+
+func NewMsgCancelAuction(creator string, id uint64) *MsgCancelAuction {
+	return &MsgCancelAuction{
+		Creator: creator,
+		Id:      id,
+	}
+}
+
+func (msg *MsgCancelAuction) Route() string {
+	return RouterKey
+}
+
+func (msg *MsgCancelAuction) Type() string {
+	return "CancelAuction"
+}
+
+func (msg *MsgCancelAuction) GetSigners() []sdk.AccAddress {
+	creator, err := sdk.AccAddressFromBech32(msg.Creator)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{creator}
+}
+
+func (msg *MsgCancelAuction) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(msg)
+	return sdk.MustSortJSON(bz)
+}
+
+func (msg *MsgCancelAuction) ValidateBasic() error {
+	_, err := sdk.AccAddressFromBech32(msg.Creator)
+	if err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid creator address (%s)", err)
+	}
+	return nil
+}
@@ -0,0 +1,121 @@
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// MaxContainerItems is the maximum number of items held by a container item
+const MaxContainerItems = 64
+
+// validateContainerItems checks the ids of a container and of the items deposited into or withdrawn from it
+func validateContainerItems(cookbookID, containerID string, itemIDs []string) error {
+	if err := ValidateID(cookbookID); err != nil {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, err.Error())
+	}
+	if err := ValidateItemID(containerID); err != nil {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, err.Error())
+	}
+	if len(itemIDs) == 0 {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "no items provided")
+	}
+	if len(itemIDs) > MaxContainerItems {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "a container holds at most %d items", MaxContainerItems)
+	}
+	seen := make(map[string]bool, len(itemIDs))
+	for _, id := range itemIDs {
+		if err := ValidateItemID(id); err != nil {
+			return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, err.Error())
+		}
+		if id == containerID {
+			return sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "item %s cannot contain itself", id)
+		}
+		if seen[id] {
+			return sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "item %s provided twice", id)
+		}
+		seen[id] = true
+	}
+	return nil
+}
+
+var _ sdk.Msg = &MsgDepositItems{}
+
+func NewMsgDepositItems(creator, cookbookID, containerID string, itemIDs []string) *MsgDepositItems {
+	return &MsgDepositItems{
+		Creator:     creator,
+		CookbookId:  cookbookID,
+		ContainerId: containerID,
+		ItemIds:     itemIDs,
+	}
+}
+
+func (msg *MsgDepositItems) Route() string {
+	return RouterKey
+}
+
+func (msg *MsgDepositItems) Type() string {
+	return "DepositItems"
+}
+
+func (msg *MsgDepositItems) GetSigners() []sdk.AccAddress {
+	creator, err := sdk.AccAddressFromBech32(msg.Creator)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{creator}
+}
+
+func (msg *MsgDepositItems) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(msg)
+	return sdk.MustSortJSON(bz)
+}
+
+func (msg *MsgDepositItems) ValidateBasic() error {
+	_, err := sdk.AccAddressFromBech32(msg.Creator)
+	if err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid creator address (%s)", err)
+	}
+
+	return validateContainerItems(msg.CookbookId, msg.ContainerId, msg.ItemIds)
+}
+
+var _ sdk.Msg = &MsgWithdrawItems{}
+
+func NewMsgWithdrawItems(creator, cookbookID, containerID string, itemIDs []string) *MsgWithdrawItems {
+	return &MsgWithdrawItems{
+		Creator:     creator,
+		CookbookId:  cookbookID,
+		ContainerId: containerID,
+		ItemIds:     itemIDs,
+	}
+}
+
+func (msg *MsgWithdrawItems) Route() string {
+	return RouterKey
+}
+
+func (msg *MsgWithdrawItems) Type() string {
+	return "WithdrawItems"
+}
+
+func (msg *MsgWithdrawItems) GetSigners() []sdk.AccAddress {
+	creator, err := sdk.AccAddressFromBech32(msg.Creator)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{creator}
+}
+
+func (msg *MsgWithdrawItems) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(msg)
+	return sdk.MustSortJSON(bz)
+}
+
+func (msg *MsgWithdrawItems) ValidateBasic() error {
+	_, err := sdk.AccAddressFromBech32(msg.Creator)
+	if err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid creator address (%s)", err)
+	}
+
+	return validateContainerItems(msg.CookbookId, msg.ContainerId, msg.ItemIds)
+}
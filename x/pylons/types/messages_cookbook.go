@@ -71,6 +71,22 @@ func (msg *MsgCreateCookbook) ValidateBasic() error {
 	if err = ValidateVersion(msg.Version); err != nil {
 		return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, err.Error())
 	}
+
+	if !msg.BurnRefund.IsValid() {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidCoins, "invalid burn refund %s", msg.BurnRefund)
+	}
+
+	if err = ValidateIndexedAttributes(msg.IndexedAttributes); err != nil {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, err.Error())
+	}
+
+	if err = ValidateAttributePermissions(msg.AttributePermissions); err != nil {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, err.Error())
+	}
+
+	if err = ValidateAttributeSchema(msg.AttributeSchema); err != nil {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, err.Error())
+	}
 	return nil
 }
 
@@ -141,5 +157,21 @@ func (msg *MsgUpdateCookbook) ValidateBasic() error {
 		return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, err.Error())
 	}
 
+	if !msg.BurnRefund.IsValid() {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidCoins, "invalid burn refund %s", msg.BurnRefund)
+	}
+
+	if err = ValidateIndexedAttributes(msg.IndexedAttributes); err != nil {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, err.Error())
+	}
+
+	if err = ValidateAttributePermissions(msg.AttributePermissions); err != nil {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, err.Error())
+	}
+
+	if err = ValidateAttributeSchema(msg.AttributeSchema); err != nil {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, err.Error())
+	}
+
 	return nil
 }
@@ -0,0 +1,171 @@
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+var _ sdk.Msg = &MsgCreateDutchAuction{}
+
+func NewMsgCreateDutchAuction(creator string, items []ItemRef, startPrice, floorPrice sdk.Coin, startHeight, endHeight int64, decay string) *MsgCreateDutchAuction {
+	return &MsgCreateDutchAuction{
+		Creator:     creator,
+		Items:       items,
+		StartPrice:  startPrice,
+		FloorPrice:  floorPrice,
+		StartHeight: startHeight,
+		EndHeight:   endHeight,
+		Decay:       decay,
+	}
+}
+
+func (msg *MsgCreateDutchAuction) Route() string {
+	return RouterKey
+}
+
+func (msg *MsgCreateDutchAuction) Type() string {
+	return "CreateDutchAuction"
+}
+
+func (msg *MsgCreateDutchAuction) GetSigners() []sdk.AccAddress {
+	creator, err := sdk.AccAddressFromBech32(msg.Creator)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{creator}
+}
+
+func (msg *MsgCreateDutchAuction) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(msg)
+	return sdk.MustSortJSON(bz)
+}
+
+func (msg *MsgCreateDutchAuction) ValidateBasic() error {
+	_, err := sdk.AccAddressFromBech32(msg.Creator)
+	if err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid creator address (%s)", err)
+	}
+
+	if len(msg.Items) == 0 {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "an auction must sell at least one item")
+	}
+	seen := make(map[string]bool)
+	for _, item := range msg.Items {
+		if err = ValidateID(item.CookbookId); err != nil {
+			return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, err.Error())
+		}
+		if err = ValidateItemID(item.ItemId); err != nil {
+			return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, err.Error())
+		}
+		key := item.CookbookId + "-" + item.ItemId
+		if seen[key] {
+			return sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "item %s in cookbook %s is duplicated", item.ItemId, item.CookbookId)
+		}
+		seen[key] = true
+	}
+
+	if !msg.StartPrice.IsValid() || !msg.StartPrice.IsPositive() {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidCoins, "invalid start price")
+	}
+	if !msg.FloorPrice.IsValid() || !msg.FloorPrice.IsPositive() {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidCoins, "invalid floor price")
+	}
+	if msg.FloorPrice.Denom != msg.StartPrice.Denom {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidCoins, "start and floor prices must have the same denom")
+	}
+	if msg.FloorPrice.Amount.GT(msg.StartPrice.Amount) {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidCoins, "floor price cannot be greater than the start price")
+	}
+
+	if msg.StartHeight <= 0 || msg.EndHeight <= msg.StartHeight {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "end height must be greater than a positive start height")
+	}
+
+	if msg.Decay != DutchAuctionDecayLinear && msg.Decay != DutchAuctionDecayExponential {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "decay must be either %s or %s", DutchAuctionDecayLinear, DutchAuctionDecayExponential)
+	}
+
+	return nil
+}
+
+var _ sdk.Msg = &MsgBuyDutchAuction{}
+
+func NewMsgBuyDutchAuction(creator string, id uint64, maxPrice sdk.Coin) *MsgBuyDutchAuction {
+	return &MsgBuyDutchAuction{
+		Creator:  creator,
+		Id:       id,
+		MaxPrice: maxPrice,
+	}
+}
+
+func (msg *MsgBuyDutchAuction) Route() string {
+	return RouterKey
+}
+
+func (msg *MsgBuyDutchAuction) Type() string {
+	return "BuyDutchAuction"
+}
+
+func (msg *MsgBuyDutchAuction) GetSigners() []sdk.AccAddress {
+	creator, err := sdk.AccAddressFromBech32(msg.Creator)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{creator}
+}
+
+func (msg *MsgBuyDutchAuction) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(msg)
+	return sdk.MustSortJSON(bz)
+}
+
+func (msg *MsgBuyDutchAuction) ValidateBasic() error {
+	_, err := sdk.AccAddressFromBech32(msg.Creator)
+	if err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid creator address (%s)", err)
+	}
+
+	if !msg.MaxPrice.IsValid() || !msg.MaxPrice.IsPositive() {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidCoins, "invalid max price")
+	}
+
+	return nil
+}
+
+var _ sdk.Msg = &MsgCancelDutchAuction{}
+
+func NewMsgCancelDutchAuction(creator string, id uint64) *MsgCancelDutchAuction {
+	return &MsgCancelDutchAuction{
+		Creator: creator,
+		Id:      id,
+	}
+}
+
+func (msg *MsgCancelDutchAuction) Route() string {
+	return RouterKey
+}
+
+func (msg *MsgCancelDutchAuction) Type() string {
+	return "CancelDutchAuction"
+}
+
+func (msg *MsgCancelDutchAuction) GetSigners() []sdk.AccAddress {
+	creator, err := sdk.AccAddressFromBech32(msg.Creator)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{creator}
+}
+
+func (msg *MsgCancelDutchAuction) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(msg)
+	return sdk.MustSortJSON(bz)
+}
+
+func (msg *MsgCancelDutchAuction) ValidateBasic() error {
+	_, err := sdk.AccAddressFromBech32(msg.Creator)
+	if err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid creator address (%s)", err)
+	}
+	return nil
+}
@@ -0,0 +1,164 @@
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+var _ sdk.Msg = &MsgApproveItem{}
+
+func NewMsgApproveItem(creator, cookbookID, itemID, operator string) *MsgApproveItem {
+	return &MsgApproveItem{
+		Creator:    creator,
+		CookbookId: cookbookID,
+		ItemId:     itemID,
+		Operator:   operator,
+	}
+}
+
+func (msg *MsgApproveItem) Route() string {
+	return RouterKey
+}
+
+func (msg *MsgApproveItem) Type() string {
+	return "ApproveItem"
+}
+
+func (msg *MsgApproveItem) GetSigners() []sdk.AccAddress {
+	creator, err := sdk.AccAddressFromBech32(msg.Creator)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{creator}
+}
+
+func (msg *MsgApproveItem) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(msg)
+	return sdk.MustSortJSON(bz)
+}
+
+func (msg *MsgApproveItem) ValidateBasic() error {
+	_, err := sdk.AccAddressFromBech32(msg.Creator)
+	if err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid creator address (%s)", err)
+	}
+
+	_, err = sdk.AccAddressFromBech32(msg.Operator)
+	if err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid operator address (%s)", err)
+	}
+	if msg.Operator == msg.Creator {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "operator cannot be the item owner")
+	}
+
+	if err = ValidateID(msg.CookbookId); err != nil {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, err.Error())
+	}
+
+	if err = ValidateItemID(msg.ItemId); err != nil {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, err.Error())
+	}
+
+	return nil
+}
+
+var _ sdk.Msg = &MsgRevokeItemApproval{}
+
+func NewMsgRevokeItemApproval(creator, cookbookID, itemID string) *MsgRevokeItemApproval {
+	return &MsgRevokeItemApproval{
+		Creator:    creator,
+		CookbookId: cookbookID,
+		ItemId:     itemID,
+	}
+}
+
+func (msg *MsgRevokeItemApproval) Route() string {
+	return RouterKey
+}
+
+func (msg *MsgRevokeItemApproval) Type() string {
+	return "RevokeItemApproval"
+}
+
+func (msg *MsgRevokeItemApproval) GetSigners() []sdk.AccAddress {
+	creator, err := sdk.AccAddressFromBech32(msg.Creator)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{creator}
+}
+
+func (msg *MsgRevokeItemApproval) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(msg)
+	return sdk.MustSortJSON(bz)
+}
+
+func (msg *MsgRevokeItemApproval) ValidateBasic() error {
+	_, err := sdk.AccAddressFromBech32(msg.Creator)
+	if err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid creator address (%s)", err)
+	}
+
+	if err = ValidateID(msg.CookbookId); err != nil {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, err.Error())
+	}
+
+	if err = ValidateItemID(msg.ItemId); err != nil {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, err.Error())
+	}
+
+	return nil
+}
+
+var _ sdk.Msg = &MsgSetOperator{}
+
+func NewMsgSetOperator(creator, cookbookID, operator string, approved bool) *MsgSetOperator {
+	return &MsgSetOperator{
+		Creator:    creator,
+		CookbookId: cookbookID,
+		Operator:   operator,
+		Approved:   approved,
+	}
+}
+
+func (msg *MsgSetOperator) Route() string {
+	return RouterKey
+}
+
+func (msg *MsgSetOperator) Type() string {
+	return "SetOperator"
+}
+
+func (msg *MsgSetOperator) GetSigners() []sdk.AccAddress {
+	creator, err := sdk.AccAddressFromBech32(msg.Creator)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{creator}
+}
+
+func (msg *MsgSetOperator) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(msg)
+	return sdk.MustSortJSON(bz)
+}
+
+func (msg *MsgSetOperator) ValidateBasic() error {
+	_, err := sdk.AccAddressFromBech32(msg.Creator)
+	if err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid creator address (%s)", err)
+	}
+
+	_, err = sdk.AccAddressFromBech32(msg.Operator)
+	if err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid operator address (%s)", err)
+	}
+	if msg.Operator == msg.Creator {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "operator cannot be the items owner")
+	}
+
+	if err = ValidateID(msg.CookbookId); err != nil {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, err.Error())
+	}
+
+	return nil
+}
@@ -0,0 +1,153 @@
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+var _ sdk.Msg = &MsgCreateItemOffer{}
+
+func NewMsgCreateItemOffer(creator, cookbookID, itemID string, itemInput ItemInput, price sdk.Coins) *MsgCreateItemOffer {
+	return &MsgCreateItemOffer{
+		Creator:    creator,
+		CookbookId: cookbookID,
+		ItemId:     itemID,
+		ItemInput:  itemInput,
+		Price:      price,
+	}
+}
+
+func (msg *MsgCreateItemOffer) Route() string {
+	return RouterKey
+}
+
+func (msg *MsgCreateItemOffer) Type() string {
+	return "CreateItemOffer"
+}
+
+func (msg *MsgCreateItemOffer) GetSigners() []sdk.AccAddress {
+	creator, err := sdk.AccAddressFromBech32(msg.Creator)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{creator}
+}
+
+func (msg *MsgCreateItemOffer) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(msg)
+	return sdk.MustSortJSON(bz)
+}
+
+func (msg *MsgCreateItemOffer) ValidateBasic() error {
+	_, err := sdk.AccAddressFromBech32(msg.Creator)
+	if err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid creator address (%s)", err)
+	}
+
+	if err = ValidateID(msg.CookbookId); err != nil {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, err.Error())
+	}
+	if msg.ItemId != "" {
+		if err = ValidateItemID(msg.ItemId); err != nil {
+			return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, err.Error())
+		}
+	}
+	if err = ValidateItemInput(msg.ItemInput); err != nil {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, err.Error())
+	}
+	if msg.ItemInput.CookbookId != "" && msg.ItemInput.CookbookId != msg.CookbookId {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "item input cookbook ID %s must be the offer cookbook ID %s", msg.ItemInput.CookbookId, msg.CookbookId)
+	}
+
+	if msg.Price.Empty() || !msg.Price.IsValid() {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidCoins, "invalid price")
+	}
+
+	if msg.ExpiresAtHeight < 0 || msg.ExpiresAt < 0 {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "offer expiry cannot be negative")
+	}
+
+	return nil
+}
+
+var _ sdk.Msg = &MsgAcceptItemOffer{}
+
+func NewMsgAcceptItemOffer(creator string, id uint64, itemID string) *MsgAcceptItemOffer {
+	return &MsgAcceptItemOffer{
+		Creator: creator,
+		Id:      id,
+		ItemId:  itemID,
+	}
+}
+
+func (msg *MsgAcceptItemOffer) Route() string {
+	return RouterKey
+}
+
+func (msg *MsgAcceptItemOffer) Type() string {
+	return "AcceptItemOffer"
+}
+
+func (msg *MsgAcceptItemOffer) GetSigners() []sdk.AccAddress {
+	creator, err := sdk.AccAddressFromBech32(msg.Creator)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{creator}
+}
+
+func (msg *MsgAcceptItemOffer) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(msg)
+	return sdk.MustSortJSON(bz)
+}
+
+func (msg *MsgAcceptItemOffer) ValidateBasic() error {
+	_, err := sdk.AccAddressFromBech32(msg.Creator)
+	if err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid creator address (%s)", err)
+	}
+
+	if err = ValidateItemID(msg.ItemId); err != nil {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, err.Error())
+	}
+
+	return nil
+}
+
+var _ sdk.Msg = &MsgCancelItemOffer{}
+
+func NewMsgCancelItemOffer(creator string, id uint64) *MsgCancelItemOffer {
+	return &MsgCancelItemOffer{
+		Creator: creator,
+		Id:      id,
+	}
+}
+
+func (msg *MsgCancelItemOffer) Route() string {
+	return RouterKey
+}
+
+func (msg *MsgCancelItemOffer) Type() string {
+	return "CancelItemOffer"
+}
+
+func (msg *MsgCancelItemOffer) GetSigners() []sdk.AccAddress {
+	creator, err := sdk.AccAddressFromBech32(msg.Creator)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{creator}
+}
+
+func (msg *MsgCancelItemOffer) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(msg)
+	return sdk.MustSortJSON(bz)
+}
+
+func (msg *MsgCancelItemOffer) ValidateBasic() error {
+	_, err := sdk.AccAddressFromBech32(msg.Creator)
+	if err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid creator address (%s)", err)
+	}
+	return nil
+}
@@ -0,0 +1,152 @@
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+var _ sdk.Msg = &MsgCreateLending{}
+
+func NewMsgCreateLending(creator string, item ItemRef, borrower string, price sdk.Coins, duration int64) *MsgCreateLending {
+	return &MsgCreateLending{
+		Creator:  creator,
+		Item:     item,
+		Borrower: borrower,
+		Price:    price,
+		Duration: duration,
+	}
+}
+
+func (msg *MsgCreateLending) Route() string {
+	return RouterKey
+}
+
+func (msg *MsgCreateLending) Type() string {
+	return "CreateLending"
+}
+
+func (msg *MsgCreateLending) GetSigners() []sdk.AccAddress {
+	creator, err := sdk.AccAddressFromBech32(msg.Creator)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{creator}
+}
+
+func (msg *MsgCreateLending) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(msg)
+	return sdk.MustSortJSON(bz)
+}
+
+func (msg *MsgCreateLending) ValidateBasic() error {
+	_, err := sdk.AccAddressFromBech32(msg.Creator)
+	if err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid creator address (%s)", err)
+	}
+
+	if msg.Borrower != "" {
+		_, err = sdk.AccAddressFromBech32(msg.Borrower)
+		if err != nil {
+			return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid borrower address (%s)", err)
+		}
+		if msg.Borrower == msg.Creator {
+			return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "an item cannot be lent to its owner")
+		}
+	}
+
+	if err = ValidateID(msg.Item.CookbookId); err != nil {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, err.Error())
+	}
+	if err = ValidateItemID(msg.Item.ItemId); err != nil {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, err.Error())
+	}
+
+	if !msg.Price.Empty() && !msg.Price.IsValid() {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidCoins, "invalid price")
+	}
+
+	if msg.Duration <= 0 {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "duration must be positive")
+	}
+	if msg.Duration > MaxLendingDuration {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "duration cannot exceed %d blocks", MaxLendingDuration)
+	}
+
+	return nil
+}
+
+var _ sdk.Msg = &MsgAcceptLending{}
+
+func NewMsgAcceptLending(creator string, id uint64) *MsgAcceptLending {
+	return &MsgAcceptLending{
+		Id:      id,
+		Creator: creator,
+	}
+}
+
+func (msg *MsgAcceptLending) Route() string {
+	return RouterKey
+}
+
+func (msg *MsgAcceptLending) Type() string {
+	return "AcceptLending"
+}
+
+func (msg *MsgAcceptLending) GetSigners() []sdk.AccAddress {
+	creator, err := sdk.AccAddressFromBech32(msg.Creator)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{creator}
+}
+
+func (msg *MsgAcceptLending) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(msg)
+	return sdk.MustSortJSON(bz)
+}
+
+func (msg *MsgAcceptLending) ValidateBasic() error {
+	_, err := sdk.AccAddressFromBech32(msg.Creator)
+	if err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid creator address (%s)", err)
+	}
+	return nil
+}
+
+var _ sdk.Msg = &MsgCancelLending{}
+
+func NewMsgCancelLending(creator string, id uint64) *MsgCancelLending {
+	return &MsgCancelLending{
+		Id:      id,
+		Creator: creator,
+	}
+}
+
+func (msg *MsgCancelLending) Route() string {
+	return RouterKey
+}
+
+func (msg *MsgCancelLending) Type() string {
+	return "CancelLending"
+}
+
+func (msg *MsgCancelLending) GetSigners() []sdk.AccAddress {
+	creator, err := sdk.AccAddressFromBech32(msg.Creator)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{creator}
+}
+
+func (msg *MsgCancelLending) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(msg)
+	return sdk.MustSortJSON(bz)
+}
+
+func (msg *MsgCancelLending) ValidateBasic() error {
+	_, err := sdk.AccAddressFromBech32(msg.Creator)
+	if err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid creator address (%s)", err)
+	}
+	return nil
+}
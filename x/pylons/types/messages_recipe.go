@@ -118,6 +118,10 @@ func (msg *MsgCreateRecipe) ValidateBasic() error {
 		if err = ValidateItemInput(ii); err != nil {
 			return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, err.Error())
 		}
+		// recipe item inputs are always items of the recipe cookbook
+		if ii.CookbookId != "" && ii.CookbookId != msg.CookbookId {
+			return sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "item input cookbook ID %s must be the recipe cookbook ID %s", ii.CookbookId, msg.CookbookId)
+		}
 	}
 
 	idMap := make(map[string]bool)
@@ -269,6 +273,10 @@ func (msg *MsgUpdateRecipe) ValidateBasic() error {
 		if err = ValidateItemInput(ii); err != nil {
 			return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, err.Error())
 		}
+		// recipe item inputs are always items of the recipe cookbook
+		if ii.CookbookId != "" && ii.CookbookId != msg.CookbookId {
+			return sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "item input cookbook ID %s must be the recipe cookbook ID %s", ii.CookbookId, msg.CookbookId)
+		}
 	}
 
 	idMap := make(map[string]bool)
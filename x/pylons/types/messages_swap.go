@@ -0,0 +1,198 @@
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+var _ sdk.Msg = &MsgCreateSwap{}
+
+func NewMsgCreateSwap(creator string, participants []SwapParticipant) *MsgCreateSwap {
+	return &MsgCreateSwap{
+		Creator:      creator,
+		Participants: participants,
+	}
+}
+
+func (msg *MsgCreateSwap) Route() string {
+	return RouterKey
+}
+
+func (msg *MsgCreateSwap) Type() string {
+	return "CreateSwap"
+}
+
+func (msg *MsgCreateSwap) GetSigners() []sdk.AccAddress {
+	creator, err := sdk.AccAddressFromBech32(msg.Creator)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{creator}
+}
+
+func (msg *MsgCreateSwap) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(msg)
+	return sdk.MustSortJSON(bz)
+}
+
+func (msg *MsgCreateSwap) ValidateBasic() error {
+	_, err := sdk.AccAddressFromBech32(msg.Creator)
+	if err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid creator address (%s)", err)
+	}
+
+	if len(msg.Participants) < 2 {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "a swap needs at least two participants")
+	}
+
+	addresses := make(map[string]bool)
+	// committed items by reference, mapped to the address of the participant committing them
+	committed := make(map[ItemRef]string)
+	committedCoins := sdk.NewCoins()
+	for _, participant := range msg.Participants {
+		if _, err = sdk.AccAddressFromBech32(participant.Address); err != nil {
+			return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid participant address (%s)", err)
+		}
+		if addresses[participant.Address] {
+			return sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "participant %s is repeated", participant.Address)
+		}
+		addresses[participant.Address] = true
+
+		if len(participant.Items) == 0 && participant.Coins.Empty() {
+			return sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "participant %s does not commit any item or coin", participant.Address)
+		}
+		if !participant.Coins.IsValid() || !participant.ReceiveCoins.IsValid() {
+			return sdkerrors.Wrapf(sdkerrors.ErrInvalidCoins, "invalid coins of participant %s", participant.Address)
+		}
+		committedCoins = committedCoins.Add(participant.Coins...)
+
+		for _, item := range participant.Items {
+			if err = ValidateID(item.CookbookId); err != nil {
+				return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, err.Error())
+			}
+			if err = ValidateItemID(item.ItemId); err != nil {
+				return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, err.Error())
+			}
+			key := ItemRef{CookbookId: item.CookbookId, ItemId: item.ItemId}
+			if _, found := committed[key]; found {
+				return sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "item in cookbook %s with ID %s is committed more than once", item.CookbookId, item.ItemId)
+			}
+			committed[key] = participant.Address
+		}
+
+		if participant.Deposited || len(participant.DepositedItems) != 0 || !participant.ChainFees.Empty() || len(participant.Royalties) != 0 {
+			return sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "participant %s cannot be created as deposited", participant.Address)
+		}
+	}
+
+	if !addresses[msg.Creator] {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "creator must be a participant of the swap")
+	}
+
+	// every committed item and coin is received by exactly one other participant
+	received := make(map[ItemRef]bool)
+	receivedCoins := sdk.NewCoins()
+	for _, participant := range msg.Participants {
+		for _, item := range participant.ReceiveItems {
+			key := ItemRef{CookbookId: item.CookbookId, ItemId: item.ItemId}
+			committer, found := committed[key]
+			if !found {
+				return sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "item in cookbook %s with ID %s received by %s is not committed", item.CookbookId, item.ItemId, participant.Address)
+			}
+			if committer == participant.Address {
+				return sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "participant %s cannot receive its own item", participant.Address)
+			}
+			if received[key] {
+				return sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "item in cookbook %s with ID %s is received more than once", item.CookbookId, item.ItemId)
+			}
+			received[key] = true
+		}
+		receivedCoins = receivedCoins.Add(participant.ReceiveCoins...)
+	}
+	if len(received) != len(committed) {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "every committed item must be received by a participant")
+	}
+	if !receivedCoins.IsEqual(committedCoins) {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidCoins, "received coins must be equal to the committed coins")
+	}
+
+	return nil
+}
+
+var _ sdk.Msg = &MsgDepositSwap{}
+
+func NewMsgDepositSwap(creator string, id uint64) *MsgDepositSwap {
+	return &MsgDepositSwap{
+		Creator: creator,
+		Id:      id,
+	}
+}
+
+func (msg *MsgDepositSwap) Route() string {
+	return RouterKey
+}
+
+func (msg *MsgDepositSwap) Type() string {
+	return "DepositSwap"
+}
+
+func (msg *MsgDepositSwap) GetSigners() []sdk.AccAddress {
+	creator, err := sdk.AccAddressFromBech32(msg.Creator)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{creator}
+}
+
+func (msg *MsgDepositSwap) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(msg)
+	return sdk.MustSortJSON(bz)
+}
+
+func (msg *MsgDepositSwap) ValidateBasic() error {
+	_, err := sdk.AccAddressFromBech32(msg.Creator)
+	if err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid creator address (%s)", err)
+	}
+
+	return nil
+}
+
+var _ sdk.Msg = &MsgAbortSwap{}
+
+func NewMsgAbortSwap(creator string, id uint64) *MsgAbortSwap {
+	return &MsgAbortSwap{
+		Creator: creator,
+		Id:      id,
+	}
+}
+
+func (msg *MsgAbortSwap) Route() string {
+	return RouterKey
+}
+
+func (msg *MsgAbortSwap) Type() string {
+	return "AbortSwap"
+}
+
+func (msg *MsgAbortSwap) GetSigners() []sdk.AccAddress {
+	creator, err := sdk.AccAddressFromBech32(msg.Creator)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{creator}
+}
+
+func (msg *MsgAbortSwap) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(msg)
+	return sdk.MustSortJSON(bz)
+}
+
+func (msg *MsgAbortSwap) ValidateBasic() error {
+	_, err := sdk.AccAddressFromBech32(msg.Creator)
+	if err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid creator address (%s)", err)
+	}
+
+	return nil
+}
@@ -1,6 +1,8 @@
 package types
 
 import (
+	"math"
+
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 )
@@ -45,6 +47,19 @@ func (msg *MsgCreateTrade) ValidateBasic() error {
 		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid creator address (%s)", err)
 	}
 
+	if msg.Receiver != "" {
+		if _, err = sdk.AccAddressFromBech32(msg.Receiver); err != nil {
+			return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid receiver address (%s)", err)
+		}
+		if msg.Receiver == msg.Creator {
+			return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "receiver cannot be the trade creator")
+		}
+	}
+
+	if msg.ExpiresAtHeight < 0 || msg.ExpiresAt < 0 {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "trade expiry cannot be negative")
+	}
+
 	for i, coinInput := range msg.CoinInputs {
 		if !coinInput.Coins.IsValid() {
 			return sdkerrors.Wrapf(sdkerrors.ErrInvalidCoins, "invalid coinInputs at index %d", i)
@@ -109,6 +124,27 @@ func (msg *MsgCreateTrade) ValidateBasic() error {
 		}
 	}
 
+	if msg.Quantity > MaxTradeQuantity {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "trade quantity cannot exceed %d", MaxTradeQuantity)
+	}
+
+	if msg.Quantity > 1 {
+		// each unit is taken out of a locked fungible item
+		for _, item := range msg.ItemOutputs {
+			if item.Amount == 0 {
+				return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "itemOutputs of a trade offering multiple units must set an amount")
+			}
+			if item.Amount > math.MaxUint64/msg.Quantity {
+				return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "itemOutputs amount overflows the trade quantity")
+			}
+		}
+		for _, ii := range msg.ItemInputs {
+			if ii.Amount > math.MaxUint64/msg.Quantity {
+				return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "itemInputs amount overflows the trade quantity")
+			}
+		}
+	}
+
 	return nil
 }
 
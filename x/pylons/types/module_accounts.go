@@ -9,6 +9,18 @@ const (
 	TradesLockerName = "pylons_trades_locker"
 	// ExecutionsLockerName is the root name of executions coins and items locker module account
 	ExecutionsLockerName = "pylons_executions_locker"
+	// LendingsLockerName is the root name of the lent items locker module account
+	LendingsLockerName = "pylons_lendings_locker"
+	// AuctionsLockerName is the root name of the auctioned items and bids locker module account
+	AuctionsLockerName = "pylons_auctions_locker"
+	// OffersLockerName is the root name of the item offers coins locker module account
+	OffersLockerName = "pylons_offers_locker"
+	// SwapsLockerName is the root name of the swapped items and coins locker module account
+	SwapsLockerName = "pylons_swaps_locker"
+	// ContainersLockerName is the root name of the locker module account of the items held by container items
+	ContainersLockerName = "pylons_containers_locker"
+	// NFTTransferEscrowName is the root name of the items escrow module account of ICS-721 transfers
+	NFTTransferEscrowName = "pylons_nft_transfer_escrow"
 	// CoinsIssuerName is the root name of the coins minter module account
 	CoinsIssuerName = "pylons_coins_issuer"
 	// PaymentsProcessorName is the root name of the payments' processor module account
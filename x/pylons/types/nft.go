@@ -0,0 +1,20 @@
+package types
+
+import (
+	"strings"
+)
+
+// NFTIDPrefix prefixes the ids of the x/nft classes and NFTs mirroring cookbooks and items.
+// x/nft ids must start with a letter and cannot contain underscores.
+const NFTIDPrefix = "pylons/"
+
+// NFTClassID returns the id of the x/nft class mirroring a cookbook
+func NFTClassID(cookbookID string) string {
+	// cookbook ids cannot contain '-' so the mapping is reversible
+	return NFTIDPrefix + strings.ReplaceAll(cookbookID, "_", "-")
+}
+
+// NFTID returns the id of the x/nft NFT mirroring an item
+func NFTID(itemID string) string {
+	return NFTIDPrefix + itemID
+}
@@ -0,0 +1,86 @@
+package types
+
+import (
+	"crypto/sha256"
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+const (
+	// NFTTransferPortID is the port the ICS-721 application binds to
+	NFTTransferPortID = "nft-transfer"
+	// NFTTransferVersion is the ICS-721 channel version
+	NFTTransferVersion = "ics721-1"
+	// IBCCookbookPrefix prefixes the ids of the cookbooks holding items received over IBC
+	IBCCookbookPrefix = "ibc_"
+)
+
+// NonFungibleTokenPacketData is the ICS-721 packet data. Cookbooks map to classes and items to tokens.
+type NonFungibleTokenPacketData struct {
+	ClassID   string   `json:"classId"`
+	ClassURI  string   `json:"classUri,omitempty"`
+	ClassData string   `json:"classData,omitempty"`
+	TokenIDs  []string `json:"tokenIds"`
+	TokenURIs []string `json:"tokenUris,omitempty"`
+	TokenData []string `json:"tokenData,omitempty"`
+	Sender    string   `json:"sender"`
+	Receiver  string   `json:"receiver"`
+	Memo      string   `json:"memo,omitempty"`
+}
+
+// ValidateBasic performs stateless checks of the packet data
+func (data NonFungibleTokenPacketData) ValidateBasic() error {
+	if strings.TrimSpace(data.ClassID) == "" {
+		return sdkerrors.Wrap(ErrInvalidRequestField, "classId cannot be empty")
+	}
+	if len(data.TokenIDs) == 0 {
+		return sdkerrors.Wrap(ErrInvalidRequestField, "tokenIds cannot be empty")
+	}
+	if len(data.TokenURIs) != 0 && len(data.TokenURIs) != len(data.TokenIDs) {
+		return sdkerrors.Wrap(ErrInvalidRequestField, "tokenUris and tokenIds lengths do not match")
+	}
+	if len(data.TokenData) != 0 && len(data.TokenData) != len(data.TokenIDs) {
+		return sdkerrors.Wrap(ErrInvalidRequestField, "tokenData and tokenIds lengths do not match")
+	}
+	tokenIDs := make(map[string]bool)
+	for _, tokenID := range data.TokenIDs {
+		if strings.TrimSpace(tokenID) == "" {
+			return sdkerrors.Wrap(ErrInvalidRequestField, "tokenIds cannot contain an empty id")
+		}
+		if tokenIDs[tokenID] {
+			return sdkerrors.Wrapf(ErrInvalidRequestField, "duplicate token id %s", tokenID)
+		}
+		tokenIDs[tokenID] = true
+	}
+	if strings.TrimSpace(data.Sender) == "" {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, "sender cannot be empty")
+	}
+	if strings.TrimSpace(data.Receiver) == "" {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, "receiver cannot be empty")
+	}
+	return nil
+}
+
+// GetBytes returns the sorted JSON encoding of the packet data
+func (data NonFungibleTokenPacketData) GetBytes() []byte {
+	bz, err := json.Marshal(data)
+	if err != nil {
+		panic(err)
+	}
+	return sdk.MustSortJSON(bz)
+}
+
+// ClassPathPrefix returns the prefix added to a class id when it is received through portID and channelID
+func ClassPathPrefix(portID, channelID string) string {
+	return fmt.Sprintf("%s/%s/", portID, channelID)
+}
+
+// IBCClassCookbookID returns the id of the cookbook holding the items received from a class path
+func IBCClassCookbookID(classPath string) string {
+	hash := sha256.Sum256([]byte(classPath))
+	return fmt.Sprintf("%s%X", IBCCookbookPrefix, hash)
+}
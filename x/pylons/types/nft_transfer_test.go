@@ -0,0 +1,45 @@
+package types
+
+import (
+	"testing"
+
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestNonFungibleTokenPacketDataValidateBasic(t *testing.T) {
+	sender := GenTestBech32FromString("sender")
+	receiver := GenTestBech32FromString("receiver")
+	for _, tc := range []struct {
+		desc string
+		data NonFungibleTokenPacketData
+		err  error
+	}{
+		{desc: "Valid", data: NonFungibleTokenPacketData{ClassID: "cookbook", TokenIDs: []string{"a", "b"}, Sender: sender, Receiver: receiver}},
+		{desc: "ValidWithURIs", data: NonFungibleTokenPacketData{ClassID: "cookbook", TokenIDs: []string{"a"}, TokenURIs: []string{"uri"}, Sender: sender, Receiver: receiver}},
+		{desc: "EmptyClass", data: NonFungibleTokenPacketData{TokenIDs: []string{"a"}, Sender: sender, Receiver: receiver}, err: ErrInvalidRequestField},
+		{desc: "NoTokens", data: NonFungibleTokenPacketData{ClassID: "cookbook", Sender: sender, Receiver: receiver}, err: ErrInvalidRequestField},
+		{desc: "DuplicateTokens", data: NonFungibleTokenPacketData{ClassID: "cookbook", TokenIDs: []string{"a", "a"}, Sender: sender, Receiver: receiver}, err: ErrInvalidRequestField},
+		{desc: "URIsLength", data: NonFungibleTokenPacketData{ClassID: "cookbook", TokenIDs: []string{"a", "b"}, TokenURIs: []string{"uri"}, Sender: sender, Receiver: receiver}, err: ErrInvalidRequestField},
+		{desc: "TokenDataLength", data: NonFungibleTokenPacketData{ClassID: "cookbook", TokenIDs: []string{"a"}, TokenData: []string{"", ""}, Sender: sender, Receiver: receiver}, err: ErrInvalidRequestField},
+		{desc: "EmptyReceiver", data: NonFungibleTokenPacketData{ClassID: "cookbook", TokenIDs: []string{"a"}, Sender: sender}, err: sdkerrors.ErrInvalidAddress},
+	} {
+		tc := tc
+		t.Run(tc.desc, func(t *testing.T) {
+			err := tc.data.ValidateBasic()
+			if tc.err != nil {
+				require.ErrorIs(t, err, tc.err)
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestIBCClassCookbookID(t *testing.T) {
+	id := IBCClassCookbookID(ClassPathPrefix(NFTTransferPortID, "channel-0") + "cookbook")
+	require.NoError(t, ValidateID(id))
+	require.Equal(t, id, IBCClassCookbookID("nft-transfer/channel-0/cookbook"))
+	require.NotEqual(t, id, IBCClassCookbookID("nft-transfer/channel-1/cookbook"))
+}
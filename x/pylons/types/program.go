@@ -80,6 +80,19 @@ func (ec *CelEnvCollection) EvalFloat64(program string) (float64, error) {
 	return getFloat(refVal.Value())
 }
 
+// EvalBool calculate a value and convert to bool
+func (ec *CelEnvCollection) EvalBool(program string) (bool, error) {
+	refVal, refErr := ec.eval(program)
+	if refErr != nil {
+		return false, refErr
+	}
+	val, ok := refVal.Value().(bool)
+	if !ok {
+		return false, errors.New("returned result from program is not convertable to bool")
+	}
+	return val, nil
+}
+
 // EvalString calculate a value and convert to string
 func (ec *CelEnvCollection) EvalString(program string) (string, error) {
 	refVal, refErr := ec.eval(program)
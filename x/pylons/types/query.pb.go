@@ -6,6 +6,8 @@ package types
 import (
 	context "context"
 	fmt "fmt"
+	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
+	types "github.com/cosmos/cosmos-sdk/types"
 	query "github.com/cosmos/cosmos-sdk/types/query"
 	_ "github.com/gogo/protobuf/gogoproto"
 	grpc1 "github.com/gogo/protobuf/grpc"
@@ -209,24 +211,36 @@ func (m *QueryListTradesByCreatorResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_QueryListTradesByCreatorResponse proto.InternalMessageInfo
 
-type QueryGetItemHistoryRequest struct {
-	CookbookId   string `protobuf:"bytes,1,opt,name=cookbook_id,json=cookbookId,proto3" json:"cookbook_id,omitempty"`
-	ItemId       string `protobuf:"bytes,2,opt,name=item_id,json=itemId,proto3" json:"item_id,omitempty"`
-	MintedNumber string `protobuf:"bytes,3,opt,name=minted_number,json=mintedNumber,proto3" json:"minted_number,omitempty"`
+type QueryListTradesRequest struct {
+	// cookbook id of the items offered by the trades, any cookbook when empty
+	CookbookId string `protobuf:"bytes,1,opt,name=cookbook_id,json=cookbookId,proto3" json:"cookbook_id,omitempty"`
+	// denom of the coins asked by the trades, required to filter or sort by price
+	Denom string `protobuf:"bytes,2,opt,name=denom,proto3" json:"denom,omitempty"`
+	// inclusive range of the price per unit of the trades in denom, an empty bound is unbounded
+	MinPrice string `protobuf:"bytes,3,opt,name=min_price,json=minPrice,proto3" json:"min_price,omitempty"`
+	MaxPrice string `protobuf:"bytes,4,opt,name=max_price,json=maxPrice,proto3" json:"max_price,omitempty"`
+	// attribute filters matched by at least one of the items offered by the trades
+	Longs   []LongAttributeFilter   `protobuf:"bytes,5,rep,name=longs,proto3" json:"longs"`
+	Doubles []DoubleAttributeFilter `protobuf:"bytes,6,rep,name=doubles,proto3" json:"doubles"`
+	Strings []StringAttributeFilter `protobuf:"bytes,7,rep,name=strings,proto3" json:"strings"`
+	// order of the trades, either "creation" (default) or "price"
+	Sort string `protobuf:"bytes,8,opt,name=sort,proto3" json:"sort,omitempty"`
+	// pagination defines an optional pagination for the request.
+	Pagination *query.PageRequest `protobuf:"bytes,9,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
-func (m *QueryGetItemHistoryRequest) Reset()         { *m = QueryGetItemHistoryRequest{} }
-func (m *QueryGetItemHistoryRequest) String() string { return proto.CompactTextString(m) }
-func (*QueryGetItemHistoryRequest) ProtoMessage()    {}
-func (*QueryGetItemHistoryRequest) Descriptor() ([]byte, []int) {
+func (m *QueryListTradesRequest) Reset()         { *m = QueryListTradesRequest{} }
+func (m *QueryListTradesRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryListTradesRequest) ProtoMessage()    {}
+func (*QueryListTradesRequest) Descriptor() ([]byte, []int) {
 	return fileDescriptor_e873e88214e938bd, []int{4}
 }
-func (m *QueryGetItemHistoryRequest) XXX_Unmarshal(b []byte) error {
+func (m *QueryListTradesRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *QueryGetItemHistoryRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *QueryListTradesRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_QueryGetItemHistoryRequest.Marshal(b, m, deterministic)
+		return xxx_messageInfo_QueryListTradesRequest.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -236,55 +250,99 @@ func (m *QueryGetItemHistoryRequest) XXX_Marshal(b []byte, deterministic bool) (
 		return b[:n], nil
 	}
 }
-func (m *QueryGetItemHistoryRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_QueryGetItemHistoryRequest.Merge(m, src)
+func (m *QueryListTradesRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryListTradesRequest.Merge(m, src)
 }
-func (m *QueryGetItemHistoryRequest) XXX_Size() int {
+func (m *QueryListTradesRequest) XXX_Size() int {
 	return m.Size()
 }
-func (m *QueryGetItemHistoryRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_QueryGetItemHistoryRequest.DiscardUnknown(m)
+func (m *QueryListTradesRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryListTradesRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_QueryGetItemHistoryRequest proto.InternalMessageInfo
+var xxx_messageInfo_QueryListTradesRequest proto.InternalMessageInfo
 
-func (m *QueryGetItemHistoryRequest) GetCookbookId() string {
+func (m *QueryListTradesRequest) GetCookbookId() string {
 	if m != nil {
 		return m.CookbookId
 	}
 	return ""
 }
 
-func (m *QueryGetItemHistoryRequest) GetItemId() string {
+func (m *QueryListTradesRequest) GetDenom() string {
 	if m != nil {
-		return m.ItemId
+		return m.Denom
 	}
 	return ""
 }
 
-func (m *QueryGetItemHistoryRequest) GetMintedNumber() string {
+func (m *QueryListTradesRequest) GetMinPrice() string {
 	if m != nil {
-		return m.MintedNumber
+		return m.MinPrice
 	}
 	return ""
 }
 
-type QueryGetItemHistoryResponse struct {
-	History []*ItemHistory `protobuf:"bytes,1,rep,name=history,proto3" json:"history,omitempty"`
+func (m *QueryListTradesRequest) GetMaxPrice() string {
+	if m != nil {
+		return m.MaxPrice
+	}
+	return ""
 }
 
-func (m *QueryGetItemHistoryResponse) Reset()         { *m = QueryGetItemHistoryResponse{} }
-func (m *QueryGetItemHistoryResponse) String() string { return proto.CompactTextString(m) }
-func (*QueryGetItemHistoryResponse) ProtoMessage()    {}
-func (*QueryGetItemHistoryResponse) Descriptor() ([]byte, []int) {
+func (m *QueryListTradesRequest) GetLongs() []LongAttributeFilter {
+	if m != nil {
+		return m.Longs
+	}
+	return nil
+}
+
+func (m *QueryListTradesRequest) GetDoubles() []DoubleAttributeFilter {
+	if m != nil {
+		return m.Doubles
+	}
+	return nil
+}
+
+func (m *QueryListTradesRequest) GetStrings() []StringAttributeFilter {
+	if m != nil {
+		return m.Strings
+	}
+	return nil
+}
+
+func (m *QueryListTradesRequest) GetSort() string {
+	if m != nil {
+		return m.Sort
+	}
+	return ""
+}
+
+func (m *QueryListTradesRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+type QueryListTradesResponse struct {
+	Trades []Trade `protobuf:"bytes,1,rep,name=trades,proto3" json:"trades"`
+	// pagination defines the pagination in the response.
+	Pagination *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryListTradesResponse) Reset()         { *m = QueryListTradesResponse{} }
+func (m *QueryListTradesResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryListTradesResponse) ProtoMessage()    {}
+func (*QueryListTradesResponse) Descriptor() ([]byte, []int) {
 	return fileDescriptor_e873e88214e938bd, []int{5}
 }
-func (m *QueryGetItemHistoryResponse) XXX_Unmarshal(b []byte) error {
+func (m *QueryListTradesResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *QueryGetItemHistoryResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *QueryListTradesResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_QueryGetItemHistoryResponse.Marshal(b, m, deterministic)
+		return xxx_messageInfo_QueryListTradesResponse.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -294,42 +352,39 @@ func (m *QueryGetItemHistoryResponse) XXX_Marshal(b []byte, deterministic bool)
 		return b[:n], nil
 	}
 }
-func (m *QueryGetItemHistoryResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_QueryGetItemHistoryResponse.Merge(m, src)
+func (m *QueryListTradesResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryListTradesResponse.Merge(m, src)
 }
-func (m *QueryGetItemHistoryResponse) XXX_Size() int {
+func (m *QueryListTradesResponse) XXX_Size() int {
 	return m.Size()
 }
-func (m *QueryGetItemHistoryResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_QueryGetItemHistoryResponse.DiscardUnknown(m)
+func (m *QueryListTradesResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryListTradesResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_QueryGetItemHistoryResponse proto.InternalMessageInfo
-
-func (m *QueryGetItemHistoryResponse) GetHistory() []*ItemHistory {
-	if m != nil {
-		return m.History
-	}
-	return nil
-}
+var xxx_messageInfo_QueryListTradesResponse proto.InternalMessageInfo
 
-type QueryGetRecipeHistoryRequest struct {
-	CookbookId string `protobuf:"bytes,1,opt,name=cookbook_id,json=cookbookId,proto3" json:"cookbook_id,omitempty"`
-	RecipeId   string `protobuf:"bytes,2,opt,name=recipe_id,json=recipeId,proto3" json:"recipe_id,omitempty"`
+type QueryQuoteFulfillTradeRequest struct {
+	Id uint64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	// address fulfilling the trade, owner of the items
+	Fulfiller       string    `protobuf:"bytes,2,opt,name=fulfiller,proto3" json:"fulfiller,omitempty"`
+	CoinInputsIndex uint64    `protobuf:"varint,3,opt,name=coin_inputs_index,json=coinInputsIndex,proto3" json:"coin_inputs_index,omitempty"`
+	Items           []ItemRef `protobuf:"bytes,4,rep,name=items,proto3" json:"items"`
+	FillAmount      uint64    `protobuf:"varint,5,opt,name=fill_amount,json=fillAmount,proto3" json:"fill_amount,omitempty"`
 }
 
-func (m *QueryGetRecipeHistoryRequest) Reset()         { *m = QueryGetRecipeHistoryRequest{} }
-func (m *QueryGetRecipeHistoryRequest) String() string { return proto.CompactTextString(m) }
-func (*QueryGetRecipeHistoryRequest) ProtoMessage()    {}
-func (*QueryGetRecipeHistoryRequest) Descriptor() ([]byte, []int) {
+func (m *QueryQuoteFulfillTradeRequest) Reset()         { *m = QueryQuoteFulfillTradeRequest{} }
+func (m *QueryQuoteFulfillTradeRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryQuoteFulfillTradeRequest) ProtoMessage()    {}
+func (*QueryQuoteFulfillTradeRequest) Descriptor() ([]byte, []int) {
 	return fileDescriptor_e873e88214e938bd, []int{6}
 }
-func (m *QueryGetRecipeHistoryRequest) XXX_Unmarshal(b []byte) error {
+func (m *QueryQuoteFulfillTradeRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *QueryGetRecipeHistoryRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *QueryQuoteFulfillTradeRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_QueryGetRecipeHistoryRequest.Marshal(b, m, deterministic)
+		return xxx_messageInfo_QueryQuoteFulfillTradeRequest.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -339,48 +394,72 @@ func (m *QueryGetRecipeHistoryRequest) XXX_Marshal(b []byte, deterministic bool)
 		return b[:n], nil
 	}
 }
-func (m *QueryGetRecipeHistoryRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_QueryGetRecipeHistoryRequest.Merge(m, src)
+func (m *QueryQuoteFulfillTradeRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryQuoteFulfillTradeRequest.Merge(m, src)
 }
-func (m *QueryGetRecipeHistoryRequest) XXX_Size() int {
+func (m *QueryQuoteFulfillTradeRequest) XXX_Size() int {
 	return m.Size()
 }
-func (m *QueryGetRecipeHistoryRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_QueryGetRecipeHistoryRequest.DiscardUnknown(m)
+func (m *QueryQuoteFulfillTradeRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryQuoteFulfillTradeRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_QueryGetRecipeHistoryRequest proto.InternalMessageInfo
+var xxx_messageInfo_QueryQuoteFulfillTradeRequest proto.InternalMessageInfo
 
-func (m *QueryGetRecipeHistoryRequest) GetCookbookId() string {
+func (m *QueryQuoteFulfillTradeRequest) GetId() uint64 {
 	if m != nil {
-		return m.CookbookId
+		return m.Id
 	}
-	return ""
+	return 0
 }
 
-func (m *QueryGetRecipeHistoryRequest) GetRecipeId() string {
+func (m *QueryQuoteFulfillTradeRequest) GetFulfiller() string {
 	if m != nil {
-		return m.RecipeId
+		return m.Fulfiller
 	}
 	return ""
 }
 
-type QueryGetRecipeHistoryResponse struct {
-	History []*RecipeHistory `protobuf:"bytes,1,rep,name=history,proto3" json:"history,omitempty"`
+func (m *QueryQuoteFulfillTradeRequest) GetCoinInputsIndex() uint64 {
+	if m != nil {
+		return m.CoinInputsIndex
+	}
+	return 0
 }
 
-func (m *QueryGetRecipeHistoryResponse) Reset()         { *m = QueryGetRecipeHistoryResponse{} }
-func (m *QueryGetRecipeHistoryResponse) String() string { return proto.CompactTextString(m) }
-func (*QueryGetRecipeHistoryResponse) ProtoMessage()    {}
-func (*QueryGetRecipeHistoryResponse) Descriptor() ([]byte, []int) {
+func (m *QueryQuoteFulfillTradeRequest) GetItems() []ItemRef {
+	if m != nil {
+		return m.Items
+	}
+	return nil
+}
+
+func (m *QueryQuoteFulfillTradeRequest) GetFillAmount() uint64 {
+	if m != nil {
+		return m.FillAmount
+	}
+	return 0
+}
+
+// CookbookRoyalty is the royalty received by the owner of a cookbook for the transfer of its items
+type CookbookRoyalty struct {
+	CookbookId string                                   `protobuf:"bytes,1,opt,name=cookbook_id,json=cookbookId,proto3" json:"cookbook_id,omitempty"`
+	Owner      string                                   `protobuf:"bytes,2,opt,name=owner,proto3" json:"owner,omitempty"`
+	Amount     github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,3,rep,name=amount,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"amount"`
+}
+
+func (m *CookbookRoyalty) Reset()         { *m = CookbookRoyalty{} }
+func (m *CookbookRoyalty) String() string { return proto.CompactTextString(m) }
+func (*CookbookRoyalty) ProtoMessage()    {}
+func (*CookbookRoyalty) Descriptor() ([]byte, []int) {
 	return fileDescriptor_e873e88214e938bd, []int{7}
 }
-func (m *QueryGetRecipeHistoryResponse) XXX_Unmarshal(b []byte) error {
+func (m *CookbookRoyalty) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *QueryGetRecipeHistoryResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *CookbookRoyalty) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_QueryGetRecipeHistoryResponse.Marshal(b, m, deterministic)
+		return xxx_messageInfo_CookbookRoyalty.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -390,48 +469,65 @@ func (m *QueryGetRecipeHistoryResponse) XXX_Marshal(b []byte, deterministic bool
 		return b[:n], nil
 	}
 }
-func (m *QueryGetRecipeHistoryResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_QueryGetRecipeHistoryResponse.Merge(m, src)
+func (m *CookbookRoyalty) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CookbookRoyalty.Merge(m, src)
 }
-func (m *QueryGetRecipeHistoryResponse) XXX_Size() int {
+func (m *CookbookRoyalty) XXX_Size() int {
 	return m.Size()
 }
-func (m *QueryGetRecipeHistoryResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_QueryGetRecipeHistoryResponse.DiscardUnknown(m)
+func (m *CookbookRoyalty) XXX_DiscardUnknown() {
+	xxx_messageInfo_CookbookRoyalty.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_QueryGetRecipeHistoryResponse proto.InternalMessageInfo
+var xxx_messageInfo_CookbookRoyalty proto.InternalMessageInfo
 
-func (m *QueryGetRecipeHistoryResponse) GetHistory() []*RecipeHistory {
+func (m *CookbookRoyalty) GetCookbookId() string {
 	if m != nil {
-		return m.History
+		return m.CookbookId
 	}
-	return nil
+	return ""
 }
 
-type RecipeHistory struct {
-	ItemId     string `protobuf:"bytes,1,opt,name=item_id,json=itemId,proto3" json:"item_id,omitempty"`
-	CookbookId string `protobuf:"bytes,2,opt,name=cookbook_id,json=cookbookId,proto3" json:"cookbook_id,omitempty"`
-	RecipeId   string `protobuf:"bytes,3,opt,name=recipe_id,json=recipeId,proto3" json:"recipe_id,omitempty"`
-	Sender     string `protobuf:"bytes,4,opt,name=sender,proto3" json:"sender,omitempty"`
-	SenderName string `protobuf:"bytes,5,opt,name=sender_name,json=senderName,proto3" json:"sender_name,omitempty"`
-	Receiver   string `protobuf:"bytes,6,opt,name=receiver,proto3" json:"receiver,omitempty"`
-	Amount     string `protobuf:"bytes,7,opt,name=amount,proto3" json:"amount,omitempty"`
-	CreatedAt  int64  `protobuf:"varint,8,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+func (m *CookbookRoyalty) GetOwner() string {
+	if m != nil {
+		return m.Owner
+	}
+	return ""
 }
 
-func (m *RecipeHistory) Reset()         { *m = RecipeHistory{} }
-func (m *RecipeHistory) String() string { return proto.CompactTextString(m) }
-func (*RecipeHistory) ProtoMessage()    {}
-func (*RecipeHistory) Descriptor() ([]byte, []int) {
+func (m *CookbookRoyalty) GetAmount() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.Amount
+	}
+	return nil
+}
+
+type QueryQuoteFulfillTradeResponse struct {
+	// transfer fees of the items provided by the fulfiller
+	FulfillerPays github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,1,rep,name=fulfiller_pays,json=fulfillerPays,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"fulfiller_pays"`
+	// coinOutputs of the filled units, locked by the trade creator, and transfer fees of the itemOutputs
+	CreatorPays github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,2,rep,name=creator_pays,json=creatorPays,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"creator_pays"`
+	// coinOutputs of the filled units and the part of the itemOutputs transfer fees sent to the fulfiller
+	FulfillerReceives github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,3,rep,name=fulfiller_receives,json=fulfillerReceives,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"fulfiller_receives"`
+	// part of the transfer fees of the items provided by the fulfiller sent to the trade creator
+	CreatorReceives github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,4,rep,name=creator_receives,json=creatorReceives,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"creator_receives"`
+	// royalties of the cookbook owners, ordered by cookbook id
+	Royalties []CookbookRoyalty                        `protobuf:"bytes,5,rep,name=royalties,proto3" json:"royalties"`
+	ChainFees github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,6,rep,name=chain_fees,json=chainFees,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"chain_fees"`
+}
+
+func (m *QueryQuoteFulfillTradeResponse) Reset()         { *m = QueryQuoteFulfillTradeResponse{} }
+func (m *QueryQuoteFulfillTradeResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryQuoteFulfillTradeResponse) ProtoMessage()    {}
+func (*QueryQuoteFulfillTradeResponse) Descriptor() ([]byte, []int) {
 	return fileDescriptor_e873e88214e938bd, []int{8}
 }
-func (m *RecipeHistory) XXX_Unmarshal(b []byte) error {
+func (m *QueryQuoteFulfillTradeResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *RecipeHistory) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *QueryQuoteFulfillTradeResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_RecipeHistory.Marshal(b, m, deterministic)
+		return xxx_messageInfo_QueryQuoteFulfillTradeResponse.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -441,89 +537,77 @@ func (m *RecipeHistory) XXX_Marshal(b []byte, deterministic bool) ([]byte, error
 		return b[:n], nil
 	}
 }
-func (m *RecipeHistory) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_RecipeHistory.Merge(m, src)
+func (m *QueryQuoteFulfillTradeResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryQuoteFulfillTradeResponse.Merge(m, src)
 }
-func (m *RecipeHistory) XXX_Size() int {
+func (m *QueryQuoteFulfillTradeResponse) XXX_Size() int {
 	return m.Size()
 }
-func (m *RecipeHistory) XXX_DiscardUnknown() {
-	xxx_messageInfo_RecipeHistory.DiscardUnknown(m)
-}
-
-var xxx_messageInfo_RecipeHistory proto.InternalMessageInfo
-
-func (m *RecipeHistory) GetItemId() string {
-	if m != nil {
-		return m.ItemId
-	}
-	return ""
+func (m *QueryQuoteFulfillTradeResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryQuoteFulfillTradeResponse.DiscardUnknown(m)
 }
 
-func (m *RecipeHistory) GetCookbookId() string {
-	if m != nil {
-		return m.CookbookId
-	}
-	return ""
-}
+var xxx_messageInfo_QueryQuoteFulfillTradeResponse proto.InternalMessageInfo
 
-func (m *RecipeHistory) GetRecipeId() string {
+func (m *QueryQuoteFulfillTradeResponse) GetFulfillerPays() github_com_cosmos_cosmos_sdk_types.Coins {
 	if m != nil {
-		return m.RecipeId
+		return m.FulfillerPays
 	}
-	return ""
+	return nil
 }
 
-func (m *RecipeHistory) GetSender() string {
+func (m *QueryQuoteFulfillTradeResponse) GetCreatorPays() github_com_cosmos_cosmos_sdk_types.Coins {
 	if m != nil {
-		return m.Sender
+		return m.CreatorPays
 	}
-	return ""
+	return nil
 }
 
-func (m *RecipeHistory) GetSenderName() string {
+func (m *QueryQuoteFulfillTradeResponse) GetFulfillerReceives() github_com_cosmos_cosmos_sdk_types.Coins {
 	if m != nil {
-		return m.SenderName
+		return m.FulfillerReceives
 	}
-	return ""
+	return nil
 }
 
-func (m *RecipeHistory) GetReceiver() string {
+func (m *QueryQuoteFulfillTradeResponse) GetCreatorReceives() github_com_cosmos_cosmos_sdk_types.Coins {
 	if m != nil {
-		return m.Receiver
+		return m.CreatorReceives
 	}
-	return ""
+	return nil
 }
 
-func (m *RecipeHistory) GetAmount() string {
+func (m *QueryQuoteFulfillTradeResponse) GetRoyalties() []CookbookRoyalty {
 	if m != nil {
-		return m.Amount
+		return m.Royalties
 	}
-	return ""
+	return nil
 }
 
-func (m *RecipeHistory) GetCreatedAt() int64 {
+func (m *QueryQuoteFulfillTradeResponse) GetChainFees() github_com_cosmos_cosmos_sdk_types.Coins {
 	if m != nil {
-		return m.CreatedAt
+		return m.ChainFees
 	}
-	return 0
+	return nil
 }
 
-type QueryGetStripeRefundRequest struct {
+type QueryListTradesByReceiverRequest struct {
+	Receiver   string             `protobuf:"bytes,1,opt,name=receiver,proto3" json:"receiver,omitempty"`
+	Pagination *query.PageRequest `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
-func (m *QueryGetStripeRefundRequest) Reset()         { *m = QueryGetStripeRefundRequest{} }
-func (m *QueryGetStripeRefundRequest) String() string { return proto.CompactTextString(m) }
-func (*QueryGetStripeRefundRequest) ProtoMessage()    {}
-func (*QueryGetStripeRefundRequest) Descriptor() ([]byte, []int) {
+func (m *QueryListTradesByReceiverRequest) Reset()         { *m = QueryListTradesByReceiverRequest{} }
+func (m *QueryListTradesByReceiverRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryListTradesByReceiverRequest) ProtoMessage()    {}
+func (*QueryListTradesByReceiverRequest) Descriptor() ([]byte, []int) {
 	return fileDescriptor_e873e88214e938bd, []int{9}
 }
-func (m *QueryGetStripeRefundRequest) XXX_Unmarshal(b []byte) error {
+func (m *QueryListTradesByReceiverRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *QueryGetStripeRefundRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *QueryListTradesByReceiverRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_QueryGetStripeRefundRequest.Marshal(b, m, deterministic)
+		return xxx_messageInfo_QueryListTradesByReceiverRequest.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -533,34 +617,50 @@ func (m *QueryGetStripeRefundRequest) XXX_Marshal(b []byte, deterministic bool)
 		return b[:n], nil
 	}
 }
-func (m *QueryGetStripeRefundRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_QueryGetStripeRefundRequest.Merge(m, src)
+func (m *QueryListTradesByReceiverRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryListTradesByReceiverRequest.Merge(m, src)
 }
-func (m *QueryGetStripeRefundRequest) XXX_Size() int {
+func (m *QueryListTradesByReceiverRequest) XXX_Size() int {
 	return m.Size()
 }
-func (m *QueryGetStripeRefundRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_QueryGetStripeRefundRequest.DiscardUnknown(m)
+func (m *QueryListTradesByReceiverRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryListTradesByReceiverRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_QueryGetStripeRefundRequest proto.InternalMessageInfo
+var xxx_messageInfo_QueryListTradesByReceiverRequest proto.InternalMessageInfo
 
-type QueryGetStripeRefundResponse struct {
-	Refunds []*StripeRefund `protobuf:"bytes,1,rep,name=refunds,proto3" json:"refunds,omitempty"`
+func (m *QueryListTradesByReceiverRequest) GetReceiver() string {
+	if m != nil {
+		return m.Receiver
+	}
+	return ""
 }
 
-func (m *QueryGetStripeRefundResponse) Reset()         { *m = QueryGetStripeRefundResponse{} }
-func (m *QueryGetStripeRefundResponse) String() string { return proto.CompactTextString(m) }
-func (*QueryGetStripeRefundResponse) ProtoMessage()    {}
-func (*QueryGetStripeRefundResponse) Descriptor() ([]byte, []int) {
+func (m *QueryListTradesByReceiverRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+type QueryListTradesByReceiverResponse struct {
+	Trades []Trade `protobuf:"bytes,1,rep,name=trades,proto3" json:"trades"`
+	// pagination defines the pagination in the response.
+	Pagination *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryListTradesByReceiverResponse) Reset()         { *m = QueryListTradesByReceiverResponse{} }
+func (m *QueryListTradesByReceiverResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryListTradesByReceiverResponse) ProtoMessage()    {}
+func (*QueryListTradesByReceiverResponse) Descriptor() ([]byte, []int) {
 	return fileDescriptor_e873e88214e938bd, []int{10}
 }
-func (m *QueryGetStripeRefundResponse) XXX_Unmarshal(b []byte) error {
+func (m *QueryListTradesByReceiverResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *QueryGetStripeRefundResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *QueryListTradesByReceiverResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_QueryGetStripeRefundResponse.Marshal(b, m, deterministic)
+		return xxx_messageInfo_QueryListTradesByReceiverResponse.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -570,41 +670,36 @@ func (m *QueryGetStripeRefundResponse) XXX_Marshal(b []byte, deterministic bool)
 		return b[:n], nil
 	}
 }
-func (m *QueryGetStripeRefundResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_QueryGetStripeRefundResponse.Merge(m, src)
+func (m *QueryListTradesByReceiverResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryListTradesByReceiverResponse.Merge(m, src)
 }
-func (m *QueryGetStripeRefundResponse) XXX_Size() int {
+func (m *QueryListTradesByReceiverResponse) XXX_Size() int {
 	return m.Size()
 }
-func (m *QueryGetStripeRefundResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_QueryGetStripeRefundResponse.DiscardUnknown(m)
+func (m *QueryListTradesByReceiverResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryListTradesByReceiverResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_QueryGetStripeRefundResponse proto.InternalMessageInfo
+var xxx_messageInfo_QueryListTradesByReceiverResponse proto.InternalMessageInfo
 
-func (m *QueryGetStripeRefundResponse) GetRefunds() []*StripeRefund {
-	if m != nil {
-		return m.Refunds
-	}
-	return nil
-}
-
-type QueryGetRedeemInfoRequest struct {
-	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+type QueryGetItemHistoryRequest struct {
+	CookbookId   string `protobuf:"bytes,1,opt,name=cookbook_id,json=cookbookId,proto3" json:"cookbook_id,omitempty"`
+	ItemId       string `protobuf:"bytes,2,opt,name=item_id,json=itemId,proto3" json:"item_id,omitempty"`
+	MintedNumber string `protobuf:"bytes,3,opt,name=minted_number,json=mintedNumber,proto3" json:"minted_number,omitempty"`
 }
 
-func (m *QueryGetRedeemInfoRequest) Reset()         { *m = QueryGetRedeemInfoRequest{} }
-func (m *QueryGetRedeemInfoRequest) String() string { return proto.CompactTextString(m) }
-func (*QueryGetRedeemInfoRequest) ProtoMessage()    {}
-func (*QueryGetRedeemInfoRequest) Descriptor() ([]byte, []int) {
+func (m *QueryGetItemHistoryRequest) Reset()         { *m = QueryGetItemHistoryRequest{} }
+func (m *QueryGetItemHistoryRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryGetItemHistoryRequest) ProtoMessage()    {}
+func (*QueryGetItemHistoryRequest) Descriptor() ([]byte, []int) {
 	return fileDescriptor_e873e88214e938bd, []int{11}
 }
-func (m *QueryGetRedeemInfoRequest) XXX_Unmarshal(b []byte) error {
+func (m *QueryGetItemHistoryRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *QueryGetRedeemInfoRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *QueryGetItemHistoryRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_QueryGetRedeemInfoRequest.Marshal(b, m, deterministic)
+		return xxx_messageInfo_QueryGetItemHistoryRequest.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -614,41 +709,55 @@ func (m *QueryGetRedeemInfoRequest) XXX_Marshal(b []byte, deterministic bool) ([
 		return b[:n], nil
 	}
 }
-func (m *QueryGetRedeemInfoRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_QueryGetRedeemInfoRequest.Merge(m, src)
+func (m *QueryGetItemHistoryRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryGetItemHistoryRequest.Merge(m, src)
 }
-func (m *QueryGetRedeemInfoRequest) XXX_Size() int {
+func (m *QueryGetItemHistoryRequest) XXX_Size() int {
 	return m.Size()
 }
-func (m *QueryGetRedeemInfoRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_QueryGetRedeemInfoRequest.DiscardUnknown(m)
+func (m *QueryGetItemHistoryRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryGetItemHistoryRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_QueryGetRedeemInfoRequest proto.InternalMessageInfo
+var xxx_messageInfo_QueryGetItemHistoryRequest proto.InternalMessageInfo
 
-func (m *QueryGetRedeemInfoRequest) GetId() string {
+func (m *QueryGetItemHistoryRequest) GetCookbookId() string {
 	if m != nil {
-		return m.Id
+		return m.CookbookId
 	}
 	return ""
 }
 
-type QueryGetRedeemInfoResponse struct {
-	RedeemInfo RedeemInfo `protobuf:"bytes,1,opt,name=redeem_info,json=redeemInfo,proto3" json:"redeem_info"`
+func (m *QueryGetItemHistoryRequest) GetItemId() string {
+	if m != nil {
+		return m.ItemId
+	}
+	return ""
 }
 
-func (m *QueryGetRedeemInfoResponse) Reset()         { *m = QueryGetRedeemInfoResponse{} }
-func (m *QueryGetRedeemInfoResponse) String() string { return proto.CompactTextString(m) }
-func (*QueryGetRedeemInfoResponse) ProtoMessage()    {}
-func (*QueryGetRedeemInfoResponse) Descriptor() ([]byte, []int) {
+func (m *QueryGetItemHistoryRequest) GetMintedNumber() string {
+	if m != nil {
+		return m.MintedNumber
+	}
+	return ""
+}
+
+type QueryGetItemHistoryResponse struct {
+	History []*ItemHistory `protobuf:"bytes,1,rep,name=history,proto3" json:"history,omitempty"`
+}
+
+func (m *QueryGetItemHistoryResponse) Reset()         { *m = QueryGetItemHistoryResponse{} }
+func (m *QueryGetItemHistoryResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryGetItemHistoryResponse) ProtoMessage()    {}
+func (*QueryGetItemHistoryResponse) Descriptor() ([]byte, []int) {
 	return fileDescriptor_e873e88214e938bd, []int{12}
 }
-func (m *QueryGetRedeemInfoResponse) XXX_Unmarshal(b []byte) error {
+func (m *QueryGetItemHistoryResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *QueryGetRedeemInfoResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *QueryGetItemHistoryResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_QueryGetRedeemInfoResponse.Marshal(b, m, deterministic)
+		return xxx_messageInfo_QueryGetItemHistoryResponse.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -658,41 +767,42 @@ func (m *QueryGetRedeemInfoResponse) XXX_Marshal(b []byte, deterministic bool) (
 		return b[:n], nil
 	}
 }
-func (m *QueryGetRedeemInfoResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_QueryGetRedeemInfoResponse.Merge(m, src)
+func (m *QueryGetItemHistoryResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryGetItemHistoryResponse.Merge(m, src)
 }
-func (m *QueryGetRedeemInfoResponse) XXX_Size() int {
+func (m *QueryGetItemHistoryResponse) XXX_Size() int {
 	return m.Size()
 }
-func (m *QueryGetRedeemInfoResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_QueryGetRedeemInfoResponse.DiscardUnknown(m)
+func (m *QueryGetItemHistoryResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryGetItemHistoryResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_QueryGetRedeemInfoResponse proto.InternalMessageInfo
+var xxx_messageInfo_QueryGetItemHistoryResponse proto.InternalMessageInfo
 
-func (m *QueryGetRedeemInfoResponse) GetRedeemInfo() RedeemInfo {
+func (m *QueryGetItemHistoryResponse) GetHistory() []*ItemHistory {
 	if m != nil {
-		return m.RedeemInfo
+		return m.History
 	}
-	return RedeemInfo{}
+	return nil
 }
 
-type QueryAllRedeemInfoRequest struct {
-	Pagination *query.PageRequest `protobuf:"bytes,1,opt,name=pagination,proto3" json:"pagination,omitempty"`
+type QueryGetItemAttributesHistoryRequest struct {
+	CookbookId string `protobuf:"bytes,1,opt,name=cookbook_id,json=cookbookId,proto3" json:"cookbook_id,omitempty"`
+	ItemId     string `protobuf:"bytes,2,opt,name=item_id,json=itemId,proto3" json:"item_id,omitempty"`
 }
 
-func (m *QueryAllRedeemInfoRequest) Reset()         { *m = QueryAllRedeemInfoRequest{} }
-func (m *QueryAllRedeemInfoRequest) String() string { return proto.CompactTextString(m) }
-func (*QueryAllRedeemInfoRequest) ProtoMessage()    {}
-func (*QueryAllRedeemInfoRequest) Descriptor() ([]byte, []int) {
+func (m *QueryGetItemAttributesHistoryRequest) Reset()         { *m = QueryGetItemAttributesHistoryRequest{} }
+func (m *QueryGetItemAttributesHistoryRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryGetItemAttributesHistoryRequest) ProtoMessage()    {}
+func (*QueryGetItemAttributesHistoryRequest) Descriptor() ([]byte, []int) {
 	return fileDescriptor_e873e88214e938bd, []int{13}
 }
-func (m *QueryAllRedeemInfoRequest) XXX_Unmarshal(b []byte) error {
+func (m *QueryGetItemAttributesHistoryRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *QueryAllRedeemInfoRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *QueryGetItemAttributesHistoryRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_QueryAllRedeemInfoRequest.Marshal(b, m, deterministic)
+		return xxx_messageInfo_QueryGetItemAttributesHistoryRequest.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -702,42 +812,48 @@ func (m *QueryAllRedeemInfoRequest) XXX_Marshal(b []byte, deterministic bool) ([
 		return b[:n], nil
 	}
 }
-func (m *QueryAllRedeemInfoRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_QueryAllRedeemInfoRequest.Merge(m, src)
+func (m *QueryGetItemAttributesHistoryRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryGetItemAttributesHistoryRequest.Merge(m, src)
 }
-func (m *QueryAllRedeemInfoRequest) XXX_Size() int {
+func (m *QueryGetItemAttributesHistoryRequest) XXX_Size() int {
 	return m.Size()
 }
-func (m *QueryAllRedeemInfoRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_QueryAllRedeemInfoRequest.DiscardUnknown(m)
+func (m *QueryGetItemAttributesHistoryRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryGetItemAttributesHistoryRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_QueryAllRedeemInfoRequest proto.InternalMessageInfo
+var xxx_messageInfo_QueryGetItemAttributesHistoryRequest proto.InternalMessageInfo
 
-func (m *QueryAllRedeemInfoRequest) GetPagination() *query.PageRequest {
+func (m *QueryGetItemAttributesHistoryRequest) GetCookbookId() string {
 	if m != nil {
-		return m.Pagination
+		return m.CookbookId
 	}
-	return nil
+	return ""
 }
 
-type QueryAllRedeemInfoResponse struct {
-	RedeemInfo []RedeemInfo        `protobuf:"bytes,1,rep,name=redeem_info,json=redeemInfo,proto3" json:"redeem_info"`
-	Pagination *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+func (m *QueryGetItemAttributesHistoryRequest) GetItemId() string {
+	if m != nil {
+		return m.ItemId
+	}
+	return ""
 }
 
-func (m *QueryAllRedeemInfoResponse) Reset()         { *m = QueryAllRedeemInfoResponse{} }
-func (m *QueryAllRedeemInfoResponse) String() string { return proto.CompactTextString(m) }
-func (*QueryAllRedeemInfoResponse) ProtoMessage()    {}
-func (*QueryAllRedeemInfoResponse) Descriptor() ([]byte, []int) {
+type QueryGetItemAttributesHistoryResponse struct {
+	History []ItemAttributesHistory `protobuf:"bytes,1,rep,name=history,proto3" json:"history"`
+}
+
+func (m *QueryGetItemAttributesHistoryResponse) Reset()         { *m = QueryGetItemAttributesHistoryResponse{} }
+func (m *QueryGetItemAttributesHistoryResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryGetItemAttributesHistoryResponse) ProtoMessage()    {}
+func (*QueryGetItemAttributesHistoryResponse) Descriptor() ([]byte, []int) {
 	return fileDescriptor_e873e88214e938bd, []int{14}
 }
-func (m *QueryAllRedeemInfoResponse) XXX_Unmarshal(b []byte) error {
+func (m *QueryGetItemAttributesHistoryResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *QueryAllRedeemInfoResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *QueryGetItemAttributesHistoryResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_QueryAllRedeemInfoResponse.Marshal(b, m, deterministic)
+		return xxx_messageInfo_QueryGetItemAttributesHistoryResponse.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -747,48 +863,44 @@ func (m *QueryAllRedeemInfoResponse) XXX_Marshal(b []byte, deterministic bool) (
 		return b[:n], nil
 	}
 }
-func (m *QueryAllRedeemInfoResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_QueryAllRedeemInfoResponse.Merge(m, src)
+func (m *QueryGetItemAttributesHistoryResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryGetItemAttributesHistoryResponse.Merge(m, src)
 }
-func (m *QueryAllRedeemInfoResponse) XXX_Size() int {
+func (m *QueryGetItemAttributesHistoryResponse) XXX_Size() int {
 	return m.Size()
 }
-func (m *QueryAllRedeemInfoResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_QueryAllRedeemInfoResponse.DiscardUnknown(m)
+func (m *QueryGetItemAttributesHistoryResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryGetItemAttributesHistoryResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_QueryAllRedeemInfoResponse proto.InternalMessageInfo
-
-func (m *QueryAllRedeemInfoResponse) GetRedeemInfo() []RedeemInfo {
-	if m != nil {
-		return m.RedeemInfo
-	}
-	return nil
-}
+var xxx_messageInfo_QueryGetItemAttributesHistoryResponse proto.InternalMessageInfo
 
-func (m *QueryAllRedeemInfoResponse) GetPagination() *query.PageResponse {
+func (m *QueryGetItemAttributesHistoryResponse) GetHistory() []ItemAttributesHistory {
 	if m != nil {
-		return m.Pagination
+		return m.History
 	}
 	return nil
 }
 
-type QueryGetPaymentInfoRequest struct {
-	PurchaseId string `protobuf:"bytes,1,opt,name=purchase_id,json=purchaseId,proto3" json:"purchase_id,omitempty"`
+type QueryGetItemProvenanceRequest struct {
+	CookbookId string `protobuf:"bytes,1,opt,name=cookbook_id,json=cookbookId,proto3" json:"cookbook_id,omitempty"`
+	ItemId     string `protobuf:"bytes,2,opt,name=item_id,json=itemId,proto3" json:"item_id,omitempty"`
+	// pagination defines an optional pagination for the request, reverse lists the newest events first.
+	Pagination *query.PageRequest `protobuf:"bytes,3,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
-func (m *QueryGetPaymentInfoRequest) Reset()         { *m = QueryGetPaymentInfoRequest{} }
-func (m *QueryGetPaymentInfoRequest) String() string { return proto.CompactTextString(m) }
-func (*QueryGetPaymentInfoRequest) ProtoMessage()    {}
-func (*QueryGetPaymentInfoRequest) Descriptor() ([]byte, []int) {
+func (m *QueryGetItemProvenanceRequest) Reset()         { *m = QueryGetItemProvenanceRequest{} }
+func (m *QueryGetItemProvenanceRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryGetItemProvenanceRequest) ProtoMessage()    {}
+func (*QueryGetItemProvenanceRequest) Descriptor() ([]byte, []int) {
 	return fileDescriptor_e873e88214e938bd, []int{15}
 }
-func (m *QueryGetPaymentInfoRequest) XXX_Unmarshal(b []byte) error {
+func (m *QueryGetItemProvenanceRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *QueryGetPaymentInfoRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *QueryGetItemProvenanceRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_QueryGetPaymentInfoRequest.Marshal(b, m, deterministic)
+		return xxx_messageInfo_QueryGetItemProvenanceRequest.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -798,41 +910,57 @@ func (m *QueryGetPaymentInfoRequest) XXX_Marshal(b []byte, deterministic bool) (
 		return b[:n], nil
 	}
 }
-func (m *QueryGetPaymentInfoRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_QueryGetPaymentInfoRequest.Merge(m, src)
+func (m *QueryGetItemProvenanceRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryGetItemProvenanceRequest.Merge(m, src)
 }
-func (m *QueryGetPaymentInfoRequest) XXX_Size() int {
+func (m *QueryGetItemProvenanceRequest) XXX_Size() int {
 	return m.Size()
 }
-func (m *QueryGetPaymentInfoRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_QueryGetPaymentInfoRequest.DiscardUnknown(m)
+func (m *QueryGetItemProvenanceRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryGetItemProvenanceRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_QueryGetPaymentInfoRequest proto.InternalMessageInfo
+var xxx_messageInfo_QueryGetItemProvenanceRequest proto.InternalMessageInfo
 
-func (m *QueryGetPaymentInfoRequest) GetPurchaseId() string {
+func (m *QueryGetItemProvenanceRequest) GetCookbookId() string {
 	if m != nil {
-		return m.PurchaseId
+		return m.CookbookId
 	}
 	return ""
 }
 
-type QueryGetPaymentInfoResponse struct {
-	PaymentInfo PaymentInfo `protobuf:"bytes,1,opt,name=payment_info,json=paymentInfo,proto3" json:"payment_info"`
+func (m *QueryGetItemProvenanceRequest) GetItemId() string {
+	if m != nil {
+		return m.ItemId
+	}
+	return ""
 }
 
-func (m *QueryGetPaymentInfoResponse) Reset()         { *m = QueryGetPaymentInfoResponse{} }
-func (m *QueryGetPaymentInfoResponse) String() string { return proto.CompactTextString(m) }
-func (*QueryGetPaymentInfoResponse) ProtoMessage()    {}
-func (*QueryGetPaymentInfoResponse) Descriptor() ([]byte, []int) {
+func (m *QueryGetItemProvenanceRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+type QueryGetItemProvenanceResponse struct {
+	Provenance []ItemProvenance `protobuf:"bytes,1,rep,name=provenance,proto3" json:"provenance"`
+	// pagination defines the pagination in the response.
+	Pagination *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryGetItemProvenanceResponse) Reset()         { *m = QueryGetItemProvenanceResponse{} }
+func (m *QueryGetItemProvenanceResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryGetItemProvenanceResponse) ProtoMessage()    {}
+func (*QueryGetItemProvenanceResponse) Descriptor() ([]byte, []int) {
 	return fileDescriptor_e873e88214e938bd, []int{16}
 }
-func (m *QueryGetPaymentInfoResponse) XXX_Unmarshal(b []byte) error {
+func (m *QueryGetItemProvenanceResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *QueryGetPaymentInfoResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *QueryGetItemProvenanceResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_QueryGetPaymentInfoResponse.Marshal(b, m, deterministic)
+		return xxx_messageInfo_QueryGetItemProvenanceResponse.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -842,41 +970,49 @@ func (m *QueryGetPaymentInfoResponse) XXX_Marshal(b []byte, deterministic bool)
 		return b[:n], nil
 	}
 }
-func (m *QueryGetPaymentInfoResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_QueryGetPaymentInfoResponse.Merge(m, src)
+func (m *QueryGetItemProvenanceResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryGetItemProvenanceResponse.Merge(m, src)
 }
-func (m *QueryGetPaymentInfoResponse) XXX_Size() int {
+func (m *QueryGetItemProvenanceResponse) XXX_Size() int {
 	return m.Size()
 }
-func (m *QueryGetPaymentInfoResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_QueryGetPaymentInfoResponse.DiscardUnknown(m)
+func (m *QueryGetItemProvenanceResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryGetItemProvenanceResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_QueryGetPaymentInfoResponse proto.InternalMessageInfo
+var xxx_messageInfo_QueryGetItemProvenanceResponse proto.InternalMessageInfo
 
-func (m *QueryGetPaymentInfoResponse) GetPaymentInfo() PaymentInfo {
+func (m *QueryGetItemProvenanceResponse) GetProvenance() []ItemProvenance {
 	if m != nil {
-		return m.PaymentInfo
+		return m.Provenance
 	}
-	return PaymentInfo{}
+	return nil
 }
 
-type QueryAllPaymentInfoRequest struct {
-	Pagination *query.PageRequest `protobuf:"bytes,1,opt,name=pagination,proto3" json:"pagination,omitempty"`
+func (m *QueryGetItemProvenanceResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
 }
 
-func (m *QueryAllPaymentInfoRequest) Reset()         { *m = QueryAllPaymentInfoRequest{} }
-func (m *QueryAllPaymentInfoRequest) String() string { return proto.CompactTextString(m) }
-func (*QueryAllPaymentInfoRequest) ProtoMessage()    {}
-func (*QueryAllPaymentInfoRequest) Descriptor() ([]byte, []int) {
+type QueryGetRecipeHistoryRequest struct {
+	CookbookId string `protobuf:"bytes,1,opt,name=cookbook_id,json=cookbookId,proto3" json:"cookbook_id,omitempty"`
+	RecipeId   string `protobuf:"bytes,2,opt,name=recipe_id,json=recipeId,proto3" json:"recipe_id,omitempty"`
+}
+
+func (m *QueryGetRecipeHistoryRequest) Reset()         { *m = QueryGetRecipeHistoryRequest{} }
+func (m *QueryGetRecipeHistoryRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryGetRecipeHistoryRequest) ProtoMessage()    {}
+func (*QueryGetRecipeHistoryRequest) Descriptor() ([]byte, []int) {
 	return fileDescriptor_e873e88214e938bd, []int{17}
 }
-func (m *QueryAllPaymentInfoRequest) XXX_Unmarshal(b []byte) error {
+func (m *QueryGetRecipeHistoryRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *QueryAllPaymentInfoRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *QueryGetRecipeHistoryRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_QueryAllPaymentInfoRequest.Marshal(b, m, deterministic)
+		return xxx_messageInfo_QueryGetRecipeHistoryRequest.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -886,42 +1022,48 @@ func (m *QueryAllPaymentInfoRequest) XXX_Marshal(b []byte, deterministic bool) (
 		return b[:n], nil
 	}
 }
-func (m *QueryAllPaymentInfoRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_QueryAllPaymentInfoRequest.Merge(m, src)
+func (m *QueryGetRecipeHistoryRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryGetRecipeHistoryRequest.Merge(m, src)
 }
-func (m *QueryAllPaymentInfoRequest) XXX_Size() int {
+func (m *QueryGetRecipeHistoryRequest) XXX_Size() int {
 	return m.Size()
 }
-func (m *QueryAllPaymentInfoRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_QueryAllPaymentInfoRequest.DiscardUnknown(m)
+func (m *QueryGetRecipeHistoryRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryGetRecipeHistoryRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_QueryAllPaymentInfoRequest proto.InternalMessageInfo
+var xxx_messageInfo_QueryGetRecipeHistoryRequest proto.InternalMessageInfo
 
-func (m *QueryAllPaymentInfoRequest) GetPagination() *query.PageRequest {
+func (m *QueryGetRecipeHistoryRequest) GetCookbookId() string {
 	if m != nil {
-		return m.Pagination
+		return m.CookbookId
 	}
-	return nil
+	return ""
 }
 
-type QueryAllPaymentInfoResponse struct {
-	PaymentInfo []PaymentInfo       `protobuf:"bytes,1,rep,name=payment_info,json=paymentInfo,proto3" json:"payment_info"`
-	Pagination  *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+func (m *QueryGetRecipeHistoryRequest) GetRecipeId() string {
+	if m != nil {
+		return m.RecipeId
+	}
+	return ""
 }
 
-func (m *QueryAllPaymentInfoResponse) Reset()         { *m = QueryAllPaymentInfoResponse{} }
-func (m *QueryAllPaymentInfoResponse) String() string { return proto.CompactTextString(m) }
-func (*QueryAllPaymentInfoResponse) ProtoMessage()    {}
-func (*QueryAllPaymentInfoResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e873e88214e938bd, []int{18}
-}
-func (m *QueryAllPaymentInfoResponse) XXX_Unmarshal(b []byte) error {
+type QueryGetRecipeHistoryResponse struct {
+	History []*RecipeHistory `protobuf:"bytes,1,rep,name=history,proto3" json:"history,omitempty"`
+}
+
+func (m *QueryGetRecipeHistoryResponse) Reset()         { *m = QueryGetRecipeHistoryResponse{} }
+func (m *QueryGetRecipeHistoryResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryGetRecipeHistoryResponse) ProtoMessage()    {}
+func (*QueryGetRecipeHistoryResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e873e88214e938bd, []int{18}
+}
+func (m *QueryGetRecipeHistoryResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *QueryAllPaymentInfoResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *QueryGetRecipeHistoryResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_QueryAllPaymentInfoResponse.Marshal(b, m, deterministic)
+		return xxx_messageInfo_QueryGetRecipeHistoryResponse.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -931,48 +1073,48 @@ func (m *QueryAllPaymentInfoResponse) XXX_Marshal(b []byte, deterministic bool)
 		return b[:n], nil
 	}
 }
-func (m *QueryAllPaymentInfoResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_QueryAllPaymentInfoResponse.Merge(m, src)
+func (m *QueryGetRecipeHistoryResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryGetRecipeHistoryResponse.Merge(m, src)
 }
-func (m *QueryAllPaymentInfoResponse) XXX_Size() int {
+func (m *QueryGetRecipeHistoryResponse) XXX_Size() int {
 	return m.Size()
 }
-func (m *QueryAllPaymentInfoResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_QueryAllPaymentInfoResponse.DiscardUnknown(m)
+func (m *QueryGetRecipeHistoryResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryGetRecipeHistoryResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_QueryAllPaymentInfoResponse proto.InternalMessageInfo
-
-func (m *QueryAllPaymentInfoResponse) GetPaymentInfo() []PaymentInfo {
-	if m != nil {
-		return m.PaymentInfo
-	}
-	return nil
-}
+var xxx_messageInfo_QueryGetRecipeHistoryResponse proto.InternalMessageInfo
 
-func (m *QueryAllPaymentInfoResponse) GetPagination() *query.PageResponse {
+func (m *QueryGetRecipeHistoryResponse) GetHistory() []*RecipeHistory {
 	if m != nil {
-		return m.Pagination
+		return m.History
 	}
 	return nil
 }
 
-type QueryGetUsernameByAddressRequest struct {
-	Address string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+type RecipeHistory struct {
+	ItemId     string `protobuf:"bytes,1,opt,name=item_id,json=itemId,proto3" json:"item_id,omitempty"`
+	CookbookId string `protobuf:"bytes,2,opt,name=cookbook_id,json=cookbookId,proto3" json:"cookbook_id,omitempty"`
+	RecipeId   string `protobuf:"bytes,3,opt,name=recipe_id,json=recipeId,proto3" json:"recipe_id,omitempty"`
+	Sender     string `protobuf:"bytes,4,opt,name=sender,proto3" json:"sender,omitempty"`
+	SenderName string `protobuf:"bytes,5,opt,name=sender_name,json=senderName,proto3" json:"sender_name,omitempty"`
+	Receiver   string `protobuf:"bytes,6,opt,name=receiver,proto3" json:"receiver,omitempty"`
+	Amount     string `protobuf:"bytes,7,opt,name=amount,proto3" json:"amount,omitempty"`
+	CreatedAt  int64  `protobuf:"varint,8,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
 }
 
-func (m *QueryGetUsernameByAddressRequest) Reset()         { *m = QueryGetUsernameByAddressRequest{} }
-func (m *QueryGetUsernameByAddressRequest) String() string { return proto.CompactTextString(m) }
-func (*QueryGetUsernameByAddressRequest) ProtoMessage()    {}
-func (*QueryGetUsernameByAddressRequest) Descriptor() ([]byte, []int) {
+func (m *RecipeHistory) Reset()         { *m = RecipeHistory{} }
+func (m *RecipeHistory) String() string { return proto.CompactTextString(m) }
+func (*RecipeHistory) ProtoMessage()    {}
+func (*RecipeHistory) Descriptor() ([]byte, []int) {
 	return fileDescriptor_e873e88214e938bd, []int{19}
 }
-func (m *QueryGetUsernameByAddressRequest) XXX_Unmarshal(b []byte) error {
+func (m *RecipeHistory) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *QueryGetUsernameByAddressRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *RecipeHistory) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_QueryGetUsernameByAddressRequest.Marshal(b, m, deterministic)
+		return xxx_messageInfo_RecipeHistory.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -982,41 +1124,89 @@ func (m *QueryGetUsernameByAddressRequest) XXX_Marshal(b []byte, deterministic b
 		return b[:n], nil
 	}
 }
-func (m *QueryGetUsernameByAddressRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_QueryGetUsernameByAddressRequest.Merge(m, src)
+func (m *RecipeHistory) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RecipeHistory.Merge(m, src)
 }
-func (m *QueryGetUsernameByAddressRequest) XXX_Size() int {
+func (m *RecipeHistory) XXX_Size() int {
 	return m.Size()
 }
-func (m *QueryGetUsernameByAddressRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_QueryGetUsernameByAddressRequest.DiscardUnknown(m)
+func (m *RecipeHistory) XXX_DiscardUnknown() {
+	xxx_messageInfo_RecipeHistory.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_QueryGetUsernameByAddressRequest proto.InternalMessageInfo
+var xxx_messageInfo_RecipeHistory proto.InternalMessageInfo
 
-func (m *QueryGetUsernameByAddressRequest) GetAddress() string {
+func (m *RecipeHistory) GetItemId() string {
 	if m != nil {
-		return m.Address
+		return m.ItemId
 	}
 	return ""
 }
 
-type QueryGetAddressByUsernameRequest struct {
-	Username string `protobuf:"bytes,1,opt,name=username,proto3" json:"username,omitempty"`
+func (m *RecipeHistory) GetCookbookId() string {
+	if m != nil {
+		return m.CookbookId
+	}
+	return ""
 }
 
-func (m *QueryGetAddressByUsernameRequest) Reset()         { *m = QueryGetAddressByUsernameRequest{} }
-func (m *QueryGetAddressByUsernameRequest) String() string { return proto.CompactTextString(m) }
-func (*QueryGetAddressByUsernameRequest) ProtoMessage()    {}
-func (*QueryGetAddressByUsernameRequest) Descriptor() ([]byte, []int) {
+func (m *RecipeHistory) GetRecipeId() string {
+	if m != nil {
+		return m.RecipeId
+	}
+	return ""
+}
+
+func (m *RecipeHistory) GetSender() string {
+	if m != nil {
+		return m.Sender
+	}
+	return ""
+}
+
+func (m *RecipeHistory) GetSenderName() string {
+	if m != nil {
+		return m.SenderName
+	}
+	return ""
+}
+
+func (m *RecipeHistory) GetReceiver() string {
+	if m != nil {
+		return m.Receiver
+	}
+	return ""
+}
+
+func (m *RecipeHistory) GetAmount() string {
+	if m != nil {
+		return m.Amount
+	}
+	return ""
+}
+
+func (m *RecipeHistory) GetCreatedAt() int64 {
+	if m != nil {
+		return m.CreatedAt
+	}
+	return 0
+}
+
+type QueryGetStripeRefundRequest struct {
+}
+
+func (m *QueryGetStripeRefundRequest) Reset()         { *m = QueryGetStripeRefundRequest{} }
+func (m *QueryGetStripeRefundRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryGetStripeRefundRequest) ProtoMessage()    {}
+func (*QueryGetStripeRefundRequest) Descriptor() ([]byte, []int) {
 	return fileDescriptor_e873e88214e938bd, []int{20}
 }
-func (m *QueryGetAddressByUsernameRequest) XXX_Unmarshal(b []byte) error {
+func (m *QueryGetStripeRefundRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *QueryGetAddressByUsernameRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *QueryGetStripeRefundRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_QueryGetAddressByUsernameRequest.Marshal(b, m, deterministic)
+		return xxx_messageInfo_QueryGetStripeRefundRequest.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -1026,41 +1216,34 @@ func (m *QueryGetAddressByUsernameRequest) XXX_Marshal(b []byte, deterministic b
 		return b[:n], nil
 	}
 }
-func (m *QueryGetAddressByUsernameRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_QueryGetAddressByUsernameRequest.Merge(m, src)
+func (m *QueryGetStripeRefundRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryGetStripeRefundRequest.Merge(m, src)
 }
-func (m *QueryGetAddressByUsernameRequest) XXX_Size() int {
+func (m *QueryGetStripeRefundRequest) XXX_Size() int {
 	return m.Size()
 }
-func (m *QueryGetAddressByUsernameRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_QueryGetAddressByUsernameRequest.DiscardUnknown(m)
+func (m *QueryGetStripeRefundRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryGetStripeRefundRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_QueryGetAddressByUsernameRequest proto.InternalMessageInfo
-
-func (m *QueryGetAddressByUsernameRequest) GetUsername() string {
-	if m != nil {
-		return m.Username
-	}
-	return ""
-}
+var xxx_messageInfo_QueryGetStripeRefundRequest proto.InternalMessageInfo
 
-type QueryGetUsernameByAddressResponse struct {
-	Username Username `protobuf:"bytes,1,opt,name=username,proto3" json:"username"`
+type QueryGetStripeRefundResponse struct {
+	Refunds []*StripeRefund `protobuf:"bytes,1,rep,name=refunds,proto3" json:"refunds,omitempty"`
 }
 
-func (m *QueryGetUsernameByAddressResponse) Reset()         { *m = QueryGetUsernameByAddressResponse{} }
-func (m *QueryGetUsernameByAddressResponse) String() string { return proto.CompactTextString(m) }
-func (*QueryGetUsernameByAddressResponse) ProtoMessage()    {}
-func (*QueryGetUsernameByAddressResponse) Descriptor() ([]byte, []int) {
+func (m *QueryGetStripeRefundResponse) Reset()         { *m = QueryGetStripeRefundResponse{} }
+func (m *QueryGetStripeRefundResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryGetStripeRefundResponse) ProtoMessage()    {}
+func (*QueryGetStripeRefundResponse) Descriptor() ([]byte, []int) {
 	return fileDescriptor_e873e88214e938bd, []int{21}
 }
-func (m *QueryGetUsernameByAddressResponse) XXX_Unmarshal(b []byte) error {
+func (m *QueryGetStripeRefundResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *QueryGetUsernameByAddressResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *QueryGetStripeRefundResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_QueryGetUsernameByAddressResponse.Marshal(b, m, deterministic)
+		return xxx_messageInfo_QueryGetStripeRefundResponse.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -1070,41 +1253,41 @@ func (m *QueryGetUsernameByAddressResponse) XXX_Marshal(b []byte, deterministic
 		return b[:n], nil
 	}
 }
-func (m *QueryGetUsernameByAddressResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_QueryGetUsernameByAddressResponse.Merge(m, src)
+func (m *QueryGetStripeRefundResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryGetStripeRefundResponse.Merge(m, src)
 }
-func (m *QueryGetUsernameByAddressResponse) XXX_Size() int {
+func (m *QueryGetStripeRefundResponse) XXX_Size() int {
 	return m.Size()
 }
-func (m *QueryGetUsernameByAddressResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_QueryGetUsernameByAddressResponse.DiscardUnknown(m)
+func (m *QueryGetStripeRefundResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryGetStripeRefundResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_QueryGetUsernameByAddressResponse proto.InternalMessageInfo
+var xxx_messageInfo_QueryGetStripeRefundResponse proto.InternalMessageInfo
 
-func (m *QueryGetUsernameByAddressResponse) GetUsername() Username {
+func (m *QueryGetStripeRefundResponse) GetRefunds() []*StripeRefund {
 	if m != nil {
-		return m.Username
+		return m.Refunds
 	}
-	return Username{}
+	return nil
 }
 
-type QueryGetAddressByUsernameResponse struct {
-	Address AccountAddr `protobuf:"bytes,1,opt,name=address,proto3" json:"address"`
+type QueryGetRedeemInfoRequest struct {
+	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
 }
 
-func (m *QueryGetAddressByUsernameResponse) Reset()         { *m = QueryGetAddressByUsernameResponse{} }
-func (m *QueryGetAddressByUsernameResponse) String() string { return proto.CompactTextString(m) }
-func (*QueryGetAddressByUsernameResponse) ProtoMessage()    {}
-func (*QueryGetAddressByUsernameResponse) Descriptor() ([]byte, []int) {
+func (m *QueryGetRedeemInfoRequest) Reset()         { *m = QueryGetRedeemInfoRequest{} }
+func (m *QueryGetRedeemInfoRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryGetRedeemInfoRequest) ProtoMessage()    {}
+func (*QueryGetRedeemInfoRequest) Descriptor() ([]byte, []int) {
 	return fileDescriptor_e873e88214e938bd, []int{22}
 }
-func (m *QueryGetAddressByUsernameResponse) XXX_Unmarshal(b []byte) error {
+func (m *QueryGetRedeemInfoRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *QueryGetAddressByUsernameResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *QueryGetRedeemInfoRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_QueryGetAddressByUsernameResponse.Marshal(b, m, deterministic)
+		return xxx_messageInfo_QueryGetRedeemInfoRequest.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -1114,41 +1297,41 @@ func (m *QueryGetAddressByUsernameResponse) XXX_Marshal(b []byte, deterministic
 		return b[:n], nil
 	}
 }
-func (m *QueryGetAddressByUsernameResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_QueryGetAddressByUsernameResponse.Merge(m, src)
+func (m *QueryGetRedeemInfoRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryGetRedeemInfoRequest.Merge(m, src)
 }
-func (m *QueryGetAddressByUsernameResponse) XXX_Size() int {
+func (m *QueryGetRedeemInfoRequest) XXX_Size() int {
 	return m.Size()
 }
-func (m *QueryGetAddressByUsernameResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_QueryGetAddressByUsernameResponse.DiscardUnknown(m)
+func (m *QueryGetRedeemInfoRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryGetRedeemInfoRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_QueryGetAddressByUsernameResponse proto.InternalMessageInfo
+var xxx_messageInfo_QueryGetRedeemInfoRequest proto.InternalMessageInfo
 
-func (m *QueryGetAddressByUsernameResponse) GetAddress() AccountAddr {
+func (m *QueryGetRedeemInfoRequest) GetId() string {
 	if m != nil {
-		return m.Address
+		return m.Id
 	}
-	return AccountAddr{}
+	return ""
 }
 
-type QueryGetTradeRequest struct {
-	Id uint64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+type QueryGetRedeemInfoResponse struct {
+	RedeemInfo RedeemInfo `protobuf:"bytes,1,opt,name=redeem_info,json=redeemInfo,proto3" json:"redeem_info"`
 }
 
-func (m *QueryGetTradeRequest) Reset()         { *m = QueryGetTradeRequest{} }
-func (m *QueryGetTradeRequest) String() string { return proto.CompactTextString(m) }
-func (*QueryGetTradeRequest) ProtoMessage()    {}
-func (*QueryGetTradeRequest) Descriptor() ([]byte, []int) {
+func (m *QueryGetRedeemInfoResponse) Reset()         { *m = QueryGetRedeemInfoResponse{} }
+func (m *QueryGetRedeemInfoResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryGetRedeemInfoResponse) ProtoMessage()    {}
+func (*QueryGetRedeemInfoResponse) Descriptor() ([]byte, []int) {
 	return fileDescriptor_e873e88214e938bd, []int{23}
 }
-func (m *QueryGetTradeRequest) XXX_Unmarshal(b []byte) error {
+func (m *QueryGetRedeemInfoResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *QueryGetTradeRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *QueryGetRedeemInfoResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_QueryGetTradeRequest.Marshal(b, m, deterministic)
+		return xxx_messageInfo_QueryGetRedeemInfoResponse.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -1158,41 +1341,41 @@ func (m *QueryGetTradeRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte
 		return b[:n], nil
 	}
 }
-func (m *QueryGetTradeRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_QueryGetTradeRequest.Merge(m, src)
+func (m *QueryGetRedeemInfoResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryGetRedeemInfoResponse.Merge(m, src)
 }
-func (m *QueryGetTradeRequest) XXX_Size() int {
+func (m *QueryGetRedeemInfoResponse) XXX_Size() int {
 	return m.Size()
 }
-func (m *QueryGetTradeRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_QueryGetTradeRequest.DiscardUnknown(m)
+func (m *QueryGetRedeemInfoResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryGetRedeemInfoResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_QueryGetTradeRequest proto.InternalMessageInfo
+var xxx_messageInfo_QueryGetRedeemInfoResponse proto.InternalMessageInfo
 
-func (m *QueryGetTradeRequest) GetId() uint64 {
+func (m *QueryGetRedeemInfoResponse) GetRedeemInfo() RedeemInfo {
 	if m != nil {
-		return m.Id
+		return m.RedeemInfo
 	}
-	return 0
+	return RedeemInfo{}
 }
 
-type QueryGetTradeResponse struct {
-	Trade Trade `protobuf:"bytes,1,opt,name=trade,proto3" json:"trade"`
+type QueryAllRedeemInfoRequest struct {
+	Pagination *query.PageRequest `protobuf:"bytes,1,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
-func (m *QueryGetTradeResponse) Reset()         { *m = QueryGetTradeResponse{} }
-func (m *QueryGetTradeResponse) String() string { return proto.CompactTextString(m) }
-func (*QueryGetTradeResponse) ProtoMessage()    {}
-func (*QueryGetTradeResponse) Descriptor() ([]byte, []int) {
+func (m *QueryAllRedeemInfoRequest) Reset()         { *m = QueryAllRedeemInfoRequest{} }
+func (m *QueryAllRedeemInfoRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryAllRedeemInfoRequest) ProtoMessage()    {}
+func (*QueryAllRedeemInfoRequest) Descriptor() ([]byte, []int) {
 	return fileDescriptor_e873e88214e938bd, []int{24}
 }
-func (m *QueryGetTradeResponse) XXX_Unmarshal(b []byte) error {
+func (m *QueryAllRedeemInfoRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *QueryGetTradeResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *QueryAllRedeemInfoRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_QueryGetTradeResponse.Marshal(b, m, deterministic)
+		return xxx_messageInfo_QueryAllRedeemInfoRequest.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -1202,43 +1385,42 @@ func (m *QueryGetTradeResponse) XXX_Marshal(b []byte, deterministic bool) ([]byt
 		return b[:n], nil
 	}
 }
-func (m *QueryGetTradeResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_QueryGetTradeResponse.Merge(m, src)
+func (m *QueryAllRedeemInfoRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryAllRedeemInfoRequest.Merge(m, src)
 }
-func (m *QueryGetTradeResponse) XXX_Size() int {
+func (m *QueryAllRedeemInfoRequest) XXX_Size() int {
 	return m.Size()
 }
-func (m *QueryGetTradeResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_QueryGetTradeResponse.DiscardUnknown(m)
+func (m *QueryAllRedeemInfoRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryAllRedeemInfoRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_QueryGetTradeResponse proto.InternalMessageInfo
+var xxx_messageInfo_QueryAllRedeemInfoRequest proto.InternalMessageInfo
 
-func (m *QueryGetTradeResponse) GetTrade() Trade {
+func (m *QueryAllRedeemInfoRequest) GetPagination() *query.PageRequest {
 	if m != nil {
-		return m.Trade
+		return m.Pagination
 	}
-	return Trade{}
+	return nil
 }
 
-type QueryListItemByOwnerRequest struct {
-	Owner string `protobuf:"bytes,1,opt,name=owner,proto3" json:"owner,omitempty"`
-	// pagination defines an optional pagination for the request.
-	Pagination *query.PageRequest `protobuf:"bytes,3,opt,name=pagination,proto3" json:"pagination,omitempty"`
+type QueryAllRedeemInfoResponse struct {
+	RedeemInfo []RedeemInfo        `protobuf:"bytes,1,rep,name=redeem_info,json=redeemInfo,proto3" json:"redeem_info"`
+	Pagination *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
-func (m *QueryListItemByOwnerRequest) Reset()         { *m = QueryListItemByOwnerRequest{} }
-func (m *QueryListItemByOwnerRequest) String() string { return proto.CompactTextString(m) }
-func (*QueryListItemByOwnerRequest) ProtoMessage()    {}
-func (*QueryListItemByOwnerRequest) Descriptor() ([]byte, []int) {
+func (m *QueryAllRedeemInfoResponse) Reset()         { *m = QueryAllRedeemInfoResponse{} }
+func (m *QueryAllRedeemInfoResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryAllRedeemInfoResponse) ProtoMessage()    {}
+func (*QueryAllRedeemInfoResponse) Descriptor() ([]byte, []int) {
 	return fileDescriptor_e873e88214e938bd, []int{25}
 }
-func (m *QueryListItemByOwnerRequest) XXX_Unmarshal(b []byte) error {
+func (m *QueryAllRedeemInfoResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *QueryListItemByOwnerRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *QueryAllRedeemInfoResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_QueryListItemByOwnerRequest.Marshal(b, m, deterministic)
+		return xxx_messageInfo_QueryAllRedeemInfoResponse.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -1248,50 +1430,48 @@ func (m *QueryListItemByOwnerRequest) XXX_Marshal(b []byte, deterministic bool)
 		return b[:n], nil
 	}
 }
-func (m *QueryListItemByOwnerRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_QueryListItemByOwnerRequest.Merge(m, src)
+func (m *QueryAllRedeemInfoResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryAllRedeemInfoResponse.Merge(m, src)
 }
-func (m *QueryListItemByOwnerRequest) XXX_Size() int {
+func (m *QueryAllRedeemInfoResponse) XXX_Size() int {
 	return m.Size()
 }
-func (m *QueryListItemByOwnerRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_QueryListItemByOwnerRequest.DiscardUnknown(m)
+func (m *QueryAllRedeemInfoResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryAllRedeemInfoResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_QueryListItemByOwnerRequest proto.InternalMessageInfo
+var xxx_messageInfo_QueryAllRedeemInfoResponse proto.InternalMessageInfo
 
-func (m *QueryListItemByOwnerRequest) GetOwner() string {
+func (m *QueryAllRedeemInfoResponse) GetRedeemInfo() []RedeemInfo {
 	if m != nil {
-		return m.Owner
+		return m.RedeemInfo
 	}
-	return ""
+	return nil
 }
 
-func (m *QueryListItemByOwnerRequest) GetPagination() *query.PageRequest {
+func (m *QueryAllRedeemInfoResponse) GetPagination() *query.PageResponse {
 	if m != nil {
 		return m.Pagination
 	}
 	return nil
 }
 
-type QueryListItemByOwnerResponse struct {
-	Items []Item `protobuf:"bytes,1,rep,name=items,proto3" json:"items"`
-	// pagination defines the pagination in the response.
-	Pagination *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+type QueryGetPaymentInfoRequest struct {
+	PurchaseId string `protobuf:"bytes,1,opt,name=purchase_id,json=purchaseId,proto3" json:"purchase_id,omitempty"`
 }
 
-func (m *QueryListItemByOwnerResponse) Reset()         { *m = QueryListItemByOwnerResponse{} }
-func (m *QueryListItemByOwnerResponse) String() string { return proto.CompactTextString(m) }
-func (*QueryListItemByOwnerResponse) ProtoMessage()    {}
-func (*QueryListItemByOwnerResponse) Descriptor() ([]byte, []int) {
+func (m *QueryGetPaymentInfoRequest) Reset()         { *m = QueryGetPaymentInfoRequest{} }
+func (m *QueryGetPaymentInfoRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryGetPaymentInfoRequest) ProtoMessage()    {}
+func (*QueryGetPaymentInfoRequest) Descriptor() ([]byte, []int) {
 	return fileDescriptor_e873e88214e938bd, []int{26}
 }
-func (m *QueryListItemByOwnerResponse) XXX_Unmarshal(b []byte) error {
+func (m *QueryGetPaymentInfoRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *QueryListItemByOwnerResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *QueryGetPaymentInfoRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_QueryListItemByOwnerResponse.Marshal(b, m, deterministic)
+		return xxx_messageInfo_QueryGetPaymentInfoRequest.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -1301,36 +1481,41 @@ func (m *QueryListItemByOwnerResponse) XXX_Marshal(b []byte, deterministic bool)
 		return b[:n], nil
 	}
 }
-func (m *QueryListItemByOwnerResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_QueryListItemByOwnerResponse.Merge(m, src)
+func (m *QueryGetPaymentInfoRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryGetPaymentInfoRequest.Merge(m, src)
 }
-func (m *QueryListItemByOwnerResponse) XXX_Size() int {
+func (m *QueryGetPaymentInfoRequest) XXX_Size() int {
 	return m.Size()
 }
-func (m *QueryListItemByOwnerResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_QueryListItemByOwnerResponse.DiscardUnknown(m)
+func (m *QueryGetPaymentInfoRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryGetPaymentInfoRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_QueryListItemByOwnerResponse proto.InternalMessageInfo
+var xxx_messageInfo_QueryGetPaymentInfoRequest proto.InternalMessageInfo
 
-type QueryGetGoogleInAppPurchaseOrderRequest struct {
-	PurchaseToken string `protobuf:"bytes,1,opt,name=purchase_token,json=purchaseToken,proto3" json:"purchase_token,omitempty"`
+func (m *QueryGetPaymentInfoRequest) GetPurchaseId() string {
+	if m != nil {
+		return m.PurchaseId
+	}
+	return ""
 }
 
-func (m *QueryGetGoogleInAppPurchaseOrderRequest) Reset() {
-	*m = QueryGetGoogleInAppPurchaseOrderRequest{}
+type QueryGetPaymentInfoResponse struct {
+	PaymentInfo PaymentInfo `protobuf:"bytes,1,opt,name=payment_info,json=paymentInfo,proto3" json:"payment_info"`
 }
-func (m *QueryGetGoogleInAppPurchaseOrderRequest) String() string { return proto.CompactTextString(m) }
-func (*QueryGetGoogleInAppPurchaseOrderRequest) ProtoMessage()    {}
-func (*QueryGetGoogleInAppPurchaseOrderRequest) Descriptor() ([]byte, []int) {
+
+func (m *QueryGetPaymentInfoResponse) Reset()         { *m = QueryGetPaymentInfoResponse{} }
+func (m *QueryGetPaymentInfoResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryGetPaymentInfoResponse) ProtoMessage()    {}
+func (*QueryGetPaymentInfoResponse) Descriptor() ([]byte, []int) {
 	return fileDescriptor_e873e88214e938bd, []int{27}
 }
-func (m *QueryGetGoogleInAppPurchaseOrderRequest) XXX_Unmarshal(b []byte) error {
+func (m *QueryGetPaymentInfoResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *QueryGetGoogleInAppPurchaseOrderRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *QueryGetPaymentInfoResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_QueryGetGoogleInAppPurchaseOrderRequest.Marshal(b, m, deterministic)
+		return xxx_messageInfo_QueryGetPaymentInfoResponse.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -1340,43 +1525,41 @@ func (m *QueryGetGoogleInAppPurchaseOrderRequest) XXX_Marshal(b []byte, determin
 		return b[:n], nil
 	}
 }
-func (m *QueryGetGoogleInAppPurchaseOrderRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_QueryGetGoogleInAppPurchaseOrderRequest.Merge(m, src)
+func (m *QueryGetPaymentInfoResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryGetPaymentInfoResponse.Merge(m, src)
 }
-func (m *QueryGetGoogleInAppPurchaseOrderRequest) XXX_Size() int {
+func (m *QueryGetPaymentInfoResponse) XXX_Size() int {
 	return m.Size()
 }
-func (m *QueryGetGoogleInAppPurchaseOrderRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_QueryGetGoogleInAppPurchaseOrderRequest.DiscardUnknown(m)
+func (m *QueryGetPaymentInfoResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryGetPaymentInfoResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_QueryGetGoogleInAppPurchaseOrderRequest proto.InternalMessageInfo
+var xxx_messageInfo_QueryGetPaymentInfoResponse proto.InternalMessageInfo
 
-func (m *QueryGetGoogleInAppPurchaseOrderRequest) GetPurchaseToken() string {
+func (m *QueryGetPaymentInfoResponse) GetPaymentInfo() PaymentInfo {
 	if m != nil {
-		return m.PurchaseToken
+		return m.PaymentInfo
 	}
-	return ""
+	return PaymentInfo{}
 }
 
-type QueryGetGoogleInAppPurchaseOrderResponse struct {
-	Order GoogleInAppPurchaseOrder `protobuf:"bytes,1,opt,name=order,proto3" json:"order"`
+type QueryAllPaymentInfoRequest struct {
+	Pagination *query.PageRequest `protobuf:"bytes,1,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
-func (m *QueryGetGoogleInAppPurchaseOrderResponse) Reset() {
-	*m = QueryGetGoogleInAppPurchaseOrderResponse{}
-}
-func (m *QueryGetGoogleInAppPurchaseOrderResponse) String() string { return proto.CompactTextString(m) }
-func (*QueryGetGoogleInAppPurchaseOrderResponse) ProtoMessage()    {}
-func (*QueryGetGoogleInAppPurchaseOrderResponse) Descriptor() ([]byte, []int) {
+func (m *QueryAllPaymentInfoRequest) Reset()         { *m = QueryAllPaymentInfoRequest{} }
+func (m *QueryAllPaymentInfoRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryAllPaymentInfoRequest) ProtoMessage()    {}
+func (*QueryAllPaymentInfoRequest) Descriptor() ([]byte, []int) {
 	return fileDescriptor_e873e88214e938bd, []int{28}
 }
-func (m *QueryGetGoogleInAppPurchaseOrderResponse) XXX_Unmarshal(b []byte) error {
+func (m *QueryAllPaymentInfoRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *QueryGetGoogleInAppPurchaseOrderResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *QueryAllPaymentInfoRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_QueryGetGoogleInAppPurchaseOrderResponse.Marshal(b, m, deterministic)
+		return xxx_messageInfo_QueryAllPaymentInfoRequest.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -1386,44 +1569,42 @@ func (m *QueryGetGoogleInAppPurchaseOrderResponse) XXX_Marshal(b []byte, determi
 		return b[:n], nil
 	}
 }
-func (m *QueryGetGoogleInAppPurchaseOrderResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_QueryGetGoogleInAppPurchaseOrderResponse.Merge(m, src)
+func (m *QueryAllPaymentInfoRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryAllPaymentInfoRequest.Merge(m, src)
 }
-func (m *QueryGetGoogleInAppPurchaseOrderResponse) XXX_Size() int {
+func (m *QueryAllPaymentInfoRequest) XXX_Size() int {
 	return m.Size()
 }
-func (m *QueryGetGoogleInAppPurchaseOrderResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_QueryGetGoogleInAppPurchaseOrderResponse.DiscardUnknown(m)
+func (m *QueryAllPaymentInfoRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryAllPaymentInfoRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_QueryGetGoogleInAppPurchaseOrderResponse proto.InternalMessageInfo
+var xxx_messageInfo_QueryAllPaymentInfoRequest proto.InternalMessageInfo
 
-func (m *QueryGetGoogleInAppPurchaseOrderResponse) GetOrder() GoogleInAppPurchaseOrder {
+func (m *QueryAllPaymentInfoRequest) GetPagination() *query.PageRequest {
 	if m != nil {
-		return m.Order
+		return m.Pagination
 	}
-	return GoogleInAppPurchaseOrder{}
+	return nil
 }
 
-type QueryListExecutionsByItemRequest struct {
-	CookbookId string `protobuf:"bytes,1,opt,name=cookbook_id,json=cookbookId,proto3" json:"cookbook_id,omitempty"`
-	ItemId     string `protobuf:"bytes,2,opt,name=item_id,json=itemId,proto3" json:"item_id,omitempty"`
-	// pagination defines an optional pagination for the request.
-	Pagination *query.PageRequest `protobuf:"bytes,3,opt,name=pagination,proto3" json:"pagination,omitempty"`
+type QueryAllPaymentInfoResponse struct {
+	PaymentInfo []PaymentInfo       `protobuf:"bytes,1,rep,name=payment_info,json=paymentInfo,proto3" json:"payment_info"`
+	Pagination  *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
-func (m *QueryListExecutionsByItemRequest) Reset()         { *m = QueryListExecutionsByItemRequest{} }
-func (m *QueryListExecutionsByItemRequest) String() string { return proto.CompactTextString(m) }
-func (*QueryListExecutionsByItemRequest) ProtoMessage()    {}
-func (*QueryListExecutionsByItemRequest) Descriptor() ([]byte, []int) {
+func (m *QueryAllPaymentInfoResponse) Reset()         { *m = QueryAllPaymentInfoResponse{} }
+func (m *QueryAllPaymentInfoResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryAllPaymentInfoResponse) ProtoMessage()    {}
+func (*QueryAllPaymentInfoResponse) Descriptor() ([]byte, []int) {
 	return fileDescriptor_e873e88214e938bd, []int{29}
 }
-func (m *QueryListExecutionsByItemRequest) XXX_Unmarshal(b []byte) error {
+func (m *QueryAllPaymentInfoResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *QueryListExecutionsByItemRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *QueryAllPaymentInfoResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_QueryListExecutionsByItemRequest.Marshal(b, m, deterministic)
+		return xxx_messageInfo_QueryAllPaymentInfoResponse.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -1433,37 +1614,48 @@ func (m *QueryListExecutionsByItemRequest) XXX_Marshal(b []byte, deterministic b
 		return b[:n], nil
 	}
 }
-func (m *QueryListExecutionsByItemRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_QueryListExecutionsByItemRequest.Merge(m, src)
+func (m *QueryAllPaymentInfoResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryAllPaymentInfoResponse.Merge(m, src)
 }
-func (m *QueryListExecutionsByItemRequest) XXX_Size() int {
+func (m *QueryAllPaymentInfoResponse) XXX_Size() int {
 	return m.Size()
 }
-func (m *QueryListExecutionsByItemRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_QueryListExecutionsByItemRequest.DiscardUnknown(m)
+func (m *QueryAllPaymentInfoResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryAllPaymentInfoResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_QueryListExecutionsByItemRequest proto.InternalMessageInfo
+var xxx_messageInfo_QueryAllPaymentInfoResponse proto.InternalMessageInfo
 
-type QueryListExecutionsByItemResponse struct {
-	CompletedExecutions []Execution `protobuf:"bytes,1,rep,name=completed_executions,json=completedExecutions,proto3" json:"completed_executions"`
-	PendingExecutions   []Execution `protobuf:"bytes,2,rep,name=pending_executions,json=pendingExecutions,proto3" json:"pending_executions"`
-	// pagination defines the pagination in the response.
-	Pagination *query.PageResponse `protobuf:"bytes,3,opt,name=pagination,proto3" json:"pagination,omitempty"`
+func (m *QueryAllPaymentInfoResponse) GetPaymentInfo() []PaymentInfo {
+	if m != nil {
+		return m.PaymentInfo
+	}
+	return nil
 }
 
-func (m *QueryListExecutionsByItemResponse) Reset()         { *m = QueryListExecutionsByItemResponse{} }
-func (m *QueryListExecutionsByItemResponse) String() string { return proto.CompactTextString(m) }
-func (*QueryListExecutionsByItemResponse) ProtoMessage()    {}
-func (*QueryListExecutionsByItemResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e873e88214e938bd, []int{30}
+func (m *QueryAllPaymentInfoResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
 }
-func (m *QueryListExecutionsByItemResponse) XXX_Unmarshal(b []byte) error {
+
+type QueryGetUsernameByAddressRequest struct {
+	Address string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+}
+
+func (m *QueryGetUsernameByAddressRequest) Reset()         { *m = QueryGetUsernameByAddressRequest{} }
+func (m *QueryGetUsernameByAddressRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryGetUsernameByAddressRequest) ProtoMessage()    {}
+func (*QueryGetUsernameByAddressRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e873e88214e938bd, []int{30}
+}
+func (m *QueryGetUsernameByAddressRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *QueryListExecutionsByItemResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *QueryGetUsernameByAddressRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_QueryListExecutionsByItemResponse.Marshal(b, m, deterministic)
+		return xxx_messageInfo_QueryGetUsernameByAddressRequest.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -1473,58 +1665,41 @@ func (m *QueryListExecutionsByItemResponse) XXX_Marshal(b []byte, deterministic
 		return b[:n], nil
 	}
 }
-func (m *QueryListExecutionsByItemResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_QueryListExecutionsByItemResponse.Merge(m, src)
+func (m *QueryGetUsernameByAddressRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryGetUsernameByAddressRequest.Merge(m, src)
 }
-func (m *QueryListExecutionsByItemResponse) XXX_Size() int {
+func (m *QueryGetUsernameByAddressRequest) XXX_Size() int {
 	return m.Size()
 }
-func (m *QueryListExecutionsByItemResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_QueryListExecutionsByItemResponse.DiscardUnknown(m)
-}
-
-var xxx_messageInfo_QueryListExecutionsByItemResponse proto.InternalMessageInfo
-
-func (m *QueryListExecutionsByItemResponse) GetCompletedExecutions() []Execution {
-	if m != nil {
-		return m.CompletedExecutions
-	}
-	return nil
+func (m *QueryGetUsernameByAddressRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryGetUsernameByAddressRequest.DiscardUnknown(m)
 }
 
-func (m *QueryListExecutionsByItemResponse) GetPendingExecutions() []Execution {
-	if m != nil {
-		return m.PendingExecutions
-	}
-	return nil
-}
+var xxx_messageInfo_QueryGetUsernameByAddressRequest proto.InternalMessageInfo
 
-func (m *QueryListExecutionsByItemResponse) GetPagination() *query.PageResponse {
+func (m *QueryGetUsernameByAddressRequest) GetAddress() string {
 	if m != nil {
-		return m.Pagination
+		return m.Address
 	}
-	return nil
+	return ""
 }
 
-type QueryListExecutionsByRecipeRequest struct {
-	CookbookId string `protobuf:"bytes,1,opt,name=cookbook_id,json=cookbookId,proto3" json:"cookbook_id,omitempty"`
-	RecipeId   string `protobuf:"bytes,2,opt,name=recipe_id,json=recipeId,proto3" json:"recipe_id,omitempty"`
-	// pagination defines an optional pagination for the request.
-	Pagination *query.PageRequest `protobuf:"bytes,3,opt,name=pagination,proto3" json:"pagination,omitempty"`
+type QueryGetAddressByUsernameRequest struct {
+	Username string `protobuf:"bytes,1,opt,name=username,proto3" json:"username,omitempty"`
 }
 
-func (m *QueryListExecutionsByRecipeRequest) Reset()         { *m = QueryListExecutionsByRecipeRequest{} }
-func (m *QueryListExecutionsByRecipeRequest) String() string { return proto.CompactTextString(m) }
-func (*QueryListExecutionsByRecipeRequest) ProtoMessage()    {}
-func (*QueryListExecutionsByRecipeRequest) Descriptor() ([]byte, []int) {
+func (m *QueryGetAddressByUsernameRequest) Reset()         { *m = QueryGetAddressByUsernameRequest{} }
+func (m *QueryGetAddressByUsernameRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryGetAddressByUsernameRequest) ProtoMessage()    {}
+func (*QueryGetAddressByUsernameRequest) Descriptor() ([]byte, []int) {
 	return fileDescriptor_e873e88214e938bd, []int{31}
 }
-func (m *QueryListExecutionsByRecipeRequest) XXX_Unmarshal(b []byte) error {
+func (m *QueryGetAddressByUsernameRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *QueryListExecutionsByRecipeRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *QueryGetAddressByUsernameRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_QueryListExecutionsByRecipeRequest.Marshal(b, m, deterministic)
+		return xxx_messageInfo_QueryGetAddressByUsernameRequest.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -1534,37 +1709,41 @@ func (m *QueryListExecutionsByRecipeRequest) XXX_Marshal(b []byte, deterministic
 		return b[:n], nil
 	}
 }
-func (m *QueryListExecutionsByRecipeRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_QueryListExecutionsByRecipeRequest.Merge(m, src)
+func (m *QueryGetAddressByUsernameRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryGetAddressByUsernameRequest.Merge(m, src)
 }
-func (m *QueryListExecutionsByRecipeRequest) XXX_Size() int {
+func (m *QueryGetAddressByUsernameRequest) XXX_Size() int {
 	return m.Size()
 }
-func (m *QueryListExecutionsByRecipeRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_QueryListExecutionsByRecipeRequest.DiscardUnknown(m)
+func (m *QueryGetAddressByUsernameRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryGetAddressByUsernameRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_QueryListExecutionsByRecipeRequest proto.InternalMessageInfo
+var xxx_messageInfo_QueryGetAddressByUsernameRequest proto.InternalMessageInfo
 
-type QueryListExecutionsByRecipeResponse struct {
-	CompletedExecutions []Execution `protobuf:"bytes,1,rep,name=completed_executions,json=completedExecutions,proto3" json:"completed_executions"`
-	PendingExecutions   []Execution `protobuf:"bytes,2,rep,name=pending_executions,json=pendingExecutions,proto3" json:"pending_executions"`
-	// pagination defines the pagination in the response.
-	Pagination *query.PageResponse `protobuf:"bytes,3,opt,name=pagination,proto3" json:"pagination,omitempty"`
+func (m *QueryGetAddressByUsernameRequest) GetUsername() string {
+	if m != nil {
+		return m.Username
+	}
+	return ""
 }
 
-func (m *QueryListExecutionsByRecipeResponse) Reset()         { *m = QueryListExecutionsByRecipeResponse{} }
-func (m *QueryListExecutionsByRecipeResponse) String() string { return proto.CompactTextString(m) }
-func (*QueryListExecutionsByRecipeResponse) ProtoMessage()    {}
-func (*QueryListExecutionsByRecipeResponse) Descriptor() ([]byte, []int) {
+type QueryGetUsernameByAddressResponse struct {
+	Username Username `protobuf:"bytes,1,opt,name=username,proto3" json:"username"`
+}
+
+func (m *QueryGetUsernameByAddressResponse) Reset()         { *m = QueryGetUsernameByAddressResponse{} }
+func (m *QueryGetUsernameByAddressResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryGetUsernameByAddressResponse) ProtoMessage()    {}
+func (*QueryGetUsernameByAddressResponse) Descriptor() ([]byte, []int) {
 	return fileDescriptor_e873e88214e938bd, []int{32}
 }
-func (m *QueryListExecutionsByRecipeResponse) XXX_Unmarshal(b []byte) error {
+func (m *QueryGetUsernameByAddressResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *QueryListExecutionsByRecipeResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *QueryGetUsernameByAddressResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_QueryListExecutionsByRecipeResponse.Marshal(b, m, deterministic)
+		return xxx_messageInfo_QueryGetUsernameByAddressResponse.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -1574,55 +1753,41 @@ func (m *QueryListExecutionsByRecipeResponse) XXX_Marshal(b []byte, deterministi
 		return b[:n], nil
 	}
 }
-func (m *QueryListExecutionsByRecipeResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_QueryListExecutionsByRecipeResponse.Merge(m, src)
+func (m *QueryGetUsernameByAddressResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryGetUsernameByAddressResponse.Merge(m, src)
 }
-func (m *QueryListExecutionsByRecipeResponse) XXX_Size() int {
+func (m *QueryGetUsernameByAddressResponse) XXX_Size() int {
 	return m.Size()
 }
-func (m *QueryListExecutionsByRecipeResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_QueryListExecutionsByRecipeResponse.DiscardUnknown(m)
-}
-
-var xxx_messageInfo_QueryListExecutionsByRecipeResponse proto.InternalMessageInfo
-
-func (m *QueryListExecutionsByRecipeResponse) GetCompletedExecutions() []Execution {
-	if m != nil {
-		return m.CompletedExecutions
-	}
-	return nil
+func (m *QueryGetUsernameByAddressResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryGetUsernameByAddressResponse.DiscardUnknown(m)
 }
 
-func (m *QueryListExecutionsByRecipeResponse) GetPendingExecutions() []Execution {
-	if m != nil {
-		return m.PendingExecutions
-	}
-	return nil
-}
+var xxx_messageInfo_QueryGetUsernameByAddressResponse proto.InternalMessageInfo
 
-func (m *QueryListExecutionsByRecipeResponse) GetPagination() *query.PageResponse {
+func (m *QueryGetUsernameByAddressResponse) GetUsername() Username {
 	if m != nil {
-		return m.Pagination
+		return m.Username
 	}
-	return nil
+	return Username{}
 }
 
-type QueryGetExecutionRequest struct {
-	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+type QueryGetAddressByUsernameResponse struct {
+	Address AccountAddr `protobuf:"bytes,1,opt,name=address,proto3" json:"address"`
 }
 
-func (m *QueryGetExecutionRequest) Reset()         { *m = QueryGetExecutionRequest{} }
-func (m *QueryGetExecutionRequest) String() string { return proto.CompactTextString(m) }
-func (*QueryGetExecutionRequest) ProtoMessage()    {}
-func (*QueryGetExecutionRequest) Descriptor() ([]byte, []int) {
+func (m *QueryGetAddressByUsernameResponse) Reset()         { *m = QueryGetAddressByUsernameResponse{} }
+func (m *QueryGetAddressByUsernameResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryGetAddressByUsernameResponse) ProtoMessage()    {}
+func (*QueryGetAddressByUsernameResponse) Descriptor() ([]byte, []int) {
 	return fileDescriptor_e873e88214e938bd, []int{33}
 }
-func (m *QueryGetExecutionRequest) XXX_Unmarshal(b []byte) error {
+func (m *QueryGetAddressByUsernameResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *QueryGetExecutionRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *QueryGetAddressByUsernameResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_QueryGetExecutionRequest.Marshal(b, m, deterministic)
+		return xxx_messageInfo_QueryGetAddressByUsernameResponse.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -1632,42 +1797,41 @@ func (m *QueryGetExecutionRequest) XXX_Marshal(b []byte, deterministic bool) ([]
 		return b[:n], nil
 	}
 }
-func (m *QueryGetExecutionRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_QueryGetExecutionRequest.Merge(m, src)
+func (m *QueryGetAddressByUsernameResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryGetAddressByUsernameResponse.Merge(m, src)
 }
-func (m *QueryGetExecutionRequest) XXX_Size() int {
+func (m *QueryGetAddressByUsernameResponse) XXX_Size() int {
 	return m.Size()
 }
-func (m *QueryGetExecutionRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_QueryGetExecutionRequest.DiscardUnknown(m)
+func (m *QueryGetAddressByUsernameResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryGetAddressByUsernameResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_QueryGetExecutionRequest proto.InternalMessageInfo
+var xxx_messageInfo_QueryGetAddressByUsernameResponse proto.InternalMessageInfo
 
-func (m *QueryGetExecutionRequest) GetId() string {
+func (m *QueryGetAddressByUsernameResponse) GetAddress() AccountAddr {
 	if m != nil {
-		return m.Id
+		return m.Address
 	}
-	return ""
+	return AccountAddr{}
 }
 
-type QueryGetExecutionResponse struct {
-	Execution Execution `protobuf:"bytes,1,opt,name=execution,proto3" json:"execution"`
-	Completed bool      `protobuf:"varint,2,opt,name=completed,proto3" json:"completed,omitempty"`
+type QueryGetTradeRequest struct {
+	Id uint64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
 }
 
-func (m *QueryGetExecutionResponse) Reset()         { *m = QueryGetExecutionResponse{} }
-func (m *QueryGetExecutionResponse) String() string { return proto.CompactTextString(m) }
-func (*QueryGetExecutionResponse) ProtoMessage()    {}
-func (*QueryGetExecutionResponse) Descriptor() ([]byte, []int) {
+func (m *QueryGetTradeRequest) Reset()         { *m = QueryGetTradeRequest{} }
+func (m *QueryGetTradeRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryGetTradeRequest) ProtoMessage()    {}
+func (*QueryGetTradeRequest) Descriptor() ([]byte, []int) {
 	return fileDescriptor_e873e88214e938bd, []int{34}
 }
-func (m *QueryGetExecutionResponse) XXX_Unmarshal(b []byte) error {
+func (m *QueryGetTradeRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *QueryGetExecutionResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *QueryGetTradeRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_QueryGetExecutionResponse.Marshal(b, m, deterministic)
+		return xxx_messageInfo_QueryGetTradeRequest.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -1677,50 +1841,41 @@ func (m *QueryGetExecutionResponse) XXX_Marshal(b []byte, deterministic bool) ([
 		return b[:n], nil
 	}
 }
-func (m *QueryGetExecutionResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_QueryGetExecutionResponse.Merge(m, src)
+func (m *QueryGetTradeRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryGetTradeRequest.Merge(m, src)
 }
-func (m *QueryGetExecutionResponse) XXX_Size() int {
+func (m *QueryGetTradeRequest) XXX_Size() int {
 	return m.Size()
 }
-func (m *QueryGetExecutionResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_QueryGetExecutionResponse.DiscardUnknown(m)
+func (m *QueryGetTradeRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryGetTradeRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_QueryGetExecutionResponse proto.InternalMessageInfo
-
-func (m *QueryGetExecutionResponse) GetExecution() Execution {
-	if m != nil {
-		return m.Execution
-	}
-	return Execution{}
-}
+var xxx_messageInfo_QueryGetTradeRequest proto.InternalMessageInfo
 
-func (m *QueryGetExecutionResponse) GetCompleted() bool {
+func (m *QueryGetTradeRequest) GetId() uint64 {
 	if m != nil {
-		return m.Completed
+		return m.Id
 	}
-	return false
+	return 0
 }
 
-type QueryListRecipesByCookbookRequest struct {
-	CookbookId string `protobuf:"bytes,1,opt,name=cookbook_id,json=cookbookId,proto3" json:"cookbook_id,omitempty"`
-	// pagination defines an optional pagination for the request.
-	Pagination *query.PageRequest `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+type QueryGetTradeResponse struct {
+	Trade Trade `protobuf:"bytes,1,opt,name=trade,proto3" json:"trade"`
 }
 
-func (m *QueryListRecipesByCookbookRequest) Reset()         { *m = QueryListRecipesByCookbookRequest{} }
-func (m *QueryListRecipesByCookbookRequest) String() string { return proto.CompactTextString(m) }
-func (*QueryListRecipesByCookbookRequest) ProtoMessage()    {}
-func (*QueryListRecipesByCookbookRequest) Descriptor() ([]byte, []int) {
+func (m *QueryGetTradeResponse) Reset()         { *m = QueryGetTradeResponse{} }
+func (m *QueryGetTradeResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryGetTradeResponse) ProtoMessage()    {}
+func (*QueryGetTradeResponse) Descriptor() ([]byte, []int) {
 	return fileDescriptor_e873e88214e938bd, []int{35}
 }
-func (m *QueryListRecipesByCookbookRequest) XXX_Unmarshal(b []byte) error {
+func (m *QueryGetTradeResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *QueryListRecipesByCookbookRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *QueryGetTradeResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_QueryListRecipesByCookbookRequest.Marshal(b, m, deterministic)
+		return xxx_messageInfo_QueryGetTradeResponse.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -1730,50 +1885,43 @@ func (m *QueryListRecipesByCookbookRequest) XXX_Marshal(b []byte, deterministic
 		return b[:n], nil
 	}
 }
-func (m *QueryListRecipesByCookbookRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_QueryListRecipesByCookbookRequest.Merge(m, src)
+func (m *QueryGetTradeResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryGetTradeResponse.Merge(m, src)
 }
-func (m *QueryListRecipesByCookbookRequest) XXX_Size() int {
+func (m *QueryGetTradeResponse) XXX_Size() int {
 	return m.Size()
 }
-func (m *QueryListRecipesByCookbookRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_QueryListRecipesByCookbookRequest.DiscardUnknown(m)
-}
-
-var xxx_messageInfo_QueryListRecipesByCookbookRequest proto.InternalMessageInfo
-
-func (m *QueryListRecipesByCookbookRequest) GetCookbookId() string {
-	if m != nil {
-		return m.CookbookId
-	}
-	return ""
+func (m *QueryGetTradeResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryGetTradeResponse.DiscardUnknown(m)
 }
 
-func (m *QueryListRecipesByCookbookRequest) GetPagination() *query.PageRequest {
+var xxx_messageInfo_QueryGetTradeResponse proto.InternalMessageInfo
+
+func (m *QueryGetTradeResponse) GetTrade() Trade {
 	if m != nil {
-		return m.Pagination
+		return m.Trade
 	}
-	return nil
+	return Trade{}
 }
 
-type QueryListRecipesByCookbookResponse struct {
-	Recipes []Recipe `protobuf:"bytes,1,rep,name=recipes,proto3" json:"recipes"`
-	// pagination defines the pagination in the response.
-	Pagination *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+type QueryListItemByOwnerRequest struct {
+	Owner string `protobuf:"bytes,1,opt,name=owner,proto3" json:"owner,omitempty"`
+	// pagination defines an optional pagination for the request.
+	Pagination *query.PageRequest `protobuf:"bytes,3,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
-func (m *QueryListRecipesByCookbookResponse) Reset()         { *m = QueryListRecipesByCookbookResponse{} }
-func (m *QueryListRecipesByCookbookResponse) String() string { return proto.CompactTextString(m) }
-func (*QueryListRecipesByCookbookResponse) ProtoMessage()    {}
-func (*QueryListRecipesByCookbookResponse) Descriptor() ([]byte, []int) {
+func (m *QueryListItemByOwnerRequest) Reset()         { *m = QueryListItemByOwnerRequest{} }
+func (m *QueryListItemByOwnerRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryListItemByOwnerRequest) ProtoMessage()    {}
+func (*QueryListItemByOwnerRequest) Descriptor() ([]byte, []int) {
 	return fileDescriptor_e873e88214e938bd, []int{36}
 }
-func (m *QueryListRecipesByCookbookResponse) XXX_Unmarshal(b []byte) error {
+func (m *QueryListItemByOwnerRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *QueryListRecipesByCookbookResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *QueryListItemByOwnerRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_QueryListRecipesByCookbookResponse.Marshal(b, m, deterministic)
+		return xxx_messageInfo_QueryListItemByOwnerRequest.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -1783,49 +1931,50 @@ func (m *QueryListRecipesByCookbookResponse) XXX_Marshal(b []byte, deterministic
 		return b[:n], nil
 	}
 }
-func (m *QueryListRecipesByCookbookResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_QueryListRecipesByCookbookResponse.Merge(m, src)
+func (m *QueryListItemByOwnerRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryListItemByOwnerRequest.Merge(m, src)
 }
-func (m *QueryListRecipesByCookbookResponse) XXX_Size() int {
+func (m *QueryListItemByOwnerRequest) XXX_Size() int {
 	return m.Size()
 }
-func (m *QueryListRecipesByCookbookResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_QueryListRecipesByCookbookResponse.DiscardUnknown(m)
+func (m *QueryListItemByOwnerRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryListItemByOwnerRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_QueryListRecipesByCookbookResponse proto.InternalMessageInfo
+var xxx_messageInfo_QueryListItemByOwnerRequest proto.InternalMessageInfo
 
-func (m *QueryListRecipesByCookbookResponse) GetRecipes() []Recipe {
+func (m *QueryListItemByOwnerRequest) GetOwner() string {
 	if m != nil {
-		return m.Recipes
+		return m.Owner
 	}
-	return nil
+	return ""
 }
 
-func (m *QueryListRecipesByCookbookResponse) GetPagination() *query.PageResponse {
+func (m *QueryListItemByOwnerRequest) GetPagination() *query.PageRequest {
 	if m != nil {
 		return m.Pagination
 	}
 	return nil
 }
 
-type QueryGetItemRequest struct {
-	CookbookId string `protobuf:"bytes,1,opt,name=cookbook_id,json=cookbookId,proto3" json:"cookbook_id,omitempty"`
-	Id         string `protobuf:"bytes,3,opt,name=id,proto3" json:"id,omitempty"`
+type QueryListItemByOwnerResponse struct {
+	Items []Item `protobuf:"bytes,1,rep,name=items,proto3" json:"items"`
+	// pagination defines the pagination in the response.
+	Pagination *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
-func (m *QueryGetItemRequest) Reset()         { *m = QueryGetItemRequest{} }
-func (m *QueryGetItemRequest) String() string { return proto.CompactTextString(m) }
-func (*QueryGetItemRequest) ProtoMessage()    {}
-func (*QueryGetItemRequest) Descriptor() ([]byte, []int) {
+func (m *QueryListItemByOwnerResponse) Reset()         { *m = QueryListItemByOwnerResponse{} }
+func (m *QueryListItemByOwnerResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryListItemByOwnerResponse) ProtoMessage()    {}
+func (*QueryListItemByOwnerResponse) Descriptor() ([]byte, []int) {
 	return fileDescriptor_e873e88214e938bd, []int{37}
 }
-func (m *QueryGetItemRequest) XXX_Unmarshal(b []byte) error {
+func (m *QueryListItemByOwnerResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *QueryGetItemRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *QueryListItemByOwnerResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_QueryGetItemRequest.Marshal(b, m, deterministic)
+		return xxx_messageInfo_QueryListItemByOwnerResponse.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -1835,48 +1984,36 @@ func (m *QueryGetItemRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte,
 		return b[:n], nil
 	}
 }
-func (m *QueryGetItemRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_QueryGetItemRequest.Merge(m, src)
+func (m *QueryListItemByOwnerResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryListItemByOwnerResponse.Merge(m, src)
 }
-func (m *QueryGetItemRequest) XXX_Size() int {
+func (m *QueryListItemByOwnerResponse) XXX_Size() int {
 	return m.Size()
 }
-func (m *QueryGetItemRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_QueryGetItemRequest.DiscardUnknown(m)
-}
-
-var xxx_messageInfo_QueryGetItemRequest proto.InternalMessageInfo
-
-func (m *QueryGetItemRequest) GetCookbookId() string {
-	if m != nil {
-		return m.CookbookId
-	}
-	return ""
+func (m *QueryListItemByOwnerResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryListItemByOwnerResponse.DiscardUnknown(m)
 }
 
-func (m *QueryGetItemRequest) GetId() string {
-	if m != nil {
-		return m.Id
-	}
-	return ""
-}
+var xxx_messageInfo_QueryListItemByOwnerResponse proto.InternalMessageInfo
 
-type QueryGetItemResponse struct {
-	Item Item `protobuf:"bytes,1,opt,name=item,proto3" json:"item"`
+type QueryListItemsByCookbookRequest struct {
+	CookbookId string `protobuf:"bytes,1,opt,name=cookbook_id,json=cookbookId,proto3" json:"cookbook_id,omitempty"`
+	// pagination defines an optional pagination for the request.
+	Pagination *query.PageRequest `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
-func (m *QueryGetItemResponse) Reset()         { *m = QueryGetItemResponse{} }
-func (m *QueryGetItemResponse) String() string { return proto.CompactTextString(m) }
-func (*QueryGetItemResponse) ProtoMessage()    {}
-func (*QueryGetItemResponse) Descriptor() ([]byte, []int) {
+func (m *QueryListItemsByCookbookRequest) Reset()         { *m = QueryListItemsByCookbookRequest{} }
+func (m *QueryListItemsByCookbookRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryListItemsByCookbookRequest) ProtoMessage()    {}
+func (*QueryListItemsByCookbookRequest) Descriptor() ([]byte, []int) {
 	return fileDescriptor_e873e88214e938bd, []int{38}
 }
-func (m *QueryGetItemResponse) XXX_Unmarshal(b []byte) error {
+func (m *QueryListItemsByCookbookRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *QueryGetItemResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *QueryListItemsByCookbookRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_QueryGetItemResponse.Marshal(b, m, deterministic)
+		return xxx_messageInfo_QueryListItemsByCookbookRequest.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -1886,42 +2023,50 @@ func (m *QueryGetItemResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte
 		return b[:n], nil
 	}
 }
-func (m *QueryGetItemResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_QueryGetItemResponse.Merge(m, src)
+func (m *QueryListItemsByCookbookRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryListItemsByCookbookRequest.Merge(m, src)
 }
-func (m *QueryGetItemResponse) XXX_Size() int {
+func (m *QueryListItemsByCookbookRequest) XXX_Size() int {
 	return m.Size()
 }
-func (m *QueryGetItemResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_QueryGetItemResponse.DiscardUnknown(m)
+func (m *QueryListItemsByCookbookRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryListItemsByCookbookRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_QueryGetItemResponse proto.InternalMessageInfo
+var xxx_messageInfo_QueryListItemsByCookbookRequest proto.InternalMessageInfo
 
-func (m *QueryGetItemResponse) GetItem() Item {
+func (m *QueryListItemsByCookbookRequest) GetCookbookId() string {
 	if m != nil {
-		return m.Item
+		return m.CookbookId
 	}
-	return Item{}
+	return ""
 }
 
-type QueryGetRecipeRequest struct {
-	CookbookId string `protobuf:"bytes,1,opt,name=cookbook_id,json=cookbookId,proto3" json:"cookbook_id,omitempty"`
-	Id         string `protobuf:"bytes,2,opt,name=id,proto3" json:"id,omitempty"`
+func (m *QueryListItemsByCookbookRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
 }
 
-func (m *QueryGetRecipeRequest) Reset()         { *m = QueryGetRecipeRequest{} }
-func (m *QueryGetRecipeRequest) String() string { return proto.CompactTextString(m) }
-func (*QueryGetRecipeRequest) ProtoMessage()    {}
-func (*QueryGetRecipeRequest) Descriptor() ([]byte, []int) {
+type QueryListItemsByCookbookResponse struct {
+	Items []Item `protobuf:"bytes,1,rep,name=items,proto3" json:"items"`
+	// pagination defines the pagination in the response.
+	Pagination *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryListItemsByCookbookResponse) Reset()         { *m = QueryListItemsByCookbookResponse{} }
+func (m *QueryListItemsByCookbookResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryListItemsByCookbookResponse) ProtoMessage()    {}
+func (*QueryListItemsByCookbookResponse) Descriptor() ([]byte, []int) {
 	return fileDescriptor_e873e88214e938bd, []int{39}
 }
-func (m *QueryGetRecipeRequest) XXX_Unmarshal(b []byte) error {
+func (m *QueryListItemsByCookbookResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *QueryGetRecipeRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *QueryListItemsByCookbookResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_QueryGetRecipeRequest.Marshal(b, m, deterministic)
+		return xxx_messageInfo_QueryListItemsByCookbookResponse.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -1931,48 +2076,51 @@ func (m *QueryGetRecipeRequest) XXX_Marshal(b []byte, deterministic bool) ([]byt
 		return b[:n], nil
 	}
 }
-func (m *QueryGetRecipeRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_QueryGetRecipeRequest.Merge(m, src)
+func (m *QueryListItemsByCookbookResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryListItemsByCookbookResponse.Merge(m, src)
 }
-func (m *QueryGetRecipeRequest) XXX_Size() int {
+func (m *QueryListItemsByCookbookResponse) XXX_Size() int {
 	return m.Size()
 }
-func (m *QueryGetRecipeRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_QueryGetRecipeRequest.DiscardUnknown(m)
+func (m *QueryListItemsByCookbookResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryListItemsByCookbookResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_QueryGetRecipeRequest proto.InternalMessageInfo
+var xxx_messageInfo_QueryListItemsByCookbookResponse proto.InternalMessageInfo
 
-func (m *QueryGetRecipeRequest) GetCookbookId() string {
+func (m *QueryListItemsByCookbookResponse) GetItems() []Item {
 	if m != nil {
-		return m.CookbookId
+		return m.Items
 	}
-	return ""
+	return nil
 }
 
-func (m *QueryGetRecipeRequest) GetId() string {
+func (m *QueryListItemsByCookbookResponse) GetPagination() *query.PageResponse {
 	if m != nil {
-		return m.Id
+		return m.Pagination
 	}
-	return ""
+	return nil
 }
 
-type QueryGetRecipeResponse struct {
-	Recipe Recipe `protobuf:"bytes,1,opt,name=recipe,proto3" json:"recipe"`
+type QueryListItemsByRecipeRequest struct {
+	CookbookId string `protobuf:"bytes,1,opt,name=cookbook_id,json=cookbookId,proto3" json:"cookbook_id,omitempty"`
+	RecipeId   string `protobuf:"bytes,2,opt,name=recipe_id,json=recipeId,proto3" json:"recipe_id,omitempty"`
+	// pagination defines an optional pagination for the request.
+	Pagination *query.PageRequest `protobuf:"bytes,3,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
-func (m *QueryGetRecipeResponse) Reset()         { *m = QueryGetRecipeResponse{} }
-func (m *QueryGetRecipeResponse) String() string { return proto.CompactTextString(m) }
-func (*QueryGetRecipeResponse) ProtoMessage()    {}
-func (*QueryGetRecipeResponse) Descriptor() ([]byte, []int) {
+func (m *QueryListItemsByRecipeRequest) Reset()         { *m = QueryListItemsByRecipeRequest{} }
+func (m *QueryListItemsByRecipeRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryListItemsByRecipeRequest) ProtoMessage()    {}
+func (*QueryListItemsByRecipeRequest) Descriptor() ([]byte, []int) {
 	return fileDescriptor_e873e88214e938bd, []int{40}
 }
-func (m *QueryGetRecipeResponse) XXX_Unmarshal(b []byte) error {
+func (m *QueryListItemsByRecipeRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *QueryGetRecipeResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *QueryListItemsByRecipeRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_QueryGetRecipeResponse.Marshal(b, m, deterministic)
+		return xxx_messageInfo_QueryListItemsByRecipeRequest.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -1982,43 +2130,57 @@ func (m *QueryGetRecipeResponse) XXX_Marshal(b []byte, deterministic bool) ([]by
 		return b[:n], nil
 	}
 }
-func (m *QueryGetRecipeResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_QueryGetRecipeResponse.Merge(m, src)
+func (m *QueryListItemsByRecipeRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryListItemsByRecipeRequest.Merge(m, src)
 }
-func (m *QueryGetRecipeResponse) XXX_Size() int {
+func (m *QueryListItemsByRecipeRequest) XXX_Size() int {
 	return m.Size()
 }
-func (m *QueryGetRecipeResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_QueryGetRecipeResponse.DiscardUnknown(m)
+func (m *QueryListItemsByRecipeRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryListItemsByRecipeRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_QueryGetRecipeResponse proto.InternalMessageInfo
+var xxx_messageInfo_QueryListItemsByRecipeRequest proto.InternalMessageInfo
 
-func (m *QueryGetRecipeResponse) GetRecipe() Recipe {
+func (m *QueryListItemsByRecipeRequest) GetCookbookId() string {
 	if m != nil {
-		return m.Recipe
+		return m.CookbookId
 	}
-	return Recipe{}
+	return ""
 }
 
-type QueryListCookbooksByCreatorRequest struct {
-	Creator string `protobuf:"bytes,1,opt,name=creator,proto3" json:"creator,omitempty"`
-	// pagination defines an optional pagination for the request.
-	Pagination *query.PageRequest `protobuf:"bytes,3,opt,name=pagination,proto3" json:"pagination,omitempty"`
+func (m *QueryListItemsByRecipeRequest) GetRecipeId() string {
+	if m != nil {
+		return m.RecipeId
+	}
+	return ""
 }
 
-func (m *QueryListCookbooksByCreatorRequest) Reset()         { *m = QueryListCookbooksByCreatorRequest{} }
-func (m *QueryListCookbooksByCreatorRequest) String() string { return proto.CompactTextString(m) }
-func (*QueryListCookbooksByCreatorRequest) ProtoMessage()    {}
-func (*QueryListCookbooksByCreatorRequest) Descriptor() ([]byte, []int) {
+func (m *QueryListItemsByRecipeRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+type QueryListItemsByRecipeResponse struct {
+	Items []Item `protobuf:"bytes,1,rep,name=items,proto3" json:"items"`
+	// pagination defines the pagination in the response.
+	Pagination *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryListItemsByRecipeResponse) Reset()         { *m = QueryListItemsByRecipeResponse{} }
+func (m *QueryListItemsByRecipeResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryListItemsByRecipeResponse) ProtoMessage()    {}
+func (*QueryListItemsByRecipeResponse) Descriptor() ([]byte, []int) {
 	return fileDescriptor_e873e88214e938bd, []int{41}
 }
-func (m *QueryListCookbooksByCreatorRequest) XXX_Unmarshal(b []byte) error {
+func (m *QueryListItemsByRecipeResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *QueryListCookbooksByCreatorRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *QueryListItemsByRecipeResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_QueryListCookbooksByCreatorRequest.Marshal(b, m, deterministic)
+		return xxx_messageInfo_QueryListItemsByRecipeResponse.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -2028,50 +2190,50 @@ func (m *QueryListCookbooksByCreatorRequest) XXX_Marshal(b []byte, deterministic
 		return b[:n], nil
 	}
 }
-func (m *QueryListCookbooksByCreatorRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_QueryListCookbooksByCreatorRequest.Merge(m, src)
+func (m *QueryListItemsByRecipeResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryListItemsByRecipeResponse.Merge(m, src)
 }
-func (m *QueryListCookbooksByCreatorRequest) XXX_Size() int {
+func (m *QueryListItemsByRecipeResponse) XXX_Size() int {
 	return m.Size()
 }
-func (m *QueryListCookbooksByCreatorRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_QueryListCookbooksByCreatorRequest.DiscardUnknown(m)
+func (m *QueryListItemsByRecipeResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryListItemsByRecipeResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_QueryListCookbooksByCreatorRequest proto.InternalMessageInfo
+var xxx_messageInfo_QueryListItemsByRecipeResponse proto.InternalMessageInfo
 
-func (m *QueryListCookbooksByCreatorRequest) GetCreator() string {
+func (m *QueryListItemsByRecipeResponse) GetItems() []Item {
 	if m != nil {
-		return m.Creator
+		return m.Items
 	}
-	return ""
+	return nil
 }
 
-func (m *QueryListCookbooksByCreatorRequest) GetPagination() *query.PageRequest {
+func (m *QueryListItemsByRecipeResponse) GetPagination() *query.PageResponse {
 	if m != nil {
 		return m.Pagination
 	}
 	return nil
 }
 
-type QueryListCookbooksByCreatorResponse struct {
-	Cookbooks []Cookbook `protobuf:"bytes,1,rep,name=cookbooks,proto3" json:"cookbooks"`
-	// pagination defines the pagination in the response.
-	Pagination *query.PageResponse `protobuf:"bytes,3,opt,name=pagination,proto3" json:"pagination,omitempty"`
+type QueryGetGoogleInAppPurchaseOrderRequest struct {
+	PurchaseToken string `protobuf:"bytes,1,opt,name=purchase_token,json=purchaseToken,proto3" json:"purchase_token,omitempty"`
 }
 
-func (m *QueryListCookbooksByCreatorResponse) Reset()         { *m = QueryListCookbooksByCreatorResponse{} }
-func (m *QueryListCookbooksByCreatorResponse) String() string { return proto.CompactTextString(m) }
-func (*QueryListCookbooksByCreatorResponse) ProtoMessage()    {}
-func (*QueryListCookbooksByCreatorResponse) Descriptor() ([]byte, []int) {
+func (m *QueryGetGoogleInAppPurchaseOrderRequest) Reset() {
+	*m = QueryGetGoogleInAppPurchaseOrderRequest{}
+}
+func (m *QueryGetGoogleInAppPurchaseOrderRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryGetGoogleInAppPurchaseOrderRequest) ProtoMessage()    {}
+func (*QueryGetGoogleInAppPurchaseOrderRequest) Descriptor() ([]byte, []int) {
 	return fileDescriptor_e873e88214e938bd, []int{42}
 }
-func (m *QueryListCookbooksByCreatorResponse) XXX_Unmarshal(b []byte) error {
+func (m *QueryGetGoogleInAppPurchaseOrderRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *QueryListCookbooksByCreatorResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *QueryGetGoogleInAppPurchaseOrderRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_QueryListCookbooksByCreatorResponse.Marshal(b, m, deterministic)
+		return xxx_messageInfo_QueryGetGoogleInAppPurchaseOrderRequest.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -2081,48 +2243,43 @@ func (m *QueryListCookbooksByCreatorResponse) XXX_Marshal(b []byte, deterministi
 		return b[:n], nil
 	}
 }
-func (m *QueryListCookbooksByCreatorResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_QueryListCookbooksByCreatorResponse.Merge(m, src)
+func (m *QueryGetGoogleInAppPurchaseOrderRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryGetGoogleInAppPurchaseOrderRequest.Merge(m, src)
 }
-func (m *QueryListCookbooksByCreatorResponse) XXX_Size() int {
+func (m *QueryGetGoogleInAppPurchaseOrderRequest) XXX_Size() int {
 	return m.Size()
 }
-func (m *QueryListCookbooksByCreatorResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_QueryListCookbooksByCreatorResponse.DiscardUnknown(m)
-}
+func (m *QueryGetGoogleInAppPurchaseOrderRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryGetGoogleInAppPurchaseOrderRequest.DiscardUnknown(m)
+}
 
-var xxx_messageInfo_QueryListCookbooksByCreatorResponse proto.InternalMessageInfo
+var xxx_messageInfo_QueryGetGoogleInAppPurchaseOrderRequest proto.InternalMessageInfo
 
-func (m *QueryListCookbooksByCreatorResponse) GetCookbooks() []Cookbook {
+func (m *QueryGetGoogleInAppPurchaseOrderRequest) GetPurchaseToken() string {
 	if m != nil {
-		return m.Cookbooks
+		return m.PurchaseToken
 	}
-	return nil
+	return ""
 }
 
-func (m *QueryListCookbooksByCreatorResponse) GetPagination() *query.PageResponse {
-	if m != nil {
-		return m.Pagination
-	}
-	return nil
+type QueryGetGoogleInAppPurchaseOrderResponse struct {
+	Order GoogleInAppPurchaseOrder `protobuf:"bytes,1,opt,name=order,proto3" json:"order"`
 }
 
-type QueryGetCookbookRequest struct {
-	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+func (m *QueryGetGoogleInAppPurchaseOrderResponse) Reset() {
+	*m = QueryGetGoogleInAppPurchaseOrderResponse{}
 }
-
-func (m *QueryGetCookbookRequest) Reset()         { *m = QueryGetCookbookRequest{} }
-func (m *QueryGetCookbookRequest) String() string { return proto.CompactTextString(m) }
-func (*QueryGetCookbookRequest) ProtoMessage()    {}
-func (*QueryGetCookbookRequest) Descriptor() ([]byte, []int) {
+func (m *QueryGetGoogleInAppPurchaseOrderResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryGetGoogleInAppPurchaseOrderResponse) ProtoMessage()    {}
+func (*QueryGetGoogleInAppPurchaseOrderResponse) Descriptor() ([]byte, []int) {
 	return fileDescriptor_e873e88214e938bd, []int{43}
 }
-func (m *QueryGetCookbookRequest) XXX_Unmarshal(b []byte) error {
+func (m *QueryGetGoogleInAppPurchaseOrderResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *QueryGetCookbookRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *QueryGetGoogleInAppPurchaseOrderResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_QueryGetCookbookRequest.Marshal(b, m, deterministic)
+		return xxx_messageInfo_QueryGetGoogleInAppPurchaseOrderResponse.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -2132,41 +2289,44 @@ func (m *QueryGetCookbookRequest) XXX_Marshal(b []byte, deterministic bool) ([]b
 		return b[:n], nil
 	}
 }
-func (m *QueryGetCookbookRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_QueryGetCookbookRequest.Merge(m, src)
+func (m *QueryGetGoogleInAppPurchaseOrderResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryGetGoogleInAppPurchaseOrderResponse.Merge(m, src)
 }
-func (m *QueryGetCookbookRequest) XXX_Size() int {
+func (m *QueryGetGoogleInAppPurchaseOrderResponse) XXX_Size() int {
 	return m.Size()
 }
-func (m *QueryGetCookbookRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_QueryGetCookbookRequest.DiscardUnknown(m)
+func (m *QueryGetGoogleInAppPurchaseOrderResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryGetGoogleInAppPurchaseOrderResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_QueryGetCookbookRequest proto.InternalMessageInfo
+var xxx_messageInfo_QueryGetGoogleInAppPurchaseOrderResponse proto.InternalMessageInfo
 
-func (m *QueryGetCookbookRequest) GetId() string {
+func (m *QueryGetGoogleInAppPurchaseOrderResponse) GetOrder() GoogleInAppPurchaseOrder {
 	if m != nil {
-		return m.Id
+		return m.Order
 	}
-	return ""
+	return GoogleInAppPurchaseOrder{}
 }
 
-type QueryGetCookbookResponse struct {
-	Cookbook Cookbook `protobuf:"bytes,1,opt,name=cookbook,proto3" json:"cookbook"`
+type QueryListExecutionsByItemRequest struct {
+	CookbookId string `protobuf:"bytes,1,opt,name=cookbook_id,json=cookbookId,proto3" json:"cookbook_id,omitempty"`
+	ItemId     string `protobuf:"bytes,2,opt,name=item_id,json=itemId,proto3" json:"item_id,omitempty"`
+	// pagination defines an optional pagination for the request.
+	Pagination *query.PageRequest `protobuf:"bytes,3,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
-func (m *QueryGetCookbookResponse) Reset()         { *m = QueryGetCookbookResponse{} }
-func (m *QueryGetCookbookResponse) String() string { return proto.CompactTextString(m) }
-func (*QueryGetCookbookResponse) ProtoMessage()    {}
-func (*QueryGetCookbookResponse) Descriptor() ([]byte, []int) {
+func (m *QueryListExecutionsByItemRequest) Reset()         { *m = QueryListExecutionsByItemRequest{} }
+func (m *QueryListExecutionsByItemRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryListExecutionsByItemRequest) ProtoMessage()    {}
+func (*QueryListExecutionsByItemRequest) Descriptor() ([]byte, []int) {
 	return fileDescriptor_e873e88214e938bd, []int{44}
 }
-func (m *QueryGetCookbookResponse) XXX_Unmarshal(b []byte) error {
+func (m *QueryListExecutionsByItemRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *QueryGetCookbookResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *QueryListExecutionsByItemRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_QueryGetCookbookResponse.Marshal(b, m, deterministic)
+		return xxx_messageInfo_QueryListExecutionsByItemRequest.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -2176,3586 +2336,12494 @@ func (m *QueryGetCookbookResponse) XXX_Marshal(b []byte, deterministic bool) ([]
 		return b[:n], nil
 	}
 }
-func (m *QueryGetCookbookResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_QueryGetCookbookResponse.Merge(m, src)
+func (m *QueryListExecutionsByItemRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryListExecutionsByItemRequest.Merge(m, src)
 }
-func (m *QueryGetCookbookResponse) XXX_Size() int {
+func (m *QueryListExecutionsByItemRequest) XXX_Size() int {
 	return m.Size()
 }
-func (m *QueryGetCookbookResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_QueryGetCookbookResponse.DiscardUnknown(m)
+func (m *QueryListExecutionsByItemRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryListExecutionsByItemRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_QueryGetCookbookResponse proto.InternalMessageInfo
+var xxx_messageInfo_QueryListExecutionsByItemRequest proto.InternalMessageInfo
 
-func (m *QueryGetCookbookResponse) GetCookbook() Cookbook {
-	if m != nil {
-		return m.Cookbook
-	}
-	return Cookbook{}
+type QueryListExecutionsByItemResponse struct {
+	CompletedExecutions []Execution `protobuf:"bytes,1,rep,name=completed_executions,json=completedExecutions,proto3" json:"completed_executions"`
+	PendingExecutions   []Execution `protobuf:"bytes,2,rep,name=pending_executions,json=pendingExecutions,proto3" json:"pending_executions"`
+	// pagination defines the pagination in the response.
+	Pagination *query.PageResponse `protobuf:"bytes,3,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
-func init() {
-	proto.RegisterType((*QueryListSignUpByReferee)(nil), "pylons.pylons.QueryListSignUpByReferee")
-	proto.RegisterType((*QueryListSignUpByRefereeResponse)(nil), "pylons.pylons.QueryListSignUpByRefereeResponse")
-	proto.RegisterType((*QueryListTradesByCreatorRequest)(nil), "pylons.pylons.QueryListTradesByCreatorRequest")
-	proto.RegisterType((*QueryListTradesByCreatorResponse)(nil), "pylons.pylons.QueryListTradesByCreatorResponse")
-	proto.RegisterType((*QueryGetItemHistoryRequest)(nil), "pylons.pylons.QueryGetItemHistoryRequest")
-	proto.RegisterType((*QueryGetItemHistoryResponse)(nil), "pylons.pylons.QueryGetItemHistoryResponse")
-	proto.RegisterType((*QueryGetRecipeHistoryRequest)(nil), "pylons.pylons.QueryGetRecipeHistoryRequest")
-	proto.RegisterType((*QueryGetRecipeHistoryResponse)(nil), "pylons.pylons.QueryGetRecipeHistoryResponse")
-	proto.RegisterType((*RecipeHistory)(nil), "pylons.pylons.RecipeHistory")
-	proto.RegisterType((*QueryGetStripeRefundRequest)(nil), "pylons.pylons.QueryGetStripeRefundRequest")
-	proto.RegisterType((*QueryGetStripeRefundResponse)(nil), "pylons.pylons.QueryGetStripeRefundResponse")
-	proto.RegisterType((*QueryGetRedeemInfoRequest)(nil), "pylons.pylons.QueryGetRedeemInfoRequest")
-	proto.RegisterType((*QueryGetRedeemInfoResponse)(nil), "pylons.pylons.QueryGetRedeemInfoResponse")
-	proto.RegisterType((*QueryAllRedeemInfoRequest)(nil), "pylons.pylons.QueryAllRedeemInfoRequest")
-	proto.RegisterType((*QueryAllRedeemInfoResponse)(nil), "pylons.pylons.QueryAllRedeemInfoResponse")
-	proto.RegisterType((*QueryGetPaymentInfoRequest)(nil), "pylons.pylons.QueryGetPaymentInfoRequest")
-	proto.RegisterType((*QueryGetPaymentInfoResponse)(nil), "pylons.pylons.QueryGetPaymentInfoResponse")
-	proto.RegisterType((*QueryAllPaymentInfoRequest)(nil), "pylons.pylons.QueryAllPaymentInfoRequest")
-	proto.RegisterType((*QueryAllPaymentInfoResponse)(nil), "pylons.pylons.QueryAllPaymentInfoResponse")
-	proto.RegisterType((*QueryGetUsernameByAddressRequest)(nil), "pylons.pylons.QueryGetUsernameByAddressRequest")
-	proto.RegisterType((*QueryGetAddressByUsernameRequest)(nil), "pylons.pylons.QueryGetAddressByUsernameRequest")
-	proto.RegisterType((*QueryGetUsernameByAddressResponse)(nil), "pylons.pylons.QueryGetUsernameByAddressResponse")
-	proto.RegisterType((*QueryGetAddressByUsernameResponse)(nil), "pylons.pylons.QueryGetAddressByUsernameResponse")
-	proto.RegisterType((*QueryGetTradeRequest)(nil), "pylons.pylons.QueryGetTradeRequest")
-	proto.RegisterType((*QueryGetTradeResponse)(nil), "pylons.pylons.QueryGetTradeResponse")
-	proto.RegisterType((*QueryListItemByOwnerRequest)(nil), "pylons.pylons.QueryListItemByOwnerRequest")
-	proto.RegisterType((*QueryListItemByOwnerResponse)(nil), "pylons.pylons.QueryListItemByOwnerResponse")
-	proto.RegisterType((*QueryGetGoogleInAppPurchaseOrderRequest)(nil), "pylons.pylons.QueryGetGoogleInAppPurchaseOrderRequest")
-	proto.RegisterType((*QueryGetGoogleInAppPurchaseOrderResponse)(nil), "pylons.pylons.QueryGetGoogleInAppPurchaseOrderResponse")
-	proto.RegisterType((*QueryListExecutionsByItemRequest)(nil), "pylons.pylons.QueryListExecutionsByItemRequest")
-	proto.RegisterType((*QueryListExecutionsByItemResponse)(nil), "pylons.pylons.QueryListExecutionsByItemResponse")
-	proto.RegisterType((*QueryListExecutionsByRecipeRequest)(nil), "pylons.pylons.QueryListExecutionsByRecipeRequest")
-	proto.RegisterType((*QueryListExecutionsByRecipeResponse)(nil), "pylons.pylons.QueryListExecutionsByRecipeResponse")
-	proto.RegisterType((*QueryGetExecutionRequest)(nil), "pylons.pylons.QueryGetExecutionRequest")
-	proto.RegisterType((*QueryGetExecutionResponse)(nil), "pylons.pylons.QueryGetExecutionResponse")
-	proto.RegisterType((*QueryListRecipesByCookbookRequest)(nil), "pylons.pylons.QueryListRecipesByCookbookRequest")
-	proto.RegisterType((*QueryListRecipesByCookbookResponse)(nil), "pylons.pylons.QueryListRecipesByCookbookResponse")
-	proto.RegisterType((*QueryGetItemRequest)(nil), "pylons.pylons.QueryGetItemRequest")
-	proto.RegisterType((*QueryGetItemResponse)(nil), "pylons.pylons.QueryGetItemResponse")
-	proto.RegisterType((*QueryGetRecipeRequest)(nil), "pylons.pylons.QueryGetRecipeRequest")
-	proto.RegisterType((*QueryGetRecipeResponse)(nil), "pylons.pylons.QueryGetRecipeResponse")
-	proto.RegisterType((*QueryListCookbooksByCreatorRequest)(nil), "pylons.pylons.QueryListCookbooksByCreatorRequest")
-	proto.RegisterType((*QueryListCookbooksByCreatorResponse)(nil), "pylons.pylons.QueryListCookbooksByCreatorResponse")
-	proto.RegisterType((*QueryGetCookbookRequest)(nil), "pylons.pylons.QueryGetCookbookRequest")
-	proto.RegisterType((*QueryGetCookbookResponse)(nil), "pylons.pylons.QueryGetCookbookResponse")
+func (m *QueryListExecutionsByItemResponse) Reset()         { *m = QueryListExecutionsByItemResponse{} }
+func (m *QueryListExecutionsByItemResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryListExecutionsByItemResponse) ProtoMessage()    {}
+func (*QueryListExecutionsByItemResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e873e88214e938bd, []int{45}
 }
-
-func init() { proto.RegisterFile("pylons/pylons/query.proto", fileDescriptor_e873e88214e938bd) }
-
-var fileDescriptor_e873e88214e938bd = []byte{
-	// 2167 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe4, 0x5a, 0xcd, 0x6f, 0xdc, 0xc6,
-	0x15, 0x17, 0x57, 0xdf, 0x4f, 0x91, 0x1d, 0x8f, 0x24, 0x6b, 0x45, 0x7d, 0x53, 0xb6, 0x25, 0x7f,
-	0x64, 0x19, 0xc9, 0x8e, 0x83, 0x24, 0x6e, 0x51, 0x29, 0x88, 0x15, 0xa1, 0x4d, 0xa2, 0xac, 0xa3,
-	0x16, 0x28, 0x8a, 0x2c, 0xa8, 0xdd, 0xf1, 0x8a, 0xb0, 0x96, 0x64, 0x48, 0x6e, 0xe2, 0xed, 0x62,
-	0x83, 0x7e, 0x00, 0x45, 0x8b, 0x7e, 0x20, 0x45, 0x2f, 0x3d, 0xa6, 0x4d, 0xd0, 0xa2, 0x08, 0x50,
-	0xa0, 0x45, 0x8f, 0xfd, 0x03, 0x72, 0x0c, 0xd0, 0x4b, 0x4f, 0x45, 0x61, 0xf7, 0xd0, 0x73, 0xff,
-	0x82, 0x82, 0x33, 0x6f, 0xc8, 0x21, 0x97, 0xb3, 0x5a, 0x39, 0xf2, 0x29, 0x27, 0x2f, 0x67, 0xde,
-	0x7b, 0xf3, 0x7b, 0xbf, 0x79, 0xf3, 0xe6, 0xcd, 0x93, 0x61, 0xce, 0x6b, 0x1d, 0xbb, 0x4e, 0x60,
-	0xe2, 0x3f, 0xef, 0x35, 0xa9, 0xdf, 0x2a, 0x79, 0xbe, 0x1b, 0xba, 0x64, 0x92, 0x8f, 0x95, 0xf8,
-	0x3f, 0xfa, 0x42, 0xdd, 0x75, 0xeb, 0xc7, 0xd4, 0xb4, 0x3c, 0xdb, 0xb4, 0x1c, 0xc7, 0x0d, 0xad,
-	0xd0, 0x66, 0xd3, 0x91, 0xb0, 0x7e, 0xad, 0xea, 0x06, 0x0d, 0x37, 0x30, 0x0f, 0xad, 0x80, 0x72,
-	0x2b, 0xe6, 0xfb, 0x9b, 0x87, 0x34, 0xb4, 0x36, 0x4d, 0xcf, 0xaa, 0xdb, 0x0e, 0x13, 0x46, 0xd9,
-	0xe9, 0xba, 0x5b, 0x77, 0xd9, 0x4f, 0x33, 0xfa, 0x85, 0xa3, 0xcb, 0x69, 0x24, 0x3e, 0xad, 0x51,
-	0xda, 0xa8, 0xd8, 0xce, 0x7d, 0x21, 0xb0, 0x92, 0x16, 0xf0, 0xac, 0x56, 0x83, 0x3a, 0xa1, 0x2c,
-	0xb1, 0x90, 0x96, 0xb0, 0xaa, 0x55, 0xb7, 0xe9, 0x84, 0x02, 0x62, 0xc6, 0xd5, 0xd0, 0xb7, 0x6a,
-	0x14, 0xa7, 0x2e, 0xa5, 0xa7, 0xb8, 0xa7, 0x15, 0xdb, 0xf2, 0x2a, 0xae, 0x5f, 0xa3, 0x3e, 0x4a,
-	0x2d, 0xa6, 0xa5, 0xe8, 0x43, 0x5a, 0x6d, 0x4a, 0x6e, 0x15, 0xd3, 0xd3, 0x76, 0x48, 0x1b, 0x38,
-	0xa3, 0x67, 0x5d, 0xab, 0xda, 0x1e, 0xcd, 0xc7, 0x5c, 0x75, 0xdd, 0x07, 0x87, 0xae, 0xfb, 0x00,
-	0x67, 0x57, 0xd3, 0xb3, 0x41, 0xe8, 0xdb, 0x1e, 0xad, 0xf8, 0xf4, 0x7e, 0xd3, 0xa9, 0x71, 0x11,
-	0xe3, 0x16, 0x14, 0xdf, 0x8e, 0xf8, 0xfe, 0x96, 0x1d, 0x84, 0xf7, 0xec, 0xba, 0x73, 0xe0, 0xed,
-	0xb4, 0xca, 0xf4, 0x3e, 0xf5, 0x29, 0x25, 0x45, 0x18, 0xad, 0xfa, 0xd4, 0x0a, 0x5d, 0xbf, 0xa8,
-	0xad, 0x68, 0x1b, 0xe3, 0x65, 0xf1, 0x69, 0x1c, 0xc0, 0x8a, 0x4a, 0xab, 0x4c, 0x03, 0xcf, 0x75,
-	0x02, 0x4a, 0x36, 0x61, 0x24, 0xb0, 0xeb, 0x4e, 0xd3, 0x63, 0xca, 0x13, 0x5b, 0x73, 0xa5, 0x54,
-	0x44, 0x94, 0x98, 0xbc, 0x6f, 0x1d, 0x7f, 0xf3, 0xdb, 0x65, 0x14, 0x34, 0x7e, 0xac, 0xc1, 0x72,
-	0x6c, 0xf7, 0x9d, 0x88, 0xe1, 0x60, 0xa7, 0xf5, 0x2a, 0x5f, 0xb3, 0x4c, 0xdf, 0x6b, 0xd2, 0x20,
-	0x54, 0x83, 0x22, 0x77, 0x01, 0x92, 0x60, 0x29, 0x16, 0xd8, 0xa2, 0x57, 0x4a, 0x3c, 0xb2, 0x4a,
-	0x51, 0x64, 0x95, 0x78, 0x7c, 0x62, 0x64, 0x95, 0xf6, 0xad, 0x3a, 0x45, 0xab, 0x65, 0x49, 0xd3,
-	0xf8, 0x93, 0x26, 0x79, 0xd7, 0x85, 0x02, 0xbd, 0xdb, 0x82, 0x11, 0x16, 0x02, 0x41, 0x51, 0x5b,
-	0x19, 0xdc, 0x98, 0xd8, 0x9a, 0xce, 0x78, 0xc7, 0xf4, 0x76, 0x86, 0x3e, 0xff, 0xd7, 0xf2, 0x40,
-	0x19, 0x25, 0xc9, 0x6e, 0x0e, 0xc0, 0xf5, 0x13, 0x01, 0xf2, 0x05, 0x65, 0x84, 0x2f, 0x8f, 0xfd,
-	0xf4, 0xe3, 0xe5, 0x81, 0xff, 0x7e, 0xbc, 0x3c, 0x60, 0xb4, 0x41, 0x67, 0x50, 0x77, 0x69, 0xb8,
-	0x17, 0xd2, 0xc6, 0xeb, 0x76, 0x10, 0xba, 0x7e, 0x4b, 0x70, 0xb5, 0x0c, 0x13, 0x22, 0x22, 0x2a,
-	0x76, 0x0d, 0xf9, 0x02, 0x31, 0xb4, 0x57, 0x23, 0xb3, 0x30, 0x1a, 0x05, 0x5a, 0x34, 0x59, 0x60,
-	0x93, 0x23, 0xd1, 0xe7, 0x5e, 0x8d, 0xac, 0xc1, 0x64, 0xc3, 0x76, 0x42, 0x5a, 0xab, 0x38, 0xcd,
-	0xc6, 0x21, 0xf5, 0x8b, 0x83, 0x6c, 0xfa, 0x19, 0x3e, 0xf8, 0x26, 0x1b, 0x33, 0xee, 0xc1, 0x7c,
-	0xee, 0xe2, 0x48, 0xd1, 0x2d, 0x18, 0x3d, 0xe2, 0x43, 0xc8, 0x91, 0x9e, 0xe1, 0x48, 0x56, 0x12,
-	0xa2, 0xc6, 0xf7, 0x60, 0x41, 0x18, 0x2d, 0xb3, 0x48, 0x3f, 0xad, 0x4f, 0xf3, 0x30, 0xce, 0x8f,
-	0x48, 0xe2, 0xd5, 0x18, 0x1f, 0xd8, 0xab, 0x19, 0xdf, 0x81, 0x45, 0x85, 0x75, 0x04, 0x7d, 0x3b,
-	0x0b, 0x7a, 0xa1, 0x2b, 0x6c, 0x65, 0xb5, 0x18, 0xf6, 0xff, 0x34, 0x98, 0x4c, 0x4d, 0xc9, 0xdc,
-	0x6a, 0x29, 0x6e, 0x33, 0x1e, 0x14, 0x7a, 0x7b, 0x30, 0x98, 0xf6, 0x80, 0x5c, 0x84, 0x91, 0x80,
-	0x3a, 0x35, 0xea, 0x17, 0x87, 0xb8, 0x55, 0xfe, 0x15, 0x59, 0xe5, 0xbf, 0x2a, 0x8e, 0xd5, 0xa0,
-	0xc5, 0x61, 0x6e, 0x95, 0x0f, 0xbd, 0x69, 0x35, 0x28, 0xd1, 0x21, 0x32, 0x42, 0xed, 0xf7, 0xa9,
-	0x5f, 0x1c, 0x89, 0x8d, 0xb2, 0xef, 0xc8, 0xa8, 0xd5, 0x88, 0xb2, 0x5d, 0x71, 0x94, 0x1b, 0xe5,
-	0x5f, 0x64, 0x11, 0x80, 0x9d, 0x2e, 0x5a, 0xab, 0x58, 0x61, 0x71, 0x6c, 0x45, 0xdb, 0x18, 0x2c,
-	0x8f, 0xe3, 0xc8, 0x76, 0x68, 0x2c, 0x26, 0x01, 0x70, 0x8f, 0xe5, 0x96, 0x32, 0x4b, 0x2d, 0xb8,
-	0x55, 0xc6, 0x41, 0xb2, 0x95, 0xe9, 0x69, 0xe4, 0xfa, 0x05, 0x18, 0xe5, 0xb9, 0x48, 0x1c, 0xa2,
-	0xf9, 0x0c, 0xd7, 0x29, 0x2d, 0x21, 0x6b, 0x5c, 0x87, 0xb9, 0x64, 0x0f, 0xa3, 0x34, 0xbf, 0xe7,
-	0xdc, 0x77, 0x45, 0x78, 0x9c, 0x83, 0x42, 0x4c, 0x78, 0xc1, 0xae, 0x19, 0xef, 0x26, 0x07, 0x44,
-	0x16, 0x46, 0x04, 0xdf, 0x80, 0x09, 0xe9, 0xa6, 0x50, 0x26, 0x2a, 0xa1, 0x87, 0xe7, 0x19, 0xfc,
-	0x78, 0xc4, 0xa8, 0x22, 0x98, 0xed, 0xe3, 0xe3, 0x6e, 0x30, 0xe9, 0x8c, 0xa4, 0x3d, 0x71, 0x46,
-	0xfa, 0xa3, 0x86, 0x5e, 0x64, 0x56, 0x51, 0x79, 0x31, 0x78, 0x4a, 0x2f, 0xce, 0x2c, 0x33, 0x19,
-	0x5f, 0x4b, 0xe8, 0xde, 0xe7, 0x37, 0xac, 0xcc, 0xc7, 0x32, 0x4c, 0x78, 0x4d, 0xbf, 0x7a, 0x64,
-	0x05, 0x54, 0x3a, 0xbb, 0x62, 0x68, 0xaf, 0x66, 0x1c, 0x26, 0x01, 0x95, 0x52, 0x47, 0x47, 0x5f,
-	0x85, 0x67, 0xe4, 0x7b, 0x1b, 0x19, 0xcd, 0xa6, 0x15, 0x49, 0x13, 0x5d, 0x9d, 0xf0, 0x92, 0x21,
-	0xa3, 0x96, 0x70, 0x99, 0x03, 0xf1, 0xac, 0xb6, 0xec, 0x33, 0x0d, 0x5d, 0xc9, 0x2e, 0xa3, 0x74,
-	0x65, 0xf0, 0xd4, 0xae, 0x9c, 0xdd, 0xb6, 0xdd, 0xc1, 0x1b, 0x6f, 0x97, 0x86, 0x07, 0x01, 0xf5,
-	0xa3, 0x0c, 0xb2, 0xd3, 0xda, 0xae, 0xd5, 0x7c, 0x1a, 0x04, 0xd2, 0xc5, 0x6b, 0xf1, 0x11, 0x71,
-	0xf1, 0xe2, 0xa7, 0xf1, 0xf5, 0x44, 0x1b, 0x75, 0x76, 0x5a, 0xc2, 0x8c, 0xd0, 0xd6, 0x61, 0xac,
-	0x89, 0x43, 0xa8, 0x1e, 0x7f, 0x1b, 0xef, 0xc2, 0x6a, 0x8f, 0xd5, 0x91, 0xb0, 0x97, 0x32, 0x06,
-	0x26, 0xb6, 0x66, 0x33, 0x64, 0xc5, 0xba, 0x9c, 0xa9, 0xc4, 0x7e, 0x25, 0xb1, 0x9f, 0x83, 0x0f,
-	0xed, 0xbf, 0x9c, 0x76, 0xaf, 0x7b, 0x2f, 0xb6, 0x79, 0x3d, 0x18, 0x59, 0xc0, 0x15, 0x62, 0x02,
-	0xae, 0xc0, 0xb4, 0x58, 0x80, 0xdd, 0xfb, 0xdd, 0xc9, 0x68, 0x88, 0x25, 0xa3, 0x3d, 0x98, 0xc9,
-	0xc8, 0xe1, 0xe2, 0xcf, 0xc3, 0x30, 0xab, 0x11, 0x70, 0xe9, 0x5e, 0xc5, 0x04, 0x17, 0x34, 0xda,
-	0x18, 0x5e, 0x51, 0x8d, 0x12, 0xdd, 0xa3, 0x3b, 0xad, 0xb7, 0x3e, 0x70, 0x68, 0x5c, 0x25, 0x4d,
-	0xc3, 0xb0, 0x1b, 0x7d, 0x23, 0xd7, 0xfc, 0x23, 0x13, 0xdc, 0x83, 0x4f, 0x1c, 0xdc, 0xbf, 0xd7,
-	0x30, 0xb3, 0x77, 0xad, 0x8e, 0xfe, 0x98, 0x30, 0x1c, 0x5d, 0x76, 0x22, 0xaf, 0x4f, 0xe5, 0x5c,
-	0xfc, 0xc2, 0x1d, 0x26, 0xf7, 0x34, 0x4a, 0xa3, 0x7d, 0x58, 0x17, 0x64, 0xef, 0xb2, 0x8a, 0x7c,
-	0xcf, 0xd9, 0xf6, 0xbc, 0x7d, 0x4c, 0x36, 0x6f, 0x45, 0x95, 0xb9, 0x60, 0xeb, 0x32, 0x9c, 0x8b,
-	0xf3, 0x52, 0xe8, 0x3e, 0xa0, 0x0e, 0xd2, 0x36, 0x29, 0x46, 0xdf, 0x89, 0x06, 0x0d, 0x17, 0x36,
-	0x4e, 0xb6, 0x18, 0x9f, 0xef, 0x61, 0x56, 0xfc, 0xe3, 0x8e, 0xae, 0x67, 0x18, 0x50, 0xe9, 0x0b,
-	0x56, 0x98, 0xae, 0xf1, 0x67, 0xb9, 0x12, 0x7d, 0x4d, 0x3c, 0x18, 0x82, 0x9d, 0x56, 0x44, 0xe0,
-	0x97, 0x2f, 0xf2, 0xce, 0x28, 0x1c, 0x24, 0xce, 0x7f, 0x55, 0xc0, 0xa3, 0x96, 0x0f, 0x18, 0xb9,
-	0x79, 0x1b, 0xa6, 0xab, 0x6e, 0xc3, 0x3b, 0xa6, 0x51, 0x5d, 0x11, 0xbf, 0x83, 0x44, 0xb0, 0x14,
-	0x33, 0x54, 0xc5, 0x66, 0x90, 0x9b, 0xa9, 0x58, 0x37, 0x59, 0x80, 0xbc, 0x01, 0xc4, 0xa3, 0x4e,
-	0xcd, 0x76, 0xea, 0xb2, 0xc1, 0x42, 0x5f, 0x06, 0x2f, 0xa0, 0xa6, 0x64, 0x6e, 0x37, 0x87, 0x99,
-	0x27, 0x4a, 0xac, 0x7f, 0xd3, 0xc0, 0xc8, 0x25, 0x84, 0xd7, 0x8a, 0x67, 0x52, 0xd4, 0x3e, 0x85,
-	0x7d, 0xfc, 0xa8, 0x00, 0x6b, 0x3d, 0x61, 0x7f, 0xf5, 0x76, 0xf2, 0x1a, 0x3e, 0x94, 0x77, 0x69,
-	0x42, 0x88, 0xaa, 0xe8, 0xfc, 0x20, 0xa9, 0x50, 0x25, 0x59, 0xe4, 0xec, 0x0e, 0x8c, 0xc7, 0x8e,
-	0x61, 0x76, 0x38, 0xc9, 0xaf, 0x44, 0x81, 0x2c, 0xc0, 0x78, 0xcc, 0x1a, 0x0b, 0x84, 0xb1, 0x72,
-	0x32, 0x60, 0xfc, 0x42, 0x93, 0xce, 0x1f, 0xdf, 0xab, 0xe8, 0xed, 0x8a, 0x71, 0xd4, 0x77, 0xb4,
-	0x9d, 0xd5, 0x4b, 0xfa, 0x53, 0x39, 0xfa, 0x73, 0xe0, 0xc8, 0xef, 0x00, 0x36, 0x89, 0x81, 0x33,
-	0x93, 0xfb, 0xe6, 0x12, 0xb7, 0x2e, 0xca, 0x9e, 0x5d, 0xf5, 0x73, 0x17, 0xa6, 0xe4, 0x77, 0x6c,
-	0xdf, 0x34, 0xf1, 0x6d, 0x1f, 0x8c, 0xb7, 0xfd, 0xb5, 0xa4, 0x0c, 0x48, 0xe5, 0xbb, 0xe7, 0x60,
-	0x28, 0xca, 0xb8, 0xb8, 0xd9, 0x3d, 0x2e, 0x43, 0x26, 0x66, 0xbc, 0x9e, 0x54, 0x09, 0xa7, 0xcc,
-	0x12, 0x1c, 0x50, 0x21, 0x06, 0xf4, 0x06, 0x5c, 0xcc, 0x5a, 0x42, 0x48, 0x37, 0x61, 0x84, 0xd3,
-	0x88, 0xa0, 0x7a, 0x32, 0x8e, 0xa2, 0xc6, 0x4f, 0xe4, 0xed, 0x14, 0xbb, 0xf8, 0xe4, 0x1d, 0x9a,
-	0xc1, 0x2f, 0x53, 0x5c, 0xaf, 0xf5, 0x04, 0x82, 0x5e, 0xbe, 0x12, 0x1d, 0x16, 0x9c, 0xc5, 0xd0,
-	0xca, 0x16, 0x8d, 0x42, 0x5b, 0x9c, 0xb4, 0x58, 0xfe, 0xec, 0x32, 0xc7, 0x55, 0x98, 0x15, 0xbb,
-	0x90, 0x3d, 0x89, 0xd9, 0xc4, 0x71, 0x90, 0x24, 0x99, 0xae, 0x53, 0xf2, 0x12, 0x8c, 0x09, 0x70,
-	0x8a, 0x02, 0x38, 0xe3, 0x4b, 0x2c, 0xbe, 0xf5, 0x68, 0x0e, 0x86, 0x99, 0x5d, 0xf2, 0x5b, 0x0d,
-	0xa6, 0x72, 0xda, 0x5a, 0xa4, 0x94, 0x31, 0x75, 0x42, 0x17, 0x4e, 0x37, 0xfb, 0x96, 0xe7, 0xe8,
-	0x8d, 0x95, 0x1f, 0xfd, 0xe3, 0x3f, 0xbf, 0x29, 0xe8, 0xa4, 0x98, 0x6a, 0xa0, 0x06, 0x66, 0x1b,
-	0x63, 0xa3, 0x43, 0x7e, 0x8d, 0xd0, 0xb2, 0x5d, 0xc8, 0x75, 0xd5, 0x52, 0x19, 0x41, 0x35, 0x26,
-	0x45, 0x87, 0xb2, 0x0f, 0x4c, 0x9f, 0x69, 0xf0, 0x6c, 0xb6, 0x55, 0x44, 0xae, 0xe7, 0xad, 0xa3,
-	0x68, 0x57, 0xe9, 0x37, 0xfa, 0x13, 0x46, 0x44, 0x77, 0x18, 0xa2, 0xdb, 0xe4, 0x56, 0xdc, 0x4b,
-	0xa6, 0x61, 0x05, 0x2f, 0x7e, 0xec, 0x34, 0x99, 0x6d, 0x29, 0x07, 0x74, 0xcc, 0x76, 0x5c, 0x16,
-	0x74, 0xc8, 0x2f, 0x35, 0x38, 0x9f, 0xe9, 0xb5, 0x90, 0x6b, 0x8a, 0xf5, 0x73, 0xfa, 0x35, 0xfa,
-	0xf5, 0xbe, 0x64, 0x11, 0xea, 0x2a, 0x83, 0x3a, 0x4f, 0xe6, 0x64, 0xa8, 0xa9, 0x0e, 0x33, 0xf9,
-	0x83, 0x06, 0xb3, 0x98, 0x0b, 0xd9, 0xf3, 0x20, 0x38, 0xb2, 0x3d, 0x41, 0xe2, 0x55, 0xc5, 0x5a,
-	0xdd, 0x5d, 0x4c, 0xfd, 0x5a, 0x3f, 0xa2, 0x88, 0xea, 0x16, 0x43, 0x55, 0x22, 0x37, 0xe4, 0x3e,
-	0xba, 0x8a, 0x3a, 0xac, 0x8a, 0x3b, 0xe4, 0x43, 0x80, 0xa4, 0x3d, 0x42, 0x36, 0x94, 0x5b, 0x96,
-	0xe9, 0xef, 0xe8, 0x57, 0xfb, 0x90, 0x44, 0x60, 0xf3, 0x0c, 0xd8, 0x0c, 0x99, 0x4a, 0xff, 0x85,
-	0xc2, 0x6c, 0x47, 0xeb, 0x77, 0x60, 0x32, 0x51, 0xd9, 0x3e, 0x3e, 0xce, 0x87, 0x90, 0xd7, 0x62,
-	0xca, 0x87, 0x90, 0xdb, 0x26, 0x32, 0x66, 0x19, 0x84, 0x0b, 0xe4, 0x7c, 0x1a, 0x42, 0x40, 0x7e,
-	0xae, 0xc1, 0x84, 0xd4, 0x69, 0x50, 0xee, 0x4d, 0x77, 0xbb, 0x44, 0xb9, 0x37, 0x39, 0x2d, 0x0f,
-	0xe3, 0x32, 0x5b, 0x7f, 0x99, 0x2c, 0x66, 0xfe, 0x06, 0x63, 0xb6, 0xa5, 0xa6, 0x50, 0x87, 0xfc,
-	0x50, 0x83, 0x73, 0x92, 0x7a, 0x44, 0x87, 0xca, 0xc9, 0x7e, 0x01, 0xe5, 0xf7, 0x60, 0x8c, 0x22,
-	0x03, 0x44, 0xc8, 0xb3, 0x19, 0x40, 0x01, 0xf9, 0x9d, 0x06, 0x17, 0xba, 0x5a, 0x11, 0xc4, 0x54,
-	0x38, 0xab, 0x6a, 0x99, 0xe8, 0xcf, 0xf7, 0xaf, 0x80, 0x90, 0xae, 0x32, 0x48, 0x6b, 0x64, 0x35,
-	0xf3, 0x57, 0x28, 0x13, 0x5b, 0x0d, 0x66, 0x1b, 0x7f, 0x74, 0xc8, 0x27, 0x1a, 0x5c, 0xe8, 0x6a,
-	0x67, 0x28, 0x31, 0xaa, 0x1a, 0x33, 0x4a, 0x8c, 0xca, 0x4e, 0x89, 0x71, 0x9d, 0x61, 0xbc, 0x4c,
-	0xd6, 0xb2, 0x18, 0x45, 0xc3, 0xc5, 0x6c, 0x8b, 0x5f, 0x1d, 0xe2, 0xc0, 0x30, 0xbb, 0x12, 0xc8,
-	0x9a, 0x62, 0x1d, 0xb9, 0x61, 0xa2, 0x5f, 0xea, 0x2d, 0x84, 0x00, 0x74, 0x06, 0x60, 0x9a, 0x90,
-	0x54, 0xde, 0xe6, 0x47, 0xe9, 0x67, 0x1a, 0x9c, 0xcf, 0x74, 0x25, 0xf2, 0x73, 0x60, 0x7e, 0xe3,
-	0x24, 0x3f, 0x07, 0x2a, 0xda, 0x1c, 0xc6, 0x22, 0x03, 0x32, 0x4b, 0x66, 0xe4, 0x6c, 0x13, 0x98,
-	0x6d, 0xd6, 0x6d, 0xe9, 0x90, 0xbf, 0x68, 0x50, 0x54, 0x3d, 0xf4, 0xc9, 0x6d, 0x85, 0xab, 0x27,
-	0xf4, 0x2a, 0xf4, 0x17, 0x4f, 0xad, 0x87, 0x60, 0x2f, 0x31, 0xb0, 0x4b, 0x64, 0x21, 0x06, 0x6b,
-	0x79, 0xd2, 0xd1, 0x63, 0x7d, 0x8f, 0x0e, 0xf9, 0xab, 0x06, 0xd3, 0x79, 0x8f, 0x77, 0xa2, 0xbc,
-	0x5d, 0x15, 0x7d, 0x89, 0xfc, 0xc0, 0xea, 0xd5, 0x17, 0x30, 0x5e, 0x64, 0x08, 0x37, 0x89, 0xd9,
-	0xf5, 0x37, 0x52, 0xce, 0xac, 0x32, 0x7f, 0xff, 0x5d, 0x83, 0x8b, 0xf9, 0x2f, 0x55, 0xb2, 0xd9,
-	0x0f, 0x8a, 0x54, 0x99, 0xad, 0x6f, 0x9d, 0x46, 0x05, 0xa1, 0xbf, 0xc2, 0xa0, 0xbf, 0x40, 0x6e,
-	0xe6, 0x40, 0xe7, 0x37, 0x74, 0x8f, 0x7b, 0xfb, 0x43, 0x18, 0x8f, 0x4d, 0xe7, 0x97, 0x3b, 0x39,
-	0x8f, 0x4e, 0x7d, 0xe3, 0x64, 0x41, 0x04, 0xb7, 0xc4, 0xc0, 0x15, 0xc9, 0xc5, 0x2e, 0x70, 0xfc,
-	0xcc, 0x7c, 0xa2, 0xc1, 0x4c, 0xee, 0x0b, 0x8d, 0x28, 0xf7, 0x50, 0xf5, 0xb6, 0xd4, 0x37, 0x4f,
-	0xa1, 0xa1, 0xba, 0x17, 0xf0, 0x81, 0x97, 0x66, 0x8c, 0x3c, 0x84, 0x21, 0x16, 0x88, 0x46, 0x8f,
-	0x72, 0x40, 0xa0, 0x58, 0xeb, 0x29, 0x83, 0xeb, 0xae, 0xb3, 0x75, 0x57, 0xc9, 0xb2, 0x7c, 0x7a,
-	0xbb, 0x62, 0xac, 0xd6, 0x21, 0x3f, 0xd0, 0x60, 0x04, 0xc3, 0xe9, 0x52, 0xcf, 0x72, 0x4e, 0x2c,
-	0x7f, 0xf9, 0x04, 0x29, 0x55, 0xb2, 0xcf, 0x8f, 0x94, 0x08, 0xc2, 0xa7, 0x18, 0xe1, 0xdd, 0x8f,
-	0x1d, 0x75, 0x84, 0x2b, 0x5f, 0x68, 0xea, 0x08, 0x57, 0xbf, 0xa5, 0x8c, 0x35, 0x06, 0x76, 0x91,
-	0xcc, 0x67, 0xff, 0xaf, 0x81, 0x5c, 0x2f, 0x7f, 0x1f, 0xc6, 0xe2, 0xd8, 0xb9, 0xa2, 0x20, 0x21,
-	0x1b, 0x31, 0xeb, 0x27, 0xca, 0xa9, 0xb2, 0xad, 0x40, 0xc0, 0x28, 0xda, 0xb9, 0xfb, 0xf9, 0xa3,
-	0x25, 0xed, 0x8b, 0x47, 0x4b, 0xda, 0xbf, 0x1f, 0x2d, 0x69, 0x1f, 0x3d, 0x5e, 0x1a, 0xf8, 0xe2,
-	0xf1, 0xd2, 0xc0, 0x3f, 0x1f, 0x2f, 0x0d, 0x7c, 0xf7, 0x46, 0xdd, 0x0e, 0x8f, 0x9a, 0x87, 0xa5,
-	0xaa, 0xdb, 0x30, 0xf7, 0x99, 0xea, 0x73, 0x21, 0xad, 0x1e, 0x09, 0x33, 0x0f, 0xe3, 0x6b, 0xa4,
-	0xe5, 0xd1, 0xe0, 0x70, 0x84, 0xfd, 0xc7, 0x88, 0x9b, 0xff, 0x0f, 0x00, 0x00, 0xff, 0xff, 0x6f,
-	0xf6, 0xbe, 0x27, 0xdc, 0x22, 0x00, 0x00,
+func (m *QueryListExecutionsByItemResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-
-// Reference imports to suppress errors if they are not otherwise used.
-var _ context.Context
-var _ grpc.ClientConn
-
-// This is a compile-time assertion to ensure that this generated file
-// is compatible with the grpc package it is being compiled against.
-const _ = grpc.SupportPackageIsVersion4
-
-// QueryClient is the client API for Query service.
-//
-// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
-type QueryClient interface {
-	// Queries a list of listTradesByCreator items.
-	ListTradesByCreator(ctx context.Context, in *QueryListTradesByCreatorRequest, opts ...grpc.CallOption) (*QueryListTradesByCreatorResponse, error)
-	// Queries a list of Signup by Referee Address items.
-	ListSignUpByReferee(ctx context.Context, in *QueryListSignUpByReferee, opts ...grpc.CallOption) (*QueryListSignUpByRefereeResponse, error)
-	// Queries a list of GetRecipeHistory items.
-	GetRecipeHistory(ctx context.Context, in *QueryGetRecipeHistoryRequest, opts ...grpc.CallOption) (*QueryGetRecipeHistoryResponse, error)
-	// Queries a list of GetStripeRefund items.
-	GetStripeRefund(ctx context.Context, in *QueryGetStripeRefundRequest, opts ...grpc.CallOption) (*QueryGetStripeRefundResponse, error)
-	// Retrieves an item history by id.
-	GetItemOwnershipHistory(ctx context.Context, in *QueryGetItemHistoryRequest, opts ...grpc.CallOption) (*QueryGetItemHistoryResponse, error)
-	// Queries a redeemInfo by index.
-	RedeemInfo(ctx context.Context, in *QueryGetRedeemInfoRequest, opts ...grpc.CallOption) (*QueryGetRedeemInfoResponse, error)
-	// Queries a list of redeemInfo items.
-	RedeemInfoAll(ctx context.Context, in *QueryAllRedeemInfoRequest, opts ...grpc.CallOption) (*QueryAllRedeemInfoResponse, error)
-	// Queries a paymentInfo by index.
-	PaymentInfo(ctx context.Context, in *QueryGetPaymentInfoRequest, opts ...grpc.CallOption) (*QueryGetPaymentInfoResponse, error)
-	// Queries a list of paymentInfo items.
-	PaymentInfoAll(ctx context.Context, in *QueryAllPaymentInfoRequest, opts ...grpc.CallOption) (*QueryAllPaymentInfoResponse, error)
-	// Queries a list of getAccountByAddress items.
-	UsernameByAddress(ctx context.Context, in *QueryGetUsernameByAddressRequest, opts ...grpc.CallOption) (*QueryGetUsernameByAddressResponse, error)
-	// Queries a username by account.
-	AddressByUsername(ctx context.Context, in *QueryGetAddressByUsernameRequest, opts ...grpc.CallOption) (*QueryGetAddressByUsernameResponse, error)
-	// Queries a trade by id.
-	Trade(ctx context.Context, in *QueryGetTradeRequest, opts ...grpc.CallOption) (*QueryGetTradeResponse, error)
-	// Queries a list of listItemByOwner items.
-	ListItemByOwner(ctx context.Context, in *QueryListItemByOwnerRequest, opts ...grpc.CallOption) (*QueryListItemByOwnerResponse, error)
-	// Queries a googleIAPOrder by purchase_token.
-	GoogleInAppPurchaseOrder(ctx context.Context, in *QueryGetGoogleInAppPurchaseOrderRequest, opts ...grpc.CallOption) (*QueryGetGoogleInAppPurchaseOrderResponse, error)
-	// Queries a list of listExecutionsByItem items.
-	ListExecutionsByItem(ctx context.Context, in *QueryListExecutionsByItemRequest, opts ...grpc.CallOption) (*QueryListExecutionsByItemResponse, error)
-	// Queries a list of listExecutionsByRecipe items.
-	ListExecutionsByRecipe(ctx context.Context, in *QueryListExecutionsByRecipeRequest, opts ...grpc.CallOption) (*QueryListExecutionsByRecipeResponse, error)
-	// Queries a execution by id.
-	Execution(ctx context.Context, in *QueryGetExecutionRequest, opts ...grpc.CallOption) (*QueryGetExecutionResponse, error)
-	// Queries a list of listRecipesByCookbook items.
-	ListRecipesByCookbook(ctx context.Context, in *QueryListRecipesByCookbookRequest, opts ...grpc.CallOption) (*QueryListRecipesByCookbookResponse, error)
-	// Queries a item by id.
-	Item(ctx context.Context, in *QueryGetItemRequest, opts ...grpc.CallOption) (*QueryGetItemResponse, error)
-	// Retrieves a recipe by id.
-	Recipe(ctx context.Context, in *QueryGetRecipeRequest, opts ...grpc.CallOption) (*QueryGetRecipeResponse, error)
-	// Retrieves the list of cookbooks owned by an address
-	ListCookbooksByCreator(ctx context.Context, in *QueryListCookbooksByCreatorRequest, opts ...grpc.CallOption) (*QueryListCookbooksByCreatorResponse, error)
-	// Retrieves a cookbook by id.
-	Cookbook(ctx context.Context, in *QueryGetCookbookRequest, opts ...grpc.CallOption) (*QueryGetCookbookResponse, error)
+func (m *QueryListExecutionsByItemResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryListExecutionsByItemResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
 }
-
-type queryClient struct {
-	cc grpc1.ClientConn
+func (m *QueryListExecutionsByItemResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryListExecutionsByItemResponse.Merge(m, src)
 }
-
-func NewQueryClient(cc grpc1.ClientConn) QueryClient {
-	return &queryClient{cc}
+func (m *QueryListExecutionsByItemResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryListExecutionsByItemResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryListExecutionsByItemResponse.DiscardUnknown(m)
 }
 
-func (c *queryClient) ListTradesByCreator(ctx context.Context, in *QueryListTradesByCreatorRequest, opts ...grpc.CallOption) (*QueryListTradesByCreatorResponse, error) {
-	out := new(QueryListTradesByCreatorResponse)
-	err := c.cc.Invoke(ctx, "/pylons.pylons.Query/ListTradesByCreator", in, out, opts...)
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryListExecutionsByItemResponse proto.InternalMessageInfo
+
+func (m *QueryListExecutionsByItemResponse) GetCompletedExecutions() []Execution {
+	if m != nil {
+		return m.CompletedExecutions
 	}
-	return out, nil
+	return nil
 }
 
-func (c *queryClient) ListSignUpByReferee(ctx context.Context, in *QueryListSignUpByReferee, opts ...grpc.CallOption) (*QueryListSignUpByRefereeResponse, error) {
-	out := new(QueryListSignUpByRefereeResponse)
-	err := c.cc.Invoke(ctx, "/pylons.pylons.Query/ListSignUpByReferee", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *QueryListExecutionsByItemResponse) GetPendingExecutions() []Execution {
+	if m != nil {
+		return m.PendingExecutions
 	}
-	return out, nil
+	return nil
 }
 
-func (c *queryClient) GetRecipeHistory(ctx context.Context, in *QueryGetRecipeHistoryRequest, opts ...grpc.CallOption) (*QueryGetRecipeHistoryResponse, error) {
-	out := new(QueryGetRecipeHistoryResponse)
-	err := c.cc.Invoke(ctx, "/pylons.pylons.Query/GetRecipeHistory", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *QueryListExecutionsByItemResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
 	}
-	return out, nil
+	return nil
 }
 
-func (c *queryClient) GetStripeRefund(ctx context.Context, in *QueryGetStripeRefundRequest, opts ...grpc.CallOption) (*QueryGetStripeRefundResponse, error) {
-	out := new(QueryGetStripeRefundResponse)
-	err := c.cc.Invoke(ctx, "/pylons.pylons.Query/GetStripeRefund", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+type QueryListExecutionsByRecipeRequest struct {
+	CookbookId string `protobuf:"bytes,1,opt,name=cookbook_id,json=cookbookId,proto3" json:"cookbook_id,omitempty"`
+	RecipeId   string `protobuf:"bytes,2,opt,name=recipe_id,json=recipeId,proto3" json:"recipe_id,omitempty"`
+	// pagination defines an optional pagination for the request.
+	Pagination *query.PageRequest `protobuf:"bytes,3,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
-func (c *queryClient) GetItemOwnershipHistory(ctx context.Context, in *QueryGetItemHistoryRequest, opts ...grpc.CallOption) (*QueryGetItemHistoryResponse, error) {
-	out := new(QueryGetItemHistoryResponse)
-	err := c.cc.Invoke(ctx, "/pylons.pylons.Query/GetItemOwnershipHistory", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *QueryListExecutionsByRecipeRequest) Reset()         { *m = QueryListExecutionsByRecipeRequest{} }
+func (m *QueryListExecutionsByRecipeRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryListExecutionsByRecipeRequest) ProtoMessage()    {}
+func (*QueryListExecutionsByRecipeRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e873e88214e938bd, []int{46}
+}
+func (m *QueryListExecutionsByRecipeRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryListExecutionsByRecipeRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryListExecutionsByRecipeRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return out, nil
+}
+func (m *QueryListExecutionsByRecipeRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryListExecutionsByRecipeRequest.Merge(m, src)
+}
+func (m *QueryListExecutionsByRecipeRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryListExecutionsByRecipeRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryListExecutionsByRecipeRequest.DiscardUnknown(m)
 }
 
-func (c *queryClient) RedeemInfo(ctx context.Context, in *QueryGetRedeemInfoRequest, opts ...grpc.CallOption) (*QueryGetRedeemInfoResponse, error) {
-	out := new(QueryGetRedeemInfoResponse)
-	err := c.cc.Invoke(ctx, "/pylons.pylons.Query/RedeemInfo", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+var xxx_messageInfo_QueryListExecutionsByRecipeRequest proto.InternalMessageInfo
+
+type QueryListExecutionsByRecipeResponse struct {
+	CompletedExecutions []Execution `protobuf:"bytes,1,rep,name=completed_executions,json=completedExecutions,proto3" json:"completed_executions"`
+	PendingExecutions   []Execution `protobuf:"bytes,2,rep,name=pending_executions,json=pendingExecutions,proto3" json:"pending_executions"`
+	// pagination defines the pagination in the response.
+	Pagination *query.PageResponse `protobuf:"bytes,3,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
-func (c *queryClient) RedeemInfoAll(ctx context.Context, in *QueryAllRedeemInfoRequest, opts ...grpc.CallOption) (*QueryAllRedeemInfoResponse, error) {
-	out := new(QueryAllRedeemInfoResponse)
-	err := c.cc.Invoke(ctx, "/pylons.pylons.Query/RedeemInfoAll", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *QueryListExecutionsByRecipeResponse) Reset()         { *m = QueryListExecutionsByRecipeResponse{} }
+func (m *QueryListExecutionsByRecipeResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryListExecutionsByRecipeResponse) ProtoMessage()    {}
+func (*QueryListExecutionsByRecipeResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e873e88214e938bd, []int{47}
+}
+func (m *QueryListExecutionsByRecipeResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryListExecutionsByRecipeResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryListExecutionsByRecipeResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return out, nil
+}
+func (m *QueryListExecutionsByRecipeResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryListExecutionsByRecipeResponse.Merge(m, src)
+}
+func (m *QueryListExecutionsByRecipeResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryListExecutionsByRecipeResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryListExecutionsByRecipeResponse.DiscardUnknown(m)
 }
 
-func (c *queryClient) PaymentInfo(ctx context.Context, in *QueryGetPaymentInfoRequest, opts ...grpc.CallOption) (*QueryGetPaymentInfoResponse, error) {
-	out := new(QueryGetPaymentInfoResponse)
-	err := c.cc.Invoke(ctx, "/pylons.pylons.Query/PaymentInfo", in, out, opts...)
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryListExecutionsByRecipeResponse proto.InternalMessageInfo
+
+func (m *QueryListExecutionsByRecipeResponse) GetCompletedExecutions() []Execution {
+	if m != nil {
+		return m.CompletedExecutions
 	}
-	return out, nil
+	return nil
 }
 
-func (c *queryClient) PaymentInfoAll(ctx context.Context, in *QueryAllPaymentInfoRequest, opts ...grpc.CallOption) (*QueryAllPaymentInfoResponse, error) {
-	out := new(QueryAllPaymentInfoResponse)
-	err := c.cc.Invoke(ctx, "/pylons.pylons.Query/PaymentInfoAll", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *QueryListExecutionsByRecipeResponse) GetPendingExecutions() []Execution {
+	if m != nil {
+		return m.PendingExecutions
 	}
-	return out, nil
+	return nil
 }
 
-func (c *queryClient) UsernameByAddress(ctx context.Context, in *QueryGetUsernameByAddressRequest, opts ...grpc.CallOption) (*QueryGetUsernameByAddressResponse, error) {
-	out := new(QueryGetUsernameByAddressResponse)
-	err := c.cc.Invoke(ctx, "/pylons.pylons.Query/UsernameByAddress", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *QueryListExecutionsByRecipeResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
 	}
-	return out, nil
+	return nil
 }
 
-func (c *queryClient) AddressByUsername(ctx context.Context, in *QueryGetAddressByUsernameRequest, opts ...grpc.CallOption) (*QueryGetAddressByUsernameResponse, error) {
-	out := new(QueryGetAddressByUsernameResponse)
-	err := c.cc.Invoke(ctx, "/pylons.pylons.Query/AddressByUsername", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+type QueryGetExecutionRequest struct {
+	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
 }
 
-func (c *queryClient) Trade(ctx context.Context, in *QueryGetTradeRequest, opts ...grpc.CallOption) (*QueryGetTradeResponse, error) {
-	out := new(QueryGetTradeResponse)
-	err := c.cc.Invoke(ctx, "/pylons.pylons.Query/Trade", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *QueryGetExecutionRequest) Reset()         { *m = QueryGetExecutionRequest{} }
+func (m *QueryGetExecutionRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryGetExecutionRequest) ProtoMessage()    {}
+func (*QueryGetExecutionRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e873e88214e938bd, []int{48}
+}
+func (m *QueryGetExecutionRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryGetExecutionRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryGetExecutionRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return out, nil
+}
+func (m *QueryGetExecutionRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryGetExecutionRequest.Merge(m, src)
+}
+func (m *QueryGetExecutionRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryGetExecutionRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryGetExecutionRequest.DiscardUnknown(m)
 }
 
-func (c *queryClient) ListItemByOwner(ctx context.Context, in *QueryListItemByOwnerRequest, opts ...grpc.CallOption) (*QueryListItemByOwnerResponse, error) {
-	out := new(QueryListItemByOwnerResponse)
-	err := c.cc.Invoke(ctx, "/pylons.pylons.Query/ListItemByOwner", in, out, opts...)
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryGetExecutionRequest proto.InternalMessageInfo
+
+func (m *QueryGetExecutionRequest) GetId() string {
+	if m != nil {
+		return m.Id
 	}
-	return out, nil
+	return ""
 }
 
-func (c *queryClient) GoogleInAppPurchaseOrder(ctx context.Context, in *QueryGetGoogleInAppPurchaseOrderRequest, opts ...grpc.CallOption) (*QueryGetGoogleInAppPurchaseOrderResponse, error) {
-	out := new(QueryGetGoogleInAppPurchaseOrderResponse)
-	err := c.cc.Invoke(ctx, "/pylons.pylons.Query/GoogleInAppPurchaseOrder", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+type QueryGetExecutionResponse struct {
+	Execution Execution `protobuf:"bytes,1,opt,name=execution,proto3" json:"execution"`
+	Completed bool      `protobuf:"varint,2,opt,name=completed,proto3" json:"completed,omitempty"`
 }
 
-func (c *queryClient) ListExecutionsByItem(ctx context.Context, in *QueryListExecutionsByItemRequest, opts ...grpc.CallOption) (*QueryListExecutionsByItemResponse, error) {
-	out := new(QueryListExecutionsByItemResponse)
-	err := c.cc.Invoke(ctx, "/pylons.pylons.Query/ListExecutionsByItem", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *QueryGetExecutionResponse) Reset()         { *m = QueryGetExecutionResponse{} }
+func (m *QueryGetExecutionResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryGetExecutionResponse) ProtoMessage()    {}
+func (*QueryGetExecutionResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e873e88214e938bd, []int{49}
+}
+func (m *QueryGetExecutionResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryGetExecutionResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryGetExecutionResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return out, nil
+}
+func (m *QueryGetExecutionResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryGetExecutionResponse.Merge(m, src)
+}
+func (m *QueryGetExecutionResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryGetExecutionResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryGetExecutionResponse.DiscardUnknown(m)
 }
 
-func (c *queryClient) ListExecutionsByRecipe(ctx context.Context, in *QueryListExecutionsByRecipeRequest, opts ...grpc.CallOption) (*QueryListExecutionsByRecipeResponse, error) {
-	out := new(QueryListExecutionsByRecipeResponse)
-	err := c.cc.Invoke(ctx, "/pylons.pylons.Query/ListExecutionsByRecipe", in, out, opts...)
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryGetExecutionResponse proto.InternalMessageInfo
+
+func (m *QueryGetExecutionResponse) GetExecution() Execution {
+	if m != nil {
+		return m.Execution
 	}
-	return out, nil
+	return Execution{}
 }
 
-func (c *queryClient) Execution(ctx context.Context, in *QueryGetExecutionRequest, opts ...grpc.CallOption) (*QueryGetExecutionResponse, error) {
-	out := new(QueryGetExecutionResponse)
-	err := c.cc.Invoke(ctx, "/pylons.pylons.Query/Execution", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *QueryGetExecutionResponse) GetCompleted() bool {
+	if m != nil {
+		return m.Completed
 	}
-	return out, nil
+	return false
 }
 
-func (c *queryClient) ListRecipesByCookbook(ctx context.Context, in *QueryListRecipesByCookbookRequest, opts ...grpc.CallOption) (*QueryListRecipesByCookbookResponse, error) {
-	out := new(QueryListRecipesByCookbookResponse)
-	err := c.cc.Invoke(ctx, "/pylons.pylons.Query/ListRecipesByCookbook", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+type QueryListRecipesByCookbookRequest struct {
+	CookbookId string `protobuf:"bytes,1,opt,name=cookbook_id,json=cookbookId,proto3" json:"cookbook_id,omitempty"`
+	// pagination defines an optional pagination for the request.
+	Pagination *query.PageRequest `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
-func (c *queryClient) Item(ctx context.Context, in *QueryGetItemRequest, opts ...grpc.CallOption) (*QueryGetItemResponse, error) {
-	out := new(QueryGetItemResponse)
-	err := c.cc.Invoke(ctx, "/pylons.pylons.Query/Item", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+func (m *QueryListRecipesByCookbookRequest) Reset()         { *m = QueryListRecipesByCookbookRequest{} }
+func (m *QueryListRecipesByCookbookRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryListRecipesByCookbookRequest) ProtoMessage()    {}
+func (*QueryListRecipesByCookbookRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e873e88214e938bd, []int{50}
 }
-
-func (c *queryClient) Recipe(ctx context.Context, in *QueryGetRecipeRequest, opts ...grpc.CallOption) (*QueryGetRecipeResponse, error) {
-	out := new(QueryGetRecipeResponse)
-	err := c.cc.Invoke(ctx, "/pylons.pylons.Query/Recipe", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+func (m *QueryListRecipesByCookbookRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-
-func (c *queryClient) ListCookbooksByCreator(ctx context.Context, in *QueryListCookbooksByCreatorRequest, opts ...grpc.CallOption) (*QueryListCookbooksByCreatorResponse, error) {
-	out := new(QueryListCookbooksByCreatorResponse)
-	err := c.cc.Invoke(ctx, "/pylons.pylons.Query/ListCookbooksByCreator", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *QueryListRecipesByCookbookRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryListRecipesByCookbookRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return out, nil
+}
+func (m *QueryListRecipesByCookbookRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryListRecipesByCookbookRequest.Merge(m, src)
+}
+func (m *QueryListRecipesByCookbookRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryListRecipesByCookbookRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryListRecipesByCookbookRequest.DiscardUnknown(m)
 }
 
-func (c *queryClient) Cookbook(ctx context.Context, in *QueryGetCookbookRequest, opts ...grpc.CallOption) (*QueryGetCookbookResponse, error) {
-	out := new(QueryGetCookbookResponse)
-	err := c.cc.Invoke(ctx, "/pylons.pylons.Query/Cookbook", in, out, opts...)
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryListRecipesByCookbookRequest proto.InternalMessageInfo
+
+func (m *QueryListRecipesByCookbookRequest) GetCookbookId() string {
+	if m != nil {
+		return m.CookbookId
 	}
-	return out, nil
+	return ""
 }
 
-// QueryServer is the server API for Query service.
-type QueryServer interface {
-	// Queries a list of listTradesByCreator items.
-	ListTradesByCreator(context.Context, *QueryListTradesByCreatorRequest) (*QueryListTradesByCreatorResponse, error)
-	// Queries a list of Signup by Referee Address items.
-	ListSignUpByReferee(context.Context, *QueryListSignUpByReferee) (*QueryListSignUpByRefereeResponse, error)
-	// Queries a list of GetRecipeHistory items.
-	GetRecipeHistory(context.Context, *QueryGetRecipeHistoryRequest) (*QueryGetRecipeHistoryResponse, error)
-	// Queries a list of GetStripeRefund items.
-	GetStripeRefund(context.Context, *QueryGetStripeRefundRequest) (*QueryGetStripeRefundResponse, error)
-	// Retrieves an item history by id.
-	GetItemOwnershipHistory(context.Context, *QueryGetItemHistoryRequest) (*QueryGetItemHistoryResponse, error)
-	// Queries a redeemInfo by index.
-	RedeemInfo(context.Context, *QueryGetRedeemInfoRequest) (*QueryGetRedeemInfoResponse, error)
-	// Queries a list of redeemInfo items.
-	RedeemInfoAll(context.Context, *QueryAllRedeemInfoRequest) (*QueryAllRedeemInfoResponse, error)
-	// Queries a paymentInfo by index.
-	PaymentInfo(context.Context, *QueryGetPaymentInfoRequest) (*QueryGetPaymentInfoResponse, error)
-	// Queries a list of paymentInfo items.
-	PaymentInfoAll(context.Context, *QueryAllPaymentInfoRequest) (*QueryAllPaymentInfoResponse, error)
-	// Queries a list of getAccountByAddress items.
-	UsernameByAddress(context.Context, *QueryGetUsernameByAddressRequest) (*QueryGetUsernameByAddressResponse, error)
-	// Queries a username by account.
-	AddressByUsername(context.Context, *QueryGetAddressByUsernameRequest) (*QueryGetAddressByUsernameResponse, error)
-	// Queries a trade by id.
-	Trade(context.Context, *QueryGetTradeRequest) (*QueryGetTradeResponse, error)
-	// Queries a list of listItemByOwner items.
-	ListItemByOwner(context.Context, *QueryListItemByOwnerRequest) (*QueryListItemByOwnerResponse, error)
-	// Queries a googleIAPOrder by purchase_token.
-	GoogleInAppPurchaseOrder(context.Context, *QueryGetGoogleInAppPurchaseOrderRequest) (*QueryGetGoogleInAppPurchaseOrderResponse, error)
-	// Queries a list of listExecutionsByItem items.
-	ListExecutionsByItem(context.Context, *QueryListExecutionsByItemRequest) (*QueryListExecutionsByItemResponse, error)
-	// Queries a list of listExecutionsByRecipe items.
-	ListExecutionsByRecipe(context.Context, *QueryListExecutionsByRecipeRequest) (*QueryListExecutionsByRecipeResponse, error)
-	// Queries a execution by id.
-	Execution(context.Context, *QueryGetExecutionRequest) (*QueryGetExecutionResponse, error)
-	// Queries a list of listRecipesByCookbook items.
-	ListRecipesByCookbook(context.Context, *QueryListRecipesByCookbookRequest) (*QueryListRecipesByCookbookResponse, error)
-	// Queries a item by id.
-	Item(context.Context, *QueryGetItemRequest) (*QueryGetItemResponse, error)
-	// Retrieves a recipe by id.
-	Recipe(context.Context, *QueryGetRecipeRequest) (*QueryGetRecipeResponse, error)
-	// Retrieves the list of cookbooks owned by an address
-	ListCookbooksByCreator(context.Context, *QueryListCookbooksByCreatorRequest) (*QueryListCookbooksByCreatorResponse, error)
-	// Retrieves a cookbook by id.
-	Cookbook(context.Context, *QueryGetCookbookRequest) (*QueryGetCookbookResponse, error)
+func (m *QueryListRecipesByCookbookRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
 }
 
-// UnimplementedQueryServer can be embedded to have forward compatible implementations.
-type UnimplementedQueryServer struct {
+type QueryListRecipesByCookbookResponse struct {
+	Recipes []Recipe `protobuf:"bytes,1,rep,name=recipes,proto3" json:"recipes"`
+	// pagination defines the pagination in the response.
+	Pagination *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
-func (*UnimplementedQueryServer) ListTradesByCreator(ctx context.Context, req *QueryListTradesByCreatorRequest) (*QueryListTradesByCreatorResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method ListTradesByCreator not implemented")
-}
-func (*UnimplementedQueryServer) ListSignUpByReferee(ctx context.Context, req *QueryListSignUpByReferee) (*QueryListSignUpByRefereeResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method ListSignUpByReferee not implemented")
-}
-func (*UnimplementedQueryServer) GetRecipeHistory(ctx context.Context, req *QueryGetRecipeHistoryRequest) (*QueryGetRecipeHistoryResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetRecipeHistory not implemented")
-}
-func (*UnimplementedQueryServer) GetStripeRefund(ctx context.Context, req *QueryGetStripeRefundRequest) (*QueryGetStripeRefundResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetStripeRefund not implemented")
-}
-func (*UnimplementedQueryServer) GetItemOwnershipHistory(ctx context.Context, req *QueryGetItemHistoryRequest) (*QueryGetItemHistoryResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetItemOwnershipHistory not implemented")
-}
-func (*UnimplementedQueryServer) RedeemInfo(ctx context.Context, req *QueryGetRedeemInfoRequest) (*QueryGetRedeemInfoResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method RedeemInfo not implemented")
+func (m *QueryListRecipesByCookbookResponse) Reset()         { *m = QueryListRecipesByCookbookResponse{} }
+func (m *QueryListRecipesByCookbookResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryListRecipesByCookbookResponse) ProtoMessage()    {}
+func (*QueryListRecipesByCookbookResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e873e88214e938bd, []int{51}
 }
-func (*UnimplementedQueryServer) RedeemInfoAll(ctx context.Context, req *QueryAllRedeemInfoRequest) (*QueryAllRedeemInfoResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method RedeemInfoAll not implemented")
+func (m *QueryListRecipesByCookbookResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-func (*UnimplementedQueryServer) PaymentInfo(ctx context.Context, req *QueryGetPaymentInfoRequest) (*QueryGetPaymentInfoResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method PaymentInfo not implemented")
+func (m *QueryListRecipesByCookbookResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryListRecipesByCookbookResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
 }
-func (*UnimplementedQueryServer) PaymentInfoAll(ctx context.Context, req *QueryAllPaymentInfoRequest) (*QueryAllPaymentInfoResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method PaymentInfoAll not implemented")
+func (m *QueryListRecipesByCookbookResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryListRecipesByCookbookResponse.Merge(m, src)
 }
-func (*UnimplementedQueryServer) UsernameByAddress(ctx context.Context, req *QueryGetUsernameByAddressRequest) (*QueryGetUsernameByAddressResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method UsernameByAddress not implemented")
+func (m *QueryListRecipesByCookbookResponse) XXX_Size() int {
+	return m.Size()
 }
-func (*UnimplementedQueryServer) AddressByUsername(ctx context.Context, req *QueryGetAddressByUsernameRequest) (*QueryGetAddressByUsernameResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method AddressByUsername not implemented")
+func (m *QueryListRecipesByCookbookResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryListRecipesByCookbookResponse.DiscardUnknown(m)
 }
-func (*UnimplementedQueryServer) Trade(ctx context.Context, req *QueryGetTradeRequest) (*QueryGetTradeResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method Trade not implemented")
+
+var xxx_messageInfo_QueryListRecipesByCookbookResponse proto.InternalMessageInfo
+
+func (m *QueryListRecipesByCookbookResponse) GetRecipes() []Recipe {
+	if m != nil {
+		return m.Recipes
+	}
+	return nil
 }
-func (*UnimplementedQueryServer) ListItemByOwner(ctx context.Context, req *QueryListItemByOwnerRequest) (*QueryListItemByOwnerResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method ListItemByOwner not implemented")
+
+func (m *QueryListRecipesByCookbookResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
 }
-func (*UnimplementedQueryServer) GoogleInAppPurchaseOrder(ctx context.Context, req *QueryGetGoogleInAppPurchaseOrderRequest) (*QueryGetGoogleInAppPurchaseOrderResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GoogleInAppPurchaseOrder not implemented")
+
+type QueryGetItemRequest struct {
+	CookbookId string `protobuf:"bytes,1,opt,name=cookbook_id,json=cookbookId,proto3" json:"cookbook_id,omitempty"`
+	Id         string `protobuf:"bytes,3,opt,name=id,proto3" json:"id,omitempty"`
 }
-func (*UnimplementedQueryServer) ListExecutionsByItem(ctx context.Context, req *QueryListExecutionsByItemRequest) (*QueryListExecutionsByItemResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method ListExecutionsByItem not implemented")
+
+func (m *QueryGetItemRequest) Reset()         { *m = QueryGetItemRequest{} }
+func (m *QueryGetItemRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryGetItemRequest) ProtoMessage()    {}
+func (*QueryGetItemRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e873e88214e938bd, []int{52}
 }
-func (*UnimplementedQueryServer) ListExecutionsByRecipe(ctx context.Context, req *QueryListExecutionsByRecipeRequest) (*QueryListExecutionsByRecipeResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method ListExecutionsByRecipe not implemented")
+func (m *QueryGetItemRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-func (*UnimplementedQueryServer) Execution(ctx context.Context, req *QueryGetExecutionRequest) (*QueryGetExecutionResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method Execution not implemented")
+func (m *QueryGetItemRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryGetItemRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
 }
-func (*UnimplementedQueryServer) ListRecipesByCookbook(ctx context.Context, req *QueryListRecipesByCookbookRequest) (*QueryListRecipesByCookbookResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method ListRecipesByCookbook not implemented")
+func (m *QueryGetItemRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryGetItemRequest.Merge(m, src)
 }
-func (*UnimplementedQueryServer) Item(ctx context.Context, req *QueryGetItemRequest) (*QueryGetItemResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method Item not implemented")
+func (m *QueryGetItemRequest) XXX_Size() int {
+	return m.Size()
 }
-func (*UnimplementedQueryServer) Recipe(ctx context.Context, req *QueryGetRecipeRequest) (*QueryGetRecipeResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method Recipe not implemented")
+func (m *QueryGetItemRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryGetItemRequest.DiscardUnknown(m)
 }
-func (*UnimplementedQueryServer) ListCookbooksByCreator(ctx context.Context, req *QueryListCookbooksByCreatorRequest) (*QueryListCookbooksByCreatorResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method ListCookbooksByCreator not implemented")
+
+var xxx_messageInfo_QueryGetItemRequest proto.InternalMessageInfo
+
+func (m *QueryGetItemRequest) GetCookbookId() string {
+	if m != nil {
+		return m.CookbookId
+	}
+	return ""
 }
-func (*UnimplementedQueryServer) Cookbook(ctx context.Context, req *QueryGetCookbookRequest) (*QueryGetCookbookResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method Cookbook not implemented")
+
+func (m *QueryGetItemRequest) GetId() string {
+	if m != nil {
+		return m.Id
+	}
+	return ""
 }
 
-func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
-	s.RegisterService(&_Query_serviceDesc, srv)
+type QueryGetItemResponse struct {
+	Item Item `protobuf:"bytes,1,opt,name=item,proto3" json:"item"`
+	// items held by the item when it is a container
+	Contents []Item `protobuf:"bytes,2,rep,name=contents,proto3" json:"contents"`
 }
 
-func _Query_ListTradesByCreator_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryListTradesByCreatorRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).ListTradesByCreator(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/pylons.pylons.Query/ListTradesByCreator",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).ListTradesByCreator(ctx, req.(*QueryListTradesByCreatorRequest))
+func (m *QueryGetItemResponse) Reset()         { *m = QueryGetItemResponse{} }
+func (m *QueryGetItemResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryGetItemResponse) ProtoMessage()    {}
+func (*QueryGetItemResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e873e88214e938bd, []int{53}
+}
+func (m *QueryGetItemResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryGetItemResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryGetItemResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return interceptor(ctx, in, info, handler)
+}
+func (m *QueryGetItemResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryGetItemResponse.Merge(m, src)
+}
+func (m *QueryGetItemResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryGetItemResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryGetItemResponse.DiscardUnknown(m)
 }
 
-func _Query_ListSignUpByReferee_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryListSignUpByReferee)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).ListSignUpByReferee(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/pylons.pylons.Query/ListSignUpByReferee",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).ListSignUpByReferee(ctx, req.(*QueryListSignUpByReferee))
+var xxx_messageInfo_QueryGetItemResponse proto.InternalMessageInfo
+
+func (m *QueryGetItemResponse) GetItem() Item {
+	if m != nil {
+		return m.Item
 	}
-	return interceptor(ctx, in, info, handler)
+	return Item{}
 }
 
-func _Query_GetRecipeHistory_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryGetRecipeHistoryRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).GetRecipeHistory(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/pylons.pylons.Query/GetRecipeHistory",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).GetRecipeHistory(ctx, req.(*QueryGetRecipeHistoryRequest))
+func (m *QueryGetItemResponse) GetContents() []Item {
+	if m != nil {
+		return m.Contents
 	}
-	return interceptor(ctx, in, info, handler)
+	return nil
 }
 
-func _Query_GetStripeRefund_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryGetStripeRefundRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).GetStripeRefund(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/pylons.pylons.Query/GetStripeRefund",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).GetStripeRefund(ctx, req.(*QueryGetStripeRefundRequest))
-	}
-	return interceptor(ctx, in, info, handler)
+type QueryGetRecipeRequest struct {
+	CookbookId string `protobuf:"bytes,1,opt,name=cookbook_id,json=cookbookId,proto3" json:"cookbook_id,omitempty"`
+	Id         string `protobuf:"bytes,2,opt,name=id,proto3" json:"id,omitempty"`
 }
 
-func _Query_GetItemOwnershipHistory_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryGetItemHistoryRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).GetItemOwnershipHistory(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/pylons.pylons.Query/GetItemOwnershipHistory",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).GetItemOwnershipHistory(ctx, req.(*QueryGetItemHistoryRequest))
+func (m *QueryGetRecipeRequest) Reset()         { *m = QueryGetRecipeRequest{} }
+func (m *QueryGetRecipeRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryGetRecipeRequest) ProtoMessage()    {}
+func (*QueryGetRecipeRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e873e88214e938bd, []int{54}
+}
+func (m *QueryGetRecipeRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryGetRecipeRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryGetRecipeRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return interceptor(ctx, in, info, handler)
+}
+func (m *QueryGetRecipeRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryGetRecipeRequest.Merge(m, src)
+}
+func (m *QueryGetRecipeRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryGetRecipeRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryGetRecipeRequest.DiscardUnknown(m)
 }
 
-func _Query_RedeemInfo_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryGetRedeemInfoRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).RedeemInfo(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/pylons.pylons.Query/RedeemInfo",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).RedeemInfo(ctx, req.(*QueryGetRedeemInfoRequest))
+var xxx_messageInfo_QueryGetRecipeRequest proto.InternalMessageInfo
+
+func (m *QueryGetRecipeRequest) GetCookbookId() string {
+	if m != nil {
+		return m.CookbookId
 	}
-	return interceptor(ctx, in, info, handler)
+	return ""
 }
 
-func _Query_RedeemInfoAll_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryAllRedeemInfoRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).RedeemInfoAll(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/pylons.pylons.Query/RedeemInfoAll",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).RedeemInfoAll(ctx, req.(*QueryAllRedeemInfoRequest))
+func (m *QueryGetRecipeRequest) GetId() string {
+	if m != nil {
+		return m.Id
 	}
-	return interceptor(ctx, in, info, handler)
+	return ""
 }
 
-func _Query_PaymentInfo_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryGetPaymentInfoRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).PaymentInfo(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/pylons.pylons.Query/PaymentInfo",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).PaymentInfo(ctx, req.(*QueryGetPaymentInfoRequest))
-	}
-	return interceptor(ctx, in, info, handler)
+type QueryGetRecipeResponse struct {
+	Recipe Recipe `protobuf:"bytes,1,opt,name=recipe,proto3" json:"recipe"`
 }
 
-func _Query_PaymentInfoAll_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryAllPaymentInfoRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).PaymentInfoAll(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/pylons.pylons.Query/PaymentInfoAll",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).PaymentInfoAll(ctx, req.(*QueryAllPaymentInfoRequest))
+func (m *QueryGetRecipeResponse) Reset()         { *m = QueryGetRecipeResponse{} }
+func (m *QueryGetRecipeResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryGetRecipeResponse) ProtoMessage()    {}
+func (*QueryGetRecipeResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e873e88214e938bd, []int{55}
+}
+func (m *QueryGetRecipeResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryGetRecipeResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryGetRecipeResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return interceptor(ctx, in, info, handler)
+}
+func (m *QueryGetRecipeResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryGetRecipeResponse.Merge(m, src)
+}
+func (m *QueryGetRecipeResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryGetRecipeResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryGetRecipeResponse.DiscardUnknown(m)
 }
 
-func _Query_UsernameByAddress_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryGetUsernameByAddressRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).UsernameByAddress(ctx, in)
+var xxx_messageInfo_QueryGetRecipeResponse proto.InternalMessageInfo
+
+func (m *QueryGetRecipeResponse) GetRecipe() Recipe {
+	if m != nil {
+		return m.Recipe
 	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/pylons.pylons.Query/UsernameByAddress",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).UsernameByAddress(ctx, req.(*QueryGetUsernameByAddressRequest))
-	}
-	return interceptor(ctx, in, info, handler)
+	return Recipe{}
 }
 
-func _Query_AddressByUsername_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryGetAddressByUsernameRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).AddressByUsername(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/pylons.pylons.Query/AddressByUsername",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).AddressByUsername(ctx, req.(*QueryGetAddressByUsernameRequest))
-	}
-	return interceptor(ctx, in, info, handler)
+type QueryListCookbooksByCreatorRequest struct {
+	Creator string `protobuf:"bytes,1,opt,name=creator,proto3" json:"creator,omitempty"`
+	// pagination defines an optional pagination for the request.
+	Pagination *query.PageRequest `protobuf:"bytes,3,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
-func _Query_Trade_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryGetTradeRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).Trade(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/pylons.pylons.Query/Trade",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).Trade(ctx, req.(*QueryGetTradeRequest))
-	}
-	return interceptor(ctx, in, info, handler)
+func (m *QueryListCookbooksByCreatorRequest) Reset()         { *m = QueryListCookbooksByCreatorRequest{} }
+func (m *QueryListCookbooksByCreatorRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryListCookbooksByCreatorRequest) ProtoMessage()    {}
+func (*QueryListCookbooksByCreatorRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e873e88214e938bd, []int{56}
 }
-
-func _Query_ListItemByOwner_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryListItemByOwnerRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).ListItemByOwner(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/pylons.pylons.Query/ListItemByOwner",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).ListItemByOwner(ctx, req.(*QueryListItemByOwnerRequest))
+func (m *QueryListCookbooksByCreatorRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryListCookbooksByCreatorRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryListCookbooksByCreatorRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return interceptor(ctx, in, info, handler)
+}
+func (m *QueryListCookbooksByCreatorRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryListCookbooksByCreatorRequest.Merge(m, src)
+}
+func (m *QueryListCookbooksByCreatorRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryListCookbooksByCreatorRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryListCookbooksByCreatorRequest.DiscardUnknown(m)
 }
 
-func _Query_GoogleInAppPurchaseOrder_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryGetGoogleInAppPurchaseOrderRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).GoogleInAppPurchaseOrder(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/pylons.pylons.Query/GoogleInAppPurchaseOrder",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).GoogleInAppPurchaseOrder(ctx, req.(*QueryGetGoogleInAppPurchaseOrderRequest))
+var xxx_messageInfo_QueryListCookbooksByCreatorRequest proto.InternalMessageInfo
+
+func (m *QueryListCookbooksByCreatorRequest) GetCreator() string {
+	if m != nil {
+		return m.Creator
 	}
-	return interceptor(ctx, in, info, handler)
+	return ""
 }
 
-func _Query_ListExecutionsByItem_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryListExecutionsByItemRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).ListExecutionsByItem(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/pylons.pylons.Query/ListExecutionsByItem",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).ListExecutionsByItem(ctx, req.(*QueryListExecutionsByItemRequest))
+func (m *QueryListCookbooksByCreatorRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
 	}
-	return interceptor(ctx, in, info, handler)
+	return nil
 }
 
-func _Query_ListExecutionsByRecipe_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryListExecutionsByRecipeRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).ListExecutionsByRecipe(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/pylons.pylons.Query/ListExecutionsByRecipe",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).ListExecutionsByRecipe(ctx, req.(*QueryListExecutionsByRecipeRequest))
-	}
-	return interceptor(ctx, in, info, handler)
+type QueryListCookbooksByCreatorResponse struct {
+	Cookbooks []Cookbook `protobuf:"bytes,1,rep,name=cookbooks,proto3" json:"cookbooks"`
+	// pagination defines the pagination in the response.
+	Pagination *query.PageResponse `protobuf:"bytes,3,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
-func _Query_Execution_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryGetExecutionRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).Execution(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/pylons.pylons.Query/Execution",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).Execution(ctx, req.(*QueryGetExecutionRequest))
+func (m *QueryListCookbooksByCreatorResponse) Reset()         { *m = QueryListCookbooksByCreatorResponse{} }
+func (m *QueryListCookbooksByCreatorResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryListCookbooksByCreatorResponse) ProtoMessage()    {}
+func (*QueryListCookbooksByCreatorResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e873e88214e938bd, []int{57}
+}
+func (m *QueryListCookbooksByCreatorResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryListCookbooksByCreatorResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryListCookbooksByCreatorResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return interceptor(ctx, in, info, handler)
+}
+func (m *QueryListCookbooksByCreatorResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryListCookbooksByCreatorResponse.Merge(m, src)
+}
+func (m *QueryListCookbooksByCreatorResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryListCookbooksByCreatorResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryListCookbooksByCreatorResponse.DiscardUnknown(m)
 }
 
-func _Query_ListRecipesByCookbook_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryListRecipesByCookbookRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).ListRecipesByCookbook(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/pylons.pylons.Query/ListRecipesByCookbook",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).ListRecipesByCookbook(ctx, req.(*QueryListRecipesByCookbookRequest))
+var xxx_messageInfo_QueryListCookbooksByCreatorResponse proto.InternalMessageInfo
+
+func (m *QueryListCookbooksByCreatorResponse) GetCookbooks() []Cookbook {
+	if m != nil {
+		return m.Cookbooks
 	}
-	return interceptor(ctx, in, info, handler)
+	return nil
 }
 
-func _Query_Item_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryGetItemRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).Item(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/pylons.pylons.Query/Item",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).Item(ctx, req.(*QueryGetItemRequest))
+func (m *QueryListCookbooksByCreatorResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
 	}
-	return interceptor(ctx, in, info, handler)
+	return nil
 }
 
-func _Query_Recipe_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryGetRecipeRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).Recipe(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/pylons.pylons.Query/Recipe",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).Recipe(ctx, req.(*QueryGetRecipeRequest))
-	}
-	return interceptor(ctx, in, info, handler)
+type QueryGetCookbookRequest struct {
+	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
 }
 
-func _Query_ListCookbooksByCreator_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryListCookbooksByCreatorRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).ListCookbooksByCreator(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/pylons.pylons.Query/ListCookbooksByCreator",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).ListCookbooksByCreator(ctx, req.(*QueryListCookbooksByCreatorRequest))
-	}
-	return interceptor(ctx, in, info, handler)
+func (m *QueryGetCookbookRequest) Reset()         { *m = QueryGetCookbookRequest{} }
+func (m *QueryGetCookbookRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryGetCookbookRequest) ProtoMessage()    {}
+func (*QueryGetCookbookRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e873e88214e938bd, []int{58}
 }
-
-func _Query_Cookbook_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryGetCookbookRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).Cookbook(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/pylons.pylons.Query/Cookbook",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).Cookbook(ctx, req.(*QueryGetCookbookRequest))
+func (m *QueryGetCookbookRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryGetCookbookRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryGetCookbookRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return interceptor(ctx, in, info, handler)
 }
-
-var _Query_serviceDesc = grpc.ServiceDesc{
-	ServiceName: "pylons.pylons.Query",
-	HandlerType: (*QueryServer)(nil),
-	Methods: []grpc.MethodDesc{
-		{
-			MethodName: "ListTradesByCreator",
-			Handler:    _Query_ListTradesByCreator_Handler,
-		},
-		{
-			MethodName: "ListSignUpByReferee",
-			Handler:    _Query_ListSignUpByReferee_Handler,
-		},
-		{
-			MethodName: "GetRecipeHistory",
-			Handler:    _Query_GetRecipeHistory_Handler,
-		},
-		{
-			MethodName: "GetStripeRefund",
-			Handler:    _Query_GetStripeRefund_Handler,
-		},
-		{
-			MethodName: "GetItemOwnershipHistory",
-			Handler:    _Query_GetItemOwnershipHistory_Handler,
-		},
-		{
-			MethodName: "RedeemInfo",
-			Handler:    _Query_RedeemInfo_Handler,
-		},
-		{
-			MethodName: "RedeemInfoAll",
-			Handler:    _Query_RedeemInfoAll_Handler,
-		},
-		{
-			MethodName: "PaymentInfo",
-			Handler:    _Query_PaymentInfo_Handler,
-		},
-		{
-			MethodName: "PaymentInfoAll",
-			Handler:    _Query_PaymentInfoAll_Handler,
-		},
-		{
-			MethodName: "UsernameByAddress",
-			Handler:    _Query_UsernameByAddress_Handler,
-		},
-		{
-			MethodName: "AddressByUsername",
-			Handler:    _Query_AddressByUsername_Handler,
-		},
-		{
-			MethodName: "Trade",
-			Handler:    _Query_Trade_Handler,
-		},
-		{
-			MethodName: "ListItemByOwner",
-			Handler:    _Query_ListItemByOwner_Handler,
-		},
-		{
-			MethodName: "GoogleInAppPurchaseOrder",
-			Handler:    _Query_GoogleInAppPurchaseOrder_Handler,
-		},
-		{
-			MethodName: "ListExecutionsByItem",
-			Handler:    _Query_ListExecutionsByItem_Handler,
-		},
-		{
-			MethodName: "ListExecutionsByRecipe",
-			Handler:    _Query_ListExecutionsByRecipe_Handler,
-		},
-		{
-			MethodName: "Execution",
-			Handler:    _Query_Execution_Handler,
-		},
-		{
-			MethodName: "ListRecipesByCookbook",
-			Handler:    _Query_ListRecipesByCookbook_Handler,
-		},
-		{
-			MethodName: "Item",
-			Handler:    _Query_Item_Handler,
-		},
-		{
-			MethodName: "Recipe",
-			Handler:    _Query_Recipe_Handler,
-		},
-		{
-			MethodName: "ListCookbooksByCreator",
-			Handler:    _Query_ListCookbooksByCreator_Handler,
-		},
-		{
-			MethodName: "Cookbook",
-			Handler:    _Query_Cookbook_Handler,
-		},
-	},
-	Streams:  []grpc.StreamDesc{},
-	Metadata: "pylons/pylons/query.proto",
+func (m *QueryGetCookbookRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryGetCookbookRequest.Merge(m, src)
+}
+func (m *QueryGetCookbookRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryGetCookbookRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryGetCookbookRequest.DiscardUnknown(m)
 }
 
-func (m *QueryListSignUpByReferee) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryGetCookbookRequest proto.InternalMessageInfo
+
+func (m *QueryGetCookbookRequest) GetId() string {
+	if m != nil {
+		return m.Id
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *QueryListSignUpByReferee) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+type QueryGetCookbookResponse struct {
+	Cookbook Cookbook `protobuf:"bytes,1,opt,name=cookbook,proto3" json:"cookbook"`
 }
 
-func (m *QueryListSignUpByReferee) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Creator) > 0 {
-		i -= len(m.Creator)
-		copy(dAtA[i:], m.Creator)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.Creator)))
-		i--
-		dAtA[i] = 0xa
+func (m *QueryGetCookbookResponse) Reset()         { *m = QueryGetCookbookResponse{} }
+func (m *QueryGetCookbookResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryGetCookbookResponse) ProtoMessage()    {}
+func (*QueryGetCookbookResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e873e88214e938bd, []int{59}
+}
+func (m *QueryGetCookbookResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryGetCookbookResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryGetCookbookResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *QueryGetCookbookResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryGetCookbookResponse.Merge(m, src)
+}
+func (m *QueryGetCookbookResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryGetCookbookResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryGetCookbookResponse.DiscardUnknown(m)
 }
 
-func (m *QueryListSignUpByRefereeResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryGetCookbookResponse proto.InternalMessageInfo
+
+func (m *QueryGetCookbookResponse) GetCookbook() Cookbook {
+	if m != nil {
+		return m.Cookbook
 	}
-	return dAtA[:n], nil
+	return Cookbook{}
 }
 
-func (m *QueryListSignUpByRefereeResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+type QueryRecipeStatsRequest struct {
+	CookbookId string `protobuf:"bytes,1,opt,name=cookbook_id,json=cookbookId,proto3" json:"cookbook_id,omitempty"`
+	RecipeId   string `protobuf:"bytes,2,opt,name=recipe_id,json=recipeId,proto3" json:"recipe_id,omitempty"`
 }
 
-func (m *QueryListSignUpByRefereeResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Signup != nil {
-		{
-			size, err := m.Signup.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
+func (m *QueryRecipeStatsRequest) Reset()         { *m = QueryRecipeStatsRequest{} }
+func (m *QueryRecipeStatsRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryRecipeStatsRequest) ProtoMessage()    {}
+func (*QueryRecipeStatsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e873e88214e938bd, []int{60}
+}
+func (m *QueryRecipeStatsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryRecipeStatsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryRecipeStatsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i--
-		dAtA[i] = 0xa
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
 }
-
-func (m *QueryListTradesByCreatorRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func (m *QueryRecipeStatsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryRecipeStatsRequest.Merge(m, src)
 }
-
-func (m *QueryListTradesByCreatorRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *QueryRecipeStatsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryRecipeStatsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryRecipeStatsRequest.DiscardUnknown(m)
 }
 
-func (m *QueryListTradesByCreatorRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Pagination != nil {
-		{
-			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.Creator) > 0 {
-		i -= len(m.Creator)
-		copy(dAtA[i:], m.Creator)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.Creator)))
-		i--
-		dAtA[i] = 0xa
+var xxx_messageInfo_QueryRecipeStatsRequest proto.InternalMessageInfo
+
+func (m *QueryRecipeStatsRequest) GetCookbookId() string {
+	if m != nil {
+		return m.CookbookId
 	}
-	return len(dAtA) - i, nil
+	return ""
 }
 
-func (m *QueryListTradesByCreatorResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *QueryRecipeStatsRequest) GetRecipeId() string {
+	if m != nil {
+		return m.RecipeId
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *QueryListTradesByCreatorResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+type QueryRecipeStatsResponse struct {
+	Stats RecipeStats `protobuf:"bytes,1,opt,name=stats,proto3" json:"stats"`
 }
 
-func (m *QueryListTradesByCreatorResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Pagination != nil {
-		{
-			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.Trades) > 0 {
-		for iNdEx := len(m.Trades) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Trades[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintQuery(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0xa
+func (m *QueryRecipeStatsResponse) Reset()         { *m = QueryRecipeStatsResponse{} }
+func (m *QueryRecipeStatsResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryRecipeStatsResponse) ProtoMessage()    {}
+func (*QueryRecipeStatsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e873e88214e938bd, []int{61}
+}
+func (m *QueryRecipeStatsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryRecipeStatsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryRecipeStatsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *QueryRecipeStatsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryRecipeStatsResponse.Merge(m, src)
+}
+func (m *QueryRecipeStatsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryRecipeStatsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryRecipeStatsResponse.DiscardUnknown(m)
 }
 
-func (m *QueryGetItemHistoryRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryRecipeStatsResponse proto.InternalMessageInfo
+
+func (m *QueryRecipeStatsResponse) GetStats() RecipeStats {
+	if m != nil {
+		return m.Stats
 	}
-	return dAtA[:n], nil
+	return RecipeStats{}
 }
 
-func (m *QueryGetItemHistoryRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+type QueryCookbookStatsRequest struct {
+	CookbookId string `protobuf:"bytes,1,opt,name=cookbook_id,json=cookbookId,proto3" json:"cookbook_id,omitempty"`
 }
 
-func (m *QueryGetItemHistoryRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.MintedNumber) > 0 {
-		i -= len(m.MintedNumber)
-		copy(dAtA[i:], m.MintedNumber)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.MintedNumber)))
-		i--
-		dAtA[i] = 0x1a
-	}
-	if len(m.ItemId) > 0 {
-		i -= len(m.ItemId)
-		copy(dAtA[i:], m.ItemId)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.ItemId)))
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.CookbookId) > 0 {
-		i -= len(m.CookbookId)
-		copy(dAtA[i:], m.CookbookId)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.CookbookId)))
-		i--
-		dAtA[i] = 0xa
+func (m *QueryCookbookStatsRequest) Reset()         { *m = QueryCookbookStatsRequest{} }
+func (m *QueryCookbookStatsRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryCookbookStatsRequest) ProtoMessage()    {}
+func (*QueryCookbookStatsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e873e88214e938bd, []int{62}
+}
+func (m *QueryCookbookStatsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryCookbookStatsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryCookbookStatsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *QueryCookbookStatsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryCookbookStatsRequest.Merge(m, src)
+}
+func (m *QueryCookbookStatsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryCookbookStatsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryCookbookStatsRequest.DiscardUnknown(m)
 }
 
-func (m *QueryGetItemHistoryResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryCookbookStatsRequest proto.InternalMessageInfo
+
+func (m *QueryCookbookStatsRequest) GetCookbookId() string {
+	if m != nil {
+		return m.CookbookId
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *QueryGetItemHistoryResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+type QueryCookbookStatsResponse struct {
+	Stats CookbookStats `protobuf:"bytes,1,opt,name=stats,proto3" json:"stats"`
 }
 
-func (m *QueryGetItemHistoryResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.History) > 0 {
-		for iNdEx := len(m.History) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.History[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintQuery(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0xa
+func (m *QueryCookbookStatsResponse) Reset()         { *m = QueryCookbookStatsResponse{} }
+func (m *QueryCookbookStatsResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryCookbookStatsResponse) ProtoMessage()    {}
+func (*QueryCookbookStatsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e873e88214e938bd, []int{63}
+}
+func (m *QueryCookbookStatsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryCookbookStatsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryCookbookStatsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *QueryCookbookStatsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryCookbookStatsResponse.Merge(m, src)
+}
+func (m *QueryCookbookStatsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryCookbookStatsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryCookbookStatsResponse.DiscardUnknown(m)
 }
 
-func (m *QueryGetRecipeHistoryRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryCookbookStatsResponse proto.InternalMessageInfo
+
+func (m *QueryCookbookStatsResponse) GetStats() CookbookStats {
+	if m != nil {
+		return m.Stats
 	}
-	return dAtA[:n], nil
+	return CookbookStats{}
 }
 
-func (m *QueryGetRecipeHistoryRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+type QueryGetLendingRequest struct {
+	Id uint64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
 }
 
-func (m *QueryGetRecipeHistoryRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.RecipeId) > 0 {
-		i -= len(m.RecipeId)
-		copy(dAtA[i:], m.RecipeId)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.RecipeId)))
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.CookbookId) > 0 {
-		i -= len(m.CookbookId)
-		copy(dAtA[i:], m.CookbookId)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.CookbookId)))
-		i--
-		dAtA[i] = 0xa
+func (m *QueryGetLendingRequest) Reset()         { *m = QueryGetLendingRequest{} }
+func (m *QueryGetLendingRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryGetLendingRequest) ProtoMessage()    {}
+func (*QueryGetLendingRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e873e88214e938bd, []int{64}
+}
+func (m *QueryGetLendingRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryGetLendingRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryGetLendingRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *QueryGetLendingRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryGetLendingRequest.Merge(m, src)
+}
+func (m *QueryGetLendingRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryGetLendingRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryGetLendingRequest.DiscardUnknown(m)
 }
 
-func (m *QueryGetRecipeHistoryResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryGetLendingRequest proto.InternalMessageInfo
+
+func (m *QueryGetLendingRequest) GetId() uint64 {
+	if m != nil {
+		return m.Id
 	}
-	return dAtA[:n], nil
+	return 0
 }
 
-func (m *QueryGetRecipeHistoryResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+type QueryGetLendingResponse struct {
+	Lending Lending `protobuf:"bytes,1,opt,name=lending,proto3" json:"lending"`
 }
 
-func (m *QueryGetRecipeHistoryResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.History) > 0 {
-		for iNdEx := len(m.History) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.History[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintQuery(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0xa
+func (m *QueryGetLendingResponse) Reset()         { *m = QueryGetLendingResponse{} }
+func (m *QueryGetLendingResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryGetLendingResponse) ProtoMessage()    {}
+func (*QueryGetLendingResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e873e88214e938bd, []int{65}
+}
+func (m *QueryGetLendingResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryGetLendingResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryGetLendingResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *QueryGetLendingResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryGetLendingResponse.Merge(m, src)
+}
+func (m *QueryGetLendingResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryGetLendingResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryGetLendingResponse.DiscardUnknown(m)
 }
 
-func (m *RecipeHistory) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryGetLendingResponse proto.InternalMessageInfo
+
+func (m *QueryGetLendingResponse) GetLending() Lending {
+	if m != nil {
+		return m.Lending
 	}
-	return dAtA[:n], nil
+	return Lending{}
 }
 
-func (m *RecipeHistory) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+type QueryGetAuctionRequest struct {
+	Id uint64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
 }
 
-func (m *RecipeHistory) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.CreatedAt != 0 {
-		i = encodeVarintQuery(dAtA, i, uint64(m.CreatedAt))
-		i--
-		dAtA[i] = 0x40
-	}
-	if len(m.Amount) > 0 {
-		i -= len(m.Amount)
-		copy(dAtA[i:], m.Amount)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.Amount)))
-		i--
-		dAtA[i] = 0x3a
-	}
-	if len(m.Receiver) > 0 {
-		i -= len(m.Receiver)
-		copy(dAtA[i:], m.Receiver)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.Receiver)))
-		i--
-		dAtA[i] = 0x32
-	}
-	if len(m.SenderName) > 0 {
-		i -= len(m.SenderName)
-		copy(dAtA[i:], m.SenderName)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.SenderName)))
-		i--
-		dAtA[i] = 0x2a
-	}
-	if len(m.Sender) > 0 {
-		i -= len(m.Sender)
-		copy(dAtA[i:], m.Sender)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.Sender)))
-		i--
-		dAtA[i] = 0x22
-	}
-	if len(m.RecipeId) > 0 {
-		i -= len(m.RecipeId)
-		copy(dAtA[i:], m.RecipeId)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.RecipeId)))
-		i--
-		dAtA[i] = 0x1a
-	}
-	if len(m.CookbookId) > 0 {
-		i -= len(m.CookbookId)
-		copy(dAtA[i:], m.CookbookId)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.CookbookId)))
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.ItemId) > 0 {
-		i -= len(m.ItemId)
-		copy(dAtA[i:], m.ItemId)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.ItemId)))
-		i--
-		dAtA[i] = 0xa
-	}
-	return len(dAtA) - i, nil
+func (m *QueryGetAuctionRequest) Reset()         { *m = QueryGetAuctionRequest{} }
+func (m *QueryGetAuctionRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryGetAuctionRequest) ProtoMessage()    {}
+func (*QueryGetAuctionRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e873e88214e938bd, []int{66}
 }
-
-func (m *QueryGetStripeRefundRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *QueryGetAuctionRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryGetAuctionRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryGetAuctionRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return dAtA[:n], nil
 }
-
-func (m *QueryGetStripeRefundRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *QueryGetAuctionRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryGetAuctionRequest.Merge(m, src)
 }
-
-func (m *QueryGetStripeRefundRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+func (m *QueryGetAuctionRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryGetAuctionRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryGetAuctionRequest.DiscardUnknown(m)
 }
 
-func (m *QueryGetStripeRefundResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryGetAuctionRequest proto.InternalMessageInfo
+
+func (m *QueryGetAuctionRequest) GetId() uint64 {
+	if m != nil {
+		return m.Id
 	}
-	return dAtA[:n], nil
+	return 0
 }
 
-func (m *QueryGetStripeRefundResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+type QueryGetAuctionResponse struct {
+	Auction Auction `protobuf:"bytes,1,opt,name=auction,proto3" json:"auction"`
 }
 
-func (m *QueryGetStripeRefundResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Refunds) > 0 {
-		for iNdEx := len(m.Refunds) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Refunds[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintQuery(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0xa
+func (m *QueryGetAuctionResponse) Reset()         { *m = QueryGetAuctionResponse{} }
+func (m *QueryGetAuctionResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryGetAuctionResponse) ProtoMessage()    {}
+func (*QueryGetAuctionResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e873e88214e938bd, []int{67}
+}
+func (m *QueryGetAuctionResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryGetAuctionResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryGetAuctionResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
 }
-
-func (m *QueryGetRedeemInfoRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func (m *QueryGetAuctionResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryGetAuctionResponse.Merge(m, src)
 }
-
-func (m *QueryGetRedeemInfoRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *QueryGetAuctionResponse) XXX_Size() int {
+	return m.Size()
 }
-
-func (m *QueryGetRedeemInfoRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Id) > 0 {
-		i -= len(m.Id)
-		copy(dAtA[i:], m.Id)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.Id)))
-		i--
-		dAtA[i] = 0xa
-	}
-	return len(dAtA) - i, nil
+func (m *QueryGetAuctionResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryGetAuctionResponse.DiscardUnknown(m)
 }
 
-func (m *QueryGetRedeemInfoResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryGetAuctionResponse proto.InternalMessageInfo
+
+func (m *QueryGetAuctionResponse) GetAuction() Auction {
+	if m != nil {
+		return m.Auction
 	}
-	return dAtA[:n], nil
+	return Auction{}
 }
 
-func (m *QueryGetRedeemInfoResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+type QueryGetDutchAuctionRequest struct {
+	Id uint64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
 }
 
-func (m *QueryGetRedeemInfoResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size, err := m.RedeemInfo.MarshalToSizedBuffer(dAtA[:i])
+func (m *QueryGetDutchAuctionRequest) Reset()         { *m = QueryGetDutchAuctionRequest{} }
+func (m *QueryGetDutchAuctionRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryGetDutchAuctionRequest) ProtoMessage()    {}
+func (*QueryGetDutchAuctionRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e873e88214e938bd, []int{68}
+}
+func (m *QueryGetDutchAuctionRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryGetDutchAuctionRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryGetDutchAuctionRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
-			return 0, err
+			return nil, err
 		}
-		i -= size
-		i = encodeVarintQuery(dAtA, i, uint64(size))
+		return b[:n], nil
 	}
-	i--
-	dAtA[i] = 0xa
-	return len(dAtA) - i, nil
+}
+func (m *QueryGetDutchAuctionRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryGetDutchAuctionRequest.Merge(m, src)
+}
+func (m *QueryGetDutchAuctionRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryGetDutchAuctionRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryGetDutchAuctionRequest.DiscardUnknown(m)
 }
 
-func (m *QueryAllRedeemInfoRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryGetDutchAuctionRequest proto.InternalMessageInfo
+
+func (m *QueryGetDutchAuctionRequest) GetId() uint64 {
+	if m != nil {
+		return m.Id
 	}
-	return dAtA[:n], nil
+	return 0
 }
 
-func (m *QueryAllRedeemInfoRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+type QueryGetDutchAuctionResponse struct {
+	DutchAuction DutchAuction `protobuf:"bytes,1,opt,name=dutch_auction,json=dutchAuction,proto3" json:"dutch_auction"`
+	CurrentPrice types.Coin   `protobuf:"bytes,2,opt,name=current_price,json=currentPrice,proto3" json:"current_price"`
 }
 
-func (m *QueryAllRedeemInfoRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Pagination != nil {
-		{
-			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
+func (m *QueryGetDutchAuctionResponse) Reset()         { *m = QueryGetDutchAuctionResponse{} }
+func (m *QueryGetDutchAuctionResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryGetDutchAuctionResponse) ProtoMessage()    {}
+func (*QueryGetDutchAuctionResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e873e88214e938bd, []int{69}
+}
+func (m *QueryGetDutchAuctionResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryGetDutchAuctionResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryGetDutchAuctionResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i--
-		dAtA[i] = 0xa
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *QueryGetDutchAuctionResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryGetDutchAuctionResponse.Merge(m, src)
+}
+func (m *QueryGetDutchAuctionResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryGetDutchAuctionResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryGetDutchAuctionResponse.DiscardUnknown(m)
 }
 
-func (m *QueryAllRedeemInfoResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryGetDutchAuctionResponse proto.InternalMessageInfo
+
+func (m *QueryGetDutchAuctionResponse) GetDutchAuction() DutchAuction {
+	if m != nil {
+		return m.DutchAuction
 	}
-	return dAtA[:n], nil
+	return DutchAuction{}
 }
 
-func (m *QueryAllRedeemInfoResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *QueryGetDutchAuctionResponse) GetCurrentPrice() types.Coin {
+	if m != nil {
+		return m.CurrentPrice
+	}
+	return types.Coin{}
 }
 
-func (m *QueryAllRedeemInfoResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Pagination != nil {
-		{
-			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.RedeemInfo) > 0 {
-		for iNdEx := len(m.RedeemInfo) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.RedeemInfo[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintQuery(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0xa
+type QueryGetItemOfferRequest struct {
+	Id uint64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+}
+
+func (m *QueryGetItemOfferRequest) Reset()         { *m = QueryGetItemOfferRequest{} }
+func (m *QueryGetItemOfferRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryGetItemOfferRequest) ProtoMessage()    {}
+func (*QueryGetItemOfferRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e873e88214e938bd, []int{70}
+}
+func (m *QueryGetItemOfferRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryGetItemOfferRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryGetItemOfferRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *QueryGetItemOfferRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryGetItemOfferRequest.Merge(m, src)
+}
+func (m *QueryGetItemOfferRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryGetItemOfferRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryGetItemOfferRequest.DiscardUnknown(m)
 }
 
-func (m *QueryGetPaymentInfoRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryGetItemOfferRequest proto.InternalMessageInfo
+
+func (m *QueryGetItemOfferRequest) GetId() uint64 {
+	if m != nil {
+		return m.Id
 	}
-	return dAtA[:n], nil
+	return 0
 }
 
-func (m *QueryGetPaymentInfoRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+type QueryGetItemOfferResponse struct {
+	ItemOffer ItemOffer `protobuf:"bytes,1,opt,name=item_offer,json=itemOffer,proto3" json:"item_offer"`
 }
 
-func (m *QueryGetPaymentInfoRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.PurchaseId) > 0 {
-		i -= len(m.PurchaseId)
-		copy(dAtA[i:], m.PurchaseId)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.PurchaseId)))
-		i--
-		dAtA[i] = 0xa
+func (m *QueryGetItemOfferResponse) Reset()         { *m = QueryGetItemOfferResponse{} }
+func (m *QueryGetItemOfferResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryGetItemOfferResponse) ProtoMessage()    {}
+func (*QueryGetItemOfferResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e873e88214e938bd, []int{71}
+}
+func (m *QueryGetItemOfferResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryGetItemOfferResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryGetItemOfferResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *QueryGetItemOfferResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryGetItemOfferResponse.Merge(m, src)
+}
+func (m *QueryGetItemOfferResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryGetItemOfferResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryGetItemOfferResponse.DiscardUnknown(m)
 }
 
-func (m *QueryGetPaymentInfoResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryGetItemOfferResponse proto.InternalMessageInfo
+
+func (m *QueryGetItemOfferResponse) GetItemOffer() ItemOffer {
+	if m != nil {
+		return m.ItemOffer
 	}
-	return dAtA[:n], nil
+	return ItemOffer{}
 }
 
-func (m *QueryGetPaymentInfoResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+type QueryGetSwapRequest struct {
+	Id uint64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
 }
 
-func (m *QueryGetPaymentInfoResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size, err := m.PaymentInfo.MarshalToSizedBuffer(dAtA[:i])
+func (m *QueryGetSwapRequest) Reset()         { *m = QueryGetSwapRequest{} }
+func (m *QueryGetSwapRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryGetSwapRequest) ProtoMessage()    {}
+func (*QueryGetSwapRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e873e88214e938bd, []int{72}
+}
+func (m *QueryGetSwapRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryGetSwapRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryGetSwapRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
-			return 0, err
+			return nil, err
 		}
-		i -= size
-		i = encodeVarintQuery(dAtA, i, uint64(size))
+		return b[:n], nil
 	}
-	i--
-	dAtA[i] = 0xa
-	return len(dAtA) - i, nil
+}
+func (m *QueryGetSwapRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryGetSwapRequest.Merge(m, src)
+}
+func (m *QueryGetSwapRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryGetSwapRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryGetSwapRequest.DiscardUnknown(m)
 }
 
-func (m *QueryAllPaymentInfoRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryGetSwapRequest proto.InternalMessageInfo
+
+func (m *QueryGetSwapRequest) GetId() uint64 {
+	if m != nil {
+		return m.Id
 	}
-	return dAtA[:n], nil
+	return 0
 }
 
-func (m *QueryAllPaymentInfoRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+type QueryGetSwapResponse struct {
+	Swap Swap `protobuf:"bytes,1,opt,name=swap,proto3" json:"swap"`
 }
 
-func (m *QueryAllPaymentInfoRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Pagination != nil {
-		{
-			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
+func (m *QueryGetSwapResponse) Reset()         { *m = QueryGetSwapResponse{} }
+func (m *QueryGetSwapResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryGetSwapResponse) ProtoMessage()    {}
+func (*QueryGetSwapResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e873e88214e938bd, []int{73}
+}
+func (m *QueryGetSwapResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryGetSwapResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryGetSwapResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i--
-		dAtA[i] = 0xa
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *QueryGetSwapResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryGetSwapResponse.Merge(m, src)
+}
+func (m *QueryGetSwapResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryGetSwapResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryGetSwapResponse.DiscardUnknown(m)
 }
 
-func (m *QueryAllPaymentInfoResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryGetSwapResponse proto.InternalMessageInfo
+
+func (m *QueryGetSwapResponse) GetSwap() Swap {
+	if m != nil {
+		return m.Swap
 	}
-	return dAtA[:n], nil
+	return Swap{}
 }
 
-func (m *QueryAllPaymentInfoResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// LongAttributeFilter matches the items with a Longs attribute in the inclusive range [min, max], an empty bound is
+// unbounded and an equality is a range with min equal to max
+type LongAttributeFilter struct {
+	Key string `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`
+	Min string `protobuf:"bytes,2,opt,name=min,proto3" json:"min,omitempty"`
+	Max string `protobuf:"bytes,3,opt,name=max,proto3" json:"max,omitempty"`
 }
 
-func (m *QueryAllPaymentInfoResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Pagination != nil {
-		{
-			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
+func (m *LongAttributeFilter) Reset()         { *m = LongAttributeFilter{} }
+func (m *LongAttributeFilter) String() string { return proto.CompactTextString(m) }
+func (*LongAttributeFilter) ProtoMessage()    {}
+func (*LongAttributeFilter) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e873e88214e938bd, []int{74}
+}
+func (m *LongAttributeFilter) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *LongAttributeFilter) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_LongAttributeFilter.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i--
-		dAtA[i] = 0x12
+		return b[:n], nil
 	}
-	if len(m.PaymentInfo) > 0 {
-		for iNdEx := len(m.PaymentInfo) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.PaymentInfo[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintQuery(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0xa
-		}
-	}
-	return len(dAtA) - i, nil
+}
+func (m *LongAttributeFilter) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_LongAttributeFilter.Merge(m, src)
+}
+func (m *LongAttributeFilter) XXX_Size() int {
+	return m.Size()
+}
+func (m *LongAttributeFilter) XXX_DiscardUnknown() {
+	xxx_messageInfo_LongAttributeFilter.DiscardUnknown(m)
 }
 
-func (m *QueryGetUsernameByAddressRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_LongAttributeFilter proto.InternalMessageInfo
+
+func (m *LongAttributeFilter) GetKey() string {
+	if m != nil {
+		return m.Key
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *QueryGetUsernameByAddressRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *LongAttributeFilter) GetMin() string {
+	if m != nil {
+		return m.Min
+	}
+	return ""
 }
 
-func (m *QueryGetUsernameByAddressRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Address) > 0 {
-		i -= len(m.Address)
-		copy(dAtA[i:], m.Address)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.Address)))
-		i--
-		dAtA[i] = 0xa
+func (m *LongAttributeFilter) GetMax() string {
+	if m != nil {
+		return m.Max
 	}
-	return len(dAtA) - i, nil
+	return ""
 }
 
-func (m *QueryGetAddressByUsernameRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+// DoubleAttributeFilter matches the items with a Doubles attribute in the inclusive range [min, max], an empty bound is
+// unbounded and an equality is a range with min equal to max
+type DoubleAttributeFilter struct {
+	Key string `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`
+	Min string `protobuf:"bytes,2,opt,name=min,proto3" json:"min,omitempty"`
+	Max string `protobuf:"bytes,3,opt,name=max,proto3" json:"max,omitempty"`
+}
+
+func (m *DoubleAttributeFilter) Reset()         { *m = DoubleAttributeFilter{} }
+func (m *DoubleAttributeFilter) String() string { return proto.CompactTextString(m) }
+func (*DoubleAttributeFilter) ProtoMessage()    {}
+func (*DoubleAttributeFilter) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e873e88214e938bd, []int{75}
+}
+func (m *DoubleAttributeFilter) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *DoubleAttributeFilter) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_DoubleAttributeFilter.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return dAtA[:n], nil
+}
+func (m *DoubleAttributeFilter) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DoubleAttributeFilter.Merge(m, src)
+}
+func (m *DoubleAttributeFilter) XXX_Size() int {
+	return m.Size()
+}
+func (m *DoubleAttributeFilter) XXX_DiscardUnknown() {
+	xxx_messageInfo_DoubleAttributeFilter.DiscardUnknown(m)
 }
 
-func (m *QueryGetAddressByUsernameRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+var xxx_messageInfo_DoubleAttributeFilter proto.InternalMessageInfo
+
+func (m *DoubleAttributeFilter) GetKey() string {
+	if m != nil {
+		return m.Key
+	}
+	return ""
 }
 
-func (m *QueryGetAddressByUsernameRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Username) > 0 {
-		i -= len(m.Username)
-		copy(dAtA[i:], m.Username)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.Username)))
-		i--
-		dAtA[i] = 0xa
+func (m *DoubleAttributeFilter) GetMin() string {
+	if m != nil {
+		return m.Min
 	}
-	return len(dAtA) - i, nil
+	return ""
 }
 
-func (m *QueryGetUsernameByAddressResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *DoubleAttributeFilter) GetMax() string {
+	if m != nil {
+		return m.Max
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *QueryGetUsernameByAddressResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// StringAttributeFilter matches the items with a Strings attribute equal to value, or starting with prefix when
+// value is empty
+type StringAttributeFilter struct {
+	Key    string `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`
+	Value  string `protobuf:"bytes,2,opt,name=value,proto3" json:"value,omitempty"`
+	Prefix string `protobuf:"bytes,3,opt,name=prefix,proto3" json:"prefix,omitempty"`
 }
 
-func (m *QueryGetUsernameByAddressResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size, err := m.Username.MarshalToSizedBuffer(dAtA[:i])
+func (m *StringAttributeFilter) Reset()         { *m = StringAttributeFilter{} }
+func (m *StringAttributeFilter) String() string { return proto.CompactTextString(m) }
+func (*StringAttributeFilter) ProtoMessage()    {}
+func (*StringAttributeFilter) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e873e88214e938bd, []int{76}
+}
+func (m *StringAttributeFilter) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *StringAttributeFilter) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_StringAttributeFilter.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
-			return 0, err
+			return nil, err
 		}
-		i -= size
-		i = encodeVarintQuery(dAtA, i, uint64(size))
+		return b[:n], nil
 	}
-	i--
-	dAtA[i] = 0xa
-	return len(dAtA) - i, nil
+}
+func (m *StringAttributeFilter) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_StringAttributeFilter.Merge(m, src)
+}
+func (m *StringAttributeFilter) XXX_Size() int {
+	return m.Size()
+}
+func (m *StringAttributeFilter) XXX_DiscardUnknown() {
+	xxx_messageInfo_StringAttributeFilter.DiscardUnknown(m)
 }
 
-func (m *QueryGetAddressByUsernameResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_StringAttributeFilter proto.InternalMessageInfo
+
+func (m *StringAttributeFilter) GetKey() string {
+	if m != nil {
+		return m.Key
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *QueryGetAddressByUsernameResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *StringAttributeFilter) GetValue() string {
+	if m != nil {
+		return m.Value
+	}
+	return ""
 }
 
-func (m *QueryGetAddressByUsernameResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size, err := m.Address.MarshalToSizedBuffer(dAtA[:i])
+func (m *StringAttributeFilter) GetPrefix() string {
+	if m != nil {
+		return m.Prefix
+	}
+	return ""
+}
+
+type QuerySearchItemsRequest struct {
+	CookbookId string                  `protobuf:"bytes,1,opt,name=cookbook_id,json=cookbookId,proto3" json:"cookbook_id,omitempty"`
+	Longs      []LongAttributeFilter   `protobuf:"bytes,2,rep,name=longs,proto3" json:"longs"`
+	Doubles    []DoubleAttributeFilter `protobuf:"bytes,3,rep,name=doubles,proto3" json:"doubles"`
+	Strings    []StringAttributeFilter `protobuf:"bytes,4,rep,name=strings,proto3" json:"strings"`
+	Owner      string                  `protobuf:"bytes,5,opt,name=owner,proto3" json:"owner,omitempty"`
+	// only match the items whose tradeable flag is equal to tradeable
+	FilterTradeable bool   `protobuf:"varint,6,opt,name=filter_tradeable,json=filterTradeable,proto3" json:"filter_tradeable,omitempty"`
+	Tradeable       bool   `protobuf:"varint,7,opt,name=tradeable,proto3" json:"tradeable,omitempty"`
+	RecipeId        string `protobuf:"bytes,8,opt,name=recipe_id,json=recipeId,proto3" json:"recipe_id,omitempty"`
+	// pagination defines an optional pagination for the request.
+	Pagination *query.PageRequest `protobuf:"bytes,9,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QuerySearchItemsRequest) Reset()         { *m = QuerySearchItemsRequest{} }
+func (m *QuerySearchItemsRequest) String() string { return proto.CompactTextString(m) }
+func (*QuerySearchItemsRequest) ProtoMessage()    {}
+func (*QuerySearchItemsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e873e88214e938bd, []int{77}
+}
+func (m *QuerySearchItemsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QuerySearchItemsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QuerySearchItemsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
-			return 0, err
+			return nil, err
 		}
-		i -= size
-		i = encodeVarintQuery(dAtA, i, uint64(size))
+		return b[:n], nil
 	}
-	i--
-	dAtA[i] = 0xa
-	return len(dAtA) - i, nil
+}
+func (m *QuerySearchItemsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QuerySearchItemsRequest.Merge(m, src)
+}
+func (m *QuerySearchItemsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QuerySearchItemsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QuerySearchItemsRequest.DiscardUnknown(m)
 }
 
-func (m *QueryGetTradeRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QuerySearchItemsRequest proto.InternalMessageInfo
+
+func (m *QuerySearchItemsRequest) GetCookbookId() string {
+	if m != nil {
+		return m.CookbookId
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *QueryGetTradeRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *QuerySearchItemsRequest) GetLongs() []LongAttributeFilter {
+	if m != nil {
+		return m.Longs
+	}
+	return nil
 }
 
-func (m *QueryGetTradeRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Id != 0 {
-		i = encodeVarintQuery(dAtA, i, uint64(m.Id))
-		i--
-		dAtA[i] = 0x8
+func (m *QuerySearchItemsRequest) GetDoubles() []DoubleAttributeFilter {
+	if m != nil {
+		return m.Doubles
 	}
-	return len(dAtA) - i, nil
+	return nil
 }
 
-func (m *QueryGetTradeResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *QuerySearchItemsRequest) GetStrings() []StringAttributeFilter {
+	if m != nil {
+		return m.Strings
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *QueryGetTradeResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *QuerySearchItemsRequest) GetOwner() string {
+	if m != nil {
+		return m.Owner
+	}
+	return ""
 }
 
-func (m *QueryGetTradeResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size, err := m.Trade.MarshalToSizedBuffer(dAtA[:i])
-		if err != nil {
-			return 0, err
-		}
-		i -= size
-		i = encodeVarintQuery(dAtA, i, uint64(size))
+func (m *QuerySearchItemsRequest) GetFilterTradeable() bool {
+	if m != nil {
+		return m.FilterTradeable
 	}
-	i--
-	dAtA[i] = 0xa
-	return len(dAtA) - i, nil
+	return false
 }
 
-func (m *QueryListItemByOwnerRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *QuerySearchItemsRequest) GetTradeable() bool {
+	if m != nil {
+		return m.Tradeable
 	}
-	return dAtA[:n], nil
+	return false
 }
 
-func (m *QueryListItemByOwnerRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *QuerySearchItemsRequest) GetRecipeId() string {
+	if m != nil {
+		return m.RecipeId
+	}
+	return ""
 }
 
-func (m *QueryListItemByOwnerRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Pagination != nil {
-		{
-			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x1a
-	}
-	if len(m.Owner) > 0 {
-		i -= len(m.Owner)
-		copy(dAtA[i:], m.Owner)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.Owner)))
-		i--
-		dAtA[i] = 0xa
+func (m *QuerySearchItemsRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
 	}
-	return len(dAtA) - i, nil
+	return nil
 }
 
-func (m *QueryListItemByOwnerResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+type QuerySearchItemsResponse struct {
+	Items []Item `protobuf:"bytes,1,rep,name=items,proto3" json:"items"`
+	// pagination defines the pagination in the response.
+	Pagination *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
-func (m *QueryListItemByOwnerResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *QuerySearchItemsResponse) Reset()         { *m = QuerySearchItemsResponse{} }
+func (m *QuerySearchItemsResponse) String() string { return proto.CompactTextString(m) }
+func (*QuerySearchItemsResponse) ProtoMessage()    {}
+func (*QuerySearchItemsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e873e88214e938bd, []int{78}
 }
-
-func (m *QueryListItemByOwnerResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Pagination != nil {
-		{
-			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
+func (m *QuerySearchItemsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QuerySearchItemsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QuerySearchItemsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i--
-		dAtA[i] = 0x12
+		return b[:n], nil
 	}
-	if len(m.Items) > 0 {
-		for iNdEx := len(m.Items) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Items[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintQuery(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0xa
-		}
+}
+func (m *QuerySearchItemsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QuerySearchItemsResponse.Merge(m, src)
+}
+func (m *QuerySearchItemsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QuerySearchItemsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QuerySearchItemsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QuerySearchItemsResponse proto.InternalMessageInfo
+
+func (m *QuerySearchItemsResponse) GetItems() []Item {
+	if m != nil {
+		return m.Items
 	}
-	return len(dAtA) - i, nil
+	return nil
 }
 
-func (m *QueryGetGoogleInAppPurchaseOrderRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *QuerySearchItemsResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *QueryGetGoogleInAppPurchaseOrderRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func init() {
+	proto.RegisterType((*QueryListSignUpByReferee)(nil), "pylons.pylons.QueryListSignUpByReferee")
+	proto.RegisterType((*QueryListSignUpByRefereeResponse)(nil), "pylons.pylons.QueryListSignUpByRefereeResponse")
+	proto.RegisterType((*QueryListTradesByCreatorRequest)(nil), "pylons.pylons.QueryListTradesByCreatorRequest")
+	proto.RegisterType((*QueryListTradesByCreatorResponse)(nil), "pylons.pylons.QueryListTradesByCreatorResponse")
+	proto.RegisterType((*QueryListTradesRequest)(nil), "pylons.pylons.QueryListTradesRequest")
+	proto.RegisterType((*QueryListTradesResponse)(nil), "pylons.pylons.QueryListTradesResponse")
+	proto.RegisterType((*QueryQuoteFulfillTradeRequest)(nil), "pylons.pylons.QueryQuoteFulfillTradeRequest")
+	proto.RegisterType((*CookbookRoyalty)(nil), "pylons.pylons.CookbookRoyalty")
+	proto.RegisterType((*QueryQuoteFulfillTradeResponse)(nil), "pylons.pylons.QueryQuoteFulfillTradeResponse")
+	proto.RegisterType((*QueryListTradesByReceiverRequest)(nil), "pylons.pylons.QueryListTradesByReceiverRequest")
+	proto.RegisterType((*QueryListTradesByReceiverResponse)(nil), "pylons.pylons.QueryListTradesByReceiverResponse")
+	proto.RegisterType((*QueryGetItemHistoryRequest)(nil), "pylons.pylons.QueryGetItemHistoryRequest")
+	proto.RegisterType((*QueryGetItemHistoryResponse)(nil), "pylons.pylons.QueryGetItemHistoryResponse")
+	proto.RegisterType((*QueryGetItemAttributesHistoryRequest)(nil), "pylons.pylons.QueryGetItemAttributesHistoryRequest")
+	proto.RegisterType((*QueryGetItemAttributesHistoryResponse)(nil), "pylons.pylons.QueryGetItemAttributesHistoryResponse")
+	proto.RegisterType((*QueryGetItemProvenanceRequest)(nil), "pylons.pylons.QueryGetItemProvenanceRequest")
+	proto.RegisterType((*QueryGetItemProvenanceResponse)(nil), "pylons.pylons.QueryGetItemProvenanceResponse")
+	proto.RegisterType((*QueryGetRecipeHistoryRequest)(nil), "pylons.pylons.QueryGetRecipeHistoryRequest")
+	proto.RegisterType((*QueryGetRecipeHistoryResponse)(nil), "pylons.pylons.QueryGetRecipeHistoryResponse")
+	proto.RegisterType((*RecipeHistory)(nil), "pylons.pylons.RecipeHistory")
+	proto.RegisterType((*QueryGetStripeRefundRequest)(nil), "pylons.pylons.QueryGetStripeRefundRequest")
+	proto.RegisterType((*QueryGetStripeRefundResponse)(nil), "pylons.pylons.QueryGetStripeRefundResponse")
+	proto.RegisterType((*QueryGetRedeemInfoRequest)(nil), "pylons.pylons.QueryGetRedeemInfoRequest")
+	proto.RegisterType((*QueryGetRedeemInfoResponse)(nil), "pylons.pylons.QueryGetRedeemInfoResponse")
+	proto.RegisterType((*QueryAllRedeemInfoRequest)(nil), "pylons.pylons.QueryAllRedeemInfoRequest")
+	proto.RegisterType((*QueryAllRedeemInfoResponse)(nil), "pylons.pylons.QueryAllRedeemInfoResponse")
+	proto.RegisterType((*QueryGetPaymentInfoRequest)(nil), "pylons.pylons.QueryGetPaymentInfoRequest")
+	proto.RegisterType((*QueryGetPaymentInfoResponse)(nil), "pylons.pylons.QueryGetPaymentInfoResponse")
+	proto.RegisterType((*QueryAllPaymentInfoRequest)(nil), "pylons.pylons.QueryAllPaymentInfoRequest")
+	proto.RegisterType((*QueryAllPaymentInfoResponse)(nil), "pylons.pylons.QueryAllPaymentInfoResponse")
+	proto.RegisterType((*QueryGetUsernameByAddressRequest)(nil), "pylons.pylons.QueryGetUsernameByAddressRequest")
+	proto.RegisterType((*QueryGetAddressByUsernameRequest)(nil), "pylons.pylons.QueryGetAddressByUsernameRequest")
+	proto.RegisterType((*QueryGetUsernameByAddressResponse)(nil), "pylons.pylons.QueryGetUsernameByAddressResponse")
+	proto.RegisterType((*QueryGetAddressByUsernameResponse)(nil), "pylons.pylons.QueryGetAddressByUsernameResponse")
+	proto.RegisterType((*QueryGetTradeRequest)(nil), "pylons.pylons.QueryGetTradeRequest")
+	proto.RegisterType((*QueryGetTradeResponse)(nil), "pylons.pylons.QueryGetTradeResponse")
+	proto.RegisterType((*QueryListItemByOwnerRequest)(nil), "pylons.pylons.QueryListItemByOwnerRequest")
+	proto.RegisterType((*QueryListItemByOwnerResponse)(nil), "pylons.pylons.QueryListItemByOwnerResponse")
+	proto.RegisterType((*QueryListItemsByCookbookRequest)(nil), "pylons.pylons.QueryListItemsByCookbookRequest")
+	proto.RegisterType((*QueryListItemsByCookbookResponse)(nil), "pylons.pylons.QueryListItemsByCookbookResponse")
+	proto.RegisterType((*QueryListItemsByRecipeRequest)(nil), "pylons.pylons.QueryListItemsByRecipeRequest")
+	proto.RegisterType((*QueryListItemsByRecipeResponse)(nil), "pylons.pylons.QueryListItemsByRecipeResponse")
+	proto.RegisterType((*QueryGetGoogleInAppPurchaseOrderRequest)(nil), "pylons.pylons.QueryGetGoogleInAppPurchaseOrderRequest")
+	proto.RegisterType((*QueryGetGoogleInAppPurchaseOrderResponse)(nil), "pylons.pylons.QueryGetGoogleInAppPurchaseOrderResponse")
+	proto.RegisterType((*QueryListExecutionsByItemRequest)(nil), "pylons.pylons.QueryListExecutionsByItemRequest")
+	proto.RegisterType((*QueryListExecutionsByItemResponse)(nil), "pylons.pylons.QueryListExecutionsByItemResponse")
+	proto.RegisterType((*QueryListExecutionsByRecipeRequest)(nil), "pylons.pylons.QueryListExecutionsByRecipeRequest")
+	proto.RegisterType((*QueryListExecutionsByRecipeResponse)(nil), "pylons.pylons.QueryListExecutionsByRecipeResponse")
+	proto.RegisterType((*QueryGetExecutionRequest)(nil), "pylons.pylons.QueryGetExecutionRequest")
+	proto.RegisterType((*QueryGetExecutionResponse)(nil), "pylons.pylons.QueryGetExecutionResponse")
+	proto.RegisterType((*QueryListRecipesByCookbookRequest)(nil), "pylons.pylons.QueryListRecipesByCookbookRequest")
+	proto.RegisterType((*QueryListRecipesByCookbookResponse)(nil), "pylons.pylons.QueryListRecipesByCookbookResponse")
+	proto.RegisterType((*QueryGetItemRequest)(nil), "pylons.pylons.QueryGetItemRequest")
+	proto.RegisterType((*QueryGetItemResponse)(nil), "pylons.pylons.QueryGetItemResponse")
+	proto.RegisterType((*QueryGetRecipeRequest)(nil), "pylons.pylons.QueryGetRecipeRequest")
+	proto.RegisterType((*QueryGetRecipeResponse)(nil), "pylons.pylons.QueryGetRecipeResponse")
+	proto.RegisterType((*QueryListCookbooksByCreatorRequest)(nil), "pylons.pylons.QueryListCookbooksByCreatorRequest")
+	proto.RegisterType((*QueryListCookbooksByCreatorResponse)(nil), "pylons.pylons.QueryListCookbooksByCreatorResponse")
+	proto.RegisterType((*QueryGetCookbookRequest)(nil), "pylons.pylons.QueryGetCookbookRequest")
+	proto.RegisterType((*QueryGetCookbookResponse)(nil), "pylons.pylons.QueryGetCookbookResponse")
+	proto.RegisterType((*QueryRecipeStatsRequest)(nil), "pylons.pylons.QueryRecipeStatsRequest")
+	proto.RegisterType((*QueryRecipeStatsResponse)(nil), "pylons.pylons.QueryRecipeStatsResponse")
+	proto.RegisterType((*QueryCookbookStatsRequest)(nil), "pylons.pylons.QueryCookbookStatsRequest")
+	proto.RegisterType((*QueryCookbookStatsResponse)(nil), "pylons.pylons.QueryCookbookStatsResponse")
+	proto.RegisterType((*QueryGetLendingRequest)(nil), "pylons.pylons.QueryGetLendingRequest")
+	proto.RegisterType((*QueryGetLendingResponse)(nil), "pylons.pylons.QueryGetLendingResponse")
+	proto.RegisterType((*QueryGetAuctionRequest)(nil), "pylons.pylons.QueryGetAuctionRequest")
+	proto.RegisterType((*QueryGetAuctionResponse)(nil), "pylons.pylons.QueryGetAuctionResponse")
+	proto.RegisterType((*QueryGetDutchAuctionRequest)(nil), "pylons.pylons.QueryGetDutchAuctionRequest")
+	proto.RegisterType((*QueryGetDutchAuctionResponse)(nil), "pylons.pylons.QueryGetDutchAuctionResponse")
+	proto.RegisterType((*QueryGetItemOfferRequest)(nil), "pylons.pylons.QueryGetItemOfferRequest")
+	proto.RegisterType((*QueryGetItemOfferResponse)(nil), "pylons.pylons.QueryGetItemOfferResponse")
+	proto.RegisterType((*QueryGetSwapRequest)(nil), "pylons.pylons.QueryGetSwapRequest")
+	proto.RegisterType((*QueryGetSwapResponse)(nil), "pylons.pylons.QueryGetSwapResponse")
+	proto.RegisterType((*LongAttributeFilter)(nil), "pylons.pylons.LongAttributeFilter")
+	proto.RegisterType((*DoubleAttributeFilter)(nil), "pylons.pylons.DoubleAttributeFilter")
+	proto.RegisterType((*StringAttributeFilter)(nil), "pylons.pylons.StringAttributeFilter")
+	proto.RegisterType((*QuerySearchItemsRequest)(nil), "pylons.pylons.QuerySearchItemsRequest")
+	proto.RegisterType((*QuerySearchItemsResponse)(nil), "pylons.pylons.QuerySearchItemsResponse")
 }
 
-func (m *QueryGetGoogleInAppPurchaseOrderRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.PurchaseToken) > 0 {
-		i -= len(m.PurchaseToken)
-		copy(dAtA[i:], m.PurchaseToken)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.PurchaseToken)))
-		i--
-		dAtA[i] = 0xa
-	}
-	return len(dAtA) - i, nil
+func init() { proto.RegisterFile("pylons/pylons/query.proto", fileDescriptor_e873e88214e938bd) }
+
+var fileDescriptor_e873e88214e938bd = []byte{
+	// 3612 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe4, 0x5b, 0xdd, 0x6f, 0x1c, 0x47,
+	0x72, 0xd7, 0xf0, 0x9b, 0x45, 0x51, 0x12, 0x9b, 0x14, 0xb9, 0x1c, 0x7e, 0x49, 0x43, 0x52, 0xa4,
+	0x3e, 0xc8, 0x95, 0x28, 0x59, 0x8e, 0x6d, 0xd9, 0x09, 0x29, 0x59, 0x32, 0xe1, 0x2f, 0x6a, 0x65,
+	0xd9, 0x80, 0x11, 0x78, 0x33, 0xdc, 0x6d, 0x92, 0x63, 0xed, 0xce, 0xac, 0x67, 0x66, 0x25, 0xad,
+	0x19, 0x3a, 0x5f, 0x80, 0x91, 0xd8, 0x4e, 0xe0, 0x7c, 0x20, 0x08, 0x8c, 0x3c, 0xd8, 0xb1, 0x93,
+	0xf8, 0x23, 0x31, 0xe0, 0xc3, 0x3d, 0xde, 0xf3, 0xc1, 0xb8, 0x87, 0x83, 0x81, 0x7b, 0xb9, 0xa7,
+	0xbb, 0x83, 0x7d, 0x0f, 0xf7, 0x7c, 0x7f, 0xc1, 0x61, 0xba, 0xab, 0xe7, 0x6b, 0xbb, 0x77, 0x97,
+	0xf4, 0x1a, 0x12, 0x70, 0x4f, 0xbb, 0xdb, 0x5d, 0xd5, 0xf5, 0xab, 0xea, 0xea, 0xee, 0xea, 0xea,
+	0x5a, 0x18, 0xaf, 0xd4, 0x4a, 0x8e, 0xed, 0x65, 0xf1, 0xe3, 0x8d, 0x2a, 0x75, 0x6b, 0xcb, 0x15,
+	0xd7, 0xf1, 0x1d, 0x32, 0xc8, 0xdb, 0x96, 0xf9, 0x87, 0x3e, 0xb9, 0xed, 0x38, 0xdb, 0x25, 0x9a,
+	0x35, 0x2b, 0x56, 0xd6, 0xb4, 0x6d, 0xc7, 0x37, 0x7d, 0x8b, 0x75, 0x07, 0xc4, 0xfa, 0x99, 0x82,
+	0xe3, 0x95, 0x1d, 0x2f, 0xbb, 0x69, 0x7a, 0x94, 0x8f, 0x92, 0xbd, 0x7b, 0x61, 0x93, 0xfa, 0xe6,
+	0x85, 0x6c, 0xc5, 0xdc, 0xb6, 0x6c, 0x46, 0x8c, 0xb4, 0xd3, 0x71, 0x5a, 0x41, 0x55, 0x70, 0x2c,
+	0xd1, 0x3f, 0xb2, 0xed, 0x6c, 0x3b, 0xec, 0x6b, 0x36, 0xf8, 0x86, 0xad, 0x33, 0x49, 0xa4, 0x2e,
+	0x2d, 0x52, 0x5a, 0xce, 0x5b, 0xf6, 0x96, 0x20, 0x38, 0x91, 0x24, 0xa8, 0x98, 0xb5, 0x32, 0xb5,
+	0xfd, 0x38, 0xc5, 0x64, 0x92, 0xc2, 0x2c, 0x14, 0x9c, 0xaa, 0xed, 0x0b, 0x15, 0x52, 0xa6, 0xf0,
+	0x5d, 0xb3, 0x48, 0xb1, 0x6b, 0x2e, 0xd9, 0xc5, 0x2d, 0x91, 0xb7, 0xcc, 0x4a, 0xde, 0x71, 0x8b,
+	0xd4, 0x45, 0xaa, 0xa9, 0x24, 0x15, 0xbd, 0x4f, 0x0b, 0xd5, 0x98, 0xda, 0x99, 0x64, 0xb7, 0xe5,
+	0xd3, 0x32, 0xf6, 0xe8, 0x69, 0xd5, 0x0a, 0x56, 0x85, 0xca, 0x31, 0x17, 0x1c, 0xe7, 0xce, 0xa6,
+	0xe3, 0xdc, 0xc1, 0xde, 0x93, 0xc9, 0x5e, 0xcf, 0x77, 0xad, 0x0a, 0xcd, 0xbb, 0x74, 0xab, 0x6a,
+	0x17, 0xe5, 0x6a, 0x79, 0xbe, 0x19, 0x6a, 0x3c, 0x91, 0xec, 0x2a, 0x51, 0xbb, 0x68, 0xd9, 0xdb,
+	0xf2, 0x4e, 0xb3, 0x5a, 0x88, 0x4f, 0x61, 0xbd, 0x2e, 0x79, 0x67, 0x6b, 0x8b, 0xba, 0x72, 0x5d,
+	0xbd, 0x7b, 0x66, 0x85, 0xf7, 0x18, 0x97, 0x20, 0x73, 0x33, 0x70, 0x8f, 0xe7, 0x2c, 0xcf, 0xbf,
+	0x65, 0x6d, 0xdb, 0xb7, 0x2b, 0x6b, 0xb5, 0x1c, 0xdd, 0xa2, 0x2e, 0xa5, 0x24, 0x03, 0xbd, 0x05,
+	0x97, 0x9a, 0xbe, 0xe3, 0x66, 0xb4, 0x13, 0xda, 0x62, 0x7f, 0x4e, 0xfc, 0x34, 0x6e, 0xc3, 0x09,
+	0x15, 0x57, 0x8e, 0x7a, 0x15, 0xc7, 0xf6, 0x28, 0xb9, 0x00, 0x3d, 0x9e, 0xb5, 0x6d, 0x57, 0x2b,
+	0x8c, 0x79, 0x60, 0x65, 0x7c, 0x39, 0xe1, 0xc0, 0xcb, 0x8c, 0xde, 0x35, 0x4b, 0xcf, 0xbe, 0x9c,
+	0x43, 0x42, 0xe3, 0xef, 0x34, 0x98, 0x09, 0xc7, 0x7d, 0x29, 0x98, 0x70, 0x6f, 0xad, 0x76, 0x95,
+	0xcb, 0xcc, 0xd1, 0x37, 0xaa, 0xd4, 0xf3, 0xd5, 0xa0, 0xc8, 0x75, 0x80, 0xc8, 0xb7, 0x33, 0x1d,
+	0x4c, 0xe8, 0xa9, 0x65, 0xee, 0xdc, 0xcb, 0x81, 0x73, 0x2f, 0xf3, 0xe5, 0x84, 0x2e, 0xbe, 0xbc,
+	0x61, 0x6e, 0x53, 0x1c, 0x35, 0x17, 0xe3, 0x34, 0x3e, 0xd3, 0xe0, 0x84, 0x1a, 0x05, 0x6a, 0xb7,
+	0x02, 0x3d, 0xcc, 0x23, 0xbd, 0x8c, 0x76, 0xa2, 0x73, 0x71, 0x60, 0x65, 0x24, 0xa5, 0x1d, 0xe3,
+	0x5b, 0xeb, 0xfa, 0xfa, 0x57, 0x33, 0x87, 0x72, 0x48, 0x49, 0x6e, 0x48, 0x00, 0x2e, 0x34, 0x05,
+	0xc8, 0x05, 0xc6, 0x11, 0x3e, 0xde, 0xf7, 0xf7, 0x1f, 0xce, 0x1c, 0xfa, 0xdd, 0x87, 0x33, 0x87,
+	0x8c, 0xaf, 0x3a, 0x61, 0x34, 0x85, 0x55, 0x18, 0x6a, 0x06, 0x06, 0x84, 0x77, 0xe6, 0xad, 0x22,
+	0x1a, 0x0b, 0x44, 0xd3, 0x7a, 0x91, 0x8c, 0x40, 0x77, 0x91, 0xda, 0x4e, 0x99, 0x21, 0xe9, 0xcf,
+	0xf1, 0x1f, 0x64, 0x02, 0xfa, 0xcb, 0x96, 0x9d, 0xaf, 0xb8, 0x56, 0x81, 0x66, 0x3a, 0x59, 0x4f,
+	0x5f, 0xd9, 0xb2, 0x37, 0x82, 0xdf, 0xac, 0xd3, 0xbc, 0x8f, 0x9d, 0x5d, 0xd8, 0x69, 0xde, 0xe7,
+	0x9d, 0x4f, 0x41, 0x77, 0xc9, 0xb1, 0xb7, 0xbd, 0x4c, 0x37, 0xb3, 0x88, 0x91, 0xb2, 0xc8, 0x73,
+	0x8e, 0xbd, 0xbd, 0xea, 0xfb, 0xae, 0xb5, 0x59, 0xf5, 0xe9, 0x75, 0xab, 0xe4, 0x53, 0x17, 0xed,
+	0xc3, 0xd9, 0xc8, 0x35, 0xe8, 0x2d, 0x3a, 0xd5, 0xcd, 0x12, 0xf5, 0x32, 0x3d, 0x6c, 0x84, 0xb9,
+	0xd4, 0x08, 0xd7, 0x58, 0xaf, 0x7c, 0x0c, 0xc1, 0x1a, 0x8c, 0x12, 0x2c, 0xbb, 0x00, 0x47, 0xaf,
+	0x74, 0x94, 0x5b, 0xac, 0x57, 0x31, 0x0a, 0xb2, 0x12, 0x02, 0x5d, 0x9e, 0xe3, 0xfa, 0x99, 0x3e,
+	0xa6, 0x23, 0xfb, 0x9e, 0xf2, 0xaf, 0xfe, 0x03, 0xfb, 0xd7, 0x87, 0x1a, 0x8c, 0xd5, 0xcd, 0xd9,
+	0xc3, 0xe5, 0x56, 0x3f, 0xd7, 0x60, 0x8a, 0x41, 0xbc, 0x59, 0x75, 0x7c, 0x7a, 0xbd, 0x5a, 0xda,
+	0xb2, 0x4a, 0x25, 0x26, 0x5b, 0x78, 0xd7, 0x11, 0xe8, 0x40, 0xa7, 0xea, 0xca, 0x75, 0x58, 0x45,
+	0x32, 0x09, 0xfd, 0x5b, 0x9c, 0x8c, 0xba, 0xe8, 0x50, 0x51, 0x03, 0x39, 0x03, 0x43, 0xc1, 0x81,
+	0x92, 0xb7, 0xec, 0x4a, 0xd5, 0xf7, 0xf2, 0x96, 0x5d, 0xa4, 0xf7, 0x99, 0x73, 0x75, 0xe5, 0x8e,
+	0x06, 0x1d, 0xeb, 0xac, 0x7d, 0x3d, 0x68, 0x26, 0x2b, 0xd0, 0x1d, 0xec, 0x5f, 0x5e, 0xa6, 0x8b,
+	0x59, 0x60, 0x34, 0x65, 0x81, 0x75, 0x9f, 0x96, 0x73, 0x74, 0x4b, 0xb8, 0x0e, 0x23, 0x0d, 0x7c,
+	0x3d, 0x90, 0x94, 0x37, 0xcb, 0xc1, 0x09, 0x92, 0xe9, 0x66, 0x23, 0x43, 0xd0, 0xb4, 0xca, 0x5a,
+	0x8c, 0xff, 0xd7, 0xe0, 0xe8, 0x55, 0x74, 0xfd, 0x9c, 0x53, 0x33, 0x4b, 0x7e, 0xad, 0xa5, 0x05,
+	0xe2, 0xdc, 0xb3, 0x43, 0x7d, 0xf8, 0x0f, 0x52, 0x80, 0x1e, 0x14, 0xd3, 0xc9, 0x00, 0x8e, 0x27,
+	0x4c, 0x2d, 0x8c, 0x7c, 0xd5, 0xb1, 0xec, 0xb5, 0xf3, 0x01, 0xc6, 0xcf, 0x7f, 0x3d, 0xb3, 0xb8,
+	0x6d, 0xf9, 0x3b, 0xd5, 0xcd, 0xe5, 0x82, 0x53, 0xce, 0xe2, 0x61, 0xcb, 0x3f, 0x96, 0xbc, 0xe2,
+	0x9d, 0xac, 0x5f, 0xab, 0x50, 0x8f, 0x31, 0x78, 0x39, 0x1c, 0xda, 0xf8, 0xb4, 0x1b, 0xa6, 0x55,
+	0x13, 0x80, 0xae, 0xe2, 0xc2, 0x91, 0xd0, 0xc0, 0xf9, 0x8a, 0x59, 0x13, 0x2e, 0xd3, 0x56, 0x3c,
+	0x83, 0xa1, 0x88, 0x0d, 0xb3, 0xe6, 0x11, 0x1b, 0x0e, 0xe3, 0x6e, 0xcb, 0x25, 0x76, 0xb4, 0x5f,
+	0xe2, 0x00, 0x0a, 0x60, 0xf2, 0xde, 0x04, 0x12, 0xe9, 0xe8, 0xd2, 0x02, 0xb5, 0xee, 0x52, 0xef,
+	0x87, 0xb0, 0xfb, 0x50, 0x28, 0x26, 0x87, 0x52, 0xc8, 0x5d, 0x38, 0x26, 0x74, 0x0d, 0x25, 0x77,
+	0xb5, 0x5f, 0xf2, 0xd1, 0x82, 0x38, 0x59, 0x50, 0xee, 0x1a, 0xf4, 0xbb, 0xcc, 0x43, 0x2d, 0x2a,
+	0xb6, 0xd2, 0xe9, 0xd4, 0x1a, 0x48, 0x79, 0x32, 0xae, 0x85, 0x88, 0x8d, 0xbc, 0x0e, 0x50, 0xd8,
+	0x31, 0x2d, 0x3b, 0xbf, 0x45, 0xc3, 0xdd, 0xb4, 0xad, 0xa8, 0xfb, 0xd9, 0xf0, 0xd7, 0x29, 0xf5,
+	0x8c, 0xb7, 0x65, 0xc7, 0x25, 0x6a, 0x13, 0x9e, 0xda, 0x3a, 0xf4, 0xa1, 0x11, 0xc5, 0xb1, 0x1d,
+	0xfe, 0x6e, 0xdb, 0xb9, 0xfd, 0xb9, 0x06, 0x27, 0x1b, 0x00, 0x79, 0xb8, 0x76, 0xd8, 0x5d, 0xd0,
+	0x19, 0xd6, 0x1b, 0xd4, 0x0f, 0x76, 0xb4, 0x67, 0x2c, 0xcf, 0x77, 0xdc, 0x5a, 0xcb, 0x67, 0xf7,
+	0x18, 0xf4, 0xb2, 0x20, 0xcf, 0x2a, 0xe2, 0xe6, 0xd4, 0x13, 0xfc, 0x5c, 0x2f, 0x92, 0x59, 0x18,
+	0x2c, 0x5b, 0xb6, 0x4f, 0x8b, 0x79, 0xbb, 0x5a, 0xde, 0xa4, 0x2e, 0x1e, 0xe1, 0x87, 0x79, 0xe3,
+	0x0b, 0xac, 0xcd, 0xb8, 0x05, 0x13, 0x52, 0xe1, 0x68, 0xa2, 0x4b, 0xd0, 0xbb, 0xc3, 0x9b, 0xd0,
+	0x46, 0xba, 0x64, 0x0f, 0x16, 0x4c, 0x82, 0xd4, 0xf8, 0x0b, 0x98, 0x8b, 0x0f, 0x1a, 0x1e, 0xb0,
+	0x5e, 0xbb, 0x74, 0x33, 0xca, 0x30, 0xdf, 0x44, 0x02, 0x2a, 0x70, 0x2d, 0xad, 0xc0, 0x9c, 0x44,
+	0x81, 0x3a, 0x76, 0x11, 0x03, 0x08, 0x85, 0x3e, 0x12, 0x87, 0x20, 0xca, 0xdb, 0x70, 0x9d, 0xbb,
+	0xd4, 0x36, 0xed, 0x02, 0xfd, 0xfe, 0xd3, 0x94, 0xf4, 0xf9, 0xce, 0x03, 0xfb, 0xfc, 0x97, 0x1a,
+	0x4c, 0xab, 0x30, 0xa2, 0x31, 0xae, 0x02, 0x54, 0xc2, 0x56, 0xb4, 0xc7, 0x94, 0xc4, 0x1e, 0x11,
+	0x2b, 0x1a, 0x22, 0xc6, 0xd6, 0xb6, 0x15, 0x60, 0xfc, 0x39, 0x4c, 0x0a, 0xbc, 0x39, 0x76, 0xaf,
+	0xda, 0xaf, 0x77, 0x4c, 0x40, 0x3f, 0xbf, 0x90, 0x45, 0x46, 0xed, 0xe3, 0x0d, 0xeb, 0x45, 0xe3,
+	0x15, 0x98, 0x52, 0x8c, 0x8e, 0xc6, 0xb8, 0x9c, 0xf6, 0x8c, 0xc9, 0xba, 0x5b, 0x49, 0x9c, 0x2d,
+	0xf4, 0x85, 0xdf, 0x6b, 0x30, 0x98, 0xe8, 0x8a, 0x4f, 0xad, 0x96, 0x98, 0xda, 0x94, 0x06, 0x1d,
+	0x8d, 0x35, 0xe8, 0x4c, 0x6a, 0x40, 0x46, 0xa1, 0xc7, 0xa3, 0x76, 0x91, 0xba, 0x18, 0x5e, 0xe3,
+	0xaf, 0x60, 0x54, 0xfe, 0x2d, 0x6f, 0x9b, 0x65, 0xca, 0x22, 0x9c, 0xfe, 0x1c, 0xf0, 0xa6, 0x17,
+	0xcc, 0x32, 0x4d, 0xec, 0xb0, 0x3d, 0xa9, 0x1d, 0x76, 0x34, 0x0c, 0x59, 0x7a, 0xf9, 0xa0, 0xfc,
+	0x17, 0x99, 0x02, 0x60, 0xa7, 0x0f, 0x2d, 0xe6, 0x4d, 0x1e, 0xeb, 0x76, 0xe6, 0xfa, 0xb1, 0x65,
+	0xd5, 0x37, 0xa6, 0xa2, 0x6d, 0xe2, 0x16, 0xbb, 0xc9, 0xe6, 0xd8, 0x45, 0x16, 0xa7, 0xca, 0xb8,
+	0x0d, 0x93, 0xf2, 0x6e, 0xb4, 0xf5, 0x23, 0xd0, 0xcb, 0x6f, 0xbe, 0x62, 0xab, 0x9d, 0x90, 0x44,
+	0xe2, 0x21, 0x97, 0xa0, 0x35, 0xce, 0xc2, 0x78, 0x34, 0x87, 0x41, 0x52, 0x61, 0xdd, 0xde, 0x72,
+	0xea, 0xc3, 0xce, 0xfe, 0x20, 0xec, 0x34, 0x5e, 0x03, 0x5d, 0x46, 0x8c, 0x08, 0xfe, 0x0c, 0x06,
+	0x62, 0x79, 0x09, 0xe5, 0x3d, 0x54, 0xf0, 0x09, 0xbf, 0x77, 0xc3, 0x16, 0xa3, 0x80, 0x60, 0x56,
+	0x4b, 0xa5, 0x7a, 0x30, 0xc9, 0x45, 0xac, 0x1d, 0x78, 0x11, 0xff, 0xaf, 0x06, 0xba, 0x4c, 0x8a,
+	0x4a, 0x8b, 0xce, 0x7d, 0x6a, 0xd1, 0xbe, 0xd5, 0xfb, 0x64, 0x64, 0xee, 0x0d, 0x9e, 0xcf, 0x89,
+	0xdb, 0x63, 0x06, 0x06, 0x2a, 0x55, 0xb7, 0xb0, 0x63, 0x7a, 0x34, 0xb6, 0x76, 0x45, 0xd3, 0x7a,
+	0xd1, 0xd8, 0x84, 0x09, 0x29, 0x7b, 0xb8, 0x53, 0x1d, 0x8e, 0x67, 0x89, 0xd0, 0xa2, 0xe9, 0xc3,
+	0x27, 0xc6, 0x89, 0xaa, 0x0e, 0x54, 0xa2, 0x26, 0xa3, 0x18, 0xd9, 0x52, 0x02, 0xb1, 0x5d, 0x53,
+	0xf6, 0x85, 0x06, 0x13, 0x52, 0x31, 0x4a, 0x55, 0x3a, 0xf7, 0xad, 0x4a, 0xfb, 0xa6, 0xed, 0x0a,
+	0x46, 0x68, 0x37, 0xa8, 0x7f, 0xdb, 0xa3, 0x6e, 0xb0, 0x83, 0xac, 0xd5, 0x56, 0x8b, 0x45, 0x97,
+	0x7a, 0x5e, 0x2c, 0xaf, 0x62, 0xf2, 0x16, 0x91, 0x57, 0xc1, 0x9f, 0xc6, 0x53, 0x11, 0x37, 0xf2,
+	0xac, 0xd5, 0xc4, 0x30, 0xb1, 0xf8, 0xae, 0x8a, 0x4d, 0x22, 0xbe, 0x13, 0xbf, 0x8d, 0xd7, 0xe0,
+	0x64, 0x03, 0xe9, 0x68, 0xb0, 0xc7, 0x52, 0x03, 0x0c, 0xac, 0x8c, 0xa5, 0x8c, 0x15, 0xf2, 0x72,
+	0x4b, 0x45, 0xe3, 0xe7, 0xa3, 0xf1, 0x25, 0xf8, 0x70, 0xfc, 0xc7, 0x93, 0xea, 0xd5, 0xcf, 0xc5,
+	0x2a, 0xcf, 0x3e, 0x06, 0x23, 0x88, 0x40, 0x40, 0x18, 0xe0, 0x14, 0x8c, 0x08, 0x01, 0x8d, 0xee,
+	0xc0, 0xc6, 0x3a, 0x1c, 0x4f, 0xd1, 0xa1, 0xf0, 0xf3, 0xd0, 0xcd, 0x22, 0x49, 0x14, 0xdd, 0x28,
+	0xe4, 0xe4, 0x84, 0xc6, 0x2e, 0x4c, 0x84, 0xa1, 0x6c, 0x70, 0x38, 0xaf, 0xd5, 0x5e, 0x0c, 0x2e,
+	0x9f, 0x42, 0x72, 0x78, 0x33, 0xd5, 0xe2, 0x37, 0xd3, 0x76, 0x05, 0x15, 0xff, 0xa5, 0xc1, 0xa4,
+	0x5c, 0x3a, 0xea, 0x93, 0x15, 0x57, 0x74, 0xee, 0xd6, 0xc3, 0x92, 0x68, 0x22, 0x79, 0x3f, 0xff,
+	0x01, 0x02, 0xe8, 0x77, 0xe2, 0xb9, 0xc2, 0x40, 0x62, 0x90, 0xa4, 0x13, 0xf7, 0xa2, 0x56, 0x83,
+	0x89, 0x76, 0x5d, 0x3d, 0xfe, 0x33, 0x7e, 0x07, 0xaa, 0x03, 0xf3, 0xa0, 0xad, 0x66, 0xfc, 0xb7,
+	0x88, 0x64, 0x63, 0xf0, 0x78, 0x34, 0xd3, 0x96, 0xb0, 0xab, 0x6d, 0x8e, 0xf7, 0x81, 0x88, 0x66,
+	0x25, 0x38, 0x1f, 0xb8, 0x11, 0x37, 0x60, 0x41, 0xac, 0xee, 0x1b, 0xec, 0xc1, 0x61, 0xdd, 0x5e,
+	0xad, 0x54, 0x36, 0xf0, 0x74, 0x7b, 0x31, 0x78, 0x78, 0x10, 0xd6, 0x9c, 0x87, 0x23, 0xe1, 0x41,
+	0xe8, 0x3b, 0x77, 0xa8, 0x8d, 0x06, 0x1d, 0x14, 0xad, 0x2f, 0x05, 0x8d, 0x86, 0x03, 0x8b, 0xcd,
+	0x47, 0x0c, 0x0f, 0x94, 0x6e, 0xf6, 0xb6, 0x81, 0x5b, 0xc8, 0x42, 0x4a, 0x6f, 0x15, 0xbf, 0xb0,
+	0x05, 0xe3, 0x35, 0xbe, 0x8c, 0xbb, 0xe9, 0xd3, 0xe2, 0x3d, 0xc4, 0x5b, 0xab, 0xf1, 0xa4, 0xda,
+	0x43, 0x72, 0xa9, 0x89, 0x2d, 0xf2, 0x7f, 0xea, 0x80, 0x93, 0x0d, 0x00, 0xa3, 0x6d, 0x6e, 0xc2,
+	0x48, 0xc1, 0x29, 0x57, 0x4a, 0x34, 0x08, 0x64, 0xc3, 0x67, 0x1e, 0xe1, 0x22, 0x99, 0x94, 0xa9,
+	0xc2, 0x61, 0xd0, 0x36, 0xc3, 0x21, 0x6f, 0x24, 0x80, 0x3c, 0x0f, 0xa4, 0xc2, 0x9f, 0x5f, 0xe2,
+	0x03, 0x76, 0xb4, 0x34, 0xe0, 0x10, 0x72, 0xc6, 0x86, 0xbb, 0x21, 0xb1, 0xcc, 0x81, 0x9c, 0xf0,
+	0xc7, 0x1a, 0x18, 0x52, 0x83, 0x3c, 0x84, 0xcb, 0x39, 0x36, 0x8f, 0xef, 0x77, 0xc0, 0x6c, 0x43,
+	0xd8, 0x7f, 0x7c, 0x33, 0x79, 0x06, 0x1f, 0xde, 0x6e, 0xd0, 0xc8, 0x20, 0xaa, 0x5b, 0xce, 0x3d,
+	0x18, 0x97, 0xd0, 0xa2, 0xcd, 0xae, 0x40, 0x7f, 0xa8, 0x18, 0xee, 0x0e, 0xcd, 0xf4, 0x8a, 0x18,
+	0x82, 0xbc, 0x7d, 0x68, 0x35, 0xe6, 0x08, 0x7d, 0xb9, 0xa8, 0xc1, 0x78, 0x2f, 0x9e, 0x52, 0xe3,
+	0x73, 0xf5, 0x20, 0x8f, 0xd9, 0x4f, 0xe2, 0xde, 0x2f, 0x81, 0x13, 0xbf, 0x78, 0xb2, 0x4e, 0x74,
+	0x9c, 0xe3, 0xd2, 0x4b, 0xbe, 0x08, 0xf3, 0x90, 0xb6, 0x7d, 0x27, 0xc5, 0x75, 0x18, 0x8e, 0xe7,
+	0x64, 0x5a, 0x36, 0x13, 0x9f, 0xf6, 0xce, 0x70, 0xda, 0xff, 0x12, 0x46, 0x92, 0xe3, 0xa0, 0x7e,
+	0x4b, 0xd0, 0x15, 0xec, 0xb8, 0x38, 0xd9, 0x0d, 0x8e, 0x40, 0x46, 0x46, 0x1e, 0x81, 0xbe, 0x82,
+	0x63, 0xfb, 0xd4, 0xf6, 0x85, 0xdf, 0x37, 0x60, 0x09, 0x49, 0x8d, 0x67, 0xa2, 0x68, 0x76, 0x9f,
+	0x9b, 0x0b, 0xd7, 0xa3, 0x23, 0xd4, 0xe3, 0x79, 0x18, 0x4d, 0x8f, 0x84, 0x9a, 0x5c, 0x84, 0x1e,
+	0x6e, 0x7d, 0xd4, 0xa5, 0xe1, 0x44, 0x21, 0xa9, 0xf1, 0x76, 0xdc, 0x0b, 0xc4, 0xe4, 0x1f, 0xfc,
+	0xa1, 0xb8, 0xf3, 0xfb, 0x5c, 0x02, 0x67, 0x1b, 0x02, 0x41, 0x2d, 0x9f, 0x08, 0xd6, 0x18, 0xf6,
+	0xa2, 0x47, 0x8e, 0x29, 0x32, 0xfa, 0x62, 0x81, 0x86, 0xf4, 0xed, 0xdb, 0x70, 0x4e, 0xe3, 0xab,
+	0xe3, 0x0d, 0xea, 0xa7, 0x17, 0x70, 0x7a, 0xbf, 0xb9, 0x0d, 0x99, 0x7a, 0xd2, 0xe8, 0xa2, 0x26,
+	0xc0, 0x29, 0x2e, 0x6a, 0x29, 0x5d, 0x42, 0x72, 0xe3, 0x15, 0x44, 0xc0, 0x67, 0xf5, 0x96, 0x6f,
+	0xfa, 0x5e, 0x7b, 0xd2, 0x7e, 0x39, 0xc8, 0xd4, 0x0f, 0x1c, 0x66, 0xfc, 0xba, 0x59, 0x8d, 0x85,
+	0xe2, 0xda, 0x17, 0x63, 0x11, 0xb1, 0x12, 0x23, 0x37, 0xae, 0xe0, 0x9e, 0x2b, 0xb4, 0xd9, 0x17,
+	0x5c, 0xe3, 0x65, 0xd0, 0x65, 0xdc, 0x88, 0xe9, 0x4f, 0x92, 0x98, 0x26, 0x15, 0x06, 0x94, 0xa0,
+	0x5a, 0x8c, 0x96, 0xd2, 0x73, 0xfc, 0x6c, 0x52, 0x5d, 0x46, 0x6f, 0xc2, 0x58, 0x1d, 0x65, 0x94,
+	0x04, 0xc5, 0xda, 0x12, 0x04, 0x90, 0x7e, 0x63, 0x45, 0x06, 0xb1, 0x41, 0x22, 0x71, 0x5c, 0xf8,
+	0x2a, 0x2f, 0x3f, 0x69, 0x41, 0x78, 0x48, 0x19, 0x09, 0xc7, 0xda, 0x15, 0x85, 0x70, 0x64, 0x10,
+	0xc2, 0x91, 0xd8, 0x58, 0x8a, 0x72, 0x47, 0xd7, 0xaa, 0x7e, 0x61, 0xa7, 0x09, 0x82, 0xff, 0xd3,
+	0x60, 0x52, 0x4e, 0x8f, 0x38, 0xae, 0xc3, 0x60, 0x31, 0x68, 0xcf, 0x27, 0xd1, 0xa4, 0x73, 0x94,
+	0x71, 0x5e, 0x84, 0x74, 0xb8, 0x18, 0x6b, 0x23, 0xd7, 0x60, 0xb0, 0x50, 0x75, 0xdd, 0x20, 0xd3,
+	0xc3, 0xcb, 0x22, 0x3a, 0x30, 0xcb, 0xa8, 0x7c, 0x6d, 0xc3, 0x51, 0x90, 0x8b, 0xd5, 0x4e, 0xc4,
+	0xa3, 0x81, 0x60, 0x33, 0x7e, 0x31, 0xa8, 0xdd, 0x51, 0xa9, 0xf6, 0x2a, 0x8c, 0x4b, 0x68, 0x51,
+	0xad, 0x27, 0x01, 0xa2, 0xea, 0x1f, 0x45, 0x38, 0x10, 0x72, 0x89, 0xdd, 0xc6, 0x12, 0x0d, 0xc6,
+	0x7c, 0x74, 0x74, 0xdd, 0xba, 0x67, 0x56, 0x54, 0x10, 0x9e, 0x86, 0x91, 0x24, 0x59, 0x74, 0x32,
+	0x05, 0xb5, 0x45, 0x8a, 0x93, 0x29, 0x20, 0x15, 0x27, 0x53, 0x40, 0x66, 0x3c, 0x0b, 0xc3, 0x92,
+	0xaa, 0x10, 0x72, 0x0c, 0x3a, 0xef, 0xd0, 0x1a, 0xae, 0xaa, 0xe0, 0x6b, 0xd0, 0x52, 0xb6, 0x6c,
+	0x5c, 0xf7, 0xc1, 0x57, 0xd6, 0x62, 0xde, 0xc7, 0xc3, 0x32, 0xf8, 0x6a, 0x3c, 0x0f, 0xc7, 0xa5,
+	0x05, 0x22, 0x07, 0x1c, 0xee, 0x15, 0x38, 0x2e, 0xad, 0x14, 0x91, 0x0c, 0x37, 0x02, 0xdd, 0x77,
+	0xcd, 0x52, 0x95, 0x8a, 0x3a, 0x01, 0xf6, 0x23, 0x48, 0xba, 0x57, 0x5c, 0xba, 0x65, 0x89, 0x51,
+	0xf1, 0x97, 0xf1, 0xb3, 0x4e, 0x5c, 0x1c, 0xb7, 0xa8, 0xe9, 0x16, 0x76, 0xd8, 0x35, 0xb7, 0xe5,
+	0x6d, 0x30, 0xac, 0xb1, 0xe9, 0xf8, 0xde, 0x35, 0x36, 0x9d, 0x6d, 0xa9, 0xb1, 0xe9, 0x3a, 0x78,
+	0x8d, 0x4d, 0x98, 0xc4, 0xea, 0x8e, 0x27, 0xb1, 0x4e, 0xc3, 0xb1, 0x2d, 0x46, 0x9e, 0x67, 0x99,
+	0x30, 0x73, 0xb3, 0x44, 0xd9, 0x7b, 0x46, 0x5f, 0xee, 0x28, 0x6f, 0x7f, 0x49, 0x34, 0x07, 0xb1,
+	0x6b, 0x44, 0xd3, 0xcb, 0x63, 0xd7, 0xb0, 0x21, 0x79, 0x62, 0xf4, 0x35, 0xbc, 0xe2, 0x1c, 0xbc,
+	0x96, 0xe7, 0xdf, 0x34, 0xc8, 0xd4, 0x4f, 0xe6, 0x83, 0xce, 0x55, 0xac, 0xbc, 0x77, 0x16, 0xba,
+	0x19, 0x2c, 0xf2, 0x1f, 0x1a, 0x0c, 0x4b, 0xea, 0xd8, 0xc8, 0x72, 0x0a, 0x4c, 0x93, 0xb2, 0x3b,
+	0x3d, 0xdb, 0x32, 0x3d, 0x87, 0x63, 0x9c, 0xf8, 0xdb, 0x5f, 0xfc, 0xf6, 0x5f, 0x3b, 0x74, 0x92,
+	0x49, 0x14, 0x70, 0x7a, 0xd9, 0x5d, 0x8c, 0xc2, 0xf6, 0x88, 0x07, 0x10, 0x0d, 0x40, 0xe6, 0x1b,
+	0x0b, 0x10, 0x38, 0x4e, 0x35, 0x23, 0x43, 0xf1, 0xa3, 0x4c, 0xfc, 0x31, 0x72, 0x24, 0x29, 0x9e,
+	0x7c, 0xa0, 0xc1, 0x50, 0x5d, 0x4d, 0x0d, 0x39, 0x27, 0x1b, 0x55, 0x55, 0xfb, 0xa4, 0x2f, 0xb5,
+	0x48, 0x8d, 0x50, 0x16, 0x19, 0x14, 0x83, 0x9c, 0x88, 0xaa, 0x7a, 0x1d, 0x9f, 0xe6, 0xb1, 0xe2,
+	0x84, 0xbb, 0x79, 0x76, 0xd7, 0x2a, 0xee, 0x91, 0x4f, 0x35, 0x18, 0x91, 0x15, 0x2f, 0x90, 0xa6,
+	0xd6, 0x4f, 0xd5, 0x5b, 0xe8, 0xe7, 0x5b, 0x67, 0x40, 0x94, 0x4b, 0x0c, 0xe5, 0x02, 0x99, 0x4f,
+	0x1a, 0x2c, 0xbf, 0x59, 0x13, 0xe5, 0x2f, 0x6e, 0x76, 0x57, 0x7c, 0xdb, 0x23, 0xff, 0x8c, 0x7e,
+	0x95, 0xae, 0x19, 0x5d, 0x50, 0x09, 0x4e, 0x11, 0xea, 0xd9, 0x16, 0x09, 0xf7, 0xe1, 0x50, 0x5f,
+	0x68, 0x70, 0x2c, 0xfd, 0xf2, 0x4b, 0xce, 0xca, 0xe4, 0x28, 0x5e, 0x9f, 0xf5, 0x73, 0xad, 0x11,
+	0x23, 0xa2, 0x2b, 0x0c, 0xd1, 0x65, 0x72, 0x29, 0x2c, 0x44, 0xa6, 0x7e, 0x1e, 0xf7, 0x1c, 0x7c,
+	0x38, 0xce, 0xee, 0xc6, 0xf6, 0xf3, 0xbd, 0xec, 0x2e, 0xf6, 0x06, 0x93, 0xfd, 0x8f, 0x1a, 0x1c,
+	0x4d, 0x3d, 0x9d, 0x92, 0x33, 0x0a, 0xf9, 0x92, 0xe7, 0x57, 0xfd, 0x6c, 0x4b, 0xb4, 0x08, 0xf5,
+	0x24, 0x83, 0x3a, 0x41, 0xc6, 0xe3, 0x50, 0x13, 0xe5, 0xc9, 0xe4, 0x7f, 0x34, 0x18, 0x13, 0x21,
+	0x45, 0xb0, 0x13, 0x7b, 0x3b, 0x56, 0x45, 0x18, 0xf1, 0xb4, 0x42, 0x56, 0x7d, 0xe9, 0x8a, 0x7e,
+	0xa6, 0x15, 0x52, 0x44, 0x75, 0x89, 0xa1, 0x5a, 0x26, 0xe7, 0x12, 0x85, 0xcb, 0x0a, 0xd3, 0x61,
+	0xce, 0x71, 0x8f, 0xfc, 0x54, 0x83, 0x8c, 0xaa, 0x04, 0x84, 0x5c, 0x6c, 0x20, 0x5e, 0x55, 0x92,
+	0xa2, 0x5f, 0xda, 0x1f, 0x13, 0xa2, 0xff, 0x53, 0x86, 0xfe, 0x31, 0xf2, 0x68, 0x02, 0xbd, 0x19,
+	0xd2, 0x37, 0x55, 0xe4, 0x33, 0x0d, 0x86, 0xea, 0xea, 0x36, 0xc8, 0xb9, 0x06, 0x60, 0xea, 0x4a,
+	0x50, 0xf4, 0xa5, 0x16, 0xa9, 0x11, 0xf3, 0xa3, 0x0c, 0xf3, 0x05, 0x92, 0x4d, 0x60, 0x8e, 0x0a,
+	0x3d, 0x94, 0x58, 0xdf, 0x02, 0x88, 0x9e, 0x98, 0xc9, 0xa2, 0x72, 0x9d, 0xa4, 0xde, 0xc8, 0xf5,
+	0xd3, 0x2d, 0x50, 0x22, 0xb6, 0x09, 0x86, 0xed, 0x38, 0x19, 0x4e, 0xfe, 0xa7, 0x80, 0x6f, 0x8d,
+	0x7b, 0x41, 0xfd, 0x85, 0x60, 0x59, 0x2d, 0x95, 0xe4, 0x10, 0x64, 0xcf, 0xf4, 0xfa, 0xe9, 0x16,
+	0x28, 0x11, 0xc2, 0x18, 0x83, 0x30, 0x44, 0x8e, 0x26, 0x21, 0x78, 0xe4, 0x5d, 0x0d, 0x06, 0x62,
+	0xaf, 0xb5, 0xca, 0x05, 0x51, 0xff, 0xe4, 0xac, 0x9f, 0x69, 0x85, 0x14, 0xe5, 0xcf, 0x33, 0xf9,
+	0x33, 0x64, 0x2a, 0xf5, 0xaf, 0x89, 0xec, 0x6e, 0xec, 0x61, 0x7d, 0x8f, 0xfc, 0x8d, 0x06, 0x47,
+	0x62, 0xec, 0x81, 0x39, 0x54, 0x4a, 0xb6, 0x0a, 0x48, 0xfe, 0x8e, 0x6d, 0x64, 0x18, 0x20, 0x42,
+	0x8e, 0xa5, 0x00, 0x79, 0xe4, 0x23, 0x0d, 0x86, 0xea, 0x9e, 0x73, 0xe5, 0x07, 0x55, 0x83, 0x67,
+	0x67, 0xfd, 0x7c, 0xeb, 0x0c, 0x08, 0xe9, 0x34, 0x83, 0x34, 0x4b, 0x4e, 0xa6, 0xfe, 0x37, 0x92,
+	0xc5, 0xe7, 0xda, 0xec, 0x2e, 0x7e, 0xd9, 0x23, 0x1f, 0x6b, 0x30, 0x54, 0xf7, 0x24, 0xac, 0xc4,
+	0xa8, 0x7a, 0xdc, 0xd6, 0xcf, 0xb7, 0xce, 0x80, 0x18, 0xcf, 0x32, 0x8c, 0xf3, 0x64, 0x36, 0x8d,
+	0x51, 0x3c, 0x5a, 0x67, 0x77, 0xc5, 0xb7, 0x3d, 0x62, 0x43, 0x37, 0x8f, 0x42, 0x66, 0x15, 0x72,
+	0x12, 0xc1, 0xc7, 0x5c, 0x63, 0x22, 0x04, 0xa0, 0x33, 0x00, 0x23, 0x84, 0x24, 0x0e, 0x4b, 0xbe,
+	0x94, 0xfe, 0x41, 0x83, 0xa3, 0xa9, 0x97, 0x5d, 0xf9, 0xc1, 0x23, 0x7f, 0x7c, 0xd6, 0xcf, 0xb6,
+	0x44, 0x8b, 0x40, 0xa6, 0x18, 0x90, 0x31, 0x72, 0x3c, 0xbe, 0xe1, 0x78, 0xd9, 0x5d, 0x16, 0xec,
+	0xef, 0x91, 0xaf, 0x82, 0xbd, 0x5c, 0xf1, 0x76, 0x45, 0x2e, 0x2b, 0x54, 0x6d, 0xf2, 0xfc, 0xa6,
+	0x3f, 0xba, 0x6f, 0x3e, 0x04, 0x3b, 0xc7, 0xc0, 0x4e, 0x93, 0xc9, 0x10, 0xac, 0x59, 0xc9, 0xee,
+	0x26, 0x9f, 0xf2, 0xf6, 0xc8, 0x8f, 0x30, 0x4a, 0x4b, 0xbf, 0x47, 0xa9, 0xa3, 0x34, 0xc5, 0x53,
+	0x9b, 0x7e, 0xbe, 0x75, 0x06, 0xd5, 0xfe, 0x1d, 0xbd, 0x69, 0x30, 0xcb, 0x2a, 0xf7, 0xef, 0x9f,
+	0x68, 0x30, 0x2a, 0x7f, 0x7c, 0x21, 0x17, 0x5a, 0x41, 0x91, 0x48, 0x01, 0xeb, 0x2b, 0xfb, 0x61,
+	0x41, 0xe8, 0x4f, 0x30, 0xe8, 0x8f, 0x90, 0x8b, 0x12, 0xe8, 0x3c, 0x2c, 0x6a, 0x10, 0x2c, 0xbd,
+	0x05, 0xfd, 0xe1, 0xd0, 0xf2, 0x18, 0x53, 0xf2, 0x8e, 0xa2, 0x2f, 0x36, 0x27, 0x44, 0x70, 0xd3,
+	0x0c, 0x5c, 0x86, 0x8c, 0xd6, 0x81, 0xe3, 0x6b, 0xe6, 0x63, 0x0d, 0x8e, 0x4b, 0x1f, 0x1d, 0x88,
+	0x72, 0x0e, 0x55, 0xcf, 0x25, 0xfa, 0x85, 0x7d, 0x70, 0xa8, 0xce, 0x05, 0x6e, 0x1a, 0x2f, 0x69,
+	0x31, 0x72, 0x1f, 0xba, 0x98, 0x23, 0x1a, 0x0d, 0x82, 0x02, 0x81, 0x62, 0xb6, 0x21, 0x0d, 0xca,
+	0x5d, 0x60, 0x72, 0x4f, 0x92, 0x99, 0xf8, 0xea, 0xad, 0xf3, 0xb1, 0xe2, 0x1e, 0xf9, 0x6b, 0x0d,
+	0x7a, 0xd0, 0x9d, 0xe6, 0x1a, 0xc6, 0xd0, 0x42, 0xfc, 0x7c, 0x13, 0x2a, 0xd5, 0x66, 0x2f, 0xf7,
+	0x94, 0x00, 0xc2, 0x27, 0xe8, 0xe1, 0xf5, 0x89, 0x78, 0xb5, 0x87, 0x2b, 0x5f, 0x0f, 0xf4, 0x95,
+	0xfd, 0xb0, 0x20, 0xd8, 0x59, 0x06, 0x76, 0x8a, 0x4c, 0xa4, 0xff, 0x1d, 0x18, 0xbf, 0xa4, 0xbc,
+	0x09, 0x7d, 0xa1, 0xef, 0x9c, 0x52, 0x18, 0x21, 0xed, 0x31, 0x0b, 0x4d, 0xe9, 0x54, 0xbb, 0xad,
+	0x40, 0xc0, 0x4d, 0xf4, 0xef, 0x1a, 0x0c, 0xc4, 0x12, 0xde, 0x72, 0xf9, 0xf5, 0xd9, 0x79, 0x7d,
+	0xa1, 0x29, 0x1d, 0xca, 0xbf, 0xcc, 0xe4, 0x9f, 0x27, 0xcb, 0x89, 0xbf, 0x37, 0x36, 0x5f, 0xde,
+	0xff, 0xa2, 0xc1, 0x60, 0x22, 0xeb, 0x2d, 0x0f, 0xef, 0x64, 0xb9, 0x78, 0xfd, 0x74, 0x0b, 0x94,
+	0x08, 0xef, 0x1c, 0x83, 0x77, 0x8a, 0xcc, 0x25, 0xe1, 0x45, 0x46, 0x4a, 0xac, 0xa6, 0xbb, 0xd0,
+	0x8b, 0x89, 0x70, 0xa2, 0xf2, 0xd6, 0x64, 0x0e, 0x5e, 0x3f, 0xd5, 0x8c, 0x0c, 0x71, 0x4c, 0x32,
+	0x1c, 0xa3, 0x64, 0x24, 0xf5, 0x57, 0x4f, 0x3e, 0x4b, 0x77, 0xa1, 0x57, 0x24, 0x97, 0x55, 0x72,
+	0x93, 0xc9, 0x6f, 0xfd, 0x54, 0x33, 0x32, 0x95, 0x5c, 0xcc, 0x7d, 0x73, 0xb9, 0xef, 0x6a, 0x70,
+	0x38, 0x9e, 0xee, 0x56, 0xde, 0x46, 0x25, 0xf9, 0x77, 0xfd, 0x6c, 0x4b, 0xb4, 0x88, 0xc3, 0x60,
+	0x38, 0x26, 0x89, 0x2e, 0x70, 0x24, 0x32, 0xf1, 0x1c, 0xcd, 0x5f, 0x41, 0x7f, 0x98, 0xa7, 0x56,
+	0xee, 0xf8, 0xe9, 0x5c, 0xb9, 0xbe, 0xd8, 0x9c, 0x10, 0x31, 0xcc, 0x30, 0x0c, 0xe3, 0x64, 0xac,
+	0xfe, 0x4f, 0xb3, 0x1c, 0xc0, 0xeb, 0xd0, 0x15, 0x24, 0xac, 0x95, 0x9b, 0x69, 0x2c, 0x3f, 0xae,
+	0xcf, 0x36, 0xa4, 0x41, 0x89, 0xe3, 0x4c, 0xe2, 0x30, 0x19, 0x8a, 0xff, 0x0d, 0x37, 0xdc, 0xbb,
+	0x86, 0x25, 0xa5, 0x63, 0xea, 0x2c, 0x9d, 0xbc, 0xe0, 0x4d, 0xcf, 0xb6, 0x4c, 0xaf, 0x5a, 0x11,
+	0x3c, 0x3c, 0x53, 0xac, 0x88, 0x4f, 0x35, 0x18, 0xaa, 0x2b, 0xcd, 0x92, 0x5f, 0x58, 0x55, 0x95,
+	0x66, 0xfa, 0x52, 0x8b, 0xd4, 0xaa, 0x1d, 0x85, 0x03, 0x6c, 0xba, 0xa3, 0xbc, 0xa3, 0xc1, 0x40,
+	0x2c, 0x27, 0x2b, 0xdf, 0xea, 0xea, 0x33, 0xf0, 0xfa, 0x42, 0x53, 0x3a, 0x04, 0x76, 0x86, 0x01,
+	0x9b, 0x23, 0x46, 0x12, 0x98, 0xc7, 0x48, 0x93, 0xc0, 0xd6, 0xae, 0x7f, 0xfd, 0xed, 0xb4, 0xf6,
+	0xcd, 0xb7, 0xd3, 0xda, 0x6f, 0xbe, 0x9d, 0xd6, 0xde, 0xff, 0x6e, 0xfa, 0xd0, 0x37, 0xdf, 0x4d,
+	0x1f, 0xfa, 0xe5, 0x77, 0xd3, 0x87, 0x5e, 0x3d, 0x17, 0xfb, 0xc7, 0xd5, 0x06, 0x1b, 0x67, 0xc9,
+	0xa7, 0x85, 0x1d, 0x31, 0xe6, 0x7d, 0xf1, 0x85, 0xfd, 0xf7, 0x6a, 0xb3, 0x87, 0xfd, 0x67, 0xfb,
+	0xe2, 0x1f, 0x06, 0x00, 0x5e, 0xa6, 0xa3, 0x85, 0x26, 0x40, 0x00, 0x00,
 }
 
-func (m *QueryGetGoogleInAppPurchaseOrderResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// QueryClient is the client API for Query service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type QueryClient interface {
+	// Queries a list of listTradesByCreator items.
+	ListTradesByCreator(ctx context.Context, in *QueryListTradesByCreatorRequest, opts ...grpc.CallOption) (*QueryListTradesByCreatorResponse, error)
+	// Queries the open trades matching a set of filters.
+	ListTrades(ctx context.Context, in *QueryListTradesRequest, opts ...grpc.CallOption) (*QueryListTradesResponse, error)
+	// Queries the coins paid and received by the parties of a trade fulfillment, with the royalties and chain fees.
+	QuoteFulfillTrade(ctx context.Context, in *QueryQuoteFulfillTradeRequest, opts ...grpc.CallOption) (*QueryQuoteFulfillTradeResponse, error)
+	// Queries the private trades that can be fulfilled by an address.
+	ListTradesByReceiver(ctx context.Context, in *QueryListTradesByReceiverRequest, opts ...grpc.CallOption) (*QueryListTradesByReceiverResponse, error)
+	// Queries a list of Signup by Referee Address items.
+	ListSignUpByReferee(ctx context.Context, in *QueryListSignUpByReferee, opts ...grpc.CallOption) (*QueryListSignUpByRefereeResponse, error)
+	// Queries a list of GetRecipeHistory items.
+	GetRecipeHistory(ctx context.Context, in *QueryGetRecipeHistoryRequest, opts ...grpc.CallOption) (*QueryGetRecipeHistoryResponse, error)
+	// Queries a list of GetStripeRefund items.
+	GetStripeRefund(ctx context.Context, in *QueryGetStripeRefundRequest, opts ...grpc.CallOption) (*QueryGetStripeRefundResponse, error)
+	// Retrieves an item history by id.
+	GetItemOwnershipHistory(ctx context.Context, in *QueryGetItemHistoryRequest, opts ...grpc.CallOption) (*QueryGetItemHistoryResponse, error)
+	// Retrieves the attribute updates of an item.
+	GetItemAttributesHistory(ctx context.Context, in *QueryGetItemAttributesHistoryRequest, opts ...grpc.CallOption) (*QueryGetItemAttributesHistoryResponse, error)
+	// Retrieves the provenance of an item, its mints, modifications, transfers, locks and burn, oldest first.
+	GetItemProvenance(ctx context.Context, in *QueryGetItemProvenanceRequest, opts ...grpc.CallOption) (*QueryGetItemProvenanceResponse, error)
+	// Queries a redeemInfo by index.
+	RedeemInfo(ctx context.Context, in *QueryGetRedeemInfoRequest, opts ...grpc.CallOption) (*QueryGetRedeemInfoResponse, error)
+	// Queries a list of redeemInfo items.
+	RedeemInfoAll(ctx context.Context, in *QueryAllRedeemInfoRequest, opts ...grpc.CallOption) (*QueryAllRedeemInfoResponse, error)
+	// Queries a paymentInfo by index.
+	PaymentInfo(ctx context.Context, in *QueryGetPaymentInfoRequest, opts ...grpc.CallOption) (*QueryGetPaymentInfoResponse, error)
+	// Queries a list of paymentInfo items.
+	PaymentInfoAll(ctx context.Context, in *QueryAllPaymentInfoRequest, opts ...grpc.CallOption) (*QueryAllPaymentInfoResponse, error)
+	// Queries a list of getAccountByAddress items.
+	UsernameByAddress(ctx context.Context, in *QueryGetUsernameByAddressRequest, opts ...grpc.CallOption) (*QueryGetUsernameByAddressResponse, error)
+	// Queries a username by account.
+	AddressByUsername(ctx context.Context, in *QueryGetAddressByUsernameRequest, opts ...grpc.CallOption) (*QueryGetAddressByUsernameResponse, error)
+	// Queries a trade by id.
+	Trade(ctx context.Context, in *QueryGetTradeRequest, opts ...grpc.CallOption) (*QueryGetTradeResponse, error)
+	// Queries a list of listItemByOwner items.
+	ListItemByOwner(ctx context.Context, in *QueryListItemByOwnerRequest, opts ...grpc.CallOption) (*QueryListItemByOwnerResponse, error)
+	// Queries a googleIAPOrder by purchase_token.
+	GoogleInAppPurchaseOrder(ctx context.Context, in *QueryGetGoogleInAppPurchaseOrderRequest, opts ...grpc.CallOption) (*QueryGetGoogleInAppPurchaseOrderResponse, error)
+	// Queries a list of listExecutionsByItem items.
+	ListExecutionsByItem(ctx context.Context, in *QueryListExecutionsByItemRequest, opts ...grpc.CallOption) (*QueryListExecutionsByItemResponse, error)
+	// Queries a list of listExecutionsByRecipe items.
+	ListExecutionsByRecipe(ctx context.Context, in *QueryListExecutionsByRecipeRequest, opts ...grpc.CallOption) (*QueryListExecutionsByRecipeResponse, error)
+	// Queries a execution by id.
+	Execution(ctx context.Context, in *QueryGetExecutionRequest, opts ...grpc.CallOption) (*QueryGetExecutionResponse, error)
+	// Queries a list of listRecipesByCookbook items.
+	ListRecipesByCookbook(ctx context.Context, in *QueryListRecipesByCookbookRequest, opts ...grpc.CallOption) (*QueryListRecipesByCookbookResponse, error)
+	// Queries a item by id.
+	Item(ctx context.Context, in *QueryGetItemRequest, opts ...grpc.CallOption) (*QueryGetItemResponse, error)
+	// Retrieves a recipe by id.
+	Recipe(ctx context.Context, in *QueryGetRecipeRequest, opts ...grpc.CallOption) (*QueryGetRecipeResponse, error)
+	// Retrieves the list of cookbooks owned by an address
+	ListCookbooksByCreator(ctx context.Context, in *QueryListCookbooksByCreatorRequest, opts ...grpc.CallOption) (*QueryListCookbooksByCreatorResponse, error)
+	// Retrieves a cookbook by id.
+	Cookbook(ctx context.Context, in *QueryGetCookbookRequest, opts ...grpc.CallOption) (*QueryGetCookbookResponse, error)
+	// Retrieves the aggregated execution statistics of a recipe.
+	RecipeStats(ctx context.Context, in *QueryRecipeStatsRequest, opts ...grpc.CallOption) (*QueryRecipeStatsResponse, error)
+	// Retrieves the aggregated execution statistics of a cookbook.
+	CookbookStats(ctx context.Context, in *QueryCookbookStatsRequest, opts ...grpc.CallOption) (*QueryCookbookStatsResponse, error)
+	// Queries a lending by id.
+	Lending(ctx context.Context, in *QueryGetLendingRequest, opts ...grpc.CallOption) (*QueryGetLendingResponse, error)
+	// Queries an auction by id.
+	Auction(ctx context.Context, in *QueryGetAuctionRequest, opts ...grpc.CallOption) (*QueryGetAuctionResponse, error)
+	// Queries a dutch auction and its current price by id.
+	DutchAuction(ctx context.Context, in *QueryGetDutchAuctionRequest, opts ...grpc.CallOption) (*QueryGetDutchAuctionResponse, error)
+	// Queries an item offer by id.
+	ItemOffer(ctx context.Context, in *QueryGetItemOfferRequest, opts ...grpc.CallOption) (*QueryGetItemOfferResponse, error)
+	// Queries a swap by id.
+	Swap(ctx context.Context, in *QueryGetSwapRequest, opts ...grpc.CallOption) (*QueryGetSwapResponse, error)
+	// Queries a list of items of a cookbook.
+	ListItemsByCookbook(ctx context.Context, in *QueryListItemsByCookbookRequest, opts ...grpc.CallOption) (*QueryListItemsByCookbookResponse, error)
+	// Queries a list of items created by a recipe.
+	ListItemsByRecipe(ctx context.Context, in *QueryListItemsByRecipeRequest, opts ...grpc.CallOption) (*QueryListItemsByRecipeResponse, error)
+	// Searches the items of a cookbook by attributes, owner, tradeable flag and recipe.
+	SearchItems(ctx context.Context, in *QuerySearchItemsRequest, opts ...grpc.CallOption) (*QuerySearchItemsResponse, error)
 }
 
-func (m *QueryGetGoogleInAppPurchaseOrderResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+type queryClient struct {
+	cc grpc1.ClientConn
 }
 
-func (m *QueryGetGoogleInAppPurchaseOrderResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size, err := m.Order.MarshalToSizedBuffer(dAtA[:i])
-		if err != nil {
-			return 0, err
-		}
-		i -= size
-		i = encodeVarintQuery(dAtA, i, uint64(size))
-	}
-	i--
-	dAtA[i] = 0xa
-	return len(dAtA) - i, nil
+func NewQueryClient(cc grpc1.ClientConn) QueryClient {
+	return &queryClient{cc}
 }
 
-func (m *QueryListExecutionsByItemRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *queryClient) ListTradesByCreator(ctx context.Context, in *QueryListTradesByCreatorRequest, opts ...grpc.CallOption) (*QueryListTradesByCreatorResponse, error) {
+	out := new(QueryListTradesByCreatorResponse)
+	err := c.cc.Invoke(ctx, "/pylons.pylons.Query/ListTradesByCreator", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	return out, nil
 }
 
-func (m *QueryListExecutionsByItemRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (c *queryClient) ListTrades(ctx context.Context, in *QueryListTradesRequest, opts ...grpc.CallOption) (*QueryListTradesResponse, error) {
+	out := new(QueryListTradesResponse)
+	err := c.cc.Invoke(ctx, "/pylons.pylons.Query/ListTrades", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func (m *QueryListExecutionsByItemRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Pagination != nil {
-		{
-			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x1a
-	}
-	if len(m.ItemId) > 0 {
-		i -= len(m.ItemId)
-		copy(dAtA[i:], m.ItemId)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.ItemId)))
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.CookbookId) > 0 {
-		i -= len(m.CookbookId)
-		copy(dAtA[i:], m.CookbookId)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.CookbookId)))
-		i--
-		dAtA[i] = 0xa
+func (c *queryClient) QuoteFulfillTrade(ctx context.Context, in *QueryQuoteFulfillTradeRequest, opts ...grpc.CallOption) (*QueryQuoteFulfillTradeResponse, error) {
+	out := new(QueryQuoteFulfillTradeResponse)
+	err := c.cc.Invoke(ctx, "/pylons.pylons.Query/QuoteFulfillTrade", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return len(dAtA) - i, nil
+	return out, nil
 }
 
-func (m *QueryListExecutionsByItemResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *queryClient) ListTradesByReceiver(ctx context.Context, in *QueryListTradesByReceiverRequest, opts ...grpc.CallOption) (*QueryListTradesByReceiverResponse, error) {
+	out := new(QueryListTradesByReceiverResponse)
+	err := c.cc.Invoke(ctx, "/pylons.pylons.Query/ListTradesByReceiver", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	return out, nil
 }
 
-func (m *QueryListExecutionsByItemResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (c *queryClient) ListSignUpByReferee(ctx context.Context, in *QueryListSignUpByReferee, opts ...grpc.CallOption) (*QueryListSignUpByRefereeResponse, error) {
+	out := new(QueryListSignUpByRefereeResponse)
+	err := c.cc.Invoke(ctx, "/pylons.pylons.Query/ListSignUpByReferee", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func (m *QueryListExecutionsByItemResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Pagination != nil {
-		{
-			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x1a
-	}
-	if len(m.PendingExecutions) > 0 {
-		for iNdEx := len(m.PendingExecutions) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.PendingExecutions[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintQuery(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x12
-		}
-	}
-	if len(m.CompletedExecutions) > 0 {
-		for iNdEx := len(m.CompletedExecutions) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.CompletedExecutions[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintQuery(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0xa
-		}
+func (c *queryClient) GetRecipeHistory(ctx context.Context, in *QueryGetRecipeHistoryRequest, opts ...grpc.CallOption) (*QueryGetRecipeHistoryResponse, error) {
+	out := new(QueryGetRecipeHistoryResponse)
+	err := c.cc.Invoke(ctx, "/pylons.pylons.Query/GetRecipeHistory", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return len(dAtA) - i, nil
+	return out, nil
 }
 
-func (m *QueryListExecutionsByRecipeRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *queryClient) GetStripeRefund(ctx context.Context, in *QueryGetStripeRefundRequest, opts ...grpc.CallOption) (*QueryGetStripeRefundResponse, error) {
+	out := new(QueryGetStripeRefundResponse)
+	err := c.cc.Invoke(ctx, "/pylons.pylons.Query/GetStripeRefund", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	return out, nil
 }
 
-func (m *QueryListExecutionsByRecipeRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (c *queryClient) GetItemOwnershipHistory(ctx context.Context, in *QueryGetItemHistoryRequest, opts ...grpc.CallOption) (*QueryGetItemHistoryResponse, error) {
+	out := new(QueryGetItemHistoryResponse)
+	err := c.cc.Invoke(ctx, "/pylons.pylons.Query/GetItemOwnershipHistory", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func (m *QueryListExecutionsByRecipeRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Pagination != nil {
-		{
-			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x1a
-	}
-	if len(m.RecipeId) > 0 {
-		i -= len(m.RecipeId)
-		copy(dAtA[i:], m.RecipeId)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.RecipeId)))
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.CookbookId) > 0 {
-		i -= len(m.CookbookId)
-		copy(dAtA[i:], m.CookbookId)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.CookbookId)))
-		i--
-		dAtA[i] = 0xa
+func (c *queryClient) GetItemAttributesHistory(ctx context.Context, in *QueryGetItemAttributesHistoryRequest, opts ...grpc.CallOption) (*QueryGetItemAttributesHistoryResponse, error) {
+	out := new(QueryGetItemAttributesHistoryResponse)
+	err := c.cc.Invoke(ctx, "/pylons.pylons.Query/GetItemAttributesHistory", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return len(dAtA) - i, nil
+	return out, nil
 }
 
-func (m *QueryListExecutionsByRecipeResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *queryClient) GetItemProvenance(ctx context.Context, in *QueryGetItemProvenanceRequest, opts ...grpc.CallOption) (*QueryGetItemProvenanceResponse, error) {
+	out := new(QueryGetItemProvenanceResponse)
+	err := c.cc.Invoke(ctx, "/pylons.pylons.Query/GetItemProvenance", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	return out, nil
 }
 
-func (m *QueryListExecutionsByRecipeResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (c *queryClient) RedeemInfo(ctx context.Context, in *QueryGetRedeemInfoRequest, opts ...grpc.CallOption) (*QueryGetRedeemInfoResponse, error) {
+	out := new(QueryGetRedeemInfoResponse)
+	err := c.cc.Invoke(ctx, "/pylons.pylons.Query/RedeemInfo", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func (m *QueryListExecutionsByRecipeResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Pagination != nil {
-		{
-			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x1a
-	}
-	if len(m.PendingExecutions) > 0 {
-		for iNdEx := len(m.PendingExecutions) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.PendingExecutions[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintQuery(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x12
-		}
+func (c *queryClient) RedeemInfoAll(ctx context.Context, in *QueryAllRedeemInfoRequest, opts ...grpc.CallOption) (*QueryAllRedeemInfoResponse, error) {
+	out := new(QueryAllRedeemInfoResponse)
+	err := c.cc.Invoke(ctx, "/pylons.pylons.Query/RedeemInfoAll", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	if len(m.CompletedExecutions) > 0 {
-		for iNdEx := len(m.CompletedExecutions) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.CompletedExecutions[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintQuery(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0xa
-		}
+	return out, nil
+}
+
+func (c *queryClient) PaymentInfo(ctx context.Context, in *QueryGetPaymentInfoRequest, opts ...grpc.CallOption) (*QueryGetPaymentInfoResponse, error) {
+	out := new(QueryGetPaymentInfoResponse)
+	err := c.cc.Invoke(ctx, "/pylons.pylons.Query/PaymentInfo", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return len(dAtA) - i, nil
+	return out, nil
 }
 
-func (m *QueryGetExecutionRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *queryClient) PaymentInfoAll(ctx context.Context, in *QueryAllPaymentInfoRequest, opts ...grpc.CallOption) (*QueryAllPaymentInfoResponse, error) {
+	out := new(QueryAllPaymentInfoResponse)
+	err := c.cc.Invoke(ctx, "/pylons.pylons.Query/PaymentInfoAll", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	return out, nil
 }
 
-func (m *QueryGetExecutionRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (c *queryClient) UsernameByAddress(ctx context.Context, in *QueryGetUsernameByAddressRequest, opts ...grpc.CallOption) (*QueryGetUsernameByAddressResponse, error) {
+	out := new(QueryGetUsernameByAddressResponse)
+	err := c.cc.Invoke(ctx, "/pylons.pylons.Query/UsernameByAddress", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func (m *QueryGetExecutionRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Id) > 0 {
-		i -= len(m.Id)
-		copy(dAtA[i:], m.Id)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.Id)))
-		i--
-		dAtA[i] = 0xa
+func (c *queryClient) AddressByUsername(ctx context.Context, in *QueryGetAddressByUsernameRequest, opts ...grpc.CallOption) (*QueryGetAddressByUsernameResponse, error) {
+	out := new(QueryGetAddressByUsernameResponse)
+	err := c.cc.Invoke(ctx, "/pylons.pylons.Query/AddressByUsername", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return len(dAtA) - i, nil
+	return out, nil
 }
 
-func (m *QueryGetExecutionResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *queryClient) Trade(ctx context.Context, in *QueryGetTradeRequest, opts ...grpc.CallOption) (*QueryGetTradeResponse, error) {
+	out := new(QueryGetTradeResponse)
+	err := c.cc.Invoke(ctx, "/pylons.pylons.Query/Trade", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	return out, nil
 }
 
-func (m *QueryGetExecutionResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (c *queryClient) ListItemByOwner(ctx context.Context, in *QueryListItemByOwnerRequest, opts ...grpc.CallOption) (*QueryListItemByOwnerResponse, error) {
+	out := new(QueryListItemByOwnerResponse)
+	err := c.cc.Invoke(ctx, "/pylons.pylons.Query/ListItemByOwner", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func (m *QueryGetExecutionResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Completed {
-		i--
-		if m.Completed {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
-		}
-		i--
-		dAtA[i] = 0x10
-	}
-	{
-		size, err := m.Execution.MarshalToSizedBuffer(dAtA[:i])
-		if err != nil {
-			return 0, err
-		}
-		i -= size
-		i = encodeVarintQuery(dAtA, i, uint64(size))
+func (c *queryClient) GoogleInAppPurchaseOrder(ctx context.Context, in *QueryGetGoogleInAppPurchaseOrderRequest, opts ...grpc.CallOption) (*QueryGetGoogleInAppPurchaseOrderResponse, error) {
+	out := new(QueryGetGoogleInAppPurchaseOrderResponse)
+	err := c.cc.Invoke(ctx, "/pylons.pylons.Query/GoogleInAppPurchaseOrder", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	i--
-	dAtA[i] = 0xa
-	return len(dAtA) - i, nil
+	return out, nil
 }
 
-func (m *QueryListRecipesByCookbookRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *queryClient) ListExecutionsByItem(ctx context.Context, in *QueryListExecutionsByItemRequest, opts ...grpc.CallOption) (*QueryListExecutionsByItemResponse, error) {
+	out := new(QueryListExecutionsByItemResponse)
+	err := c.cc.Invoke(ctx, "/pylons.pylons.Query/ListExecutionsByItem", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	return out, nil
 }
 
-func (m *QueryListRecipesByCookbookRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (c *queryClient) ListExecutionsByRecipe(ctx context.Context, in *QueryListExecutionsByRecipeRequest, opts ...grpc.CallOption) (*QueryListExecutionsByRecipeResponse, error) {
+	out := new(QueryListExecutionsByRecipeResponse)
+	err := c.cc.Invoke(ctx, "/pylons.pylons.Query/ListExecutionsByRecipe", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func (m *QueryListRecipesByCookbookRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Pagination != nil {
-		{
-			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.CookbookId) > 0 {
-		i -= len(m.CookbookId)
-		copy(dAtA[i:], m.CookbookId)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.CookbookId)))
-		i--
-		dAtA[i] = 0xa
+func (c *queryClient) Execution(ctx context.Context, in *QueryGetExecutionRequest, opts ...grpc.CallOption) (*QueryGetExecutionResponse, error) {
+	out := new(QueryGetExecutionResponse)
+	err := c.cc.Invoke(ctx, "/pylons.pylons.Query/Execution", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return len(dAtA) - i, nil
+	return out, nil
 }
 
-func (m *QueryListRecipesByCookbookResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *queryClient) ListRecipesByCookbook(ctx context.Context, in *QueryListRecipesByCookbookRequest, opts ...grpc.CallOption) (*QueryListRecipesByCookbookResponse, error) {
+	out := new(QueryListRecipesByCookbookResponse)
+	err := c.cc.Invoke(ctx, "/pylons.pylons.Query/ListRecipesByCookbook", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	return out, nil
 }
 
-func (m *QueryListRecipesByCookbookResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (c *queryClient) Item(ctx context.Context, in *QueryGetItemRequest, opts ...grpc.CallOption) (*QueryGetItemResponse, error) {
+	out := new(QueryGetItemResponse)
+	err := c.cc.Invoke(ctx, "/pylons.pylons.Query/Item", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func (m *QueryListRecipesByCookbookResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Pagination != nil {
-		{
-			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.Recipes) > 0 {
-		for iNdEx := len(m.Recipes) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Recipes[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintQuery(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0xa
-		}
+func (c *queryClient) Recipe(ctx context.Context, in *QueryGetRecipeRequest, opts ...grpc.CallOption) (*QueryGetRecipeResponse, error) {
+	out := new(QueryGetRecipeResponse)
+	err := c.cc.Invoke(ctx, "/pylons.pylons.Query/Recipe", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return len(dAtA) - i, nil
+	return out, nil
 }
 
-func (m *QueryGetItemRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *queryClient) ListCookbooksByCreator(ctx context.Context, in *QueryListCookbooksByCreatorRequest, opts ...grpc.CallOption) (*QueryListCookbooksByCreatorResponse, error) {
+	out := new(QueryListCookbooksByCreatorResponse)
+	err := c.cc.Invoke(ctx, "/pylons.pylons.Query/ListCookbooksByCreator", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	return out, nil
 }
 
-func (m *QueryGetItemRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (c *queryClient) Cookbook(ctx context.Context, in *QueryGetCookbookRequest, opts ...grpc.CallOption) (*QueryGetCookbookResponse, error) {
+	out := new(QueryGetCookbookResponse)
+	err := c.cc.Invoke(ctx, "/pylons.pylons.Query/Cookbook", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func (m *QueryGetItemRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Id) > 0 {
-		i -= len(m.Id)
-		copy(dAtA[i:], m.Id)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.Id)))
-		i--
-		dAtA[i] = 0x1a
-	}
-	if len(m.CookbookId) > 0 {
-		i -= len(m.CookbookId)
-		copy(dAtA[i:], m.CookbookId)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.CookbookId)))
-		i--
-		dAtA[i] = 0xa
+func (c *queryClient) RecipeStats(ctx context.Context, in *QueryRecipeStatsRequest, opts ...grpc.CallOption) (*QueryRecipeStatsResponse, error) {
+	out := new(QueryRecipeStatsResponse)
+	err := c.cc.Invoke(ctx, "/pylons.pylons.Query/RecipeStats", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return len(dAtA) - i, nil
+	return out, nil
 }
 
-func (m *QueryGetItemResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *queryClient) CookbookStats(ctx context.Context, in *QueryCookbookStatsRequest, opts ...grpc.CallOption) (*QueryCookbookStatsResponse, error) {
+	out := new(QueryCookbookStatsResponse)
+	err := c.cc.Invoke(ctx, "/pylons.pylons.Query/CookbookStats", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	return out, nil
 }
 
-func (m *QueryGetItemResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (c *queryClient) Lending(ctx context.Context, in *QueryGetLendingRequest, opts ...grpc.CallOption) (*QueryGetLendingResponse, error) {
+	out := new(QueryGetLendingResponse)
+	err := c.cc.Invoke(ctx, "/pylons.pylons.Query/Lending", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func (m *QueryGetItemResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size, err := m.Item.MarshalToSizedBuffer(dAtA[:i])
-		if err != nil {
-			return 0, err
-		}
-		i -= size
-		i = encodeVarintQuery(dAtA, i, uint64(size))
+func (c *queryClient) Auction(ctx context.Context, in *QueryGetAuctionRequest, opts ...grpc.CallOption) (*QueryGetAuctionResponse, error) {
+	out := new(QueryGetAuctionResponse)
+	err := c.cc.Invoke(ctx, "/pylons.pylons.Query/Auction", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	i--
-	dAtA[i] = 0xa
-	return len(dAtA) - i, nil
+	return out, nil
 }
 
-func (m *QueryGetRecipeRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *queryClient) DutchAuction(ctx context.Context, in *QueryGetDutchAuctionRequest, opts ...grpc.CallOption) (*QueryGetDutchAuctionResponse, error) {
+	out := new(QueryGetDutchAuctionResponse)
+	err := c.cc.Invoke(ctx, "/pylons.pylons.Query/DutchAuction", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	return out, nil
 }
 
-func (m *QueryGetRecipeRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (c *queryClient) ItemOffer(ctx context.Context, in *QueryGetItemOfferRequest, opts ...grpc.CallOption) (*QueryGetItemOfferResponse, error) {
+	out := new(QueryGetItemOfferResponse)
+	err := c.cc.Invoke(ctx, "/pylons.pylons.Query/ItemOffer", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func (m *QueryGetRecipeRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Id) > 0 {
-		i -= len(m.Id)
-		copy(dAtA[i:], m.Id)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.Id)))
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.CookbookId) > 0 {
-		i -= len(m.CookbookId)
-		copy(dAtA[i:], m.CookbookId)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.CookbookId)))
-		i--
-		dAtA[i] = 0xa
+func (c *queryClient) Swap(ctx context.Context, in *QueryGetSwapRequest, opts ...grpc.CallOption) (*QueryGetSwapResponse, error) {
+	out := new(QueryGetSwapResponse)
+	err := c.cc.Invoke(ctx, "/pylons.pylons.Query/Swap", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return len(dAtA) - i, nil
+	return out, nil
 }
 
-func (m *QueryGetRecipeResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *queryClient) ListItemsByCookbook(ctx context.Context, in *QueryListItemsByCookbookRequest, opts ...grpc.CallOption) (*QueryListItemsByCookbookResponse, error) {
+	out := new(QueryListItemsByCookbookResponse)
+	err := c.cc.Invoke(ctx, "/pylons.pylons.Query/ListItemsByCookbook", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
-}
-
-func (m *QueryGetRecipeResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+	return out, nil
 }
 
-func (m *QueryGetRecipeResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size, err := m.Recipe.MarshalToSizedBuffer(dAtA[:i])
-		if err != nil {
-			return 0, err
-		}
-		i -= size
-		i = encodeVarintQuery(dAtA, i, uint64(size))
+func (c *queryClient) ListItemsByRecipe(ctx context.Context, in *QueryListItemsByRecipeRequest, opts ...grpc.CallOption) (*QueryListItemsByRecipeResponse, error) {
+	out := new(QueryListItemsByRecipeResponse)
+	err := c.cc.Invoke(ctx, "/pylons.pylons.Query/ListItemsByRecipe", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	i--
-	dAtA[i] = 0xa
-	return len(dAtA) - i, nil
+	return out, nil
 }
 
-func (m *QueryListCookbooksByCreatorRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *queryClient) SearchItems(ctx context.Context, in *QuerySearchItemsRequest, opts ...grpc.CallOption) (*QuerySearchItemsResponse, error) {
+	out := new(QuerySearchItemsResponse)
+	err := c.cc.Invoke(ctx, "/pylons.pylons.Query/SearchItems", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	return out, nil
 }
 
-func (m *QueryListCookbooksByCreatorRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// QueryServer is the server API for Query service.
+type QueryServer interface {
+	// Queries a list of listTradesByCreator items.
+	ListTradesByCreator(context.Context, *QueryListTradesByCreatorRequest) (*QueryListTradesByCreatorResponse, error)
+	// Queries the open trades matching a set of filters.
+	ListTrades(context.Context, *QueryListTradesRequest) (*QueryListTradesResponse, error)
+	// Queries the coins paid and received by the parties of a trade fulfillment, with the royalties and chain fees.
+	QuoteFulfillTrade(context.Context, *QueryQuoteFulfillTradeRequest) (*QueryQuoteFulfillTradeResponse, error)
+	// Queries the private trades that can be fulfilled by an address.
+	ListTradesByReceiver(context.Context, *QueryListTradesByReceiverRequest) (*QueryListTradesByReceiverResponse, error)
+	// Queries a list of Signup by Referee Address items.
+	ListSignUpByReferee(context.Context, *QueryListSignUpByReferee) (*QueryListSignUpByRefereeResponse, error)
+	// Queries a list of GetRecipeHistory items.
+	GetRecipeHistory(context.Context, *QueryGetRecipeHistoryRequest) (*QueryGetRecipeHistoryResponse, error)
+	// Queries a list of GetStripeRefund items.
+	GetStripeRefund(context.Context, *QueryGetStripeRefundRequest) (*QueryGetStripeRefundResponse, error)
+	// Retrieves an item history by id.
+	GetItemOwnershipHistory(context.Context, *QueryGetItemHistoryRequest) (*QueryGetItemHistoryResponse, error)
+	// Retrieves the attribute updates of an item.
+	GetItemAttributesHistory(context.Context, *QueryGetItemAttributesHistoryRequest) (*QueryGetItemAttributesHistoryResponse, error)
+	// Retrieves the provenance of an item, its mints, modifications, transfers, locks and burn, oldest first.
+	GetItemProvenance(context.Context, *QueryGetItemProvenanceRequest) (*QueryGetItemProvenanceResponse, error)
+	// Queries a redeemInfo by index.
+	RedeemInfo(context.Context, *QueryGetRedeemInfoRequest) (*QueryGetRedeemInfoResponse, error)
+	// Queries a list of redeemInfo items.
+	RedeemInfoAll(context.Context, *QueryAllRedeemInfoRequest) (*QueryAllRedeemInfoResponse, error)
+	// Queries a paymentInfo by index.
+	PaymentInfo(context.Context, *QueryGetPaymentInfoRequest) (*QueryGetPaymentInfoResponse, error)
+	// Queries a list of paymentInfo items.
+	PaymentInfoAll(context.Context, *QueryAllPaymentInfoRequest) (*QueryAllPaymentInfoResponse, error)
+	// Queries a list of getAccountByAddress items.
+	UsernameByAddress(context.Context, *QueryGetUsernameByAddressRequest) (*QueryGetUsernameByAddressResponse, error)
+	// Queries a username by account.
+	AddressByUsername(context.Context, *QueryGetAddressByUsernameRequest) (*QueryGetAddressByUsernameResponse, error)
+	// Queries a trade by id.
+	Trade(context.Context, *QueryGetTradeRequest) (*QueryGetTradeResponse, error)
+	// Queries a list of listItemByOwner items.
+	ListItemByOwner(context.Context, *QueryListItemByOwnerRequest) (*QueryListItemByOwnerResponse, error)
+	// Queries a googleIAPOrder by purchase_token.
+	GoogleInAppPurchaseOrder(context.Context, *QueryGetGoogleInAppPurchaseOrderRequest) (*QueryGetGoogleInAppPurchaseOrderResponse, error)
+	// Queries a list of listExecutionsByItem items.
+	ListExecutionsByItem(context.Context, *QueryListExecutionsByItemRequest) (*QueryListExecutionsByItemResponse, error)
+	// Queries a list of listExecutionsByRecipe items.
+	ListExecutionsByRecipe(context.Context, *QueryListExecutionsByRecipeRequest) (*QueryListExecutionsByRecipeResponse, error)
+	// Queries a execution by id.
+	Execution(context.Context, *QueryGetExecutionRequest) (*QueryGetExecutionResponse, error)
+	// Queries a list of listRecipesByCookbook items.
+	ListRecipesByCookbook(context.Context, *QueryListRecipesByCookbookRequest) (*QueryListRecipesByCookbookResponse, error)
+	// Queries a item by id.
+	Item(context.Context, *QueryGetItemRequest) (*QueryGetItemResponse, error)
+	// Retrieves a recipe by id.
+	Recipe(context.Context, *QueryGetRecipeRequest) (*QueryGetRecipeResponse, error)
+	// Retrieves the list of cookbooks owned by an address
+	ListCookbooksByCreator(context.Context, *QueryListCookbooksByCreatorRequest) (*QueryListCookbooksByCreatorResponse, error)
+	// Retrieves a cookbook by id.
+	Cookbook(context.Context, *QueryGetCookbookRequest) (*QueryGetCookbookResponse, error)
+	// Retrieves the aggregated execution statistics of a recipe.
+	RecipeStats(context.Context, *QueryRecipeStatsRequest) (*QueryRecipeStatsResponse, error)
+	// Retrieves the aggregated execution statistics of a cookbook.
+	CookbookStats(context.Context, *QueryCookbookStatsRequest) (*QueryCookbookStatsResponse, error)
+	// Queries a lending by id.
+	Lending(context.Context, *QueryGetLendingRequest) (*QueryGetLendingResponse, error)
+	// Queries an auction by id.
+	Auction(context.Context, *QueryGetAuctionRequest) (*QueryGetAuctionResponse, error)
+	// Queries a dutch auction and its current price by id.
+	DutchAuction(context.Context, *QueryGetDutchAuctionRequest) (*QueryGetDutchAuctionResponse, error)
+	// Queries an item offer by id.
+	ItemOffer(context.Context, *QueryGetItemOfferRequest) (*QueryGetItemOfferResponse, error)
+	// Queries a swap by id.
+	Swap(context.Context, *QueryGetSwapRequest) (*QueryGetSwapResponse, error)
+	// Queries a list of items of a cookbook.
+	ListItemsByCookbook(context.Context, *QueryListItemsByCookbookRequest) (*QueryListItemsByCookbookResponse, error)
+	// Queries a list of items created by a recipe.
+	ListItemsByRecipe(context.Context, *QueryListItemsByRecipeRequest) (*QueryListItemsByRecipeResponse, error)
+	// Searches the items of a cookbook by attributes, owner, tradeable flag and recipe.
+	SearchItems(context.Context, *QuerySearchItemsRequest) (*QuerySearchItemsResponse, error)
 }
 
-func (m *QueryListCookbooksByCreatorRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Pagination != nil {
-		{
-			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x1a
-	}
-	if len(m.Creator) > 0 {
-		i -= len(m.Creator)
-		copy(dAtA[i:], m.Creator)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.Creator)))
-		i--
-		dAtA[i] = 0xa
-	}
-	return len(dAtA) - i, nil
+// UnimplementedQueryServer can be embedded to have forward compatible implementations.
+type UnimplementedQueryServer struct {
 }
 
-func (m *QueryListCookbooksByCreatorResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func (*UnimplementedQueryServer) ListTradesByCreator(ctx context.Context, req *QueryListTradesByCreatorRequest) (*QueryListTradesByCreatorResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListTradesByCreator not implemented")
 }
-
-func (m *QueryListCookbooksByCreatorResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (*UnimplementedQueryServer) ListTrades(ctx context.Context, req *QueryListTradesRequest) (*QueryListTradesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListTrades not implemented")
 }
-
-func (m *QueryListCookbooksByCreatorResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Pagination != nil {
-		{
-			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x1a
-	}
-	if len(m.Cookbooks) > 0 {
-		for iNdEx := len(m.Cookbooks) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Cookbooks[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintQuery(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0xa
-		}
-	}
-	return len(dAtA) - i, nil
+func (*UnimplementedQueryServer) QuoteFulfillTrade(ctx context.Context, req *QueryQuoteFulfillTradeRequest) (*QueryQuoteFulfillTradeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method QuoteFulfillTrade not implemented")
 }
-
-func (m *QueryGetCookbookRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func (*UnimplementedQueryServer) ListTradesByReceiver(ctx context.Context, req *QueryListTradesByReceiverRequest) (*QueryListTradesByReceiverResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListTradesByReceiver not implemented")
 }
-
-func (m *QueryGetCookbookRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (*UnimplementedQueryServer) ListSignUpByReferee(ctx context.Context, req *QueryListSignUpByReferee) (*QueryListSignUpByRefereeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListSignUpByReferee not implemented")
 }
-
-func (m *QueryGetCookbookRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Id) > 0 {
-		i -= len(m.Id)
-		copy(dAtA[i:], m.Id)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.Id)))
-		i--
-		dAtA[i] = 0xa
-	}
-	return len(dAtA) - i, nil
+func (*UnimplementedQueryServer) GetRecipeHistory(ctx context.Context, req *QueryGetRecipeHistoryRequest) (*QueryGetRecipeHistoryResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetRecipeHistory not implemented")
 }
-
-func (m *QueryGetCookbookResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func (*UnimplementedQueryServer) GetStripeRefund(ctx context.Context, req *QueryGetStripeRefundRequest) (*QueryGetStripeRefundResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetStripeRefund not implemented")
 }
-
-func (m *QueryGetCookbookResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (*UnimplementedQueryServer) GetItemOwnershipHistory(ctx context.Context, req *QueryGetItemHistoryRequest) (*QueryGetItemHistoryResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetItemOwnershipHistory not implemented")
 }
-
-func (m *QueryGetCookbookResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size, err := m.Cookbook.MarshalToSizedBuffer(dAtA[:i])
-		if err != nil {
-			return 0, err
-		}
-		i -= size
-		i = encodeVarintQuery(dAtA, i, uint64(size))
-	}
-	i--
-	dAtA[i] = 0xa
-	return len(dAtA) - i, nil
+func (*UnimplementedQueryServer) GetItemAttributesHistory(ctx context.Context, req *QueryGetItemAttributesHistoryRequest) (*QueryGetItemAttributesHistoryResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetItemAttributesHistory not implemented")
 }
-
-func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
-	offset -= sovQuery(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
-	}
-	dAtA[offset] = uint8(v)
-	return base
+func (*UnimplementedQueryServer) GetItemProvenance(ctx context.Context, req *QueryGetItemProvenanceRequest) (*QueryGetItemProvenanceResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetItemProvenance not implemented")
 }
-func (m *QueryListSignUpByReferee) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Creator)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	return n
+func (*UnimplementedQueryServer) RedeemInfo(ctx context.Context, req *QueryGetRedeemInfoRequest) (*QueryGetRedeemInfoResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RedeemInfo not implemented")
+}
+func (*UnimplementedQueryServer) RedeemInfoAll(ctx context.Context, req *QueryAllRedeemInfoRequest) (*QueryAllRedeemInfoResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RedeemInfoAll not implemented")
+}
+func (*UnimplementedQueryServer) PaymentInfo(ctx context.Context, req *QueryGetPaymentInfoRequest) (*QueryGetPaymentInfoResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PaymentInfo not implemented")
+}
+func (*UnimplementedQueryServer) PaymentInfoAll(ctx context.Context, req *QueryAllPaymentInfoRequest) (*QueryAllPaymentInfoResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PaymentInfoAll not implemented")
+}
+func (*UnimplementedQueryServer) UsernameByAddress(ctx context.Context, req *QueryGetUsernameByAddressRequest) (*QueryGetUsernameByAddressResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UsernameByAddress not implemented")
+}
+func (*UnimplementedQueryServer) AddressByUsername(ctx context.Context, req *QueryGetAddressByUsernameRequest) (*QueryGetAddressByUsernameResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AddressByUsername not implemented")
+}
+func (*UnimplementedQueryServer) Trade(ctx context.Context, req *QueryGetTradeRequest) (*QueryGetTradeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Trade not implemented")
+}
+func (*UnimplementedQueryServer) ListItemByOwner(ctx context.Context, req *QueryListItemByOwnerRequest) (*QueryListItemByOwnerResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListItemByOwner not implemented")
+}
+func (*UnimplementedQueryServer) GoogleInAppPurchaseOrder(ctx context.Context, req *QueryGetGoogleInAppPurchaseOrderRequest) (*QueryGetGoogleInAppPurchaseOrderResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GoogleInAppPurchaseOrder not implemented")
+}
+func (*UnimplementedQueryServer) ListExecutionsByItem(ctx context.Context, req *QueryListExecutionsByItemRequest) (*QueryListExecutionsByItemResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListExecutionsByItem not implemented")
+}
+func (*UnimplementedQueryServer) ListExecutionsByRecipe(ctx context.Context, req *QueryListExecutionsByRecipeRequest) (*QueryListExecutionsByRecipeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListExecutionsByRecipe not implemented")
+}
+func (*UnimplementedQueryServer) Execution(ctx context.Context, req *QueryGetExecutionRequest) (*QueryGetExecutionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Execution not implemented")
+}
+func (*UnimplementedQueryServer) ListRecipesByCookbook(ctx context.Context, req *QueryListRecipesByCookbookRequest) (*QueryListRecipesByCookbookResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListRecipesByCookbook not implemented")
+}
+func (*UnimplementedQueryServer) Item(ctx context.Context, req *QueryGetItemRequest) (*QueryGetItemResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Item not implemented")
+}
+func (*UnimplementedQueryServer) Recipe(ctx context.Context, req *QueryGetRecipeRequest) (*QueryGetRecipeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Recipe not implemented")
+}
+func (*UnimplementedQueryServer) ListCookbooksByCreator(ctx context.Context, req *QueryListCookbooksByCreatorRequest) (*QueryListCookbooksByCreatorResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListCookbooksByCreator not implemented")
+}
+func (*UnimplementedQueryServer) Cookbook(ctx context.Context, req *QueryGetCookbookRequest) (*QueryGetCookbookResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Cookbook not implemented")
+}
+func (*UnimplementedQueryServer) RecipeStats(ctx context.Context, req *QueryRecipeStatsRequest) (*QueryRecipeStatsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RecipeStats not implemented")
+}
+func (*UnimplementedQueryServer) CookbookStats(ctx context.Context, req *QueryCookbookStatsRequest) (*QueryCookbookStatsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CookbookStats not implemented")
+}
+func (*UnimplementedQueryServer) Lending(ctx context.Context, req *QueryGetLendingRequest) (*QueryGetLendingResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Lending not implemented")
+}
+func (*UnimplementedQueryServer) Auction(ctx context.Context, req *QueryGetAuctionRequest) (*QueryGetAuctionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Auction not implemented")
+}
+func (*UnimplementedQueryServer) DutchAuction(ctx context.Context, req *QueryGetDutchAuctionRequest) (*QueryGetDutchAuctionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DutchAuction not implemented")
+}
+func (*UnimplementedQueryServer) ItemOffer(ctx context.Context, req *QueryGetItemOfferRequest) (*QueryGetItemOfferResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ItemOffer not implemented")
+}
+func (*UnimplementedQueryServer) Swap(ctx context.Context, req *QueryGetSwapRequest) (*QueryGetSwapResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Swap not implemented")
+}
+func (*UnimplementedQueryServer) ListItemsByCookbook(ctx context.Context, req *QueryListItemsByCookbookRequest) (*QueryListItemsByCookbookResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListItemsByCookbook not implemented")
+}
+func (*UnimplementedQueryServer) ListItemsByRecipe(ctx context.Context, req *QueryListItemsByRecipeRequest) (*QueryListItemsByRecipeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListItemsByRecipe not implemented")
+}
+func (*UnimplementedQueryServer) SearchItems(ctx context.Context, req *QuerySearchItemsRequest) (*QuerySearchItemsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SearchItems not implemented")
 }
 
-func (m *QueryListSignUpByRefereeResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.Signup != nil {
-		l = m.Signup.Size()
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	return n
+func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
+	s.RegisterService(&_Query_serviceDesc, srv)
 }
 
-func (m *QueryListTradesByCreatorRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func _Query_ListTradesByCreator_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryListTradesByCreatorRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	l = len(m.Creator)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+	if interceptor == nil {
+		return srv.(QueryServer).ListTradesByCreator(ctx, in)
 	}
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 1 + l + sovQuery(uint64(l))
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pylons.pylons.Query/ListTradesByCreator",
 	}
-	return n
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ListTradesByCreator(ctx, req.(*QueryListTradesByCreatorRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryListTradesByCreatorResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func _Query_ListTrades_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryListTradesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	if len(m.Trades) > 0 {
-		for _, e := range m.Trades {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
-		}
+	if interceptor == nil {
+		return srv.(QueryServer).ListTrades(ctx, in)
 	}
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 1 + l + sovQuery(uint64(l))
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pylons.pylons.Query/ListTrades",
 	}
-	return n
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ListTrades(ctx, req.(*QueryListTradesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryGetItemHistoryRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func _Query_QuoteFulfillTrade_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryQuoteFulfillTradeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	l = len(m.CookbookId)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+	if interceptor == nil {
+		return srv.(QueryServer).QuoteFulfillTrade(ctx, in)
 	}
-	l = len(m.ItemId)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pylons.pylons.Query/QuoteFulfillTrade",
 	}
-	l = len(m.MintedNumber)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).QuoteFulfillTrade(ctx, req.(*QueryQuoteFulfillTradeRequest))
 	}
-	return n
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryGetItemHistoryResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func _Query_ListTradesByReceiver_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryListTradesByReceiverRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	if len(m.History) > 0 {
-		for _, e := range m.History {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
-		}
+	if interceptor == nil {
+		return srv.(QueryServer).ListTradesByReceiver(ctx, in)
 	}
-	return n
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pylons.pylons.Query/ListTradesByReceiver",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ListTradesByReceiver(ctx, req.(*QueryListTradesByReceiverRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryGetRecipeHistoryRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func _Query_ListSignUpByReferee_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryListSignUpByReferee)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	l = len(m.CookbookId)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+	if interceptor == nil {
+		return srv.(QueryServer).ListSignUpByReferee(ctx, in)
 	}
-	l = len(m.RecipeId)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pylons.pylons.Query/ListSignUpByReferee",
 	}
-	return n
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ListSignUpByReferee(ctx, req.(*QueryListSignUpByReferee))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryGetRecipeHistoryResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func _Query_GetRecipeHistory_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryGetRecipeHistoryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	if len(m.History) > 0 {
-		for _, e := range m.History {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
-		}
+	if interceptor == nil {
+		return srv.(QueryServer).GetRecipeHistory(ctx, in)
 	}
-	return n
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pylons.pylons.Query/GetRecipeHistory",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).GetRecipeHistory(ctx, req.(*QueryGetRecipeHistoryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *RecipeHistory) Size() (n int) {
-	if m == nil {
-		return 0
+func _Query_GetStripeRefund_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryGetStripeRefundRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	l = len(m.ItemId)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+	if interceptor == nil {
+		return srv.(QueryServer).GetStripeRefund(ctx, in)
 	}
-	l = len(m.CookbookId)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pylons.pylons.Query/GetStripeRefund",
 	}
-	l = len(m.RecipeId)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).GetStripeRefund(ctx, req.(*QueryGetStripeRefundRequest))
 	}
-	l = len(m.Sender)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_GetItemOwnershipHistory_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryGetItemHistoryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	l = len(m.SenderName)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	l = len(m.Receiver)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+	if interceptor == nil {
+		return srv.(QueryServer).GetItemOwnershipHistory(ctx, in)
 	}
-	l = len(m.Amount)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pylons.pylons.Query/GetItemOwnershipHistory",
 	}
-	if m.CreatedAt != 0 {
-		n += 1 + sovQuery(uint64(m.CreatedAt))
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).GetItemOwnershipHistory(ctx, req.(*QueryGetItemHistoryRequest))
 	}
-	return n
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryGetStripeRefundRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func _Query_GetItemAttributesHistory_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryGetItemAttributesHistoryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	return n
-}
-
-func (m *QueryGetStripeRefundResponse) Size() (n int) {
-	if m == nil {
-		return 0
+	if interceptor == nil {
+		return srv.(QueryServer).GetItemAttributesHistory(ctx, in)
 	}
-	var l int
-	_ = l
-	if len(m.Refunds) > 0 {
-		for _, e := range m.Refunds {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
-		}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pylons.pylons.Query/GetItemAttributesHistory",
 	}
-	return n
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).GetItemAttributesHistory(ctx, req.(*QueryGetItemAttributesHistoryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryGetRedeemInfoRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func _Query_GetItemProvenance_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryGetItemProvenanceRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	l = len(m.Id)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+	if interceptor == nil {
+		return srv.(QueryServer).GetItemProvenance(ctx, in)
 	}
-	return n
-}
-
-func (m *QueryGetRedeemInfoResponse) Size() (n int) {
-	if m == nil {
-		return 0
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pylons.pylons.Query/GetItemProvenance",
 	}
-	var l int
-	_ = l
-	l = m.RedeemInfo.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	return n
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).GetItemProvenance(ctx, req.(*QueryGetItemProvenanceRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryAllRedeemInfoRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func _Query_RedeemInfo_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryGetRedeemInfoRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 1 + l + sovQuery(uint64(l))
+	if interceptor == nil {
+		return srv.(QueryServer).RedeemInfo(ctx, in)
 	}
-	return n
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pylons.pylons.Query/RedeemInfo",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).RedeemInfo(ctx, req.(*QueryGetRedeemInfoRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryAllRedeemInfoResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func _Query_RedeemInfoAll_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryAllRedeemInfoRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	if len(m.RedeemInfo) > 0 {
-		for _, e := range m.RedeemInfo {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
-		}
+	if interceptor == nil {
+		return srv.(QueryServer).RedeemInfoAll(ctx, in)
 	}
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 1 + l + sovQuery(uint64(l))
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pylons.pylons.Query/RedeemInfoAll",
 	}
-	return n
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).RedeemInfoAll(ctx, req.(*QueryAllRedeemInfoRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryGetPaymentInfoRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func _Query_PaymentInfo_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryGetPaymentInfoRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	l = len(m.PurchaseId)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+	if interceptor == nil {
+		return srv.(QueryServer).PaymentInfo(ctx, in)
 	}
-	return n
-}
-
-func (m *QueryGetPaymentInfoResponse) Size() (n int) {
-	if m == nil {
-		return 0
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pylons.pylons.Query/PaymentInfo",
 	}
-	var l int
-	_ = l
-	l = m.PaymentInfo.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	return n
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).PaymentInfo(ctx, req.(*QueryGetPaymentInfoRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryAllPaymentInfoRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func _Query_PaymentInfoAll_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryAllPaymentInfoRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 1 + l + sovQuery(uint64(l))
+	if interceptor == nil {
+		return srv.(QueryServer).PaymentInfoAll(ctx, in)
 	}
-	return n
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pylons.pylons.Query/PaymentInfoAll",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).PaymentInfoAll(ctx, req.(*QueryAllPaymentInfoRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryAllPaymentInfoResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func _Query_UsernameByAddress_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryGetUsernameByAddressRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	if len(m.PaymentInfo) > 0 {
-		for _, e := range m.PaymentInfo {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
-		}
+	if interceptor == nil {
+		return srv.(QueryServer).UsernameByAddress(ctx, in)
 	}
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 1 + l + sovQuery(uint64(l))
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pylons.pylons.Query/UsernameByAddress",
 	}
-	return n
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).UsernameByAddress(ctx, req.(*QueryGetUsernameByAddressRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryGetUsernameByAddressRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func _Query_AddressByUsername_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryGetAddressByUsernameRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	l = len(m.Address)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+	if interceptor == nil {
+		return srv.(QueryServer).AddressByUsername(ctx, in)
 	}
-	return n
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pylons.pylons.Query/AddressByUsername",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).AddressByUsername(ctx, req.(*QueryGetAddressByUsernameRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryGetAddressByUsernameRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func _Query_Trade_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryGetTradeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	l = len(m.Username)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+	if interceptor == nil {
+		return srv.(QueryServer).Trade(ctx, in)
 	}
-	return n
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pylons.pylons.Query/Trade",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Trade(ctx, req.(*QueryGetTradeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryGetUsernameByAddressResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func _Query_ListItemByOwner_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryListItemByOwnerRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	l = m.Username.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	return n
-}
-
-func (m *QueryGetAddressByUsernameResponse) Size() (n int) {
-	if m == nil {
-		return 0
+	if interceptor == nil {
+		return srv.(QueryServer).ListItemByOwner(ctx, in)
 	}
-	var l int
-	_ = l
-	l = m.Address.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	return n
-}
-
-func (m *QueryGetTradeRequest) Size() (n int) {
-	if m == nil {
-		return 0
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pylons.pylons.Query/ListItemByOwner",
 	}
-	var l int
-	_ = l
-	if m.Id != 0 {
-		n += 1 + sovQuery(uint64(m.Id))
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ListItemByOwner(ctx, req.(*QueryListItemByOwnerRequest))
 	}
-	return n
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryGetTradeResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func _Query_GoogleInAppPurchaseOrder_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryGetGoogleInAppPurchaseOrderRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	l = m.Trade.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	return n
-}
-
-func (m *QueryListItemByOwnerRequest) Size() (n int) {
-	if m == nil {
-		return 0
+	if interceptor == nil {
+		return srv.(QueryServer).GoogleInAppPurchaseOrder(ctx, in)
 	}
-	var l int
-	_ = l
-	l = len(m.Owner)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pylons.pylons.Query/GoogleInAppPurchaseOrder",
 	}
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 1 + l + sovQuery(uint64(l))
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).GoogleInAppPurchaseOrder(ctx, req.(*QueryGetGoogleInAppPurchaseOrderRequest))
 	}
-	return n
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryListItemByOwnerResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func _Query_ListExecutionsByItem_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryListExecutionsByItemRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	if len(m.Items) > 0 {
-		for _, e := range m.Items {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
-		}
+	if interceptor == nil {
+		return srv.(QueryServer).ListExecutionsByItem(ctx, in)
 	}
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 1 + l + sovQuery(uint64(l))
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pylons.pylons.Query/ListExecutionsByItem",
 	}
-	return n
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ListExecutionsByItem(ctx, req.(*QueryListExecutionsByItemRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryGetGoogleInAppPurchaseOrderRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func _Query_ListExecutionsByRecipe_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryListExecutionsByRecipeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	l = len(m.PurchaseToken)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+	if interceptor == nil {
+		return srv.(QueryServer).ListExecutionsByRecipe(ctx, in)
 	}
-	return n
-}
-
-func (m *QueryGetGoogleInAppPurchaseOrderResponse) Size() (n int) {
-	if m == nil {
-		return 0
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pylons.pylons.Query/ListExecutionsByRecipe",
 	}
-	var l int
-	_ = l
-	l = m.Order.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	return n
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ListExecutionsByRecipe(ctx, req.(*QueryListExecutionsByRecipeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryListExecutionsByItemRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func _Query_Execution_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryGetExecutionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	l = len(m.CookbookId)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+	if interceptor == nil {
+		return srv.(QueryServer).Execution(ctx, in)
 	}
-	l = len(m.ItemId)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pylons.pylons.Query/Execution",
 	}
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 1 + l + sovQuery(uint64(l))
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Execution(ctx, req.(*QueryGetExecutionRequest))
 	}
-	return n
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryListExecutionsByItemResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func _Query_ListRecipesByCookbook_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryListRecipesByCookbookRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	if len(m.CompletedExecutions) > 0 {
-		for _, e := range m.CompletedExecutions {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
-		}
+	if interceptor == nil {
+		return srv.(QueryServer).ListRecipesByCookbook(ctx, in)
 	}
-	if len(m.PendingExecutions) > 0 {
-		for _, e := range m.PendingExecutions {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
-		}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pylons.pylons.Query/ListRecipesByCookbook",
 	}
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 1 + l + sovQuery(uint64(l))
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ListRecipesByCookbook(ctx, req.(*QueryListRecipesByCookbookRequest))
 	}
-	return n
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryListExecutionsByRecipeRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func _Query_Item_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryGetItemRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	l = len(m.CookbookId)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+	if interceptor == nil {
+		return srv.(QueryServer).Item(ctx, in)
 	}
-	l = len(m.RecipeId)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pylons.pylons.Query/Item",
 	}
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 1 + l + sovQuery(uint64(l))
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Item(ctx, req.(*QueryGetItemRequest))
 	}
-	return n
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryListExecutionsByRecipeResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func _Query_Recipe_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryGetRecipeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	if len(m.CompletedExecutions) > 0 {
-		for _, e := range m.CompletedExecutions {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
-		}
+	if interceptor == nil {
+		return srv.(QueryServer).Recipe(ctx, in)
 	}
-	if len(m.PendingExecutions) > 0 {
-		for _, e := range m.PendingExecutions {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
-		}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pylons.pylons.Query/Recipe",
 	}
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 1 + l + sovQuery(uint64(l))
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Recipe(ctx, req.(*QueryGetRecipeRequest))
 	}
-	return n
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryGetExecutionRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func _Query_ListCookbooksByCreator_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryListCookbooksByCreatorRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	l = len(m.Id)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+	if interceptor == nil {
+		return srv.(QueryServer).ListCookbooksByCreator(ctx, in)
 	}
-	return n
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pylons.pylons.Query/ListCookbooksByCreator",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ListCookbooksByCreator(ctx, req.(*QueryListCookbooksByCreatorRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryGetExecutionResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func _Query_Cookbook_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryGetCookbookRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	l = m.Execution.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	if m.Completed {
-		n += 2
+	if interceptor == nil {
+		return srv.(QueryServer).Cookbook(ctx, in)
 	}
-	return n
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pylons.pylons.Query/Cookbook",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Cookbook(ctx, req.(*QueryGetCookbookRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryListRecipesByCookbookRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func _Query_RecipeStats_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryRecipeStatsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	l = len(m.CookbookId)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+	if interceptor == nil {
+		return srv.(QueryServer).RecipeStats(ctx, in)
 	}
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 1 + l + sovQuery(uint64(l))
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pylons.pylons.Query/RecipeStats",
 	}
-	return n
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).RecipeStats(ctx, req.(*QueryRecipeStatsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryListRecipesByCookbookResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func _Query_CookbookStats_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryCookbookStatsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	if len(m.Recipes) > 0 {
-		for _, e := range m.Recipes {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
-		}
+	if interceptor == nil {
+		return srv.(QueryServer).CookbookStats(ctx, in)
 	}
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 1 + l + sovQuery(uint64(l))
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pylons.pylons.Query/CookbookStats",
 	}
-	return n
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).CookbookStats(ctx, req.(*QueryCookbookStatsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryGetItemRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func _Query_Lending_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryGetLendingRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	l = len(m.CookbookId)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+	if interceptor == nil {
+		return srv.(QueryServer).Lending(ctx, in)
 	}
-	l = len(m.Id)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pylons.pylons.Query/Lending",
 	}
-	return n
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Lending(ctx, req.(*QueryGetLendingRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryGetItemResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func _Query_Auction_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryGetAuctionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	l = m.Item.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	return n
+	if interceptor == nil {
+		return srv.(QueryServer).Auction(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pylons.pylons.Query/Auction",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Auction(ctx, req.(*QueryGetAuctionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryGetRecipeRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func _Query_DutchAuction_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryGetDutchAuctionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	l = len(m.CookbookId)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+	if interceptor == nil {
+		return srv.(QueryServer).DutchAuction(ctx, in)
 	}
-	l = len(m.Id)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pylons.pylons.Query/DutchAuction",
 	}
-	return n
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).DutchAuction(ctx, req.(*QueryGetDutchAuctionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryGetRecipeResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func _Query_ItemOffer_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryGetItemOfferRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	l = m.Recipe.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	return n
+	if interceptor == nil {
+		return srv.(QueryServer).ItemOffer(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pylons.pylons.Query/ItemOffer",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ItemOffer(ctx, req.(*QueryGetItemOfferRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryListCookbooksByCreatorRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func _Query_Swap_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryGetSwapRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	l = len(m.Creator)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+	if interceptor == nil {
+		return srv.(QueryServer).Swap(ctx, in)
 	}
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 1 + l + sovQuery(uint64(l))
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pylons.pylons.Query/Swap",
 	}
-	return n
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Swap(ctx, req.(*QueryGetSwapRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryListCookbooksByCreatorResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func _Query_ListItemsByCookbook_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryListItemsByCookbookRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	if len(m.Cookbooks) > 0 {
-		for _, e := range m.Cookbooks {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
-		}
+	if interceptor == nil {
+		return srv.(QueryServer).ListItemsByCookbook(ctx, in)
 	}
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 1 + l + sovQuery(uint64(l))
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pylons.pylons.Query/ListItemsByCookbook",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ListItemsByCookbook(ctx, req.(*QueryListItemsByCookbookRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_ListItemsByRecipe_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryListItemsByRecipeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).ListItemsByRecipe(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pylons.pylons.Query/ListItemsByRecipe",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ListItemsByRecipe(ctx, req.(*QueryListItemsByRecipeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_SearchItems_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QuerySearchItemsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).SearchItems(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pylons.pylons.Query/SearchItems",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).SearchItems(ctx, req.(*QuerySearchItemsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var _Query_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "pylons.pylons.Query",
+	HandlerType: (*QueryServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "ListTradesByCreator",
+			Handler:    _Query_ListTradesByCreator_Handler,
+		},
+		{
+			MethodName: "ListTrades",
+			Handler:    _Query_ListTrades_Handler,
+		},
+		{
+			MethodName: "QuoteFulfillTrade",
+			Handler:    _Query_QuoteFulfillTrade_Handler,
+		},
+		{
+			MethodName: "ListTradesByReceiver",
+			Handler:    _Query_ListTradesByReceiver_Handler,
+		},
+		{
+			MethodName: "ListSignUpByReferee",
+			Handler:    _Query_ListSignUpByReferee_Handler,
+		},
+		{
+			MethodName: "GetRecipeHistory",
+			Handler:    _Query_GetRecipeHistory_Handler,
+		},
+		{
+			MethodName: "GetStripeRefund",
+			Handler:    _Query_GetStripeRefund_Handler,
+		},
+		{
+			MethodName: "GetItemOwnershipHistory",
+			Handler:    _Query_GetItemOwnershipHistory_Handler,
+		},
+		{
+			MethodName: "GetItemAttributesHistory",
+			Handler:    _Query_GetItemAttributesHistory_Handler,
+		},
+		{
+			MethodName: "GetItemProvenance",
+			Handler:    _Query_GetItemProvenance_Handler,
+		},
+		{
+			MethodName: "RedeemInfo",
+			Handler:    _Query_RedeemInfo_Handler,
+		},
+		{
+			MethodName: "RedeemInfoAll",
+			Handler:    _Query_RedeemInfoAll_Handler,
+		},
+		{
+			MethodName: "PaymentInfo",
+			Handler:    _Query_PaymentInfo_Handler,
+		},
+		{
+			MethodName: "PaymentInfoAll",
+			Handler:    _Query_PaymentInfoAll_Handler,
+		},
+		{
+			MethodName: "UsernameByAddress",
+			Handler:    _Query_UsernameByAddress_Handler,
+		},
+		{
+			MethodName: "AddressByUsername",
+			Handler:    _Query_AddressByUsername_Handler,
+		},
+		{
+			MethodName: "Trade",
+			Handler:    _Query_Trade_Handler,
+		},
+		{
+			MethodName: "ListItemByOwner",
+			Handler:    _Query_ListItemByOwner_Handler,
+		},
+		{
+			MethodName: "GoogleInAppPurchaseOrder",
+			Handler:    _Query_GoogleInAppPurchaseOrder_Handler,
+		},
+		{
+			MethodName: "ListExecutionsByItem",
+			Handler:    _Query_ListExecutionsByItem_Handler,
+		},
+		{
+			MethodName: "ListExecutionsByRecipe",
+			Handler:    _Query_ListExecutionsByRecipe_Handler,
+		},
+		{
+			MethodName: "Execution",
+			Handler:    _Query_Execution_Handler,
+		},
+		{
+			MethodName: "ListRecipesByCookbook",
+			Handler:    _Query_ListRecipesByCookbook_Handler,
+		},
+		{
+			MethodName: "Item",
+			Handler:    _Query_Item_Handler,
+		},
+		{
+			MethodName: "Recipe",
+			Handler:    _Query_Recipe_Handler,
+		},
+		{
+			MethodName: "ListCookbooksByCreator",
+			Handler:    _Query_ListCookbooksByCreator_Handler,
+		},
+		{
+			MethodName: "Cookbook",
+			Handler:    _Query_Cookbook_Handler,
+		},
+		{
+			MethodName: "RecipeStats",
+			Handler:    _Query_RecipeStats_Handler,
+		},
+		{
+			MethodName: "CookbookStats",
+			Handler:    _Query_CookbookStats_Handler,
+		},
+		{
+			MethodName: "Lending",
+			Handler:    _Query_Lending_Handler,
+		},
+		{
+			MethodName: "Auction",
+			Handler:    _Query_Auction_Handler,
+		},
+		{
+			MethodName: "DutchAuction",
+			Handler:    _Query_DutchAuction_Handler,
+		},
+		{
+			MethodName: "ItemOffer",
+			Handler:    _Query_ItemOffer_Handler,
+		},
+		{
+			MethodName: "Swap",
+			Handler:    _Query_Swap_Handler,
+		},
+		{
+			MethodName: "ListItemsByCookbook",
+			Handler:    _Query_ListItemsByCookbook_Handler,
+		},
+		{
+			MethodName: "ListItemsByRecipe",
+			Handler:    _Query_ListItemsByRecipe_Handler,
+		},
+		{
+			MethodName: "SearchItems",
+			Handler:    _Query_SearchItems_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "pylons/pylons/query.proto",
+}
+
+func (m *QueryListSignUpByReferee) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryListSignUpByReferee) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryListSignUpByReferee) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Creator) > 0 {
+		i -= len(m.Creator)
+		copy(dAtA[i:], m.Creator)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Creator)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryListSignUpByRefereeResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryListSignUpByRefereeResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryListSignUpByRefereeResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Signup != nil {
+		{
+			size, err := m.Signup.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryListTradesByCreatorRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryListTradesByCreatorRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryListTradesByCreatorRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Creator) > 0 {
+		i -= len(m.Creator)
+		copy(dAtA[i:], m.Creator)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Creator)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryListTradesByCreatorResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryListTradesByCreatorResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryListTradesByCreatorResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Trades) > 0 {
+		for iNdEx := len(m.Trades) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Trades[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryListTradesRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryListTradesRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryListTradesRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x4a
+	}
+	if len(m.Sort) > 0 {
+		i -= len(m.Sort)
+		copy(dAtA[i:], m.Sort)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Sort)))
+		i--
+		dAtA[i] = 0x42
+	}
+	if len(m.Strings) > 0 {
+		for iNdEx := len(m.Strings) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Strings[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x3a
+		}
+	}
+	if len(m.Doubles) > 0 {
+		for iNdEx := len(m.Doubles) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Doubles[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x32
+		}
+	}
+	if len(m.Longs) > 0 {
+		for iNdEx := len(m.Longs) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Longs[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
+	if len(m.MaxPrice) > 0 {
+		i -= len(m.MaxPrice)
+		copy(dAtA[i:], m.MaxPrice)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.MaxPrice)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.MinPrice) > 0 {
+		i -= len(m.MinPrice)
+		copy(dAtA[i:], m.MinPrice)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.MinPrice)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.CookbookId) > 0 {
+		i -= len(m.CookbookId)
+		copy(dAtA[i:], m.CookbookId)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.CookbookId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryListTradesResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryListTradesResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryListTradesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Trades) > 0 {
+		for iNdEx := len(m.Trades) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Trades[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryQuoteFulfillTradeRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryQuoteFulfillTradeRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryQuoteFulfillTradeRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.FillAmount != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.FillAmount))
+		i--
+		dAtA[i] = 0x28
+	}
+	if len(m.Items) > 0 {
+		for iNdEx := len(m.Items) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Items[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if m.CoinInputsIndex != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.CoinInputsIndex))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.Fulfiller) > 0 {
+		i -= len(m.Fulfiller)
+		copy(dAtA[i:], m.Fulfiller)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Fulfiller)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Id != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Id))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *CookbookRoyalty) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *CookbookRoyalty) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *CookbookRoyalty) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Amount) > 0 {
+		for iNdEx := len(m.Amount) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Amount[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.Owner) > 0 {
+		i -= len(m.Owner)
+		copy(dAtA[i:], m.Owner)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Owner)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.CookbookId) > 0 {
+		i -= len(m.CookbookId)
+		copy(dAtA[i:], m.CookbookId)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.CookbookId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryQuoteFulfillTradeResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryQuoteFulfillTradeResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryQuoteFulfillTradeResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ChainFees) > 0 {
+		for iNdEx := len(m.ChainFees) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ChainFees[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x32
+		}
+	}
+	if len(m.Royalties) > 0 {
+		for iNdEx := len(m.Royalties) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Royalties[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
+	if len(m.CreatorReceives) > 0 {
+		for iNdEx := len(m.CreatorReceives) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.CreatorReceives[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if len(m.FulfillerReceives) > 0 {
+		for iNdEx := len(m.FulfillerReceives) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.FulfillerReceives[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.CreatorPays) > 0 {
+		for iNdEx := len(m.CreatorPays) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.CreatorPays[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.FulfillerPays) > 0 {
+		for iNdEx := len(m.FulfillerPays) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.FulfillerPays[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryListTradesByReceiverRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryListTradesByReceiverRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryListTradesByReceiverRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Receiver) > 0 {
+		i -= len(m.Receiver)
+		copy(dAtA[i:], m.Receiver)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Receiver)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryListTradesByReceiverResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryListTradesByReceiverResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryListTradesByReceiverResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Trades) > 0 {
+		for iNdEx := len(m.Trades) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Trades[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryGetItemHistoryRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryGetItemHistoryRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryGetItemHistoryRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.MintedNumber) > 0 {
+		i -= len(m.MintedNumber)
+		copy(dAtA[i:], m.MintedNumber)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.MintedNumber)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.ItemId) > 0 {
+		i -= len(m.ItemId)
+		copy(dAtA[i:], m.ItemId)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ItemId)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.CookbookId) > 0 {
+		i -= len(m.CookbookId)
+		copy(dAtA[i:], m.CookbookId)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.CookbookId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryGetItemHistoryResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryGetItemHistoryResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryGetItemHistoryResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.History) > 0 {
+		for iNdEx := len(m.History) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.History[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryGetItemAttributesHistoryRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryGetItemAttributesHistoryRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryGetItemAttributesHistoryRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ItemId) > 0 {
+		i -= len(m.ItemId)
+		copy(dAtA[i:], m.ItemId)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ItemId)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.CookbookId) > 0 {
+		i -= len(m.CookbookId)
+		copy(dAtA[i:], m.CookbookId)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.CookbookId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryGetItemAttributesHistoryResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryGetItemAttributesHistoryResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryGetItemAttributesHistoryResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.History) > 0 {
+		for iNdEx := len(m.History) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.History[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryGetItemProvenanceRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryGetItemProvenanceRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryGetItemProvenanceRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.ItemId) > 0 {
+		i -= len(m.ItemId)
+		copy(dAtA[i:], m.ItemId)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ItemId)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.CookbookId) > 0 {
+		i -= len(m.CookbookId)
+		copy(dAtA[i:], m.CookbookId)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.CookbookId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryGetItemProvenanceResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryGetItemProvenanceResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryGetItemProvenanceResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Provenance) > 0 {
+		for iNdEx := len(m.Provenance) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Provenance[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryGetRecipeHistoryRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryGetRecipeHistoryRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryGetRecipeHistoryRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.RecipeId) > 0 {
+		i -= len(m.RecipeId)
+		copy(dAtA[i:], m.RecipeId)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.RecipeId)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.CookbookId) > 0 {
+		i -= len(m.CookbookId)
+		copy(dAtA[i:], m.CookbookId)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.CookbookId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryGetRecipeHistoryResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryGetRecipeHistoryResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryGetRecipeHistoryResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.History) > 0 {
+		for iNdEx := len(m.History) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.History[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *RecipeHistory) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *RecipeHistory) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RecipeHistory) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.CreatedAt != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.CreatedAt))
+		i--
+		dAtA[i] = 0x40
+	}
+	if len(m.Amount) > 0 {
+		i -= len(m.Amount)
+		copy(dAtA[i:], m.Amount)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Amount)))
+		i--
+		dAtA[i] = 0x3a
+	}
+	if len(m.Receiver) > 0 {
+		i -= len(m.Receiver)
+		copy(dAtA[i:], m.Receiver)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Receiver)))
+		i--
+		dAtA[i] = 0x32
+	}
+	if len(m.SenderName) > 0 {
+		i -= len(m.SenderName)
+		copy(dAtA[i:], m.SenderName)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.SenderName)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.Sender) > 0 {
+		i -= len(m.Sender)
+		copy(dAtA[i:], m.Sender)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Sender)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.RecipeId) > 0 {
+		i -= len(m.RecipeId)
+		copy(dAtA[i:], m.RecipeId)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.RecipeId)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.CookbookId) > 0 {
+		i -= len(m.CookbookId)
+		copy(dAtA[i:], m.CookbookId)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.CookbookId)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.ItemId) > 0 {
+		i -= len(m.ItemId)
+		copy(dAtA[i:], m.ItemId)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ItemId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryGetStripeRefundRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryGetStripeRefundRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryGetStripeRefundRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryGetStripeRefundResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryGetStripeRefundResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryGetStripeRefundResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Refunds) > 0 {
+		for iNdEx := len(m.Refunds) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Refunds[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryGetRedeemInfoRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryGetRedeemInfoRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryGetRedeemInfoRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Id) > 0 {
+		i -= len(m.Id)
+		copy(dAtA[i:], m.Id)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Id)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryGetRedeemInfoResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryGetRedeemInfoResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryGetRedeemInfoResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.RedeemInfo.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryAllRedeemInfoRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryAllRedeemInfoRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAllRedeemInfoRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryAllRedeemInfoResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryAllRedeemInfoResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAllRedeemInfoResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.RedeemInfo) > 0 {
+		for iNdEx := len(m.RedeemInfo) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.RedeemInfo[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryGetPaymentInfoRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryGetPaymentInfoRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryGetPaymentInfoRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.PurchaseId) > 0 {
+		i -= len(m.PurchaseId)
+		copy(dAtA[i:], m.PurchaseId)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.PurchaseId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryGetPaymentInfoResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryGetPaymentInfoResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryGetPaymentInfoResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.PaymentInfo.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryAllPaymentInfoRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryAllPaymentInfoRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAllPaymentInfoRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryAllPaymentInfoResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryAllPaymentInfoResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAllPaymentInfoResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.PaymentInfo) > 0 {
+		for iNdEx := len(m.PaymentInfo) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.PaymentInfo[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryGetUsernameByAddressRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryGetUsernameByAddressRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryGetUsernameByAddressRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryGetAddressByUsernameRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryGetAddressByUsernameRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryGetAddressByUsernameRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Username) > 0 {
+		i -= len(m.Username)
+		copy(dAtA[i:], m.Username)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Username)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryGetUsernameByAddressResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryGetUsernameByAddressResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryGetUsernameByAddressResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Username.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryGetAddressByUsernameResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryGetAddressByUsernameResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryGetAddressByUsernameResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Address.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryGetTradeRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryGetTradeRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryGetTradeRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Id != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Id))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryGetTradeResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryGetTradeResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryGetTradeResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Trade.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryListItemByOwnerRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryListItemByOwnerRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryListItemByOwnerRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Owner) > 0 {
+		i -= len(m.Owner)
+		copy(dAtA[i:], m.Owner)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Owner)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryListItemByOwnerResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryListItemByOwnerResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryListItemByOwnerResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Items) > 0 {
+		for iNdEx := len(m.Items) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Items[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryListItemsByCookbookRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryListItemsByCookbookRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryListItemsByCookbookRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.CookbookId) > 0 {
+		i -= len(m.CookbookId)
+		copy(dAtA[i:], m.CookbookId)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.CookbookId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryListItemsByCookbookResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryListItemsByCookbookResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryListItemsByCookbookResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Items) > 0 {
+		for iNdEx := len(m.Items) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Items[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryListItemsByRecipeRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryListItemsByRecipeRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryListItemsByRecipeRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.RecipeId) > 0 {
+		i -= len(m.RecipeId)
+		copy(dAtA[i:], m.RecipeId)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.RecipeId)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.CookbookId) > 0 {
+		i -= len(m.CookbookId)
+		copy(dAtA[i:], m.CookbookId)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.CookbookId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryListItemsByRecipeResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryListItemsByRecipeResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryListItemsByRecipeResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Items) > 0 {
+		for iNdEx := len(m.Items) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Items[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryGetGoogleInAppPurchaseOrderRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryGetGoogleInAppPurchaseOrderRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryGetGoogleInAppPurchaseOrderRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.PurchaseToken) > 0 {
+		i -= len(m.PurchaseToken)
+		copy(dAtA[i:], m.PurchaseToken)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.PurchaseToken)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryGetGoogleInAppPurchaseOrderResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryGetGoogleInAppPurchaseOrderResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryGetGoogleInAppPurchaseOrderResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Order.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryListExecutionsByItemRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryListExecutionsByItemRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryListExecutionsByItemRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.ItemId) > 0 {
+		i -= len(m.ItemId)
+		copy(dAtA[i:], m.ItemId)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ItemId)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.CookbookId) > 0 {
+		i -= len(m.CookbookId)
+		copy(dAtA[i:], m.CookbookId)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.CookbookId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryListExecutionsByItemResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryListExecutionsByItemResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryListExecutionsByItemResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.PendingExecutions) > 0 {
+		for iNdEx := len(m.PendingExecutions) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.PendingExecutions[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.CompletedExecutions) > 0 {
+		for iNdEx := len(m.CompletedExecutions) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.CompletedExecutions[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryListExecutionsByRecipeRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryListExecutionsByRecipeRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryListExecutionsByRecipeRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.RecipeId) > 0 {
+		i -= len(m.RecipeId)
+		copy(dAtA[i:], m.RecipeId)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.RecipeId)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.CookbookId) > 0 {
+		i -= len(m.CookbookId)
+		copy(dAtA[i:], m.CookbookId)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.CookbookId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryListExecutionsByRecipeResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryListExecutionsByRecipeResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryListExecutionsByRecipeResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.PendingExecutions) > 0 {
+		for iNdEx := len(m.PendingExecutions) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.PendingExecutions[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.CompletedExecutions) > 0 {
+		for iNdEx := len(m.CompletedExecutions) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.CompletedExecutions[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryGetExecutionRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryGetExecutionRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryGetExecutionRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Id) > 0 {
+		i -= len(m.Id)
+		copy(dAtA[i:], m.Id)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Id)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryGetExecutionResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryGetExecutionResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryGetExecutionResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Completed {
+		i--
+		if m.Completed {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x10
+	}
+	{
+		size, err := m.Execution.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryListRecipesByCookbookRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryListRecipesByCookbookRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryListRecipesByCookbookRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.CookbookId) > 0 {
+		i -= len(m.CookbookId)
+		copy(dAtA[i:], m.CookbookId)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.CookbookId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryListRecipesByCookbookResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryListRecipesByCookbookResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryListRecipesByCookbookResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Recipes) > 0 {
+		for iNdEx := len(m.Recipes) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Recipes[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryGetItemRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryGetItemRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryGetItemRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Id) > 0 {
+		i -= len(m.Id)
+		copy(dAtA[i:], m.Id)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Id)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.CookbookId) > 0 {
+		i -= len(m.CookbookId)
+		copy(dAtA[i:], m.CookbookId)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.CookbookId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryGetItemResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryGetItemResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryGetItemResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Contents) > 0 {
+		for iNdEx := len(m.Contents) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Contents[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	{
+		size, err := m.Item.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryGetRecipeRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryGetRecipeRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryGetRecipeRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Id) > 0 {
+		i -= len(m.Id)
+		copy(dAtA[i:], m.Id)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Id)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.CookbookId) > 0 {
+		i -= len(m.CookbookId)
+		copy(dAtA[i:], m.CookbookId)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.CookbookId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryGetRecipeResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryGetRecipeResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryGetRecipeResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Recipe.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryListCookbooksByCreatorRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryListCookbooksByCreatorRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryListCookbooksByCreatorRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Creator) > 0 {
+		i -= len(m.Creator)
+		copy(dAtA[i:], m.Creator)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Creator)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryListCookbooksByCreatorResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryListCookbooksByCreatorResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryListCookbooksByCreatorResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Cookbooks) > 0 {
+		for iNdEx := len(m.Cookbooks) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Cookbooks[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryGetCookbookRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryGetCookbookRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryGetCookbookRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Id) > 0 {
+		i -= len(m.Id)
+		copy(dAtA[i:], m.Id)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Id)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryGetCookbookResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryGetCookbookResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryGetCookbookResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Cookbook.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryRecipeStatsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryRecipeStatsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryRecipeStatsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.RecipeId) > 0 {
+		i -= len(m.RecipeId)
+		copy(dAtA[i:], m.RecipeId)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.RecipeId)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.CookbookId) > 0 {
+		i -= len(m.CookbookId)
+		copy(dAtA[i:], m.CookbookId)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.CookbookId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryRecipeStatsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryRecipeStatsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryRecipeStatsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Stats.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryCookbookStatsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryCookbookStatsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryCookbookStatsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.CookbookId) > 0 {
+		i -= len(m.CookbookId)
+		copy(dAtA[i:], m.CookbookId)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.CookbookId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryCookbookStatsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryCookbookStatsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryCookbookStatsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Stats.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryGetLendingRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryGetLendingRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryGetLendingRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Id != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Id))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryGetLendingResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryGetLendingResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryGetLendingResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Lending.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryGetAuctionRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryGetAuctionRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryGetAuctionRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Id != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Id))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryGetAuctionResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryGetAuctionResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryGetAuctionResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Auction.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryGetDutchAuctionRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryGetDutchAuctionRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryGetDutchAuctionRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Id != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Id))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryGetDutchAuctionResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryGetDutchAuctionResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryGetDutchAuctionResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.CurrentPrice.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	{
+		size, err := m.DutchAuction.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryGetItemOfferRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryGetItemOfferRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryGetItemOfferRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Id != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Id))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryGetItemOfferResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryGetItemOfferResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryGetItemOfferResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.ItemOffer.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryGetSwapRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryGetSwapRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryGetSwapRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Id != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Id))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryGetSwapResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryGetSwapResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryGetSwapResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Swap.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *LongAttributeFilter) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *LongAttributeFilter) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *LongAttributeFilter) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Max) > 0 {
+		i -= len(m.Max)
+		copy(dAtA[i:], m.Max)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Max)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Min) > 0 {
+		i -= len(m.Min)
+		copy(dAtA[i:], m.Min)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Min)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Key) > 0 {
+		i -= len(m.Key)
+		copy(dAtA[i:], m.Key)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Key)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *DoubleAttributeFilter) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *DoubleAttributeFilter) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DoubleAttributeFilter) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Max) > 0 {
+		i -= len(m.Max)
+		copy(dAtA[i:], m.Max)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Max)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Min) > 0 {
+		i -= len(m.Min)
+		copy(dAtA[i:], m.Min)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Min)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Key) > 0 {
+		i -= len(m.Key)
+		copy(dAtA[i:], m.Key)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Key)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *StringAttributeFilter) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *StringAttributeFilter) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *StringAttributeFilter) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Prefix) > 0 {
+		i -= len(m.Prefix)
+		copy(dAtA[i:], m.Prefix)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Prefix)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Value) > 0 {
+		i -= len(m.Value)
+		copy(dAtA[i:], m.Value)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Value)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Key) > 0 {
+		i -= len(m.Key)
+		copy(dAtA[i:], m.Key)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Key)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QuerySearchItemsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QuerySearchItemsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QuerySearchItemsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x4a
+	}
+	if len(m.RecipeId) > 0 {
+		i -= len(m.RecipeId)
+		copy(dAtA[i:], m.RecipeId)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.RecipeId)))
+		i--
+		dAtA[i] = 0x42
+	}
+	if m.Tradeable {
+		i--
+		if m.Tradeable {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x38
+	}
+	if m.FilterTradeable {
+		i--
+		if m.FilterTradeable {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x30
+	}
+	if len(m.Owner) > 0 {
+		i -= len(m.Owner)
+		copy(dAtA[i:], m.Owner)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Owner)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.Strings) > 0 {
+		for iNdEx := len(m.Strings) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Strings[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if len(m.Doubles) > 0 {
+		for iNdEx := len(m.Doubles) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Doubles[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.Longs) > 0 {
+		for iNdEx := len(m.Longs) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Longs[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.CookbookId) > 0 {
+		i -= len(m.CookbookId)
+		copy(dAtA[i:], m.CookbookId)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.CookbookId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QuerySearchItemsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QuerySearchItemsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QuerySearchItemsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Items) > 0 {
+		for iNdEx := len(m.Items) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Items[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
+	offset -= sovQuery(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *QueryListSignUpByReferee) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Creator)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryListSignUpByRefereeResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Signup != nil {
+		l = m.Signup.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryListTradesByCreatorRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Creator)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryListTradesByCreatorResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Trades) > 0 {
+		for _, e := range m.Trades {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryListTradesRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.CookbookId)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.MinPrice)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.MaxPrice)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if len(m.Longs) > 0 {
+		for _, e := range m.Longs {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if len(m.Doubles) > 0 {
+		for _, e := range m.Doubles {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if len(m.Strings) > 0 {
+		for _, e := range m.Strings {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	l = len(m.Sort)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryListTradesResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Trades) > 0 {
+		for _, e := range m.Trades {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryQuoteFulfillTradeRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Id != 0 {
+		n += 1 + sovQuery(uint64(m.Id))
+	}
+	l = len(m.Fulfiller)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.CoinInputsIndex != 0 {
+		n += 1 + sovQuery(uint64(m.CoinInputsIndex))
+	}
+	if len(m.Items) > 0 {
+		for _, e := range m.Items {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.FillAmount != 0 {
+		n += 1 + sovQuery(uint64(m.FillAmount))
+	}
+	return n
+}
+
+func (m *CookbookRoyalty) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.CookbookId)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.Owner)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if len(m.Amount) > 0 {
+		for _, e := range m.Amount {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryQuoteFulfillTradeResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.FulfillerPays) > 0 {
+		for _, e := range m.FulfillerPays {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if len(m.CreatorPays) > 0 {
+		for _, e := range m.CreatorPays {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if len(m.FulfillerReceives) > 0 {
+		for _, e := range m.FulfillerReceives {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if len(m.CreatorReceives) > 0 {
+		for _, e := range m.CreatorReceives {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if len(m.Royalties) > 0 {
+		for _, e := range m.Royalties {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if len(m.ChainFees) > 0 {
+		for _, e := range m.ChainFees {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryListTradesByReceiverRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Receiver)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryListTradesByReceiverResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Trades) > 0 {
+		for _, e := range m.Trades {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryGetItemHistoryRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.CookbookId)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.ItemId)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.MintedNumber)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryGetItemHistoryResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.History) > 0 {
+		for _, e := range m.History {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryGetItemAttributesHistoryRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.CookbookId)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.ItemId)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryGetItemAttributesHistoryResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.History) > 0 {
+		for _, e := range m.History {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryGetItemProvenanceRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.CookbookId)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.ItemId)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryGetItemProvenanceResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Provenance) > 0 {
+		for _, e := range m.Provenance {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryGetRecipeHistoryRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.CookbookId)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.RecipeId)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryGetRecipeHistoryResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.History) > 0 {
+		for _, e := range m.History {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *RecipeHistory) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ItemId)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.CookbookId)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.RecipeId)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.Sender)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.SenderName)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.Receiver)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.Amount)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.CreatedAt != 0 {
+		n += 1 + sovQuery(uint64(m.CreatedAt))
+	}
+	return n
+}
+
+func (m *QueryGetStripeRefundRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryGetStripeRefundResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Refunds) > 0 {
+		for _, e := range m.Refunds {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryGetRedeemInfoRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Id)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryGetRedeemInfoResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.RedeemInfo.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryAllRedeemInfoRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryAllRedeemInfoResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.RedeemInfo) > 0 {
+		for _, e := range m.RedeemInfo {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryGetPaymentInfoRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.PurchaseId)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryGetPaymentInfoResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.PaymentInfo.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryAllPaymentInfoRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryAllPaymentInfoResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.PaymentInfo) > 0 {
+		for _, e := range m.PaymentInfo {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryGetUsernameByAddressRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryGetAddressByUsernameRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Username)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryGetUsernameByAddressResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Username.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryGetAddressByUsernameResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Address.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryGetTradeRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Id != 0 {
+		n += 1 + sovQuery(uint64(m.Id))
+	}
+	return n
+}
+
+func (m *QueryGetTradeResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Trade.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryListItemByOwnerRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Owner)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryListItemByOwnerResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Items) > 0 {
+		for _, e := range m.Items {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryListItemsByCookbookRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.CookbookId)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryListItemsByCookbookResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Items) > 0 {
+		for _, e := range m.Items {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryListItemsByRecipeRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.CookbookId)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.RecipeId)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryListItemsByRecipeResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Items) > 0 {
+		for _, e := range m.Items {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryGetGoogleInAppPurchaseOrderRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.PurchaseToken)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryGetGoogleInAppPurchaseOrderResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Order.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryListExecutionsByItemRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.CookbookId)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.ItemId)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryListExecutionsByItemResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.CompletedExecutions) > 0 {
+		for _, e := range m.CompletedExecutions {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if len(m.PendingExecutions) > 0 {
+		for _, e := range m.PendingExecutions {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryListExecutionsByRecipeRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.CookbookId)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.RecipeId)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryListExecutionsByRecipeResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.CompletedExecutions) > 0 {
+		for _, e := range m.CompletedExecutions {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if len(m.PendingExecutions) > 0 {
+		for _, e := range m.PendingExecutions {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryGetExecutionRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Id)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryGetExecutionResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Execution.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	if m.Completed {
+		n += 2
+	}
+	return n
+}
+
+func (m *QueryListRecipesByCookbookRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.CookbookId)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryListRecipesByCookbookResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Recipes) > 0 {
+		for _, e := range m.Recipes {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryGetItemRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.CookbookId)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.Id)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryGetItemResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Item.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	if len(m.Contents) > 0 {
+		for _, e := range m.Contents {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryGetRecipeRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.CookbookId)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.Id)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryGetRecipeResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Recipe.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryListCookbooksByCreatorRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Creator)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryListCookbooksByCreatorResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Cookbooks) > 0 {
+		for _, e := range m.Cookbooks {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryGetCookbookRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Id)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryGetCookbookResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Cookbook.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryRecipeStatsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.CookbookId)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.RecipeId)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryRecipeStatsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Stats.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryCookbookStatsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.CookbookId)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryCookbookStatsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Stats.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryGetLendingRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Id != 0 {
+		n += 1 + sovQuery(uint64(m.Id))
+	}
+	return n
+}
+
+func (m *QueryGetLendingResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Lending.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryGetAuctionRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Id != 0 {
+		n += 1 + sovQuery(uint64(m.Id))
+	}
+	return n
+}
+
+func (m *QueryGetAuctionResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Auction.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryGetDutchAuctionRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Id != 0 {
+		n += 1 + sovQuery(uint64(m.Id))
+	}
+	return n
+}
+
+func (m *QueryGetDutchAuctionResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.DutchAuction.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = m.CurrentPrice.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryGetItemOfferRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Id != 0 {
+		n += 1 + sovQuery(uint64(m.Id))
+	}
+	return n
+}
+
+func (m *QueryGetItemOfferResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.ItemOffer.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryGetSwapRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Id != 0 {
+		n += 1 + sovQuery(uint64(m.Id))
+	}
+	return n
+}
+
+func (m *QueryGetSwapResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Swap.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *LongAttributeFilter) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Key)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.Min)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.Max)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *DoubleAttributeFilter) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Key)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.Min)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.Max)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *StringAttributeFilter) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Key)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.Value)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.Prefix)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QuerySearchItemsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.CookbookId)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if len(m.Longs) > 0 {
+		for _, e := range m.Longs {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if len(m.Doubles) > 0 {
+		for _, e := range m.Doubles {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if len(m.Strings) > 0 {
+		for _, e := range m.Strings {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	l = len(m.Owner)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.FilterTradeable {
+		n += 2
+	}
+	if m.Tradeable {
+		n += 2
+	}
+	l = len(m.RecipeId)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QuerySearchItemsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Items) > 0 {
+		for _, e := range m.Items {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func sovQuery(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozQuery(x uint64) (n int) {
+	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *QueryListSignUpByReferee) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryListSignUpByReferee: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryListSignUpByReferee: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Creator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryListSignUpByRefereeResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryListSignUpByRefereeResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryListSignUpByRefereeResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Signup", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Signup == nil {
+				m.Signup = &ReferralKV{}
+			}
+			if err := m.Signup.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryListTradesByCreatorRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryListTradesByCreatorRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryListTradesByCreatorRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Creator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryListTradesByCreatorResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryListTradesByCreatorResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryListTradesByCreatorResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Trades", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Trades = append(m.Trades, Trade{})
+			if err := m.Trades[len(m.Trades)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryListTradesRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryListTradesRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryListTradesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CookbookId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CookbookId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinPrice", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MinPrice = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxPrice", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MaxPrice = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Longs", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Longs = append(m.Longs, LongAttributeFilter{})
+			if err := m.Longs[len(m.Longs)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Doubles", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Doubles = append(m.Doubles, DoubleAttributeFilter{})
+			if err := m.Doubles[len(m.Doubles)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Strings", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Strings = append(m.Strings, StringAttributeFilter{})
+			if err := m.Strings[len(m.Strings)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sort", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Sort = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryListTradesResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryListTradesResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryListTradesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Trades", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Trades = append(m.Trades, Trade{})
+			if err := m.Trades[len(m.Trades)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryQuoteFulfillTradeRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryQuoteFulfillTradeRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryQuoteFulfillTradeRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			m.Id = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Id |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Fulfiller", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Fulfiller = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CoinInputsIndex", wireType)
+			}
+			m.CoinInputsIndex = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.CoinInputsIndex |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Items", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Items = append(m.Items, ItemRef{})
+			if err := m.Items[len(m.Items)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FillAmount", wireType)
+			}
+			m.FillAmount = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.FillAmount |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *CookbookRoyalty) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: CookbookRoyalty: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: CookbookRoyalty: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CookbookId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CookbookId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Owner", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Owner = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Amount = append(m.Amount, types.Coin{})
+			if err := m.Amount[len(m.Amount)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryQuoteFulfillTradeResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryQuoteFulfillTradeResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryQuoteFulfillTradeResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FulfillerPays", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.FulfillerPays = append(m.FulfillerPays, types.Coin{})
+			if err := m.FulfillerPays[len(m.FulfillerPays)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CreatorPays", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CreatorPays = append(m.CreatorPays, types.Coin{})
+			if err := m.CreatorPays[len(m.CreatorPays)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FulfillerReceives", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.FulfillerReceives = append(m.FulfillerReceives, types.Coin{})
+			if err := m.FulfillerReceives[len(m.FulfillerReceives)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CreatorReceives", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CreatorReceives = append(m.CreatorReceives, types.Coin{})
+			if err := m.CreatorReceives[len(m.CreatorReceives)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Royalties", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Royalties = append(m.Royalties, CookbookRoyalty{})
+			if err := m.Royalties[len(m.Royalties)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChainFees", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ChainFees = append(m.ChainFees, types.Coin{})
+			if err := m.ChainFees[len(m.ChainFees)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryListTradesByReceiverRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryListTradesByReceiverRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryListTradesByReceiverRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Receiver", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Receiver = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryListTradesByReceiverResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryListTradesByReceiverResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryListTradesByReceiverResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Trades", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Trades = append(m.Trades, Trade{})
+			if err := m.Trades[len(m.Trades)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryGetItemHistoryRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryGetItemHistoryRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryGetItemHistoryRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CookbookId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CookbookId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ItemId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ItemId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MintedNumber", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MintedNumber = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryGetItemHistoryResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryGetItemHistoryResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryGetItemHistoryResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field History", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.History = append(m.History, &ItemHistory{})
+			if err := m.History[len(m.History)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryGetItemAttributesHistoryRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryGetItemAttributesHistoryRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryGetItemAttributesHistoryRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CookbookId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CookbookId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ItemId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ItemId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryGetItemAttributesHistoryResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryGetItemAttributesHistoryResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryGetItemAttributesHistoryResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field History", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.History = append(m.History, ItemAttributesHistory{})
+			if err := m.History[len(m.History)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryGetItemProvenanceRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryGetItemProvenanceRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryGetItemProvenanceRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CookbookId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CookbookId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ItemId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ItemId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryGetItemProvenanceResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryGetItemProvenanceResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryGetItemProvenanceResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Provenance", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Provenance = append(m.Provenance, ItemProvenance{})
+			if err := m.Provenance[len(m.Provenance)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryGetRecipeHistoryRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryGetRecipeHistoryRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryGetRecipeHistoryRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CookbookId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CookbookId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RecipeId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RecipeId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryGetRecipeHistoryResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryGetRecipeHistoryResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryGetRecipeHistoryResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field History", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.History = append(m.History, &RecipeHistory{})
+			if err := m.History[len(m.History)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *RecipeHistory) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RecipeHistory: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RecipeHistory: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ItemId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ItemId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CookbookId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CookbookId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RecipeId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RecipeId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Sender = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SenderName", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SenderName = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Receiver", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Receiver = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Amount = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CreatedAt", wireType)
+			}
+			m.CreatedAt = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.CreatedAt |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryGetStripeRefundRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryGetStripeRefundRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryGetStripeRefundRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryGetStripeRefundResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryGetStripeRefundResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryGetStripeRefundResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Refunds", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Refunds = append(m.Refunds, &StripeRefund{})
+			if err := m.Refunds[len(m.Refunds)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryGetRedeemInfoRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryGetRedeemInfoRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryGetRedeemInfoRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Id = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryGetRedeemInfoResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryGetRedeemInfoResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryGetRedeemInfoResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RedeemInfo", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.RedeemInfo.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryAllRedeemInfoRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryAllRedeemInfoRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryAllRedeemInfoRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryAllRedeemInfoResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryAllRedeemInfoResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryAllRedeemInfoResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RedeemInfo", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RedeemInfo = append(m.RedeemInfo, RedeemInfo{})
+			if err := m.RedeemInfo[len(m.RedeemInfo)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryGetPaymentInfoRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryGetPaymentInfoRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryGetPaymentInfoRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PurchaseId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PurchaseId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryGetPaymentInfoResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryGetPaymentInfoResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryGetPaymentInfoResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PaymentInfo", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.PaymentInfo.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryAllPaymentInfoRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryAllPaymentInfoRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryAllPaymentInfoRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryAllPaymentInfoResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryAllPaymentInfoResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryAllPaymentInfoResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PaymentInfo", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PaymentInfo = append(m.PaymentInfo, PaymentInfo{})
+			if err := m.PaymentInfo[len(m.PaymentInfo)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryGetUsernameByAddressRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryGetUsernameByAddressRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryGetUsernameByAddressRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryGetAddressByUsernameRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryGetAddressByUsernameRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryGetAddressByUsernameRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Username", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Username = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryGetUsernameByAddressResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryGetUsernameByAddressResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryGetUsernameByAddressResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Username", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Username.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryGetAddressByUsernameResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryGetAddressByUsernameResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryGetAddressByUsernameResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Address.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
 	}
-	return n
-}
 
-func (m *QueryGetCookbookRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Id)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	return n
+	return nil
 }
+func (m *QueryGetTradeRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryGetTradeRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryGetTradeRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			m.Id = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Id |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
 
-func (m *QueryGetCookbookResponse) Size() (n int) {
-	if m == nil {
-		return 0
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	var l int
-	_ = l
-	l = m.Cookbook.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	return n
+	return nil
 }
+func (m *QueryGetTradeResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryGetTradeResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryGetTradeResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Trade", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Trade.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
 
-func sovQuery(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozQuery(x uint64) (n int) {
-	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
 }
-func (m *QueryListSignUpByReferee) Unmarshal(dAtA []byte) error {
+func (m *QueryListItemByOwnerRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5778,15 +14846,15 @@ func (m *QueryListSignUpByReferee) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryListSignUpByReferee: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryListItemByOwnerRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryListSignUpByReferee: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryListItemByOwnerRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Owner", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -5814,7 +14882,43 @@ func (m *QueryListSignUpByReferee) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Creator = string(dAtA[iNdEx:postIndex])
+			m.Owner = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -5837,7 +14941,7 @@ func (m *QueryListSignUpByReferee) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryListSignUpByRefereeResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryListItemByOwnerResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5860,15 +14964,49 @@ func (m *QueryListSignUpByRefereeResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryListSignUpByRefereeResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryListItemByOwnerResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryListSignUpByRefereeResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryListItemByOwnerResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Signup", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Items", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Items = append(m.Items, Item{})
+			if err := m.Items[len(m.Items)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -5895,10 +15033,10 @@ func (m *QueryListSignUpByRefereeResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Signup == nil {
-				m.Signup = &ReferralKV{}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
 			}
-			if err := m.Signup.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -5923,7 +15061,7 @@ func (m *QueryListSignUpByRefereeResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryListTradesByCreatorRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryListItemsByCookbookRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5946,15 +15084,15 @@ func (m *QueryListTradesByCreatorRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryListTradesByCreatorRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryListItemsByCookbookRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryListTradesByCreatorRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryListItemsByCookbookRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field CookbookId", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -5982,7 +15120,7 @@ func (m *QueryListTradesByCreatorRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Creator = string(dAtA[iNdEx:postIndex])
+			m.CookbookId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
@@ -6041,7 +15179,7 @@ func (m *QueryListTradesByCreatorRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryListTradesByCreatorResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryListItemsByCookbookResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -6064,15 +15202,15 @@ func (m *QueryListTradesByCreatorResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryListTradesByCreatorResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryListItemsByCookbookResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryListTradesByCreatorResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryListItemsByCookbookResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Trades", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Items", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -6099,8 +15237,8 @@ func (m *QueryListTradesByCreatorResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Trades = append(m.Trades, Trade{})
-			if err := m.Trades[len(m.Trades)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Items = append(m.Items, Item{})
+			if err := m.Items[len(m.Items)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -6161,7 +15299,7 @@ func (m *QueryListTradesByCreatorResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryGetItemHistoryRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryListItemsByRecipeRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -6184,10 +15322,10 @@ func (m *QueryGetItemHistoryRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryGetItemHistoryRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryListItemsByRecipeRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryGetItemHistoryRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryListItemsByRecipeRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -6224,7 +15362,7 @@ func (m *QueryGetItemHistoryRequest) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ItemId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field RecipeId", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -6252,13 +15390,13 @@ func (m *QueryGetItemHistoryRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ItemId = string(dAtA[iNdEx:postIndex])
+			m.RecipeId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MintedNumber", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -6268,23 +15406,27 @@ func (m *QueryGetItemHistoryRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.MintedNumber = string(dAtA[iNdEx:postIndex])
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -6307,7 +15449,7 @@ func (m *QueryGetItemHistoryRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryGetItemHistoryResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryListItemsByRecipeResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -6330,15 +15472,15 @@ func (m *QueryGetItemHistoryResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryGetItemHistoryResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryListItemsByRecipeResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryGetItemHistoryResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryListItemsByRecipeResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field History", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Items", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -6365,8 +15507,44 @@ func (m *QueryGetItemHistoryResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.History = append(m.History, &ItemHistory{})
-			if err := m.History[len(m.History)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Items = append(m.Items, Item{})
+			if err := m.Items[len(m.Items)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -6391,7 +15569,7 @@ func (m *QueryGetItemHistoryResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryGetRecipeHistoryRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryGetGoogleInAppPurchaseOrderRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -6414,15 +15592,15 @@ func (m *QueryGetRecipeHistoryRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryGetRecipeHistoryRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryGetGoogleInAppPurchaseOrderRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryGetRecipeHistoryRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryGetGoogleInAppPurchaseOrderRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CookbookId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field PurchaseToken", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -6450,13 +15628,63 @@ func (m *QueryGetRecipeHistoryRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.CookbookId = string(dAtA[iNdEx:postIndex])
+			m.PurchaseToken = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 2:
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryGetGoogleInAppPurchaseOrderResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryGetGoogleInAppPurchaseOrderResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryGetGoogleInAppPurchaseOrderResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RecipeId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Order", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -6466,23 +15694,24 @@ func (m *QueryGetRecipeHistoryRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.RecipeId = string(dAtA[iNdEx:postIndex])
+			if err := m.Order.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -6505,7 +15734,7 @@ func (m *QueryGetRecipeHistoryRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryGetRecipeHistoryResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryListExecutionsByItemRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -6528,15 +15757,79 @@ func (m *QueryGetRecipeHistoryResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryGetRecipeHistoryResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryListExecutionsByItemRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryGetRecipeHistoryResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryListExecutionsByItemRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field History", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field CookbookId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CookbookId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ItemId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ItemId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -6563,8 +15856,10 @@ func (m *QueryGetRecipeHistoryResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.History = append(m.History, &RecipeHistory{})
-			if err := m.History[len(m.History)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -6589,7 +15884,7 @@ func (m *QueryGetRecipeHistoryResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *RecipeHistory) Unmarshal(dAtA []byte) error {
+func (m *QueryListExecutionsByItemResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -6612,17 +15907,17 @@ func (m *RecipeHistory) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: RecipeHistory: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryListExecutionsByItemResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: RecipeHistory: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryListExecutionsByItemResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ItemId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field CompletedExecutions", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -6632,29 +15927,31 @@ func (m *RecipeHistory) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ItemId = string(dAtA[iNdEx:postIndex])
+			m.CompletedExecutions = append(m.CompletedExecutions, Execution{})
+			if err := m.CompletedExecutions[len(m.CompletedExecutions)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CookbookId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field PendingExecutions", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -6664,29 +15961,31 @@ func (m *RecipeHistory) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.CookbookId = string(dAtA[iNdEx:postIndex])
+			m.PendingExecutions = append(m.PendingExecutions, Execution{})
+			if err := m.PendingExecutions[len(m.PendingExecutions)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RecipeId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -6696,59 +15995,81 @@ func (m *RecipeHistory) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.RecipeId = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 4:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
 			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthQuery
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthQuery
 			}
-			if postIndex > l {
+			if (iNdEx + skippy) > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Sender = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 5:
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryListExecutionsByRecipeRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryListExecutionsByRecipeRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryListExecutionsByRecipeRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SenderName", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field CookbookId", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -6776,11 +16097,11 @@ func (m *RecipeHistory) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.SenderName = string(dAtA[iNdEx:postIndex])
+			m.CookbookId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 6:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Receiver", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field RecipeId", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -6808,13 +16129,13 @@ func (m *RecipeHistory) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Receiver = string(dAtA[iNdEx:postIndex])
+			m.RecipeId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 7:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -6824,43 +16145,28 @@ func (m *RecipeHistory) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Amount = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 8:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CreatedAt", wireType)
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
 			}
-			m.CreatedAt = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.CreatedAt |= int64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -6882,7 +16188,7 @@ func (m *RecipeHistory) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryGetStripeRefundRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryListExecutionsByRecipeResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -6905,65 +16211,83 @@ func (m *QueryGetStripeRefundRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryGetStripeRefundRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryListExecutionsByRecipeResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryGetStripeRefundRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryListExecutionsByRecipeResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
-		default:
-			iNdEx = preIndex
-			skippy, err := skipQuery(dAtA[iNdEx:])
-			if err != nil {
-				return err
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CompletedExecutions", wireType)
 			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			if (iNdEx + skippy) > l {
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *QueryGetStripeRefundResponse) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowQuery
+			m.CompletedExecutions = append(m.CompletedExecutions, Execution{})
+			if err := m.CompletedExecutions[len(m.CompletedExecutions)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PendingExecutions", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
 			}
-			if iNdEx >= l {
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			m.PendingExecutions = append(m.PendingExecutions, Execution{})
+			if err := m.PendingExecutions[len(m.PendingExecutions)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: QueryGetStripeRefundResponse: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryGetStripeRefundResponse: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			iNdEx = postIndex
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Refunds", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -6990,8 +16314,10 @@ func (m *QueryGetStripeRefundResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Refunds = append(m.Refunds, &StripeRefund{})
-			if err := m.Refunds[len(m.Refunds)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -7016,7 +16342,7 @@ func (m *QueryGetStripeRefundResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryGetRedeemInfoRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryGetExecutionRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -7039,10 +16365,10 @@ func (m *QueryGetRedeemInfoRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryGetRedeemInfoRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryGetExecutionRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryGetRedeemInfoRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryGetExecutionRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -7098,7 +16424,7 @@ func (m *QueryGetRedeemInfoRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryGetRedeemInfoResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryGetExecutionResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -7121,15 +16447,15 @@ func (m *QueryGetRedeemInfoResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryGetRedeemInfoResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryGetExecutionResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryGetRedeemInfoResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryGetExecutionResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RedeemInfo", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Execution", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -7156,10 +16482,30 @@ func (m *QueryGetRedeemInfoResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.RedeemInfo.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Execution.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Completed", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Completed = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -7181,7 +16527,7 @@ func (m *QueryGetRedeemInfoResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryAllRedeemInfoRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryListRecipesByCookbookRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -7204,13 +16550,45 @@ func (m *QueryAllRedeemInfoRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryAllRedeemInfoRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryListRecipesByCookbookRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryAllRedeemInfoRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryListRecipesByCookbookRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CookbookId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CookbookId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
 			}
@@ -7267,7 +16645,7 @@ func (m *QueryAllRedeemInfoRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryAllRedeemInfoResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryListRecipesByCookbookResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -7290,15 +16668,15 @@ func (m *QueryAllRedeemInfoResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryAllRedeemInfoResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryListRecipesByCookbookResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryAllRedeemInfoResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryListRecipesByCookbookResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RedeemInfo", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Recipes", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -7325,8 +16703,8 @@ func (m *QueryAllRedeemInfoResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.RedeemInfo = append(m.RedeemInfo, RedeemInfo{})
-			if err := m.RedeemInfo[len(m.RedeemInfo)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Recipes = append(m.Recipes, Recipe{})
+			if err := m.Recipes[len(m.Recipes)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -7387,7 +16765,7 @@ func (m *QueryAllRedeemInfoResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryGetPaymentInfoRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryGetItemRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -7410,15 +16788,15 @@ func (m *QueryGetPaymentInfoRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryGetPaymentInfoRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryGetItemRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryGetPaymentInfoRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryGetItemRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PurchaseId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field CookbookId", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -7443,149 +16821,16 @@ func (m *QueryGetPaymentInfoRequest) Unmarshal(dAtA []byte) error {
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.PurchaseId = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipQuery(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *QueryGetPaymentInfoResponse) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowQuery
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: QueryGetPaymentInfoResponse: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryGetPaymentInfoResponse: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PaymentInfo", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthQuery
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if err := m.PaymentInfo.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipQuery(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *QueryAllPaymentInfoRequest) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowQuery
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: QueryAllPaymentInfoRequest: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryAllPaymentInfoRequest: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CookbookId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -7595,27 +16840,23 @@ func (m *QueryAllPaymentInfoRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Pagination == nil {
-				m.Pagination = &query.PageRequest{}
-			}
-			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Id = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -7638,7 +16879,7 @@ func (m *QueryAllPaymentInfoRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryAllPaymentInfoResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryGetItemResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -7661,15 +16902,15 @@ func (m *QueryAllPaymentInfoResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryAllPaymentInfoResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryGetItemResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryAllPaymentInfoResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryGetItemResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PaymentInfo", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Item", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -7696,14 +16937,13 @@ func (m *QueryAllPaymentInfoResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.PaymentInfo = append(m.PaymentInfo, PaymentInfo{})
-			if err := m.PaymentInfo[len(m.PaymentInfo)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Item.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Contents", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -7730,10 +16970,8 @@ func (m *QueryAllPaymentInfoResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Pagination == nil {
-				m.Pagination = &query.PageResponse{}
-			}
-			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Contents = append(m.Contents, Item{})
+			if err := m.Contents[len(m.Contents)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -7758,7 +16996,7 @@ func (m *QueryAllPaymentInfoResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryGetUsernameByAddressRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryGetRecipeRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -7781,15 +17019,15 @@ func (m *QueryGetUsernameByAddressRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryGetUsernameByAddressRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryGetRecipeRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryGetUsernameByAddressRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryGetRecipeRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field CookbookId", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -7817,7 +17055,39 @@ func (m *QueryGetUsernameByAddressRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Address = string(dAtA[iNdEx:postIndex])
+			m.CookbookId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Id = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -7840,7 +17110,7 @@ func (m *QueryGetUsernameByAddressRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryGetAddressByUsernameRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryGetRecipeResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -7863,17 +17133,17 @@ func (m *QueryGetAddressByUsernameRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryGetAddressByUsernameRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryGetRecipeResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryGetAddressByUsernameRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryGetRecipeResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Username", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Recipe", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -7883,23 +17153,24 @@ func (m *QueryGetAddressByUsernameRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Username = string(dAtA[iNdEx:postIndex])
+			if err := m.Recipe.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -7922,7 +17193,7 @@ func (m *QueryGetAddressByUsernameRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryGetUsernameByAddressResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryListCookbooksByCreatorRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -7945,15 +17216,47 @@ func (m *QueryGetUsernameByAddressResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryGetUsernameByAddressResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryListCookbooksByCreatorRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryGetUsernameByAddressResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryListCookbooksByCreatorRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Username", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Creator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -7980,7 +17283,10 @@ func (m *QueryGetUsernameByAddressResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Username.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -8005,7 +17311,7 @@ func (m *QueryGetUsernameByAddressResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryGetAddressByUsernameResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryListCookbooksByCreatorResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -8028,15 +17334,15 @@ func (m *QueryGetAddressByUsernameResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryGetAddressByUsernameResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryListCookbooksByCreatorResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryGetAddressByUsernameResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryListCookbooksByCreatorResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Cookbooks", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -8063,7 +17369,44 @@ func (m *QueryGetAddressByUsernameResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Address.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Cookbooks = append(m.Cookbooks, Cookbook{})
+			if err := m.Cookbooks[len(m.Cookbooks)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -8088,7 +17431,7 @@ func (m *QueryGetAddressByUsernameResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryGetTradeRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryGetCookbookRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -8111,17 +17454,17 @@ func (m *QueryGetTradeRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryGetTradeRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryGetCookbookRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryGetTradeRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryGetCookbookRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
+			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
 			}
-			m.Id = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -8131,11 +17474,24 @@ func (m *QueryGetTradeRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Id |= uint64(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Id = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -8157,7 +17513,7 @@ func (m *QueryGetTradeRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryGetTradeResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryGetCookbookResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -8180,15 +17536,15 @@ func (m *QueryGetTradeResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryGetTradeResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryGetCookbookResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryGetTradeResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryGetCookbookResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Trade", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Cookbook", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -8215,7 +17571,7 @@ func (m *QueryGetTradeResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Trade.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Cookbook.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -8240,7 +17596,7 @@ func (m *QueryGetTradeResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryListItemByOwnerRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryRecipeStatsRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -8263,15 +17619,15 @@ func (m *QueryListItemByOwnerRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryListItemByOwnerRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryRecipeStatsRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryListItemByOwnerRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryRecipeStatsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Owner", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field CookbookId", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -8299,13 +17655,13 @@ func (m *QueryListItemByOwnerRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Owner = string(dAtA[iNdEx:postIndex])
+			m.CookbookId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 3:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field RecipeId", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -8315,27 +17671,23 @@ func (m *QueryListItemByOwnerRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Pagination == nil {
-				m.Pagination = &query.PageRequest{}
-			}
-			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.RecipeId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -8358,7 +17710,7 @@ func (m *QueryListItemByOwnerRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryListItemByOwnerResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryRecipeStatsResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -8381,49 +17733,15 @@ func (m *QueryListItemByOwnerResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryListItemByOwnerResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryRecipeStatsResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryListItemByOwnerResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryRecipeStatsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Items", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthQuery
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Items = append(m.Items, Item{})
-			if err := m.Items[len(m.Items)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Stats", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -8450,10 +17768,7 @@ func (m *QueryListItemByOwnerResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Pagination == nil {
-				m.Pagination = &query.PageResponse{}
-			}
-			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Stats.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -8478,7 +17793,7 @@ func (m *QueryListItemByOwnerResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryGetGoogleInAppPurchaseOrderRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryCookbookStatsRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -8501,15 +17816,15 @@ func (m *QueryGetGoogleInAppPurchaseOrderRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryGetGoogleInAppPurchaseOrderRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryCookbookStatsRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryGetGoogleInAppPurchaseOrderRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryCookbookStatsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PurchaseToken", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field CookbookId", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -8537,7 +17852,7 @@ func (m *QueryGetGoogleInAppPurchaseOrderRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.PurchaseToken = string(dAtA[iNdEx:postIndex])
+			m.CookbookId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -8560,7 +17875,7 @@ func (m *QueryGetGoogleInAppPurchaseOrderRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryGetGoogleInAppPurchaseOrderResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryCookbookStatsResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -8583,15 +17898,15 @@ func (m *QueryGetGoogleInAppPurchaseOrderResponse) Unmarshal(dAtA []byte) error
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryGetGoogleInAppPurchaseOrderResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryCookbookStatsResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryGetGoogleInAppPurchaseOrderResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryCookbookStatsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Order", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Stats", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -8618,7 +17933,7 @@ func (m *QueryGetGoogleInAppPurchaseOrderResponse) Unmarshal(dAtA []byte) error
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Order.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Stats.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -8643,7 +17958,7 @@ func (m *QueryGetGoogleInAppPurchaseOrderResponse) Unmarshal(dAtA []byte) error
 	}
 	return nil
 }
-func (m *QueryListExecutionsByItemRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryGetLendingRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -8666,17 +17981,17 @@ func (m *QueryListExecutionsByItemRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryListExecutionsByItemRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryGetLendingRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryListExecutionsByItemRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryGetLendingRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CookbookId", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
 			}
-			var stringLen uint64
+			m.Id = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -8686,59 +18001,64 @@ func (m *QueryListExecutionsByItemRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.Id |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthQuery
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthQuery
 			}
-			if postIndex > l {
+			if (iNdEx + skippy) > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.CookbookId = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ItemId", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthQuery
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuery
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryGetLendingResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
 			}
-			if postIndex > l {
+			if iNdEx >= l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ItemId = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 3:
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryGetLendingResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryGetLendingResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Lending", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -8765,10 +18085,7 @@ func (m *QueryListExecutionsByItemRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Pagination == nil {
-				m.Pagination = &query.PageRequest{}
-			}
-			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Lending.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -8793,7 +18110,7 @@ func (m *QueryListExecutionsByItemRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryListExecutionsByItemResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryGetAuctionRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -8816,85 +18133,17 @@ func (m *QueryListExecutionsByItemResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryListExecutionsByItemResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryGetAuctionRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryListExecutionsByItemResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryGetAuctionRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CompletedExecutions", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthQuery
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.CompletedExecutions = append(m.CompletedExecutions, Execution{})
-			if err := m.CompletedExecutions[len(m.CompletedExecutions)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PendingExecutions", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthQuery
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.PendingExecutions = append(m.PendingExecutions, Execution{})
-			if err := m.PendingExecutions[len(m.PendingExecutions)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
 			}
-			var msglen int
+			m.Id = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -8904,28 +18153,11 @@ func (m *QueryListExecutionsByItemResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.Id |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthQuery
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.Pagination == nil {
-				m.Pagination = &query.PageResponse{}
-			}
-			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+				}
 			}
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -8947,7 +18179,7 @@ func (m *QueryListExecutionsByItemResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryListExecutionsByRecipeRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryGetAuctionResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -8970,79 +18202,15 @@ func (m *QueryListExecutionsByRecipeRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryListExecutionsByRecipeRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryGetAuctionResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryListExecutionsByRecipeRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryGetAuctionResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CookbookId", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthQuery
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.CookbookId = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RecipeId", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthQuery
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.RecipeId = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Auction", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -9069,10 +18237,7 @@ func (m *QueryListExecutionsByRecipeRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Pagination == nil {
-				m.Pagination = &query.PageRequest{}
-			}
-			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Auction.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -9097,7 +18262,7 @@ func (m *QueryListExecutionsByRecipeRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryListExecutionsByRecipeResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryGetDutchAuctionRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -9120,17 +18285,17 @@ func (m *QueryListExecutionsByRecipeResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryListExecutionsByRecipeResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryGetDutchAuctionRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryListExecutionsByRecipeResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryGetDutchAuctionRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CompletedExecutions", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
 			}
-			var msglen int
+			m.Id = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -9140,29 +18305,64 @@ func (m *QueryListExecutionsByRecipeResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.Id |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthQuery
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthQuery
 			}
-			if postIndex > l {
+			if (iNdEx + skippy) > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.CompletedExecutions = append(m.CompletedExecutions, Execution{})
-			if err := m.CompletedExecutions[len(m.CompletedExecutions)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryGetDutchAuctionResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
 			}
-			iNdEx = postIndex
-		case 2:
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryGetDutchAuctionResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryGetDutchAuctionResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PendingExecutions", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field DutchAuction", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -9189,14 +18389,13 @@ func (m *QueryListExecutionsByRecipeResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.PendingExecutions = append(m.PendingExecutions, Execution{})
-			if err := m.PendingExecutions[len(m.PendingExecutions)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.DutchAuction.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 3:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field CurrentPrice", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -9223,10 +18422,7 @@ func (m *QueryListExecutionsByRecipeResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Pagination == nil {
-				m.Pagination = &query.PageResponse{}
-			}
-			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.CurrentPrice.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -9251,7 +18447,7 @@ func (m *QueryListExecutionsByRecipeResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryGetExecutionRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryGetItemOfferRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -9274,17 +18470,17 @@ func (m *QueryGetExecutionRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryGetExecutionRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryGetItemOfferRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryGetExecutionRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryGetItemOfferRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 2 {
+			if wireType != 0 {
 				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
 			}
-			var stringLen uint64
+			m.Id = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -9294,24 +18490,11 @@ func (m *QueryGetExecutionRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.Id |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthQuery
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Id = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -9333,7 +18516,7 @@ func (m *QueryGetExecutionRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryGetExecutionResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryGetItemOfferResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -9356,15 +18539,15 @@ func (m *QueryGetExecutionResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryGetExecutionResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryGetItemOfferResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryGetExecutionResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryGetItemOfferResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Execution", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ItemOffer", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -9391,30 +18574,10 @@ func (m *QueryGetExecutionResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Execution.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.ItemOffer.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Completed", wireType)
-			}
-			var v int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				v |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			m.Completed = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -9436,7 +18599,7 @@ func (m *QueryGetExecutionResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryListRecipesByCookbookRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryGetSwapRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -9459,17 +18622,17 @@ func (m *QueryListRecipesByCookbookRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryListRecipesByCookbookRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryGetSwapRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryListRecipesByCookbookRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryGetSwapRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CookbookId", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
 			}
-			var stringLen uint64
+			m.Id = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -9479,27 +18642,64 @@ func (m *QueryListRecipesByCookbookRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.Id |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthQuery
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthQuery
 			}
-			if postIndex > l {
+			if (iNdEx + skippy) > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.CookbookId = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 2:
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryGetSwapResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryGetSwapResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryGetSwapResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Swap", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -9526,10 +18726,7 @@ func (m *QueryListRecipesByCookbookRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Pagination == nil {
-				m.Pagination = &query.PageRequest{}
-			}
-			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Swap.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -9554,7 +18751,7 @@ func (m *QueryListRecipesByCookbookRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryListRecipesByCookbookResponse) Unmarshal(dAtA []byte) error {
+func (m *LongAttributeFilter) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -9577,17 +18774,17 @@ func (m *QueryListRecipesByCookbookResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryListRecipesByCookbookResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: LongAttributeFilter: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryListRecipesByCookbookResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: LongAttributeFilter: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Recipes", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Key", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -9597,31 +18794,29 @@ func (m *QueryListRecipesByCookbookResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Recipes = append(m.Recipes, Recipe{})
-			if err := m.Recipes[len(m.Recipes)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Key = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Min", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -9631,27 +18826,55 @@ func (m *QueryListRecipesByCookbookResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Pagination == nil {
-				m.Pagination = &query.PageResponse{}
+			m.Min = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Max", wireType)
 			}
-			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
 			}
+			m.Max = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -9674,7 +18897,7 @@ func (m *QueryListRecipesByCookbookResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryGetItemRequest) Unmarshal(dAtA []byte) error {
+func (m *DoubleAttributeFilter) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -9697,15 +18920,15 @@ func (m *QueryGetItemRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryGetItemRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: DoubleAttributeFilter: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryGetItemRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: DoubleAttributeFilter: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CookbookId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Key", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -9733,11 +18956,43 @@ func (m *QueryGetItemRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.CookbookId = string(dAtA[iNdEx:postIndex])
+			m.Key = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Min", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Min = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Max", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -9765,7 +19020,7 @@ func (m *QueryGetItemRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Id = string(dAtA[iNdEx:postIndex])
+			m.Max = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -9788,7 +19043,7 @@ func (m *QueryGetItemRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryGetItemResponse) Unmarshal(dAtA []byte) error {
+func (m *StringAttributeFilter) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -9811,17 +19066,17 @@ func (m *QueryGetItemResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryGetItemResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: StringAttributeFilter: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryGetItemResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: StringAttributeFilter: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Item", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Key", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -9831,24 +19086,87 @@ func (m *QueryGetItemResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Item.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			m.Key = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Value", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Value = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Prefix", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
 			}
+			m.Prefix = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -9871,7 +19189,7 @@ func (m *QueryGetItemResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryGetRecipeRequest) Unmarshal(dAtA []byte) error {
+func (m *QuerySearchItemsRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -9894,10 +19212,10 @@ func (m *QueryGetRecipeRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryGetRecipeRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QuerySearchItemsRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryGetRecipeRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QuerySearchItemsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -9934,9 +19252,9 @@ func (m *QueryGetRecipeRequest) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Longs", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -9946,77 +19264,63 @@ func (m *QueryGetRecipeRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Id = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipQuery(dAtA[iNdEx:])
-			if err != nil {
+			m.Longs = append(m.Longs, LongAttributeFilter{})
+			if err := m.Longs[len(m.Longs)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *QueryGetRecipeResponse) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowQuery
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Doubles", wireType)
 			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: QueryGetRecipeResponse: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryGetRecipeResponse: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Doubles = append(m.Doubles, DoubleAttributeFilter{})
+			if err := m.Doubles[len(m.Doubles)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Recipe", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Strings", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -10043,63 +19347,14 @@ func (m *QueryGetRecipeResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Recipe.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Strings = append(m.Strings, StringAttributeFilter{})
+			if err := m.Strings[len(m.Strings)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipQuery(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *QueryListCookbooksByCreatorRequest) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowQuery
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: QueryListCookbooksByCreatorRequest: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryListCookbooksByCreatorRequest: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Owner", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -10127,13 +19382,13 @@ func (m *QueryListCookbooksByCreatorRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Creator = string(dAtA[iNdEx:postIndex])
+			m.Owner = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FilterTradeable", wireType)
 			}
-			var msglen int
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -10143,83 +19398,37 @@ func (m *QueryListCookbooksByCreatorRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				v |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthQuery
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.Pagination == nil {
-				m.Pagination = &query.PageRequest{}
-			}
-			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipQuery(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *QueryListCookbooksByCreatorResponse) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowQuery
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
+			m.FilterTradeable = bool(v != 0)
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Tradeable", wireType)
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: QueryListCookbooksByCreatorResponse: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryListCookbooksByCreatorResponse: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			m.Tradeable = bool(v != 0)
+		case 8:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Cookbooks", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field RecipeId", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -10229,27 +19438,25 @@ func (m *QueryListCookbooksByCreatorResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Cookbooks = append(m.Cookbooks, Cookbook{})
-			if err := m.Cookbooks[len(m.Cookbooks)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.RecipeId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 3:
+		case 9:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
 			}
@@ -10279,7 +19486,7 @@ func (m *QueryListCookbooksByCreatorResponse) Unmarshal(dAtA []byte) error {
 				return io.ErrUnexpectedEOF
 			}
 			if m.Pagination == nil {
-				m.Pagination = &query.PageResponse{}
+				m.Pagination = &query.PageRequest{}
 			}
 			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
@@ -10306,7 +19513,7 @@ func (m *QueryListCookbooksByCreatorResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryGetCookbookRequest) Unmarshal(dAtA []byte) error {
+func (m *QuerySearchItemsResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -10329,17 +19536,17 @@ func (m *QueryGetCookbookRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryGetCookbookRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QuerySearchItemsResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryGetCookbookRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QuerySearchItemsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Items", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -10349,77 +19556,29 @@ func (m *QueryGetCookbookRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Id = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipQuery(dAtA[iNdEx:])
-			if err != nil {
+			m.Items = append(m.Items, Item{})
+			if err := m.Items[len(m.Items)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *QueryGetCookbookResponse) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowQuery
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: QueryGetCookbookResponse: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryGetCookbookResponse: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			iNdEx = postIndex
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Cookbook", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -10446,7 +19605,10 @@ func (m *QueryGetCookbookResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Cookbook.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -3,6 +3,7 @@ package types
 import (
 	"errors"
 
+	"github.com/google/cel-go/cel"
 	"github.com/rogpeppe/go-internal/semver"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
@@ -82,6 +83,10 @@ func ItemInputsEqual(original, updated []ItemInput) bool {
 			itemA := original[i]
 			itemB := updated[i]
 
+			if itemA.Amount != itemB.Amount || itemA.CookbookId != itemB.CookbookId || itemA.Condition != itemB.Condition {
+				return false
+			}
+
 			if len(itemA.Longs) == len(itemB.Longs) {
 				for j := range itemA.Longs {
 					if itemA.Longs[j] != itemB.Longs[j] {
@@ -168,6 +173,18 @@ func EntriesListEqual(original, updated EntriesList) (bool, error) {
 				return false, nil
 			}
 
+			if originalItem.Fungible != updatedItem.Fungible || originalItem.Amount != updatedItem.Amount {
+				return false, nil
+			}
+
+			if originalItem.ExpiryBlocks != updatedItem.ExpiryBlocks || originalItem.ExpirySeconds != updatedItem.ExpirySeconds {
+				return false, nil
+			}
+
+			if originalItem.TransferPolicy != updatedItem.TransferPolicy {
+				return false, nil
+			}
+
 			if len(originalItem.TransferFee) != len(updatedItem.TransferFee) {
 				return false, nil
 			}
@@ -538,6 +555,25 @@ func ValidateItemInput(i ItemInput) error {
 		return err
 	}
 
+	if i.CookbookId != "" {
+		err = ValidateID(i.CookbookId)
+		if err != nil {
+			return err
+		}
+	}
+
+	// the condition is only parsed here since the attributes it references depend on the matched item, it is checked
+	// when evaluated against each item, an item for which it cannot be evaluated not matching the input
+	if i.Condition != "" {
+		env, err := cel.NewEnv()
+		if err != nil {
+			return err
+		}
+		if _, issues := env.Parse(i.Condition); issues != nil && issues.Err() != nil {
+			return sdkerrors.Wrapf(ErrInvalidRequestField, "invalid item input condition: %s", issues.Err().Error())
+		}
+	}
+
 	return nil
 }
 
@@ -696,6 +732,18 @@ func ValidateItemOutputs(io []ItemOutput, idMap map[string]bool) error {
 				return sdkerrors.Wrapf(ErrInvalidRequestField, "invalid transferFee on ItemOutput %s", item.Id)
 			}
 		}
+
+		if !item.Fungible && item.Amount != 0 {
+			return sdkerrors.Wrapf(ErrInvalidRequestField, "amount can only be set on fungible ItemOutput %s", item.Id)
+		}
+
+		if item.ExpiryBlocks < 0 || item.ExpirySeconds < 0 {
+			return sdkerrors.Wrapf(ErrInvalidRequestField, "invalid expiry on ItemOutput %s", item.Id)
+		}
+
+		if item.TransferPolicy.LockBlocks < 0 {
+			return sdkerrors.Wrapf(ErrInvalidRequestField, "invalid transfer lock on ItemOutput %s", item.Id)
+		}
 	}
 	return nil
 }
@@ -197,6 +197,12 @@ type ItemInput struct {
 	Doubles []DoubleInputParam `protobuf:"bytes,2,rep,name=doubles,proto3" json:"doubles"`
 	Longs   []LongInputParam   `protobuf:"bytes,3,rep,name=longs,proto3" json:"longs"`
 	Strings []StringInputParam `protobuf:"bytes,4,rep,name=strings,proto3" json:"strings"`
+	// amount defines the number of units consumed from a fungible item. A 0 value consumes the whole item
+	Amount uint64 `protobuf:"varint,5,opt,name=amount,proto3" json:"amount,omitempty,string"`
+	// cookbook_id restricts the matched items to the items of a cookbook. An empty value matches the items of any cookbook
+	CookbookId string `protobuf:"bytes,6,opt,name=cookbook_id,json=cookbookId,proto3" json:"cookbook_id,omitempty"`
+	// condition is a CEL expression evaluated on the attributes of the matched item, which must evaluate to true
+	Condition string `protobuf:"bytes,7,opt,name=condition,proto3" json:"condition,omitempty"`
 }
 
 func (m *ItemInput) Reset()         { *m = ItemInput{} }
@@ -260,6 +266,27 @@ func (m *ItemInput) GetStrings() []StringInputParam {
 	return nil
 }
 
+func (m *ItemInput) GetAmount() uint64 {
+	if m != nil {
+		return m.Amount
+	}
+	return 0
+}
+
+func (m *ItemInput) GetCookbookId() string {
+	if m != nil {
+		return m.CookbookId
+	}
+	return ""
+}
+
+func (m *ItemInput) GetCondition() string {
+	if m != nil {
+		return m.Condition
+	}
+	return ""
+}
+
 // DoubleWeightRange describes weight range that produce double value
 type DoubleWeightRange struct {
 	Lower  github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,1,opt,name=lower,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"lower"`
@@ -630,6 +657,16 @@ type ItemOutput struct {
 	Quantity     uint64 `protobuf:"varint,8,opt,name=quantity,proto3" json:"quantity,omitempty,string"`
 	AmountMinted uint64 `protobuf:"varint,9,opt,name=amount_minted,json=amountMinted,proto3" json:"amount_minted,omitempty,string"`
 	Tradeable    bool   `protobuf:"varint,10,opt,name=tradeable,proto3" json:"tradeable,omitempty"`
+	// fungible items are stackable, identical units are merged in a single item record holding a quantity
+	Fungible bool `protobuf:"varint,11,opt,name=fungible,proto3" json:"fungible,omitempty"`
+	// amount defines the number of units minted for a fungible item. A 0 value mints a single unit
+	Amount uint64 `protobuf:"varint,12,opt,name=amount,proto3" json:"amount,omitempty,string"`
+	// number of blocks after minting when the item expires. A 0 value indicates no expiry
+	ExpiryBlocks int64 `protobuf:"varint,13,opt,name=expiry_blocks,json=expiryBlocks,proto3" json:"expiry_blocks,omitempty,string"`
+	// number of seconds after minting when the item expires. A 0 value indicates no expiry
+	ExpirySeconds int64 `protobuf:"varint,14,opt,name=expiry_seconds,json=expirySeconds,proto3" json:"expiry_seconds,omitempty,string"`
+	// transfer restrictions of the minted items
+	TransferPolicy ItemTransferPolicy `protobuf:"bytes,15,opt,name=transfer_policy,json=transferPolicy,proto3" json:"transfer_policy"`
 }
 
 func (m *ItemOutput) Reset()         { *m = ItemOutput{} }
@@ -728,6 +765,41 @@ func (m *ItemOutput) GetTradeable() bool {
 	return false
 }
 
+func (m *ItemOutput) GetFungible() bool {
+	if m != nil {
+		return m.Fungible
+	}
+	return false
+}
+
+func (m *ItemOutput) GetAmount() uint64 {
+	if m != nil {
+		return m.Amount
+	}
+	return 0
+}
+
+func (m *ItemOutput) GetExpiryBlocks() int64 {
+	if m != nil {
+		return m.ExpiryBlocks
+	}
+	return 0
+}
+
+func (m *ItemOutput) GetExpirySeconds() int64 {
+	if m != nil {
+		return m.ExpirySeconds
+	}
+	return 0
+}
+
+func (m *ItemOutput) GetTransferPolicy() ItemTransferPolicy {
+	if m != nil {
+		return m.TransferPolicy
+	}
+	return ItemTransferPolicy{}
+}
+
 // ItemModifyOutput describes what is modified from item input
 type ItemModifyOutput struct {
 	Id           string        `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
@@ -1193,87 +1265,96 @@ func init() {
 func init() { proto.RegisterFile("pylons/pylons/recipe.proto", fileDescriptor_b7afbd12656b9308) }
 
 var fileDescriptor_b7afbd12656b9308 = []byte{
-	// 1279 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x57, 0xcb, 0x6e, 0xdb, 0x46,
-	0x14, 0x35, 0xf5, 0xb0, 0xc5, 0x2b, 0x59, 0x76, 0xa6, 0x41, 0xcb, 0x38, 0x8d, 0xe4, 0x08, 0x6d,
-	0xe1, 0x45, 0x22, 0xe5, 0xd1, 0x4d, 0xb2, 0x68, 0x10, 0x35, 0x0f, 0xa8, 0x49, 0x10, 0x83, 0x41,
-	0x53, 0xb4, 0x28, 0x40, 0x50, 0xe4, 0x48, 0x19, 0x48, 0x9c, 0x61, 0xc9, 0x91, 0x63, 0xed, 0xba,
-	0xc9, 0xba, 0xfd, 0x8d, 0xf6, 0x23, 0xba, 0xce, 0x32, 0x8b, 0x2e, 0xd2, 0x2e, 0xd4, 0x22, 0xd9,
-	0x19, 0xfd, 0x88, 0x62, 0x1e, 0x94, 0x28, 0x9a, 0xce, 0xc3, 0xf1, 0x4a, 0xe4, 0xbd, 0x3c, 0x67,
-	0x66, 0xee, 0x3d, 0xf7, 0x90, 0x82, 0xad, 0x70, 0x3a, 0x66, 0x34, 0xee, 0xe8, 0x9f, 0x08, 0x7b,
-	0x24, 0xc4, 0xed, 0x30, 0x62, 0x9c, 0xa1, 0x75, 0x15, 0x6c, 0xab, 0x9f, 0xad, 0xd3, 0x43, 0x36,
-	0x64, 0x32, 0xd3, 0x11, 0x57, 0xea, 0xa1, 0xad, 0x86, 0xc7, 0xe2, 0x80, 0xc5, 0x9d, 0xbe, 0x1b,
-	0xe3, 0xce, 0xde, 0xe5, 0x3e, 0xe6, 0xee, 0xe5, 0x8e, 0xc7, 0x08, 0xd5, 0x79, 0x6b, 0x79, 0x01,
-	0xc2, 0x71, 0xa0, 0x32, 0xad, 0x3f, 0x0c, 0xd8, 0xbc, 0xc5, 0x26, 0xfd, 0x31, 0xee, 0xd1, 0x70,
-	0xc2, 0x77, 0xdd, 0xc8, 0x0d, 0xd0, 0x26, 0x14, 0x47, 0x78, 0x6a, 0x19, 0xdb, 0xc6, 0x8e, 0x69,
-	0x8b, 0x4b, 0x74, 0x0f, 0xcc, 0x80, 0x50, 0x67, 0xcf, 0x1d, 0x4f, 0xb0, 0x55, 0x10, 0xf1, 0x6e,
-	0xfb, 0xf9, 0xac, 0xb9, 0xf2, 0xf7, 0xac, 0xf9, 0xc5, 0x90, 0xf0, 0x27, 0x93, 0x7e, 0xdb, 0x63,
-	0x41, 0x47, 0x6f, 0x43, 0xfd, 0x5c, 0x8c, 0xfd, 0x51, 0x87, 0x4f, 0x43, 0x1c, 0xb7, 0x6f, 0x61,
-	0xcf, 0xae, 0x04, 0x84, 0x3e, 0x16, 0x78, 0x49, 0xe6, 0xee, 0x6b, 0xb2, 0xe2, 0x31, 0xc9, 0xdc,
-	0x7d, 0x49, 0xd6, 0xfa, 0x11, 0xea, 0xf7, 0x19, 0x1d, 0xbe, 0x71, 0xf7, 0x67, 0xb3, 0xbb, 0x2f,
-	0xa6, 0x76, 0x73, 0x36, 0xbb, 0x9b, 0x62, 0x8a, 0xfd, 0x3a, 0x6c, 0x3e, 0xe2, 0x11, 0x79, 0x0b,
-	0xff, 0x69, 0x28, 0xa7, 0x2a, 0x63, 0xab, 0x9b, 0xd6, 0x4b, 0x03, 0xcc, 0x1e, 0xc7, 0x81, 0x84,
-	0xa2, 0x3a, 0x14, 0x88, 0xaf, 0x41, 0x05, 0xe2, 0xa3, 0x1b, 0xb0, 0xe6, 0xcb, 0xba, 0xc7, 0x56,
-	0x61, 0xbb, 0xb8, 0x53, 0xbd, 0xd2, 0x6c, 0x2f, 0x75, 0xba, 0x9d, 0xed, 0x4a, 0xb7, 0x24, 0x6a,
-	0x64, 0x27, 0x28, 0x74, 0x0d, 0xca, 0x63, 0x46, 0x87, 0xb1, 0x55, 0x94, 0xf0, 0x73, 0x19, 0xf8,
-	0x72, 0x51, 0x34, 0x58, 0x21, 0xc4, 0xda, 0xb1, 0x3c, 0x55, 0x6c, 0x95, 0x72, 0xd7, 0xce, 0x9e,
-	0x39, 0x59, 0x5b, 0xa3, 0x5a, 0x7f, 0x1a, 0x70, 0x4a, 0xed, 0xef, 0x3b, 0x4c, 0x86, 0x4f, 0xb8,
-	0xed, 0xd2, 0x21, 0x46, 0xb7, 0xc4, 0x8e, 0x9e, 0xe2, 0x48, 0x9d, 0xf2, 0xbd, 0x7b, 0xaa, 0xc0,
-	0x82, 0x65, 0x12, 0x86, 0x38, 0x3a, 0xa6, 0xcc, 0x14, 0x18, 0x5d, 0x85, 0xd5, 0xa7, 0x72, 0x6b,
-	0xb2, 0xa5, 0xa5, 0xee, 0xd9, 0x83, 0x59, 0xf3, 0x13, 0x15, 0xb9, 0xc0, 0x02, 0x31, 0x00, 0x21,
-	0x9f, 0x5e, 0x50, 0xe7, 0xb1, 0xf5, 0xa3, 0xad, 0x67, 0x06, 0x54, 0xd5, 0xb1, 0x8e, 0xea, 0xf4,
-	0x37, 0x50, 0x7b, 0xba, 0x38, 0x71, 0xd2, 0xba, 0xed, 0xdc, 0xd6, 0xa5, 0x4a, 0xa3, 0xeb, 0xb7,
-	0x84, 0x45, 0x16, 0xac, 0x85, 0x11, 0x1b, 0x46, 0x6e, 0xa0, 0x86, 0xc0, 0x4e, 0x6e, 0x5b, 0xbf,
-	0x19, 0x50, 0xef, 0x51, 0x9e, 0xae, 0xed, 0xa5, 0x74, 0x6d, 0x8b, 0xdd, 0xad, 0x83, 0x59, 0xf3,
-	0x63, 0x19, 0x38, 0x7c, 0x1a, 0x5d, 0xc7, 0x4b, 0xe9, 0x3a, 0x6a, 0x84, 0x0c, 0xe4, 0x20, 0x3e,
-	0xa0, 0x66, 0x3f, 0x1b, 0x60, 0x0a, 0xad, 0x1d, 0x55, 0xb1, 0xbb, 0xb9, 0x15, 0xcb, 0xaa, 0x75,
-	0xf9, 0xb4, 0xef, 0x59, 0xae, 0x87, 0x50, 0x55, 0x82, 0x7d, 0xaf, 0xf9, 0x7c, 0x03, 0xe1, 0x08,
-	0xe0, 0x6b, 0x46, 0xe8, 0xc3, 0x09, 0xcf, 0x9b, 0xdc, 0xab, 0x50, 0x12, 0xd6, 0x2a, 0xc9, 0xaa,
-	0x57, 0xce, 0xb4, 0x95, 0x0c, 0xdb, 0xc2, 0x7b, 0xdb, 0xda, 0x7b, 0xdb, 0x02, 0xae, 0x4f, 0x21,
-	0x1f, 0x7e, 0xc3, 0x62, 0x7f, 0x95, 0x00, 0x84, 0x4d, 0x1c, 0xb1, 0xda, 0xf5, 0xac, 0x4f, 0x6c,
-	0xe5, 0x8a, 0x2d, 0xd7, 0x22, 0xbe, 0x5c, 0xb6, 0x08, 0x2b, 0xc7, 0x22, 0x72, 0xdc, 0xe1, 0x7a,
-	0xd6, 0x1d, 0xb6, 0x72, 0xdd, 0x21, 0xcf, 0x18, 0xd0, 0x7d, 0xd8, 0x08, 0x26, 0xdc, 0xed, 0x8f,
-	0xb1, 0x93, 0x70, 0x94, 0x73, 0x1b, 0xae, 0x38, 0xee, 0xe1, 0xa9, 0xf4, 0x59, 0x4d, 0x53, 0xd7,
-	0xd8, 0x47, 0x9a, 0xad, 0x0b, 0x35, 0x1e, 0xb9, 0x34, 0x1e, 0xe0, 0xc8, 0x19, 0x60, 0x6c, 0xad,
-	0x4a, 0xaa, 0xb7, 0x56, 0xbc, 0x9a, 0x80, 0xee, 0x60, 0x8c, 0xbe, 0x87, 0x4d, 0x1e, 0xb9, 0x3e,
-	0x76, 0x42, 0x1c, 0x79, 0x98, 0x72, 0x77, 0x88, 0xad, 0xb5, 0x63, 0x39, 0xcb, 0x86, 0xe4, 0xd9,
-	0x9d, 0xd3, 0xa0, 0x6b, 0x50, 0xf9, 0x69, 0xe2, 0x52, 0x4e, 0xf8, 0xd4, 0xaa, 0xc8, 0x89, 0x39,
-	0x77, 0x30, 0x6b, 0x9e, 0x49, 0x62, 0x87, 0x67, 0x66, 0xfe, 0x38, 0xba, 0x0b, 0xeb, 0x6e, 0xc0,
-	0x26, 0x94, 0x3b, 0x01, 0xa1, 0x1c, 0xfb, 0x96, 0x29, 0xf1, 0xad, 0x83, 0x59, 0xb3, 0xb1, 0x94,
-	0x38, 0x4c, 0x52, 0x53, 0xf9, 0x07, 0x32, 0x8d, 0x3e, 0x05, 0x53, 0x6e, 0x4b, 0x94, 0xcd, 0x82,
-	0x6d, 0x63, 0xa7, 0x62, 0x2f, 0x02, 0xad, 0x67, 0x65, 0xd8, 0x14, 0xda, 0x7a, 0xc0, 0x7c, 0x32,
-	0x98, 0x1e, 0xa1, 0xb0, 0xcf, 0xa0, 0x2e, 0x96, 0x70, 0x88, 0xb0, 0x7b, 0x27, 0xc2, 0x03, 0x3d,
-	0x26, 0x35, 0x92, 0xbc, 0xbc, 0x6c, 0x3c, 0x48, 0xeb, 0xb0, 0x78, 0x6c, 0x1d, 0x96, 0x8e, 0xa9,
-	0xc3, 0xf2, 0x09, 0xe8, 0x70, 0xf5, 0xe4, 0x74, 0xb8, 0x76, 0x42, 0x3a, 0xac, 0x9c, 0xbc, 0x0e,
-	0xcd, 0x0f, 0xd4, 0x21, 0x9c, 0x84, 0x0e, 0xab, 0x59, 0x1d, 0xfe, 0x67, 0x40, 0xf5, 0x36, 0xe5,
-	0x11, 0xc1, 0xf1, 0x7d, 0x12, 0x73, 0x51, 0x50, 0xe1, 0x8a, 0x0e, 0x93, 0x8a, 0x8c, 0x2d, 0x43,
-	0x17, 0x74, 0xb9, 0x37, 0x0b, 0x0f, 0x4e, 0x0a, 0xea, 0xcd, 0x23, 0xb2, 0x29, 0x52, 0xb6, 0x09,
-	0x47, 0x21, 0x97, 0x63, 0xe1, 0xac, 0x09, 0x07, 0x99, 0x47, 0x62, 0xf4, 0x2d, 0x7c, 0x24, 0x39,
-	0x02, 0x39, 0x1f, 0x73, 0xaa, 0x62, 0xee, 0x47, 0x51, 0x76, 0x90, 0x34, 0xe1, 0x29, 0x92, 0x89,
-	0xc7, 0x2d, 0x02, 0x1b, 0xea, 0x6d, 0x86, 0xfd, 0x64, 0xa5, 0xf3, 0x60, 0x62, 0xca, 0xa3, 0xa9,
-	0x43, 0x7c, 0x75, 0x5c, 0x53, 0xc3, 0x2b, 0x32, 0xdc, 0xf3, 0xe3, 0xd4, 0xeb, 0xb7, 0xf0, 0xee,
-	0xaf, 0x5f, 0x0a, 0xa6, 0x28, 0x93, 0xfa, 0xc6, 0x74, 0xa1, 0x2c, 0x2a, 0xb4, 0xa8, 0xe7, 0x91,
-	0x02, 0xbd, 0x24, 0xd6, 0xfe, 0xfd, 0x9f, 0xe6, 0xce, 0x3b, 0x68, 0x4e, 0x00, 0x62, 0x5b, 0x31,
-	0xb7, 0x7e, 0x29, 0xc3, 0xaa, 0x2d, 0xff, 0x9f, 0xa0, 0x26, 0x54, 0x3d, 0xc6, 0x46, 0x7d, 0xc6,
-	0x46, 0xce, 0xdc, 0x50, 0x20, 0x09, 0xf5, 0x7c, 0x6d, 0x34, 0x85, 0xb9, 0xd1, 0x9c, 0x87, 0x1a,
-	0x65, 0x3e, 0x76, 0xf6, 0x70, 0x14, 0x13, 0x46, 0xd5, 0x57, 0x86, 0x5d, 0x15, 0xb1, 0xc7, 0x2a,
-	0x84, 0x10, 0x94, 0xa8, 0x1b, 0x60, 0xab, 0x24, 0x41, 0xf2, 0x1a, 0x6d, 0x43, 0xd5, 0xc7, 0xb1,
-	0x17, 0x91, 0x90, 0x0b, 0x54, 0x59, 0xa6, 0xd2, 0x21, 0xf1, 0x72, 0x4d, 0x38, 0x57, 0xd5, 0xcb,
-	0x55, 0xdf, 0xa2, 0x1b, 0x20, 0x35, 0xa3, 0xbc, 0x2d, 0xd6, 0x83, 0x6b, 0xe5, 0xe8, 0x4c, 0x16,
-	0x50, 0xb7, 0x04, 0xbc, 0x24, 0x20, 0x3e, 0x95, 0xab, 0x0b, 0x73, 0x8c, 0xad, 0x4a, 0x2e, 0xc1,
-	0xfc, 0x2b, 0x3f, 0x21, 0x98, 0x3b, 0xa7, 0x74, 0x31, 0xac, 0x94, 0x2f, 0x67, 0xf3, 0xb0, 0x8b,
-	0xa5, 0xe6, 0x22, 0x71, 0x31, 0x0d, 0x40, 0x5f, 0xc1, 0x5a, 0x22, 0x49, 0x90, 0x0b, 0x37, 0x32,
-	0xd8, 0x8c, 0xca, 0x12, 0xbc, 0x06, 0xa1, 0xcf, 0xa1, 0xde, 0x1f, 0x33, 0x6f, 0xe4, 0x88, 0x19,
-	0x8d, 0xf6, 0xdc, 0xb1, 0x9c, 0xcc, 0xa2, 0xbd, 0x2e, 0xa3, 0x3d, 0x1d, 0x44, 0xb7, 0xa1, 0xee,
-	0xb1, 0x98, 0x0b, 0x67, 0x72, 0x64, 0xc6, 0xaa, 0xbd, 0xdb, 0xa7, 0x4d, 0x4d, 0xc0, 0x76, 0x71,
-	0xd4, 0x15, 0x20, 0xd1, 0x05, 0x4c, 0xc5, 0xb8, 0xfb, 0xd6, 0xba, 0x34, 0x80, 0xe4, 0x16, 0x9d,
-	0x03, 0xc0, 0xfb, 0x3c, 0x72, 0x1d, 0x42, 0x07, 0xcc, 0xaa, 0xcb, 0x16, 0x99, 0x32, 0xd2, 0xa3,
-	0x03, 0x26, 0xd2, 0x5e, 0x84, 0x5d, 0x8e, 0x7d, 0xc7, 0xe5, 0xd6, 0x86, 0xdc, 0xa2, 0xa9, 0x23,
-	0x37, 0xb9, 0x48, 0x4f, 0x42, 0x3f, 0x49, 0x6f, 0xaa, 0xb4, 0x8e, 0xdc, 0xe4, 0xdd, 0x3b, 0xcf,
-	0x5f, 0x35, 0x8c, 0x17, 0xaf, 0x1a, 0xc6, 0xbf, 0xaf, 0x1a, 0xc6, 0xaf, 0xaf, 0x1b, 0x2b, 0x2f,
-	0x5e, 0x37, 0x56, 0x5e, 0xbe, 0x6e, 0xac, 0xfc, 0x70, 0x21, 0x25, 0xee, 0x5d, 0x59, 0xb0, 0x8b,
-	0x1c, 0x7b, 0x4f, 0x92, 0x7f, 0xc1, 0xfb, 0xc9, 0x85, 0x94, 0x79, 0x7f, 0x55, 0xfe, 0x21, 0xbe,
-	0xfa, 0x7f, 0x00, 0x00, 0x00, 0xff, 0xff, 0xb6, 0x23, 0x3e, 0xf4, 0x8d, 0x0f, 0x00, 0x00,
+	// 1420 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x58, 0x4b, 0x6f, 0xdb, 0x46,
+	0x10, 0x36, 0x25, 0xcb, 0x92, 0x46, 0xb2, 0xec, 0xb0, 0x41, 0xcb, 0x28, 0x8d, 0xa4, 0xa8, 0x0f,
+	0xf8, 0x90, 0x48, 0x79, 0xf4, 0x92, 0x1c, 0x1a, 0x44, 0xcd, 0x03, 0xca, 0x03, 0x31, 0x98, 0x36,
+	0x45, 0x8b, 0x02, 0x04, 0x45, 0xae, 0x94, 0x85, 0x45, 0x2e, 0x4b, 0xae, 0x1c, 0xeb, 0xd6, 0x4b,
+	0xce, 0x6d, 0x7f, 0x46, 0xfb, 0x23, 0x7a, 0xce, 0xa1, 0x87, 0x1c, 0x7a, 0x28, 0x7a, 0x50, 0x8b,
+	0xe4, 0x66, 0xf4, 0x47, 0x14, 0x3b, 0xbb, 0x94, 0x28, 0x9a, 0x4e, 0x1d, 0xc7, 0x27, 0x91, 0x33,
+	0x3b, 0xdf, 0xee, 0x7e, 0x3b, 0xf3, 0xcd, 0x52, 0x50, 0x0f, 0xa6, 0x63, 0xe6, 0x47, 0x5d, 0xf5,
+	0x13, 0x12, 0x87, 0x06, 0xa4, 0x13, 0x84, 0x8c, 0x33, 0x7d, 0x5d, 0x1a, 0x3b, 0xf2, 0xa7, 0x7e,
+	0x7a, 0xc4, 0x46, 0x0c, 0x3d, 0x5d, 0xf1, 0x24, 0x07, 0xd5, 0x1b, 0x0e, 0x8b, 0x3c, 0x16, 0x75,
+	0x07, 0x76, 0x44, 0xba, 0xbb, 0x97, 0x07, 0x84, 0xdb, 0x97, 0xbb, 0x0e, 0xa3, 0xbe, 0xf2, 0x1b,
+	0xcb, 0x13, 0x50, 0x4e, 0x3c, 0xe9, 0x69, 0xff, 0xa6, 0xc1, 0xe6, 0x2d, 0x36, 0x19, 0x8c, 0x49,
+	0xdf, 0x0f, 0x26, 0x7c, 0xdb, 0x0e, 0x6d, 0x4f, 0xdf, 0x84, 0xfc, 0x0e, 0x99, 0x1a, 0x5a, 0x4b,
+	0xdb, 0x2a, 0x9b, 0xe2, 0x51, 0xbf, 0x0f, 0x65, 0x8f, 0xfa, 0xd6, 0xae, 0x3d, 0x9e, 0x10, 0x23,
+	0x27, 0xec, 0xbd, 0xce, 0x8b, 0x59, 0x73, 0xe5, 0xaf, 0x59, 0xf3, 0xd3, 0x11, 0xe5, 0x4f, 0x27,
+	0x83, 0x8e, 0xc3, 0xbc, 0xae, 0x5a, 0x86, 0xfc, 0xb9, 0x18, 0xb9, 0x3b, 0x5d, 0x3e, 0x0d, 0x48,
+	0xd4, 0xb9, 0x45, 0x1c, 0xb3, 0xe4, 0x51, 0xff, 0x89, 0x88, 0x47, 0x30, 0x7b, 0x4f, 0x81, 0xe5,
+	0x8f, 0x09, 0x66, 0xef, 0x21, 0x58, 0xfb, 0x3b, 0xa8, 0x3d, 0x60, 0xfe, 0xe8, 0x8d, 0xab, 0x3f,
+	0x9b, 0x5e, 0x7d, 0x3e, 0xb1, 0x9a, 0xb3, 0xe9, 0xd5, 0xe4, 0x13, 0xe8, 0xd7, 0x61, 0xf3, 0x31,
+	0x0f, 0xe9, 0xff, 0xe0, 0x9f, 0x86, 0x42, 0x82, 0x19, 0x53, 0xbe, 0xb4, 0x7f, 0xcf, 0x41, 0xb9,
+	0xcf, 0x89, 0x87, 0xa1, 0x7a, 0x0d, 0x72, 0xd4, 0x55, 0x41, 0x39, 0xea, 0xea, 0x37, 0xa0, 0xe8,
+	0x22, 0xef, 0x91, 0x91, 0x6b, 0xe5, 0xb7, 0x2a, 0x57, 0x9a, 0x9d, 0xa5, 0x93, 0xee, 0xa4, 0x4f,
+	0xa5, 0xb7, 0x2a, 0x38, 0x32, 0xe3, 0x28, 0xfd, 0x1a, 0x14, 0xc6, 0xcc, 0x1f, 0x45, 0x46, 0x1e,
+	0xc3, 0xcf, 0xa5, 0xc2, 0x97, 0x49, 0x51, 0xc1, 0x32, 0x42, 0xcc, 0x1d, 0xe1, 0xae, 0x22, 0x63,
+	0x35, 0x73, 0xee, 0xf4, 0x9e, 0xe3, 0xb9, 0x55, 0x94, 0x7e, 0x15, 0xd6, 0x6c, 0x8f, 0x4d, 0x7c,
+	0x6e, 0x14, 0x5a, 0xda, 0xd6, 0x6a, 0xef, 0xec, 0xfe, 0xac, 0xf9, 0x81, 0xb4, 0x5c, 0x60, 0x9e,
+	0x48, 0xaf, 0x80, 0x4f, 0x2f, 0xc8, 0xd1, 0xa6, 0x1a, 0xaa, 0x37, 0xa1, 0xe2, 0x30, 0xb6, 0x33,
+	0x60, 0x6c, 0xc7, 0xa2, 0xae, 0xb1, 0x86, 0x54, 0x40, 0x6c, 0xea, 0xbb, 0xfa, 0x87, 0x50, 0x76,
+	0x98, 0xef, 0x52, 0x4e, 0x99, 0x6f, 0x14, 0xd1, 0xbd, 0x30, 0xb4, 0xff, 0xd0, 0xe0, 0x94, 0xe4,
+	0xe4, 0x6b, 0x42, 0x47, 0x4f, 0xb9, 0x69, 0xfb, 0x23, 0xa2, 0xdf, 0x12, 0x2c, 0x3c, 0x23, 0xa1,
+	0xa1, 0x1d, 0x2b, 0x8f, 0x64, 0xb0, 0x40, 0x99, 0x04, 0x01, 0x09, 0x8f, 0x99, 0xda, 0x32, 0x58,
+	0xb0, 0xf2, 0x0c, 0x97, 0x66, 0xe4, 0x17, 0xac, 0x48, 0x4b, 0x06, 0x2b, 0xd2, 0xd1, 0x7e, 0xae,
+	0x41, 0x45, 0x6e, 0xeb, 0xb0, 0xec, 0xba, 0x07, 0xd5, 0x67, 0x8b, 0x1d, 0xc7, 0xe9, 0xd2, 0xca,
+	0x4c, 0x97, 0x04, 0x35, 0xea, 0xcc, 0x96, 0x62, 0x75, 0x03, 0x8a, 0x41, 0xc8, 0x46, 0xa1, 0xed,
+	0xc9, 0xc2, 0x33, 0xe3, 0xd7, 0xf6, 0x2f, 0x1a, 0xd4, 0xfa, 0x3e, 0x4f, 0x72, 0x7b, 0x29, 0xc9,
+	0x6d, 0xbe, 0x57, 0xdf, 0x9f, 0x35, 0xdf, 0x47, 0xc3, 0xc1, 0xdd, 0x28, 0x1e, 0x2f, 0x25, 0x79,
+	0x54, 0x11, 0x68, 0xc8, 0x88, 0x78, 0x07, 0xce, 0x7e, 0xd0, 0xa0, 0x2c, 0xf2, 0xfb, 0x30, 0xc6,
+	0xee, 0x66, 0x32, 0x96, 0xae, 0x90, 0xe5, 0xdd, 0xbe, 0x25, 0x5d, 0x8f, 0xa0, 0x22, 0x8b, 0xe4,
+	0xad, 0x34, 0xe1, 0x0d, 0x80, 0x3b, 0x00, 0x5f, 0x30, 0xea, 0x3f, 0x9a, 0xf0, 0x2c, 0xb5, 0xb8,
+	0x0a, 0xab, 0x42, 0xce, 0x11, 0xac, 0x72, 0xe5, 0x4c, 0x47, 0xa6, 0x61, 0x47, 0xe8, 0x7d, 0x47,
+	0xe9, 0x7d, 0x47, 0x84, 0xab, 0x5d, 0xe0, 0xe0, 0x37, 0x4c, 0xf6, 0x73, 0x11, 0x40, 0x48, 0xd3,
+	0x21, 0xb3, 0x5d, 0x4f, 0x6b, 0x53, 0x3d, 0x33, 0xd9, 0x32, 0x65, 0xe9, 0xb3, 0x65, 0x59, 0x32,
+	0x32, 0x64, 0x29, 0x43, 0x91, 0xae, 0xa7, 0x15, 0xa9, 0x9e, 0xa9, 0x48, 0x99, 0x62, 0xf4, 0x00,
+	0x36, 0xbc, 0x09, 0xb7, 0x07, 0x63, 0x62, 0xc5, 0x18, 0x85, 0xcc, 0x03, 0x97, 0x18, 0xf7, 0xc9,
+	0x14, 0xb5, 0x5d, 0xc1, 0xd4, 0x54, 0xec, 0x63, 0x85, 0xd6, 0x83, 0x2a, 0x0f, 0x6d, 0x3f, 0x1a,
+	0x92, 0xd0, 0x1a, 0x12, 0x62, 0xac, 0xb5, 0xf2, 0x47, 0x61, 0xbc, 0x12, 0x07, 0xdd, 0x21, 0x44,
+	0xff, 0x06, 0x36, 0x79, 0x68, 0xbb, 0xc4, 0x0a, 0x48, 0xe8, 0x10, 0x9f, 0xdb, 0x23, 0x62, 0x14,
+	0x8f, 0xa5, 0x2c, 0x1b, 0x88, 0xb3, 0x3d, 0x87, 0xd1, 0xaf, 0x41, 0xe9, 0xfb, 0x89, 0xed, 0x73,
+	0xca, 0xa7, 0x46, 0x09, 0x2b, 0xe6, 0xdc, 0xfe, 0xac, 0x79, 0x26, 0xb6, 0x1d, 0xac, 0x99, 0xf9,
+	0x70, 0xfd, 0x2e, 0xac, 0x4b, 0x25, 0xb6, 0x3c, 0xea, 0x73, 0xe2, 0x1a, 0x65, 0x8c, 0x6f, 0xef,
+	0xcf, 0x9a, 0x8d, 0x25, 0xc7, 0x41, 0x90, 0xaa, 0xf4, 0x3f, 0x44, 0xb7, 0xd0, 0x69, 0x5c, 0x96,
+	0xa0, 0xcd, 0x80, 0x96, 0xb6, 0x55, 0x32, 0x17, 0x06, 0xbd, 0x0e, 0xa5, 0xe1, 0xc4, 0x1f, 0x51,
+	0xe1, 0xac, 0xa0, 0x73, 0xfe, 0x9e, 0xe8, 0x1b, 0xd5, 0xa3, 0xf7, 0x8d, 0xbb, 0xb0, 0x4e, 0xf6,
+	0x02, 0x1a, 0x4e, 0xad, 0xc1, 0x98, 0x39, 0x3b, 0x91, 0xb1, 0x8e, 0xe2, 0x82, 0xeb, 0x5e, 0x72,
+	0x64, 0xac, 0x5b, 0xfa, 0x7b, 0xe8, 0xd6, 0xef, 0x41, 0x4d, 0x8d, 0x8f, 0x88, 0xe8, 0x2b, 0x91,
+	0x51, 0x43, 0xa4, 0x8f, 0xf6, 0x67, 0xcd, 0xe6, 0xb2, 0xe7, 0x20, 0x94, 0x5a, 0xc3, 0x63, 0xe9,
+	0xd7, 0xb7, 0x61, 0x63, 0x9e, 0x26, 0x01, 0x1b, 0x53, 0x67, 0x6a, 0x6c, 0x60, 0x6d, 0x9e, 0x4f,
+	0xab, 0x0c, 0x27, 0xde, 0x97, 0x6a, 0xe4, 0x36, 0x0e, 0x8c, 0x13, 0x8f, 0x2f, 0x59, 0xdb, 0xcf,
+	0x0b, 0xb0, 0x29, 0x06, 0x3f, 0x64, 0x2e, 0x1d, 0x4e, 0x0f, 0xa9, 0xcc, 0x8f, 0xa1, 0x26, 0xd6,
+	0x65, 0x51, 0xd1, 0x9a, 0xad, 0x90, 0x0c, 0x95, 0xbc, 0x54, 0x69, 0x7c, 0xd1, 0x30, 0xc9, 0x30,
+	0x59, 0xbf, 0xf9, 0x63, 0xd7, 0xef, 0xea, 0x31, 0xeb, 0xb7, 0x70, 0x02, 0xf5, 0xbb, 0x76, 0x72,
+	0xf5, 0x5b, 0x3c, 0xa1, 0xfa, 0x2d, 0x9d, 0x7c, 0xfd, 0x96, 0xdf, 0xb1, 0x7e, 0xe1, 0x24, 0xea,
+	0xb7, 0x92, 0xaa, 0xdf, 0xf6, 0xbf, 0x1a, 0x54, 0x6e, 0xfb, 0x3c, 0xa4, 0x24, 0x7a, 0x40, 0x23,
+	0x2e, 0x08, 0x15, 0xdd, 0xc4, 0x62, 0x98, 0x91, 0x91, 0xa1, 0x29, 0x42, 0x97, 0xcf, 0x66, 0xd1,
+	0xbb, 0x62, 0x42, 0x9d, 0xb9, 0x05, 0x0f, 0x05, 0xd3, 0x36, 0xc6, 0xc8, 0x65, 0x62, 0x2c, 0x3a,
+	0x52, 0x8c, 0x41, 0xe7, 0x96, 0x48, 0xff, 0x0a, 0xde, 0x43, 0x0c, 0x0f, 0xeb, 0x63, 0x0e, 0x95,
+	0xcf, 0xbc, 0xc0, 0xa6, 0x0b, 0x49, 0x01, 0x9e, 0xa2, 0x29, 0x7b, 0xd4, 0xa6, 0xb0, 0x21, 0x6f,
+	0x01, 0xc4, 0x8d, 0x67, 0x3a, 0x0f, 0x65, 0xe2, 0xf3, 0x70, 0x6a, 0x51, 0x57, 0x6e, 0xb7, 0xac,
+	0xc2, 0x4b, 0x68, 0xee, 0xbb, 0x51, 0xe2, 0xda, 0x92, 0x3b, 0xfa, 0xb5, 0xc5, 0x87, 0xb2, 0xa0,
+	0x49, 0x7e, 0x0f, 0xd8, 0x50, 0x10, 0x0c, 0x2d, 0xf8, 0x3c, 0x34, 0x41, 0x2f, 0x89, 0xb9, 0x7f,
+	0xfd, 0xbb, 0xb9, 0x75, 0x84, 0x9c, 0x13, 0x01, 0x91, 0x29, 0x91, 0xdb, 0x3f, 0x16, 0x60, 0xcd,
+	0xc4, 0x6f, 0xc9, 0xf4, 0xdd, 0x5b, 0x3b, 0x70, 0xf7, 0x96, 0x42, 0x93, 0x9b, 0x0b, 0xcd, 0x79,
+	0xa8, 0xfa, 0xcc, 0x25, 0xd6, 0x2e, 0x09, 0x23, 0x71, 0x1d, 0xc7, 0xdb, 0x99, 0x59, 0x11, 0xb6,
+	0x27, 0xd2, 0xa4, 0xeb, 0xb0, 0xea, 0xdb, 0x1e, 0x31, 0x56, 0x31, 0x08, 0x9f, 0xf5, 0x16, 0x54,
+	0x5c, 0x12, 0x39, 0x21, 0x0d, 0xf0, 0x12, 0x5f, 0x40, 0x57, 0xd2, 0x24, 0x2e, 0x25, 0x31, 0xa6,
+	0xfc, 0x02, 0x88, 0x5f, 0xf5, 0x1b, 0x80, 0x39, 0x23, 0xb5, 0x2d, 0x32, 0x8a, 0x99, 0xfa, 0x33,
+	0x27, 0x50, 0x1d, 0x09, 0x38, 0xb1, 0x41, 0x7c, 0xd6, 0x54, 0x16, 0xe2, 0x18, 0x19, 0xa5, 0x4c,
+	0x80, 0xf9, 0x17, 0x59, 0x0c, 0x30, 0x57, 0x4e, 0x54, 0x31, 0x22, 0x33, 0x1f, 0x6b, 0xf3, 0xa0,
+	0x8a, 0x25, 0xea, 0x22, 0x56, 0x31, 0x15, 0xa0, 0x7f, 0x0e, 0xc5, 0x38, 0x25, 0x01, 0x27, 0x6e,
+	0xa4, 0x62, 0x53, 0x59, 0x16, 0xc7, 0xab, 0x20, 0xfd, 0x13, 0xa8, 0x61, 0x17, 0xb3, 0x44, 0x8d,
+	0x86, 0xbb, 0xf6, 0x18, 0x2b, 0x33, 0x6f, 0xae, 0xa3, 0xb5, 0xaf, 0x8c, 0xfa, 0x6d, 0xa8, 0x39,
+	0x2c, 0xe2, 0x42, 0x99, 0x64, 0xd7, 0xc3, 0x4e, 0x7a, 0x04, 0x81, 0xab, 0x8a, 0xb0, 0x6d, 0x12,
+	0x62, 0x2f, 0x14, 0xa7, 0x40, 0x7c, 0x51, 0xee, 0x2e, 0x76, 0xd3, 0x92, 0x19, 0xbf, 0xea, 0xe7,
+	0x00, 0xc8, 0x1e, 0x0f, 0x6d, 0x8b, 0xfa, 0x43, 0x86, 0x0d, 0xb2, 0x6c, 0x96, 0xd1, 0xd2, 0xf7,
+	0x87, 0x4c, 0xb8, 0x9d, 0x90, 0xd8, 0x9c, 0xb8, 0x96, 0xcd, 0xb1, 0xe5, 0xe5, 0xcd, 0xb2, 0xb2,
+	0xdc, 0xe4, 0xc2, 0x3d, 0x09, 0xdc, 0xd8, 0xbd, 0x29, 0xdd, 0xca, 0x72, 0x93, 0xf7, 0xee, 0xbc,
+	0x78, 0xd5, 0xd0, 0x5e, 0xbe, 0x6a, 0x68, 0xff, 0xbc, 0x6a, 0x68, 0x3f, 0xbd, 0x6e, 0xac, 0xbc,
+	0x7c, 0xdd, 0x58, 0xf9, 0xf3, 0x75, 0x63, 0xe5, 0xdb, 0x0b, 0x89, 0xe4, 0xde, 0x46, 0xc2, 0x2e,
+	0x72, 0xe2, 0x3c, 0x8d, 0xff, 0xb1, 0xd8, 0x8b, 0x1f, 0x30, 0xcd, 0x07, 0x6b, 0xf8, 0xe7, 0xc5,
+	0xd5, 0xff, 0x06, 0x00, 0x42, 0x07, 0x2a, 0x19, 0x39, 0x11, 0x00, 0x00,
 }
 
 func (m *DoubleInputParam) Marshal() (dAtA []byte, err error) {
@@ -1423,6 +1504,25 @@ func (m *ItemInput) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.Condition) > 0 {
+		i -= len(m.Condition)
+		copy(dAtA[i:], m.Condition)
+		i = encodeVarintRecipe(dAtA, i, uint64(len(m.Condition)))
+		i--
+		dAtA[i] = 0x3a
+	}
+	if len(m.CookbookId) > 0 {
+		i -= len(m.CookbookId)
+		copy(dAtA[i:], m.CookbookId)
+		i = encodeVarintRecipe(dAtA, i, uint64(len(m.CookbookId)))
+		i--
+		dAtA[i] = 0x32
+	}
+	if m.Amount != 0 {
+		i = encodeVarintRecipe(dAtA, i, uint64(m.Amount))
+		i--
+		dAtA[i] = 0x28
+	}
 	if len(m.Strings) > 0 {
 		for iNdEx := len(m.Strings) - 1; iNdEx >= 0; iNdEx-- {
 			{
@@ -1774,6 +1874,41 @@ func (m *ItemOutput) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	{
+		size, err := m.TransferPolicy.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintRecipe(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x7a
+	if m.ExpirySeconds != 0 {
+		i = encodeVarintRecipe(dAtA, i, uint64(m.ExpirySeconds))
+		i--
+		dAtA[i] = 0x70
+	}
+	if m.ExpiryBlocks != 0 {
+		i = encodeVarintRecipe(dAtA, i, uint64(m.ExpiryBlocks))
+		i--
+		dAtA[i] = 0x68
+	}
+	if m.Amount != 0 {
+		i = encodeVarintRecipe(dAtA, i, uint64(m.Amount))
+		i--
+		dAtA[i] = 0x60
+	}
+	if m.Fungible {
+		i--
+		if m.Fungible {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x58
+	}
 	if m.Tradeable {
 		i--
 		if m.Tradeable {
@@ -2411,6 +2546,17 @@ func (m *ItemInput) Size() (n int) {
 			n += 1 + l + sovRecipe(uint64(l))
 		}
 	}
+	if m.Amount != 0 {
+		n += 1 + sovRecipe(uint64(m.Amount))
+	}
+	l = len(m.CookbookId)
+	if l > 0 {
+		n += 1 + l + sovRecipe(uint64(l))
+	}
+	l = len(m.Condition)
+	if l > 0 {
+		n += 1 + l + sovRecipe(uint64(l))
+	}
 	return n
 }
 
@@ -2585,6 +2731,20 @@ func (m *ItemOutput) Size() (n int) {
 	if m.Tradeable {
 		n += 2
 	}
+	if m.Fungible {
+		n += 2
+	}
+	if m.Amount != 0 {
+		n += 1 + sovRecipe(uint64(m.Amount))
+	}
+	if m.ExpiryBlocks != 0 {
+		n += 1 + sovRecipe(uint64(m.ExpiryBlocks))
+	}
+	if m.ExpirySeconds != 0 {
+		n += 1 + sovRecipe(uint64(m.ExpirySeconds))
+	}
+	l = m.TransferPolicy.Size()
+	n += 1 + l + sovRecipe(uint64(l))
 	return n
 }
 
@@ -3329,6 +3489,89 @@ func (m *ItemInput) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			m.Amount = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRecipe
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Amount |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CookbookId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRecipe
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRecipe
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRecipe
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CookbookId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Condition", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRecipe
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRecipe
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRecipe
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Condition = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipRecipe(dAtA[iNdEx:])
@@ -4506,6 +4749,116 @@ func (m *ItemOutput) Unmarshal(dAtA []byte) error {
 				}
 			}
 			m.Tradeable = bool(v != 0)
+		case 11:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Fungible", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRecipe
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Fungible = bool(v != 0)
+		case 12:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			m.Amount = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRecipe
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Amount |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 13:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExpiryBlocks", wireType)
+			}
+			m.ExpiryBlocks = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRecipe
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ExpiryBlocks |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 14:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExpirySeconds", wireType)
+			}
+			m.ExpirySeconds = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRecipe
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ExpirySeconds |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 15:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TransferPolicy", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRecipe
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthRecipe
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthRecipe
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.TransferPolicy.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipRecipe(dAtA[iNdEx:])
@@ -125,6 +125,9 @@ func TestValidateItemInput(t *testing.T) {
 		{desc: "ValidEmpty", obj: "{}"},
 		{desc: "Valid", obj: "{conditions: {}}"},
 		{desc: "Invalid", obj: "{\"doubles\": [{\"key\": \"test\", \"min_value\": \"1.01\", \"max_value\": \"1\"}]}", err: ErrInvalidRequestField},
+		{desc: "ValidCookbookAndCondition", obj: "{\"cookbook_id\": \"swords\", \"condition\": \"attack > 30\"}"},
+		{desc: "InvalidCookbook", obj: "{\"cookbook_id\": \"-swords\"}", err: ErrInvalidRequestField},
+		{desc: "InvalidCondition", obj: "{\"condition\": \"attack >\"}", err: ErrInvalidRequestField},
 	} {
 		tc := tc
 		t.Run(tc.desc, func(t *testing.T) {
@@ -0,0 +1,1955 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: pylons/pylons/stats.proto
+
+package types
+
+import (
+	fmt "fmt"
+	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
+	types "github.com/cosmos/cosmos-sdk/types"
+	_ "github.com/gogo/protobuf/gogoproto"
+	proto "github.com/gogo/protobuf/proto"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// ItemMintCount tracks the number of items minted from a recipe ItemOutput
+type ItemMintCount struct {
+	ItemOutputId string `protobuf:"bytes,1,opt,name=item_output_id,json=itemOutputId,proto3" json:"item_output_id,omitempty"`
+	Count        uint64 `protobuf:"varint,2,opt,name=count,proto3" json:"count,omitempty"`
+}
+
+func (m *ItemMintCount) Reset()         { *m = ItemMintCount{} }
+func (m *ItemMintCount) String() string { return proto.CompactTextString(m) }
+func (*ItemMintCount) ProtoMessage()    {}
+func (*ItemMintCount) Descriptor() ([]byte, []int) {
+	return fileDescriptor_fb7dde71f80df619, []int{0}
+}
+func (m *ItemMintCount) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ItemMintCount) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ItemMintCount.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ItemMintCount) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ItemMintCount.Merge(m, src)
+}
+func (m *ItemMintCount) XXX_Size() int {
+	return m.Size()
+}
+func (m *ItemMintCount) XXX_DiscardUnknown() {
+	xxx_messageInfo_ItemMintCount.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ItemMintCount proto.InternalMessageInfo
+
+func (m *ItemMintCount) GetItemOutputId() string {
+	if m != nil {
+		return m.ItemOutputId
+	}
+	return ""
+}
+
+func (m *ItemMintCount) GetCount() uint64 {
+	if m != nil {
+		return m.Count
+	}
+	return 0
+}
+
+// RecipeStats contains the aggregated results of the completed executions of a recipe
+type RecipeStats struct {
+	CookbookId      string `protobuf:"bytes,1,opt,name=cookbook_id,json=cookbookId,proto3" json:"cookbook_id,omitempty"`
+	RecipeId        string `protobuf:"bytes,2,opt,name=recipe_id,json=recipeId,proto3" json:"recipe_id,omitempty"`
+	Executions      uint64 `protobuf:"varint,3,opt,name=executions,proto3" json:"executions,omitempty"`
+	UniqueExecutors uint64 `protobuf:"varint,4,opt,name=unique_executors,json=uniqueExecutors,proto3" json:"unique_executors,omitempty"`
+	// coins transferred to the cookbook owner, after fees
+	Revenue github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,5,rep,name=revenue,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"revenue"`
+	// coins paid to the chain as recipe fees
+	Fees github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,6,rep,name=fees,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"fees"`
+	// cookbook coins burned by the executions
+	Burned              github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,7,rep,name=burned,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"burned"`
+	ItemsMinted         []ItemMintCount                          `protobuf:"bytes,8,rep,name=items_minted,json=itemsMinted,proto3" json:"items_minted"`
+	LastExecutionHeight int64                                    `protobuf:"varint,9,opt,name=last_execution_height,json=lastExecutionHeight,proto3" json:"last_execution_height,omitempty"`
+}
+
+func (m *RecipeStats) Reset()         { *m = RecipeStats{} }
+func (m *RecipeStats) String() string { return proto.CompactTextString(m) }
+func (*RecipeStats) ProtoMessage()    {}
+func (*RecipeStats) Descriptor() ([]byte, []int) {
+	return fileDescriptor_fb7dde71f80df619, []int{1}
+}
+func (m *RecipeStats) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *RecipeStats) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_RecipeStats.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *RecipeStats) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RecipeStats.Merge(m, src)
+}
+func (m *RecipeStats) XXX_Size() int {
+	return m.Size()
+}
+func (m *RecipeStats) XXX_DiscardUnknown() {
+	xxx_messageInfo_RecipeStats.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RecipeStats proto.InternalMessageInfo
+
+func (m *RecipeStats) GetCookbookId() string {
+	if m != nil {
+		return m.CookbookId
+	}
+	return ""
+}
+
+func (m *RecipeStats) GetRecipeId() string {
+	if m != nil {
+		return m.RecipeId
+	}
+	return ""
+}
+
+func (m *RecipeStats) GetExecutions() uint64 {
+	if m != nil {
+		return m.Executions
+	}
+	return 0
+}
+
+func (m *RecipeStats) GetUniqueExecutors() uint64 {
+	if m != nil {
+		return m.UniqueExecutors
+	}
+	return 0
+}
+
+func (m *RecipeStats) GetRevenue() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.Revenue
+	}
+	return nil
+}
+
+func (m *RecipeStats) GetFees() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.Fees
+	}
+	return nil
+}
+
+func (m *RecipeStats) GetBurned() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.Burned
+	}
+	return nil
+}
+
+func (m *RecipeStats) GetItemsMinted() []ItemMintCount {
+	if m != nil {
+		return m.ItemsMinted
+	}
+	return nil
+}
+
+func (m *RecipeStats) GetLastExecutionHeight() int64 {
+	if m != nil {
+		return m.LastExecutionHeight
+	}
+	return 0
+}
+
+// CookbookStats contains the aggregated results of the completed executions of all the recipes in a cookbook
+type CookbookStats struct {
+	CookbookId          string                                   `protobuf:"bytes,1,opt,name=cookbook_id,json=cookbookId,proto3" json:"cookbook_id,omitempty"`
+	Executions          uint64                                   `protobuf:"varint,2,opt,name=executions,proto3" json:"executions,omitempty"`
+	UniqueExecutors     uint64                                   `protobuf:"varint,3,opt,name=unique_executors,json=uniqueExecutors,proto3" json:"unique_executors,omitempty"`
+	Revenue             github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,4,rep,name=revenue,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"revenue"`
+	Fees                github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,5,rep,name=fees,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"fees"`
+	Burned              github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,6,rep,name=burned,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"burned"`
+	ItemsMinted         uint64                                   `protobuf:"varint,7,opt,name=items_minted,json=itemsMinted,proto3" json:"items_minted,omitempty"`
+	LastExecutionHeight int64                                    `protobuf:"varint,8,opt,name=last_execution_height,json=lastExecutionHeight,proto3" json:"last_execution_height,omitempty"`
+}
+
+func (m *CookbookStats) Reset()         { *m = CookbookStats{} }
+func (m *CookbookStats) String() string { return proto.CompactTextString(m) }
+func (*CookbookStats) ProtoMessage()    {}
+func (*CookbookStats) Descriptor() ([]byte, []int) {
+	return fileDescriptor_fb7dde71f80df619, []int{2}
+}
+func (m *CookbookStats) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *CookbookStats) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_CookbookStats.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *CookbookStats) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CookbookStats.Merge(m, src)
+}
+func (m *CookbookStats) XXX_Size() int {
+	return m.Size()
+}
+func (m *CookbookStats) XXX_DiscardUnknown() {
+	xxx_messageInfo_CookbookStats.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CookbookStats proto.InternalMessageInfo
+
+func (m *CookbookStats) GetCookbookId() string {
+	if m != nil {
+		return m.CookbookId
+	}
+	return ""
+}
+
+func (m *CookbookStats) GetExecutions() uint64 {
+	if m != nil {
+		return m.Executions
+	}
+	return 0
+}
+
+func (m *CookbookStats) GetUniqueExecutors() uint64 {
+	if m != nil {
+		return m.UniqueExecutors
+	}
+	return 0
+}
+
+func (m *CookbookStats) GetRevenue() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.Revenue
+	}
+	return nil
+}
+
+func (m *CookbookStats) GetFees() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.Fees
+	}
+	return nil
+}
+
+func (m *CookbookStats) GetBurned() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.Burned
+	}
+	return nil
+}
+
+func (m *CookbookStats) GetItemsMinted() uint64 {
+	if m != nil {
+		return m.ItemsMinted
+	}
+	return 0
+}
+
+func (m *CookbookStats) GetLastExecutionHeight() int64 {
+	if m != nil {
+		return m.LastExecutionHeight
+	}
+	return 0
+}
+
+// RecipeExecutors lists the addresses that completed an execution of a recipe
+type RecipeExecutors struct {
+	CookbookId string   `protobuf:"bytes,1,opt,name=cookbook_id,json=cookbookId,proto3" json:"cookbook_id,omitempty"`
+	RecipeId   string   `protobuf:"bytes,2,opt,name=recipe_id,json=recipeId,proto3" json:"recipe_id,omitempty"`
+	Executors  []string `protobuf:"bytes,3,rep,name=executors,proto3" json:"executors,omitempty"`
+}
+
+func (m *RecipeExecutors) Reset()         { *m = RecipeExecutors{} }
+func (m *RecipeExecutors) String() string { return proto.CompactTextString(m) }
+func (*RecipeExecutors) ProtoMessage()    {}
+func (*RecipeExecutors) Descriptor() ([]byte, []int) {
+	return fileDescriptor_fb7dde71f80df619, []int{3}
+}
+func (m *RecipeExecutors) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *RecipeExecutors) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_RecipeExecutors.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *RecipeExecutors) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RecipeExecutors.Merge(m, src)
+}
+func (m *RecipeExecutors) XXX_Size() int {
+	return m.Size()
+}
+func (m *RecipeExecutors) XXX_DiscardUnknown() {
+	xxx_messageInfo_RecipeExecutors.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RecipeExecutors proto.InternalMessageInfo
+
+func (m *RecipeExecutors) GetCookbookId() string {
+	if m != nil {
+		return m.CookbookId
+	}
+	return ""
+}
+
+func (m *RecipeExecutors) GetRecipeId() string {
+	if m != nil {
+		return m.RecipeId
+	}
+	return ""
+}
+
+func (m *RecipeExecutors) GetExecutors() []string {
+	if m != nil {
+		return m.Executors
+	}
+	return nil
+}
+
+// CookbookExecutors lists the addresses that completed an execution of a recipe in a cookbook
+type CookbookExecutors struct {
+	CookbookId string   `protobuf:"bytes,1,opt,name=cookbook_id,json=cookbookId,proto3" json:"cookbook_id,omitempty"`
+	Executors  []string `protobuf:"bytes,2,rep,name=executors,proto3" json:"executors,omitempty"`
+}
+
+func (m *CookbookExecutors) Reset()         { *m = CookbookExecutors{} }
+func (m *CookbookExecutors) String() string { return proto.CompactTextString(m) }
+func (*CookbookExecutors) ProtoMessage()    {}
+func (*CookbookExecutors) Descriptor() ([]byte, []int) {
+	return fileDescriptor_fb7dde71f80df619, []int{4}
+}
+func (m *CookbookExecutors) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *CookbookExecutors) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_CookbookExecutors.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *CookbookExecutors) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CookbookExecutors.Merge(m, src)
+}
+func (m *CookbookExecutors) XXX_Size() int {
+	return m.Size()
+}
+func (m *CookbookExecutors) XXX_DiscardUnknown() {
+	xxx_messageInfo_CookbookExecutors.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CookbookExecutors proto.InternalMessageInfo
+
+func (m *CookbookExecutors) GetCookbookId() string {
+	if m != nil {
+		return m.CookbookId
+	}
+	return ""
+}
+
+func (m *CookbookExecutors) GetExecutors() []string {
+	if m != nil {
+		return m.Executors
+	}
+	return nil
+}
+
+func init() {
+	proto.RegisterType((*ItemMintCount)(nil), "pylons.pylons.ItemMintCount")
+	proto.RegisterType((*RecipeStats)(nil), "pylons.pylons.RecipeStats")
+	proto.RegisterType((*CookbookStats)(nil), "pylons.pylons.CookbookStats")
+	proto.RegisterType((*RecipeExecutors)(nil), "pylons.pylons.RecipeExecutors")
+	proto.RegisterType((*CookbookExecutors)(nil), "pylons.pylons.CookbookExecutors")
+}
+
+func init() { proto.RegisterFile("pylons/pylons/stats.proto", fileDescriptor_fb7dde71f80df619) }
+
+var fileDescriptor_fb7dde71f80df619 = []byte{
+	// 539 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xbc, 0x94, 0xcf, 0x6e, 0xd3, 0x4e,
+	0x10, 0xc7, 0xe3, 0xd8, 0xf9, 0x37, 0xf9, 0xe5, 0x57, 0x58, 0x8a, 0xe4, 0x96, 0xca, 0x09, 0x11,
+	0x07, 0x23, 0x51, 0x9b, 0x96, 0x37, 0x48, 0x14, 0x44, 0x84, 0x2a, 0x90, 0xb9, 0x71, 0xb1, 0x62,
+	0x7b, 0x48, 0x56, 0xa9, 0xbd, 0x21, 0xbb, 0xae, 0xda, 0xb7, 0xe0, 0x39, 0x78, 0x08, 0xce, 0x3d,
+	0xf6, 0xc8, 0x09, 0x50, 0xf2, 0x20, 0x20, 0xef, 0xda, 0x24, 0xa9, 0x84, 0x08, 0xa2, 0xe5, 0xb4,
+	0x9b, 0xef, 0xcc, 0xce, 0x44, 0xdf, 0xf9, 0x78, 0x60, 0x6f, 0x76, 0x71, 0xca, 0x12, 0xee, 0xe6,
+	0x07, 0x17, 0x23, 0xc1, 0x9d, 0xd9, 0x9c, 0x09, 0x46, 0x5a, 0x4a, 0x73, 0xd4, 0xb1, 0xbf, 0x3b,
+	0x66, 0x63, 0x26, 0x23, 0x6e, 0x76, 0x53, 0x49, 0xfb, 0x56, 0xc8, 0x78, 0xcc, 0xb8, 0x1b, 0x8c,
+	0x38, 0xba, 0x67, 0x47, 0x01, 0x8a, 0xd1, 0x91, 0x1b, 0x32, 0x9a, 0xa8, 0x78, 0xf7, 0x25, 0xb4,
+	0x86, 0x02, 0xe3, 0x13, 0x9a, 0x88, 0x3e, 0x4b, 0x13, 0x41, 0x1e, 0xc1, 0xff, 0x54, 0x60, 0xec,
+	0xb3, 0x54, 0xcc, 0x52, 0xe1, 0xd3, 0xc8, 0xd4, 0x3a, 0x9a, 0xdd, 0xf0, 0xfe, 0xcb, 0xd4, 0x57,
+	0x52, 0x1c, 0x46, 0x64, 0x17, 0x2a, 0x61, 0x96, 0x6e, 0x96, 0x3b, 0x9a, 0x6d, 0x78, 0xea, 0x47,
+	0xf7, 0x93, 0x01, 0x4d, 0x0f, 0x43, 0x3a, 0xc3, 0x37, 0xd9, 0xff, 0x24, 0x6d, 0x68, 0x86, 0x8c,
+	0x4d, 0x03, 0xc6, 0xa6, 0xab, 0x42, 0x50, 0x48, 0xc3, 0x88, 0x3c, 0x80, 0xc6, 0x5c, 0xe6, 0x67,
+	0xe1, 0xb2, 0x0c, 0xd7, 0x95, 0x30, 0x8c, 0x88, 0x05, 0x80, 0xe7, 0x18, 0xa6, 0x82, 0xb2, 0x84,
+	0x9b, 0xba, 0x6c, 0xb4, 0xa6, 0x90, 0xc7, 0x70, 0x27, 0x4d, 0xe8, 0xfb, 0x14, 0x7d, 0x25, 0xb2,
+	0x39, 0x37, 0x0d, 0x99, 0xb5, 0xa3, 0xf4, 0x41, 0x21, 0x13, 0x84, 0xda, 0x1c, 0xcf, 0x30, 0x49,
+	0xd1, 0xac, 0x74, 0x74, 0xbb, 0x79, 0xbc, 0xe7, 0x28, 0x5f, 0x9c, 0xcc, 0x17, 0x27, 0xf7, 0xc5,
+	0xe9, 0x33, 0x9a, 0xf4, 0x9e, 0x5e, 0x7e, 0x69, 0x97, 0x3e, 0x7e, 0x6d, 0xdb, 0x63, 0x2a, 0x26,
+	0x69, 0xe0, 0x84, 0x2c, 0x76, 0x73, 0x13, 0xd5, 0x71, 0xc8, 0xa3, 0xa9, 0x2b, 0x2e, 0x66, 0xc8,
+	0xe5, 0x03, 0xee, 0x15, 0xb5, 0x89, 0x0f, 0xc6, 0x3b, 0x44, 0x6e, 0x56, 0x6f, 0xbe, 0x87, 0x2c,
+	0x4c, 0x42, 0xa8, 0x06, 0xe9, 0x3c, 0xc1, 0xc8, 0xac, 0xdd, 0x7c, 0x8b, 0xbc, 0x34, 0x19, 0x80,
+	0x9c, 0x35, 0xf7, 0x63, 0x9a, 0x08, 0x8c, 0xcc, 0xba, 0x6c, 0x75, 0xe0, 0x6c, 0xe0, 0xe6, 0x6c,
+	0x50, 0xd3, 0x33, 0xb2, 0x6e, 0x5e, 0x53, 0xbe, 0x3b, 0x91, 0xcf, 0xc8, 0x31, 0xdc, 0x3f, 0x1d,
+	0x71, 0xe1, 0xff, 0x9c, 0x98, 0x3f, 0x41, 0x3a, 0x9e, 0x08, 0xb3, 0xd1, 0xd1, 0x6c, 0xdd, 0xbb,
+	0x97, 0x05, 0x07, 0x45, 0xec, 0x85, 0x0c, 0x75, 0xbf, 0xeb, 0xd0, 0xea, 0xe7, 0x78, 0x6c, 0x89,
+	0xd0, 0x26, 0x25, 0xe5, 0xad, 0x28, 0xd1, 0x7f, 0x4b, 0x89, 0xf1, 0x0f, 0x28, 0xa9, 0xdc, 0x3e,
+	0x25, 0xd5, 0xdb, 0xa3, 0xe4, 0xe1, 0x35, 0x4a, 0x6a, 0xd2, 0xd3, 0xed, 0x08, 0xa8, 0xff, 0x9a,
+	0x80, 0x18, 0x76, 0xd4, 0x06, 0x59, 0x8d, 0xe5, 0xef, 0xb6, 0xc8, 0x01, 0x34, 0xd6, 0x07, 0xaf,
+	0xdb, 0x0d, 0x6f, 0x25, 0x74, 0x3d, 0xb8, 0x5b, 0xf0, 0xf6, 0x07, 0x0d, 0x37, 0x6a, 0x96, 0xaf,
+	0xd5, 0xec, 0x3d, 0xbf, 0x5c, 0x58, 0xda, 0xd5, 0xc2, 0xd2, 0xbe, 0x2d, 0x2c, 0xed, 0xc3, 0xd2,
+	0x2a, 0x5d, 0x2d, 0xad, 0xd2, 0xe7, 0xa5, 0x55, 0x7a, 0xfb, 0x64, 0xcd, 0xe5, 0xd7, 0xf2, 0x33,
+	0x3a, 0x14, 0x18, 0x4e, 0x8a, 0xe5, 0x7e, 0x5e, 0x5c, 0xa4, 0xdf, 0x41, 0x55, 0x6e, 0xe8, 0x67,
+	0x3f, 0x06, 0x00, 0x29, 0x0d, 0xd7, 0xdc, 0x03, 0x06, 0x00, 0x00,
+}
+
+func (m *ItemMintCount) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ItemMintCount) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ItemMintCount) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Count != 0 {
+		i = encodeVarintStats(dAtA, i, uint64(m.Count))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.ItemOutputId) > 0 {
+		i -= len(m.ItemOutputId)
+		copy(dAtA[i:], m.ItemOutputId)
+		i = encodeVarintStats(dAtA, i, uint64(len(m.ItemOutputId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *RecipeStats) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *RecipeStats) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RecipeStats) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.LastExecutionHeight != 0 {
+		i = encodeVarintStats(dAtA, i, uint64(m.LastExecutionHeight))
+		i--
+		dAtA[i] = 0x48
+	}
+	if len(m.ItemsMinted) > 0 {
+		for iNdEx := len(m.ItemsMinted) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ItemsMinted[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintStats(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x42
+		}
+	}
+	if len(m.Burned) > 0 {
+		for iNdEx := len(m.Burned) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Burned[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintStats(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x3a
+		}
+	}
+	if len(m.Fees) > 0 {
+		for iNdEx := len(m.Fees) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Fees[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintStats(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x32
+		}
+	}
+	if len(m.Revenue) > 0 {
+		for iNdEx := len(m.Revenue) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Revenue[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintStats(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
+	if m.UniqueExecutors != 0 {
+		i = encodeVarintStats(dAtA, i, uint64(m.UniqueExecutors))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.Executions != 0 {
+		i = encodeVarintStats(dAtA, i, uint64(m.Executions))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.RecipeId) > 0 {
+		i -= len(m.RecipeId)
+		copy(dAtA[i:], m.RecipeId)
+		i = encodeVarintStats(dAtA, i, uint64(len(m.RecipeId)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.CookbookId) > 0 {
+		i -= len(m.CookbookId)
+		copy(dAtA[i:], m.CookbookId)
+		i = encodeVarintStats(dAtA, i, uint64(len(m.CookbookId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *CookbookStats) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *CookbookStats) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *CookbookStats) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.LastExecutionHeight != 0 {
+		i = encodeVarintStats(dAtA, i, uint64(m.LastExecutionHeight))
+		i--
+		dAtA[i] = 0x40
+	}
+	if m.ItemsMinted != 0 {
+		i = encodeVarintStats(dAtA, i, uint64(m.ItemsMinted))
+		i--
+		dAtA[i] = 0x38
+	}
+	if len(m.Burned) > 0 {
+		for iNdEx := len(m.Burned) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Burned[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintStats(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x32
+		}
+	}
+	if len(m.Fees) > 0 {
+		for iNdEx := len(m.Fees) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Fees[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintStats(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
+	if len(m.Revenue) > 0 {
+		for iNdEx := len(m.Revenue) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Revenue[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintStats(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if m.UniqueExecutors != 0 {
+		i = encodeVarintStats(dAtA, i, uint64(m.UniqueExecutors))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.Executions != 0 {
+		i = encodeVarintStats(dAtA, i, uint64(m.Executions))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.CookbookId) > 0 {
+		i -= len(m.CookbookId)
+		copy(dAtA[i:], m.CookbookId)
+		i = encodeVarintStats(dAtA, i, uint64(len(m.CookbookId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *RecipeExecutors) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *RecipeExecutors) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RecipeExecutors) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Executors) > 0 {
+		for iNdEx := len(m.Executors) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Executors[iNdEx])
+			copy(dAtA[i:], m.Executors[iNdEx])
+			i = encodeVarintStats(dAtA, i, uint64(len(m.Executors[iNdEx])))
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.RecipeId) > 0 {
+		i -= len(m.RecipeId)
+		copy(dAtA[i:], m.RecipeId)
+		i = encodeVarintStats(dAtA, i, uint64(len(m.RecipeId)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.CookbookId) > 0 {
+		i -= len(m.CookbookId)
+		copy(dAtA[i:], m.CookbookId)
+		i = encodeVarintStats(dAtA, i, uint64(len(m.CookbookId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *CookbookExecutors) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *CookbookExecutors) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *CookbookExecutors) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Executors) > 0 {
+		for iNdEx := len(m.Executors) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Executors[iNdEx])
+			copy(dAtA[i:], m.Executors[iNdEx])
+			i = encodeVarintStats(dAtA, i, uint64(len(m.Executors[iNdEx])))
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.CookbookId) > 0 {
+		i -= len(m.CookbookId)
+		copy(dAtA[i:], m.CookbookId)
+		i = encodeVarintStats(dAtA, i, uint64(len(m.CookbookId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintStats(dAtA []byte, offset int, v uint64) int {
+	offset -= sovStats(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *ItemMintCount) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ItemOutputId)
+	if l > 0 {
+		n += 1 + l + sovStats(uint64(l))
+	}
+	if m.Count != 0 {
+		n += 1 + sovStats(uint64(m.Count))
+	}
+	return n
+}
+
+func (m *RecipeStats) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.CookbookId)
+	if l > 0 {
+		n += 1 + l + sovStats(uint64(l))
+	}
+	l = len(m.RecipeId)
+	if l > 0 {
+		n += 1 + l + sovStats(uint64(l))
+	}
+	if m.Executions != 0 {
+		n += 1 + sovStats(uint64(m.Executions))
+	}
+	if m.UniqueExecutors != 0 {
+		n += 1 + sovStats(uint64(m.UniqueExecutors))
+	}
+	if len(m.Revenue) > 0 {
+		for _, e := range m.Revenue {
+			l = e.Size()
+			n += 1 + l + sovStats(uint64(l))
+		}
+	}
+	if len(m.Fees) > 0 {
+		for _, e := range m.Fees {
+			l = e.Size()
+			n += 1 + l + sovStats(uint64(l))
+		}
+	}
+	if len(m.Burned) > 0 {
+		for _, e := range m.Burned {
+			l = e.Size()
+			n += 1 + l + sovStats(uint64(l))
+		}
+	}
+	if len(m.ItemsMinted) > 0 {
+		for _, e := range m.ItemsMinted {
+			l = e.Size()
+			n += 1 + l + sovStats(uint64(l))
+		}
+	}
+	if m.LastExecutionHeight != 0 {
+		n += 1 + sovStats(uint64(m.LastExecutionHeight))
+	}
+	return n
+}
+
+func (m *CookbookStats) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.CookbookId)
+	if l > 0 {
+		n += 1 + l + sovStats(uint64(l))
+	}
+	if m.Executions != 0 {
+		n += 1 + sovStats(uint64(m.Executions))
+	}
+	if m.UniqueExecutors != 0 {
+		n += 1 + sovStats(uint64(m.UniqueExecutors))
+	}
+	if len(m.Revenue) > 0 {
+		for _, e := range m.Revenue {
+			l = e.Size()
+			n += 1 + l + sovStats(uint64(l))
+		}
+	}
+	if len(m.Fees) > 0 {
+		for _, e := range m.Fees {
+			l = e.Size()
+			n += 1 + l + sovStats(uint64(l))
+		}
+	}
+	if len(m.Burned) > 0 {
+		for _, e := range m.Burned {
+			l = e.Size()
+			n += 1 + l + sovStats(uint64(l))
+		}
+	}
+	if m.ItemsMinted != 0 {
+		n += 1 + sovStats(uint64(m.ItemsMinted))
+	}
+	if m.LastExecutionHeight != 0 {
+		n += 1 + sovStats(uint64(m.LastExecutionHeight))
+	}
+	return n
+}
+
+func (m *RecipeExecutors) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.CookbookId)
+	if l > 0 {
+		n += 1 + l + sovStats(uint64(l))
+	}
+	l = len(m.RecipeId)
+	if l > 0 {
+		n += 1 + l + sovStats(uint64(l))
+	}
+	if len(m.Executors) > 0 {
+		for _, s := range m.Executors {
+			l = len(s)
+			n += 1 + l + sovStats(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *CookbookExecutors) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.CookbookId)
+	if l > 0 {
+		n += 1 + l + sovStats(uint64(l))
+	}
+	if len(m.Executors) > 0 {
+		for _, s := range m.Executors {
+			l = len(s)
+			n += 1 + l + sovStats(uint64(l))
+		}
+	}
+	return n
+}
+
+func sovStats(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozStats(x uint64) (n int) {
+	return sovStats(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *ItemMintCount) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowStats
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ItemMintCount: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ItemMintCount: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ItemOutputId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStats
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthStats
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthStats
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ItemOutputId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Count", wireType)
+			}
+			m.Count = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStats
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Count |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipStats(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthStats
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *RecipeStats) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowStats
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RecipeStats: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RecipeStats: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CookbookId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStats
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthStats
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthStats
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CookbookId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RecipeId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStats
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthStats
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthStats
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RecipeId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Executions", wireType)
+			}
+			m.Executions = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStats
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Executions |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UniqueExecutors", wireType)
+			}
+			m.UniqueExecutors = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStats
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.UniqueExecutors |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Revenue", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStats
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthStats
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthStats
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Revenue = append(m.Revenue, types.Coin{})
+			if err := m.Revenue[len(m.Revenue)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Fees", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStats
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthStats
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthStats
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Fees = append(m.Fees, types.Coin{})
+			if err := m.Fees[len(m.Fees)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Burned", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStats
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthStats
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthStats
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Burned = append(m.Burned, types.Coin{})
+			if err := m.Burned[len(m.Burned)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ItemsMinted", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStats
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthStats
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthStats
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ItemsMinted = append(m.ItemsMinted, ItemMintCount{})
+			if err := m.ItemsMinted[len(m.ItemsMinted)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 9:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LastExecutionHeight", wireType)
+			}
+			m.LastExecutionHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStats
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.LastExecutionHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipStats(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthStats
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *CookbookStats) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowStats
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: CookbookStats: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: CookbookStats: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CookbookId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStats
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthStats
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthStats
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CookbookId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Executions", wireType)
+			}
+			m.Executions = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStats
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Executions |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UniqueExecutors", wireType)
+			}
+			m.UniqueExecutors = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStats
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.UniqueExecutors |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Revenue", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStats
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthStats
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthStats
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Revenue = append(m.Revenue, types.Coin{})
+			if err := m.Revenue[len(m.Revenue)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Fees", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStats
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthStats
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthStats
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Fees = append(m.Fees, types.Coin{})
+			if err := m.Fees[len(m.Fees)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Burned", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStats
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthStats
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthStats
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Burned = append(m.Burned, types.Coin{})
+			if err := m.Burned[len(m.Burned)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ItemsMinted", wireType)
+			}
+			m.ItemsMinted = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStats
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ItemsMinted |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LastExecutionHeight", wireType)
+			}
+			m.LastExecutionHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStats
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.LastExecutionHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipStats(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthStats
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *RecipeExecutors) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowStats
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RecipeExecutors: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RecipeExecutors: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CookbookId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStats
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthStats
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthStats
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CookbookId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RecipeId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStats
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthStats
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthStats
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RecipeId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Executors", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStats
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthStats
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthStats
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Executors = append(m.Executors, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipStats(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthStats
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *CookbookExecutors) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowStats
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: CookbookExecutors: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: CookbookExecutors: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CookbookId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStats
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthStats
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthStats
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CookbookId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Executors", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStats
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthStats
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthStats
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Executors = append(m.Executors, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipStats(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthStats
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipStats(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowStats
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowStats
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowStats
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthStats
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupStats
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthStats
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthStats        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowStats          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupStats = fmt.Errorf("proto: unexpected end of group")
+)
@@ -0,0 +1,58 @@
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// ParticipantIndex returns the index of the participant of the swap with the given address, or -1 if the address is
+// not a participant
+func (s Swap) ParticipantIndex(addr string) int {
+	for i, participant := range s.Participants {
+		if participant.Address == addr {
+			return i
+		}
+	}
+	return -1
+}
+
+// AllDeposited checks if every participant of the swap has deposited its commitment
+func (s Swap) AllDeposited() bool {
+	for _, participant := range s.Participants {
+		if !participant.Deposited {
+			return false
+		}
+	}
+	return true
+}
+
+// FindCommittedItem returns the indexes of the participant committing an item and of the item in its committed items,
+// or -1 and -1 if no participant commits it. Items are matched by cookbook and item ID, the amount of a received
+// fungible item being the committed amount
+func (s Swap) FindCommittedItem(ref ItemRef) (int, int) {
+	for i, participant := range s.Participants {
+		for j, item := range participant.Items {
+			if item.CookbookId == ref.CookbookId && item.ItemId == ref.ItemId {
+				return i, j
+			}
+		}
+	}
+	return -1, -1
+}
+
+// RoyaltiesByCookbook returns the royalties of the participant items transfer fees by cookbook ID
+func (p SwapParticipant) RoyaltiesByCookbook() map[string]sdk.Coins {
+	royalties := make(map[string]sdk.Coins, len(p.Royalties))
+	for _, royalty := range p.Royalties {
+		royalties[royalty.CookbookId] = royalty.Amount
+	}
+	return royalties
+}
+
+// TransferFees returns the transfer fees of the participant items, the chain fees and the royalties
+func (p SwapParticipant) TransferFees() sdk.Coins {
+	fees := p.ChainFees
+	for _, royalty := range p.Royalties {
+		fees = fees.Add(royalty.Amount...)
+	}
+	return fees
+}
@@ -0,0 +1,110 @@
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// MaxExpiredTradesPerBlock bounds the number of expired trades cancelled at the end of each block
+const MaxExpiredTradesPerBlock = 100
+
+// MaxTradeQuantity bounds the number of units offered by a trade
+const MaxTradeQuantity = 10000
+
+// Orders of the trades listed by ListTrades
+const (
+	TradeSortCreation = "creation"
+	TradeSortPrice    = "price"
+)
+
+// Reasons of the cancellation of a trade
+const (
+	TradeCancelReasonCancelled = "cancelled"
+	TradeCancelReasonExpired   = "expired"
+)
+
+// IsExpired checks if the trade reached its expiry height or time
+func (t Trade) IsExpired(ctx sdk.Context) bool {
+	if t.ExpiresAtHeight != 0 && ctx.BlockHeight() >= t.ExpiresAtHeight {
+		return true
+	}
+	return t.ExpiresAt != 0 && ctx.BlockTime().Unix() >= t.ExpiresAt
+}
+
+// RemainingUnits returns the number of units of the trade left to fill, a trade without quantity offers a single unit
+func (t Trade) RemainingUnits() uint64 {
+	if t.Quantity == 0 {
+		return 1
+	}
+	return t.RemainingQuantity
+}
+
+// IsFillItemsCount checks if count items are provided to fill an amount of units of the trade, one item for each
+// fungible input and fillAmount items for each other input. It is checked before expanding the item inputs of the fill
+func (t Trade) IsFillItemsCount(fillAmount uint64, count int) bool {
+	fungible, other := uint64(0), uint64(0)
+	for _, ii := range t.ItemInputs {
+		if ii.Amount != 0 {
+			fungible++
+		} else {
+			other++
+		}
+	}
+	if count < 0 || uint64(count) < fungible {
+		return false
+	}
+	rest := uint64(count) - fungible
+	if other == 0 {
+		return rest == 0
+	}
+	return rest%other == 0 && rest/other == fillAmount
+}
+
+// FillItemInputs returns the item inputs required to fill an amount of units of the trade, the amount of fungible
+// inputs is multiplied and other inputs are repeated
+func (t Trade) FillItemInputs(fillAmount uint64) []ItemInput {
+	itemInputs := make([]ItemInput, 0, len(t.ItemInputs))
+	for _, ii := range t.ItemInputs {
+		if ii.Amount != 0 {
+			ii.Amount *= fillAmount
+			itemInputs = append(itemInputs, ii)
+			continue
+		}
+		for i := uint64(0); i < fillAmount; i++ {
+			itemInputs = append(itemInputs, ii)
+		}
+	}
+	return itemInputs
+}
+
+// Prices returns the price per unit of the trade in each denom asked by its coin inputs, the lowest amount of the
+// denom among the coin inputs
+func (t Trade) Prices() sdk.Coins {
+	prices := sdk.NewCoins()
+	for _, coinInput := range t.CoinInputs {
+		for _, coin := range coinInput.Coins {
+			if !coin.IsPositive() {
+				continue
+			}
+			price := prices.AmountOf(coin.Denom)
+			if price.IsZero() {
+				prices = prices.Add(coin)
+			} else if coin.Amount.LT(price) {
+				prices = prices.Sub(sdk.NewCoin(coin.Denom, price.Sub(coin.Amount)))
+			}
+		}
+	}
+	return prices
+}
+
+// OfferedCookbookIDs returns the distinct cookbook ids of the items offered by the trade
+func (t Trade) OfferedCookbookIDs() []string {
+	cookbookIDs := make([]string, 0, len(t.ItemOutputs))
+	seen := make(map[string]bool, len(t.ItemOutputs))
+	for _, itemRef := range t.ItemOutputs {
+		if !seen[itemRef.CookbookId] {
+			seen[itemRef.CookbookId] = true
+			cookbookIDs = append(cookbookIDs, itemRef.CookbookId)
+		}
+	}
+	return cookbookIDs
+}
@@ -28,6 +28,8 @@ const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 type ItemRef struct {
 	CookbookId string `protobuf:"bytes,1,opt,name=cookbook_id,json=cookbookId,proto3" json:"cookbook_id,omitempty"`
 	ItemId     string `protobuf:"bytes,2,opt,name=item_id,json=itemId,proto3" json:"item_id,omitempty"`
+	// amount defines the number of units referenced from a fungible item. A 0 value references the whole item
+	Amount uint64 `protobuf:"varint,3,opt,name=amount,proto3" json:"amount,omitempty"`
 }
 
 func (m *ItemRef) Reset()         { *m = ItemRef{} }
@@ -77,16 +79,32 @@ func (m *ItemRef) GetItemId() string {
 	return ""
 }
 
+func (m *ItemRef) GetAmount() uint64 {
+	if m != nil {
+		return m.Amount
+	}
+	return 0
+}
+
 type Trade struct {
-	Creator          string                                   `protobuf:"bytes,1,opt,name=creator,proto3" json:"creator,omitempty"`
-	Id               uint64                                   `protobuf:"varint,2,opt,name=id,proto3" json:"id,omitempty"`
-	CoinInputs       []CoinInput                              `protobuf:"bytes,3,rep,name=coin_inputs,json=coinInputs,proto3" json:"coin_inputs"`
-	ItemInputs       []ItemInput                              `protobuf:"bytes,4,rep,name=item_inputs,json=itemInputs,proto3" json:"item_inputs"`
-	CoinOutputs      github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,5,rep,name=coin_outputs,json=coinOutputs,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"coin_outputs"`
-	ItemOutputs      []ItemRef                                `protobuf:"bytes,6,rep,name=item_outputs,json=itemOutputs,proto3" json:"item_outputs"`
-	ExtraInfo        string                                   `protobuf:"bytes,7,opt,name=extra_info,json=extraInfo,proto3" json:"extra_info,omitempty"`
-	Receiver         string                                   `protobuf:"bytes,8,opt,name=receiver,proto3" json:"receiver,omitempty"`
-	TradedItemInputs []ItemRef                                `protobuf:"bytes,9,rep,name=traded_item_inputs,json=tradedItemInputs,proto3" json:"traded_item_inputs"`
+	Creator     string                                   `protobuf:"bytes,1,opt,name=creator,proto3" json:"creator,omitempty"`
+	Id          uint64                                   `protobuf:"varint,2,opt,name=id,proto3" json:"id,omitempty"`
+	CoinInputs  []CoinInput                              `protobuf:"bytes,3,rep,name=coin_inputs,json=coinInputs,proto3" json:"coin_inputs"`
+	ItemInputs  []ItemInput                              `protobuf:"bytes,4,rep,name=item_inputs,json=itemInputs,proto3" json:"item_inputs"`
+	CoinOutputs github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,5,rep,name=coin_outputs,json=coinOutputs,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"coin_outputs"`
+	ItemOutputs []ItemRef                                `protobuf:"bytes,6,rep,name=item_outputs,json=itemOutputs,proto3" json:"item_outputs"`
+	ExtraInfo   string                                   `protobuf:"bytes,7,opt,name=extra_info,json=extraInfo,proto3" json:"extra_info,omitempty"`
+	// address of the only account allowed to fulfill the trade, anyone can fulfill the trade when empty
+	Receiver         string    `protobuf:"bytes,8,opt,name=receiver,proto3" json:"receiver,omitempty"`
+	TradedItemInputs []ItemRef `protobuf:"bytes,9,rep,name=traded_item_inputs,json=tradedItemInputs,proto3" json:"traded_item_inputs"`
+	// block height at which the trade expires, 0 if the trade does not expire by height
+	ExpiresAtHeight int64 `protobuf:"varint,10,opt,name=expires_at_height,json=expiresAtHeight,proto3" json:"expires_at_height,omitempty"`
+	// unix time at which the trade expires, 0 if the trade does not expire by time
+	ExpiresAt int64 `protobuf:"varint,11,opt,name=expires_at,json=expiresAt,proto3" json:"expires_at,omitempty"`
+	// number of units offered by the trade, the coin and item inputs and outputs are given per unit
+	Quantity uint64 `protobuf:"varint,12,opt,name=quantity,proto3" json:"quantity,omitempty"`
+	// number of units left to fill, the trade is closed once all its units are filled
+	RemainingQuantity uint64 `protobuf:"varint,13,opt,name=remaining_quantity,json=remainingQuantity,proto3" json:"remaining_quantity,omitempty"`
 }
 
 func (m *Trade) Reset()         { *m = Trade{} }
@@ -185,6 +203,34 @@ func (m *Trade) GetTradedItemInputs() []ItemRef {
 	return nil
 }
 
+func (m *Trade) GetExpiresAtHeight() int64 {
+	if m != nil {
+		return m.ExpiresAtHeight
+	}
+	return 0
+}
+
+func (m *Trade) GetExpiresAt() int64 {
+	if m != nil {
+		return m.ExpiresAt
+	}
+	return 0
+}
+
+func (m *Trade) GetQuantity() uint64 {
+	if m != nil {
+		return m.Quantity
+	}
+	return 0
+}
+
+func (m *Trade) GetRemainingQuantity() uint64 {
+	if m != nil {
+		return m.RemainingQuantity
+	}
+	return 0
+}
+
 func init() {
 	proto.RegisterType((*ItemRef)(nil), "pylons.pylons.ItemRef")
 	proto.RegisterType((*Trade)(nil), "pylons.pylons.Trade")
@@ -193,35 +239,40 @@ func init() {
 func init() { proto.RegisterFile("pylons/pylons/trade.proto", fileDescriptor_81335eb2534cb6ac) }
 
 var fileDescriptor_81335eb2534cb6ac = []byte{
-	// 445 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x52, 0xc1, 0x6e, 0x13, 0x31,
-	0x10, 0xcd, 0x26, 0x69, 0xd2, 0xb8, 0x05, 0x21, 0x0b, 0xc1, 0x36, 0x12, 0x9b, 0xaa, 0xa7, 0x1c,
-	0xa8, 0x97, 0xc2, 0x07, 0x54, 0x4a, 0x25, 0xa4, 0xe5, 0x02, 0x5a, 0x71, 0xe2, 0x12, 0xed, 0x7a,
-	0x9d, 0xd4, 0x0a, 0xf1, 0xac, 0x6c, 0xa7, 0x6a, 0xff, 0x82, 0xef, 0xe0, 0x4b, 0x7a, 0xec, 0x91,
-	0x13, 0xa0, 0xe4, 0xc8, 0x4f, 0x20, 0x8f, 0xbd, 0x51, 0x23, 0xe5, 0xd0, 0xd3, 0xec, 0xbc, 0xf1,
-	0xbc, 0x79, 0xf3, 0x76, 0xc8, 0x49, 0x7d, 0xf7, 0x1d, 0x94, 0x49, 0x43, 0xb0, 0xba, 0xa8, 0x04,
-	0xab, 0x35, 0x58, 0xa0, 0xcf, 0x3c, 0xc6, 0x7c, 0x18, 0x26, 0x1c, 0xcc, 0x12, 0x4c, 0x5a, 0x16,
-	0x46, 0xa4, 0x37, 0x17, 0xa5, 0xb0, 0xc5, 0x45, 0xca, 0x41, 0x2a, 0xff, 0x7c, 0xf8, 0x72, 0x0e,
-	0x73, 0xc0, 0xcf, 0xd4, 0x7d, 0x05, 0x74, 0xb8, 0xcb, 0xaf, 0x05, 0x97, 0x75, 0x18, 0x70, 0x76,
-	0x45, 0xfa, 0x99, 0x15, 0xcb, 0x5c, 0xcc, 0xe8, 0x88, 0x1c, 0x71, 0x80, 0x45, 0x09, 0xb0, 0x98,
-	0xca, 0x2a, 0x8e, 0x4e, 0xa3, 0xf1, 0x20, 0x27, 0x0d, 0x94, 0x55, 0xf4, 0x35, 0xe9, 0x4b, 0x2b,
-	0x96, 0xae, 0xd8, 0xc6, 0x62, 0xcf, 0xa5, 0x59, 0x75, 0xf6, 0xaf, 0x43, 0x0e, 0xbe, 0x3a, 0xd5,
-	0x34, 0x26, 0x7d, 0xae, 0x45, 0x61, 0x41, 0x87, 0xfe, 0x26, 0xa5, 0xcf, 0x49, 0x3b, 0xf4, 0x75,
-	0xf3, 0xb6, 0xac, 0xe8, 0xa5, 0x9b, 0x26, 0xd5, 0x54, 0xaa, 0x7a, 0x65, 0x4d, 0xdc, 0x39, 0xed,
-	0x8c, 0x8f, 0xde, 0xc7, 0x6c, 0x67, 0x5f, 0x76, 0x05, 0x52, 0x65, 0xee, 0xc1, 0xa4, 0x7b, 0xff,
-	0x7b, 0xd4, 0x72, 0x6a, 0x02, 0x60, 0x1c, 0x81, 0x57, 0xe3, 0x09, 0xba, 0x7b, 0x09, 0xdc, 0x6e,
-	0x3b, 0x04, 0xb2, 0x01, 0x0c, 0x55, 0xe4, 0x18, 0x15, 0xc0, 0xca, 0x22, 0xc3, 0x01, 0x32, 0x9c,
-	0x30, 0xef, 0x31, 0x73, 0x1e, 0xb3, 0xe0, 0x31, 0x0a, 0x99, 0xbc, 0x73, 0x14, 0x3f, 0xff, 0x8c,
-	0xc6, 0x73, 0x69, 0xaf, 0x57, 0x25, 0xe3, 0xb0, 0x4c, 0xc3, 0x0f, 0xf1, 0xe1, 0xdc, 0x54, 0x8b,
-	0xd4, 0xde, 0xd5, 0xc2, 0x2b, 0x37, 0x39, 0xae, 0xf8, 0xd9, 0xf3, 0xd3, 0x4b, 0x72, 0x8c, 0x82,
-	0x9b, 0x79, 0x3d, 0x9c, 0xf7, 0x6a, 0x8f, 0xe2, 0x5c, 0xcc, 0x82, 0x5e, 0x5c, 0xb1, 0x21, 0x78,
-	0x43, 0x88, 0xb8, 0xb5, 0xba, 0x98, 0x4a, 0x35, 0x83, 0xb8, 0x8f, 0xfe, 0x0e, 0x10, 0xc9, 0xd4,
-	0x0c, 0xe8, 0x90, 0x1c, 0x6a, 0xc1, 0x85, 0xbc, 0x11, 0x3a, 0x3e, 0xc4, 0xe2, 0x36, 0xa7, 0x9f,
-	0x08, 0xc5, 0xb3, 0xaa, 0xa6, 0x8f, 0x3d, 0x1b, 0x3c, 0x41, 0xc1, 0x0b, 0xdf, 0xb7, 0x35, 0xd2,
-	0x4c, 0x3e, 0xde, 0xaf, 0x93, 0xe8, 0x61, 0x9d, 0x44, 0x7f, 0xd7, 0x49, 0xf4, 0x63, 0x93, 0xb4,
-	0x1e, 0x36, 0x49, 0xeb, 0xd7, 0x26, 0x69, 0x7d, 0x7b, 0xfb, 0xc8, 0x98, 0x2f, 0x48, 0x76, 0x6e,
-	0x05, 0xbf, 0x6e, 0x0e, 0xef, 0x76, 0x7b, 0xe1, 0xce, 0xa2, 0xb2, 0x87, 0x17, 0xf8, 0xe1, 0x7f,
-	0x00, 0x00, 0x00, 0xff, 0xff, 0x06, 0xdb, 0xed, 0x1b, 0xff, 0x02, 0x00, 0x00,
+	// 526 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x53, 0x4d, 0x8e, 0xd3, 0x30,
+	0x18, 0x6d, 0xfa, 0x3b, 0x75, 0x3b, 0xc0, 0x58, 0x68, 0xc8, 0x54, 0x22, 0xad, 0x66, 0x55, 0x21,
+	0x9a, 0x30, 0x70, 0x80, 0x11, 0x45, 0x42, 0x84, 0x0d, 0x10, 0xb1, 0x82, 0x45, 0x94, 0x26, 0x6e,
+	0x6b, 0x95, 0xd8, 0xc1, 0x76, 0x47, 0xed, 0x92, 0x1b, 0x70, 0x0e, 0x4e, 0x32, 0xcb, 0x59, 0xb2,
+	0x02, 0xd4, 0x5e, 0x04, 0xf9, 0xb3, 0x13, 0xa6, 0xd2, 0x2c, 0x58, 0xd9, 0xdf, 0xfb, 0x5e, 0x9e,
+	0xdf, 0xf7, 0x1c, 0xa3, 0xb3, 0x62, 0xfb, 0x85, 0x33, 0x19, 0xd8, 0x45, 0x89, 0x24, 0x23, 0x7e,
+	0x21, 0xb8, 0xe2, 0xf8, 0xd8, 0x60, 0xbe, 0x59, 0x06, 0x5e, 0xca, 0x65, 0xce, 0x65, 0x30, 0x4b,
+	0x24, 0x09, 0xae, 0x2e, 0x66, 0x44, 0x25, 0x17, 0x41, 0xca, 0x29, 0x33, 0xf4, 0xc1, 0xc3, 0x05,
+	0x5f, 0x70, 0xd8, 0x06, 0x7a, 0x67, 0xd1, 0xc1, 0xa1, 0xbe, 0x20, 0x29, 0x2d, 0xec, 0x01, 0xe7,
+	0x9f, 0x51, 0x27, 0x54, 0x24, 0x8f, 0xc8, 0x1c, 0x0f, 0x51, 0x2f, 0xe5, 0x7c, 0x35, 0xe3, 0x7c,
+	0x15, 0xd3, 0xcc, 0x75, 0x46, 0xce, 0xb8, 0x1b, 0xa1, 0x12, 0x0a, 0x33, 0xfc, 0x08, 0x75, 0xa8,
+	0x22, 0xb9, 0x6e, 0xd6, 0xa1, 0xd9, 0xd6, 0x65, 0x98, 0xe1, 0x53, 0xd4, 0x4e, 0x72, 0xbe, 0x66,
+	0xca, 0x6d, 0x8c, 0x9c, 0x71, 0x33, 0xb2, 0xd5, 0xf9, 0xb7, 0x16, 0x6a, 0x7d, 0xd4, 0xd3, 0x60,
+	0x17, 0x75, 0x52, 0x41, 0x12, 0xc5, 0x85, 0xd5, 0x2d, 0x4b, 0x7c, 0x0f, 0xd5, 0xad, 0x5e, 0x33,
+	0xaa, 0xd3, 0x0c, 0x5f, 0x6a, 0x17, 0x94, 0xc5, 0x94, 0x15, 0x6b, 0x25, 0xdd, 0xc6, 0xa8, 0x31,
+	0xee, 0x3d, 0x77, 0xfd, 0x83, 0x1c, 0xfc, 0x57, 0x9c, 0xb2, 0x50, 0x13, 0xa6, 0xcd, 0xeb, 0x5f,
+	0xc3, 0x9a, 0x76, 0x69, 0x01, 0xa9, 0x05, 0x8c, 0x4b, 0x23, 0xd0, 0xbc, 0x53, 0x40, 0xcf, 0x7c,
+	0x20, 0x40, 0x4b, 0x40, 0x62, 0x86, 0xfa, 0xe0, 0x80, 0xaf, 0x15, 0x28, 0xb4, 0x40, 0xe1, 0xcc,
+	0x37, 0xd9, 0xfb, 0x3a, 0x7b, 0xdf, 0x66, 0x0f, 0x46, 0xa6, 0xcf, 0xb4, 0xc4, 0x8f, 0xdf, 0xc3,
+	0xf1, 0x82, 0xaa, 0xe5, 0x7a, 0xe6, 0xa7, 0x3c, 0x0f, 0xec, 0x45, 0x99, 0x65, 0x22, 0xb3, 0x55,
+	0xa0, 0xb6, 0x05, 0x31, 0xce, 0x65, 0x04, 0x23, 0xbe, 0x33, 0xfa, 0xf8, 0x12, 0xf5, 0xc1, 0x70,
+	0x79, 0x5e, 0x1b, 0xce, 0x3b, 0xbd, 0xc3, 0x71, 0x44, 0xe6, 0xd6, 0x2f, 0x8c, 0x58, 0x0a, 0x3c,
+	0x46, 0x88, 0x6c, 0x94, 0x48, 0x62, 0xca, 0xe6, 0xdc, 0xed, 0x40, 0xbe, 0x5d, 0x40, 0x42, 0x36,
+	0xe7, 0x78, 0x80, 0x8e, 0x04, 0x49, 0x09, 0xbd, 0x22, 0xc2, 0x3d, 0x82, 0x66, 0x55, 0xe3, 0xb7,
+	0x08, 0xc3, 0xef, 0x96, 0xc5, 0xb7, 0x33, 0xeb, 0xfe, 0x87, 0x83, 0x07, 0xe6, 0xbb, 0xf0, 0x5f,
+	0x6e, 0x4f, 0xd0, 0x09, 0xd9, 0x14, 0x54, 0x10, 0x19, 0x27, 0x2a, 0x5e, 0x12, 0xba, 0x58, 0x2a,
+	0x17, 0x8d, 0x9c, 0x71, 0x23, 0xba, 0x6f, 0x1b, 0x2f, 0xd5, 0x1b, 0x80, 0x8d, 0xe5, 0x92, 0xeb,
+	0xf6, 0x80, 0xd4, 0xad, 0x48, 0xda, 0xf2, 0xd7, 0x75, 0xc2, 0x14, 0x55, 0x5b, 0xb7, 0x0f, 0xbf,
+	0x46, 0x55, 0xe3, 0x09, 0xc2, 0x82, 0xe4, 0x09, 0x65, 0x94, 0x2d, 0xe2, 0x8a, 0x75, 0x0c, 0xac,
+	0x93, 0xaa, 0xf3, 0xc1, 0x36, 0xa6, 0xaf, 0xaf, 0x77, 0x9e, 0x73, 0xb3, 0xf3, 0x9c, 0x3f, 0x3b,
+	0xcf, 0xf9, 0xbe, 0xf7, 0x6a, 0x37, 0x7b, 0xaf, 0xf6, 0x73, 0xef, 0xd5, 0x3e, 0x3d, 0xbd, 0x75,
+	0x5d, 0xef, 0x61, 0xc4, 0x89, 0x22, 0xe9, 0xb2, 0x7c, 0x26, 0x9b, 0x72, 0x03, 0x17, 0x37, 0x6b,
+	0xc3, 0x7b, 0x79, 0xf1, 0x77, 0x00, 0x8b, 0x31, 0x8d, 0xfe, 0xad, 0x03, 0x00, 0x00,
 }
 
 func (m *ItemRef) Marshal() (dAtA []byte, err error) {
@@ -244,6 +295,11 @@ func (m *ItemRef) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.Amount != 0 {
+		i = encodeVarintTrade(dAtA, i, uint64(m.Amount))
+		i--
+		dAtA[i] = 0x18
+	}
 	if len(m.ItemId) > 0 {
 		i -= len(m.ItemId)
 		copy(dAtA[i:], m.ItemId)
@@ -281,6 +337,26 @@ func (m *Trade) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.RemainingQuantity != 0 {
+		i = encodeVarintTrade(dAtA, i, uint64(m.RemainingQuantity))
+		i--
+		dAtA[i] = 0x68
+	}
+	if m.Quantity != 0 {
+		i = encodeVarintTrade(dAtA, i, uint64(m.Quantity))
+		i--
+		dAtA[i] = 0x60
+	}
+	if m.ExpiresAt != 0 {
+		i = encodeVarintTrade(dAtA, i, uint64(m.ExpiresAt))
+		i--
+		dAtA[i] = 0x58
+	}
+	if m.ExpiresAtHeight != 0 {
+		i = encodeVarintTrade(dAtA, i, uint64(m.ExpiresAtHeight))
+		i--
+		dAtA[i] = 0x50
+	}
 	if len(m.TradedItemInputs) > 0 {
 		for iNdEx := len(m.TradedItemInputs) - 1; iNdEx >= 0; iNdEx-- {
 			{
@@ -405,6 +481,9 @@ func (m *ItemRef) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovTrade(uint64(l))
 	}
+	if m.Amount != 0 {
+		n += 1 + sovTrade(uint64(m.Amount))
+	}
 	return n
 }
 
@@ -459,6 +538,18 @@ func (m *Trade) Size() (n int) {
 			n += 1 + l + sovTrade(uint64(l))
 		}
 	}
+	if m.ExpiresAtHeight != 0 {
+		n += 1 + sovTrade(uint64(m.ExpiresAtHeight))
+	}
+	if m.ExpiresAt != 0 {
+		n += 1 + sovTrade(uint64(m.ExpiresAt))
+	}
+	if m.Quantity != 0 {
+		n += 1 + sovTrade(uint64(m.Quantity))
+	}
+	if m.RemainingQuantity != 0 {
+		n += 1 + sovTrade(uint64(m.RemainingQuantity))
+	}
 	return n
 }
 
@@ -561,6 +652,25 @@ func (m *ItemRef) Unmarshal(dAtA []byte) error {
 			}
 			m.ItemId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			m.Amount = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTrade
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Amount |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTrade(dAtA[iNdEx:])
@@ -896,6 +1006,82 @@ func (m *Trade) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 10:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExpiresAtHeight", wireType)
+			}
+			m.ExpiresAtHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTrade
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ExpiresAtHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 11:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExpiresAt", wireType)
+			}
+			m.ExpiresAt = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTrade
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ExpiresAt |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 12:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Quantity", wireType)
+			}
+			m.Quantity = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTrade
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Quantity |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 13:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RemainingQuantity", wireType)
+			}
+			m.RemainingQuantity = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTrade
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.RemainingQuantity |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTrade(dAtA[iNdEx:])
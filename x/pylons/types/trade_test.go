@@ -0,0 +1,86 @@
+package types
+
+import (
+	"bytes"
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestTradeFillItemInputs(t *testing.T) {
+	trade := Trade{
+		ItemInputs: []ItemInput{
+			{Id: "sword"},
+			{Id: "potion", Amount: 5},
+		},
+	}
+
+	require.Equal(t, uint64(1), trade.RemainingUnits())
+	require.Equal(t, trade.ItemInputs, trade.FillItemInputs(1))
+
+	// fungible inputs are multiplied and other inputs are repeated
+	itemInputs := trade.FillItemInputs(3)
+	require.Len(t, itemInputs, 4)
+	require.Equal(t, "sword", itemInputs[2].Id)
+	require.Equal(t, "potion", itemInputs[3].Id)
+	require.Equal(t, uint64(15), itemInputs[3].Amount)
+	require.Equal(t, uint64(5), trade.ItemInputs[1].Amount)
+
+	trade.Quantity = 3
+	trade.RemainingQuantity = 2
+	require.Equal(t, uint64(2), trade.RemainingUnits())
+}
+
+func TestTradeIsFillItemsCount(t *testing.T) {
+	trade := Trade{
+		ItemInputs: []ItemInput{
+			{Id: "sword"},
+			{Id: "shield"},
+			{Id: "potion", Amount: 5},
+		},
+	}
+
+	require.True(t, trade.IsFillItemsCount(1, 3))
+	require.True(t, trade.IsFillItemsCount(3, 7))
+	require.False(t, trade.IsFillItemsCount(3, 6))
+	require.False(t, trade.IsFillItemsCount(1<<62, 7))
+	require.False(t, trade.IsFillItemsCount(1, 0))
+
+	fungible := Trade{ItemInputs: []ItemInput{{Id: "potion", Amount: 5}}}
+	require.True(t, fungible.IsFillItemsCount(1<<62, 1))
+	require.False(t, fungible.IsFillItemsCount(1, 2))
+}
+
+func TestTradePrices(t *testing.T) {
+	trade := Trade{
+		CoinInputs: []CoinInput{
+			{Coins: sdk.NewCoins(sdk.NewInt64Coin("upylon", 100))},
+			{Coins: sdk.NewCoins(sdk.NewInt64Coin("upylon", 80), sdk.NewInt64Coin("uatom", 5))},
+			{Coins: sdk.NewCoins(sdk.NewInt64Coin("uatom", 10))},
+		},
+		ItemOutputs: []ItemRef{
+			{CookbookId: "cookbookA", ItemId: "a"},
+			{CookbookId: "cookbookB", ItemId: "b"},
+			{CookbookId: "cookbookA", ItemId: "c"},
+		},
+	}
+
+	// the price in each denom is its lowest amount among the coin inputs
+	require.Equal(t, sdk.NewCoins(sdk.NewInt64Coin("upylon", 80), sdk.NewInt64Coin("uatom", 5)), trade.Prices())
+	require.Equal(t, []string{"cookbookA", "cookbookB"}, trade.OfferedCookbookIDs())
+	require.True(t, Trade{}.Prices().IsZero())
+}
+
+func TestPriceTradeIndexKey(t *testing.T) {
+	// keys are ordered by price, then by trade id
+	keys := [][]byte{
+		PriceTradeIndexKey("upylon", sdk.NewInt(9), 5),
+		PriceTradeIndexKey("upylon", sdk.NewInt(255), 1),
+		PriceTradeIndexKey("upylon", sdk.NewInt(256), 0),
+		PriceTradeIndexKey("upylon", sdk.NewInt(256), 3),
+	}
+	for i := 1; i < len(keys); i++ {
+		require.Negative(t, bytes.Compare(keys[i-1], keys[i]))
+	}
+}
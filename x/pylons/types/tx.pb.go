@@ -8,6 +8,7 @@ import (
 	fmt "fmt"
 	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
 	types "github.com/cosmos/cosmos-sdk/types"
+	types1 "github.com/cosmos/ibc-go/v5/modules/core/02-client/types"
 	_ "github.com/gogo/protobuf/gogoproto"
 	grpc1 "github.com/gogo/protobuf/grpc"
 	proto "github.com/gogo/protobuf/proto"
@@ -509,6 +510,8 @@ type MsgFulfillTrade struct {
 	CoinInputsIndex uint64        `protobuf:"varint,3,opt,name=coin_inputs_index,json=coinInputsIndex,proto3" json:"coin_inputs_index,omitempty"`
 	Items           []ItemRef     `protobuf:"bytes,4,rep,name=items,proto3" json:"items"`
 	PaymentInfos    []PaymentInfo `protobuf:"bytes,5,rep,name=payment_infos,json=paymentInfos,proto3" json:"payment_infos"`
+	// number of units of the trade to fill, 0 fills a single unit
+	FillAmount uint64 `protobuf:"varint,6,opt,name=fill_amount,json=fillAmount,proto3" json:"fill_amount,omitempty"`
 }
 
 func (m *MsgFulfillTrade) Reset()         { *m = MsgFulfillTrade{} }
@@ -579,6 +582,13 @@ func (m *MsgFulfillTrade) GetPaymentInfos() []PaymentInfo {
 	return nil
 }
 
+func (m *MsgFulfillTrade) GetFillAmount() uint64 {
+	if m != nil {
+		return m.FillAmount
+	}
+	return 0
+}
+
 type MsgFulfillTradeResponse struct {
 }
 
@@ -622,6 +632,14 @@ type MsgCreateTrade struct {
 	CoinOutputs github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,4,rep,name=coin_outputs,json=coinOutputs,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"coin_outputs"`
 	ItemOutputs []ItemRef                                `protobuf:"bytes,5,rep,name=item_outputs,json=itemOutputs,proto3" json:"item_outputs"`
 	ExtraInfo   string                                   `protobuf:"bytes,6,opt,name=extra_info,json=extraInfo,proto3" json:"extra_info,omitempty"`
+	// address of the only account allowed to fulfill the trade, anyone can fulfill the trade when empty
+	Receiver string `protobuf:"bytes,7,opt,name=receiver,proto3" json:"receiver,omitempty"`
+	// block height at which the trade expires and is cancelled, 0 if the trade does not expire by height
+	ExpiresAtHeight int64 `protobuf:"varint,8,opt,name=expires_at_height,json=expiresAtHeight,proto3" json:"expires_at_height,omitempty"`
+	// unix time at which the trade expires and is cancelled, 0 if the trade does not expire by time
+	ExpiresAt int64 `protobuf:"varint,9,opt,name=expires_at,json=expiresAt,proto3" json:"expires_at,omitempty"`
+	// number of units offered by the trade, 0 offers a single unit
+	Quantity uint64 `protobuf:"varint,10,opt,name=quantity,proto3" json:"quantity,omitempty"`
 }
 
 func (m *MsgCreateTrade) Reset()         { *m = MsgCreateTrade{} }
@@ -699,6 +717,34 @@ func (m *MsgCreateTrade) GetExtraInfo() string {
 	return ""
 }
 
+func (m *MsgCreateTrade) GetReceiver() string {
+	if m != nil {
+		return m.Receiver
+	}
+	return ""
+}
+
+func (m *MsgCreateTrade) GetExpiresAtHeight() int64 {
+	if m != nil {
+		return m.ExpiresAtHeight
+	}
+	return 0
+}
+
+func (m *MsgCreateTrade) GetExpiresAt() int64 {
+	if m != nil {
+		return m.ExpiresAt
+	}
+	return 0
+}
+
+func (m *MsgCreateTrade) GetQuantity() uint64 {
+	if m != nil {
+		return m.Quantity
+	}
+	return 0
+}
+
 type MsgCreateTradeResponse struct {
 	Id uint64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
 }
@@ -1233,27 +1279,23 @@ func (m *MsgSendItemsResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_MsgSendItemsResponse proto.InternalMessageInfo
 
-type MsgExecuteRecipe struct {
-	Creator         string        `protobuf:"bytes,1,opt,name=creator,proto3" json:"creator,omitempty"`
-	CookbookId      string        `protobuf:"bytes,2,opt,name=cookbook_id,json=cookbookId,proto3" json:"cookbook_id,omitempty"`
-	RecipeId        string        `protobuf:"bytes,3,opt,name=recipe_id,json=recipeId,proto3" json:"recipe_id,omitempty"`
-	CoinInputsIndex uint64        `protobuf:"varint,4,opt,name=coin_inputs_index,json=coinInputsIndex,proto3" json:"coin_inputs_index,omitempty"`
-	ItemIds         []string      `protobuf:"bytes,5,rep,name=item_ids,json=itemIds,proto3" json:"item_ids,omitempty"`
-	PaymentInfos    []PaymentInfo `protobuf:"bytes,6,rep,name=payment_infos,json=paymentInfos,proto3" json:"payment_infos"`
+type MsgBurnItems struct {
+	Creator string    `protobuf:"bytes,1,opt,name=creator,proto3" json:"creator,omitempty"`
+	Items   []ItemRef `protobuf:"bytes,2,rep,name=items,proto3" json:"items"`
 }
 
-func (m *MsgExecuteRecipe) Reset()         { *m = MsgExecuteRecipe{} }
-func (m *MsgExecuteRecipe) String() string { return proto.CompactTextString(m) }
-func (*MsgExecuteRecipe) ProtoMessage()    {}
-func (*MsgExecuteRecipe) Descriptor() ([]byte, []int) {
+func (m *MsgBurnItems) Reset()         { *m = MsgBurnItems{} }
+func (m *MsgBurnItems) String() string { return proto.CompactTextString(m) }
+func (*MsgBurnItems) ProtoMessage()    {}
+func (*MsgBurnItems) Descriptor() ([]byte, []int) {
 	return fileDescriptor_2c35d96b13ec9475, []int{24}
 }
-func (m *MsgExecuteRecipe) XXX_Unmarshal(b []byte) error {
+func (m *MsgBurnItems) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *MsgExecuteRecipe) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *MsgBurnItems) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_MsgExecuteRecipe.Marshal(b, m, deterministic)
+		return xxx_messageInfo_MsgBurnItems.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -1263,76 +1305,47 @@ func (m *MsgExecuteRecipe) XXX_Marshal(b []byte, deterministic bool) ([]byte, er
 		return b[:n], nil
 	}
 }
-func (m *MsgExecuteRecipe) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_MsgExecuteRecipe.Merge(m, src)
+func (m *MsgBurnItems) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgBurnItems.Merge(m, src)
 }
-func (m *MsgExecuteRecipe) XXX_Size() int {
+func (m *MsgBurnItems) XXX_Size() int {
 	return m.Size()
 }
-func (m *MsgExecuteRecipe) XXX_DiscardUnknown() {
-	xxx_messageInfo_MsgExecuteRecipe.DiscardUnknown(m)
+func (m *MsgBurnItems) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgBurnItems.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_MsgExecuteRecipe proto.InternalMessageInfo
+var xxx_messageInfo_MsgBurnItems proto.InternalMessageInfo
 
-func (m *MsgExecuteRecipe) GetCreator() string {
+func (m *MsgBurnItems) GetCreator() string {
 	if m != nil {
 		return m.Creator
 	}
 	return ""
 }
 
-func (m *MsgExecuteRecipe) GetCookbookId() string {
-	if m != nil {
-		return m.CookbookId
-	}
-	return ""
-}
-
-func (m *MsgExecuteRecipe) GetRecipeId() string {
-	if m != nil {
-		return m.RecipeId
-	}
-	return ""
-}
-
-func (m *MsgExecuteRecipe) GetCoinInputsIndex() uint64 {
-	if m != nil {
-		return m.CoinInputsIndex
-	}
-	return 0
-}
-
-func (m *MsgExecuteRecipe) GetItemIds() []string {
-	if m != nil {
-		return m.ItemIds
-	}
-	return nil
-}
-
-func (m *MsgExecuteRecipe) GetPaymentInfos() []PaymentInfo {
+func (m *MsgBurnItems) GetItems() []ItemRef {
 	if m != nil {
-		return m.PaymentInfos
+		return m.Items
 	}
 	return nil
 }
 
-type MsgExecuteRecipeResponse struct {
-	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+type MsgBurnItemsResponse struct {
 }
 
-func (m *MsgExecuteRecipeResponse) Reset()         { *m = MsgExecuteRecipeResponse{} }
-func (m *MsgExecuteRecipeResponse) String() string { return proto.CompactTextString(m) }
-func (*MsgExecuteRecipeResponse) ProtoMessage()    {}
-func (*MsgExecuteRecipeResponse) Descriptor() ([]byte, []int) {
+func (m *MsgBurnItemsResponse) Reset()         { *m = MsgBurnItemsResponse{} }
+func (m *MsgBurnItemsResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgBurnItemsResponse) ProtoMessage()    {}
+func (*MsgBurnItemsResponse) Descriptor() ([]byte, []int) {
 	return fileDescriptor_2c35d96b13ec9475, []int{25}
 }
-func (m *MsgExecuteRecipeResponse) XXX_Unmarshal(b []byte) error {
+func (m *MsgBurnItemsResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *MsgExecuteRecipeResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *MsgBurnItemsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_MsgExecuteRecipeResponse.Marshal(b, m, deterministic)
+		return xxx_messageInfo_MsgBurnItemsResponse.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -1342,45 +1355,38 @@ func (m *MsgExecuteRecipeResponse) XXX_Marshal(b []byte, deterministic bool) ([]
 		return b[:n], nil
 	}
 }
-func (m *MsgExecuteRecipeResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_MsgExecuteRecipeResponse.Merge(m, src)
+func (m *MsgBurnItemsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgBurnItemsResponse.Merge(m, src)
 }
-func (m *MsgExecuteRecipeResponse) XXX_Size() int {
+func (m *MsgBurnItemsResponse) XXX_Size() int {
 	return m.Size()
 }
-func (m *MsgExecuteRecipeResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_MsgExecuteRecipeResponse.DiscardUnknown(m)
+func (m *MsgBurnItemsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgBurnItemsResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_MsgExecuteRecipeResponse proto.InternalMessageInfo
-
-func (m *MsgExecuteRecipeResponse) GetId() string {
-	if m != nil {
-		return m.Id
-	}
-	return ""
-}
+var xxx_messageInfo_MsgBurnItemsResponse proto.InternalMessageInfo
 
-type MsgSetItemString struct {
-	Creator    string `protobuf:"bytes,1,opt,name=creator,proto3" json:"creator,omitempty"`
-	CookbookId string `protobuf:"bytes,2,opt,name=cookbook_id,json=cookbookId,proto3" json:"cookbook_id,omitempty"`
-	Id         string `protobuf:"bytes,4,opt,name=id,proto3" json:"id,omitempty"`
-	Field      string `protobuf:"bytes,5,opt,name=field,proto3" json:"field,omitempty"`
-	Value      string `protobuf:"bytes,6,opt,name=value,proto3" json:"value,omitempty"`
+type MsgCreateLending struct {
+	Creator  string                                   `protobuf:"bytes,1,opt,name=creator,proto3" json:"creator,omitempty"`
+	Item     ItemRef                                  `protobuf:"bytes,2,opt,name=item,proto3" json:"item"`
+	Borrower string                                   `protobuf:"bytes,3,opt,name=borrower,proto3" json:"borrower,omitempty"`
+	Price    github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,4,rep,name=price,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"price"`
+	Duration int64                                    `protobuf:"varint,5,opt,name=duration,proto3" json:"duration,omitempty"`
 }
 
-func (m *MsgSetItemString) Reset()         { *m = MsgSetItemString{} }
-func (m *MsgSetItemString) String() string { return proto.CompactTextString(m) }
-func (*MsgSetItemString) ProtoMessage()    {}
-func (*MsgSetItemString) Descriptor() ([]byte, []int) {
+func (m *MsgCreateLending) Reset()         { *m = MsgCreateLending{} }
+func (m *MsgCreateLending) String() string { return proto.CompactTextString(m) }
+func (*MsgCreateLending) ProtoMessage()    {}
+func (*MsgCreateLending) Descriptor() ([]byte, []int) {
 	return fileDescriptor_2c35d96b13ec9475, []int{26}
 }
-func (m *MsgSetItemString) XXX_Unmarshal(b []byte) error {
+func (m *MsgCreateLending) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *MsgSetItemString) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *MsgCreateLending) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_MsgSetItemString.Marshal(b, m, deterministic)
+		return xxx_messageInfo_MsgCreateLending.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -1390,68 +1396,69 @@ func (m *MsgSetItemString) XXX_Marshal(b []byte, deterministic bool) ([]byte, er
 		return b[:n], nil
 	}
 }
-func (m *MsgSetItemString) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_MsgSetItemString.Merge(m, src)
+func (m *MsgCreateLending) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgCreateLending.Merge(m, src)
 }
-func (m *MsgSetItemString) XXX_Size() int {
+func (m *MsgCreateLending) XXX_Size() int {
 	return m.Size()
 }
-func (m *MsgSetItemString) XXX_DiscardUnknown() {
-	xxx_messageInfo_MsgSetItemString.DiscardUnknown(m)
+func (m *MsgCreateLending) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgCreateLending.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_MsgSetItemString proto.InternalMessageInfo
+var xxx_messageInfo_MsgCreateLending proto.InternalMessageInfo
 
-func (m *MsgSetItemString) GetCreator() string {
+func (m *MsgCreateLending) GetCreator() string {
 	if m != nil {
 		return m.Creator
 	}
 	return ""
 }
 
-func (m *MsgSetItemString) GetCookbookId() string {
+func (m *MsgCreateLending) GetItem() ItemRef {
 	if m != nil {
-		return m.CookbookId
+		return m.Item
 	}
-	return ""
+	return ItemRef{}
 }
 
-func (m *MsgSetItemString) GetId() string {
+func (m *MsgCreateLending) GetBorrower() string {
 	if m != nil {
-		return m.Id
+		return m.Borrower
 	}
 	return ""
 }
 
-func (m *MsgSetItemString) GetField() string {
+func (m *MsgCreateLending) GetPrice() github_com_cosmos_cosmos_sdk_types.Coins {
 	if m != nil {
-		return m.Field
+		return m.Price
 	}
-	return ""
+	return nil
 }
 
-func (m *MsgSetItemString) GetValue() string {
+func (m *MsgCreateLending) GetDuration() int64 {
 	if m != nil {
-		return m.Value
+		return m.Duration
 	}
-	return ""
+	return 0
 }
 
-type MsgSetItemStringResponse struct {
+type MsgCreateLendingResponse struct {
+	Id uint64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
 }
 
-func (m *MsgSetItemStringResponse) Reset()         { *m = MsgSetItemStringResponse{} }
-func (m *MsgSetItemStringResponse) String() string { return proto.CompactTextString(m) }
-func (*MsgSetItemStringResponse) ProtoMessage()    {}
-func (*MsgSetItemStringResponse) Descriptor() ([]byte, []int) {
+func (m *MsgCreateLendingResponse) Reset()         { *m = MsgCreateLendingResponse{} }
+func (m *MsgCreateLendingResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgCreateLendingResponse) ProtoMessage()    {}
+func (*MsgCreateLendingResponse) Descriptor() ([]byte, []int) {
 	return fileDescriptor_2c35d96b13ec9475, []int{27}
 }
-func (m *MsgSetItemStringResponse) XXX_Unmarshal(b []byte) error {
+func (m *MsgCreateLendingResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *MsgSetItemStringResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *MsgCreateLendingResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_MsgSetItemStringResponse.Marshal(b, m, deterministic)
+		return xxx_messageInfo_MsgCreateLendingResponse.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -1461,47 +1468,42 @@ func (m *MsgSetItemStringResponse) XXX_Marshal(b []byte, deterministic bool) ([]
 		return b[:n], nil
 	}
 }
-func (m *MsgSetItemStringResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_MsgSetItemStringResponse.Merge(m, src)
+func (m *MsgCreateLendingResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgCreateLendingResponse.Merge(m, src)
 }
-func (m *MsgSetItemStringResponse) XXX_Size() int {
+func (m *MsgCreateLendingResponse) XXX_Size() int {
 	return m.Size()
 }
-func (m *MsgSetItemStringResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_MsgSetItemStringResponse.DiscardUnknown(m)
+func (m *MsgCreateLendingResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgCreateLendingResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_MsgSetItemStringResponse proto.InternalMessageInfo
+var xxx_messageInfo_MsgCreateLendingResponse proto.InternalMessageInfo
 
-type MsgCreateRecipe struct {
-	Creator       string            `protobuf:"bytes,1,opt,name=creator,proto3" json:"creator,omitempty"`
-	CookbookId    string            `protobuf:"bytes,2,opt,name=cookbook_id,json=cookbookId,proto3" json:"cookbook_id,omitempty"`
-	Id            string            `protobuf:"bytes,3,opt,name=id,proto3" json:"id,omitempty"`
-	Name          string            `protobuf:"bytes,4,opt,name=name,proto3" json:"name,omitempty"`
-	Description   string            `protobuf:"bytes,5,opt,name=description,proto3" json:"description,omitempty"`
-	Version       string            `protobuf:"bytes,6,opt,name=version,proto3" json:"version,omitempty"`
-	CoinInputs    []CoinInput       `protobuf:"bytes,7,rep,name=coin_inputs,json=coinInputs,proto3" json:"coin_inputs"`
-	ItemInputs    []ItemInput       `protobuf:"bytes,8,rep,name=item_inputs,json=itemInputs,proto3" json:"item_inputs"`
-	Entries       EntriesList       `protobuf:"bytes,9,opt,name=entries,proto3" json:"entries"`
-	Outputs       []WeightedOutputs `protobuf:"bytes,10,rep,name=outputs,proto3" json:"outputs"`
-	BlockInterval int64             `protobuf:"varint,11,opt,name=block_interval,json=blockInterval,proto3" json:"block_interval,omitempty"`
-	CostPerBlock  types.Coin        `protobuf:"bytes,12,opt,name=cost_per_block,json=costPerBlock,proto3" json:"cost_per_block"`
-	Enabled       bool              `protobuf:"varint,13,opt,name=enabled,proto3" json:"enabled,omitempty"`
-	ExtraInfo     string            `protobuf:"bytes,14,opt,name=extra_info,json=extraInfo,proto3" json:"extra_info,omitempty"`
+func (m *MsgCreateLendingResponse) GetId() uint64 {
+	if m != nil {
+		return m.Id
+	}
+	return 0
 }
 
-func (m *MsgCreateRecipe) Reset()         { *m = MsgCreateRecipe{} }
-func (m *MsgCreateRecipe) String() string { return proto.CompactTextString(m) }
-func (*MsgCreateRecipe) ProtoMessage()    {}
-func (*MsgCreateRecipe) Descriptor() ([]byte, []int) {
+type MsgAcceptLending struct {
+	Creator string `protobuf:"bytes,1,opt,name=creator,proto3" json:"creator,omitempty"`
+	Id      uint64 `protobuf:"varint,2,opt,name=id,proto3" json:"id,omitempty"`
+}
+
+func (m *MsgAcceptLending) Reset()         { *m = MsgAcceptLending{} }
+func (m *MsgAcceptLending) String() string { return proto.CompactTextString(m) }
+func (*MsgAcceptLending) ProtoMessage()    {}
+func (*MsgAcceptLending) Descriptor() ([]byte, []int) {
 	return fileDescriptor_2c35d96b13ec9475, []int{28}
 }
-func (m *MsgCreateRecipe) XXX_Unmarshal(b []byte) error {
+func (m *MsgAcceptLending) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *MsgCreateRecipe) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *MsgAcceptLending) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_MsgCreateRecipe.Marshal(b, m, deterministic)
+		return xxx_messageInfo_MsgAcceptLending.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -1511,131 +1513,240 @@ func (m *MsgCreateRecipe) XXX_Marshal(b []byte, deterministic bool) ([]byte, err
 		return b[:n], nil
 	}
 }
-func (m *MsgCreateRecipe) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_MsgCreateRecipe.Merge(m, src)
+func (m *MsgAcceptLending) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgAcceptLending.Merge(m, src)
 }
-func (m *MsgCreateRecipe) XXX_Size() int {
+func (m *MsgAcceptLending) XXX_Size() int {
 	return m.Size()
 }
-func (m *MsgCreateRecipe) XXX_DiscardUnknown() {
-	xxx_messageInfo_MsgCreateRecipe.DiscardUnknown(m)
+func (m *MsgAcceptLending) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgAcceptLending.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_MsgCreateRecipe proto.InternalMessageInfo
+var xxx_messageInfo_MsgAcceptLending proto.InternalMessageInfo
 
-func (m *MsgCreateRecipe) GetCreator() string {
+func (m *MsgAcceptLending) GetCreator() string {
 	if m != nil {
 		return m.Creator
 	}
 	return ""
 }
 
-func (m *MsgCreateRecipe) GetCookbookId() string {
+func (m *MsgAcceptLending) GetId() uint64 {
 	if m != nil {
-		return m.CookbookId
+		return m.Id
 	}
-	return ""
+	return 0
 }
 
-func (m *MsgCreateRecipe) GetId() string {
-	if m != nil {
-		return m.Id
-	}
-	return ""
+type MsgAcceptLendingResponse struct {
 }
 
-func (m *MsgCreateRecipe) GetName() string {
-	if m != nil {
-		return m.Name
+func (m *MsgAcceptLendingResponse) Reset()         { *m = MsgAcceptLendingResponse{} }
+func (m *MsgAcceptLendingResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgAcceptLendingResponse) ProtoMessage()    {}
+func (*MsgAcceptLendingResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2c35d96b13ec9475, []int{29}
+}
+func (m *MsgAcceptLendingResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgAcceptLendingResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgAcceptLendingResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return ""
+}
+func (m *MsgAcceptLendingResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgAcceptLendingResponse.Merge(m, src)
+}
+func (m *MsgAcceptLendingResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgAcceptLendingResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgAcceptLendingResponse.DiscardUnknown(m)
 }
 
-func (m *MsgCreateRecipe) GetDescription() string {
-	if m != nil {
-		return m.Description
+var xxx_messageInfo_MsgAcceptLendingResponse proto.InternalMessageInfo
+
+type MsgCancelLending struct {
+	Creator string `protobuf:"bytes,1,opt,name=creator,proto3" json:"creator,omitempty"`
+	Id      uint64 `protobuf:"varint,2,opt,name=id,proto3" json:"id,omitempty"`
+}
+
+func (m *MsgCancelLending) Reset()         { *m = MsgCancelLending{} }
+func (m *MsgCancelLending) String() string { return proto.CompactTextString(m) }
+func (*MsgCancelLending) ProtoMessage()    {}
+func (*MsgCancelLending) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2c35d96b13ec9475, []int{30}
+}
+func (m *MsgCancelLending) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgCancelLending) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgCancelLending.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return ""
+}
+func (m *MsgCancelLending) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgCancelLending.Merge(m, src)
+}
+func (m *MsgCancelLending) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgCancelLending) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgCancelLending.DiscardUnknown(m)
 }
 
-func (m *MsgCreateRecipe) GetVersion() string {
+var xxx_messageInfo_MsgCancelLending proto.InternalMessageInfo
+
+func (m *MsgCancelLending) GetCreator() string {
 	if m != nil {
-		return m.Version
+		return m.Creator
 	}
 	return ""
 }
 
-func (m *MsgCreateRecipe) GetCoinInputs() []CoinInput {
+func (m *MsgCancelLending) GetId() uint64 {
 	if m != nil {
-		return m.CoinInputs
+		return m.Id
 	}
-	return nil
+	return 0
 }
 
-func (m *MsgCreateRecipe) GetItemInputs() []ItemInput {
-	if m != nil {
-		return m.ItemInputs
-	}
-	return nil
+type MsgCancelLendingResponse struct {
 }
 
-func (m *MsgCreateRecipe) GetEntries() EntriesList {
-	if m != nil {
-		return m.Entries
+func (m *MsgCancelLendingResponse) Reset()         { *m = MsgCancelLendingResponse{} }
+func (m *MsgCancelLendingResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgCancelLendingResponse) ProtoMessage()    {}
+func (*MsgCancelLendingResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2c35d96b13ec9475, []int{31}
+}
+func (m *MsgCancelLendingResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgCancelLendingResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgCancelLendingResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return EntriesList{}
+}
+func (m *MsgCancelLendingResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgCancelLendingResponse.Merge(m, src)
+}
+func (m *MsgCancelLendingResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgCancelLendingResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgCancelLendingResponse.DiscardUnknown(m)
 }
 
-func (m *MsgCreateRecipe) GetOutputs() []WeightedOutputs {
-	if m != nil {
-		return m.Outputs
+var xxx_messageInfo_MsgCancelLendingResponse proto.InternalMessageInfo
+
+type MsgCreateAuction struct {
+	Creator      string     `protobuf:"bytes,1,opt,name=creator,proto3" json:"creator,omitempty"`
+	Items        []ItemRef  `protobuf:"bytes,2,rep,name=items,proto3" json:"items"`
+	ReservePrice types.Coin `protobuf:"bytes,3,opt,name=reserve_price,json=reservePrice,proto3" json:"reserve_price"`
+	EndTime      int64      `protobuf:"varint,4,opt,name=end_time,json=endTime,proto3" json:"end_time,omitempty"`
+}
+
+func (m *MsgCreateAuction) Reset()         { *m = MsgCreateAuction{} }
+func (m *MsgCreateAuction) String() string { return proto.CompactTextString(m) }
+func (*MsgCreateAuction) ProtoMessage()    {}
+func (*MsgCreateAuction) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2c35d96b13ec9475, []int{32}
+}
+func (m *MsgCreateAuction) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgCreateAuction) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgCreateAuction.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return nil
+}
+func (m *MsgCreateAuction) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgCreateAuction.Merge(m, src)
+}
+func (m *MsgCreateAuction) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgCreateAuction) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgCreateAuction.DiscardUnknown(m)
 }
 
-func (m *MsgCreateRecipe) GetBlockInterval() int64 {
+var xxx_messageInfo_MsgCreateAuction proto.InternalMessageInfo
+
+func (m *MsgCreateAuction) GetCreator() string {
 	if m != nil {
-		return m.BlockInterval
+		return m.Creator
 	}
-	return 0
+	return ""
 }
 
-func (m *MsgCreateRecipe) GetCostPerBlock() types.Coin {
+func (m *MsgCreateAuction) GetItems() []ItemRef {
 	if m != nil {
-		return m.CostPerBlock
+		return m.Items
 	}
-	return types.Coin{}
+	return nil
 }
 
-func (m *MsgCreateRecipe) GetEnabled() bool {
+func (m *MsgCreateAuction) GetReservePrice() types.Coin {
 	if m != nil {
-		return m.Enabled
+		return m.ReservePrice
 	}
-	return false
+	return types.Coin{}
 }
 
-func (m *MsgCreateRecipe) GetExtraInfo() string {
+func (m *MsgCreateAuction) GetEndTime() int64 {
 	if m != nil {
-		return m.ExtraInfo
+		return m.EndTime
 	}
-	return ""
+	return 0
 }
 
-type MsgCreateRecipeResponse struct {
+type MsgCreateAuctionResponse struct {
+	Id uint64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
 }
 
-func (m *MsgCreateRecipeResponse) Reset()         { *m = MsgCreateRecipeResponse{} }
-func (m *MsgCreateRecipeResponse) String() string { return proto.CompactTextString(m) }
-func (*MsgCreateRecipeResponse) ProtoMessage()    {}
-func (*MsgCreateRecipeResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2c35d96b13ec9475, []int{29}
+func (m *MsgCreateAuctionResponse) Reset()         { *m = MsgCreateAuctionResponse{} }
+func (m *MsgCreateAuctionResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgCreateAuctionResponse) ProtoMessage()    {}
+func (*MsgCreateAuctionResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2c35d96b13ec9475, []int{33}
 }
-func (m *MsgCreateRecipeResponse) XXX_Unmarshal(b []byte) error {
+func (m *MsgCreateAuctionResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *MsgCreateRecipeResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *MsgCreateAuctionResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_MsgCreateRecipeResponse.Marshal(b, m, deterministic)
+		return xxx_messageInfo_MsgCreateAuctionResponse.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -1645,47 +1756,43 @@ func (m *MsgCreateRecipeResponse) XXX_Marshal(b []byte, deterministic bool) ([]b
 		return b[:n], nil
 	}
 }
-func (m *MsgCreateRecipeResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_MsgCreateRecipeResponse.Merge(m, src)
+func (m *MsgCreateAuctionResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgCreateAuctionResponse.Merge(m, src)
 }
-func (m *MsgCreateRecipeResponse) XXX_Size() int {
+func (m *MsgCreateAuctionResponse) XXX_Size() int {
 	return m.Size()
 }
-func (m *MsgCreateRecipeResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_MsgCreateRecipeResponse.DiscardUnknown(m)
+func (m *MsgCreateAuctionResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgCreateAuctionResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_MsgCreateRecipeResponse proto.InternalMessageInfo
+var xxx_messageInfo_MsgCreateAuctionResponse proto.InternalMessageInfo
 
-type MsgUpdateRecipe struct {
-	Creator       string            `protobuf:"bytes,1,opt,name=creator,proto3" json:"creator,omitempty"`
-	CookbookId    string            `protobuf:"bytes,2,opt,name=cookbook_id,json=cookbookId,proto3" json:"cookbook_id,omitempty"`
-	Id            string            `protobuf:"bytes,3,opt,name=id,proto3" json:"id,omitempty"`
-	Name          string            `protobuf:"bytes,4,opt,name=name,proto3" json:"name,omitempty"`
-	Description   string            `protobuf:"bytes,5,opt,name=description,proto3" json:"description,omitempty"`
-	Version       string            `protobuf:"bytes,6,opt,name=version,proto3" json:"version,omitempty"`
-	CoinInputs    []CoinInput       `protobuf:"bytes,7,rep,name=coin_inputs,json=coinInputs,proto3" json:"coin_inputs"`
-	ItemInputs    []ItemInput       `protobuf:"bytes,8,rep,name=item_inputs,json=itemInputs,proto3" json:"item_inputs"`
-	Entries       EntriesList       `protobuf:"bytes,9,opt,name=entries,proto3" json:"entries"`
-	Outputs       []WeightedOutputs `protobuf:"bytes,10,rep,name=outputs,proto3" json:"outputs"`
-	BlockInterval int64             `protobuf:"varint,11,opt,name=block_interval,json=blockInterval,proto3" json:"block_interval,omitempty"`
-	CostPerBlock  types.Coin        `protobuf:"bytes,12,opt,name=cost_per_block,json=costPerBlock,proto3" json:"cost_per_block"`
-	Enabled       bool              `protobuf:"varint,13,opt,name=enabled,proto3" json:"enabled,omitempty"`
-	ExtraInfo     string            `protobuf:"bytes,14,opt,name=extra_info,json=extraInfo,proto3" json:"extra_info,omitempty"`
+func (m *MsgCreateAuctionResponse) GetId() uint64 {
+	if m != nil {
+		return m.Id
+	}
+	return 0
 }
 
-func (m *MsgUpdateRecipe) Reset()         { *m = MsgUpdateRecipe{} }
-func (m *MsgUpdateRecipe) String() string { return proto.CompactTextString(m) }
-func (*MsgUpdateRecipe) ProtoMessage()    {}
-func (*MsgUpdateRecipe) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2c35d96b13ec9475, []int{30}
+type MsgPlaceBid struct {
+	Creator string     `protobuf:"bytes,1,opt,name=creator,proto3" json:"creator,omitempty"`
+	Id      uint64     `protobuf:"varint,2,opt,name=id,proto3" json:"id,omitempty"`
+	Amount  types.Coin `protobuf:"bytes,3,opt,name=amount,proto3" json:"amount"`
 }
-func (m *MsgUpdateRecipe) XXX_Unmarshal(b []byte) error {
+
+func (m *MsgPlaceBid) Reset()         { *m = MsgPlaceBid{} }
+func (m *MsgPlaceBid) String() string { return proto.CompactTextString(m) }
+func (*MsgPlaceBid) ProtoMessage()    {}
+func (*MsgPlaceBid) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2c35d96b13ec9475, []int{34}
+}
+func (m *MsgPlaceBid) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *MsgUpdateRecipe) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *MsgPlaceBid) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_MsgUpdateRecipe.Marshal(b, m, deterministic)
+		return xxx_messageInfo_MsgPlaceBid.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -1695,131 +1802,142 @@ func (m *MsgUpdateRecipe) XXX_Marshal(b []byte, deterministic bool) ([]byte, err
 		return b[:n], nil
 	}
 }
-func (m *MsgUpdateRecipe) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_MsgUpdateRecipe.Merge(m, src)
+func (m *MsgPlaceBid) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgPlaceBid.Merge(m, src)
 }
-func (m *MsgUpdateRecipe) XXX_Size() int {
+func (m *MsgPlaceBid) XXX_Size() int {
 	return m.Size()
 }
-func (m *MsgUpdateRecipe) XXX_DiscardUnknown() {
-	xxx_messageInfo_MsgUpdateRecipe.DiscardUnknown(m)
+func (m *MsgPlaceBid) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgPlaceBid.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_MsgUpdateRecipe proto.InternalMessageInfo
+var xxx_messageInfo_MsgPlaceBid proto.InternalMessageInfo
 
-func (m *MsgUpdateRecipe) GetCreator() string {
+func (m *MsgPlaceBid) GetCreator() string {
 	if m != nil {
 		return m.Creator
 	}
 	return ""
 }
 
-func (m *MsgUpdateRecipe) GetCookbookId() string {
-	if m != nil {
-		return m.CookbookId
-	}
-	return ""
-}
-
-func (m *MsgUpdateRecipe) GetId() string {
+func (m *MsgPlaceBid) GetId() uint64 {
 	if m != nil {
 		return m.Id
 	}
-	return ""
+	return 0
 }
 
-func (m *MsgUpdateRecipe) GetName() string {
+func (m *MsgPlaceBid) GetAmount() types.Coin {
 	if m != nil {
-		return m.Name
+		return m.Amount
 	}
-	return ""
+	return types.Coin{}
 }
 
-func (m *MsgUpdateRecipe) GetDescription() string {
-	if m != nil {
-		return m.Description
-	}
-	return ""
+type MsgPlaceBidResponse struct {
 }
 
-func (m *MsgUpdateRecipe) GetVersion() string {
-	if m != nil {
-		return m.Version
-	}
-	return ""
+func (m *MsgPlaceBidResponse) Reset()         { *m = MsgPlaceBidResponse{} }
+func (m *MsgPlaceBidResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgPlaceBidResponse) ProtoMessage()    {}
+func (*MsgPlaceBidResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2c35d96b13ec9475, []int{35}
 }
-
-func (m *MsgUpdateRecipe) GetCoinInputs() []CoinInput {
-	if m != nil {
-		return m.CoinInputs
-	}
-	return nil
+func (m *MsgPlaceBidResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-
-func (m *MsgUpdateRecipe) GetItemInputs() []ItemInput {
-	if m != nil {
-		return m.ItemInputs
+func (m *MsgPlaceBidResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgPlaceBidResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return nil
 }
-
-func (m *MsgUpdateRecipe) GetEntries() EntriesList {
-	if m != nil {
-		return m.Entries
-	}
-	return EntriesList{}
+func (m *MsgPlaceBidResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgPlaceBidResponse.Merge(m, src)
 }
-
-func (m *MsgUpdateRecipe) GetOutputs() []WeightedOutputs {
-	if m != nil {
-		return m.Outputs
-	}
-	return nil
+func (m *MsgPlaceBidResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgPlaceBidResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgPlaceBidResponse.DiscardUnknown(m)
 }
 
-func (m *MsgUpdateRecipe) GetBlockInterval() int64 {
-	if m != nil {
-		return m.BlockInterval
-	}
-	return 0
+var xxx_messageInfo_MsgPlaceBidResponse proto.InternalMessageInfo
+
+type MsgCancelAuction struct {
+	Creator string `protobuf:"bytes,1,opt,name=creator,proto3" json:"creator,omitempty"`
+	Id      uint64 `protobuf:"varint,2,opt,name=id,proto3" json:"id,omitempty"`
 }
 
-func (m *MsgUpdateRecipe) GetCostPerBlock() types.Coin {
-	if m != nil {
-		return m.CostPerBlock
+func (m *MsgCancelAuction) Reset()         { *m = MsgCancelAuction{} }
+func (m *MsgCancelAuction) String() string { return proto.CompactTextString(m) }
+func (*MsgCancelAuction) ProtoMessage()    {}
+func (*MsgCancelAuction) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2c35d96b13ec9475, []int{36}
+}
+func (m *MsgCancelAuction) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgCancelAuction) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgCancelAuction.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return types.Coin{}
+}
+func (m *MsgCancelAuction) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgCancelAuction.Merge(m, src)
+}
+func (m *MsgCancelAuction) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgCancelAuction) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgCancelAuction.DiscardUnknown(m)
 }
 
-func (m *MsgUpdateRecipe) GetEnabled() bool {
+var xxx_messageInfo_MsgCancelAuction proto.InternalMessageInfo
+
+func (m *MsgCancelAuction) GetCreator() string {
 	if m != nil {
-		return m.Enabled
+		return m.Creator
 	}
-	return false
+	return ""
 }
 
-func (m *MsgUpdateRecipe) GetExtraInfo() string {
+func (m *MsgCancelAuction) GetId() uint64 {
 	if m != nil {
-		return m.ExtraInfo
+		return m.Id
 	}
-	return ""
+	return 0
 }
 
-type MsgUpdateRecipeResponse struct {
+type MsgCancelAuctionResponse struct {
 }
 
-func (m *MsgUpdateRecipeResponse) Reset()         { *m = MsgUpdateRecipeResponse{} }
-func (m *MsgUpdateRecipeResponse) String() string { return proto.CompactTextString(m) }
-func (*MsgUpdateRecipeResponse) ProtoMessage()    {}
-func (*MsgUpdateRecipeResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2c35d96b13ec9475, []int{31}
+func (m *MsgCancelAuctionResponse) Reset()         { *m = MsgCancelAuctionResponse{} }
+func (m *MsgCancelAuctionResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgCancelAuctionResponse) ProtoMessage()    {}
+func (*MsgCancelAuctionResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2c35d96b13ec9475, []int{37}
 }
-func (m *MsgUpdateRecipeResponse) XXX_Unmarshal(b []byte) error {
+func (m *MsgCancelAuctionResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *MsgUpdateRecipeResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *MsgCancelAuctionResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_MsgUpdateRecipeResponse.Marshal(b, m, deterministic)
+		return xxx_messageInfo_MsgCancelAuctionResponse.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -1829,41 +1947,40 @@ func (m *MsgUpdateRecipeResponse) XXX_Marshal(b []byte, deterministic bool) ([]b
 		return b[:n], nil
 	}
 }
-func (m *MsgUpdateRecipeResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_MsgUpdateRecipeResponse.Merge(m, src)
+func (m *MsgCancelAuctionResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgCancelAuctionResponse.Merge(m, src)
 }
-func (m *MsgUpdateRecipeResponse) XXX_Size() int {
+func (m *MsgCancelAuctionResponse) XXX_Size() int {
 	return m.Size()
 }
-func (m *MsgUpdateRecipeResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_MsgUpdateRecipeResponse.DiscardUnknown(m)
+func (m *MsgCancelAuctionResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgCancelAuctionResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_MsgUpdateRecipeResponse proto.InternalMessageInfo
+var xxx_messageInfo_MsgCancelAuctionResponse proto.InternalMessageInfo
 
-type MsgCreateCookbook struct {
-	Creator      string `protobuf:"bytes,1,opt,name=creator,proto3" json:"creator,omitempty"`
-	Id           string `protobuf:"bytes,2,opt,name=id,proto3" json:"id,omitempty"`
-	Name         string `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`
-	Description  string `protobuf:"bytes,4,opt,name=description,proto3" json:"description,omitempty"`
-	Developer    string `protobuf:"bytes,5,opt,name=developer,proto3" json:"developer,omitempty"`
-	Version      string `protobuf:"bytes,6,opt,name=version,proto3" json:"version,omitempty"`
-	SupportEmail string `protobuf:"bytes,7,opt,name=support_email,json=supportEmail,proto3" json:"support_email,omitempty"`
-	Enabled      bool   `protobuf:"varint,8,opt,name=enabled,proto3" json:"enabled,omitempty"`
+type MsgCreateDutchAuction struct {
+	Creator     string     `protobuf:"bytes,1,opt,name=creator,proto3" json:"creator,omitempty"`
+	Items       []ItemRef  `protobuf:"bytes,2,rep,name=items,proto3" json:"items"`
+	StartPrice  types.Coin `protobuf:"bytes,3,opt,name=start_price,json=startPrice,proto3" json:"start_price"`
+	FloorPrice  types.Coin `protobuf:"bytes,4,opt,name=floor_price,json=floorPrice,proto3" json:"floor_price"`
+	StartHeight int64      `protobuf:"varint,5,opt,name=start_height,json=startHeight,proto3" json:"start_height,omitempty"`
+	EndHeight   int64      `protobuf:"varint,6,opt,name=end_height,json=endHeight,proto3" json:"end_height,omitempty"`
+	Decay       string     `protobuf:"bytes,7,opt,name=decay,proto3" json:"decay,omitempty"`
 }
 
-func (m *MsgCreateCookbook) Reset()         { *m = MsgCreateCookbook{} }
-func (m *MsgCreateCookbook) String() string { return proto.CompactTextString(m) }
-func (*MsgCreateCookbook) ProtoMessage()    {}
-func (*MsgCreateCookbook) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2c35d96b13ec9475, []int{32}
+func (m *MsgCreateDutchAuction) Reset()         { *m = MsgCreateDutchAuction{} }
+func (m *MsgCreateDutchAuction) String() string { return proto.CompactTextString(m) }
+func (*MsgCreateDutchAuction) ProtoMessage()    {}
+func (*MsgCreateDutchAuction) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2c35d96b13ec9475, []int{38}
 }
-func (m *MsgCreateCookbook) XXX_Unmarshal(b []byte) error {
+func (m *MsgCreateDutchAuction) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *MsgCreateCookbook) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *MsgCreateDutchAuction) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_MsgCreateCookbook.Marshal(b, m, deterministic)
+		return xxx_messageInfo_MsgCreateDutchAuction.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -1873,89 +1990,83 @@ func (m *MsgCreateCookbook) XXX_Marshal(b []byte, deterministic bool) ([]byte, e
 		return b[:n], nil
 	}
 }
-func (m *MsgCreateCookbook) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_MsgCreateCookbook.Merge(m, src)
+func (m *MsgCreateDutchAuction) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgCreateDutchAuction.Merge(m, src)
 }
-func (m *MsgCreateCookbook) XXX_Size() int {
+func (m *MsgCreateDutchAuction) XXX_Size() int {
 	return m.Size()
 }
-func (m *MsgCreateCookbook) XXX_DiscardUnknown() {
-	xxx_messageInfo_MsgCreateCookbook.DiscardUnknown(m)
+func (m *MsgCreateDutchAuction) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgCreateDutchAuction.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_MsgCreateCookbook proto.InternalMessageInfo
+var xxx_messageInfo_MsgCreateDutchAuction proto.InternalMessageInfo
 
-func (m *MsgCreateCookbook) GetCreator() string {
+func (m *MsgCreateDutchAuction) GetCreator() string {
 	if m != nil {
 		return m.Creator
 	}
 	return ""
 }
 
-func (m *MsgCreateCookbook) GetId() string {
+func (m *MsgCreateDutchAuction) GetItems() []ItemRef {
 	if m != nil {
-		return m.Id
+		return m.Items
 	}
-	return ""
+	return nil
 }
 
-func (m *MsgCreateCookbook) GetName() string {
+func (m *MsgCreateDutchAuction) GetStartPrice() types.Coin {
 	if m != nil {
-		return m.Name
+		return m.StartPrice
 	}
-	return ""
+	return types.Coin{}
 }
 
-func (m *MsgCreateCookbook) GetDescription() string {
+func (m *MsgCreateDutchAuction) GetFloorPrice() types.Coin {
 	if m != nil {
-		return m.Description
+		return m.FloorPrice
 	}
-	return ""
+	return types.Coin{}
 }
 
-func (m *MsgCreateCookbook) GetDeveloper() string {
+func (m *MsgCreateDutchAuction) GetStartHeight() int64 {
 	if m != nil {
-		return m.Developer
+		return m.StartHeight
 	}
-	return ""
+	return 0
 }
 
-func (m *MsgCreateCookbook) GetVersion() string {
+func (m *MsgCreateDutchAuction) GetEndHeight() int64 {
 	if m != nil {
-		return m.Version
+		return m.EndHeight
 	}
-	return ""
+	return 0
 }
 
-func (m *MsgCreateCookbook) GetSupportEmail() string {
+func (m *MsgCreateDutchAuction) GetDecay() string {
 	if m != nil {
-		return m.SupportEmail
+		return m.Decay
 	}
 	return ""
 }
 
-func (m *MsgCreateCookbook) GetEnabled() bool {
-	if m != nil {
-		return m.Enabled
-	}
-	return false
-}
-
-type MsgCreateCookbookResponse struct {
+type MsgCreateDutchAuctionResponse struct {
+	Id uint64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
 }
 
-func (m *MsgCreateCookbookResponse) Reset()         { *m = MsgCreateCookbookResponse{} }
-func (m *MsgCreateCookbookResponse) String() string { return proto.CompactTextString(m) }
-func (*MsgCreateCookbookResponse) ProtoMessage()    {}
-func (*MsgCreateCookbookResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2c35d96b13ec9475, []int{33}
+func (m *MsgCreateDutchAuctionResponse) Reset()         { *m = MsgCreateDutchAuctionResponse{} }
+func (m *MsgCreateDutchAuctionResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgCreateDutchAuctionResponse) ProtoMessage()    {}
+func (*MsgCreateDutchAuctionResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2c35d96b13ec9475, []int{39}
 }
-func (m *MsgCreateCookbookResponse) XXX_Unmarshal(b []byte) error {
+func (m *MsgCreateDutchAuctionResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *MsgCreateCookbookResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *MsgCreateDutchAuctionResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_MsgCreateCookbookResponse.Marshal(b, m, deterministic)
+		return xxx_messageInfo_MsgCreateDutchAuctionResponse.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -1965,41 +2076,44 @@ func (m *MsgCreateCookbookResponse) XXX_Marshal(b []byte, deterministic bool) ([
 		return b[:n], nil
 	}
 }
-func (m *MsgCreateCookbookResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_MsgCreateCookbookResponse.Merge(m, src)
+func (m *MsgCreateDutchAuctionResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgCreateDutchAuctionResponse.Merge(m, src)
 }
-func (m *MsgCreateCookbookResponse) XXX_Size() int {
+func (m *MsgCreateDutchAuctionResponse) XXX_Size() int {
 	return m.Size()
 }
-func (m *MsgCreateCookbookResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_MsgCreateCookbookResponse.DiscardUnknown(m)
+func (m *MsgCreateDutchAuctionResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgCreateDutchAuctionResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_MsgCreateCookbookResponse proto.InternalMessageInfo
+var xxx_messageInfo_MsgCreateDutchAuctionResponse proto.InternalMessageInfo
 
-type MsgUpdateCookbook struct {
-	Creator      string `protobuf:"bytes,1,opt,name=creator,proto3" json:"creator,omitempty"`
-	Id           string `protobuf:"bytes,2,opt,name=id,proto3" json:"id,omitempty"`
-	Name         string `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`
-	Description  string `protobuf:"bytes,4,opt,name=description,proto3" json:"description,omitempty"`
-	Developer    string `protobuf:"bytes,5,opt,name=developer,proto3" json:"developer,omitempty"`
-	Version      string `protobuf:"bytes,6,opt,name=version,proto3" json:"version,omitempty"`
-	SupportEmail string `protobuf:"bytes,7,opt,name=support_email,json=supportEmail,proto3" json:"support_email,omitempty"`
-	Enabled      bool   `protobuf:"varint,8,opt,name=enabled,proto3" json:"enabled,omitempty"`
+func (m *MsgCreateDutchAuctionResponse) GetId() uint64 {
+	if m != nil {
+		return m.Id
+	}
+	return 0
 }
 
-func (m *MsgUpdateCookbook) Reset()         { *m = MsgUpdateCookbook{} }
-func (m *MsgUpdateCookbook) String() string { return proto.CompactTextString(m) }
-func (*MsgUpdateCookbook) ProtoMessage()    {}
-func (*MsgUpdateCookbook) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2c35d96b13ec9475, []int{34}
+type MsgBuyDutchAuction struct {
+	Creator string `protobuf:"bytes,1,opt,name=creator,proto3" json:"creator,omitempty"`
+	Id      uint64 `protobuf:"varint,2,opt,name=id,proto3" json:"id,omitempty"`
+	// highest price the buyer accepts to pay
+	MaxPrice types.Coin `protobuf:"bytes,3,opt,name=max_price,json=maxPrice,proto3" json:"max_price"`
 }
-func (m *MsgUpdateCookbook) XXX_Unmarshal(b []byte) error {
+
+func (m *MsgBuyDutchAuction) Reset()         { *m = MsgBuyDutchAuction{} }
+func (m *MsgBuyDutchAuction) String() string { return proto.CompactTextString(m) }
+func (*MsgBuyDutchAuction) ProtoMessage()    {}
+func (*MsgBuyDutchAuction) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2c35d96b13ec9475, []int{40}
+}
+func (m *MsgBuyDutchAuction) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *MsgUpdateCookbook) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *MsgBuyDutchAuction) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_MsgUpdateCookbook.Marshal(b, m, deterministic)
+		return xxx_messageInfo_MsgBuyDutchAuction.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -2009,89 +2123,150 @@ func (m *MsgUpdateCookbook) XXX_Marshal(b []byte, deterministic bool) ([]byte, e
 		return b[:n], nil
 	}
 }
-func (m *MsgUpdateCookbook) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_MsgUpdateCookbook.Merge(m, src)
+func (m *MsgBuyDutchAuction) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgBuyDutchAuction.Merge(m, src)
 }
-func (m *MsgUpdateCookbook) XXX_Size() int {
+func (m *MsgBuyDutchAuction) XXX_Size() int {
 	return m.Size()
 }
-func (m *MsgUpdateCookbook) XXX_DiscardUnknown() {
-	xxx_messageInfo_MsgUpdateCookbook.DiscardUnknown(m)
+func (m *MsgBuyDutchAuction) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgBuyDutchAuction.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_MsgUpdateCookbook proto.InternalMessageInfo
+var xxx_messageInfo_MsgBuyDutchAuction proto.InternalMessageInfo
 
-func (m *MsgUpdateCookbook) GetCreator() string {
+func (m *MsgBuyDutchAuction) GetCreator() string {
 	if m != nil {
 		return m.Creator
 	}
 	return ""
 }
 
-func (m *MsgUpdateCookbook) GetId() string {
+func (m *MsgBuyDutchAuction) GetId() uint64 {
 	if m != nil {
 		return m.Id
 	}
-	return ""
+	return 0
 }
 
-func (m *MsgUpdateCookbook) GetName() string {
+func (m *MsgBuyDutchAuction) GetMaxPrice() types.Coin {
 	if m != nil {
-		return m.Name
+		return m.MaxPrice
 	}
-	return ""
+	return types.Coin{}
 }
 
-func (m *MsgUpdateCookbook) GetDescription() string {
-	if m != nil {
-		return m.Description
+type MsgBuyDutchAuctionResponse struct {
+	Price types.Coin `protobuf:"bytes,1,opt,name=price,proto3" json:"price"`
+}
+
+func (m *MsgBuyDutchAuctionResponse) Reset()         { *m = MsgBuyDutchAuctionResponse{} }
+func (m *MsgBuyDutchAuctionResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgBuyDutchAuctionResponse) ProtoMessage()    {}
+func (*MsgBuyDutchAuctionResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2c35d96b13ec9475, []int{41}
+}
+func (m *MsgBuyDutchAuctionResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgBuyDutchAuctionResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgBuyDutchAuctionResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return ""
+}
+func (m *MsgBuyDutchAuctionResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgBuyDutchAuctionResponse.Merge(m, src)
+}
+func (m *MsgBuyDutchAuctionResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgBuyDutchAuctionResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgBuyDutchAuctionResponse.DiscardUnknown(m)
 }
 
-func (m *MsgUpdateCookbook) GetDeveloper() string {
+var xxx_messageInfo_MsgBuyDutchAuctionResponse proto.InternalMessageInfo
+
+func (m *MsgBuyDutchAuctionResponse) GetPrice() types.Coin {
 	if m != nil {
-		return m.Developer
+		return m.Price
 	}
-	return ""
+	return types.Coin{}
 }
 
-func (m *MsgUpdateCookbook) GetVersion() string {
-	if m != nil {
-		return m.Version
+type MsgCancelDutchAuction struct {
+	Creator string `protobuf:"bytes,1,opt,name=creator,proto3" json:"creator,omitempty"`
+	Id      uint64 `protobuf:"varint,2,opt,name=id,proto3" json:"id,omitempty"`
+}
+
+func (m *MsgCancelDutchAuction) Reset()         { *m = MsgCancelDutchAuction{} }
+func (m *MsgCancelDutchAuction) String() string { return proto.CompactTextString(m) }
+func (*MsgCancelDutchAuction) ProtoMessage()    {}
+func (*MsgCancelDutchAuction) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2c35d96b13ec9475, []int{42}
+}
+func (m *MsgCancelDutchAuction) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgCancelDutchAuction) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgCancelDutchAuction.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return ""
+}
+func (m *MsgCancelDutchAuction) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgCancelDutchAuction.Merge(m, src)
+}
+func (m *MsgCancelDutchAuction) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgCancelDutchAuction) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgCancelDutchAuction.DiscardUnknown(m)
 }
 
-func (m *MsgUpdateCookbook) GetSupportEmail() string {
+var xxx_messageInfo_MsgCancelDutchAuction proto.InternalMessageInfo
+
+func (m *MsgCancelDutchAuction) GetCreator() string {
 	if m != nil {
-		return m.SupportEmail
+		return m.Creator
 	}
 	return ""
 }
 
-func (m *MsgUpdateCookbook) GetEnabled() bool {
+func (m *MsgCancelDutchAuction) GetId() uint64 {
 	if m != nil {
-		return m.Enabled
+		return m.Id
 	}
-	return false
+	return 0
 }
 
-type MsgUpdateCookbookResponse struct {
+type MsgCancelDutchAuctionResponse struct {
 }
 
-func (m *MsgUpdateCookbookResponse) Reset()         { *m = MsgUpdateCookbookResponse{} }
-func (m *MsgUpdateCookbookResponse) String() string { return proto.CompactTextString(m) }
-func (*MsgUpdateCookbookResponse) ProtoMessage()    {}
-func (*MsgUpdateCookbookResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2c35d96b13ec9475, []int{35}
+func (m *MsgCancelDutchAuctionResponse) Reset()         { *m = MsgCancelDutchAuctionResponse{} }
+func (m *MsgCancelDutchAuctionResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgCancelDutchAuctionResponse) ProtoMessage()    {}
+func (*MsgCancelDutchAuctionResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2c35d96b13ec9475, []int{43}
 }
-func (m *MsgUpdateCookbookResponse) XXX_Unmarshal(b []byte) error {
+func (m *MsgCancelDutchAuctionResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *MsgUpdateCookbookResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *MsgCancelDutchAuctionResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_MsgUpdateCookbookResponse.Marshal(b, m, deterministic)
+		return xxx_messageInfo_MsgCancelDutchAuctionResponse.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -2101,3236 +2276,14168 @@ func (m *MsgUpdateCookbookResponse) XXX_Marshal(b []byte, deterministic bool) ([
 		return b[:n], nil
 	}
 }
-func (m *MsgUpdateCookbookResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_MsgUpdateCookbookResponse.Merge(m, src)
+func (m *MsgCancelDutchAuctionResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgCancelDutchAuctionResponse.Merge(m, src)
 }
-func (m *MsgUpdateCookbookResponse) XXX_Size() int {
+func (m *MsgCancelDutchAuctionResponse) XXX_Size() int {
 	return m.Size()
 }
-func (m *MsgUpdateCookbookResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_MsgUpdateCookbookResponse.DiscardUnknown(m)
+func (m *MsgCancelDutchAuctionResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgCancelDutchAuctionResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_MsgUpdateCookbookResponse proto.InternalMessageInfo
+var xxx_messageInfo_MsgCancelDutchAuctionResponse proto.InternalMessageInfo
 
-func init() {
-	proto.RegisterType((*MsgAppleIap)(nil), "pylons.pylons.MsgAppleIap")
-	proto.RegisterType((*MsgAppleIapResponse)(nil), "pylons.pylons.MsgAppleIapResponse")
-	proto.RegisterType((*MsgAddStripeRefund)(nil), "pylons.pylons.MsgAddStripeRefund")
-	proto.RegisterType((*MsgAddStripeRefundResponse)(nil), "pylons.pylons.MsgAddStripeRefundResponse")
-	proto.RegisterType((*MsgBurnDebtToken)(nil), "pylons.pylons.MsgBurnDebtToken")
-	proto.RegisterType((*MsgBurnDebtTokenResponse)(nil), "pylons.pylons.MsgBurnDebtTokenResponse")
-	proto.RegisterType((*MsgUpdateAccount)(nil), "pylons.pylons.MsgUpdateAccount")
-	proto.RegisterType((*MsgUpdateAccountResponse)(nil), "pylons.pylons.MsgUpdateAccountResponse")
-	proto.RegisterType((*MsgCreateAccount)(nil), "pylons.pylons.MsgCreateAccount")
-	proto.RegisterType((*MsgCreateAccountResponse)(nil), "pylons.pylons.MsgCreateAccountResponse")
-	proto.RegisterType((*MsgFulfillTrade)(nil), "pylons.pylons.MsgFulfillTrade")
-	proto.RegisterType((*MsgFulfillTradeResponse)(nil), "pylons.pylons.MsgFulfillTradeResponse")
-	proto.RegisterType((*MsgCreateTrade)(nil), "pylons.pylons.MsgCreateTrade")
-	proto.RegisterType((*MsgCreateTradeResponse)(nil), "pylons.pylons.MsgCreateTradeResponse")
-	proto.RegisterType((*MsgCancelTrade)(nil), "pylons.pylons.MsgCancelTrade")
-	proto.RegisterType((*MsgCancelTradeResponse)(nil), "pylons.pylons.MsgCancelTradeResponse")
-	proto.RegisterType((*MsgCompleteExecutionEarly)(nil), "pylons.pylons.MsgCompleteExecutionEarly")
-	proto.RegisterType((*MsgCompleteExecutionEarlyResponse)(nil), "pylons.pylons.MsgCompleteExecutionEarlyResponse")
-	proto.RegisterType((*MsgTransferCookbook)(nil), "pylons.pylons.MsgTransferCookbook")
-	proto.RegisterType((*MsgTransferCookbookResponse)(nil), "pylons.pylons.MsgTransferCookbookResponse")
-	proto.RegisterType((*MsgGoogleInAppPurchaseGetCoins)(nil), "pylons.pylons.MsgGoogleInAppPurchaseGetCoins")
-	proto.RegisterType((*MsgGoogleInAppPurchaseGetCoinsResponse)(nil), "pylons.pylons.MsgGoogleInAppPurchaseGetCoinsResponse")
-	proto.RegisterType((*MsgSendItems)(nil), "pylons.pylons.MsgSendItems")
-	proto.RegisterType((*MsgSendItemsResponse)(nil), "pylons.pylons.MsgSendItemsResponse")
-	proto.RegisterType((*MsgExecuteRecipe)(nil), "pylons.pylons.MsgExecuteRecipe")
-	proto.RegisterType((*MsgExecuteRecipeResponse)(nil), "pylons.pylons.MsgExecuteRecipeResponse")
-	proto.RegisterType((*MsgSetItemString)(nil), "pylons.pylons.MsgSetItemString")
-	proto.RegisterType((*MsgSetItemStringResponse)(nil), "pylons.pylons.MsgSetItemStringResponse")
-	proto.RegisterType((*MsgCreateRecipe)(nil), "pylons.pylons.MsgCreateRecipe")
-	proto.RegisterType((*MsgCreateRecipeResponse)(nil), "pylons.pylons.MsgCreateRecipeResponse")
-	proto.RegisterType((*MsgUpdateRecipe)(nil), "pylons.pylons.MsgUpdateRecipe")
-	proto.RegisterType((*MsgUpdateRecipeResponse)(nil), "pylons.pylons.MsgUpdateRecipeResponse")
-	proto.RegisterType((*MsgCreateCookbook)(nil), "pylons.pylons.MsgCreateCookbook")
-	proto.RegisterType((*MsgCreateCookbookResponse)(nil), "pylons.pylons.MsgCreateCookbookResponse")
-	proto.RegisterType((*MsgUpdateCookbook)(nil), "pylons.pylons.MsgUpdateCookbook")
-	proto.RegisterType((*MsgUpdateCookbookResponse)(nil), "pylons.pylons.MsgUpdateCookbookResponse")
+type MsgCreateItemOffer struct {
+	Creator         string                                   `protobuf:"bytes,1,opt,name=creator,proto3" json:"creator,omitempty"`
+	CookbookId      string                                   `protobuf:"bytes,2,opt,name=cookbook_id,json=cookbookId,proto3" json:"cookbook_id,omitempty"`
+	ItemId          string                                   `protobuf:"bytes,3,opt,name=item_id,json=itemId,proto3" json:"item_id,omitempty"`
+	ItemInput       ItemInput                                `protobuf:"bytes,4,opt,name=item_input,json=itemInput,proto3" json:"item_input"`
+	Price           github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,5,rep,name=price,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"price"`
+	ExpiresAtHeight int64                                    `protobuf:"varint,6,opt,name=expires_at_height,json=expiresAtHeight,proto3" json:"expires_at_height,omitempty"`
+	ExpiresAt       int64                                    `protobuf:"varint,7,opt,name=expires_at,json=expiresAt,proto3" json:"expires_at,omitempty"`
 }
 
-func init() { proto.RegisterFile("pylons/pylons/tx.proto", fileDescriptor_2c35d96b13ec9475) }
-
-var fileDescriptor_2c35d96b13ec9475 = []byte{
-	// 1664 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xec, 0x59, 0xcf, 0x73, 0xdb, 0xc4,
-	0x17, 0x8f, 0x63, 0xa7, 0x89, 0x9f, 0xe3, 0xa4, 0x55, 0xf3, 0xcd, 0x57, 0x51, 0x12, 0x3b, 0x75,
-	0x69, 0x71, 0x3b, 0xd4, 0x69, 0xd3, 0xc2, 0xa1, 0x07, 0x4a, 0xd2, 0xa6, 0xc5, 0x0c, 0x19, 0x32,
-	0x4e, 0x81, 0x29, 0x03, 0xe3, 0x91, 0xa5, 0x67, 0x47, 0x13, 0x59, 0xd2, 0xac, 0xd6, 0x99, 0xe4,
-	0xcc, 0x89, 0x1b, 0x27, 0xf8, 0x1f, 0xf8, 0x4b, 0x7a, 0x62, 0xca, 0x8d, 0x13, 0x30, 0xe9, 0x8d,
-	0xbf, 0x81, 0x61, 0x98, 0xfd, 0x21, 0x59, 0x92, 0x65, 0x25, 0x69, 0xb8, 0xd1, 0x93, 0xa3, 0xf7,
-	0xf3, 0xf3, 0xf6, 0xbd, 0x7d, 0x6f, 0x77, 0x03, 0x8b, 0xde, 0xb1, 0xed, 0x3a, 0xfe, 0xba, 0xfc,
-	0xa1, 0x47, 0x0d, 0x8f, 0xb8, 0xd4, 0x55, 0xca, 0x82, 0xd0, 0x10, 0x3f, 0xda, 0x42, 0xcf, 0xed,
-	0xb9, 0x9c, 0xb3, 0xce, 0xfe, 0x12, 0x42, 0x5a, 0xc5, 0x70, 0xfd, 0xbe, 0xeb, 0xaf, 0x77, 0x74,
-	0x1f, 0xd7, 0x0f, 0xef, 0x75, 0x90, 0xea, 0xf7, 0xd6, 0x0d, 0xd7, 0x72, 0x24, 0x7f, 0x29, 0x61,
-	0x9c, 0xe8, 0x26, 0x4a, 0xd6, 0x3b, 0x71, 0x56, 0xcf, 0x75, 0x7b, 0x36, 0xb6, 0x2d, 0xdd, 0x6b,
-	0xbb, 0xc4, 0x44, 0x22, 0xa5, 0xd6, 0xe2, 0x52, 0x9e, 0x7e, 0xdc, 0x47, 0x87, 0xb6, 0x2d, 0xa7,
-	0x1b, 0x40, 0xa8, 0xc6, 0x25, 0x08, 0x9a, 0x88, 0xfd, 0xa8, 0xc0, 0x6a, 0x5c, 0x00, 0x8f, 0xd0,
-	0x18, 0x50, 0xcb, 0x0d, 0x20, 0xaa, 0x71, 0xb6, 0x45, 0xb1, 0x2f, 0x39, 0x5a, 0xd2, 0xb2, 0x61,
-	0x79, 0x01, 0xfa, 0x95, 0x38, 0xcf, 0x70, 0xdd, 0x83, 0x8e, 0xeb, 0x1e, 0x08, 0x6e, 0xed, 0xc7,
-	0x1c, 0x94, 0x76, 0xfc, 0xde, 0xa6, 0xe7, 0xd9, 0xd8, 0xd4, 0x3d, 0x45, 0x85, 0x69, 0x83, 0xa0,
-	0x4e, 0x5d, 0xa2, 0xe6, 0xd6, 0x72, 0xf5, 0x62, 0x2b, 0xf8, 0x54, 0x56, 0x01, 0x3c, 0xe2, 0x9a,
-	0x03, 0x83, 0xb6, 0x2d, 0x53, 0x9d, 0xe4, 0xcc, 0xa2, 0xa4, 0x34, 0x4d, 0xa5, 0x0a, 0x25, 0x6f,
-	0x40, 0x8c, 0x7d, 0xdd, 0x47, 0xc6, 0xcf, 0x73, 0x3e, 0x04, 0xa4, 0xa6, 0xa9, 0x34, 0xe0, 0x2a,
-	0x41, 0x03, 0x2d, 0x8f, 0xb6, 0x4d, 0x9d, 0xea, 0x6d, 0x96, 0x88, 0x0f, 0x1e, 0xa8, 0x05, 0x2e,
-	0x78, 0x45, 0xb2, 0x9e, 0xe8, 0x54, 0xdf, 0xe2, 0x8c, 0xda, 0xff, 0xe0, 0x6a, 0x04, 0x58, 0x0b,
-	0x7d, 0xcf, 0x75, 0x7c, 0xac, 0x99, 0xa0, 0x30, 0xb2, 0x69, 0xee, 0x51, 0x62, 0x79, 0xd8, 0xc2,
-	0xee, 0xc0, 0x31, 0x33, 0x60, 0x3f, 0x80, 0x69, 0x99, 0x0a, 0x8e, 0xb9, 0xb4, 0xa1, 0x35, 0x62,
-	0xe5, 0xd2, 0xd8, 0x15, 0xdc, 0xa6, 0xd3, 0x75, 0x5b, 0x81, 0x68, 0x6d, 0x05, 0xb4, 0x51, 0x2f,
-	0x21, 0x06, 0x07, 0x2e, 0xef, 0xf8, 0xbd, 0xad, 0x01, 0x71, 0x9e, 0x60, 0x87, 0x3e, 0x77, 0x0f,
-	0xd0, 0xc9, 0x40, 0xf0, 0x11, 0x94, 0x22, 0xa9, 0x96, 0x28, 0x96, 0x12, 0x28, 0x5a, 0x5c, 0x82,
-	0x81, 0xd8, 0x2a, 0xbc, 0xfc, 0xad, 0x3a, 0xd1, 0x02, 0x12, 0x52, 0x6a, 0x1a, 0xa8, 0x49, 0x7f,
-	0x21, 0x96, 0x8f, 0x39, 0x96, 0xcf, 0x3d, 0x53, 0xa7, 0xb8, 0x69, 0x18, 0xee, 0xc0, 0xa1, 0x19,
-	0x58, 0x34, 0x98, 0x19, 0xf8, 0x48, 0x1c, 0xbd, 0x8f, 0x32, 0x85, 0xe1, 0xb7, 0xf4, 0x12, 0xb3,
-	0x14, 0x7a, 0xf9, 0x2e, 0xc7, 0xdd, 0x3c, 0x66, 0x66, 0x2e, 0xe6, 0x46, 0x59, 0x80, 0x29, 0xca,
-	0x22, 0x90, 0x25, 0x22, 0x3e, 0x94, 0x5b, 0x70, 0x99, 0x60, 0x17, 0x09, 0xd1, 0xed, 0xb6, 0x6e,
-	0x9a, 0x04, 0x7d, 0x5f, 0x96, 0xc6, 0x7c, 0x40, 0xdf, 0x14, 0x64, 0x89, 0x33, 0x06, 0x25, 0xc4,
-	0x79, 0x92, 0x83, 0xf9, 0x1d, 0xbf, 0xf7, 0x74, 0x60, 0x77, 0x2d, 0xdb, 0x7e, 0xce, 0x36, 0x71,
-	0x06, 0xcc, 0x39, 0x98, 0x94, 0xa5, 0x5c, 0x68, 0x4d, 0x5a, 0xa6, 0x72, 0x1b, 0xae, 0xb0, 0x8e,
-	0xd0, 0xb6, 0x1c, 0x6f, 0x40, 0xfd, 0xb6, 0xe5, 0x98, 0x78, 0xc4, 0x61, 0x16, 0x5a, 0xf3, 0x8c,
-	0xd1, 0xe4, 0xf4, 0x26, 0x23, 0x2b, 0x1b, 0x30, 0xc5, 0x36, 0x20, 0x43, 0x99, 0xaf, 0x97, 0x36,
-	0x16, 0x13, 0xf9, 0x6c, 0x52, 0xec, 0xb7, 0xb0, 0x2b, 0x93, 0x29, 0x44, 0x95, 0x6d, 0x28, 0x47,
-	0xdb, 0x82, 0xaf, 0x4e, 0x71, 0xdd, 0x8c, 0x8a, 0x94, 0xfa, 0xb3, 0xde, 0x90, 0xe4, 0xd7, 0x96,
-	0xe0, 0xff, 0x89, 0x18, 0xc3, 0xf8, 0xff, 0x9a, 0x84, 0xb9, 0x70, 0x71, 0x4e, 0x0b, 0xff, 0x11,
-	0x94, 0x22, 0xe1, 0xaa, 0x93, 0x1c, 0x8c, 0x9a, 0x00, 0xf3, 0x38, 0x88, 0x3b, 0xa8, 0xcb, 0xe1,
-	0x42, 0x30, 0x03, 0x2c, 0xb0, 0xc0, 0x40, 0x3e, 0xd5, 0x00, 0x5b, 0x89, 0x98, 0x01, 0x2b, 0x20,
-	0xf8, 0x8a, 0x03, 0xb3, 0x1c, 0x81, 0x3b, 0xa0, 0xdc, 0x82, 0x58, 0xcb, 0xa5, 0x86, 0xe8, 0xd5,
-	0x0d, 0xd6, 0x22, 0x1a, 0xb2, 0x57, 0x73, 0x20, 0x5b, 0x77, 0x99, 0x89, 0x9f, 0x7e, 0xaf, 0xd6,
-	0x7b, 0x16, 0xdd, 0x1f, 0x74, 0x1a, 0x86, 0xdb, 0x5f, 0x97, 0x8d, 0x5d, 0xfc, 0xdc, 0xf1, 0xcd,
-	0x83, 0x75, 0x7a, 0xec, 0xa1, 0x40, 0xee, 0xb7, 0x78, 0x88, 0x9f, 0x09, 0xfb, 0xca, 0x23, 0x98,
-	0xe5, 0x80, 0x03, 0x7f, 0x53, 0x67, 0xc8, 0x1d, 0x0f, 0x31, 0x30, 0xb0, 0x0a, 0x80, 0x47, 0x94,
-	0xe8, 0x62, 0x2b, 0x5f, 0x12, 0x4d, 0x90, 0x53, 0xf8, 0x46, 0xad, 0xc3, 0x62, 0x7c, 0xf5, 0x83,
-	0xc4, 0xc8, 0x52, 0xcb, 0x05, 0xa5, 0x56, 0x7b, 0x28, 0xf2, 0xa4, 0x3b, 0x06, 0x9e, 0xb7, 0x4c,
-	0x6b, 0xaa, 0xf0, 0x32, 0xd4, 0x0d, 0xd3, 0xbf, 0x0d, 0x4b, 0x8c, 0xe3, 0xf6, 0x3d, 0x1b, 0x29,
-	0x6e, 0x07, 0xf3, 0x63, 0x5b, 0x27, 0xf6, 0xf1, 0x99, 0x1c, 0x14, 0xb9, 0x83, 0xfb, 0x70, 0x6d,
-	0xac, 0x99, 0x94, 0x88, 0x84, 0xd2, 0x37, 0xbc, 0x5f, 0x3f, 0x27, 0xba, 0xe3, 0x77, 0x91, 0x3c,
-	0x96, 0x63, 0xe6, 0xec, 0x5e, 0x95, 0x15, 0x28, 0xf2, 0xc1, 0x65, 0xb1, 0x5e, 0x2d, 0x9a, 0xc3,
-	0x90, 0x50, 0x5b, 0x85, 0xe5, 0x14, 0xf3, 0x61, 0xe4, 0x3f, 0xe7, 0xa0, 0xb2, 0xe3, 0xf7, 0x9e,
-	0xf1, 0xd9, 0xdc, 0x74, 0x36, 0x3d, 0x6f, 0x57, 0x8e, 0x9e, 0x67, 0x48, 0x79, 0x25, 0xbc, 0xf9,
-	0x68, 0xbb, 0x01, 0x73, 0xe1, 0x68, 0x8b, 0xb6, 0xae, 0x72, 0x40, 0x15, 0x13, 0xe0, 0x9c, 0x03,
-	0x8e, 0xc5, 0xeb, 0x5b, 0x3d, 0x47, 0xa7, 0x03, 0x82, 0xea, 0x94, 0x70, 0x1a, 0x12, 0x6a, 0x75,
-	0xb8, 0x99, 0x1d, 0x4f, 0x18, 0xfa, 0x11, 0xcc, 0xee, 0xf8, 0xbd, 0x3d, 0x74, 0xcc, 0x26, 0xef,
-	0x32, 0x99, 0x6d, 0x99, 0xc3, 0x38, 0x44, 0x12, 0xb4, 0xe5, 0xe0, 0x7b, 0xd8, 0xcf, 0xf2, 0x67,
-	0xee, 0x67, 0xb5, 0x45, 0x58, 0x88, 0x7a, 0x0e, 0x11, 0xfd, 0x2d, 0xa6, 0x85, 0x28, 0x1c, 0x6c,
-	0xf1, 0xd3, 0x48, 0x06, 0xac, 0x2a, 0xeb, 0x43, 0x22, 0x9f, 0xc3, 0xf5, 0x87, 0x80, 0xd4, 0x34,
-	0x95, 0x65, 0x59, 0x19, 0x91, 0x93, 0xc5, 0x8c, 0x20, 0x34, 0xc7, 0x34, 0xed, 0x42, 0x7a, 0xd3,
-	0xae, 0xc2, 0x8c, 0x68, 0x58, 0xa6, 0xd8, 0xfb, 0x45, 0x19, 0xcf, 0x34, 0xef, 0x49, 0x66, 0x4a,
-	0x87, 0xbe, 0xf4, 0x46, 0x1d, 0xfa, 0x36, 0x1f, 0x51, 0xb1, 0xf8, 0xc7, 0xee, 0x1b, 0x39, 0x5a,
-	0xf7, 0x90, 0xb2, 0x45, 0x64, 0xe7, 0x0d, 0xa7, 0x77, 0x91, 0xc5, 0x12, 0xf6, 0x0b, 0xe1, 0xb6,
-	0x5a, 0x80, 0xa9, 0xae, 0x85, 0xb6, 0x29, 0x4b, 0x4c, 0x7c, 0x30, 0xea, 0xa1, 0x6e, 0x0f, 0x50,
-	0xf6, 0x30, 0xf1, 0x21, 0x47, 0x6b, 0x0c, 0x4a, 0x98, 0xd4, 0x5f, 0x0a, 0x7c, 0xb4, 0x8a, 0xe6,
-	0x76, 0xf1, 0x9c, 0x0a, 0x98, 0xf9, 0x10, 0xa6, 0x02, 0x05, 0x7e, 0x5c, 0x10, 0xc0, 0xf9, 0xdf,
-	0xca, 0x1a, 0x94, 0x4c, 0xf4, 0x0d, 0x62, 0x79, 0xac, 0xfd, 0xc8, 0x00, 0xa2, 0x24, 0x06, 0xe0,
-	0x10, 0x89, 0xcf, 0xb8, 0x22, 0x90, 0xe0, 0x33, 0x39, 0xdc, 0xa6, 0x2f, 0x3a, 0xdc, 0x66, 0xce,
-	0x3d, 0xdc, 0x1e, 0xc2, 0x34, 0x3a, 0x94, 0x58, 0xe8, 0xab, 0xc5, 0xd4, 0x93, 0xe7, 0xb6, 0xe0,
-	0x7e, 0x6a, 0xf9, 0x81, 0x7a, 0xa0, 0xa0, 0x7c, 0x08, 0xd3, 0xc1, 0x8c, 0x02, 0xee, 0xb8, 0x92,
-	0xd0, 0xfd, 0x12, 0xad, 0xde, 0x3e, 0x45, 0x53, 0x0e, 0xa6, 0x40, 0x5f, 0x2a, 0xb1, 0x96, 0xd5,
-	0xb1, 0x5d, 0xe3, 0xa0, 0x6d, 0x39, 0x14, 0xc9, 0xa1, 0x6e, 0xab, 0xa5, 0xb5, 0x5c, 0x3d, 0xdf,
-	0x2a, 0x73, 0x6a, 0x53, 0x12, 0x95, 0x6d, 0x98, 0x33, 0x5c, 0x9f, 0xb6, 0x3d, 0x24, 0x6d, 0xce,
-	0x51, 0x67, 0xe5, 0xe9, 0x74, 0xec, 0x04, 0x96, 0xe5, 0xce, 0xd4, 0x76, 0x91, 0x6c, 0x31, 0x25,
-	0x96, 0x05, 0x74, 0xf4, 0x8e, 0x8d, 0xa6, 0x5a, 0x5e, 0xcb, 0xd5, 0x67, 0x5a, 0xc1, 0x67, 0x62,
-	0x5e, 0xce, 0x25, 0xe7, 0xa5, 0x38, 0xc9, 0x44, 0x4b, 0x2a, 0x59, 0x6e, 0xe2, 0x38, 0xfa, 0xb6,
-	0xdc, 0xde, 0x96, 0xdb, 0xbf, 0x56, 0x6e, 0xd1, 0x92, 0x0a, 0xcb, 0xed, 0xcf, 0x1c, 0x5c, 0x09,
-	0x4b, 0xf1, 0x0d, 0x0e, 0x2f, 0x41, 0x3d, 0xe5, 0xc7, 0xd7, 0x53, 0x61, 0xb4, 0x9e, 0x56, 0xa0,
-	0x68, 0xe2, 0x21, 0xda, 0xae, 0x87, 0x24, 0x38, 0x02, 0x84, 0x84, 0x8c, 0x6a, 0xbb, 0x0e, 0x65,
-	0x7f, 0xe0, 0x79, 0x2e, 0xa1, 0x6d, 0xec, 0xeb, 0x96, 0xad, 0x4e, 0x73, 0xfe, 0xac, 0x24, 0x6e,
-	0x33, 0x5a, 0x74, 0x99, 0x66, 0x62, 0xcb, 0x54, 0x5b, 0x16, 0xc7, 0xc4, 0x58, 0xac, 0xc9, 0x95,
-	0x10, 0xab, 0xf4, 0xdf, 0x58, 0x89, 0x78, 0xac, 0xc1, 0x4a, 0x6c, 0xfc, 0x50, 0x86, 0xfc, 0x8e,
-	0xdf, 0x53, 0x3e, 0x81, 0x99, 0xf0, 0x7d, 0x24, 0xb9, 0xa9, 0x22, 0x4f, 0x14, 0x5a, 0x6d, 0x3c,
-	0x2f, 0x9c, 0xfe, 0x6d, 0x98, 0x4f, 0xbe, 0x5d, 0x5c, 0x4b, 0x51, 0x8b, 0x8b, 0x68, 0xb7, 0x4e,
-	0x15, 0x09, 0x1d, 0xbc, 0x80, 0x72, 0xfc, 0x61, 0xa2, 0x3a, 0xaa, 0x1b, 0x13, 0xd0, 0xde, 0x3d,
-	0x45, 0x20, 0x6a, 0x3a, 0xfe, 0xce, 0x90, 0x62, 0x3a, 0x26, 0x90, 0x66, 0x3a, 0xf5, 0x7d, 0x41,
-	0xf9, 0x02, 0x66, 0x63, 0x77, 0xf6, 0xca, 0xa8, 0x62, 0x94, 0xaf, 0xdd, 0xcc, 0xe6, 0x87, 0x76,
-	0xf7, 0xa0, 0x14, 0xbd, 0x0b, 0xaf, 0x8e, 0xaa, 0x45, 0xd8, 0xda, 0x8d, 0x4c, 0x76, 0xcc, 0x68,
-	0xe4, 0xe2, 0x96, 0x66, 0x74, 0xc8, 0x4e, 0x35, 0x3a, 0x7a, 0x75, 0x53, 0x28, 0x2c, 0x8e, 0xb9,
-	0xb7, 0xd5, 0x53, 0x0c, 0xa4, 0x4a, 0x6a, 0x77, 0xcf, 0x2a, 0x19, 0x7a, 0xed, 0xc0, 0xe5, 0x91,
-	0x1b, 0x5b, 0x4a, 0x19, 0x27, 0x65, 0xb4, 0xdb, 0xa7, 0xcb, 0x84, 0x3e, 0xbe, 0xcd, 0xc1, 0x72,
-	0xd6, 0xbd, 0xec, 0xce, 0xa8, 0xad, 0x0c, 0x71, 0xed, 0xfd, 0x73, 0x89, 0x47, 0x8b, 0x37, 0xfe,
-	0x7a, 0x55, 0x1d, 0x97, 0xec, 0x8c, 0xe2, 0x4d, 0x7d, 0x74, 0x52, 0x76, 0xa0, 0x38, 0xbc, 0x7d,
-	0x2d, 0x8f, 0x6a, 0x85, 0x4c, 0xed, 0x7a, 0x06, 0x33, 0x8a, 0x34, 0x7e, 0x73, 0x4a, 0x41, 0x1a,
-	0x13, 0x48, 0x43, 0x9a, 0x7e, 0xf7, 0x78, 0x01, 0xe5, 0xf8, 0x3d, 0xa3, 0x9a, 0x06, 0x28, 0x22,
-	0x90, 0x66, 0x3a, 0xf5, 0x7a, 0xc0, 0x76, 0x70, 0xec, 0x6a, 0x50, 0x19, 0xb7, 0x7a, 0x12, 0xf3,
-	0xcd, 0x6c, 0x7e, 0xd4, 0x6e, 0xec, 0x0c, 0x58, 0x19, 0xd7, 0x52, 0xc6, 0xdb, 0x4d, 0x1b, 0xf8,
-	0xca, 0xd7, 0x30, 0x97, 0x18, 0xf6, 0x6b, 0xe3, 0x10, 0x85, 0x55, 0x5f, 0x3f, 0x4d, 0x22, 0x6a,
-	0x3d, 0x31, 0x40, 0xd7, 0xc6, 0xe1, 0xca, 0xb2, 0x9e, 0x3e, 0x98, 0xb6, 0x9e, 0xbe, 0x3c, 0xa9,
-	0xe4, 0x5e, 0x9d, 0x54, 0x72, 0x7f, 0x9c, 0x54, 0x72, 0xdf, 0xbf, 0xae, 0x4c, 0xbc, 0x7a, 0x5d,
-	0x99, 0xf8, 0xf5, 0x75, 0x65, 0xe2, 0xab, 0xf7, 0x22, 0xef, 0x62, 0xbb, 0xdc, 0xcc, 0x1d, 0x8a,
-	0xc6, 0x7e, 0xf0, 0xf8, 0x7f, 0x14, 0xfe, 0x7b, 0xe3, 0xd8, 0x43, 0xbf, 0x73, 0x89, 0xff, 0x0f,
-	0xe0, 0xfe, 0x3f, 0x01, 0x00, 0x00, 0xff, 0xff, 0x38, 0x01, 0x37, 0x14, 0x59, 0x19, 0x00, 0x00,
+func (m *MsgCreateItemOffer) Reset()         { *m = MsgCreateItemOffer{} }
+func (m *MsgCreateItemOffer) String() string { return proto.CompactTextString(m) }
+func (*MsgCreateItemOffer) ProtoMessage()    {}
+func (*MsgCreateItemOffer) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2c35d96b13ec9475, []int{44}
+}
+func (m *MsgCreateItemOffer) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgCreateItemOffer) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgCreateItemOffer.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgCreateItemOffer) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgCreateItemOffer.Merge(m, src)
+}
+func (m *MsgCreateItemOffer) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgCreateItemOffer) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgCreateItemOffer.DiscardUnknown(m)
 }
 
-// Reference imports to suppress errors if they are not otherwise used.
-var _ context.Context
-var _ grpc.ClientConn
+var xxx_messageInfo_MsgCreateItemOffer proto.InternalMessageInfo
 
-// This is a compile-time assertion to ensure that this generated file
-// is compatible with the grpc package it is being compiled against.
-const _ = grpc.SupportPackageIsVersion4
+func (m *MsgCreateItemOffer) GetCreator() string {
+	if m != nil {
+		return m.Creator
+	}
+	return ""
+}
 
-// MsgClient is the client API for Msg service.
-//
-// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
-type MsgClient interface {
-	AppleIap(ctx context.Context, in *MsgAppleIap, opts ...grpc.CallOption) (*MsgAppleIapResponse, error)
-	AddStripeRefund(ctx context.Context, in *MsgAddStripeRefund, opts ...grpc.CallOption) (*MsgAddStripeRefundResponse, error)
-	// this line is used by starport scaffolding # proto/tx/rpc
-	BurnDebtToken(ctx context.Context, in *MsgBurnDebtToken, opts ...grpc.CallOption) (*MsgBurnDebtTokenResponse, error)
-	UpdateAccount(ctx context.Context, in *MsgUpdateAccount, opts ...grpc.CallOption) (*MsgUpdateAccountResponse, error)
-	FulfillTrade(ctx context.Context, in *MsgFulfillTrade, opts ...grpc.CallOption) (*MsgFulfillTradeResponse, error)
-	CreateTrade(ctx context.Context, in *MsgCreateTrade, opts ...grpc.CallOption) (*MsgCreateTradeResponse, error)
-	CancelTrade(ctx context.Context, in *MsgCancelTrade, opts ...grpc.CallOption) (*MsgCancelTradeResponse, error)
-	CompleteExecutionEarly(ctx context.Context, in *MsgCompleteExecutionEarly, opts ...grpc.CallOption) (*MsgCompleteExecutionEarlyResponse, error)
-	TransferCookbook(ctx context.Context, in *MsgTransferCookbook, opts ...grpc.CallOption) (*MsgTransferCookbookResponse, error)
-	GoogleInAppPurchaseGetCoins(ctx context.Context, in *MsgGoogleInAppPurchaseGetCoins, opts ...grpc.CallOption) (*MsgGoogleInAppPurchaseGetCoinsResponse, error)
-	CreateAccount(ctx context.Context, in *MsgCreateAccount, opts ...grpc.CallOption) (*MsgCreateAccountResponse, error)
-	SendItems(ctx context.Context, in *MsgSendItems, opts ...grpc.CallOption) (*MsgSendItemsResponse, error)
-	ExecuteRecipe(ctx context.Context, in *MsgExecuteRecipe, opts ...grpc.CallOption) (*MsgExecuteRecipeResponse, error)
-	SetItemString(ctx context.Context, in *MsgSetItemString, opts ...grpc.CallOption) (*MsgSetItemStringResponse, error)
-	CreateRecipe(ctx context.Context, in *MsgCreateRecipe, opts ...grpc.CallOption) (*MsgCreateRecipeResponse, error)
-	UpdateRecipe(ctx context.Context, in *MsgUpdateRecipe, opts ...grpc.CallOption) (*MsgUpdateRecipeResponse, error)
-	CreateCookbook(ctx context.Context, in *MsgCreateCookbook, opts ...grpc.CallOption) (*MsgCreateCookbookResponse, error)
-	UpdateCookbook(ctx context.Context, in *MsgUpdateCookbook, opts ...grpc.CallOption) (*MsgUpdateCookbookResponse, error)
+func (m *MsgCreateItemOffer) GetCookbookId() string {
+	if m != nil {
+		return m.CookbookId
+	}
+	return ""
 }
 
-type msgClient struct {
-	cc grpc1.ClientConn
+func (m *MsgCreateItemOffer) GetItemId() string {
+	if m != nil {
+		return m.ItemId
+	}
+	return ""
 }
 
-func NewMsgClient(cc grpc1.ClientConn) MsgClient {
-	return &msgClient{cc}
+func (m *MsgCreateItemOffer) GetItemInput() ItemInput {
+	if m != nil {
+		return m.ItemInput
+	}
+	return ItemInput{}
 }
 
-func (c *msgClient) AppleIap(ctx context.Context, in *MsgAppleIap, opts ...grpc.CallOption) (*MsgAppleIapResponse, error) {
-	out := new(MsgAppleIapResponse)
-	err := c.cc.Invoke(ctx, "/pylons.pylons.Msg/AppleIap", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *MsgCreateItemOffer) GetPrice() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.Price
 	}
-	return out, nil
+	return nil
 }
 
-func (c *msgClient) AddStripeRefund(ctx context.Context, in *MsgAddStripeRefund, opts ...grpc.CallOption) (*MsgAddStripeRefundResponse, error) {
-	out := new(MsgAddStripeRefundResponse)
-	err := c.cc.Invoke(ctx, "/pylons.pylons.Msg/AddStripeRefund", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *MsgCreateItemOffer) GetExpiresAtHeight() int64 {
+	if m != nil {
+		return m.ExpiresAtHeight
 	}
-	return out, nil
+	return 0
 }
 
-func (c *msgClient) BurnDebtToken(ctx context.Context, in *MsgBurnDebtToken, opts ...grpc.CallOption) (*MsgBurnDebtTokenResponse, error) {
-	out := new(MsgBurnDebtTokenResponse)
-	err := c.cc.Invoke(ctx, "/pylons.pylons.Msg/BurnDebtToken", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *MsgCreateItemOffer) GetExpiresAt() int64 {
+	if m != nil {
+		return m.ExpiresAt
 	}
-	return out, nil
+	return 0
 }
 
-func (c *msgClient) UpdateAccount(ctx context.Context, in *MsgUpdateAccount, opts ...grpc.CallOption) (*MsgUpdateAccountResponse, error) {
-	out := new(MsgUpdateAccountResponse)
-	err := c.cc.Invoke(ctx, "/pylons.pylons.Msg/UpdateAccount", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+type MsgCreateItemOfferResponse struct {
+	Id uint64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
 }
 
-func (c *msgClient) FulfillTrade(ctx context.Context, in *MsgFulfillTrade, opts ...grpc.CallOption) (*MsgFulfillTradeResponse, error) {
-	out := new(MsgFulfillTradeResponse)
-	err := c.cc.Invoke(ctx, "/pylons.pylons.Msg/FulfillTrade", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+func (m *MsgCreateItemOfferResponse) Reset()         { *m = MsgCreateItemOfferResponse{} }
+func (m *MsgCreateItemOfferResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgCreateItemOfferResponse) ProtoMessage()    {}
+func (*MsgCreateItemOfferResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2c35d96b13ec9475, []int{45}
 }
-
-func (c *msgClient) CreateTrade(ctx context.Context, in *MsgCreateTrade, opts ...grpc.CallOption) (*MsgCreateTradeResponse, error) {
-	out := new(MsgCreateTradeResponse)
-	err := c.cc.Invoke(ctx, "/pylons.pylons.Msg/CreateTrade", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *MsgCreateItemOfferResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgCreateItemOfferResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgCreateItemOfferResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return out, nil
+}
+func (m *MsgCreateItemOfferResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgCreateItemOfferResponse.Merge(m, src)
+}
+func (m *MsgCreateItemOfferResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgCreateItemOfferResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgCreateItemOfferResponse.DiscardUnknown(m)
 }
 
-func (c *msgClient) CancelTrade(ctx context.Context, in *MsgCancelTrade, opts ...grpc.CallOption) (*MsgCancelTradeResponse, error) {
-	out := new(MsgCancelTradeResponse)
-	err := c.cc.Invoke(ctx, "/pylons.pylons.Msg/CancelTrade", in, out, opts...)
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_MsgCreateItemOfferResponse proto.InternalMessageInfo
+
+func (m *MsgCreateItemOfferResponse) GetId() uint64 {
+	if m != nil {
+		return m.Id
 	}
-	return out, nil
+	return 0
 }
 
-func (c *msgClient) CompleteExecutionEarly(ctx context.Context, in *MsgCompleteExecutionEarly, opts ...grpc.CallOption) (*MsgCompleteExecutionEarlyResponse, error) {
-	out := new(MsgCompleteExecutionEarlyResponse)
-	err := c.cc.Invoke(ctx, "/pylons.pylons.Msg/CompleteExecutionEarly", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+type MsgAcceptItemOffer struct {
+	Creator string `protobuf:"bytes,1,opt,name=creator,proto3" json:"creator,omitempty"`
+	Id      uint64 `protobuf:"varint,2,opt,name=id,proto3" json:"id,omitempty"`
+	// id of the sold item of the offer cookbook
+	ItemId string `protobuf:"bytes,3,opt,name=item_id,json=itemId,proto3" json:"item_id,omitempty"`
 }
 
-func (c *msgClient) TransferCookbook(ctx context.Context, in *MsgTransferCookbook, opts ...grpc.CallOption) (*MsgTransferCookbookResponse, error) {
-	out := new(MsgTransferCookbookResponse)
-	err := c.cc.Invoke(ctx, "/pylons.pylons.Msg/TransferCookbook", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *MsgAcceptItemOffer) Reset()         { *m = MsgAcceptItemOffer{} }
+func (m *MsgAcceptItemOffer) String() string { return proto.CompactTextString(m) }
+func (*MsgAcceptItemOffer) ProtoMessage()    {}
+func (*MsgAcceptItemOffer) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2c35d96b13ec9475, []int{46}
+}
+func (m *MsgAcceptItemOffer) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgAcceptItemOffer) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgAcceptItemOffer.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return out, nil
+}
+func (m *MsgAcceptItemOffer) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgAcceptItemOffer.Merge(m, src)
+}
+func (m *MsgAcceptItemOffer) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgAcceptItemOffer) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgAcceptItemOffer.DiscardUnknown(m)
 }
 
-func (c *msgClient) GoogleInAppPurchaseGetCoins(ctx context.Context, in *MsgGoogleInAppPurchaseGetCoins, opts ...grpc.CallOption) (*MsgGoogleInAppPurchaseGetCoinsResponse, error) {
-	out := new(MsgGoogleInAppPurchaseGetCoinsResponse)
-	err := c.cc.Invoke(ctx, "/pylons.pylons.Msg/GoogleInAppPurchaseGetCoins", in, out, opts...)
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_MsgAcceptItemOffer proto.InternalMessageInfo
+
+func (m *MsgAcceptItemOffer) GetCreator() string {
+	if m != nil {
+		return m.Creator
 	}
-	return out, nil
+	return ""
 }
 
-func (c *msgClient) CreateAccount(ctx context.Context, in *MsgCreateAccount, opts ...grpc.CallOption) (*MsgCreateAccountResponse, error) {
-	out := new(MsgCreateAccountResponse)
-	err := c.cc.Invoke(ctx, "/pylons.pylons.Msg/CreateAccount", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *MsgAcceptItemOffer) GetId() uint64 {
+	if m != nil {
+		return m.Id
 	}
-	return out, nil
+	return 0
 }
 
-func (c *msgClient) SendItems(ctx context.Context, in *MsgSendItems, opts ...grpc.CallOption) (*MsgSendItemsResponse, error) {
-	out := new(MsgSendItemsResponse)
-	err := c.cc.Invoke(ctx, "/pylons.pylons.Msg/SendItems", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *MsgAcceptItemOffer) GetItemId() string {
+	if m != nil {
+		return m.ItemId
 	}
-	return out, nil
+	return ""
 }
 
-func (c *msgClient) ExecuteRecipe(ctx context.Context, in *MsgExecuteRecipe, opts ...grpc.CallOption) (*MsgExecuteRecipeResponse, error) {
-	out := new(MsgExecuteRecipeResponse)
-	err := c.cc.Invoke(ctx, "/pylons.pylons.Msg/ExecuteRecipe", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+type MsgAcceptItemOfferResponse struct {
 }
 
-func (c *msgClient) SetItemString(ctx context.Context, in *MsgSetItemString, opts ...grpc.CallOption) (*MsgSetItemStringResponse, error) {
-	out := new(MsgSetItemStringResponse)
-	err := c.cc.Invoke(ctx, "/pylons.pylons.Msg/SetItemString", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *MsgAcceptItemOfferResponse) Reset()         { *m = MsgAcceptItemOfferResponse{} }
+func (m *MsgAcceptItemOfferResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgAcceptItemOfferResponse) ProtoMessage()    {}
+func (*MsgAcceptItemOfferResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2c35d96b13ec9475, []int{47}
+}
+func (m *MsgAcceptItemOfferResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgAcceptItemOfferResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgAcceptItemOfferResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return out, nil
+}
+func (m *MsgAcceptItemOfferResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgAcceptItemOfferResponse.Merge(m, src)
+}
+func (m *MsgAcceptItemOfferResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgAcceptItemOfferResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgAcceptItemOfferResponse.DiscardUnknown(m)
 }
 
-func (c *msgClient) CreateRecipe(ctx context.Context, in *MsgCreateRecipe, opts ...grpc.CallOption) (*MsgCreateRecipeResponse, error) {
-	out := new(MsgCreateRecipeResponse)
-	err := c.cc.Invoke(ctx, "/pylons.pylons.Msg/CreateRecipe", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+var xxx_messageInfo_MsgAcceptItemOfferResponse proto.InternalMessageInfo
+
+type MsgCancelItemOffer struct {
+	Creator string `protobuf:"bytes,1,opt,name=creator,proto3" json:"creator,omitempty"`
+	Id      uint64 `protobuf:"varint,2,opt,name=id,proto3" json:"id,omitempty"`
 }
 
-func (c *msgClient) UpdateRecipe(ctx context.Context, in *MsgUpdateRecipe, opts ...grpc.CallOption) (*MsgUpdateRecipeResponse, error) {
-	out := new(MsgUpdateRecipeResponse)
-	err := c.cc.Invoke(ctx, "/pylons.pylons.Msg/UpdateRecipe", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *MsgCancelItemOffer) Reset()         { *m = MsgCancelItemOffer{} }
+func (m *MsgCancelItemOffer) String() string { return proto.CompactTextString(m) }
+func (*MsgCancelItemOffer) ProtoMessage()    {}
+func (*MsgCancelItemOffer) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2c35d96b13ec9475, []int{48}
+}
+func (m *MsgCancelItemOffer) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgCancelItemOffer) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgCancelItemOffer.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return out, nil
+}
+func (m *MsgCancelItemOffer) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgCancelItemOffer.Merge(m, src)
+}
+func (m *MsgCancelItemOffer) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgCancelItemOffer) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgCancelItemOffer.DiscardUnknown(m)
 }
 
-func (c *msgClient) CreateCookbook(ctx context.Context, in *MsgCreateCookbook, opts ...grpc.CallOption) (*MsgCreateCookbookResponse, error) {
-	out := new(MsgCreateCookbookResponse)
-	err := c.cc.Invoke(ctx, "/pylons.pylons.Msg/CreateCookbook", in, out, opts...)
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_MsgCancelItemOffer proto.InternalMessageInfo
+
+func (m *MsgCancelItemOffer) GetCreator() string {
+	if m != nil {
+		return m.Creator
 	}
-	return out, nil
+	return ""
 }
 
-func (c *msgClient) UpdateCookbook(ctx context.Context, in *MsgUpdateCookbook, opts ...grpc.CallOption) (*MsgUpdateCookbookResponse, error) {
-	out := new(MsgUpdateCookbookResponse)
-	err := c.cc.Invoke(ctx, "/pylons.pylons.Msg/UpdateCookbook", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *MsgCancelItemOffer) GetId() uint64 {
+	if m != nil {
+		return m.Id
 	}
-	return out, nil
+	return 0
 }
 
-// MsgServer is the server API for Msg service.
-type MsgServer interface {
-	AppleIap(context.Context, *MsgAppleIap) (*MsgAppleIapResponse, error)
-	AddStripeRefund(context.Context, *MsgAddStripeRefund) (*MsgAddStripeRefundResponse, error)
-	// this line is used by starport scaffolding # proto/tx/rpc
-	BurnDebtToken(context.Context, *MsgBurnDebtToken) (*MsgBurnDebtTokenResponse, error)
-	UpdateAccount(context.Context, *MsgUpdateAccount) (*MsgUpdateAccountResponse, error)
-	FulfillTrade(context.Context, *MsgFulfillTrade) (*MsgFulfillTradeResponse, error)
-	CreateTrade(context.Context, *MsgCreateTrade) (*MsgCreateTradeResponse, error)
-	CancelTrade(context.Context, *MsgCancelTrade) (*MsgCancelTradeResponse, error)
-	CompleteExecutionEarly(context.Context, *MsgCompleteExecutionEarly) (*MsgCompleteExecutionEarlyResponse, error)
-	TransferCookbook(context.Context, *MsgTransferCookbook) (*MsgTransferCookbookResponse, error)
-	GoogleInAppPurchaseGetCoins(context.Context, *MsgGoogleInAppPurchaseGetCoins) (*MsgGoogleInAppPurchaseGetCoinsResponse, error)
-	CreateAccount(context.Context, *MsgCreateAccount) (*MsgCreateAccountResponse, error)
-	SendItems(context.Context, *MsgSendItems) (*MsgSendItemsResponse, error)
-	ExecuteRecipe(context.Context, *MsgExecuteRecipe) (*MsgExecuteRecipeResponse, error)
-	SetItemString(context.Context, *MsgSetItemString) (*MsgSetItemStringResponse, error)
-	CreateRecipe(context.Context, *MsgCreateRecipe) (*MsgCreateRecipeResponse, error)
-	UpdateRecipe(context.Context, *MsgUpdateRecipe) (*MsgUpdateRecipeResponse, error)
-	CreateCookbook(context.Context, *MsgCreateCookbook) (*MsgCreateCookbookResponse, error)
-	UpdateCookbook(context.Context, *MsgUpdateCookbook) (*MsgUpdateCookbookResponse, error)
+type MsgCancelItemOfferResponse struct {
 }
 
-// UnimplementedMsgServer can be embedded to have forward compatible implementations.
-type UnimplementedMsgServer struct {
+func (m *MsgCancelItemOfferResponse) Reset()         { *m = MsgCancelItemOfferResponse{} }
+func (m *MsgCancelItemOfferResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgCancelItemOfferResponse) ProtoMessage()    {}
+func (*MsgCancelItemOfferResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2c35d96b13ec9475, []int{49}
 }
-
-func (*UnimplementedMsgServer) AppleIap(ctx context.Context, req *MsgAppleIap) (*MsgAppleIapResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method AppleIap not implemented")
+func (m *MsgCancelItemOfferResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-func (*UnimplementedMsgServer) AddStripeRefund(ctx context.Context, req *MsgAddStripeRefund) (*MsgAddStripeRefundResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method AddStripeRefund not implemented")
+func (m *MsgCancelItemOfferResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgCancelItemOfferResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
 }
-func (*UnimplementedMsgServer) BurnDebtToken(ctx context.Context, req *MsgBurnDebtToken) (*MsgBurnDebtTokenResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method BurnDebtToken not implemented")
+func (m *MsgCancelItemOfferResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgCancelItemOfferResponse.Merge(m, src)
 }
-func (*UnimplementedMsgServer) UpdateAccount(ctx context.Context, req *MsgUpdateAccount) (*MsgUpdateAccountResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method UpdateAccount not implemented")
+func (m *MsgCancelItemOfferResponse) XXX_Size() int {
+	return m.Size()
 }
-func (*UnimplementedMsgServer) FulfillTrade(ctx context.Context, req *MsgFulfillTrade) (*MsgFulfillTradeResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method FulfillTrade not implemented")
+func (m *MsgCancelItemOfferResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgCancelItemOfferResponse.DiscardUnknown(m)
 }
-func (*UnimplementedMsgServer) CreateTrade(ctx context.Context, req *MsgCreateTrade) (*MsgCreateTradeResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method CreateTrade not implemented")
+
+var xxx_messageInfo_MsgCancelItemOfferResponse proto.InternalMessageInfo
+
+type MsgCreateSwap struct {
+	Creator string `protobuf:"bytes,1,opt,name=creator,proto3" json:"creator,omitempty"`
+	// the creator is one of the participants and deposits its commitment on creation
+	Participants []SwapParticipant `protobuf:"bytes,2,rep,name=participants,proto3" json:"participants"`
 }
-func (*UnimplementedMsgServer) CancelTrade(ctx context.Context, req *MsgCancelTrade) (*MsgCancelTradeResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method CancelTrade not implemented")
+
+func (m *MsgCreateSwap) Reset()         { *m = MsgCreateSwap{} }
+func (m *MsgCreateSwap) String() string { return proto.CompactTextString(m) }
+func (*MsgCreateSwap) ProtoMessage()    {}
+func (*MsgCreateSwap) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2c35d96b13ec9475, []int{50}
 }
-func (*UnimplementedMsgServer) CompleteExecutionEarly(ctx context.Context, req *MsgCompleteExecutionEarly) (*MsgCompleteExecutionEarlyResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method CompleteExecutionEarly not implemented")
+func (m *MsgCreateSwap) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-func (*UnimplementedMsgServer) TransferCookbook(ctx context.Context, req *MsgTransferCookbook) (*MsgTransferCookbookResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method TransferCookbook not implemented")
+func (m *MsgCreateSwap) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgCreateSwap.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
 }
-func (*UnimplementedMsgServer) GoogleInAppPurchaseGetCoins(ctx context.Context, req *MsgGoogleInAppPurchaseGetCoins) (*MsgGoogleInAppPurchaseGetCoinsResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GoogleInAppPurchaseGetCoins not implemented")
+func (m *MsgCreateSwap) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgCreateSwap.Merge(m, src)
 }
-func (*UnimplementedMsgServer) CreateAccount(ctx context.Context, req *MsgCreateAccount) (*MsgCreateAccountResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method CreateAccount not implemented")
+func (m *MsgCreateSwap) XXX_Size() int {
+	return m.Size()
 }
-func (*UnimplementedMsgServer) SendItems(ctx context.Context, req *MsgSendItems) (*MsgSendItemsResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method SendItems not implemented")
+func (m *MsgCreateSwap) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgCreateSwap.DiscardUnknown(m)
 }
-func (*UnimplementedMsgServer) ExecuteRecipe(ctx context.Context, req *MsgExecuteRecipe) (*MsgExecuteRecipeResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method ExecuteRecipe not implemented")
+
+var xxx_messageInfo_MsgCreateSwap proto.InternalMessageInfo
+
+func (m *MsgCreateSwap) GetCreator() string {
+	if m != nil {
+		return m.Creator
+	}
+	return ""
 }
-func (*UnimplementedMsgServer) SetItemString(ctx context.Context, req *MsgSetItemString) (*MsgSetItemStringResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method SetItemString not implemented")
+
+func (m *MsgCreateSwap) GetParticipants() []SwapParticipant {
+	if m != nil {
+		return m.Participants
+	}
+	return nil
 }
-func (*UnimplementedMsgServer) CreateRecipe(ctx context.Context, req *MsgCreateRecipe) (*MsgCreateRecipeResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method CreateRecipe not implemented")
+
+type MsgCreateSwapResponse struct {
+	Id uint64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
 }
-func (*UnimplementedMsgServer) UpdateRecipe(ctx context.Context, req *MsgUpdateRecipe) (*MsgUpdateRecipeResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method UpdateRecipe not implemented")
+
+func (m *MsgCreateSwapResponse) Reset()         { *m = MsgCreateSwapResponse{} }
+func (m *MsgCreateSwapResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgCreateSwapResponse) ProtoMessage()    {}
+func (*MsgCreateSwapResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2c35d96b13ec9475, []int{51}
 }
-func (*UnimplementedMsgServer) CreateCookbook(ctx context.Context, req *MsgCreateCookbook) (*MsgCreateCookbookResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method CreateCookbook not implemented")
+func (m *MsgCreateSwapResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-func (*UnimplementedMsgServer) UpdateCookbook(ctx context.Context, req *MsgUpdateCookbook) (*MsgUpdateCookbookResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method UpdateCookbook not implemented")
+func (m *MsgCreateSwapResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgCreateSwapResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
 }
-
-func RegisterMsgServer(s grpc1.Server, srv MsgServer) {
-	s.RegisterService(&_Msg_serviceDesc, srv)
+func (m *MsgCreateSwapResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgCreateSwapResponse.Merge(m, src)
+}
+func (m *MsgCreateSwapResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgCreateSwapResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgCreateSwapResponse.DiscardUnknown(m)
 }
 
-func _Msg_AppleIap_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgAppleIap)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MsgServer).AppleIap(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/pylons.pylons.Msg/AppleIap",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).AppleIap(ctx, req.(*MsgAppleIap))
+var xxx_messageInfo_MsgCreateSwapResponse proto.InternalMessageInfo
+
+func (m *MsgCreateSwapResponse) GetId() uint64 {
+	if m != nil {
+		return m.Id
 	}
-	return interceptor(ctx, in, info, handler)
+	return 0
 }
 
-func _Msg_AddStripeRefund_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgAddStripeRefund)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MsgServer).AddStripeRefund(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/pylons.pylons.Msg/AddStripeRefund",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).AddStripeRefund(ctx, req.(*MsgAddStripeRefund))
-	}
-	return interceptor(ctx, in, info, handler)
+type MsgDepositSwap struct {
+	Creator string `protobuf:"bytes,1,opt,name=creator,proto3" json:"creator,omitempty"`
+	Id      uint64 `protobuf:"varint,2,opt,name=id,proto3" json:"id,omitempty"`
 }
 
-func _Msg_BurnDebtToken_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgBurnDebtToken)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MsgServer).BurnDebtToken(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/pylons.pylons.Msg/BurnDebtToken",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).BurnDebtToken(ctx, req.(*MsgBurnDebtToken))
+func (m *MsgDepositSwap) Reset()         { *m = MsgDepositSwap{} }
+func (m *MsgDepositSwap) String() string { return proto.CompactTextString(m) }
+func (*MsgDepositSwap) ProtoMessage()    {}
+func (*MsgDepositSwap) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2c35d96b13ec9475, []int{52}
+}
+func (m *MsgDepositSwap) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgDepositSwap) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgDepositSwap.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return interceptor(ctx, in, info, handler)
+}
+func (m *MsgDepositSwap) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgDepositSwap.Merge(m, src)
+}
+func (m *MsgDepositSwap) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgDepositSwap) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgDepositSwap.DiscardUnknown(m)
 }
 
-func _Msg_UpdateAccount_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgUpdateAccount)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MsgServer).UpdateAccount(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/pylons.pylons.Msg/UpdateAccount",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).UpdateAccount(ctx, req.(*MsgUpdateAccount))
+var xxx_messageInfo_MsgDepositSwap proto.InternalMessageInfo
+
+func (m *MsgDepositSwap) GetCreator() string {
+	if m != nil {
+		return m.Creator
 	}
-	return interceptor(ctx, in, info, handler)
+	return ""
 }
 
-func _Msg_FulfillTrade_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgFulfillTrade)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MsgServer).FulfillTrade(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/pylons.pylons.Msg/FulfillTrade",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).FulfillTrade(ctx, req.(*MsgFulfillTrade))
+func (m *MsgDepositSwap) GetId() uint64 {
+	if m != nil {
+		return m.Id
 	}
-	return interceptor(ctx, in, info, handler)
+	return 0
 }
 
-func _Msg_CreateTrade_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgCreateTrade)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MsgServer).CreateTrade(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/pylons.pylons.Msg/CreateTrade",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).CreateTrade(ctx, req.(*MsgCreateTrade))
-	}
-	return interceptor(ctx, in, info, handler)
+type MsgDepositSwapResponse struct {
 }
 
-func _Msg_CancelTrade_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgCancelTrade)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MsgServer).CancelTrade(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/pylons.pylons.Msg/CancelTrade",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).CancelTrade(ctx, req.(*MsgCancelTrade))
+func (m *MsgDepositSwapResponse) Reset()         { *m = MsgDepositSwapResponse{} }
+func (m *MsgDepositSwapResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgDepositSwapResponse) ProtoMessage()    {}
+func (*MsgDepositSwapResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2c35d96b13ec9475, []int{53}
+}
+func (m *MsgDepositSwapResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgDepositSwapResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgDepositSwapResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return interceptor(ctx, in, info, handler)
+}
+func (m *MsgDepositSwapResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgDepositSwapResponse.Merge(m, src)
+}
+func (m *MsgDepositSwapResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgDepositSwapResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgDepositSwapResponse.DiscardUnknown(m)
 }
 
-func _Msg_CompleteExecutionEarly_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgCompleteExecutionEarly)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MsgServer).CompleteExecutionEarly(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/pylons.pylons.Msg/CompleteExecutionEarly",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).CompleteExecutionEarly(ctx, req.(*MsgCompleteExecutionEarly))
-	}
-	return interceptor(ctx, in, info, handler)
+var xxx_messageInfo_MsgDepositSwapResponse proto.InternalMessageInfo
+
+type MsgAbortSwap struct {
+	Creator string `protobuf:"bytes,1,opt,name=creator,proto3" json:"creator,omitempty"`
+	Id      uint64 `protobuf:"varint,2,opt,name=id,proto3" json:"id,omitempty"`
 }
 
-func _Msg_TransferCookbook_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgTransferCookbook)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MsgServer).TransferCookbook(ctx, in)
+func (m *MsgAbortSwap) Reset()         { *m = MsgAbortSwap{} }
+func (m *MsgAbortSwap) String() string { return proto.CompactTextString(m) }
+func (*MsgAbortSwap) ProtoMessage()    {}
+func (*MsgAbortSwap) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2c35d96b13ec9475, []int{54}
+}
+func (m *MsgAbortSwap) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgAbortSwap) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgAbortSwap.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/pylons.pylons.Msg/TransferCookbook",
+}
+func (m *MsgAbortSwap) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgAbortSwap.Merge(m, src)
+}
+func (m *MsgAbortSwap) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgAbortSwap) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgAbortSwap.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgAbortSwap proto.InternalMessageInfo
+
+func (m *MsgAbortSwap) GetCreator() string {
+	if m != nil {
+		return m.Creator
 	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).TransferCookbook(ctx, req.(*MsgTransferCookbook))
+	return ""
+}
+
+func (m *MsgAbortSwap) GetId() uint64 {
+	if m != nil {
+		return m.Id
 	}
-	return interceptor(ctx, in, info, handler)
+	return 0
 }
 
-func _Msg_GoogleInAppPurchaseGetCoins_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgGoogleInAppPurchaseGetCoins)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MsgServer).GoogleInAppPurchaseGetCoins(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/pylons.pylons.Msg/GoogleInAppPurchaseGetCoins",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).GoogleInAppPurchaseGetCoins(ctx, req.(*MsgGoogleInAppPurchaseGetCoins))
-	}
-	return interceptor(ctx, in, info, handler)
+type MsgAbortSwapResponse struct {
 }
 
-func _Msg_CreateAccount_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgCreateAccount)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MsgServer).CreateAccount(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/pylons.pylons.Msg/CreateAccount",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).CreateAccount(ctx, req.(*MsgCreateAccount))
+func (m *MsgAbortSwapResponse) Reset()         { *m = MsgAbortSwapResponse{} }
+func (m *MsgAbortSwapResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgAbortSwapResponse) ProtoMessage()    {}
+func (*MsgAbortSwapResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2c35d96b13ec9475, []int{55}
+}
+func (m *MsgAbortSwapResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgAbortSwapResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgAbortSwapResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return interceptor(ctx, in, info, handler)
+}
+func (m *MsgAbortSwapResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgAbortSwapResponse.Merge(m, src)
+}
+func (m *MsgAbortSwapResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgAbortSwapResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgAbortSwapResponse.DiscardUnknown(m)
 }
 
-func _Msg_SendItems_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgSendItems)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MsgServer).SendItems(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/pylons.pylons.Msg/SendItems",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).SendItems(ctx, req.(*MsgSendItems))
-	}
-	return interceptor(ctx, in, info, handler)
+var xxx_messageInfo_MsgAbortSwapResponse proto.InternalMessageInfo
+
+type MsgApproveItem struct {
+	Creator    string `protobuf:"bytes,1,opt,name=creator,proto3" json:"creator,omitempty"`
+	CookbookId string `protobuf:"bytes,2,opt,name=cookbook_id,json=cookbookId,proto3" json:"cookbook_id,omitempty"`
+	ItemId     string `protobuf:"bytes,3,opt,name=item_id,json=itemId,proto3" json:"item_id,omitempty"`
+	Operator   string `protobuf:"bytes,4,opt,name=operator,proto3" json:"operator,omitempty"`
 }
 
-func _Msg_ExecuteRecipe_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgExecuteRecipe)
-	if err := dec(in); err != nil {
-		return nil, err
+func (m *MsgApproveItem) Reset()         { *m = MsgApproveItem{} }
+func (m *MsgApproveItem) String() string { return proto.CompactTextString(m) }
+func (*MsgApproveItem) ProtoMessage()    {}
+func (*MsgApproveItem) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2c35d96b13ec9475, []int{56}
+}
+func (m *MsgApproveItem) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgApproveItem) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgApproveItem.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	if interceptor == nil {
-		return srv.(MsgServer).ExecuteRecipe(ctx, in)
+}
+func (m *MsgApproveItem) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgApproveItem.Merge(m, src)
+}
+func (m *MsgApproveItem) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgApproveItem) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgApproveItem.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgApproveItem proto.InternalMessageInfo
+
+func (m *MsgApproveItem) GetCreator() string {
+	if m != nil {
+		return m.Creator
 	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/pylons.pylons.Msg/ExecuteRecipe",
+	return ""
+}
+
+func (m *MsgApproveItem) GetCookbookId() string {
+	if m != nil {
+		return m.CookbookId
 	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).ExecuteRecipe(ctx, req.(*MsgExecuteRecipe))
+	return ""
+}
+
+func (m *MsgApproveItem) GetItemId() string {
+	if m != nil {
+		return m.ItemId
 	}
-	return interceptor(ctx, in, info, handler)
+	return ""
 }
 
-func _Msg_SetItemString_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgSetItemString)
-	if err := dec(in); err != nil {
-		return nil, err
+func (m *MsgApproveItem) GetOperator() string {
+	if m != nil {
+		return m.Operator
 	}
-	if interceptor == nil {
-		return srv.(MsgServer).SetItemString(ctx, in)
+	return ""
+}
+
+type MsgApproveItemResponse struct {
+}
+
+func (m *MsgApproveItemResponse) Reset()         { *m = MsgApproveItemResponse{} }
+func (m *MsgApproveItemResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgApproveItemResponse) ProtoMessage()    {}
+func (*MsgApproveItemResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2c35d96b13ec9475, []int{57}
+}
+func (m *MsgApproveItemResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgApproveItemResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgApproveItemResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/pylons.pylons.Msg/SetItemString",
+}
+func (m *MsgApproveItemResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgApproveItemResponse.Merge(m, src)
+}
+func (m *MsgApproveItemResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgApproveItemResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgApproveItemResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgApproveItemResponse proto.InternalMessageInfo
+
+type MsgRevokeItemApproval struct {
+	Creator    string `protobuf:"bytes,1,opt,name=creator,proto3" json:"creator,omitempty"`
+	CookbookId string `protobuf:"bytes,2,opt,name=cookbook_id,json=cookbookId,proto3" json:"cookbook_id,omitempty"`
+	ItemId     string `protobuf:"bytes,3,opt,name=item_id,json=itemId,proto3" json:"item_id,omitempty"`
+}
+
+func (m *MsgRevokeItemApproval) Reset()         { *m = MsgRevokeItemApproval{} }
+func (m *MsgRevokeItemApproval) String() string { return proto.CompactTextString(m) }
+func (*MsgRevokeItemApproval) ProtoMessage()    {}
+func (*MsgRevokeItemApproval) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2c35d96b13ec9475, []int{58}
+}
+func (m *MsgRevokeItemApproval) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgRevokeItemApproval) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgRevokeItemApproval.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).SetItemString(ctx, req.(*MsgSetItemString))
+}
+func (m *MsgRevokeItemApproval) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgRevokeItemApproval.Merge(m, src)
+}
+func (m *MsgRevokeItemApproval) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgRevokeItemApproval) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgRevokeItemApproval.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgRevokeItemApproval proto.InternalMessageInfo
+
+func (m *MsgRevokeItemApproval) GetCreator() string {
+	if m != nil {
+		return m.Creator
 	}
-	return interceptor(ctx, in, info, handler)
+	return ""
 }
 
-func _Msg_CreateRecipe_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgCreateRecipe)
-	if err := dec(in); err != nil {
-		return nil, err
+func (m *MsgRevokeItemApproval) GetCookbookId() string {
+	if m != nil {
+		return m.CookbookId
 	}
-	if interceptor == nil {
-		return srv.(MsgServer).CreateRecipe(ctx, in)
+	return ""
+}
+
+func (m *MsgRevokeItemApproval) GetItemId() string {
+	if m != nil {
+		return m.ItemId
 	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/pylons.pylons.Msg/CreateRecipe",
+	return ""
+}
+
+type MsgRevokeItemApprovalResponse struct {
+}
+
+func (m *MsgRevokeItemApprovalResponse) Reset()         { *m = MsgRevokeItemApprovalResponse{} }
+func (m *MsgRevokeItemApprovalResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgRevokeItemApprovalResponse) ProtoMessage()    {}
+func (*MsgRevokeItemApprovalResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2c35d96b13ec9475, []int{59}
+}
+func (m *MsgRevokeItemApprovalResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgRevokeItemApprovalResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgRevokeItemApprovalResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).CreateRecipe(ctx, req.(*MsgCreateRecipe))
+}
+func (m *MsgRevokeItemApprovalResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgRevokeItemApprovalResponse.Merge(m, src)
+}
+func (m *MsgRevokeItemApprovalResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgRevokeItemApprovalResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgRevokeItemApprovalResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgRevokeItemApprovalResponse proto.InternalMessageInfo
+
+type MsgSetOperator struct {
+	Creator    string `protobuf:"bytes,1,opt,name=creator,proto3" json:"creator,omitempty"`
+	CookbookId string `protobuf:"bytes,2,opt,name=cookbook_id,json=cookbookId,proto3" json:"cookbook_id,omitempty"`
+	Operator   string `protobuf:"bytes,3,opt,name=operator,proto3" json:"operator,omitempty"`
+	Approved   bool   `protobuf:"varint,4,opt,name=approved,proto3" json:"approved,omitempty"`
+}
+
+func (m *MsgSetOperator) Reset()         { *m = MsgSetOperator{} }
+func (m *MsgSetOperator) String() string { return proto.CompactTextString(m) }
+func (*MsgSetOperator) ProtoMessage()    {}
+func (*MsgSetOperator) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2c35d96b13ec9475, []int{60}
+}
+func (m *MsgSetOperator) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgSetOperator) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSetOperator.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return interceptor(ctx, in, info, handler)
+}
+func (m *MsgSetOperator) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSetOperator.Merge(m, src)
+}
+func (m *MsgSetOperator) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSetOperator) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSetOperator.DiscardUnknown(m)
 }
 
-func _Msg_UpdateRecipe_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgUpdateRecipe)
-	if err := dec(in); err != nil {
-		return nil, err
+var xxx_messageInfo_MsgSetOperator proto.InternalMessageInfo
+
+func (m *MsgSetOperator) GetCreator() string {
+	if m != nil {
+		return m.Creator
 	}
-	if interceptor == nil {
-		return srv.(MsgServer).UpdateRecipe(ctx, in)
+	return ""
+}
+
+func (m *MsgSetOperator) GetCookbookId() string {
+	if m != nil {
+		return m.CookbookId
 	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/pylons.pylons.Msg/UpdateRecipe",
+	return ""
+}
+
+func (m *MsgSetOperator) GetOperator() string {
+	if m != nil {
+		return m.Operator
 	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).UpdateRecipe(ctx, req.(*MsgUpdateRecipe))
+	return ""
+}
+
+func (m *MsgSetOperator) GetApproved() bool {
+	if m != nil {
+		return m.Approved
 	}
-	return interceptor(ctx, in, info, handler)
+	return false
 }
 
-func _Msg_CreateCookbook_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgCreateCookbook)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MsgServer).CreateCookbook(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/pylons.pylons.Msg/CreateCookbook",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).CreateCookbook(ctx, req.(*MsgCreateCookbook))
-	}
-	return interceptor(ctx, in, info, handler)
+type MsgSetOperatorResponse struct {
 }
 
-func _Msg_UpdateCookbook_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgUpdateCookbook)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MsgServer).UpdateCookbook(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/pylons.pylons.Msg/UpdateCookbook",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).UpdateCookbook(ctx, req.(*MsgUpdateCookbook))
+func (m *MsgSetOperatorResponse) Reset()         { *m = MsgSetOperatorResponse{} }
+func (m *MsgSetOperatorResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgSetOperatorResponse) ProtoMessage()    {}
+func (*MsgSetOperatorResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2c35d96b13ec9475, []int{61}
+}
+func (m *MsgSetOperatorResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgSetOperatorResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSetOperatorResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return interceptor(ctx, in, info, handler)
+}
+func (m *MsgSetOperatorResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSetOperatorResponse.Merge(m, src)
+}
+func (m *MsgSetOperatorResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSetOperatorResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSetOperatorResponse.DiscardUnknown(m)
 }
 
-var _Msg_serviceDesc = grpc.ServiceDesc{
-	ServiceName: "pylons.pylons.Msg",
-	HandlerType: (*MsgServer)(nil),
-	Methods: []grpc.MethodDesc{
-		{
-			MethodName: "AppleIap",
-			Handler:    _Msg_AppleIap_Handler,
-		},
-		{
-			MethodName: "AddStripeRefund",
-			Handler:    _Msg_AddStripeRefund_Handler,
-		},
-		{
-			MethodName: "BurnDebtToken",
-			Handler:    _Msg_BurnDebtToken_Handler,
-		},
-		{
-			MethodName: "UpdateAccount",
-			Handler:    _Msg_UpdateAccount_Handler,
-		},
-		{
-			MethodName: "FulfillTrade",
-			Handler:    _Msg_FulfillTrade_Handler,
-		},
-		{
-			MethodName: "CreateTrade",
-			Handler:    _Msg_CreateTrade_Handler,
-		},
-		{
-			MethodName: "CancelTrade",
-			Handler:    _Msg_CancelTrade_Handler,
-		},
-		{
-			MethodName: "CompleteExecutionEarly",
-			Handler:    _Msg_CompleteExecutionEarly_Handler,
-		},
-		{
-			MethodName: "TransferCookbook",
-			Handler:    _Msg_TransferCookbook_Handler,
-		},
-		{
-			MethodName: "GoogleInAppPurchaseGetCoins",
-			Handler:    _Msg_GoogleInAppPurchaseGetCoins_Handler,
-		},
-		{
-			MethodName: "CreateAccount",
-			Handler:    _Msg_CreateAccount_Handler,
-		},
-		{
-			MethodName: "SendItems",
-			Handler:    _Msg_SendItems_Handler,
-		},
-		{
-			MethodName: "ExecuteRecipe",
-			Handler:    _Msg_ExecuteRecipe_Handler,
-		},
-		{
-			MethodName: "SetItemString",
-			Handler:    _Msg_SetItemString_Handler,
-		},
-		{
-			MethodName: "CreateRecipe",
-			Handler:    _Msg_CreateRecipe_Handler,
-		},
-		{
-			MethodName: "UpdateRecipe",
-			Handler:    _Msg_UpdateRecipe_Handler,
-		},
-		{
-			MethodName: "CreateCookbook",
-			Handler:    _Msg_CreateCookbook_Handler,
-		},
-		{
-			MethodName: "UpdateCookbook",
-			Handler:    _Msg_UpdateCookbook_Handler,
-		},
-	},
-	Streams:  []grpc.StreamDesc{},
-	Metadata: "pylons/pylons/tx.proto",
+var xxx_messageInfo_MsgSetOperatorResponse proto.InternalMessageInfo
+
+// MsgTransferItems sends items of a cookbook to another chain as ICS-721 tokens
+type MsgTransferItems struct {
+	Creator       string   `protobuf:"bytes,1,opt,name=creator,proto3" json:"creator,omitempty"`
+	SourcePort    string   `protobuf:"bytes,2,opt,name=source_port,json=sourcePort,proto3" json:"source_port,omitempty"`
+	SourceChannel string   `protobuf:"bytes,3,opt,name=source_channel,json=sourceChannel,proto3" json:"source_channel,omitempty"`
+	CookbookId    string   `protobuf:"bytes,4,opt,name=cookbook_id,json=cookbookId,proto3" json:"cookbook_id,omitempty"`
+	ItemIds       []string `protobuf:"bytes,5,rep,name=item_ids,json=itemIds,proto3" json:"item_ids,omitempty"`
+	// address on the receiving chain
+	Receiver string `protobuf:"bytes,6,opt,name=receiver,proto3" json:"receiver,omitempty"`
+	// timeout height on the receiving chain, the timeout is disabled when set to 0
+	TimeoutHeight types1.Height `protobuf:"bytes,7,opt,name=timeout_height,json=timeoutHeight,proto3" json:"timeout_height"`
+	// timeout timestamp in absolute nanoseconds since unix epoch, the timeout is disabled when set to 0
+	TimeoutTimestamp uint64 `protobuf:"varint,8,opt,name=timeout_timestamp,json=timeoutTimestamp,proto3" json:"timeout_timestamp,omitempty"`
+	Memo             string `protobuf:"bytes,9,opt,name=memo,proto3" json:"memo,omitempty"`
 }
 
-func (m *MsgAppleIap) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *MsgTransferItems) Reset()         { *m = MsgTransferItems{} }
+func (m *MsgTransferItems) String() string { return proto.CompactTextString(m) }
+func (*MsgTransferItems) ProtoMessage()    {}
+func (*MsgTransferItems) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2c35d96b13ec9475, []int{62}
+}
+func (m *MsgTransferItems) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgTransferItems) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgTransferItems.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return dAtA[:n], nil
+}
+func (m *MsgTransferItems) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgTransferItems.Merge(m, src)
+}
+func (m *MsgTransferItems) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgTransferItems) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgTransferItems.DiscardUnknown(m)
 }
 
-func (m *MsgAppleIap) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+var xxx_messageInfo_MsgTransferItems proto.InternalMessageInfo
+
+func (m *MsgTransferItems) GetCreator() string {
+	if m != nil {
+		return m.Creator
+	}
+	return ""
 }
 
-func (m *MsgAppleIap) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.ReceiptDataBase64) > 0 {
-		i -= len(m.ReceiptDataBase64)
-		copy(dAtA[i:], m.ReceiptDataBase64)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.ReceiptDataBase64)))
-		i--
-		dAtA[i] = 0x22
+func (m *MsgTransferItems) GetSourcePort() string {
+	if m != nil {
+		return m.SourcePort
 	}
-	if len(m.PurchaseId) > 0 {
-		i -= len(m.PurchaseId)
-		copy(dAtA[i:], m.PurchaseId)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.PurchaseId)))
-		i--
-		dAtA[i] = 0x1a
+	return ""
+}
+
+func (m *MsgTransferItems) GetSourceChannel() string {
+	if m != nil {
+		return m.SourceChannel
 	}
-	if len(m.ProductId) > 0 {
-		i -= len(m.ProductId)
-		copy(dAtA[i:], m.ProductId)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.ProductId)))
-		i--
-		dAtA[i] = 0x12
+	return ""
+}
+
+func (m *MsgTransferItems) GetCookbookId() string {
+	if m != nil {
+		return m.CookbookId
 	}
-	if len(m.Creator) > 0 {
-		i -= len(m.Creator)
-		copy(dAtA[i:], m.Creator)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Creator)))
-		i--
-		dAtA[i] = 0xa
+	return ""
+}
+
+func (m *MsgTransferItems) GetItemIds() []string {
+	if m != nil {
+		return m.ItemIds
 	}
-	return len(dAtA) - i, nil
+	return nil
 }
 
-func (m *MsgAppleIapResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *MsgTransferItems) GetReceiver() string {
+	if m != nil {
+		return m.Receiver
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *MsgAppleIapResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *MsgTransferItems) GetTimeoutHeight() types1.Height {
+	if m != nil {
+		return m.TimeoutHeight
+	}
+	return types1.Height{}
 }
 
-func (m *MsgAppleIapResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+func (m *MsgTransferItems) GetTimeoutTimestamp() uint64 {
+	if m != nil {
+		return m.TimeoutTimestamp
+	}
+	return 0
 }
 
-func (m *MsgAddStripeRefund) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *MsgTransferItems) GetMemo() string {
+	if m != nil {
+		return m.Memo
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *MsgAddStripeRefund) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+type MsgTransferItemsResponse struct {
+	Sequence uint64 `protobuf:"varint,1,opt,name=sequence,proto3" json:"sequence,omitempty"`
 }
 
-func (m *MsgAddStripeRefund) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Payment != nil {
-		{
-			size, err := m.Payment.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintTx(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.Creator) > 0 {
-		i -= len(m.Creator)
-		copy(dAtA[i:], m.Creator)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Creator)))
-		i--
-		dAtA[i] = 0xa
-	}
-	return len(dAtA) - i, nil
+func (m *MsgTransferItemsResponse) Reset()         { *m = MsgTransferItemsResponse{} }
+func (m *MsgTransferItemsResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgTransferItemsResponse) ProtoMessage()    {}
+func (*MsgTransferItemsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2c35d96b13ec9475, []int{63}
 }
-
-func (m *MsgAddStripeRefundResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *MsgTransferItemsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgTransferItemsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgTransferItemsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return dAtA[:n], nil
 }
-
-func (m *MsgAddStripeRefundResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *MsgTransferItemsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgTransferItemsResponse.Merge(m, src)
 }
-
-func (m *MsgAddStripeRefundResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+func (m *MsgTransferItemsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgTransferItemsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgTransferItemsResponse.DiscardUnknown(m)
 }
 
-func (m *MsgBurnDebtToken) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_MsgTransferItemsResponse proto.InternalMessageInfo
+
+func (m *MsgTransferItemsResponse) GetSequence() uint64 {
+	if m != nil {
+		return m.Sequence
 	}
-	return dAtA[:n], nil
+	return 0
 }
 
-func (m *MsgBurnDebtToken) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+type MsgExecuteRecipe struct {
+	Creator         string        `protobuf:"bytes,1,opt,name=creator,proto3" json:"creator,omitempty"`
+	CookbookId      string        `protobuf:"bytes,2,opt,name=cookbook_id,json=cookbookId,proto3" json:"cookbook_id,omitempty"`
+	RecipeId        string        `protobuf:"bytes,3,opt,name=recipe_id,json=recipeId,proto3" json:"recipe_id,omitempty"`
+	CoinInputsIndex uint64        `protobuf:"varint,4,opt,name=coin_inputs_index,json=coinInputsIndex,proto3" json:"coin_inputs_index,omitempty"`
+	ItemIds         []string      `protobuf:"bytes,5,rep,name=item_ids,json=itemIds,proto3" json:"item_ids,omitempty"`
+	PaymentInfos    []PaymentInfo `protobuf:"bytes,6,rep,name=payment_infos,json=paymentInfos,proto3" json:"payment_infos"`
 }
 
-func (m *MsgBurnDebtToken) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size, err := m.RedeemInfo.MarshalToSizedBuffer(dAtA[:i])
+func (m *MsgExecuteRecipe) Reset()         { *m = MsgExecuteRecipe{} }
+func (m *MsgExecuteRecipe) String() string { return proto.CompactTextString(m) }
+func (*MsgExecuteRecipe) ProtoMessage()    {}
+func (*MsgExecuteRecipe) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2c35d96b13ec9475, []int{64}
+}
+func (m *MsgExecuteRecipe) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgExecuteRecipe) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgExecuteRecipe.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
-			return 0, err
+			return nil, err
 		}
-		i -= size
-		i = encodeVarintTx(dAtA, i, uint64(size))
-	}
-	i--
-	dAtA[i] = 0x12
-	if len(m.Creator) > 0 {
-		i -= len(m.Creator)
-		copy(dAtA[i:], m.Creator)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Creator)))
-		i--
-		dAtA[i] = 0xa
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
 }
-
-func (m *MsgBurnDebtTokenResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func (m *MsgExecuteRecipe) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgExecuteRecipe.Merge(m, src)
 }
-
-func (m *MsgBurnDebtTokenResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *MsgExecuteRecipe) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgExecuteRecipe) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgExecuteRecipe.DiscardUnknown(m)
 }
 
-func (m *MsgBurnDebtTokenResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+var xxx_messageInfo_MsgExecuteRecipe proto.InternalMessageInfo
+
+func (m *MsgExecuteRecipe) GetCreator() string {
+	if m != nil {
+		return m.Creator
+	}
+	return ""
 }
 
-func (m *MsgUpdateAccount) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *MsgExecuteRecipe) GetCookbookId() string {
+	if m != nil {
+		return m.CookbookId
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *MsgUpdateAccount) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *MsgExecuteRecipe) GetRecipeId() string {
+	if m != nil {
+		return m.RecipeId
+	}
+	return ""
 }
 
-func (m *MsgUpdateAccount) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Username) > 0 {
-		i -= len(m.Username)
-		copy(dAtA[i:], m.Username)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Username)))
-		i--
-		dAtA[i] = 0x12
+func (m *MsgExecuteRecipe) GetCoinInputsIndex() uint64 {
+	if m != nil {
+		return m.CoinInputsIndex
 	}
-	if len(m.Creator) > 0 {
-		i -= len(m.Creator)
-		copy(dAtA[i:], m.Creator)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Creator)))
-		i--
-		dAtA[i] = 0xa
+	return 0
+}
+
+func (m *MsgExecuteRecipe) GetItemIds() []string {
+	if m != nil {
+		return m.ItemIds
 	}
-	return len(dAtA) - i, nil
+	return nil
 }
 
-func (m *MsgUpdateAccountResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *MsgExecuteRecipe) GetPaymentInfos() []PaymentInfo {
+	if m != nil {
+		return m.PaymentInfos
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *MsgUpdateAccountResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+type MsgExecuteRecipeResponse struct {
+	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
 }
 
-func (m *MsgUpdateAccountResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+func (m *MsgExecuteRecipeResponse) Reset()         { *m = MsgExecuteRecipeResponse{} }
+func (m *MsgExecuteRecipeResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgExecuteRecipeResponse) ProtoMessage()    {}
+func (*MsgExecuteRecipeResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2c35d96b13ec9475, []int{65}
+}
+func (m *MsgExecuteRecipeResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgExecuteRecipeResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgExecuteRecipeResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgExecuteRecipeResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgExecuteRecipeResponse.Merge(m, src)
+}
+func (m *MsgExecuteRecipeResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgExecuteRecipeResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgExecuteRecipeResponse.DiscardUnknown(m)
 }
 
-func (m *MsgCreateAccount) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_MsgExecuteRecipeResponse proto.InternalMessageInfo
+
+func (m *MsgExecuteRecipeResponse) GetId() string {
+	if m != nil {
+		return m.Id
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *MsgCreateAccount) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+type MsgSetItemString struct {
+	Creator    string `protobuf:"bytes,1,opt,name=creator,proto3" json:"creator,omitempty"`
+	CookbookId string `protobuf:"bytes,2,opt,name=cookbook_id,json=cookbookId,proto3" json:"cookbook_id,omitempty"`
+	Id         string `protobuf:"bytes,4,opt,name=id,proto3" json:"id,omitempty"`
+	Field      string `protobuf:"bytes,5,opt,name=field,proto3" json:"field,omitempty"`
+	Value      string `protobuf:"bytes,6,opt,name=value,proto3" json:"value,omitempty"`
 }
 
-func (m *MsgCreateAccount) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.ReferralAddress) > 0 {
-		i -= len(m.ReferralAddress)
-		copy(dAtA[i:], m.ReferralAddress)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.ReferralAddress)))
-		i--
-		dAtA[i] = 0x22
-	}
-	if len(m.Token) > 0 {
-		i -= len(m.Token)
-		copy(dAtA[i:], m.Token)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Token)))
-		i--
-		dAtA[i] = 0x1a
-	}
-	if len(m.Username) > 0 {
-		i -= len(m.Username)
-		copy(dAtA[i:], m.Username)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Username)))
-		i--
-		dAtA[i] = 0x12
+func (m *MsgSetItemString) Reset()         { *m = MsgSetItemString{} }
+func (m *MsgSetItemString) String() string { return proto.CompactTextString(m) }
+func (*MsgSetItemString) ProtoMessage()    {}
+func (*MsgSetItemString) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2c35d96b13ec9475, []int{66}
+}
+func (m *MsgSetItemString) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgSetItemString) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSetItemString.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	if len(m.Creator) > 0 {
-		i -= len(m.Creator)
-		copy(dAtA[i:], m.Creator)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Creator)))
-		i--
-		dAtA[i] = 0xa
+}
+func (m *MsgSetItemString) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSetItemString.Merge(m, src)
+}
+func (m *MsgSetItemString) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSetItemString) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSetItemString.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgSetItemString proto.InternalMessageInfo
+
+func (m *MsgSetItemString) GetCreator() string {
+	if m != nil {
+		return m.Creator
 	}
-	return len(dAtA) - i, nil
+	return ""
 }
 
-func (m *MsgCreateAccountResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *MsgSetItemString) GetCookbookId() string {
+	if m != nil {
+		return m.CookbookId
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *MsgCreateAccountResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *MsgSetItemString) GetId() string {
+	if m != nil {
+		return m.Id
+	}
+	return ""
 }
 
-func (m *MsgCreateAccountResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+func (m *MsgSetItemString) GetField() string {
+	if m != nil {
+		return m.Field
+	}
+	return ""
 }
 
-func (m *MsgFulfillTrade) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *MsgSetItemString) GetValue() string {
+	if m != nil {
+		return m.Value
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *MsgFulfillTrade) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+type MsgSetItemStringResponse struct {
 }
 
-func (m *MsgFulfillTrade) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.PaymentInfos) > 0 {
-		for iNdEx := len(m.PaymentInfos) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.PaymentInfos[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintTx(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x2a
+func (m *MsgSetItemStringResponse) Reset()         { *m = MsgSetItemStringResponse{} }
+func (m *MsgSetItemStringResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgSetItemStringResponse) ProtoMessage()    {}
+func (*MsgSetItemStringResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2c35d96b13ec9475, []int{67}
+}
+func (m *MsgSetItemStringResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgSetItemStringResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSetItemStringResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	if len(m.Items) > 0 {
-		for iNdEx := len(m.Items) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Items[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintTx(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x22
+}
+func (m *MsgSetItemStringResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSetItemStringResponse.Merge(m, src)
+}
+func (m *MsgSetItemStringResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSetItemStringResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSetItemStringResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgSetItemStringResponse proto.InternalMessageInfo
+
+// MsgUpdateItemAttributes sets attributes of an item, each key must be declared in the attribute permissions of the
+// cookbook and the creator must be the cookbook creator or one of the updaters of the key
+type MsgUpdateItemAttributes struct {
+	Creator    string           `protobuf:"bytes,1,opt,name=creator,proto3" json:"creator,omitempty"`
+	CookbookId string           `protobuf:"bytes,2,opt,name=cookbook_id,json=cookbookId,proto3" json:"cookbook_id,omitempty"`
+	Id         string           `protobuf:"bytes,3,opt,name=id,proto3" json:"id,omitempty"`
+	Doubles    []DoubleKeyValue `protobuf:"bytes,4,rep,name=doubles,proto3" json:"doubles"`
+	Longs      []LongKeyValue   `protobuf:"bytes,5,rep,name=longs,proto3" json:"longs"`
+	Strings    []StringKeyValue `protobuf:"bytes,6,rep,name=strings,proto3" json:"strings"`
+}
+
+func (m *MsgUpdateItemAttributes) Reset()         { *m = MsgUpdateItemAttributes{} }
+func (m *MsgUpdateItemAttributes) String() string { return proto.CompactTextString(m) }
+func (*MsgUpdateItemAttributes) ProtoMessage()    {}
+func (*MsgUpdateItemAttributes) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2c35d96b13ec9475, []int{68}
+}
+func (m *MsgUpdateItemAttributes) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgUpdateItemAttributes) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgUpdateItemAttributes.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	if m.CoinInputsIndex != 0 {
-		i = encodeVarintTx(dAtA, i, uint64(m.CoinInputsIndex))
-		i--
-		dAtA[i] = 0x18
-	}
-	if m.Id != 0 {
-		i = encodeVarintTx(dAtA, i, uint64(m.Id))
-		i--
-		dAtA[i] = 0x10
+}
+func (m *MsgUpdateItemAttributes) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgUpdateItemAttributes.Merge(m, src)
+}
+func (m *MsgUpdateItemAttributes) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgUpdateItemAttributes) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgUpdateItemAttributes.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgUpdateItemAttributes proto.InternalMessageInfo
+
+func (m *MsgUpdateItemAttributes) GetCreator() string {
+	if m != nil {
+		return m.Creator
 	}
-	if len(m.Creator) > 0 {
-		i -= len(m.Creator)
-		copy(dAtA[i:], m.Creator)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Creator)))
-		i--
-		dAtA[i] = 0xa
+	return ""
+}
+
+func (m *MsgUpdateItemAttributes) GetCookbookId() string {
+	if m != nil {
+		return m.CookbookId
 	}
-	return len(dAtA) - i, nil
+	return ""
 }
 
-func (m *MsgFulfillTradeResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *MsgUpdateItemAttributes) GetId() string {
+	if m != nil {
+		return m.Id
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *MsgFulfillTradeResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *MsgUpdateItemAttributes) GetDoubles() []DoubleKeyValue {
+	if m != nil {
+		return m.Doubles
+	}
+	return nil
 }
 
-func (m *MsgFulfillTradeResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+func (m *MsgUpdateItemAttributes) GetLongs() []LongKeyValue {
+	if m != nil {
+		return m.Longs
+	}
+	return nil
 }
 
-func (m *MsgCreateTrade) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *MsgUpdateItemAttributes) GetStrings() []StringKeyValue {
+	if m != nil {
+		return m.Strings
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *MsgCreateTrade) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+type MsgUpdateItemAttributesResponse struct {
 }
 
-func (m *MsgCreateTrade) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.ExtraInfo) > 0 {
-		i -= len(m.ExtraInfo)
-		copy(dAtA[i:], m.ExtraInfo)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.ExtraInfo)))
-		i--
-		dAtA[i] = 0x32
-	}
-	if len(m.ItemOutputs) > 0 {
-		for iNdEx := len(m.ItemOutputs) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.ItemOutputs[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintTx(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x2a
-		}
-	}
-	if len(m.CoinOutputs) > 0 {
-		for iNdEx := len(m.CoinOutputs) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.CoinOutputs[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintTx(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x22
-		}
-	}
-	if len(m.ItemInputs) > 0 {
-		for iNdEx := len(m.ItemInputs) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.ItemInputs[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintTx(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x1a
+func (m *MsgUpdateItemAttributesResponse) Reset()         { *m = MsgUpdateItemAttributesResponse{} }
+func (m *MsgUpdateItemAttributesResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgUpdateItemAttributesResponse) ProtoMessage()    {}
+func (*MsgUpdateItemAttributesResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2c35d96b13ec9475, []int{69}
+}
+func (m *MsgUpdateItemAttributesResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgUpdateItemAttributesResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgUpdateItemAttributesResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	if len(m.CoinInputs) > 0 {
-		for iNdEx := len(m.CoinInputs) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.CoinInputs[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintTx(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x12
+}
+func (m *MsgUpdateItemAttributesResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgUpdateItemAttributesResponse.Merge(m, src)
+}
+func (m *MsgUpdateItemAttributesResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgUpdateItemAttributesResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgUpdateItemAttributesResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgUpdateItemAttributesResponse proto.InternalMessageInfo
+
+// MsgDepositItems puts items into a container item of the same cookbook
+type MsgDepositItems struct {
+	Creator     string   `protobuf:"bytes,1,opt,name=creator,proto3" json:"creator,omitempty"`
+	CookbookId  string   `protobuf:"bytes,2,opt,name=cookbook_id,json=cookbookId,proto3" json:"cookbook_id,omitempty"`
+	ContainerId string   `protobuf:"bytes,3,opt,name=container_id,json=containerId,proto3" json:"container_id,omitempty"`
+	ItemIds     []string `protobuf:"bytes,4,rep,name=item_ids,json=itemIds,proto3" json:"item_ids,omitempty"`
+}
+
+func (m *MsgDepositItems) Reset()         { *m = MsgDepositItems{} }
+func (m *MsgDepositItems) String() string { return proto.CompactTextString(m) }
+func (*MsgDepositItems) ProtoMessage()    {}
+func (*MsgDepositItems) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2c35d96b13ec9475, []int{70}
+}
+func (m *MsgDepositItems) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgDepositItems) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgDepositItems.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	if len(m.Creator) > 0 {
-		i -= len(m.Creator)
-		copy(dAtA[i:], m.Creator)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Creator)))
-		i--
-		dAtA[i] = 0xa
-	}
-	return len(dAtA) - i, nil
+}
+func (m *MsgDepositItems) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgDepositItems.Merge(m, src)
+}
+func (m *MsgDepositItems) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgDepositItems) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgDepositItems.DiscardUnknown(m)
 }
 
-func (m *MsgCreateTradeResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_MsgDepositItems proto.InternalMessageInfo
+
+func (m *MsgDepositItems) GetCreator() string {
+	if m != nil {
+		return m.Creator
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *MsgCreateTradeResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *MsgDepositItems) GetCookbookId() string {
+	if m != nil {
+		return m.CookbookId
+	}
+	return ""
 }
 
-func (m *MsgCreateTradeResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Id != 0 {
-		i = encodeVarintTx(dAtA, i, uint64(m.Id))
-		i--
-		dAtA[i] = 0x8
+func (m *MsgDepositItems) GetContainerId() string {
+	if m != nil {
+		return m.ContainerId
 	}
-	return len(dAtA) - i, nil
+	return ""
 }
 
-func (m *MsgCancelTrade) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *MsgDepositItems) GetItemIds() []string {
+	if m != nil {
+		return m.ItemIds
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *MsgCancelTrade) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+type MsgDepositItemsResponse struct {
 }
 
-func (m *MsgCancelTrade) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Id != 0 {
-		i = encodeVarintTx(dAtA, i, uint64(m.Id))
-		i--
-		dAtA[i] = 0x10
-	}
-	if len(m.Creator) > 0 {
-		i -= len(m.Creator)
-		copy(dAtA[i:], m.Creator)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Creator)))
-		i--
-		dAtA[i] = 0xa
-	}
-	return len(dAtA) - i, nil
+func (m *MsgDepositItemsResponse) Reset()         { *m = MsgDepositItemsResponse{} }
+func (m *MsgDepositItemsResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgDepositItemsResponse) ProtoMessage()    {}
+func (*MsgDepositItemsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2c35d96b13ec9475, []int{71}
 }
-
-func (m *MsgCancelTradeResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *MsgDepositItemsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgDepositItemsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgDepositItemsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return dAtA[:n], nil
 }
-
-func (m *MsgCancelTradeResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *MsgDepositItemsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgDepositItemsResponse.Merge(m, src)
+}
+func (m *MsgDepositItemsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgDepositItemsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgDepositItemsResponse.DiscardUnknown(m)
 }
 
-func (m *MsgCancelTradeResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+var xxx_messageInfo_MsgDepositItemsResponse proto.InternalMessageInfo
+
+// MsgWithdrawItems takes items out of a container item, back to the container owner
+type MsgWithdrawItems struct {
+	Creator     string   `protobuf:"bytes,1,opt,name=creator,proto3" json:"creator,omitempty"`
+	CookbookId  string   `protobuf:"bytes,2,opt,name=cookbook_id,json=cookbookId,proto3" json:"cookbook_id,omitempty"`
+	ContainerId string   `protobuf:"bytes,3,opt,name=container_id,json=containerId,proto3" json:"container_id,omitempty"`
+	ItemIds     []string `protobuf:"bytes,4,rep,name=item_ids,json=itemIds,proto3" json:"item_ids,omitempty"`
 }
 
-func (m *MsgCompleteExecutionEarly) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *MsgWithdrawItems) Reset()         { *m = MsgWithdrawItems{} }
+func (m *MsgWithdrawItems) String() string { return proto.CompactTextString(m) }
+func (*MsgWithdrawItems) ProtoMessage()    {}
+func (*MsgWithdrawItems) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2c35d96b13ec9475, []int{72}
+}
+func (m *MsgWithdrawItems) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgWithdrawItems) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgWithdrawItems.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return dAtA[:n], nil
+}
+func (m *MsgWithdrawItems) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgWithdrawItems.Merge(m, src)
+}
+func (m *MsgWithdrawItems) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgWithdrawItems) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgWithdrawItems.DiscardUnknown(m)
 }
 
-func (m *MsgCompleteExecutionEarly) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+var xxx_messageInfo_MsgWithdrawItems proto.InternalMessageInfo
+
+func (m *MsgWithdrawItems) GetCreator() string {
+	if m != nil {
+		return m.Creator
+	}
+	return ""
 }
 
-func (m *MsgCompleteExecutionEarly) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Id) > 0 {
-		i -= len(m.Id)
-		copy(dAtA[i:], m.Id)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Id)))
-		i--
-		dAtA[i] = 0x12
+func (m *MsgWithdrawItems) GetCookbookId() string {
+	if m != nil {
+		return m.CookbookId
 	}
-	if len(m.Creator) > 0 {
-		i -= len(m.Creator)
-		copy(dAtA[i:], m.Creator)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Creator)))
-		i--
-		dAtA[i] = 0xa
+	return ""
+}
+
+func (m *MsgWithdrawItems) GetContainerId() string {
+	if m != nil {
+		return m.ContainerId
 	}
-	return len(dAtA) - i, nil
+	return ""
 }
 
-func (m *MsgCompleteExecutionEarlyResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *MsgWithdrawItems) GetItemIds() []string {
+	if m != nil {
+		return m.ItemIds
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *MsgCompleteExecutionEarlyResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+type MsgWithdrawItemsResponse struct {
 }
 
-func (m *MsgCompleteExecutionEarlyResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Id) > 0 {
-		i -= len(m.Id)
-		copy(dAtA[i:], m.Id)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Id)))
-		i--
-		dAtA[i] = 0xa
-	}
-	return len(dAtA) - i, nil
+func (m *MsgWithdrawItemsResponse) Reset()         { *m = MsgWithdrawItemsResponse{} }
+func (m *MsgWithdrawItemsResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgWithdrawItemsResponse) ProtoMessage()    {}
+func (*MsgWithdrawItemsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2c35d96b13ec9475, []int{73}
 }
-
-func (m *MsgTransferCookbook) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *MsgWithdrawItemsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgWithdrawItemsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgWithdrawItemsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return dAtA[:n], nil
+}
+func (m *MsgWithdrawItemsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgWithdrawItemsResponse.Merge(m, src)
+}
+func (m *MsgWithdrawItemsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgWithdrawItemsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgWithdrawItemsResponse.DiscardUnknown(m)
 }
 
-func (m *MsgTransferCookbook) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+var xxx_messageInfo_MsgWithdrawItemsResponse proto.InternalMessageInfo
+
+type MsgCreateRecipe struct {
+	Creator       string            `protobuf:"bytes,1,opt,name=creator,proto3" json:"creator,omitempty"`
+	CookbookId    string            `protobuf:"bytes,2,opt,name=cookbook_id,json=cookbookId,proto3" json:"cookbook_id,omitempty"`
+	Id            string            `protobuf:"bytes,3,opt,name=id,proto3" json:"id,omitempty"`
+	Name          string            `protobuf:"bytes,4,opt,name=name,proto3" json:"name,omitempty"`
+	Description   string            `protobuf:"bytes,5,opt,name=description,proto3" json:"description,omitempty"`
+	Version       string            `protobuf:"bytes,6,opt,name=version,proto3" json:"version,omitempty"`
+	CoinInputs    []CoinInput       `protobuf:"bytes,7,rep,name=coin_inputs,json=coinInputs,proto3" json:"coin_inputs"`
+	ItemInputs    []ItemInput       `protobuf:"bytes,8,rep,name=item_inputs,json=itemInputs,proto3" json:"item_inputs"`
+	Entries       EntriesList       `protobuf:"bytes,9,opt,name=entries,proto3" json:"entries"`
+	Outputs       []WeightedOutputs `protobuf:"bytes,10,rep,name=outputs,proto3" json:"outputs"`
+	BlockInterval int64             `protobuf:"varint,11,opt,name=block_interval,json=blockInterval,proto3" json:"block_interval,omitempty"`
+	CostPerBlock  types.Coin        `protobuf:"bytes,12,opt,name=cost_per_block,json=costPerBlock,proto3" json:"cost_per_block"`
+	Enabled       bool              `protobuf:"varint,13,opt,name=enabled,proto3" json:"enabled,omitempty"`
+	ExtraInfo     string            `protobuf:"bytes,14,opt,name=extra_info,json=extraInfo,proto3" json:"extra_info,omitempty"`
 }
 
-func (m *MsgTransferCookbook) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Recipient) > 0 {
-		i -= len(m.Recipient)
-		copy(dAtA[i:], m.Recipient)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Recipient)))
-		i--
-		dAtA[i] = 0x1a
-	}
-	if len(m.Id) > 0 {
-		i -= len(m.Id)
-		copy(dAtA[i:], m.Id)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Id)))
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.Creator) > 0 {
-		i -= len(m.Creator)
-		copy(dAtA[i:], m.Creator)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Creator)))
-		i--
-		dAtA[i] = 0xa
+func (m *MsgCreateRecipe) Reset()         { *m = MsgCreateRecipe{} }
+func (m *MsgCreateRecipe) String() string { return proto.CompactTextString(m) }
+func (*MsgCreateRecipe) ProtoMessage()    {}
+func (*MsgCreateRecipe) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2c35d96b13ec9475, []int{74}
+}
+func (m *MsgCreateRecipe) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgCreateRecipe) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgCreateRecipe.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *MsgCreateRecipe) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgCreateRecipe.Merge(m, src)
+}
+func (m *MsgCreateRecipe) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgCreateRecipe) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgCreateRecipe.DiscardUnknown(m)
 }
 
-func (m *MsgTransferCookbookResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_MsgCreateRecipe proto.InternalMessageInfo
+
+func (m *MsgCreateRecipe) GetCreator() string {
+	if m != nil {
+		return m.Creator
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *MsgTransferCookbookResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *MsgCreateRecipe) GetCookbookId() string {
+	if m != nil {
+		return m.CookbookId
+	}
+	return ""
 }
 
-func (m *MsgTransferCookbookResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+func (m *MsgCreateRecipe) GetId() string {
+	if m != nil {
+		return m.Id
+	}
+	return ""
 }
 
-func (m *MsgGoogleInAppPurchaseGetCoins) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *MsgCreateRecipe) GetName() string {
+	if m != nil {
+		return m.Name
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *MsgGoogleInAppPurchaseGetCoins) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *MsgCreateRecipe) GetDescription() string {
+	if m != nil {
+		return m.Description
+	}
+	return ""
 }
 
-func (m *MsgGoogleInAppPurchaseGetCoins) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Signature) > 0 {
-		i -= len(m.Signature)
-		copy(dAtA[i:], m.Signature)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Signature)))
-		i--
-		dAtA[i] = 0x2a
-	}
-	if len(m.ReceiptDataBase64) > 0 {
-		i -= len(m.ReceiptDataBase64)
-		copy(dAtA[i:], m.ReceiptDataBase64)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.ReceiptDataBase64)))
-		i--
-		dAtA[i] = 0x22
-	}
-	if len(m.PurchaseToken) > 0 {
-		i -= len(m.PurchaseToken)
-		copy(dAtA[i:], m.PurchaseToken)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.PurchaseToken)))
-		i--
-		dAtA[i] = 0x1a
+func (m *MsgCreateRecipe) GetVersion() string {
+	if m != nil {
+		return m.Version
 	}
-	if len(m.ProductId) > 0 {
-		i -= len(m.ProductId)
-		copy(dAtA[i:], m.ProductId)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.ProductId)))
-		i--
-		dAtA[i] = 0x12
+	return ""
+}
+
+func (m *MsgCreateRecipe) GetCoinInputs() []CoinInput {
+	if m != nil {
+		return m.CoinInputs
 	}
-	if len(m.Creator) > 0 {
-		i -= len(m.Creator)
-		copy(dAtA[i:], m.Creator)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Creator)))
-		i--
-		dAtA[i] = 0xa
+	return nil
+}
+
+func (m *MsgCreateRecipe) GetItemInputs() []ItemInput {
+	if m != nil {
+		return m.ItemInputs
 	}
-	return len(dAtA) - i, nil
+	return nil
 }
 
-func (m *MsgGoogleInAppPurchaseGetCoinsResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *MsgCreateRecipe) GetEntries() EntriesList {
+	if m != nil {
+		return m.Entries
 	}
-	return dAtA[:n], nil
+	return EntriesList{}
 }
 
-func (m *MsgGoogleInAppPurchaseGetCoinsResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *MsgCreateRecipe) GetOutputs() []WeightedOutputs {
+	if m != nil {
+		return m.Outputs
+	}
+	return nil
 }
 
-func (m *MsgGoogleInAppPurchaseGetCoinsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+func (m *MsgCreateRecipe) GetBlockInterval() int64 {
+	if m != nil {
+		return m.BlockInterval
+	}
+	return 0
 }
 
-func (m *MsgSendItems) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *MsgCreateRecipe) GetCostPerBlock() types.Coin {
+	if m != nil {
+		return m.CostPerBlock
 	}
-	return dAtA[:n], nil
+	return types.Coin{}
 }
 
-func (m *MsgSendItems) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *MsgCreateRecipe) GetEnabled() bool {
+	if m != nil {
+		return m.Enabled
+	}
+	return false
 }
 
-func (m *MsgSendItems) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Items) > 0 {
-		for iNdEx := len(m.Items) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Items[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintTx(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x1a
-		}
-	}
-	if len(m.Receiver) > 0 {
-		i -= len(m.Receiver)
-		copy(dAtA[i:], m.Receiver)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Receiver)))
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.Creator) > 0 {
-		i -= len(m.Creator)
-		copy(dAtA[i:], m.Creator)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Creator)))
-		i--
-		dAtA[i] = 0xa
+func (m *MsgCreateRecipe) GetExtraInfo() string {
+	if m != nil {
+		return m.ExtraInfo
 	}
-	return len(dAtA) - i, nil
+	return ""
 }
 
-func (m *MsgSendItemsResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+type MsgCreateRecipeResponse struct {
 }
 
-func (m *MsgSendItemsResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *MsgCreateRecipeResponse) Reset()         { *m = MsgCreateRecipeResponse{} }
+func (m *MsgCreateRecipeResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgCreateRecipeResponse) ProtoMessage()    {}
+func (*MsgCreateRecipeResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2c35d96b13ec9475, []int{75}
 }
-
-func (m *MsgSendItemsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+func (m *MsgCreateRecipeResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-
-func (m *MsgExecuteRecipe) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *MsgCreateRecipeResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgCreateRecipeResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return dAtA[:n], nil
+}
+func (m *MsgCreateRecipeResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgCreateRecipeResponse.Merge(m, src)
+}
+func (m *MsgCreateRecipeResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgCreateRecipeResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgCreateRecipeResponse.DiscardUnknown(m)
 }
 
-func (m *MsgExecuteRecipe) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+var xxx_messageInfo_MsgCreateRecipeResponse proto.InternalMessageInfo
+
+type MsgUpdateRecipe struct {
+	Creator       string            `protobuf:"bytes,1,opt,name=creator,proto3" json:"creator,omitempty"`
+	CookbookId    string            `protobuf:"bytes,2,opt,name=cookbook_id,json=cookbookId,proto3" json:"cookbook_id,omitempty"`
+	Id            string            `protobuf:"bytes,3,opt,name=id,proto3" json:"id,omitempty"`
+	Name          string            `protobuf:"bytes,4,opt,name=name,proto3" json:"name,omitempty"`
+	Description   string            `protobuf:"bytes,5,opt,name=description,proto3" json:"description,omitempty"`
+	Version       string            `protobuf:"bytes,6,opt,name=version,proto3" json:"version,omitempty"`
+	CoinInputs    []CoinInput       `protobuf:"bytes,7,rep,name=coin_inputs,json=coinInputs,proto3" json:"coin_inputs"`
+	ItemInputs    []ItemInput       `protobuf:"bytes,8,rep,name=item_inputs,json=itemInputs,proto3" json:"item_inputs"`
+	Entries       EntriesList       `protobuf:"bytes,9,opt,name=entries,proto3" json:"entries"`
+	Outputs       []WeightedOutputs `protobuf:"bytes,10,rep,name=outputs,proto3" json:"outputs"`
+	BlockInterval int64             `protobuf:"varint,11,opt,name=block_interval,json=blockInterval,proto3" json:"block_interval,omitempty"`
+	CostPerBlock  types.Coin        `protobuf:"bytes,12,opt,name=cost_per_block,json=costPerBlock,proto3" json:"cost_per_block"`
+	Enabled       bool              `protobuf:"varint,13,opt,name=enabled,proto3" json:"enabled,omitempty"`
+	ExtraInfo     string            `protobuf:"bytes,14,opt,name=extra_info,json=extraInfo,proto3" json:"extra_info,omitempty"`
 }
 
-func (m *MsgExecuteRecipe) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.PaymentInfos) > 0 {
-		for iNdEx := len(m.PaymentInfos) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.PaymentInfos[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintTx(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x32
-		}
-	}
-	if len(m.ItemIds) > 0 {
-		for iNdEx := len(m.ItemIds) - 1; iNdEx >= 0; iNdEx-- {
-			i -= len(m.ItemIds[iNdEx])
-			copy(dAtA[i:], m.ItemIds[iNdEx])
-			i = encodeVarintTx(dAtA, i, uint64(len(m.ItemIds[iNdEx])))
-			i--
-			dAtA[i] = 0x2a
+func (m *MsgUpdateRecipe) Reset()         { *m = MsgUpdateRecipe{} }
+func (m *MsgUpdateRecipe) String() string { return proto.CompactTextString(m) }
+func (*MsgUpdateRecipe) ProtoMessage()    {}
+func (*MsgUpdateRecipe) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2c35d96b13ec9475, []int{76}
+}
+func (m *MsgUpdateRecipe) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgUpdateRecipe) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgUpdateRecipe.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	if m.CoinInputsIndex != 0 {
-		i = encodeVarintTx(dAtA, i, uint64(m.CoinInputsIndex))
-		i--
-		dAtA[i] = 0x20
-	}
-	if len(m.RecipeId) > 0 {
-		i -= len(m.RecipeId)
-		copy(dAtA[i:], m.RecipeId)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.RecipeId)))
-		i--
-		dAtA[i] = 0x1a
-	}
-	if len(m.CookbookId) > 0 {
-		i -= len(m.CookbookId)
-		copy(dAtA[i:], m.CookbookId)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.CookbookId)))
-		i--
-		dAtA[i] = 0x12
+}
+func (m *MsgUpdateRecipe) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgUpdateRecipe.Merge(m, src)
+}
+func (m *MsgUpdateRecipe) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgUpdateRecipe) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgUpdateRecipe.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgUpdateRecipe proto.InternalMessageInfo
+
+func (m *MsgUpdateRecipe) GetCreator() string {
+	if m != nil {
+		return m.Creator
 	}
-	if len(m.Creator) > 0 {
-		i -= len(m.Creator)
-		copy(dAtA[i:], m.Creator)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Creator)))
-		i--
-		dAtA[i] = 0xa
+	return ""
+}
+
+func (m *MsgUpdateRecipe) GetCookbookId() string {
+	if m != nil {
+		return m.CookbookId
 	}
-	return len(dAtA) - i, nil
+	return ""
 }
 
-func (m *MsgExecuteRecipeResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *MsgUpdateRecipe) GetId() string {
+	if m != nil {
+		return m.Id
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *MsgExecuteRecipeResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *MsgUpdateRecipe) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
 }
 
-func (m *MsgExecuteRecipeResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Id) > 0 {
-		i -= len(m.Id)
-		copy(dAtA[i:], m.Id)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Id)))
-		i--
-		dAtA[i] = 0xa
+func (m *MsgUpdateRecipe) GetDescription() string {
+	if m != nil {
+		return m.Description
 	}
-	return len(dAtA) - i, nil
+	return ""
 }
 
-func (m *MsgSetItemString) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *MsgUpdateRecipe) GetVersion() string {
+	if m != nil {
+		return m.Version
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *MsgSetItemString) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *MsgUpdateRecipe) GetCoinInputs() []CoinInput {
+	if m != nil {
+		return m.CoinInputs
+	}
+	return nil
 }
 
-func (m *MsgSetItemString) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Value) > 0 {
-		i -= len(m.Value)
-		copy(dAtA[i:], m.Value)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Value)))
-		i--
-		dAtA[i] = 0x32
-	}
-	if len(m.Field) > 0 {
-		i -= len(m.Field)
-		copy(dAtA[i:], m.Field)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Field)))
-		i--
-		dAtA[i] = 0x2a
-	}
-	if len(m.Id) > 0 {
-		i -= len(m.Id)
-		copy(dAtA[i:], m.Id)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Id)))
-		i--
-		dAtA[i] = 0x22
+func (m *MsgUpdateRecipe) GetItemInputs() []ItemInput {
+	if m != nil {
+		return m.ItemInputs
 	}
-	if len(m.CookbookId) > 0 {
-		i -= len(m.CookbookId)
-		copy(dAtA[i:], m.CookbookId)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.CookbookId)))
-		i--
-		dAtA[i] = 0x12
+	return nil
+}
+
+func (m *MsgUpdateRecipe) GetEntries() EntriesList {
+	if m != nil {
+		return m.Entries
 	}
-	if len(m.Creator) > 0 {
-		i -= len(m.Creator)
-		copy(dAtA[i:], m.Creator)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Creator)))
-		i--
-		dAtA[i] = 0xa
+	return EntriesList{}
+}
+
+func (m *MsgUpdateRecipe) GetOutputs() []WeightedOutputs {
+	if m != nil {
+		return m.Outputs
 	}
-	return len(dAtA) - i, nil
+	return nil
 }
 
-func (m *MsgSetItemStringResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *MsgUpdateRecipe) GetBlockInterval() int64 {
+	if m != nil {
+		return m.BlockInterval
 	}
-	return dAtA[:n], nil
+	return 0
 }
 
-func (m *MsgSetItemStringResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *MsgUpdateRecipe) GetCostPerBlock() types.Coin {
+	if m != nil {
+		return m.CostPerBlock
+	}
+	return types.Coin{}
 }
 
-func (m *MsgSetItemStringResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+func (m *MsgUpdateRecipe) GetEnabled() bool {
+	if m != nil {
+		return m.Enabled
+	}
+	return false
 }
 
-func (m *MsgCreateRecipe) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *MsgUpdateRecipe) GetExtraInfo() string {
+	if m != nil {
+		return m.ExtraInfo
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *MsgCreateRecipe) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+type MsgUpdateRecipeResponse struct {
 }
 
-func (m *MsgCreateRecipe) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.ExtraInfo) > 0 {
-		i -= len(m.ExtraInfo)
-		copy(dAtA[i:], m.ExtraInfo)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.ExtraInfo)))
-		i--
-		dAtA[i] = 0x72
-	}
-	if m.Enabled {
-		i--
-		if m.Enabled {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
+func (m *MsgUpdateRecipeResponse) Reset()         { *m = MsgUpdateRecipeResponse{} }
+func (m *MsgUpdateRecipeResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgUpdateRecipeResponse) ProtoMessage()    {}
+func (*MsgUpdateRecipeResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2c35d96b13ec9475, []int{77}
+}
+func (m *MsgUpdateRecipeResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgUpdateRecipeResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgUpdateRecipeResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i--
-		dAtA[i] = 0x68
+		return b[:n], nil
 	}
-	{
-		size, err := m.CostPerBlock.MarshalToSizedBuffer(dAtA[:i])
+}
+func (m *MsgUpdateRecipeResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgUpdateRecipeResponse.Merge(m, src)
+}
+func (m *MsgUpdateRecipeResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgUpdateRecipeResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgUpdateRecipeResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgUpdateRecipeResponse proto.InternalMessageInfo
+
+type MsgCreateCookbook struct {
+	Creator              string                                   `protobuf:"bytes,1,opt,name=creator,proto3" json:"creator,omitempty"`
+	Id                   string                                   `protobuf:"bytes,2,opt,name=id,proto3" json:"id,omitempty"`
+	Name                 string                                   `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`
+	Description          string                                   `protobuf:"bytes,4,opt,name=description,proto3" json:"description,omitempty"`
+	Developer            string                                   `protobuf:"bytes,5,opt,name=developer,proto3" json:"developer,omitempty"`
+	Version              string                                   `protobuf:"bytes,6,opt,name=version,proto3" json:"version,omitempty"`
+	SupportEmail         string                                   `protobuf:"bytes,7,opt,name=support_email,json=supportEmail,proto3" json:"support_email,omitempty"`
+	Enabled              bool                                     `protobuf:"varint,8,opt,name=enabled,proto3" json:"enabled,omitempty"`
+	BurnRefund           github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,9,rep,name=burn_refund,json=burnRefund,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"burn_refund"`
+	IndexedAttributes    []string                                 `protobuf:"bytes,10,rep,name=indexed_attributes,json=indexedAttributes,proto3" json:"indexed_attributes,omitempty"`
+	AttributePermissions []ItemAttributePermission                `protobuf:"bytes,11,rep,name=attribute_permissions,json=attributePermissions,proto3" json:"attribute_permissions"`
+	AttributeSchema      []ItemAttributeSchema                    `protobuf:"bytes,12,rep,name=attribute_schema,json=attributeSchema,proto3" json:"attribute_schema"`
+}
+
+func (m *MsgCreateCookbook) Reset()         { *m = MsgCreateCookbook{} }
+func (m *MsgCreateCookbook) String() string { return proto.CompactTextString(m) }
+func (*MsgCreateCookbook) ProtoMessage()    {}
+func (*MsgCreateCookbook) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2c35d96b13ec9475, []int{78}
+}
+func (m *MsgCreateCookbook) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgCreateCookbook) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgCreateCookbook.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
-			return 0, err
+			return nil, err
 		}
-		i -= size
-		i = encodeVarintTx(dAtA, i, uint64(size))
+		return b[:n], nil
 	}
-	i--
-	dAtA[i] = 0x62
-	if m.BlockInterval != 0 {
-		i = encodeVarintTx(dAtA, i, uint64(m.BlockInterval))
-		i--
-		dAtA[i] = 0x58
+}
+func (m *MsgCreateCookbook) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgCreateCookbook.Merge(m, src)
+}
+func (m *MsgCreateCookbook) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgCreateCookbook) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgCreateCookbook.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgCreateCookbook proto.InternalMessageInfo
+
+func (m *MsgCreateCookbook) GetCreator() string {
+	if m != nil {
+		return m.Creator
 	}
-	if len(m.Outputs) > 0 {
-		for iNdEx := len(m.Outputs) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Outputs[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintTx(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x52
-		}
+	return ""
+}
+
+func (m *MsgCreateCookbook) GetId() string {
+	if m != nil {
+		return m.Id
 	}
-	{
-		size, err := m.Entries.MarshalToSizedBuffer(dAtA[:i])
-		if err != nil {
-			return 0, err
-		}
-		i -= size
-		i = encodeVarintTx(dAtA, i, uint64(size))
+	return ""
+}
+
+func (m *MsgCreateCookbook) GetName() string {
+	if m != nil {
+		return m.Name
 	}
-	i--
-	dAtA[i] = 0x4a
-	if len(m.ItemInputs) > 0 {
-		for iNdEx := len(m.ItemInputs) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.ItemInputs[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintTx(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x42
-		}
+	return ""
+}
+
+func (m *MsgCreateCookbook) GetDescription() string {
+	if m != nil {
+		return m.Description
 	}
-	if len(m.CoinInputs) > 0 {
-		for iNdEx := len(m.CoinInputs) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.CoinInputs[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintTx(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x3a
-		}
-	}
-	if len(m.Version) > 0 {
-		i -= len(m.Version)
-		copy(dAtA[i:], m.Version)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Version)))
-		i--
-		dAtA[i] = 0x32
-	}
-	if len(m.Description) > 0 {
-		i -= len(m.Description)
-		copy(dAtA[i:], m.Description)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Description)))
-		i--
-		dAtA[i] = 0x2a
-	}
-	if len(m.Name) > 0 {
-		i -= len(m.Name)
-		copy(dAtA[i:], m.Name)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Name)))
-		i--
-		dAtA[i] = 0x22
+	return ""
+}
+
+func (m *MsgCreateCookbook) GetDeveloper() string {
+	if m != nil {
+		return m.Developer
 	}
-	if len(m.Id) > 0 {
-		i -= len(m.Id)
-		copy(dAtA[i:], m.Id)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Id)))
-		i--
-		dAtA[i] = 0x1a
+	return ""
+}
+
+func (m *MsgCreateCookbook) GetVersion() string {
+	if m != nil {
+		return m.Version
 	}
-	if len(m.CookbookId) > 0 {
-		i -= len(m.CookbookId)
-		copy(dAtA[i:], m.CookbookId)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.CookbookId)))
-		i--
-		dAtA[i] = 0x12
+	return ""
+}
+
+func (m *MsgCreateCookbook) GetSupportEmail() string {
+	if m != nil {
+		return m.SupportEmail
 	}
-	if len(m.Creator) > 0 {
-		i -= len(m.Creator)
-		copy(dAtA[i:], m.Creator)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Creator)))
-		i--
-		dAtA[i] = 0xa
+	return ""
+}
+
+func (m *MsgCreateCookbook) GetEnabled() bool {
+	if m != nil {
+		return m.Enabled
 	}
-	return len(dAtA) - i, nil
+	return false
 }
 
-func (m *MsgCreateRecipeResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *MsgCreateCookbook) GetBurnRefund() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.BurnRefund
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *MsgCreateRecipeResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *MsgCreateCookbook) GetIndexedAttributes() []string {
+	if m != nil {
+		return m.IndexedAttributes
+	}
+	return nil
 }
 
-func (m *MsgCreateRecipeResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+func (m *MsgCreateCookbook) GetAttributePermissions() []ItemAttributePermission {
+	if m != nil {
+		return m.AttributePermissions
+	}
+	return nil
 }
 
-func (m *MsgUpdateRecipe) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *MsgCreateCookbook) GetAttributeSchema() []ItemAttributeSchema {
+	if m != nil {
+		return m.AttributeSchema
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *MsgUpdateRecipe) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+type MsgCreateCookbookResponse struct {
 }
 
-func (m *MsgUpdateRecipe) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.ExtraInfo) > 0 {
-		i -= len(m.ExtraInfo)
-		copy(dAtA[i:], m.ExtraInfo)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.ExtraInfo)))
-		i--
-		dAtA[i] = 0x72
-	}
-	if m.Enabled {
-		i--
-		if m.Enabled {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
+func (m *MsgCreateCookbookResponse) Reset()         { *m = MsgCreateCookbookResponse{} }
+func (m *MsgCreateCookbookResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgCreateCookbookResponse) ProtoMessage()    {}
+func (*MsgCreateCookbookResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2c35d96b13ec9475, []int{79}
+}
+func (m *MsgCreateCookbookResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgCreateCookbookResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgCreateCookbookResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i--
-		dAtA[i] = 0x68
+		return b[:n], nil
 	}
-	{
-		size, err := m.CostPerBlock.MarshalToSizedBuffer(dAtA[:i])
+}
+func (m *MsgCreateCookbookResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgCreateCookbookResponse.Merge(m, src)
+}
+func (m *MsgCreateCookbookResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgCreateCookbookResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgCreateCookbookResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgCreateCookbookResponse proto.InternalMessageInfo
+
+type MsgUpdateCookbook struct {
+	Creator              string                                   `protobuf:"bytes,1,opt,name=creator,proto3" json:"creator,omitempty"`
+	Id                   string                                   `protobuf:"bytes,2,opt,name=id,proto3" json:"id,omitempty"`
+	Name                 string                                   `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`
+	Description          string                                   `protobuf:"bytes,4,opt,name=description,proto3" json:"description,omitempty"`
+	Developer            string                                   `protobuf:"bytes,5,opt,name=developer,proto3" json:"developer,omitempty"`
+	Version              string                                   `protobuf:"bytes,6,opt,name=version,proto3" json:"version,omitempty"`
+	SupportEmail         string                                   `protobuf:"bytes,7,opt,name=support_email,json=supportEmail,proto3" json:"support_email,omitempty"`
+	Enabled              bool                                     `protobuf:"varint,8,opt,name=enabled,proto3" json:"enabled,omitempty"`
+	BurnRefund           github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,9,rep,name=burn_refund,json=burnRefund,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"burn_refund"`
+	IndexedAttributes    []string                                 `protobuf:"bytes,10,rep,name=indexed_attributes,json=indexedAttributes,proto3" json:"indexed_attributes,omitempty"`
+	AttributePermissions []ItemAttributePermission                `protobuf:"bytes,11,rep,name=attribute_permissions,json=attributePermissions,proto3" json:"attribute_permissions"`
+	AttributeSchema      []ItemAttributeSchema                    `protobuf:"bytes,12,rep,name=attribute_schema,json=attributeSchema,proto3" json:"attribute_schema"`
+}
+
+func (m *MsgUpdateCookbook) Reset()         { *m = MsgUpdateCookbook{} }
+func (m *MsgUpdateCookbook) String() string { return proto.CompactTextString(m) }
+func (*MsgUpdateCookbook) ProtoMessage()    {}
+func (*MsgUpdateCookbook) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2c35d96b13ec9475, []int{80}
+}
+func (m *MsgUpdateCookbook) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgUpdateCookbook) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgUpdateCookbook.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
-			return 0, err
+			return nil, err
 		}
-		i -= size
-		i = encodeVarintTx(dAtA, i, uint64(size))
+		return b[:n], nil
 	}
-	i--
-	dAtA[i] = 0x62
-	if m.BlockInterval != 0 {
-		i = encodeVarintTx(dAtA, i, uint64(m.BlockInterval))
-		i--
-		dAtA[i] = 0x58
+}
+func (m *MsgUpdateCookbook) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgUpdateCookbook.Merge(m, src)
+}
+func (m *MsgUpdateCookbook) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgUpdateCookbook) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgUpdateCookbook.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgUpdateCookbook proto.InternalMessageInfo
+
+func (m *MsgUpdateCookbook) GetCreator() string {
+	if m != nil {
+		return m.Creator
 	}
-	if len(m.Outputs) > 0 {
-		for iNdEx := len(m.Outputs) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Outputs[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintTx(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x52
-		}
+	return ""
+}
+
+func (m *MsgUpdateCookbook) GetId() string {
+	if m != nil {
+		return m.Id
 	}
-	{
-		size, err := m.Entries.MarshalToSizedBuffer(dAtA[:i])
-		if err != nil {
-			return 0, err
-		}
-		i -= size
-		i = encodeVarintTx(dAtA, i, uint64(size))
+	return ""
+}
+
+func (m *MsgUpdateCookbook) GetName() string {
+	if m != nil {
+		return m.Name
 	}
-	i--
-	dAtA[i] = 0x4a
-	if len(m.ItemInputs) > 0 {
-		for iNdEx := len(m.ItemInputs) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.ItemInputs[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintTx(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x42
-		}
+	return ""
+}
+
+func (m *MsgUpdateCookbook) GetDescription() string {
+	if m != nil {
+		return m.Description
 	}
-	if len(m.CoinInputs) > 0 {
-		for iNdEx := len(m.CoinInputs) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.CoinInputs[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintTx(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x3a
-		}
-	}
-	if len(m.Version) > 0 {
-		i -= len(m.Version)
-		copy(dAtA[i:], m.Version)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Version)))
-		i--
-		dAtA[i] = 0x32
-	}
-	if len(m.Description) > 0 {
-		i -= len(m.Description)
-		copy(dAtA[i:], m.Description)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Description)))
-		i--
-		dAtA[i] = 0x2a
-	}
-	if len(m.Name) > 0 {
-		i -= len(m.Name)
-		copy(dAtA[i:], m.Name)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Name)))
-		i--
-		dAtA[i] = 0x22
-	}
-	if len(m.Id) > 0 {
-		i -= len(m.Id)
-		copy(dAtA[i:], m.Id)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Id)))
-		i--
-		dAtA[i] = 0x1a
-	}
-	if len(m.CookbookId) > 0 {
-		i -= len(m.CookbookId)
-		copy(dAtA[i:], m.CookbookId)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.CookbookId)))
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.Creator) > 0 {
-		i -= len(m.Creator)
-		copy(dAtA[i:], m.Creator)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Creator)))
-		i--
-		dAtA[i] = 0xa
-	}
-	return len(dAtA) - i, nil
+	return ""
 }
 
-func (m *MsgUpdateRecipeResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *MsgUpdateCookbook) GetDeveloper() string {
+	if m != nil {
+		return m.Developer
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *MsgUpdateRecipeResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *MsgUpdateCookbook) GetVersion() string {
+	if m != nil {
+		return m.Version
+	}
+	return ""
 }
 
-func (m *MsgUpdateRecipeResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+func (m *MsgUpdateCookbook) GetSupportEmail() string {
+	if m != nil {
+		return m.SupportEmail
+	}
+	return ""
 }
 
-func (m *MsgCreateCookbook) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *MsgUpdateCookbook) GetEnabled() bool {
+	if m != nil {
+		return m.Enabled
 	}
-	return dAtA[:n], nil
+	return false
 }
 
-func (m *MsgCreateCookbook) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *MsgUpdateCookbook) GetBurnRefund() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.BurnRefund
+	}
+	return nil
 }
 
-func (m *MsgCreateCookbook) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Enabled {
-		i--
-		if m.Enabled {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
-		}
-		i--
-		dAtA[i] = 0x40
-	}
-	if len(m.SupportEmail) > 0 {
-		i -= len(m.SupportEmail)
-		copy(dAtA[i:], m.SupportEmail)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.SupportEmail)))
-		i--
-		dAtA[i] = 0x3a
-	}
-	if len(m.Version) > 0 {
-		i -= len(m.Version)
-		copy(dAtA[i:], m.Version)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Version)))
-		i--
-		dAtA[i] = 0x32
-	}
-	if len(m.Developer) > 0 {
-		i -= len(m.Developer)
-		copy(dAtA[i:], m.Developer)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Developer)))
-		i--
-		dAtA[i] = 0x2a
-	}
-	if len(m.Description) > 0 {
-		i -= len(m.Description)
-		copy(dAtA[i:], m.Description)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Description)))
-		i--
-		dAtA[i] = 0x22
-	}
-	if len(m.Name) > 0 {
-		i -= len(m.Name)
-		copy(dAtA[i:], m.Name)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Name)))
-		i--
-		dAtA[i] = 0x1a
-	}
-	if len(m.Id) > 0 {
-		i -= len(m.Id)
-		copy(dAtA[i:], m.Id)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Id)))
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.Creator) > 0 {
-		i -= len(m.Creator)
-		copy(dAtA[i:], m.Creator)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Creator)))
-		i--
-		dAtA[i] = 0xa
+func (m *MsgUpdateCookbook) GetIndexedAttributes() []string {
+	if m != nil {
+		return m.IndexedAttributes
 	}
-	return len(dAtA) - i, nil
+	return nil
 }
 
-func (m *MsgCreateCookbookResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *MsgUpdateCookbook) GetAttributePermissions() []ItemAttributePermission {
+	if m != nil {
+		return m.AttributePermissions
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *MsgCreateCookbookResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *MsgUpdateCookbook) GetAttributeSchema() []ItemAttributeSchema {
+	if m != nil {
+		return m.AttributeSchema
+	}
+	return nil
 }
 
-func (m *MsgCreateCookbookResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+type MsgUpdateCookbookResponse struct {
 }
 
-func (m *MsgUpdateCookbook) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func (m *MsgUpdateCookbookResponse) Reset()         { *m = MsgUpdateCookbookResponse{} }
+func (m *MsgUpdateCookbookResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgUpdateCookbookResponse) ProtoMessage()    {}
+func (*MsgUpdateCookbookResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2c35d96b13ec9475, []int{81}
 }
-
-func (m *MsgUpdateCookbook) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *MsgUpdateCookbookResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-
-func (m *MsgUpdateCookbook) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Enabled {
-		i--
-		if m.Enabled {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
+func (m *MsgUpdateCookbookResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgUpdateCookbookResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i--
-		dAtA[i] = 0x40
-	}
-	if len(m.SupportEmail) > 0 {
-		i -= len(m.SupportEmail)
-		copy(dAtA[i:], m.SupportEmail)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.SupportEmail)))
-		i--
-		dAtA[i] = 0x3a
-	}
-	if len(m.Version) > 0 {
-		i -= len(m.Version)
-		copy(dAtA[i:], m.Version)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Version)))
-		i--
-		dAtA[i] = 0x32
-	}
-	if len(m.Developer) > 0 {
-		i -= len(m.Developer)
-		copy(dAtA[i:], m.Developer)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Developer)))
-		i--
-		dAtA[i] = 0x2a
+		return b[:n], nil
 	}
-	if len(m.Description) > 0 {
-		i -= len(m.Description)
-		copy(dAtA[i:], m.Description)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Description)))
-		i--
-		dAtA[i] = 0x22
-	}
-	if len(m.Name) > 0 {
-		i -= len(m.Name)
-		copy(dAtA[i:], m.Name)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Name)))
-		i--
-		dAtA[i] = 0x1a
-	}
-	if len(m.Id) > 0 {
-		i -= len(m.Id)
-		copy(dAtA[i:], m.Id)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Id)))
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.Creator) > 0 {
-		i -= len(m.Creator)
-		copy(dAtA[i:], m.Creator)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Creator)))
-		i--
-		dAtA[i] = 0xa
-	}
-	return len(dAtA) - i, nil
+}
+func (m *MsgUpdateCookbookResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgUpdateCookbookResponse.Merge(m, src)
+}
+func (m *MsgUpdateCookbookResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgUpdateCookbookResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgUpdateCookbookResponse.DiscardUnknown(m)
 }
 
-func (m *MsgUpdateCookbookResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+var xxx_messageInfo_MsgUpdateCookbookResponse proto.InternalMessageInfo
+
+func init() {
+	proto.RegisterType((*MsgAppleIap)(nil), "pylons.pylons.MsgAppleIap")
+	proto.RegisterType((*MsgAppleIapResponse)(nil), "pylons.pylons.MsgAppleIapResponse")
+	proto.RegisterType((*MsgAddStripeRefund)(nil), "pylons.pylons.MsgAddStripeRefund")
+	proto.RegisterType((*MsgAddStripeRefundResponse)(nil), "pylons.pylons.MsgAddStripeRefundResponse")
+	proto.RegisterType((*MsgBurnDebtToken)(nil), "pylons.pylons.MsgBurnDebtToken")
+	proto.RegisterType((*MsgBurnDebtTokenResponse)(nil), "pylons.pylons.MsgBurnDebtTokenResponse")
+	proto.RegisterType((*MsgUpdateAccount)(nil), "pylons.pylons.MsgUpdateAccount")
+	proto.RegisterType((*MsgUpdateAccountResponse)(nil), "pylons.pylons.MsgUpdateAccountResponse")
+	proto.RegisterType((*MsgCreateAccount)(nil), "pylons.pylons.MsgCreateAccount")
+	proto.RegisterType((*MsgCreateAccountResponse)(nil), "pylons.pylons.MsgCreateAccountResponse")
+	proto.RegisterType((*MsgFulfillTrade)(nil), "pylons.pylons.MsgFulfillTrade")
+	proto.RegisterType((*MsgFulfillTradeResponse)(nil), "pylons.pylons.MsgFulfillTradeResponse")
+	proto.RegisterType((*MsgCreateTrade)(nil), "pylons.pylons.MsgCreateTrade")
+	proto.RegisterType((*MsgCreateTradeResponse)(nil), "pylons.pylons.MsgCreateTradeResponse")
+	proto.RegisterType((*MsgCancelTrade)(nil), "pylons.pylons.MsgCancelTrade")
+	proto.RegisterType((*MsgCancelTradeResponse)(nil), "pylons.pylons.MsgCancelTradeResponse")
+	proto.RegisterType((*MsgCompleteExecutionEarly)(nil), "pylons.pylons.MsgCompleteExecutionEarly")
+	proto.RegisterType((*MsgCompleteExecutionEarlyResponse)(nil), "pylons.pylons.MsgCompleteExecutionEarlyResponse")
+	proto.RegisterType((*MsgTransferCookbook)(nil), "pylons.pylons.MsgTransferCookbook")
+	proto.RegisterType((*MsgTransferCookbookResponse)(nil), "pylons.pylons.MsgTransferCookbookResponse")
+	proto.RegisterType((*MsgGoogleInAppPurchaseGetCoins)(nil), "pylons.pylons.MsgGoogleInAppPurchaseGetCoins")
+	proto.RegisterType((*MsgGoogleInAppPurchaseGetCoinsResponse)(nil), "pylons.pylons.MsgGoogleInAppPurchaseGetCoinsResponse")
+	proto.RegisterType((*MsgSendItems)(nil), "pylons.pylons.MsgSendItems")
+	proto.RegisterType((*MsgSendItemsResponse)(nil), "pylons.pylons.MsgSendItemsResponse")
+	proto.RegisterType((*MsgBurnItems)(nil), "pylons.pylons.MsgBurnItems")
+	proto.RegisterType((*MsgBurnItemsResponse)(nil), "pylons.pylons.MsgBurnItemsResponse")
+	proto.RegisterType((*MsgCreateLending)(nil), "pylons.pylons.MsgCreateLending")
+	proto.RegisterType((*MsgCreateLendingResponse)(nil), "pylons.pylons.MsgCreateLendingResponse")
+	proto.RegisterType((*MsgAcceptLending)(nil), "pylons.pylons.MsgAcceptLending")
+	proto.RegisterType((*MsgAcceptLendingResponse)(nil), "pylons.pylons.MsgAcceptLendingResponse")
+	proto.RegisterType((*MsgCancelLending)(nil), "pylons.pylons.MsgCancelLending")
+	proto.RegisterType((*MsgCancelLendingResponse)(nil), "pylons.pylons.MsgCancelLendingResponse")
+	proto.RegisterType((*MsgCreateAuction)(nil), "pylons.pylons.MsgCreateAuction")
+	proto.RegisterType((*MsgCreateAuctionResponse)(nil), "pylons.pylons.MsgCreateAuctionResponse")
+	proto.RegisterType((*MsgPlaceBid)(nil), "pylons.pylons.MsgPlaceBid")
+	proto.RegisterType((*MsgPlaceBidResponse)(nil), "pylons.pylons.MsgPlaceBidResponse")
+	proto.RegisterType((*MsgCancelAuction)(nil), "pylons.pylons.MsgCancelAuction")
+	proto.RegisterType((*MsgCancelAuctionResponse)(nil), "pylons.pylons.MsgCancelAuctionResponse")
+	proto.RegisterType((*MsgCreateDutchAuction)(nil), "pylons.pylons.MsgCreateDutchAuction")
+	proto.RegisterType((*MsgCreateDutchAuctionResponse)(nil), "pylons.pylons.MsgCreateDutchAuctionResponse")
+	proto.RegisterType((*MsgBuyDutchAuction)(nil), "pylons.pylons.MsgBuyDutchAuction")
+	proto.RegisterType((*MsgBuyDutchAuctionResponse)(nil), "pylons.pylons.MsgBuyDutchAuctionResponse")
+	proto.RegisterType((*MsgCancelDutchAuction)(nil), "pylons.pylons.MsgCancelDutchAuction")
+	proto.RegisterType((*MsgCancelDutchAuctionResponse)(nil), "pylons.pylons.MsgCancelDutchAuctionResponse")
+	proto.RegisterType((*MsgCreateItemOffer)(nil), "pylons.pylons.MsgCreateItemOffer")
+	proto.RegisterType((*MsgCreateItemOfferResponse)(nil), "pylons.pylons.MsgCreateItemOfferResponse")
+	proto.RegisterType((*MsgAcceptItemOffer)(nil), "pylons.pylons.MsgAcceptItemOffer")
+	proto.RegisterType((*MsgAcceptItemOfferResponse)(nil), "pylons.pylons.MsgAcceptItemOfferResponse")
+	proto.RegisterType((*MsgCancelItemOffer)(nil), "pylons.pylons.MsgCancelItemOffer")
+	proto.RegisterType((*MsgCancelItemOfferResponse)(nil), "pylons.pylons.MsgCancelItemOfferResponse")
+	proto.RegisterType((*MsgCreateSwap)(nil), "pylons.pylons.MsgCreateSwap")
+	proto.RegisterType((*MsgCreateSwapResponse)(nil), "pylons.pylons.MsgCreateSwapResponse")
+	proto.RegisterType((*MsgDepositSwap)(nil), "pylons.pylons.MsgDepositSwap")
+	proto.RegisterType((*MsgDepositSwapResponse)(nil), "pylons.pylons.MsgDepositSwapResponse")
+	proto.RegisterType((*MsgAbortSwap)(nil), "pylons.pylons.MsgAbortSwap")
+	proto.RegisterType((*MsgAbortSwapResponse)(nil), "pylons.pylons.MsgAbortSwapResponse")
+	proto.RegisterType((*MsgApproveItem)(nil), "pylons.pylons.MsgApproveItem")
+	proto.RegisterType((*MsgApproveItemResponse)(nil), "pylons.pylons.MsgApproveItemResponse")
+	proto.RegisterType((*MsgRevokeItemApproval)(nil), "pylons.pylons.MsgRevokeItemApproval")
+	proto.RegisterType((*MsgRevokeItemApprovalResponse)(nil), "pylons.pylons.MsgRevokeItemApprovalResponse")
+	proto.RegisterType((*MsgSetOperator)(nil), "pylons.pylons.MsgSetOperator")
+	proto.RegisterType((*MsgSetOperatorResponse)(nil), "pylons.pylons.MsgSetOperatorResponse")
+	proto.RegisterType((*MsgTransferItems)(nil), "pylons.pylons.MsgTransferItems")
+	proto.RegisterType((*MsgTransferItemsResponse)(nil), "pylons.pylons.MsgTransferItemsResponse")
+	proto.RegisterType((*MsgExecuteRecipe)(nil), "pylons.pylons.MsgExecuteRecipe")
+	proto.RegisterType((*MsgExecuteRecipeResponse)(nil), "pylons.pylons.MsgExecuteRecipeResponse")
+	proto.RegisterType((*MsgSetItemString)(nil), "pylons.pylons.MsgSetItemString")
+	proto.RegisterType((*MsgSetItemStringResponse)(nil), "pylons.pylons.MsgSetItemStringResponse")
+	proto.RegisterType((*MsgUpdateItemAttributes)(nil), "pylons.pylons.MsgUpdateItemAttributes")
+	proto.RegisterType((*MsgUpdateItemAttributesResponse)(nil), "pylons.pylons.MsgUpdateItemAttributesResponse")
+	proto.RegisterType((*MsgDepositItems)(nil), "pylons.pylons.MsgDepositItems")
+	proto.RegisterType((*MsgDepositItemsResponse)(nil), "pylons.pylons.MsgDepositItemsResponse")
+	proto.RegisterType((*MsgWithdrawItems)(nil), "pylons.pylons.MsgWithdrawItems")
+	proto.RegisterType((*MsgWithdrawItemsResponse)(nil), "pylons.pylons.MsgWithdrawItemsResponse")
+	proto.RegisterType((*MsgCreateRecipe)(nil), "pylons.pylons.MsgCreateRecipe")
+	proto.RegisterType((*MsgCreateRecipeResponse)(nil), "pylons.pylons.MsgCreateRecipeResponse")
+	proto.RegisterType((*MsgUpdateRecipe)(nil), "pylons.pylons.MsgUpdateRecipe")
+	proto.RegisterType((*MsgUpdateRecipeResponse)(nil), "pylons.pylons.MsgUpdateRecipeResponse")
+	proto.RegisterType((*MsgCreateCookbook)(nil), "pylons.pylons.MsgCreateCookbook")
+	proto.RegisterType((*MsgCreateCookbookResponse)(nil), "pylons.pylons.MsgCreateCookbookResponse")
+	proto.RegisterType((*MsgUpdateCookbook)(nil), "pylons.pylons.MsgUpdateCookbook")
+	proto.RegisterType((*MsgUpdateCookbookResponse)(nil), "pylons.pylons.MsgUpdateCookbookResponse")
 }
 
-func (m *MsgUpdateCookbookResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func init() { proto.RegisterFile("pylons/pylons/tx.proto", fileDescriptor_2c35d96b13ec9475) }
+
+var fileDescriptor_2c35d96b13ec9475 = []byte{
+	// 3095 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xec, 0x3b, 0x4b, 0x73, 0x1b, 0xc7,
+	0xd1, 0x02, 0x01, 0x3e, 0xd0, 0xe0, 0x43, 0x5a, 0x4b, 0x32, 0xb8, 0x14, 0x01, 0x72, 0x2d, 0x89,
+	0x94, 0x3f, 0x09, 0xb4, 0xe4, 0xd7, 0x57, 0x2e, 0xbf, 0x48, 0x89, 0xb6, 0xe1, 0x98, 0x65, 0x16,
+	0xa8, 0xd8, 0x95, 0x94, 0x53, 0xa8, 0xc5, 0xee, 0x10, 0xdc, 0x22, 0xb0, 0xbb, 0x9e, 0x1d, 0x50,
+	0x64, 0x55, 0x0e, 0xa9, 0xf2, 0x29, 0xc9, 0x25, 0xb7, 0x1c, 0xf2, 0x0f, 0xf2, 0x27, 0x52, 0xbe,
+	0xf9, 0x94, 0x72, 0x0e, 0x49, 0xf9, 0x94, 0xa4, 0xec, 0x5f, 0x91, 0x4b, 0x92, 0x9a, 0xc7, 0x0e,
+	0x66, 0x16, 0xbb, 0x0b, 0x90, 0xb2, 0x2a, 0x17, 0x9d, 0x88, 0xed, 0xee, 0xe9, 0xd7, 0xf4, 0xcc,
+	0xf4, 0x74, 0x0f, 0xe1, 0x7a, 0x78, 0xd6, 0x0b, 0xfc, 0x68, 0x4b, 0xfc, 0x21, 0xa7, 0x8d, 0x10,
+	0x07, 0x24, 0x30, 0x16, 0x38, 0xa0, 0xc1, 0xff, 0x98, 0x57, 0xbb, 0x41, 0x37, 0x60, 0x98, 0x2d,
+	0xfa, 0x8b, 0x13, 0x99, 0x35, 0x27, 0x88, 0xfa, 0x41, 0xb4, 0xd5, 0xb1, 0x23, 0xb4, 0x75, 0x72,
+	0xbf, 0x83, 0x88, 0x7d, 0x7f, 0xcb, 0x09, 0x3c, 0x5f, 0xe0, 0xeb, 0x5e, 0xc7, 0xd9, 0x72, 0x02,
+	0x8c, 0xb6, 0x9c, 0x9e, 0x87, 0x7c, 0xb2, 0x75, 0x72, 0x5f, 0xfc, 0x12, 0x04, 0xcb, 0x09, 0xe9,
+	0xd8, 0x76, 0x91, 0x40, 0x55, 0x75, 0x54, 0xf4, 0xc4, 0x0e, 0x05, 0xe6, 0xa6, 0x8e, 0xe9, 0x06,
+	0x41, 0xb7, 0x87, 0xda, 0x9e, 0x1d, 0xb6, 0x03, 0xec, 0x22, 0x2c, 0xa8, 0xd6, 0x74, 0xaa, 0xd0,
+	0x3e, 0xeb, 0x23, 0x9f, 0xb4, 0x3d, 0xff, 0x30, 0xd6, 0xbe, 0xae, 0x53, 0x60, 0xe4, 0x22, 0xd4,
+	0x57, 0x09, 0x56, 0x75, 0x02, 0x74, 0x8a, 0x9c, 0x01, 0xf1, 0x02, 0x3f, 0x5d, 0x43, 0x8f, 0xa0,
+	0xbe, 0xc0, 0x98, 0x49, 0xce, 0x8e, 0x17, 0xc6, 0x76, 0xdd, 0xd0, 0x71, 0x4e, 0x10, 0x1c, 0x77,
+	0x82, 0xe0, 0x98, 0x63, 0xad, 0xdf, 0x17, 0xa0, 0xb2, 0x17, 0x75, 0xb7, 0xc3, 0xb0, 0x87, 0x9a,
+	0x76, 0x68, 0x54, 0x61, 0xd6, 0xc1, 0xc8, 0x26, 0x01, 0xae, 0x16, 0xd6, 0x0a, 0x9b, 0xe5, 0x56,
+	0xfc, 0x69, 0xac, 0x02, 0x84, 0x38, 0x70, 0x07, 0x0e, 0x69, 0x7b, 0x6e, 0x75, 0x8a, 0x21, 0xcb,
+	0x02, 0xd2, 0x74, 0x8d, 0x3a, 0x54, 0xc2, 0x01, 0x76, 0x8e, 0xec, 0x08, 0x51, 0x7c, 0x91, 0xe1,
+	0x21, 0x06, 0x35, 0x5d, 0xa3, 0x01, 0x2f, 0x60, 0xe4, 0x20, 0x2f, 0x24, 0x6d, 0xd7, 0x26, 0x76,
+	0x9b, 0xce, 0xe1, 0x1b, 0xaf, 0x55, 0x4b, 0x8c, 0xf0, 0x8a, 0x40, 0x3d, 0xb2, 0x89, 0xbd, 0xc3,
+	0x10, 0xd6, 0x35, 0x78, 0x41, 0x51, 0xac, 0x85, 0xa2, 0x30, 0xf0, 0x23, 0x64, 0xb9, 0x60, 0x50,
+	0xb0, 0xeb, 0x1e, 0x10, 0xec, 0x85, 0xa8, 0x85, 0x0e, 0x07, 0xbe, 0x9b, 0xa3, 0xf6, 0x6b, 0x30,
+	0x2b, 0xa6, 0x82, 0xe9, 0x5c, 0x79, 0x60, 0x36, 0xb4, 0x48, 0x6b, 0xec, 0x73, 0x6c, 0xd3, 0x3f,
+	0x0c, 0x5a, 0x31, 0xa9, 0x75, 0x03, 0xcc, 0x51, 0x29, 0x52, 0x07, 0x1f, 0x2e, 0xef, 0x45, 0xdd,
+	0x9d, 0x01, 0xf6, 0x1f, 0xa1, 0x0e, 0x79, 0x1c, 0x1c, 0x23, 0x3f, 0x47, 0x83, 0xf7, 0xa1, 0xa2,
+	0x4c, 0xb5, 0xd0, 0x62, 0x39, 0xa1, 0x45, 0x8b, 0x51, 0x50, 0x25, 0x76, 0x4a, 0xdf, 0xfc, 0xbd,
+	0x7e, 0xa9, 0x05, 0x58, 0x42, 0x2c, 0x13, 0xaa, 0x49, 0x79, 0x52, 0x97, 0x8f, 0x98, 0x2e, 0x3f,
+	0x0d, 0x5d, 0x9b, 0xa0, 0x6d, 0xc7, 0x09, 0x06, 0x3e, 0xc9, 0xd1, 0xc5, 0x84, 0xb9, 0x41, 0x84,
+	0xb0, 0x6f, 0xf7, 0x91, 0x98, 0x42, 0xf9, 0x2d, 0xa4, 0x68, 0x9c, 0xa4, 0x94, 0x5f, 0x17, 0x98,
+	0x98, 0x87, 0x18, 0x0d, 0x91, 0x17, 0x13, 0x63, 0x5c, 0x85, 0x69, 0x42, 0x2d, 0x10, 0x21, 0xc2,
+	0x3f, 0x8c, 0x3b, 0x70, 0x19, 0xa3, 0x43, 0x84, 0xb1, 0xdd, 0x6b, 0xdb, 0xae, 0x8b, 0x51, 0x14,
+	0x89, 0xd0, 0x58, 0x8a, 0xe1, 0xdb, 0x1c, 0x2c, 0xf4, 0xd4, 0x54, 0x91, 0x7a, 0x7e, 0x35, 0x05,
+	0x4b, 0x7b, 0x51, 0xf7, 0x83, 0x41, 0xef, 0xd0, 0xeb, 0xf5, 0x1e, 0xd3, 0xe5, 0x9d, 0xa3, 0xe6,
+	0x22, 0x4c, 0x89, 0x50, 0x2e, 0xb5, 0xa6, 0x3c, 0xd7, 0x78, 0x19, 0xae, 0xd0, 0xcd, 0xa4, 0xed,
+	0xf9, 0xe1, 0x80, 0x44, 0x6d, 0xcf, 0x77, 0xd1, 0x29, 0x53, 0xb3, 0xd4, 0x5a, 0xa2, 0x88, 0x26,
+	0x83, 0x37, 0x29, 0xd8, 0x78, 0x00, 0xd3, 0x74, 0x01, 0x52, 0x2d, 0x8b, 0x9b, 0x95, 0x07, 0xd7,
+	0x13, 0xf3, 0xd9, 0x24, 0xa8, 0xdf, 0x42, 0x87, 0x62, 0x32, 0x39, 0xa9, 0xb1, 0x0b, 0x0b, 0xea,
+	0xb6, 0x10, 0x55, 0xa7, 0xd7, 0x8a, 0xf9, 0x11, 0x29, 0xc6, 0xcf, 0x87, 0x43, 0x50, 0x44, 0x97,
+	0x1a, 0xb5, 0xae, 0x6d, 0xf7, 0xa9, 0xed, 0xd5, 0x19, 0xa6, 0x20, 0x50, 0xd0, 0x36, 0x83, 0x58,
+	0xcb, 0xf0, 0x62, 0xc2, 0x09, 0xd2, 0x41, 0xff, 0x29, 0xc2, 0xa2, 0xf4, 0xde, 0x38, 0xff, 0xbc,
+	0x07, 0x15, 0xc5, 0x1f, 0xd5, 0x29, 0xa6, 0x6d, 0x35, 0xa1, 0xed, 0xc3, 0xd8, 0x31, 0x71, 0xe0,
+	0x0e, 0x3d, 0x45, 0x19, 0x50, 0xcb, 0x63, 0x06, 0xc5, 0x54, 0x06, 0xd4, 0x55, 0x1a, 0x03, 0x2f,
+	0x06, 0x44, 0x86, 0x0f, 0xf3, 0x4c, 0x83, 0x60, 0x40, 0x18, 0x07, 0xee, 0xec, 0xe5, 0x06, 0x3f,
+	0x07, 0x1a, 0x74, 0x0f, 0x69, 0x88, 0x73, 0x80, 0x29, 0xb2, 0xf3, 0x0a, 0x65, 0xf1, 0xc7, 0x7f,
+	0xd4, 0x37, 0xbb, 0x1e, 0x39, 0x1a, 0x74, 0x1a, 0x4e, 0xd0, 0xdf, 0x12, 0x87, 0x06, 0xff, 0x73,
+	0x2f, 0x72, 0x8f, 0xb7, 0xc8, 0x59, 0x88, 0xb8, 0xe6, 0x51, 0x8b, 0x99, 0xf8, 0x29, 0xe7, 0x6f,
+	0xbc, 0x07, 0xf3, 0x4c, 0xe1, 0x58, 0xde, 0xf4, 0x04, 0x93, 0xcb, 0x4c, 0x8c, 0x19, 0xac, 0x02,
+	0xa0, 0x53, 0x82, 0x6d, 0xbe, 0xd6, 0x67, 0xf8, 0x2e, 0xc9, 0x20, 0x74, 0xee, 0xe8, 0xc2, 0x60,
+	0x3b, 0xdd, 0x09, 0xc2, 0xd5, 0x59, 0xbe, 0x30, 0xe2, 0x6f, 0x1a, 0x7d, 0xe8, 0x34, 0xf4, 0x30,
+	0x8a, 0xda, 0x36, 0x69, 0x1f, 0x21, 0xaf, 0x7b, 0x44, 0xaa, 0x73, 0x6b, 0x85, 0xcd, 0x62, 0x6b,
+	0x49, 0x20, 0xb6, 0xc9, 0x47, 0x0c, 0xcc, 0xc5, 0xc4, 0xb4, 0xd5, 0x32, 0x23, 0x2a, 0x4b, 0x22,
+	0x2a, 0xe6, 0xcb, 0x81, 0xed, 0x13, 0x8f, 0x9c, 0x55, 0x81, 0x85, 0x87, 0xfc, 0xb6, 0x36, 0xe1,
+	0xba, 0x1e, 0x00, 0x71, 0x6c, 0x88, 0xe5, 0x50, 0x88, 0x97, 0x83, 0xf5, 0x16, 0x0f, 0x15, 0xdb,
+	0x77, 0xd0, 0x79, 0x97, 0x92, 0x55, 0x85, 0xeb, 0xfa, 0x58, 0x19, 0x81, 0xbb, 0xb0, 0x4c, 0x31,
+	0x41, 0x3f, 0xec, 0x21, 0x82, 0x76, 0xe3, 0x33, 0x6e, 0xd7, 0xc6, 0xbd, 0xb3, 0x89, 0x04, 0x94,
+	0x99, 0x80, 0x57, 0x61, 0x3d, 0x93, 0x4d, 0x8a, 0x45, 0x7c, 0xd0, 0x2f, 0xd8, 0x99, 0xf2, 0x18,
+	0xdb, 0x7e, 0x74, 0x88, 0xf0, 0x43, 0x71, 0x14, 0x4e, 0x2e, 0xd5, 0xb8, 0x01, 0x65, 0x76, 0xb8,
+	0x7a, 0xf4, 0x3c, 0xe1, 0x1b, 0xd8, 0x10, 0x60, 0xad, 0xc2, 0x4a, 0x0a, 0x7b, 0x69, 0xf9, 0x9f,
+	0x0b, 0x50, 0xdb, 0x8b, 0xba, 0x1f, 0xb2, 0xfc, 0xa1, 0xe9, 0x6f, 0x87, 0xe1, 0xbe, 0x38, 0x1e,
+	0x3f, 0x44, 0x84, 0x05, 0xe3, 0xc5, 0x8f, 0xdf, 0x5b, 0xb0, 0x28, 0x8f, 0x5f, 0x75, 0x7b, 0x5d,
+	0x88, 0xa1, 0xfc, 0x94, 0x3a, 0xe7, 0x21, 0x4c, 0xed, 0x8d, 0xbc, 0xae, 0x6f, 0x93, 0x01, 0x46,
+	0xd5, 0x69, 0x2e, 0x54, 0x02, 0xac, 0x4d, 0xb8, 0x9d, 0x6f, 0x8f, 0x34, 0xfd, 0x14, 0xe6, 0xf7,
+	0xa2, 0xee, 0x01, 0xf2, 0xdd, 0x26, 0xdb, 0x09, 0x73, 0x8f, 0x0e, 0xb9, 0x42, 0xa6, 0x12, 0x2b,
+	0x44, 0xee, 0xb9, 0xc5, 0x89, 0xf7, 0x5c, 0xeb, 0x3a, 0x5c, 0x55, 0x25, 0x4b, 0x8d, 0xbe, 0x80,
+	0x79, 0x71, 0xa6, 0x8e, 0xd3, 0x48, 0x4a, 0x9d, 0x3a, 0xaf, 0x54, 0xc9, 0x5d, 0x4a, 0xfd, 0x97,
+	0x7a, 0x8e, 0x7e, 0x82, 0x7c, 0xd7, 0xf3, 0xbb, 0x39, 0xa2, 0x5f, 0x81, 0x12, 0xe5, 0x27, 0x72,
+	0x86, 0x7c, 0xc9, 0x8c, 0x92, 0xba, 0xaf, 0x13, 0x60, 0x1c, 0x3c, 0x41, 0x58, 0x44, 0x80, 0xfc,
+	0x36, 0x6c, 0x98, 0x0e, 0xb1, 0xe7, 0xa0, 0x67, 0xb1, 0x8b, 0x72, 0xce, 0x54, 0xbc, 0x3b, 0xc0,
+	0x36, 0x5d, 0x89, 0x2c, 0x5c, 0x8a, 0x2d, 0xf9, 0x6d, 0xbd, 0x0c, 0xd5, 0xa4, 0xe9, 0x99, 0x5b,
+	0xcf, 0xdb, 0xcc, 0x4d, 0xdb, 0x8e, 0x83, 0x42, 0x32, 0xde, 0x4d, 0xc9, 0xcd, 0x87, 0x67, 0x08,
+	0xda, 0x68, 0x39, 0x03, 0x9c, 0x33, 0xdf, 0x98, 0x2e, 0xca, 0x59, 0x1b, 0x2d, 0x39, 0x7f, 0xad,
+	0xe5, 0x48, 0x03, 0x87, 0x1a, 0xfd, 0xe3, 0x86, 0x95, 0xf1, 0x08, 0x16, 0x30, 0x8a, 0x10, 0x3e,
+	0x41, 0x6d, 0x3e, 0x93, 0x45, 0x91, 0x4c, 0x66, 0xce, 0xa4, 0xc8, 0x1f, 0xc4, 0xa8, 0x7d, 0x36,
+	0x49, 0xcb, 0x30, 0x87, 0x7c, 0xb7, 0x4d, 0xbc, 0x3e, 0x62, 0x2b, 0xbf, 0xd8, 0x9a, 0x45, 0xbe,
+	0xfb, 0xd8, 0xeb, 0x23, 0x6d, 0x8e, 0x84, 0x09, 0x99, 0x73, 0x14, 0xb2, 0x9b, 0xc3, 0x7e, 0xcf,
+	0x76, 0xd0, 0x8e, 0xe7, 0x9e, 0x23, 0xcd, 0x7a, 0x13, 0x66, 0x44, 0xea, 0x32, 0xa1, 0xfa, 0x82,
+	0x5c, 0x5c, 0x09, 0x62, 0x89, 0xa9, 0x53, 0x3a, 0xde, 0xef, 0x79, 0x53, 0x9a, 0x30, 0xd9, 0xfa,
+	0x7a, 0x0a, 0xae, 0x49, 0x7f, 0x3c, 0x1a, 0x10, 0xe7, 0xe8, 0xd9, 0xcc, 0xeb, 0xfb, 0x50, 0x89,
+	0x88, 0x8d, 0xc9, 0xf9, 0x66, 0x15, 0xd8, 0x18, 0x3e, 0xa7, 0xef, 0x43, 0xe5, 0xb0, 0x17, 0x04,
+	0xb8, 0x1d, 0xaf, 0xf0, 0xc9, 0x38, 0xb0, 0x31, 0x9c, 0xc3, 0x3a, 0xcc, 0x73, 0x1d, 0x44, 0xe6,
+	0xc1, 0x97, 0x2f, 0xd7, 0x4b, 0xc9, 0x3a, 0x7c, 0x37, 0x26, 0x98, 0x11, 0x59, 0x87, 0xef, 0x0a,
+	0xf4, 0x55, 0x98, 0x76, 0x91, 0x63, 0x9f, 0x89, 0xcc, 0x86, 0x7f, 0x58, 0x5b, 0xb0, 0x9a, 0xea,
+	0xc2, 0xcc, 0xb8, 0xfa, 0x25, 0xbb, 0xe1, 0xed, 0x0c, 0xce, 0x26, 0x74, 0x78, 0x32, 0xbc, 0xde,
+	0x86, 0x72, 0xdf, 0x3e, 0x3d, 0x9f, 0x2b, 0xe7, 0xfa, 0xf6, 0x29, 0x73, 0x83, 0x75, 0x00, 0xe6,
+	0xa8, 0x74, 0xa9, 0xeb, 0xeb, 0xf1, 0x16, 0x5a, 0x98, 0x8c, 0x2f, 0xa7, 0xb6, 0xb6, 0xe1, 0x9a,
+	0x8c, 0xb1, 0x8b, 0x59, 0x65, 0xd5, 0x61, 0x35, 0x95, 0x85, 0x8c, 0xd5, 0xbf, 0x4e, 0x81, 0x21,
+	0x1d, 0x4d, 0xa3, 0xec, 0xd3, 0xc3, 0x43, 0x84, 0x73, 0x24, 0xd4, 0x69, 0x76, 0xcf, 0x53, 0x94,
+	0x61, 0x4a, 0x01, 0x31, 0xa8, 0xe9, 0x1a, 0x2f, 0xc2, 0x2c, 0xcf, 0xde, 0xe3, 0xeb, 0xfc, 0x0c,
+	0xfd, 0x6c, 0xba, 0xc6, 0x3b, 0x00, 0xc3, 0xb4, 0x5e, 0xc4, 0xda, 0xb8, 0xac, 0xbe, 0x2c, 0xb3,
+	0xfa, 0xe1, 0x39, 0x34, 0xfd, 0xcc, 0xce, 0xa1, 0xd4, 0x5c, 0x7a, 0x66, 0x92, 0x5c, 0x7a, 0x36,
+	0x91, 0x4b, 0x5b, 0x77, 0xc1, 0x1c, 0x75, 0x6b, 0x66, 0xf0, 0x7e, 0x0e, 0x86, 0x3c, 0x7a, 0x26,
+	0x99, 0x84, 0x64, 0xf0, 0x66, 0xf9, 0x3c, 0xae, 0x48, 0xe8, 0x8c, 0xe5, 0xe4, 0xbf, 0x0b, 0x86,
+	0x8c, 0x8e, 0x0b, 0x88, 0x15, 0xdc, 0x13, 0xe3, 0x25, 0xf7, 0x08, 0x16, 0xa4, 0x0b, 0x0e, 0x9e,
+	0xe4, 0x56, 0x89, 0x3e, 0x82, 0xf9, 0xd0, 0xc6, 0xc4, 0x73, 0xbc, 0xd0, 0xf6, 0xe5, 0x9d, 0xb1,
+	0x96, 0x08, 0x0e, 0xca, 0x64, 0x7f, 0x48, 0x36, 0xbc, 0xe5, 0x0e, 0x47, 0x5a, 0x1b, 0xca, 0xd6,
+	0x4b, 0xe9, 0xc7, 0x5c, 0x53, 0x1e, 0xa1, 0x30, 0x88, 0x3c, 0x32, 0x46, 0xbd, 0xf4, 0x6b, 0x8a,
+	0x32, 0x56, 0xda, 0xfc, 0xff, 0x2c, 0x3f, 0xdc, 0xee, 0x04, 0xf8, 0xbc, 0x3c, 0x79, 0xee, 0x27,
+	0x47, 0x4a, 0x8e, 0xbf, 0x2a, 0x30, 0x45, 0xb7, 0xc3, 0x10, 0x07, 0x27, 0x2c, 0x94, 0x9e, 0xc9,
+	0xe2, 0x34, 0x61, 0x2e, 0x08, 0x11, 0x66, 0x4c, 0x79, 0x5e, 0x2f, 0xbf, 0x85, 0xb9, 0x8a, 0x06,
+	0x52, 0xb9, 0x63, 0xe6, 0xed, 0x16, 0x3a, 0x09, 0x8e, 0x19, 0x82, 0xd3, 0xd8, 0xbd, 0x67, 0xa1,
+	0xa2, 0xd8, 0xcb, 0x46, 0x85, 0x0d, 0xcb, 0x38, 0xdc, 0x55, 0x07, 0x88, 0x7c, 0x2a, 0x54, 0x7f,
+	0x1a, 0x3d, 0x54, 0x8f, 0x14, 0x75, 0x8f, 0x50, 0x9c, 0xcd, 0xdd, 0xe1, 0x32, 0x6f, 0xcd, 0xb5,
+	0xe4, 0xb7, 0xf0, 0x96, 0xa2, 0x84, 0xd4, 0xef, 0x6f, 0x53, 0x70, 0x59, 0xb9, 0xe9, 0x8d, 0xbb,
+	0x41, 0xd4, 0xa1, 0x12, 0x05, 0x03, 0xec, 0xa0, 0x76, 0x18, 0x60, 0x12, 0x6b, 0xc8, 0x41, 0xfb,
+	0x01, 0x26, 0xf4, 0xf6, 0x26, 0x08, 0x9c, 0x23, 0xdb, 0xf7, 0x51, 0x2f, 0xbe, 0xbd, 0x71, 0xe8,
+	0x43, 0x0e, 0x4c, 0x5a, 0x5a, 0x1a, 0xb1, 0x74, 0x19, 0xe6, 0x84, 0xc7, 0x79, 0xe9, 0xa2, 0xdc,
+	0x9a, 0xe5, 0x2e, 0x8f, 0xb4, 0x7b, 0xd5, 0x4c, 0xe2, 0x5e, 0xf5, 0x21, 0x2c, 0xd2, 0x64, 0x30,
+	0x18, 0xc8, 0xad, 0x72, 0x56, 0x94, 0x4a, 0xbd, 0x8e, 0xd3, 0x70, 0x02, 0x8c, 0x1a, 0xa2, 0x8a,
+	0x7e, 0x72, 0xbf, 0xc1, 0x77, 0x4d, 0xb1, 0x64, 0x17, 0xc4, 0x38, 0x0e, 0x34, 0xfe, 0x0f, 0xae,
+	0xc4, 0x8c, 0xe8, 0xdf, 0x88, 0xd8, 0xfd, 0x90, 0x95, 0x30, 0x4a, 0xad, 0xcb, 0x02, 0xf1, 0x38,
+	0x86, 0x1b, 0x06, 0x94, 0xfa, 0xa8, 0x1f, 0xb0, 0xea, 0x45, 0xb9, 0xc5, 0x7e, 0x5b, 0x6f, 0x40,
+	0x35, 0xe9, 0x57, 0xb9, 0xee, 0x4d, 0x98, 0x8b, 0xd0, 0x97, 0x03, 0xe4, 0x8b, 0xe3, 0xb7, 0xd4,
+	0x92, 0xdf, 0xd6, 0xbf, 0x79, 0xee, 0xcd, 0xcb, 0x00, 0xa8, 0xc5, 0xea, 0xdf, 0x4f, 0x13, 0x32,
+	0x2b, 0xe2, 0x9e, 0xaf, 0xd4, 0xb2, 0xe7, 0x38, 0xa0, 0x99, 0x51, 0x26, 0x2c, 0xa5, 0x97, 0x09,
+	0xeb, 0xc9, 0x19, 0x11, 0x8e, 0x93, 0xf3, 0x32, 0x52, 0x13, 0x9c, 0xb9, 0x48, 0x4d, 0x50, 0x24,
+	0xee, 0x9a, 0xfd, 0x99, 0x55, 0x10, 0x51, 0xcc, 0x3d, 0x40, 0xec, 0x20, 0xa1, 0x15, 0x6e, 0xbf,
+	0xfb, 0x34, 0xce, 0xe2, 0xfc, 0x4b, 0x31, 0x7f, 0x9a, 0x07, 0x1e, 0x7a, 0xa8, 0xe7, 0x8a, 0x82,
+	0x01, 0xff, 0xa0, 0xd0, 0x13, 0xbb, 0x37, 0x40, 0x22, 0xfa, 0xf8, 0x87, 0xc8, 0xbe, 0x35, 0x55,
+	0xe4, 0x2a, 0xfb, 0xc3, 0x14, 0xbc, 0x28, 0x2b, 0xd2, 0x6c, 0x9f, 0x20, 0x04, 0x7b, 0x9d, 0x01,
+	0x41, 0xd1, 0xd3, 0xab, 0x5b, 0x94, 0xea, 0xbe, 0x03, 0xb3, 0x6e, 0x30, 0xe8, 0xf4, 0x50, 0x5c,
+	0x5e, 0x5c, 0x4d, 0xf8, 0xfe, 0x11, 0xc3, 0xfe, 0x04, 0x9d, 0x7d, 0x46, 0x55, 0x8e, 0x27, 0x50,
+	0x8c, 0x31, 0xde, 0x84, 0xe9, 0x5e, 0xe0, 0x77, 0xe3, 0x5a, 0xe1, 0x4a, 0x62, 0xf0, 0x27, 0x81,
+	0xdf, 0x4d, 0x0c, 0xe5, 0xf4, 0x54, 0x6e, 0xc4, 0x0c, 0x8e, 0xe7, 0x3c, 0x29, 0x97, 0xbb, 0x23,
+	0x29, 0x57, 0x8c, 0xb1, 0xd6, 0xa1, 0x9e, 0xe1, 0x1c, 0xe9, 0xc0, 0xdf, 0x14, 0x60, 0x69, 0x78,
+	0xbc, 0x4d, 0xb0, 0x4b, 0xe5, 0x3b, 0x6e, 0x9d, 0x16, 0x63, 0x7d, 0x62, 0x7b, 0x3e, 0xc2, 0xc3,
+	0x75, 0x51, 0x91, 0xb0, 0xc4, 0x06, 0x54, 0xd2, 0x36, 0x20, 0x51, 0x94, 0x56, 0x75, 0x91, 0x7a,
+	0xfe, 0x96, 0x07, 0xe4, 0xe7, 0x1e, 0x39, 0x72, 0xb1, 0xfd, 0xe4, 0x7f, 0xac, 0x28, 0x0f, 0x49,
+	0x4d, 0x19, 0xa9, 0xe9, 0x5f, 0x4a, 0xb0, 0x24, 0xb3, 0x92, 0xa7, 0xdf, 0x66, 0x92, 0xa1, 0x68,
+	0x40, 0x89, 0xf5, 0x4c, 0xf8, 0x5a, 0x62, 0xbf, 0x8d, 0x35, 0xa8, 0xb8, 0x28, 0x72, 0xb0, 0x17,
+	0xca, 0xaa, 0x4a, 0xb9, 0xa5, 0x82, 0xa8, 0x02, 0x27, 0x08, 0x47, 0x14, 0xcb, 0xd7, 0x56, 0xfc,
+	0x99, 0x2c, 0xe0, 0xcf, 0x3e, 0x6d, 0x01, 0x7f, 0xee, 0xdc, 0x05, 0xfc, 0xb7, 0x60, 0x16, 0xf9,
+	0x04, 0x7b, 0x28, 0x62, 0xfb, 0xfc, 0xe8, 0xc6, 0xb6, 0xcb, 0xb1, 0x9f, 0x78, 0x51, 0x3c, 0x3c,
+	0x1e, 0x60, 0xbc, 0x0b, 0xb3, 0x71, 0x1d, 0x1e, 0x52, 0xd3, 0xc8, 0xcf, 0xd9, 0xa9, 0x83, 0x5c,
+	0x51, 0x7c, 0x8f, 0xc7, 0x8b, 0x41, 0xf4, 0x54, 0xed, 0xf4, 0x02, 0xe7, 0xb8, 0xed, 0xf9, 0x04,
+	0xe1, 0x13, 0xbb, 0x57, 0xad, 0xb0, 0xe4, 0x7e, 0x81, 0x41, 0x9b, 0x02, 0x68, 0xec, 0xc2, 0xa2,
+	0x13, 0x44, 0xa4, 0x1d, 0x22, 0xdc, 0x66, 0x98, 0xea, 0xfc, 0x84, 0x55, 0x15, 0x3a, 0x6c, 0x1f,
+	0xe1, 0x1d, 0x3a, 0x88, 0xce, 0x02, 0xf2, 0xed, 0x4e, 0x0f, 0xb9, 0xd5, 0x05, 0x96, 0x48, 0xc4,
+	0x9f, 0x89, 0x9e, 0xc0, 0x62, 0xa2, 0x27, 0x20, 0x16, 0x86, 0x1a, 0x52, 0xc9, 0x70, 0xe3, 0x8b,
+	0xfc, 0x79, 0xb8, 0x3d, 0x0f, 0xb7, 0x1f, 0x2d, 0xdc, 0xd4, 0x90, 0x92, 0xe1, 0xf6, 0xa7, 0x12,
+	0x5c, 0x91, 0xa1, 0x78, 0x81, 0xee, 0x48, 0x1c, 0x4f, 0xc5, 0xec, 0x78, 0x2a, 0x8d, 0xc6, 0xd3,
+	0x0d, 0x28, 0xbb, 0xe8, 0x04, 0xf5, 0x68, 0x4e, 0x1e, 0xf7, 0x18, 0x24, 0x20, 0x27, 0xda, 0x5e,
+	0x82, 0x85, 0x68, 0x10, 0xd2, 0x8c, 0xba, 0x8d, 0xfa, 0xb6, 0xd7, 0x13, 0x65, 0xa7, 0x79, 0x01,
+	0xdc, 0xa5, 0x30, 0xd5, 0x4d, 0x73, 0xba, 0x9b, 0x7a, 0x50, 0xe9, 0x0c, 0xb0, 0xdf, 0xc6, 0xac,
+	0xb7, 0x5f, 0x2d, 0xff, 0xf8, 0xb5, 0x08, 0xa0, 0xfc, 0xc5, 0x03, 0x85, 0x7b, 0x60, 0xb0, 0x3c,
+	0x11, 0xb9, 0x6d, 0x5b, 0x1e, 0xd4, 0x2c, 0xce, 0xca, 0xad, 0x2b, 0x02, 0xa3, 0xa4, 0x37, 0x36,
+	0x5c, 0x93, 0x64, 0x34, 0x52, 0xfa, 0x5e, 0x44, 0x6d, 0x8e, 0xaa, 0x15, 0xa6, 0xe6, 0xed, 0x94,
+	0x25, 0x21, 0x47, 0xef, 0x4b, 0x72, 0x11, 0x38, 0x57, 0xed, 0x51, 0x54, 0x64, 0x1c, 0xc0, 0xe5,
+	0xa1, 0x88, 0xc8, 0x39, 0x42, 0x7d, 0xbb, 0x3a, 0xcf, 0xb8, 0x5b, 0x79, 0xdc, 0x0f, 0x18, 0xa5,
+	0xe0, 0xbc, 0x64, 0xeb, 0x60, 0x6b, 0x85, 0x37, 0xf7, 0xb4, 0x00, 0x4a, 0x86, 0x17, 0x0f, 0xbd,
+	0xe7, 0xe1, 0xf5, 0x3c, 0xbc, 0x2e, 0x18, 0x5e, 0x7a, 0x00, 0xc5, 0xe1, 0xf5, 0xe0, 0xbb, 0x55,
+	0x28, 0xee, 0x45, 0x5d, 0xe3, 0x63, 0x98, 0x93, 0xcf, 0x99, 0x92, 0xdb, 0xbf, 0xf2, 0xa2, 0xc8,
+	0xb4, 0xb2, 0x71, 0x31, 0x4f, 0xa3, 0x0d, 0x4b, 0xc9, 0xa7, 0x46, 0xeb, 0x29, 0xc3, 0x74, 0x12,
+	0xf3, 0xce, 0x58, 0x12, 0x29, 0xe0, 0x67, 0xb0, 0xa0, 0xbf, 0x23, 0xaa, 0x8f, 0x8e, 0xd5, 0x08,
+	0xcc, 0x8d, 0x31, 0x04, 0x2a, 0x6b, 0xfd, 0x59, 0x50, 0x0a, 0x6b, 0x8d, 0xc0, 0xdc, 0x18, 0x43,
+	0x20, 0x59, 0x7f, 0x06, 0xf3, 0xda, 0x13, 0x9b, 0xda, 0xe8, 0x40, 0x15, 0x6f, 0xde, 0xce, 0xc7,
+	0x4b, 0xbe, 0x07, 0x50, 0x51, 0x5f, 0xa6, 0xac, 0x8e, 0x0e, 0x53, 0xd0, 0xe6, 0xad, 0x5c, 0xb4,
+	0xc6, 0x54, 0x79, 0xc3, 0x90, 0xc6, 0x74, 0x88, 0x36, 0x6f, 0xe5, 0xa2, 0x25, 0x53, 0x02, 0xd7,
+	0x33, 0x9e, 0x30, 0x6c, 0xa6, 0x30, 0x48, 0xa5, 0x34, 0x5f, 0x99, 0x94, 0x52, 0x4a, 0xed, 0xc0,
+	0xe5, 0x91, 0xc7, 0x0b, 0x29, 0x61, 0x9c, 0xa4, 0x31, 0x5f, 0x1e, 0x4f, 0x23, 0x65, 0x7c, 0x55,
+	0x80, 0x95, 0xbc, 0x27, 0x0a, 0xf7, 0x46, 0x79, 0xe5, 0x90, 0x9b, 0xaf, 0x9f, 0x8b, 0x5c, 0x0d,
+	0x5e, 0xfd, 0xb1, 0x59, 0x3d, 0x6b, 0xb2, 0x73, 0x82, 0x37, 0xf5, 0x8d, 0x98, 0xb1, 0x07, 0xe5,
+	0xe1, 0x43, 0x84, 0x95, 0xd1, 0x51, 0x12, 0x69, 0xbe, 0x94, 0x83, 0x54, 0xd9, 0x0d, 0x5f, 0x11,
+	0xac, 0xa4, 0x2f, 0xce, 0x4c, 0x76, 0x23, 0x2f, 0x04, 0x86, 0x86, 0xc7, 0xcd, 0xe9, 0x4c, 0xc3,
+	0x05, 0x81, 0xb9, 0x31, 0x86, 0x40, 0x65, 0xad, 0x77, 0xd4, 0x53, 0x58, 0x6b, 0x04, 0xe6, 0xc6,
+	0x18, 0x02, 0x4d, 0x6b, 0xad, 0xa5, 0x5e, 0xcf, 0x5a, 0x46, 0x79, 0x5a, 0xa7, 0xb5, 0xd5, 0x95,
+	0x48, 0x10, 0x3d, 0xb3, 0xec, 0x48, 0xe0, 0x04, 0xe6, 0xc6, 0x18, 0x02, 0xc9, 0xfa, 0x63, 0x98,
+	0x93, 0xed, 0xeb, 0x94, 0x93, 0x22, 0xc6, 0x99, 0x56, 0x36, 0x6e, 0xd4, 0x03, 0x79, 0x6a, 0xaa,
+	0x04, 0xe6, 0xc6, 0x18, 0x02, 0xc9, 0xfa, 0x08, 0x8c, 0x94, 0x0e, 0xf4, 0xcd, 0x2c, 0x2b, 0x55,
+	0x2a, 0xf3, 0xee, 0x24, 0x54, 0xea, 0x71, 0x97, 0xec, 0xbb, 0xae, 0xa7, 0x05, 0xad, 0x46, 0x62,
+	0xde, 0x19, 0x4b, 0xa2, 0x99, 0x32, 0xda, 0x05, 0xbd, 0x99, 0xe5, 0x89, 0xb1, 0xa6, 0x64, 0xb6,
+	0x43, 0xa9, 0x29, 0xc9, 0x56, 0xe8, 0x7a, 0x96, 0x2f, 0x24, 0x89, 0x79, 0x67, 0x2c, 0x89, 0x96,
+	0x1a, 0x24, 0xda, 0x7c, 0xeb, 0x59, 0xcb, 0x25, 0x57, 0x40, 0x46, 0x4f, 0x8f, 0x59, 0x90, 0x68,
+	0xe8, 0xad, 0x67, 0xb9, 0x20, 0xdf, 0x82, 0xf4, 0xb6, 0x9e, 0xb1, 0x0f, 0xa0, 0xf4, 0xf4, 0x6e,
+	0x64, 0x99, 0x4e, 0xb1, 0xe6, 0xcd, 0x3c, 0xac, 0x7a, 0xd4, 0xaa, 0x7d, 0xb8, 0x94, 0xa3, 0x56,
+	0x41, 0x9b, 0xb7, 0x72, 0xd1, 0xea, 0x06, 0x3b, 0x6c, 0xc3, 0xa5, 0x6c, 0xb0, 0x12, 0x69, 0xbe,
+	0x94, 0x83, 0x54, 0x75, 0x54, 0x5b, 0x70, 0xab, 0xa9, 0x59, 0x60, 0x8c, 0x36, 0x6f, 0xe5, 0xa2,
+	0xd5, 0xb8, 0x4e, 0xe9, 0x9d, 0xa5, 0x38, 0x6d, 0x94, 0xca, 0xbc, 0x3b, 0x09, 0x95, 0xaa, 0xbe,
+	0xda, 0x16, 0x5b, 0x4d, 0x3b, 0xa2, 0x24, 0xda, 0xbc, 0x95, 0x8b, 0x56, 0x37, 0x2f, 0xbd, 0x97,
+	0x55, 0xcf, 0x4e, 0x18, 0xf8, 0x59, 0xb6, 0x31, 0x86, 0x40, 0x65, 0xad, 0x77, 0x65, 0x52, 0x58,
+	0x6b, 0x04, 0xe6, 0xc6, 0x18, 0x02, 0x95, 0xb5, 0xde, 0xc3, 0xa8, 0xa7, 0x5a, 0x3b, 0x24, 0x30,
+	0x37, 0xc6, 0x10, 0x48, 0xd6, 0x3e, 0x5c, 0x4d, 0x6d, 0x3b, 0xdc, 0xce, 0xca, 0x90, 0x75, 0x3a,
+	0xb3, 0x31, 0x19, 0x9d, 0x9a, 0x50, 0x6b, 0x55, 0xfa, 0x5a, 0xe6, 0xd2, 0xe0, 0xee, 0xbf, 0x9d,
+	0x8f, 0x57, 0x5d, 0xa4, 0x57, 0xd5, 0x53, 0x5c, 0xa4, 0x11, 0x98, 0x1b, 0x63, 0x08, 0x54, 0x95,
+	0xb5, 0x32, 0x78, 0x2d, 0x6b, 0x87, 0x10, 0xd3, 0x7a, 0x3b, 0x1f, 0xaf, 0xf2, 0xd5, 0xea, 0x9d,
+	0xb5, 0x2c, 0x57, 0x66, 0xf3, 0x4d, 0x2b, 0x6e, 0x19, 0x5f, 0xc0, 0x62, 0xa2, 0xb0, 0xb5, 0x96,
+	0xa5, 0x91, 0xcc, 0x9b, 0x37, 0xc7, 0x51, 0xa8, 0xdc, 0x13, 0x75, 0x8d, 0xb5, 0x2c, 0xbd, 0xf2,
+	0xb8, 0xa7, 0x5f, 0x6d, 0x77, 0x3e, 0xf8, 0xe6, 0xfb, 0x5a, 0xe1, 0xdb, 0xef, 0x6b, 0x85, 0x7f,
+	0x7e, 0x5f, 0x2b, 0xfc, 0xee, 0x87, 0xda, 0xa5, 0x6f, 0x7f, 0xa8, 0x5d, 0xfa, 0xee, 0x87, 0xda,
+	0xa5, 0x9f, 0xdf, 0x55, 0xca, 0x05, 0xfb, 0x8c, 0xcd, 0x3d, 0x82, 0x9c, 0xa3, 0xf8, 0xbf, 0x7d,
+	0x4e, 0xe3, 0x1f, 0xac, 0x70, 0xd0, 0x99, 0x61, 0xff, 0xf4, 0xf3, 0xea, 0x7f, 0x07, 0x00, 0xfe,
+	0xb8, 0xdb, 0xa9, 0x85, 0x35, 0x00, 0x00,
 }
 
-func (m *MsgUpdateCookbookResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// MsgClient is the client API for Msg service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type MsgClient interface {
+	AppleIap(ctx context.Context, in *MsgAppleIap, opts ...grpc.CallOption) (*MsgAppleIapResponse, error)
+	AddStripeRefund(ctx context.Context, in *MsgAddStripeRefund, opts ...grpc.CallOption) (*MsgAddStripeRefundResponse, error)
+	// this line is used by starport scaffolding # proto/tx/rpc
+	BurnDebtToken(ctx context.Context, in *MsgBurnDebtToken, opts ...grpc.CallOption) (*MsgBurnDebtTokenResponse, error)
+	UpdateAccount(ctx context.Context, in *MsgUpdateAccount, opts ...grpc.CallOption) (*MsgUpdateAccountResponse, error)
+	FulfillTrade(ctx context.Context, in *MsgFulfillTrade, opts ...grpc.CallOption) (*MsgFulfillTradeResponse, error)
+	CreateTrade(ctx context.Context, in *MsgCreateTrade, opts ...grpc.CallOption) (*MsgCreateTradeResponse, error)
+	CancelTrade(ctx context.Context, in *MsgCancelTrade, opts ...grpc.CallOption) (*MsgCancelTradeResponse, error)
+	CompleteExecutionEarly(ctx context.Context, in *MsgCompleteExecutionEarly, opts ...grpc.CallOption) (*MsgCompleteExecutionEarlyResponse, error)
+	TransferCookbook(ctx context.Context, in *MsgTransferCookbook, opts ...grpc.CallOption) (*MsgTransferCookbookResponse, error)
+	GoogleInAppPurchaseGetCoins(ctx context.Context, in *MsgGoogleInAppPurchaseGetCoins, opts ...grpc.CallOption) (*MsgGoogleInAppPurchaseGetCoinsResponse, error)
+	CreateAccount(ctx context.Context, in *MsgCreateAccount, opts ...grpc.CallOption) (*MsgCreateAccountResponse, error)
+	SendItems(ctx context.Context, in *MsgSendItems, opts ...grpc.CallOption) (*MsgSendItemsResponse, error)
+	BurnItems(ctx context.Context, in *MsgBurnItems, opts ...grpc.CallOption) (*MsgBurnItemsResponse, error)
+	CreateLending(ctx context.Context, in *MsgCreateLending, opts ...grpc.CallOption) (*MsgCreateLendingResponse, error)
+	AcceptLending(ctx context.Context, in *MsgAcceptLending, opts ...grpc.CallOption) (*MsgAcceptLendingResponse, error)
+	CancelLending(ctx context.Context, in *MsgCancelLending, opts ...grpc.CallOption) (*MsgCancelLendingResponse, error)
+	CreateAuction(ctx context.Context, in *MsgCreateAuction, opts ...grpc.CallOption) (*MsgCreateAuctionResponse, error)
+	PlaceBid(ctx context.Context, in *MsgPlaceBid, opts ...grpc.CallOption) (*MsgPlaceBidResponse, error)
+	CancelAuction(ctx context.Context, in *MsgCancelAuction, opts ...grpc.CallOption) (*MsgCancelAuctionResponse, error)
+	CreateDutchAuction(ctx context.Context, in *MsgCreateDutchAuction, opts ...grpc.CallOption) (*MsgCreateDutchAuctionResponse, error)
+	BuyDutchAuction(ctx context.Context, in *MsgBuyDutchAuction, opts ...grpc.CallOption) (*MsgBuyDutchAuctionResponse, error)
+	CancelDutchAuction(ctx context.Context, in *MsgCancelDutchAuction, opts ...grpc.CallOption) (*MsgCancelDutchAuctionResponse, error)
+	CreateItemOffer(ctx context.Context, in *MsgCreateItemOffer, opts ...grpc.CallOption) (*MsgCreateItemOfferResponse, error)
+	AcceptItemOffer(ctx context.Context, in *MsgAcceptItemOffer, opts ...grpc.CallOption) (*MsgAcceptItemOfferResponse, error)
+	CancelItemOffer(ctx context.Context, in *MsgCancelItemOffer, opts ...grpc.CallOption) (*MsgCancelItemOfferResponse, error)
+	CreateSwap(ctx context.Context, in *MsgCreateSwap, opts ...grpc.CallOption) (*MsgCreateSwapResponse, error)
+	DepositSwap(ctx context.Context, in *MsgDepositSwap, opts ...grpc.CallOption) (*MsgDepositSwapResponse, error)
+	AbortSwap(ctx context.Context, in *MsgAbortSwap, opts ...grpc.CallOption) (*MsgAbortSwapResponse, error)
+	ApproveItem(ctx context.Context, in *MsgApproveItem, opts ...grpc.CallOption) (*MsgApproveItemResponse, error)
+	RevokeItemApproval(ctx context.Context, in *MsgRevokeItemApproval, opts ...grpc.CallOption) (*MsgRevokeItemApprovalResponse, error)
+	SetOperator(ctx context.Context, in *MsgSetOperator, opts ...grpc.CallOption) (*MsgSetOperatorResponse, error)
+	TransferItems(ctx context.Context, in *MsgTransferItems, opts ...grpc.CallOption) (*MsgTransferItemsResponse, error)
+	ExecuteRecipe(ctx context.Context, in *MsgExecuteRecipe, opts ...grpc.CallOption) (*MsgExecuteRecipeResponse, error)
+	SetItemString(ctx context.Context, in *MsgSetItemString, opts ...grpc.CallOption) (*MsgSetItemStringResponse, error)
+	UpdateItemAttributes(ctx context.Context, in *MsgUpdateItemAttributes, opts ...grpc.CallOption) (*MsgUpdateItemAttributesResponse, error)
+	DepositItems(ctx context.Context, in *MsgDepositItems, opts ...grpc.CallOption) (*MsgDepositItemsResponse, error)
+	WithdrawItems(ctx context.Context, in *MsgWithdrawItems, opts ...grpc.CallOption) (*MsgWithdrawItemsResponse, error)
+	CreateRecipe(ctx context.Context, in *MsgCreateRecipe, opts ...grpc.CallOption) (*MsgCreateRecipeResponse, error)
+	UpdateRecipe(ctx context.Context, in *MsgUpdateRecipe, opts ...grpc.CallOption) (*MsgUpdateRecipeResponse, error)
+	CreateCookbook(ctx context.Context, in *MsgCreateCookbook, opts ...grpc.CallOption) (*MsgCreateCookbookResponse, error)
+	UpdateCookbook(ctx context.Context, in *MsgUpdateCookbook, opts ...grpc.CallOption) (*MsgUpdateCookbookResponse, error)
 }
 
-func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
-	offset -= sovTx(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
-	}
-	dAtA[offset] = uint8(v)
-	return base
+type msgClient struct {
+	cc grpc1.ClientConn
 }
-func (m *MsgAppleIap) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Creator)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	l = len(m.ProductId)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	l = len(m.PurchaseId)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	l = len(m.ReceiptDataBase64)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	return n
+
+func NewMsgClient(cc grpc1.ClientConn) MsgClient {
+	return &msgClient{cc}
 }
 
-func (m *MsgAppleIapResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (c *msgClient) AppleIap(ctx context.Context, in *MsgAppleIap, opts ...grpc.CallOption) (*MsgAppleIapResponse, error) {
+	out := new(MsgAppleIapResponse)
+	err := c.cc.Invoke(ctx, "/pylons.pylons.Msg/AppleIap", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	return n
+	return out, nil
 }
 
-func (m *MsgAddStripeRefund) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Creator)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	if m.Payment != nil {
-		l = m.Payment.Size()
-		n += 1 + l + sovTx(uint64(l))
+func (c *msgClient) AddStripeRefund(ctx context.Context, in *MsgAddStripeRefund, opts ...grpc.CallOption) (*MsgAddStripeRefundResponse, error) {
+	out := new(MsgAddStripeRefundResponse)
+	err := c.cc.Invoke(ctx, "/pylons.pylons.Msg/AddStripeRefund", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return out, nil
 }
 
-func (m *MsgAddStripeRefundResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (c *msgClient) BurnDebtToken(ctx context.Context, in *MsgBurnDebtToken, opts ...grpc.CallOption) (*MsgBurnDebtTokenResponse, error) {
+	out := new(MsgBurnDebtTokenResponse)
+	err := c.cc.Invoke(ctx, "/pylons.pylons.Msg/BurnDebtToken", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	return n
+	return out, nil
 }
 
-func (m *MsgBurnDebtToken) Size() (n int) {
-	if m == nil {
-		return 0
+func (c *msgClient) UpdateAccount(ctx context.Context, in *MsgUpdateAccount, opts ...grpc.CallOption) (*MsgUpdateAccountResponse, error) {
+	out := new(MsgUpdateAccountResponse)
+	err := c.cc.Invoke(ctx, "/pylons.pylons.Msg/UpdateAccount", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	l = len(m.Creator)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	return out, nil
+}
+
+func (c *msgClient) FulfillTrade(ctx context.Context, in *MsgFulfillTrade, opts ...grpc.CallOption) (*MsgFulfillTradeResponse, error) {
+	out := new(MsgFulfillTradeResponse)
+	err := c.cc.Invoke(ctx, "/pylons.pylons.Msg/FulfillTrade", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	l = m.RedeemInfo.Size()
-	n += 1 + l + sovTx(uint64(l))
-	return n
+	return out, nil
 }
 
-func (m *MsgBurnDebtTokenResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (c *msgClient) CreateTrade(ctx context.Context, in *MsgCreateTrade, opts ...grpc.CallOption) (*MsgCreateTradeResponse, error) {
+	out := new(MsgCreateTradeResponse)
+	err := c.cc.Invoke(ctx, "/pylons.pylons.Msg/CreateTrade", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	return n
+	return out, nil
 }
 
-func (m *MsgUpdateAccount) Size() (n int) {
-	if m == nil {
-		return 0
+func (c *msgClient) CancelTrade(ctx context.Context, in *MsgCancelTrade, opts ...grpc.CallOption) (*MsgCancelTradeResponse, error) {
+	out := new(MsgCancelTradeResponse)
+	err := c.cc.Invoke(ctx, "/pylons.pylons.Msg/CancelTrade", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	l = len(m.Creator)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	l = len(m.Username)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	return n
+	return out, nil
 }
 
-func (m *MsgUpdateAccountResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (c *msgClient) CompleteExecutionEarly(ctx context.Context, in *MsgCompleteExecutionEarly, opts ...grpc.CallOption) (*MsgCompleteExecutionEarlyResponse, error) {
+	out := new(MsgCompleteExecutionEarlyResponse)
+	err := c.cc.Invoke(ctx, "/pylons.pylons.Msg/CompleteExecutionEarly", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	return n
+	return out, nil
 }
 
-func (m *MsgCreateAccount) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Creator)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	l = len(m.Username)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+func (c *msgClient) TransferCookbook(ctx context.Context, in *MsgTransferCookbook, opts ...grpc.CallOption) (*MsgTransferCookbookResponse, error) {
+	out := new(MsgTransferCookbookResponse)
+	err := c.cc.Invoke(ctx, "/pylons.pylons.Msg/TransferCookbook", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	l = len(m.Token)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	return out, nil
+}
+
+func (c *msgClient) GoogleInAppPurchaseGetCoins(ctx context.Context, in *MsgGoogleInAppPurchaseGetCoins, opts ...grpc.CallOption) (*MsgGoogleInAppPurchaseGetCoinsResponse, error) {
+	out := new(MsgGoogleInAppPurchaseGetCoinsResponse)
+	err := c.cc.Invoke(ctx, "/pylons.pylons.Msg/GoogleInAppPurchaseGetCoins", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	l = len(m.ReferralAddress)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	return out, nil
+}
+
+func (c *msgClient) CreateAccount(ctx context.Context, in *MsgCreateAccount, opts ...grpc.CallOption) (*MsgCreateAccountResponse, error) {
+	out := new(MsgCreateAccountResponse)
+	err := c.cc.Invoke(ctx, "/pylons.pylons.Msg/CreateAccount", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return out, nil
 }
 
-func (m *MsgCreateAccountResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (c *msgClient) SendItems(ctx context.Context, in *MsgSendItems, opts ...grpc.CallOption) (*MsgSendItemsResponse, error) {
+	out := new(MsgSendItemsResponse)
+	err := c.cc.Invoke(ctx, "/pylons.pylons.Msg/SendItems", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	return n
+	return out, nil
 }
 
-func (m *MsgFulfillTrade) Size() (n int) {
-	if m == nil {
-		return 0
+func (c *msgClient) BurnItems(ctx context.Context, in *MsgBurnItems, opts ...grpc.CallOption) (*MsgBurnItemsResponse, error) {
+	out := new(MsgBurnItemsResponse)
+	err := c.cc.Invoke(ctx, "/pylons.pylons.Msg/BurnItems", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	l = len(m.Creator)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	return out, nil
+}
+
+func (c *msgClient) CreateLending(ctx context.Context, in *MsgCreateLending, opts ...grpc.CallOption) (*MsgCreateLendingResponse, error) {
+	out := new(MsgCreateLendingResponse)
+	err := c.cc.Invoke(ctx, "/pylons.pylons.Msg/CreateLending", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	if m.Id != 0 {
-		n += 1 + sovTx(uint64(m.Id))
+	return out, nil
+}
+
+func (c *msgClient) AcceptLending(ctx context.Context, in *MsgAcceptLending, opts ...grpc.CallOption) (*MsgAcceptLendingResponse, error) {
+	out := new(MsgAcceptLendingResponse)
+	err := c.cc.Invoke(ctx, "/pylons.pylons.Msg/AcceptLending", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	if m.CoinInputsIndex != 0 {
-		n += 1 + sovTx(uint64(m.CoinInputsIndex))
+	return out, nil
+}
+
+func (c *msgClient) CancelLending(ctx context.Context, in *MsgCancelLending, opts ...grpc.CallOption) (*MsgCancelLendingResponse, error) {
+	out := new(MsgCancelLendingResponse)
+	err := c.cc.Invoke(ctx, "/pylons.pylons.Msg/CancelLending", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	if len(m.Items) > 0 {
-		for _, e := range m.Items {
-			l = e.Size()
-			n += 1 + l + sovTx(uint64(l))
-		}
+	return out, nil
+}
+
+func (c *msgClient) CreateAuction(ctx context.Context, in *MsgCreateAuction, opts ...grpc.CallOption) (*MsgCreateAuctionResponse, error) {
+	out := new(MsgCreateAuctionResponse)
+	err := c.cc.Invoke(ctx, "/pylons.pylons.Msg/CreateAuction", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	if len(m.PaymentInfos) > 0 {
-		for _, e := range m.PaymentInfos {
-			l = e.Size()
-			n += 1 + l + sovTx(uint64(l))
-		}
+	return out, nil
+}
+
+func (c *msgClient) PlaceBid(ctx context.Context, in *MsgPlaceBid, opts ...grpc.CallOption) (*MsgPlaceBidResponse, error) {
+	out := new(MsgPlaceBidResponse)
+	err := c.cc.Invoke(ctx, "/pylons.pylons.Msg/PlaceBid", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return out, nil
 }
 
-func (m *MsgFulfillTradeResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (c *msgClient) CancelAuction(ctx context.Context, in *MsgCancelAuction, opts ...grpc.CallOption) (*MsgCancelAuctionResponse, error) {
+	out := new(MsgCancelAuctionResponse)
+	err := c.cc.Invoke(ctx, "/pylons.pylons.Msg/CancelAuction", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	return n
+	return out, nil
 }
 
-func (m *MsgCreateTrade) Size() (n int) {
-	if m == nil {
-		return 0
+func (c *msgClient) CreateDutchAuction(ctx context.Context, in *MsgCreateDutchAuction, opts ...grpc.CallOption) (*MsgCreateDutchAuctionResponse, error) {
+	out := new(MsgCreateDutchAuctionResponse)
+	err := c.cc.Invoke(ctx, "/pylons.pylons.Msg/CreateDutchAuction", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	l = len(m.Creator)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	return out, nil
+}
+
+func (c *msgClient) BuyDutchAuction(ctx context.Context, in *MsgBuyDutchAuction, opts ...grpc.CallOption) (*MsgBuyDutchAuctionResponse, error) {
+	out := new(MsgBuyDutchAuctionResponse)
+	err := c.cc.Invoke(ctx, "/pylons.pylons.Msg/BuyDutchAuction", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	if len(m.CoinInputs) > 0 {
-		for _, e := range m.CoinInputs {
-			l = e.Size()
-			n += 1 + l + sovTx(uint64(l))
-		}
+	return out, nil
+}
+
+func (c *msgClient) CancelDutchAuction(ctx context.Context, in *MsgCancelDutchAuction, opts ...grpc.CallOption) (*MsgCancelDutchAuctionResponse, error) {
+	out := new(MsgCancelDutchAuctionResponse)
+	err := c.cc.Invoke(ctx, "/pylons.pylons.Msg/CancelDutchAuction", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	if len(m.ItemInputs) > 0 {
-		for _, e := range m.ItemInputs {
-			l = e.Size()
-			n += 1 + l + sovTx(uint64(l))
-		}
+	return out, nil
+}
+
+func (c *msgClient) CreateItemOffer(ctx context.Context, in *MsgCreateItemOffer, opts ...grpc.CallOption) (*MsgCreateItemOfferResponse, error) {
+	out := new(MsgCreateItemOfferResponse)
+	err := c.cc.Invoke(ctx, "/pylons.pylons.Msg/CreateItemOffer", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	if len(m.CoinOutputs) > 0 {
-		for _, e := range m.CoinOutputs {
-			l = e.Size()
-			n += 1 + l + sovTx(uint64(l))
-		}
+	return out, nil
+}
+
+func (c *msgClient) AcceptItemOffer(ctx context.Context, in *MsgAcceptItemOffer, opts ...grpc.CallOption) (*MsgAcceptItemOfferResponse, error) {
+	out := new(MsgAcceptItemOfferResponse)
+	err := c.cc.Invoke(ctx, "/pylons.pylons.Msg/AcceptItemOffer", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	if len(m.ItemOutputs) > 0 {
-		for _, e := range m.ItemOutputs {
-			l = e.Size()
-			n += 1 + l + sovTx(uint64(l))
-		}
+	return out, nil
+}
+
+func (c *msgClient) CancelItemOffer(ctx context.Context, in *MsgCancelItemOffer, opts ...grpc.CallOption) (*MsgCancelItemOfferResponse, error) {
+	out := new(MsgCancelItemOfferResponse)
+	err := c.cc.Invoke(ctx, "/pylons.pylons.Msg/CancelItemOffer", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	l = len(m.ExtraInfo)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	return out, nil
+}
+
+func (c *msgClient) CreateSwap(ctx context.Context, in *MsgCreateSwap, opts ...grpc.CallOption) (*MsgCreateSwapResponse, error) {
+	out := new(MsgCreateSwapResponse)
+	err := c.cc.Invoke(ctx, "/pylons.pylons.Msg/CreateSwap", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return out, nil
 }
 
-func (m *MsgCreateTradeResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (c *msgClient) DepositSwap(ctx context.Context, in *MsgDepositSwap, opts ...grpc.CallOption) (*MsgDepositSwapResponse, error) {
+	out := new(MsgDepositSwapResponse)
+	err := c.cc.Invoke(ctx, "/pylons.pylons.Msg/DepositSwap", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	if m.Id != 0 {
-		n += 1 + sovTx(uint64(m.Id))
+	return out, nil
+}
+
+func (c *msgClient) AbortSwap(ctx context.Context, in *MsgAbortSwap, opts ...grpc.CallOption) (*MsgAbortSwapResponse, error) {
+	out := new(MsgAbortSwapResponse)
+	err := c.cc.Invoke(ctx, "/pylons.pylons.Msg/AbortSwap", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return out, nil
 }
 
-func (m *MsgCancelTrade) Size() (n int) {
-	if m == nil {
-		return 0
+func (c *msgClient) ApproveItem(ctx context.Context, in *MsgApproveItem, opts ...grpc.CallOption) (*MsgApproveItemResponse, error) {
+	out := new(MsgApproveItemResponse)
+	err := c.cc.Invoke(ctx, "/pylons.pylons.Msg/ApproveItem", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	l = len(m.Creator)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	return out, nil
+}
+
+func (c *msgClient) RevokeItemApproval(ctx context.Context, in *MsgRevokeItemApproval, opts ...grpc.CallOption) (*MsgRevokeItemApprovalResponse, error) {
+	out := new(MsgRevokeItemApprovalResponse)
+	err := c.cc.Invoke(ctx, "/pylons.pylons.Msg/RevokeItemApproval", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	if m.Id != 0 {
-		n += 1 + sovTx(uint64(m.Id))
+	return out, nil
+}
+
+func (c *msgClient) SetOperator(ctx context.Context, in *MsgSetOperator, opts ...grpc.CallOption) (*MsgSetOperatorResponse, error) {
+	out := new(MsgSetOperatorResponse)
+	err := c.cc.Invoke(ctx, "/pylons.pylons.Msg/SetOperator", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return out, nil
 }
 
-func (m *MsgCancelTradeResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (c *msgClient) TransferItems(ctx context.Context, in *MsgTransferItems, opts ...grpc.CallOption) (*MsgTransferItemsResponse, error) {
+	out := new(MsgTransferItemsResponse)
+	err := c.cc.Invoke(ctx, "/pylons.pylons.Msg/TransferItems", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	return n
+	return out, nil
 }
 
-func (m *MsgCompleteExecutionEarly) Size() (n int) {
-	if m == nil {
-		return 0
+func (c *msgClient) ExecuteRecipe(ctx context.Context, in *MsgExecuteRecipe, opts ...grpc.CallOption) (*MsgExecuteRecipeResponse, error) {
+	out := new(MsgExecuteRecipeResponse)
+	err := c.cc.Invoke(ctx, "/pylons.pylons.Msg/ExecuteRecipe", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	l = len(m.Creator)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	l = len(m.Id)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	return n
+	return out, nil
 }
 
-func (m *MsgCompleteExecutionEarlyResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Id)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+func (c *msgClient) SetItemString(ctx context.Context, in *MsgSetItemString, opts ...grpc.CallOption) (*MsgSetItemStringResponse, error) {
+	out := new(MsgSetItemStringResponse)
+	err := c.cc.Invoke(ctx, "/pylons.pylons.Msg/SetItemString", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return out, nil
 }
 
-func (m *MsgTransferCookbook) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Creator)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	l = len(m.Id)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	l = len(m.Recipient)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+func (c *msgClient) UpdateItemAttributes(ctx context.Context, in *MsgUpdateItemAttributes, opts ...grpc.CallOption) (*MsgUpdateItemAttributesResponse, error) {
+	out := new(MsgUpdateItemAttributesResponse)
+	err := c.cc.Invoke(ctx, "/pylons.pylons.Msg/UpdateItemAttributes", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return out, nil
 }
 
-func (m *MsgTransferCookbookResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (c *msgClient) DepositItems(ctx context.Context, in *MsgDepositItems, opts ...grpc.CallOption) (*MsgDepositItemsResponse, error) {
+	out := new(MsgDepositItemsResponse)
+	err := c.cc.Invoke(ctx, "/pylons.pylons.Msg/DepositItems", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	return n
+	return out, nil
 }
 
-func (m *MsgGoogleInAppPurchaseGetCoins) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Creator)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	l = len(m.ProductId)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	l = len(m.PurchaseToken)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	l = len(m.ReceiptDataBase64)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	l = len(m.Signature)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+func (c *msgClient) WithdrawItems(ctx context.Context, in *MsgWithdrawItems, opts ...grpc.CallOption) (*MsgWithdrawItemsResponse, error) {
+	out := new(MsgWithdrawItemsResponse)
+	err := c.cc.Invoke(ctx, "/pylons.pylons.Msg/WithdrawItems", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return out, nil
 }
 
-func (m *MsgGoogleInAppPurchaseGetCoinsResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (c *msgClient) CreateRecipe(ctx context.Context, in *MsgCreateRecipe, opts ...grpc.CallOption) (*MsgCreateRecipeResponse, error) {
+	out := new(MsgCreateRecipeResponse)
+	err := c.cc.Invoke(ctx, "/pylons.pylons.Msg/CreateRecipe", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	return n
+	return out, nil
 }
 
-func (m *MsgSendItems) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Creator)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	l = len(m.Receiver)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	if len(m.Items) > 0 {
-		for _, e := range m.Items {
-			l = e.Size()
-			n += 1 + l + sovTx(uint64(l))
-		}
+func (c *msgClient) UpdateRecipe(ctx context.Context, in *MsgUpdateRecipe, opts ...grpc.CallOption) (*MsgUpdateRecipeResponse, error) {
+	out := new(MsgUpdateRecipeResponse)
+	err := c.cc.Invoke(ctx, "/pylons.pylons.Msg/UpdateRecipe", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return out, nil
 }
 
-func (m *MsgSendItemsResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (c *msgClient) CreateCookbook(ctx context.Context, in *MsgCreateCookbook, opts ...grpc.CallOption) (*MsgCreateCookbookResponse, error) {
+	out := new(MsgCreateCookbookResponse)
+	err := c.cc.Invoke(ctx, "/pylons.pylons.Msg/CreateCookbook", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	return n
+	return out, nil
 }
 
-func (m *MsgExecuteRecipe) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Creator)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	l = len(m.CookbookId)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	l = len(m.RecipeId)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	if m.CoinInputsIndex != 0 {
-		n += 1 + sovTx(uint64(m.CoinInputsIndex))
-	}
-	if len(m.ItemIds) > 0 {
-		for _, s := range m.ItemIds {
-			l = len(s)
-			n += 1 + l + sovTx(uint64(l))
-		}
-	}
-	if len(m.PaymentInfos) > 0 {
-		for _, e := range m.PaymentInfos {
-			l = e.Size()
-			n += 1 + l + sovTx(uint64(l))
-		}
+func (c *msgClient) UpdateCookbook(ctx context.Context, in *MsgUpdateCookbook, opts ...grpc.CallOption) (*MsgUpdateCookbookResponse, error) {
+	out := new(MsgUpdateCookbookResponse)
+	err := c.cc.Invoke(ctx, "/pylons.pylons.Msg/UpdateCookbook", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return out, nil
 }
 
-func (m *MsgExecuteRecipeResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Id)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	return n
+// MsgServer is the server API for Msg service.
+type MsgServer interface {
+	AppleIap(context.Context, *MsgAppleIap) (*MsgAppleIapResponse, error)
+	AddStripeRefund(context.Context, *MsgAddStripeRefund) (*MsgAddStripeRefundResponse, error)
+	// this line is used by starport scaffolding # proto/tx/rpc
+	BurnDebtToken(context.Context, *MsgBurnDebtToken) (*MsgBurnDebtTokenResponse, error)
+	UpdateAccount(context.Context, *MsgUpdateAccount) (*MsgUpdateAccountResponse, error)
+	FulfillTrade(context.Context, *MsgFulfillTrade) (*MsgFulfillTradeResponse, error)
+	CreateTrade(context.Context, *MsgCreateTrade) (*MsgCreateTradeResponse, error)
+	CancelTrade(context.Context, *MsgCancelTrade) (*MsgCancelTradeResponse, error)
+	CompleteExecutionEarly(context.Context, *MsgCompleteExecutionEarly) (*MsgCompleteExecutionEarlyResponse, error)
+	TransferCookbook(context.Context, *MsgTransferCookbook) (*MsgTransferCookbookResponse, error)
+	GoogleInAppPurchaseGetCoins(context.Context, *MsgGoogleInAppPurchaseGetCoins) (*MsgGoogleInAppPurchaseGetCoinsResponse, error)
+	CreateAccount(context.Context, *MsgCreateAccount) (*MsgCreateAccountResponse, error)
+	SendItems(context.Context, *MsgSendItems) (*MsgSendItemsResponse, error)
+	BurnItems(context.Context, *MsgBurnItems) (*MsgBurnItemsResponse, error)
+	CreateLending(context.Context, *MsgCreateLending) (*MsgCreateLendingResponse, error)
+	AcceptLending(context.Context, *MsgAcceptLending) (*MsgAcceptLendingResponse, error)
+	CancelLending(context.Context, *MsgCancelLending) (*MsgCancelLendingResponse, error)
+	CreateAuction(context.Context, *MsgCreateAuction) (*MsgCreateAuctionResponse, error)
+	PlaceBid(context.Context, *MsgPlaceBid) (*MsgPlaceBidResponse, error)
+	CancelAuction(context.Context, *MsgCancelAuction) (*MsgCancelAuctionResponse, error)
+	CreateDutchAuction(context.Context, *MsgCreateDutchAuction) (*MsgCreateDutchAuctionResponse, error)
+	BuyDutchAuction(context.Context, *MsgBuyDutchAuction) (*MsgBuyDutchAuctionResponse, error)
+	CancelDutchAuction(context.Context, *MsgCancelDutchAuction) (*MsgCancelDutchAuctionResponse, error)
+	CreateItemOffer(context.Context, *MsgCreateItemOffer) (*MsgCreateItemOfferResponse, error)
+	AcceptItemOffer(context.Context, *MsgAcceptItemOffer) (*MsgAcceptItemOfferResponse, error)
+	CancelItemOffer(context.Context, *MsgCancelItemOffer) (*MsgCancelItemOfferResponse, error)
+	CreateSwap(context.Context, *MsgCreateSwap) (*MsgCreateSwapResponse, error)
+	DepositSwap(context.Context, *MsgDepositSwap) (*MsgDepositSwapResponse, error)
+	AbortSwap(context.Context, *MsgAbortSwap) (*MsgAbortSwapResponse, error)
+	ApproveItem(context.Context, *MsgApproveItem) (*MsgApproveItemResponse, error)
+	RevokeItemApproval(context.Context, *MsgRevokeItemApproval) (*MsgRevokeItemApprovalResponse, error)
+	SetOperator(context.Context, *MsgSetOperator) (*MsgSetOperatorResponse, error)
+	TransferItems(context.Context, *MsgTransferItems) (*MsgTransferItemsResponse, error)
+	ExecuteRecipe(context.Context, *MsgExecuteRecipe) (*MsgExecuteRecipeResponse, error)
+	SetItemString(context.Context, *MsgSetItemString) (*MsgSetItemStringResponse, error)
+	UpdateItemAttributes(context.Context, *MsgUpdateItemAttributes) (*MsgUpdateItemAttributesResponse, error)
+	DepositItems(context.Context, *MsgDepositItems) (*MsgDepositItemsResponse, error)
+	WithdrawItems(context.Context, *MsgWithdrawItems) (*MsgWithdrawItemsResponse, error)
+	CreateRecipe(context.Context, *MsgCreateRecipe) (*MsgCreateRecipeResponse, error)
+	UpdateRecipe(context.Context, *MsgUpdateRecipe) (*MsgUpdateRecipeResponse, error)
+	CreateCookbook(context.Context, *MsgCreateCookbook) (*MsgCreateCookbookResponse, error)
+	UpdateCookbook(context.Context, *MsgUpdateCookbook) (*MsgUpdateCookbookResponse, error)
 }
 
-func (m *MsgSetItemString) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Creator)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	l = len(m.CookbookId)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	l = len(m.Id)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	l = len(m.Field)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	l = len(m.Value)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	return n
+// UnimplementedMsgServer can be embedded to have forward compatible implementations.
+type UnimplementedMsgServer struct {
 }
 
-func (m *MsgSetItemStringResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	return n
+func (*UnimplementedMsgServer) AppleIap(ctx context.Context, req *MsgAppleIap) (*MsgAppleIapResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AppleIap not implemented")
 }
-
-func (m *MsgCreateRecipe) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Creator)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+func (*UnimplementedMsgServer) AddStripeRefund(ctx context.Context, req *MsgAddStripeRefund) (*MsgAddStripeRefundResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AddStripeRefund not implemented")
+}
+func (*UnimplementedMsgServer) BurnDebtToken(ctx context.Context, req *MsgBurnDebtToken) (*MsgBurnDebtTokenResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BurnDebtToken not implemented")
+}
+func (*UnimplementedMsgServer) UpdateAccount(ctx context.Context, req *MsgUpdateAccount) (*MsgUpdateAccountResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateAccount not implemented")
+}
+func (*UnimplementedMsgServer) FulfillTrade(ctx context.Context, req *MsgFulfillTrade) (*MsgFulfillTradeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method FulfillTrade not implemented")
+}
+func (*UnimplementedMsgServer) CreateTrade(ctx context.Context, req *MsgCreateTrade) (*MsgCreateTradeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateTrade not implemented")
+}
+func (*UnimplementedMsgServer) CancelTrade(ctx context.Context, req *MsgCancelTrade) (*MsgCancelTradeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CancelTrade not implemented")
+}
+func (*UnimplementedMsgServer) CompleteExecutionEarly(ctx context.Context, req *MsgCompleteExecutionEarly) (*MsgCompleteExecutionEarlyResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CompleteExecutionEarly not implemented")
+}
+func (*UnimplementedMsgServer) TransferCookbook(ctx context.Context, req *MsgTransferCookbook) (*MsgTransferCookbookResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method TransferCookbook not implemented")
+}
+func (*UnimplementedMsgServer) GoogleInAppPurchaseGetCoins(ctx context.Context, req *MsgGoogleInAppPurchaseGetCoins) (*MsgGoogleInAppPurchaseGetCoinsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GoogleInAppPurchaseGetCoins not implemented")
+}
+func (*UnimplementedMsgServer) CreateAccount(ctx context.Context, req *MsgCreateAccount) (*MsgCreateAccountResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateAccount not implemented")
+}
+func (*UnimplementedMsgServer) SendItems(ctx context.Context, req *MsgSendItems) (*MsgSendItemsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SendItems not implemented")
+}
+func (*UnimplementedMsgServer) BurnItems(ctx context.Context, req *MsgBurnItems) (*MsgBurnItemsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BurnItems not implemented")
+}
+func (*UnimplementedMsgServer) CreateLending(ctx context.Context, req *MsgCreateLending) (*MsgCreateLendingResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateLending not implemented")
+}
+func (*UnimplementedMsgServer) AcceptLending(ctx context.Context, req *MsgAcceptLending) (*MsgAcceptLendingResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AcceptLending not implemented")
+}
+func (*UnimplementedMsgServer) CancelLending(ctx context.Context, req *MsgCancelLending) (*MsgCancelLendingResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CancelLending not implemented")
+}
+func (*UnimplementedMsgServer) CreateAuction(ctx context.Context, req *MsgCreateAuction) (*MsgCreateAuctionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateAuction not implemented")
+}
+func (*UnimplementedMsgServer) PlaceBid(ctx context.Context, req *MsgPlaceBid) (*MsgPlaceBidResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PlaceBid not implemented")
+}
+func (*UnimplementedMsgServer) CancelAuction(ctx context.Context, req *MsgCancelAuction) (*MsgCancelAuctionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CancelAuction not implemented")
+}
+func (*UnimplementedMsgServer) CreateDutchAuction(ctx context.Context, req *MsgCreateDutchAuction) (*MsgCreateDutchAuctionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateDutchAuction not implemented")
+}
+func (*UnimplementedMsgServer) BuyDutchAuction(ctx context.Context, req *MsgBuyDutchAuction) (*MsgBuyDutchAuctionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BuyDutchAuction not implemented")
+}
+func (*UnimplementedMsgServer) CancelDutchAuction(ctx context.Context, req *MsgCancelDutchAuction) (*MsgCancelDutchAuctionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CancelDutchAuction not implemented")
+}
+func (*UnimplementedMsgServer) CreateItemOffer(ctx context.Context, req *MsgCreateItemOffer) (*MsgCreateItemOfferResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateItemOffer not implemented")
+}
+func (*UnimplementedMsgServer) AcceptItemOffer(ctx context.Context, req *MsgAcceptItemOffer) (*MsgAcceptItemOfferResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AcceptItemOffer not implemented")
+}
+func (*UnimplementedMsgServer) CancelItemOffer(ctx context.Context, req *MsgCancelItemOffer) (*MsgCancelItemOfferResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CancelItemOffer not implemented")
+}
+func (*UnimplementedMsgServer) CreateSwap(ctx context.Context, req *MsgCreateSwap) (*MsgCreateSwapResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateSwap not implemented")
+}
+func (*UnimplementedMsgServer) DepositSwap(ctx context.Context, req *MsgDepositSwap) (*MsgDepositSwapResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DepositSwap not implemented")
+}
+func (*UnimplementedMsgServer) AbortSwap(ctx context.Context, req *MsgAbortSwap) (*MsgAbortSwapResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AbortSwap not implemented")
+}
+func (*UnimplementedMsgServer) ApproveItem(ctx context.Context, req *MsgApproveItem) (*MsgApproveItemResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ApproveItem not implemented")
+}
+func (*UnimplementedMsgServer) RevokeItemApproval(ctx context.Context, req *MsgRevokeItemApproval) (*MsgRevokeItemApprovalResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RevokeItemApproval not implemented")
+}
+func (*UnimplementedMsgServer) SetOperator(ctx context.Context, req *MsgSetOperator) (*MsgSetOperatorResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetOperator not implemented")
+}
+func (*UnimplementedMsgServer) TransferItems(ctx context.Context, req *MsgTransferItems) (*MsgTransferItemsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method TransferItems not implemented")
+}
+func (*UnimplementedMsgServer) ExecuteRecipe(ctx context.Context, req *MsgExecuteRecipe) (*MsgExecuteRecipeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ExecuteRecipe not implemented")
+}
+func (*UnimplementedMsgServer) SetItemString(ctx context.Context, req *MsgSetItemString) (*MsgSetItemStringResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetItemString not implemented")
+}
+func (*UnimplementedMsgServer) UpdateItemAttributes(ctx context.Context, req *MsgUpdateItemAttributes) (*MsgUpdateItemAttributesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateItemAttributes not implemented")
+}
+func (*UnimplementedMsgServer) DepositItems(ctx context.Context, req *MsgDepositItems) (*MsgDepositItemsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DepositItems not implemented")
+}
+func (*UnimplementedMsgServer) WithdrawItems(ctx context.Context, req *MsgWithdrawItems) (*MsgWithdrawItemsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method WithdrawItems not implemented")
+}
+func (*UnimplementedMsgServer) CreateRecipe(ctx context.Context, req *MsgCreateRecipe) (*MsgCreateRecipeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateRecipe not implemented")
+}
+func (*UnimplementedMsgServer) UpdateRecipe(ctx context.Context, req *MsgUpdateRecipe) (*MsgUpdateRecipeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateRecipe not implemented")
+}
+func (*UnimplementedMsgServer) CreateCookbook(ctx context.Context, req *MsgCreateCookbook) (*MsgCreateCookbookResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateCookbook not implemented")
+}
+func (*UnimplementedMsgServer) UpdateCookbook(ctx context.Context, req *MsgUpdateCookbook) (*MsgUpdateCookbookResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateCookbook not implemented")
+}
+
+func RegisterMsgServer(s grpc1.Server, srv MsgServer) {
+	s.RegisterService(&_Msg_serviceDesc, srv)
+}
+
+func _Msg_AppleIap_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgAppleIap)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	l = len(m.CookbookId)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	if interceptor == nil {
+		return srv.(MsgServer).AppleIap(ctx, in)
 	}
-	l = len(m.Id)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pylons.pylons.Msg/AppleIap",
 	}
-	l = len(m.Name)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).AppleIap(ctx, req.(*MsgAppleIap))
 	}
-	l = len(m.Description)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_AddStripeRefund_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgAddStripeRefund)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	l = len(m.Version)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	if interceptor == nil {
+		return srv.(MsgServer).AddStripeRefund(ctx, in)
 	}
-	if len(m.CoinInputs) > 0 {
-		for _, e := range m.CoinInputs {
-			l = e.Size()
-			n += 1 + l + sovTx(uint64(l))
-		}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pylons.pylons.Msg/AddStripeRefund",
 	}
-	if len(m.ItemInputs) > 0 {
-		for _, e := range m.ItemInputs {
-			l = e.Size()
-			n += 1 + l + sovTx(uint64(l))
-		}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).AddStripeRefund(ctx, req.(*MsgAddStripeRefund))
 	}
-	l = m.Entries.Size()
-	n += 1 + l + sovTx(uint64(l))
-	if len(m.Outputs) > 0 {
-		for _, e := range m.Outputs {
-			l = e.Size()
-			n += 1 + l + sovTx(uint64(l))
-		}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_BurnDebtToken_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgBurnDebtToken)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	if m.BlockInterval != 0 {
-		n += 1 + sovTx(uint64(m.BlockInterval))
+	if interceptor == nil {
+		return srv.(MsgServer).BurnDebtToken(ctx, in)
 	}
-	l = m.CostPerBlock.Size()
-	n += 1 + l + sovTx(uint64(l))
-	if m.Enabled {
-		n += 2
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pylons.pylons.Msg/BurnDebtToken",
 	}
-	l = len(m.ExtraInfo)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).BurnDebtToken(ctx, req.(*MsgBurnDebtToken))
 	}
-	return n
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *MsgCreateRecipeResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func _Msg_UpdateAccount_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgUpdateAccount)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	return n
+	if interceptor == nil {
+		return srv.(MsgServer).UpdateAccount(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pylons.pylons.Msg/UpdateAccount",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).UpdateAccount(ctx, req.(*MsgUpdateAccount))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *MsgUpdateRecipe) Size() (n int) {
-	if m == nil {
-		return 0
+func _Msg_FulfillTrade_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgFulfillTrade)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	l = len(m.Creator)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	if interceptor == nil {
+		return srv.(MsgServer).FulfillTrade(ctx, in)
 	}
-	l = len(m.CookbookId)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pylons.pylons.Msg/FulfillTrade",
 	}
-	l = len(m.Id)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).FulfillTrade(ctx, req.(*MsgFulfillTrade))
 	}
-	l = len(m.Name)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_CreateTrade_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgCreateTrade)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	l = len(m.Description)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	if interceptor == nil {
+		return srv.(MsgServer).CreateTrade(ctx, in)
 	}
-	l = len(m.Version)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pylons.pylons.Msg/CreateTrade",
 	}
-	if len(m.CoinInputs) > 0 {
-		for _, e := range m.CoinInputs {
-			l = e.Size()
-			n += 1 + l + sovTx(uint64(l))
-		}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).CreateTrade(ctx, req.(*MsgCreateTrade))
 	}
-	if len(m.ItemInputs) > 0 {
-		for _, e := range m.ItemInputs {
-			l = e.Size()
-			n += 1 + l + sovTx(uint64(l))
-		}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_CancelTrade_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgCancelTrade)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	l = m.Entries.Size()
-	n += 1 + l + sovTx(uint64(l))
-	if len(m.Outputs) > 0 {
-		for _, e := range m.Outputs {
-			l = e.Size()
-			n += 1 + l + sovTx(uint64(l))
-		}
+	if interceptor == nil {
+		return srv.(MsgServer).CancelTrade(ctx, in)
 	}
-	if m.BlockInterval != 0 {
-		n += 1 + sovTx(uint64(m.BlockInterval))
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pylons.pylons.Msg/CancelTrade",
 	}
-	l = m.CostPerBlock.Size()
-	n += 1 + l + sovTx(uint64(l))
-	if m.Enabled {
-		n += 2
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).CancelTrade(ctx, req.(*MsgCancelTrade))
 	}
-	l = len(m.ExtraInfo)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_CompleteExecutionEarly_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgCompleteExecutionEarly)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	return n
+	if interceptor == nil {
+		return srv.(MsgServer).CompleteExecutionEarly(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pylons.pylons.Msg/CompleteExecutionEarly",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).CompleteExecutionEarly(ctx, req.(*MsgCompleteExecutionEarly))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *MsgUpdateRecipeResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func _Msg_TransferCookbook_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgTransferCookbook)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	return n
+	if interceptor == nil {
+		return srv.(MsgServer).TransferCookbook(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pylons.pylons.Msg/TransferCookbook",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).TransferCookbook(ctx, req.(*MsgTransferCookbook))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *MsgCreateCookbook) Size() (n int) {
-	if m == nil {
-		return 0
+func _Msg_GoogleInAppPurchaseGetCoins_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgGoogleInAppPurchaseGetCoins)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	l = len(m.Creator)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	if interceptor == nil {
+		return srv.(MsgServer).GoogleInAppPurchaseGetCoins(ctx, in)
 	}
-	l = len(m.Id)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pylons.pylons.Msg/GoogleInAppPurchaseGetCoins",
 	}
-	l = len(m.Name)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).GoogleInAppPurchaseGetCoins(ctx, req.(*MsgGoogleInAppPurchaseGetCoins))
 	}
-	l = len(m.Description)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_CreateAccount_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgCreateAccount)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	l = len(m.Developer)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	if interceptor == nil {
+		return srv.(MsgServer).CreateAccount(ctx, in)
 	}
-	l = len(m.Version)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pylons.pylons.Msg/CreateAccount",
 	}
-	l = len(m.SupportEmail)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).CreateAccount(ctx, req.(*MsgCreateAccount))
 	}
-	if m.Enabled {
-		n += 2
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_SendItems_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgSendItems)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	return n
+	if interceptor == nil {
+		return srv.(MsgServer).SendItems(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pylons.pylons.Msg/SendItems",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).SendItems(ctx, req.(*MsgSendItems))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *MsgCreateCookbookResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func _Msg_BurnItems_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgBurnItems)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	return n
+	if interceptor == nil {
+		return srv.(MsgServer).BurnItems(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pylons.pylons.Msg/BurnItems",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).BurnItems(ctx, req.(*MsgBurnItems))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *MsgUpdateCookbook) Size() (n int) {
-	if m == nil {
-		return 0
+func _Msg_CreateLending_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgCreateLending)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	l = len(m.Creator)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	if interceptor == nil {
+		return srv.(MsgServer).CreateLending(ctx, in)
 	}
-	l = len(m.Id)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pylons.pylons.Msg/CreateLending",
 	}
-	l = len(m.Name)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).CreateLending(ctx, req.(*MsgCreateLending))
 	}
-	l = len(m.Description)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_AcceptLending_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgAcceptLending)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	l = len(m.Developer)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	if interceptor == nil {
+		return srv.(MsgServer).AcceptLending(ctx, in)
 	}
-	l = len(m.Version)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pylons.pylons.Msg/AcceptLending",
 	}
-	l = len(m.SupportEmail)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).AcceptLending(ctx, req.(*MsgAcceptLending))
 	}
-	if m.Enabled {
-		n += 2
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_CancelLending_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgCancelLending)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	return n
+	if interceptor == nil {
+		return srv.(MsgServer).CancelLending(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pylons.pylons.Msg/CancelLending",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).CancelLending(ctx, req.(*MsgCancelLending))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *MsgUpdateCookbookResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func _Msg_CreateAuction_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgCreateAuction)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	return n
+	if interceptor == nil {
+		return srv.(MsgServer).CreateAuction(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pylons.pylons.Msg/CreateAuction",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).CreateAuction(ctx, req.(*MsgCreateAuction))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func sovTx(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
+func _Msg_PlaceBid_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgPlaceBid)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).PlaceBid(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pylons.pylons.Msg/PlaceBid",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).PlaceBid(ctx, req.(*MsgPlaceBid))
+	}
+	return interceptor(ctx, in, info, handler)
 }
-func sozTx(x uint64) (n int) {
-	return sovTx(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+
+func _Msg_CancelAuction_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgCancelAuction)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).CancelAuction(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pylons.pylons.Msg/CancelAuction",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).CancelAuction(ctx, req.(*MsgCancelAuction))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_CreateDutchAuction_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgCreateDutchAuction)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).CreateDutchAuction(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pylons.pylons.Msg/CreateDutchAuction",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).CreateDutchAuction(ctx, req.(*MsgCreateDutchAuction))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_BuyDutchAuction_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgBuyDutchAuction)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).BuyDutchAuction(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pylons.pylons.Msg/BuyDutchAuction",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).BuyDutchAuction(ctx, req.(*MsgBuyDutchAuction))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_CancelDutchAuction_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgCancelDutchAuction)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).CancelDutchAuction(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pylons.pylons.Msg/CancelDutchAuction",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).CancelDutchAuction(ctx, req.(*MsgCancelDutchAuction))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_CreateItemOffer_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgCreateItemOffer)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).CreateItemOffer(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pylons.pylons.Msg/CreateItemOffer",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).CreateItemOffer(ctx, req.(*MsgCreateItemOffer))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_AcceptItemOffer_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgAcceptItemOffer)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).AcceptItemOffer(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pylons.pylons.Msg/AcceptItemOffer",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).AcceptItemOffer(ctx, req.(*MsgAcceptItemOffer))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_CancelItemOffer_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgCancelItemOffer)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).CancelItemOffer(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pylons.pylons.Msg/CancelItemOffer",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).CancelItemOffer(ctx, req.(*MsgCancelItemOffer))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_CreateSwap_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgCreateSwap)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).CreateSwap(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pylons.pylons.Msg/CreateSwap",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).CreateSwap(ctx, req.(*MsgCreateSwap))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_DepositSwap_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgDepositSwap)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).DepositSwap(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pylons.pylons.Msg/DepositSwap",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).DepositSwap(ctx, req.(*MsgDepositSwap))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_AbortSwap_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgAbortSwap)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).AbortSwap(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pylons.pylons.Msg/AbortSwap",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).AbortSwap(ctx, req.(*MsgAbortSwap))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_ApproveItem_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgApproveItem)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).ApproveItem(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pylons.pylons.Msg/ApproveItem",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).ApproveItem(ctx, req.(*MsgApproveItem))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_RevokeItemApproval_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgRevokeItemApproval)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).RevokeItemApproval(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pylons.pylons.Msg/RevokeItemApproval",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).RevokeItemApproval(ctx, req.(*MsgRevokeItemApproval))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_SetOperator_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgSetOperator)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).SetOperator(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pylons.pylons.Msg/SetOperator",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).SetOperator(ctx, req.(*MsgSetOperator))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_TransferItems_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgTransferItems)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).TransferItems(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pylons.pylons.Msg/TransferItems",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).TransferItems(ctx, req.(*MsgTransferItems))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_ExecuteRecipe_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgExecuteRecipe)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).ExecuteRecipe(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pylons.pylons.Msg/ExecuteRecipe",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).ExecuteRecipe(ctx, req.(*MsgExecuteRecipe))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_SetItemString_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgSetItemString)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).SetItemString(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pylons.pylons.Msg/SetItemString",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).SetItemString(ctx, req.(*MsgSetItemString))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_UpdateItemAttributes_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgUpdateItemAttributes)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).UpdateItemAttributes(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pylons.pylons.Msg/UpdateItemAttributes",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).UpdateItemAttributes(ctx, req.(*MsgUpdateItemAttributes))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_DepositItems_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgDepositItems)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).DepositItems(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pylons.pylons.Msg/DepositItems",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).DepositItems(ctx, req.(*MsgDepositItems))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_WithdrawItems_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgWithdrawItems)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).WithdrawItems(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pylons.pylons.Msg/WithdrawItems",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).WithdrawItems(ctx, req.(*MsgWithdrawItems))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_CreateRecipe_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgCreateRecipe)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).CreateRecipe(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pylons.pylons.Msg/CreateRecipe",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).CreateRecipe(ctx, req.(*MsgCreateRecipe))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_UpdateRecipe_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgUpdateRecipe)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).UpdateRecipe(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pylons.pylons.Msg/UpdateRecipe",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).UpdateRecipe(ctx, req.(*MsgUpdateRecipe))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_CreateCookbook_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgCreateCookbook)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).CreateCookbook(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pylons.pylons.Msg/CreateCookbook",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).CreateCookbook(ctx, req.(*MsgCreateCookbook))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_UpdateCookbook_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgUpdateCookbook)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).UpdateCookbook(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pylons.pylons.Msg/UpdateCookbook",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).UpdateCookbook(ctx, req.(*MsgUpdateCookbook))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var _Msg_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "pylons.pylons.Msg",
+	HandlerType: (*MsgServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "AppleIap",
+			Handler:    _Msg_AppleIap_Handler,
+		},
+		{
+			MethodName: "AddStripeRefund",
+			Handler:    _Msg_AddStripeRefund_Handler,
+		},
+		{
+			MethodName: "BurnDebtToken",
+			Handler:    _Msg_BurnDebtToken_Handler,
+		},
+		{
+			MethodName: "UpdateAccount",
+			Handler:    _Msg_UpdateAccount_Handler,
+		},
+		{
+			MethodName: "FulfillTrade",
+			Handler:    _Msg_FulfillTrade_Handler,
+		},
+		{
+			MethodName: "CreateTrade",
+			Handler:    _Msg_CreateTrade_Handler,
+		},
+		{
+			MethodName: "CancelTrade",
+			Handler:    _Msg_CancelTrade_Handler,
+		},
+		{
+			MethodName: "CompleteExecutionEarly",
+			Handler:    _Msg_CompleteExecutionEarly_Handler,
+		},
+		{
+			MethodName: "TransferCookbook",
+			Handler:    _Msg_TransferCookbook_Handler,
+		},
+		{
+			MethodName: "GoogleInAppPurchaseGetCoins",
+			Handler:    _Msg_GoogleInAppPurchaseGetCoins_Handler,
+		},
+		{
+			MethodName: "CreateAccount",
+			Handler:    _Msg_CreateAccount_Handler,
+		},
+		{
+			MethodName: "SendItems",
+			Handler:    _Msg_SendItems_Handler,
+		},
+		{
+			MethodName: "BurnItems",
+			Handler:    _Msg_BurnItems_Handler,
+		},
+		{
+			MethodName: "CreateLending",
+			Handler:    _Msg_CreateLending_Handler,
+		},
+		{
+			MethodName: "AcceptLending",
+			Handler:    _Msg_AcceptLending_Handler,
+		},
+		{
+			MethodName: "CancelLending",
+			Handler:    _Msg_CancelLending_Handler,
+		},
+		{
+			MethodName: "CreateAuction",
+			Handler:    _Msg_CreateAuction_Handler,
+		},
+		{
+			MethodName: "PlaceBid",
+			Handler:    _Msg_PlaceBid_Handler,
+		},
+		{
+			MethodName: "CancelAuction",
+			Handler:    _Msg_CancelAuction_Handler,
+		},
+		{
+			MethodName: "CreateDutchAuction",
+			Handler:    _Msg_CreateDutchAuction_Handler,
+		},
+		{
+			MethodName: "BuyDutchAuction",
+			Handler:    _Msg_BuyDutchAuction_Handler,
+		},
+		{
+			MethodName: "CancelDutchAuction",
+			Handler:    _Msg_CancelDutchAuction_Handler,
+		},
+		{
+			MethodName: "CreateItemOffer",
+			Handler:    _Msg_CreateItemOffer_Handler,
+		},
+		{
+			MethodName: "AcceptItemOffer",
+			Handler:    _Msg_AcceptItemOffer_Handler,
+		},
+		{
+			MethodName: "CancelItemOffer",
+			Handler:    _Msg_CancelItemOffer_Handler,
+		},
+		{
+			MethodName: "CreateSwap",
+			Handler:    _Msg_CreateSwap_Handler,
+		},
+		{
+			MethodName: "DepositSwap",
+			Handler:    _Msg_DepositSwap_Handler,
+		},
+		{
+			MethodName: "AbortSwap",
+			Handler:    _Msg_AbortSwap_Handler,
+		},
+		{
+			MethodName: "ApproveItem",
+			Handler:    _Msg_ApproveItem_Handler,
+		},
+		{
+			MethodName: "RevokeItemApproval",
+			Handler:    _Msg_RevokeItemApproval_Handler,
+		},
+		{
+			MethodName: "SetOperator",
+			Handler:    _Msg_SetOperator_Handler,
+		},
+		{
+			MethodName: "TransferItems",
+			Handler:    _Msg_TransferItems_Handler,
+		},
+		{
+			MethodName: "ExecuteRecipe",
+			Handler:    _Msg_ExecuteRecipe_Handler,
+		},
+		{
+			MethodName: "SetItemString",
+			Handler:    _Msg_SetItemString_Handler,
+		},
+		{
+			MethodName: "UpdateItemAttributes",
+			Handler:    _Msg_UpdateItemAttributes_Handler,
+		},
+		{
+			MethodName: "DepositItems",
+			Handler:    _Msg_DepositItems_Handler,
+		},
+		{
+			MethodName: "WithdrawItems",
+			Handler:    _Msg_WithdrawItems_Handler,
+		},
+		{
+			MethodName: "CreateRecipe",
+			Handler:    _Msg_CreateRecipe_Handler,
+		},
+		{
+			MethodName: "UpdateRecipe",
+			Handler:    _Msg_UpdateRecipe_Handler,
+		},
+		{
+			MethodName: "CreateCookbook",
+			Handler:    _Msg_CreateCookbook_Handler,
+		},
+		{
+			MethodName: "UpdateCookbook",
+			Handler:    _Msg_UpdateCookbook_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "pylons/pylons/tx.proto",
+}
+
+func (m *MsgAppleIap) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgAppleIap) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgAppleIap) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ReceiptDataBase64) > 0 {
+		i -= len(m.ReceiptDataBase64)
+		copy(dAtA[i:], m.ReceiptDataBase64)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.ReceiptDataBase64)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.PurchaseId) > 0 {
+		i -= len(m.PurchaseId)
+		copy(dAtA[i:], m.PurchaseId)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.PurchaseId)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.ProductId) > 0 {
+		i -= len(m.ProductId)
+		copy(dAtA[i:], m.ProductId)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.ProductId)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Creator) > 0 {
+		i -= len(m.Creator)
+		copy(dAtA[i:], m.Creator)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Creator)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgAppleIapResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgAppleIapResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgAppleIapResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgAddStripeRefund) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgAddStripeRefund) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgAddStripeRefund) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Payment != nil {
+		{
+			size, err := m.Payment.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintTx(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Creator) > 0 {
+		i -= len(m.Creator)
+		copy(dAtA[i:], m.Creator)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Creator)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgAddStripeRefundResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgAddStripeRefundResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgAddStripeRefundResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgBurnDebtToken) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgBurnDebtToken) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgBurnDebtToken) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.RedeemInfo.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.Creator) > 0 {
+		i -= len(m.Creator)
+		copy(dAtA[i:], m.Creator)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Creator)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgBurnDebtTokenResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgBurnDebtTokenResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgBurnDebtTokenResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgUpdateAccount) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUpdateAccount) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUpdateAccount) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Username) > 0 {
+		i -= len(m.Username)
+		copy(dAtA[i:], m.Username)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Username)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Creator) > 0 {
+		i -= len(m.Creator)
+		copy(dAtA[i:], m.Creator)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Creator)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgUpdateAccountResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUpdateAccountResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUpdateAccountResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgCreateAccount) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgCreateAccount) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgCreateAccount) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ReferralAddress) > 0 {
+		i -= len(m.ReferralAddress)
+		copy(dAtA[i:], m.ReferralAddress)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.ReferralAddress)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.Token) > 0 {
+		i -= len(m.Token)
+		copy(dAtA[i:], m.Token)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Token)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Username) > 0 {
+		i -= len(m.Username)
+		copy(dAtA[i:], m.Username)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Username)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Creator) > 0 {
+		i -= len(m.Creator)
+		copy(dAtA[i:], m.Creator)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Creator)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgCreateAccountResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgCreateAccountResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgCreateAccountResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgFulfillTrade) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgFulfillTrade) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgFulfillTrade) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.FillAmount != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.FillAmount))
+		i--
+		dAtA[i] = 0x30
+	}
+	if len(m.PaymentInfos) > 0 {
+		for iNdEx := len(m.PaymentInfos) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.PaymentInfos[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
+	if len(m.Items) > 0 {
+		for iNdEx := len(m.Items) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Items[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if m.CoinInputsIndex != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.CoinInputsIndex))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.Id != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.Id))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Creator) > 0 {
+		i -= len(m.Creator)
+		copy(dAtA[i:], m.Creator)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Creator)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgFulfillTradeResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgFulfillTradeResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgFulfillTradeResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgCreateTrade) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgCreateTrade) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgCreateTrade) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Quantity != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.Quantity))
+		i--
+		dAtA[i] = 0x50
+	}
+	if m.ExpiresAt != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.ExpiresAt))
+		i--
+		dAtA[i] = 0x48
+	}
+	if m.ExpiresAtHeight != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.ExpiresAtHeight))
+		i--
+		dAtA[i] = 0x40
+	}
+	if len(m.Receiver) > 0 {
+		i -= len(m.Receiver)
+		copy(dAtA[i:], m.Receiver)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Receiver)))
+		i--
+		dAtA[i] = 0x3a
+	}
+	if len(m.ExtraInfo) > 0 {
+		i -= len(m.ExtraInfo)
+		copy(dAtA[i:], m.ExtraInfo)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.ExtraInfo)))
+		i--
+		dAtA[i] = 0x32
+	}
+	if len(m.ItemOutputs) > 0 {
+		for iNdEx := len(m.ItemOutputs) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ItemOutputs[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
+	if len(m.CoinOutputs) > 0 {
+		for iNdEx := len(m.CoinOutputs) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.CoinOutputs[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if len(m.ItemInputs) > 0 {
+		for iNdEx := len(m.ItemInputs) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ItemInputs[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.CoinInputs) > 0 {
+		for iNdEx := len(m.CoinInputs) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.CoinInputs[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.Creator) > 0 {
+		i -= len(m.Creator)
+		copy(dAtA[i:], m.Creator)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Creator)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgCreateTradeResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgCreateTradeResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgCreateTradeResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Id != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.Id))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgCancelTrade) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgCancelTrade) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgCancelTrade) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Id != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.Id))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Creator) > 0 {
+		i -= len(m.Creator)
+		copy(dAtA[i:], m.Creator)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Creator)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgCancelTradeResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgCancelTradeResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgCancelTradeResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgCompleteExecutionEarly) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgCompleteExecutionEarly) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgCompleteExecutionEarly) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Id) > 0 {
+		i -= len(m.Id)
+		copy(dAtA[i:], m.Id)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Id)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Creator) > 0 {
+		i -= len(m.Creator)
+		copy(dAtA[i:], m.Creator)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Creator)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgCompleteExecutionEarlyResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgCompleteExecutionEarlyResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgCompleteExecutionEarlyResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Id) > 0 {
+		i -= len(m.Id)
+		copy(dAtA[i:], m.Id)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Id)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgTransferCookbook) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgTransferCookbook) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgTransferCookbook) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Recipient) > 0 {
+		i -= len(m.Recipient)
+		copy(dAtA[i:], m.Recipient)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Recipient)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Id) > 0 {
+		i -= len(m.Id)
+		copy(dAtA[i:], m.Id)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Id)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Creator) > 0 {
+		i -= len(m.Creator)
+		copy(dAtA[i:], m.Creator)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Creator)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgTransferCookbookResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgTransferCookbookResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgTransferCookbookResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgGoogleInAppPurchaseGetCoins) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgGoogleInAppPurchaseGetCoins) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgGoogleInAppPurchaseGetCoins) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Signature) > 0 {
+		i -= len(m.Signature)
+		copy(dAtA[i:], m.Signature)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Signature)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.ReceiptDataBase64) > 0 {
+		i -= len(m.ReceiptDataBase64)
+		copy(dAtA[i:], m.ReceiptDataBase64)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.ReceiptDataBase64)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.PurchaseToken) > 0 {
+		i -= len(m.PurchaseToken)
+		copy(dAtA[i:], m.PurchaseToken)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.PurchaseToken)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.ProductId) > 0 {
+		i -= len(m.ProductId)
+		copy(dAtA[i:], m.ProductId)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.ProductId)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Creator) > 0 {
+		i -= len(m.Creator)
+		copy(dAtA[i:], m.Creator)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Creator)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgGoogleInAppPurchaseGetCoinsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgGoogleInAppPurchaseGetCoinsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgGoogleInAppPurchaseGetCoinsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSendItems) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSendItems) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSendItems) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Items) > 0 {
+		for iNdEx := len(m.Items) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Items[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.Receiver) > 0 {
+		i -= len(m.Receiver)
+		copy(dAtA[i:], m.Receiver)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Receiver)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Creator) > 0 {
+		i -= len(m.Creator)
+		copy(dAtA[i:], m.Creator)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Creator)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSendItemsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSendItemsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSendItemsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgBurnItems) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgBurnItems) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgBurnItems) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Items) > 0 {
+		for iNdEx := len(m.Items) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Items[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.Creator) > 0 {
+		i -= len(m.Creator)
+		copy(dAtA[i:], m.Creator)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Creator)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgBurnItemsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgBurnItemsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgBurnItemsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgCreateLending) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgCreateLending) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgCreateLending) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Duration != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.Duration))
+		i--
+		dAtA[i] = 0x28
+	}
+	if len(m.Price) > 0 {
+		for iNdEx := len(m.Price) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Price[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if len(m.Borrower) > 0 {
+		i -= len(m.Borrower)
+		copy(dAtA[i:], m.Borrower)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Borrower)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	{
+		size, err := m.Item.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.Creator) > 0 {
+		i -= len(m.Creator)
+		copy(dAtA[i:], m.Creator)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Creator)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgCreateLendingResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgCreateLendingResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgCreateLendingResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Id != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.Id))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgAcceptLending) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgAcceptLending) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgAcceptLending) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Id != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.Id))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Creator) > 0 {
+		i -= len(m.Creator)
+		copy(dAtA[i:], m.Creator)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Creator)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgAcceptLendingResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgAcceptLendingResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgAcceptLendingResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgCancelLending) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgCancelLending) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgCancelLending) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Id != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.Id))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Creator) > 0 {
+		i -= len(m.Creator)
+		copy(dAtA[i:], m.Creator)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Creator)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgCancelLendingResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgCancelLendingResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgCancelLendingResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgCreateAuction) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgCreateAuction) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgCreateAuction) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.EndTime != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.EndTime))
+		i--
+		dAtA[i] = 0x20
+	}
+	{
+		size, err := m.ReservePrice.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if len(m.Items) > 0 {
+		for iNdEx := len(m.Items) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Items[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.Creator) > 0 {
+		i -= len(m.Creator)
+		copy(dAtA[i:], m.Creator)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Creator)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgCreateAuctionResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgCreateAuctionResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgCreateAuctionResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Id != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.Id))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgPlaceBid) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgPlaceBid) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgPlaceBid) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Amount.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if m.Id != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.Id))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Creator) > 0 {
+		i -= len(m.Creator)
+		copy(dAtA[i:], m.Creator)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Creator)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgPlaceBidResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgPlaceBidResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgPlaceBidResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgCancelAuction) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgCancelAuction) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgCancelAuction) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Id != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.Id))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Creator) > 0 {
+		i -= len(m.Creator)
+		copy(dAtA[i:], m.Creator)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Creator)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgCancelAuctionResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgCancelAuctionResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgCancelAuctionResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgCreateDutchAuction) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgCreateDutchAuction) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgCreateDutchAuction) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Decay) > 0 {
+		i -= len(m.Decay)
+		copy(dAtA[i:], m.Decay)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Decay)))
+		i--
+		dAtA[i] = 0x3a
+	}
+	if m.EndHeight != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.EndHeight))
+		i--
+		dAtA[i] = 0x30
+	}
+	if m.StartHeight != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.StartHeight))
+		i--
+		dAtA[i] = 0x28
+	}
+	{
+		size, err := m.FloorPrice.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	{
+		size, err := m.StartPrice.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if len(m.Items) > 0 {
+		for iNdEx := len(m.Items) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Items[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.Creator) > 0 {
+		i -= len(m.Creator)
+		copy(dAtA[i:], m.Creator)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Creator)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgCreateDutchAuctionResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgCreateDutchAuctionResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgCreateDutchAuctionResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Id != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.Id))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgBuyDutchAuction) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgBuyDutchAuction) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgBuyDutchAuction) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.MaxPrice.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if m.Id != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.Id))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Creator) > 0 {
+		i -= len(m.Creator)
+		copy(dAtA[i:], m.Creator)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Creator)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgBuyDutchAuctionResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgBuyDutchAuctionResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgBuyDutchAuctionResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Price.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgCancelDutchAuction) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgCancelDutchAuction) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgCancelDutchAuction) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Id != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.Id))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Creator) > 0 {
+		i -= len(m.Creator)
+		copy(dAtA[i:], m.Creator)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Creator)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgCancelDutchAuctionResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgCancelDutchAuctionResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgCancelDutchAuctionResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgCreateItemOffer) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgCreateItemOffer) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgCreateItemOffer) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.ExpiresAt != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.ExpiresAt))
+		i--
+		dAtA[i] = 0x38
+	}
+	if m.ExpiresAtHeight != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.ExpiresAtHeight))
+		i--
+		dAtA[i] = 0x30
+	}
+	if len(m.Price) > 0 {
+		for iNdEx := len(m.Price) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Price[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
+	{
+		size, err := m.ItemInput.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	if len(m.ItemId) > 0 {
+		i -= len(m.ItemId)
+		copy(dAtA[i:], m.ItemId)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.ItemId)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.CookbookId) > 0 {
+		i -= len(m.CookbookId)
+		copy(dAtA[i:], m.CookbookId)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.CookbookId)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Creator) > 0 {
+		i -= len(m.Creator)
+		copy(dAtA[i:], m.Creator)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Creator)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgCreateItemOfferResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgCreateItemOfferResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgCreateItemOfferResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Id != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.Id))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgAcceptItemOffer) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgAcceptItemOffer) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgAcceptItemOffer) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ItemId) > 0 {
+		i -= len(m.ItemId)
+		copy(dAtA[i:], m.ItemId)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.ItemId)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.Id != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.Id))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Creator) > 0 {
+		i -= len(m.Creator)
+		copy(dAtA[i:], m.Creator)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Creator)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgAcceptItemOfferResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgAcceptItemOfferResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgAcceptItemOfferResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgCancelItemOffer) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgCancelItemOffer) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgCancelItemOffer) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Id != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.Id))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Creator) > 0 {
+		i -= len(m.Creator)
+		copy(dAtA[i:], m.Creator)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Creator)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgCancelItemOfferResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgCancelItemOfferResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgCancelItemOfferResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgCreateSwap) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgCreateSwap) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgCreateSwap) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Participants) > 0 {
+		for iNdEx := len(m.Participants) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Participants[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.Creator) > 0 {
+		i -= len(m.Creator)
+		copy(dAtA[i:], m.Creator)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Creator)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgCreateSwapResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgCreateSwapResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgCreateSwapResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Id != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.Id))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgDepositSwap) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgDepositSwap) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgDepositSwap) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Id != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.Id))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Creator) > 0 {
+		i -= len(m.Creator)
+		copy(dAtA[i:], m.Creator)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Creator)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgDepositSwapResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgDepositSwapResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgDepositSwapResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgAbortSwap) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgAbortSwap) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgAbortSwap) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Id != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.Id))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Creator) > 0 {
+		i -= len(m.Creator)
+		copy(dAtA[i:], m.Creator)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Creator)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgAbortSwapResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgAbortSwapResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgAbortSwapResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgApproveItem) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgApproveItem) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgApproveItem) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Operator) > 0 {
+		i -= len(m.Operator)
+		copy(dAtA[i:], m.Operator)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Operator)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.ItemId) > 0 {
+		i -= len(m.ItemId)
+		copy(dAtA[i:], m.ItemId)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.ItemId)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.CookbookId) > 0 {
+		i -= len(m.CookbookId)
+		copy(dAtA[i:], m.CookbookId)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.CookbookId)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Creator) > 0 {
+		i -= len(m.Creator)
+		copy(dAtA[i:], m.Creator)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Creator)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgApproveItemResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgApproveItemResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgApproveItemResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgRevokeItemApproval) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgRevokeItemApproval) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgRevokeItemApproval) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ItemId) > 0 {
+		i -= len(m.ItemId)
+		copy(dAtA[i:], m.ItemId)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.ItemId)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.CookbookId) > 0 {
+		i -= len(m.CookbookId)
+		copy(dAtA[i:], m.CookbookId)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.CookbookId)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Creator) > 0 {
+		i -= len(m.Creator)
+		copy(dAtA[i:], m.Creator)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Creator)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgRevokeItemApprovalResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgRevokeItemApprovalResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgRevokeItemApprovalResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSetOperator) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSetOperator) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSetOperator) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Approved {
+		i--
+		if m.Approved {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.Operator) > 0 {
+		i -= len(m.Operator)
+		copy(dAtA[i:], m.Operator)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Operator)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.CookbookId) > 0 {
+		i -= len(m.CookbookId)
+		copy(dAtA[i:], m.CookbookId)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.CookbookId)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Creator) > 0 {
+		i -= len(m.Creator)
+		copy(dAtA[i:], m.Creator)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Creator)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSetOperatorResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSetOperatorResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSetOperatorResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgTransferItems) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgTransferItems) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgTransferItems) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Memo) > 0 {
+		i -= len(m.Memo)
+		copy(dAtA[i:], m.Memo)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Memo)))
+		i--
+		dAtA[i] = 0x4a
+	}
+	if m.TimeoutTimestamp != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.TimeoutTimestamp))
+		i--
+		dAtA[i] = 0x40
+	}
+	{
+		size, err := m.TimeoutHeight.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x3a
+	if len(m.Receiver) > 0 {
+		i -= len(m.Receiver)
+		copy(dAtA[i:], m.Receiver)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Receiver)))
+		i--
+		dAtA[i] = 0x32
+	}
+	if len(m.ItemIds) > 0 {
+		for iNdEx := len(m.ItemIds) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.ItemIds[iNdEx])
+			copy(dAtA[i:], m.ItemIds[iNdEx])
+			i = encodeVarintTx(dAtA, i, uint64(len(m.ItemIds[iNdEx])))
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
+	if len(m.CookbookId) > 0 {
+		i -= len(m.CookbookId)
+		copy(dAtA[i:], m.CookbookId)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.CookbookId)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.SourceChannel) > 0 {
+		i -= len(m.SourceChannel)
+		copy(dAtA[i:], m.SourceChannel)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.SourceChannel)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.SourcePort) > 0 {
+		i -= len(m.SourcePort)
+		copy(dAtA[i:], m.SourcePort)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.SourcePort)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Creator) > 0 {
+		i -= len(m.Creator)
+		copy(dAtA[i:], m.Creator)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Creator)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgTransferItemsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgTransferItemsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgTransferItemsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Sequence != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.Sequence))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgExecuteRecipe) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgExecuteRecipe) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgExecuteRecipe) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.PaymentInfos) > 0 {
+		for iNdEx := len(m.PaymentInfos) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.PaymentInfos[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x32
+		}
+	}
+	if len(m.ItemIds) > 0 {
+		for iNdEx := len(m.ItemIds) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.ItemIds[iNdEx])
+			copy(dAtA[i:], m.ItemIds[iNdEx])
+			i = encodeVarintTx(dAtA, i, uint64(len(m.ItemIds[iNdEx])))
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
+	if m.CoinInputsIndex != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.CoinInputsIndex))
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.RecipeId) > 0 {
+		i -= len(m.RecipeId)
+		copy(dAtA[i:], m.RecipeId)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.RecipeId)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.CookbookId) > 0 {
+		i -= len(m.CookbookId)
+		copy(dAtA[i:], m.CookbookId)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.CookbookId)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Creator) > 0 {
+		i -= len(m.Creator)
+		copy(dAtA[i:], m.Creator)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Creator)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgExecuteRecipeResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgExecuteRecipeResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgExecuteRecipeResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Id) > 0 {
+		i -= len(m.Id)
+		copy(dAtA[i:], m.Id)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Id)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSetItemString) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSetItemString) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSetItemString) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Value) > 0 {
+		i -= len(m.Value)
+		copy(dAtA[i:], m.Value)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Value)))
+		i--
+		dAtA[i] = 0x32
+	}
+	if len(m.Field) > 0 {
+		i -= len(m.Field)
+		copy(dAtA[i:], m.Field)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Field)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.Id) > 0 {
+		i -= len(m.Id)
+		copy(dAtA[i:], m.Id)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Id)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.CookbookId) > 0 {
+		i -= len(m.CookbookId)
+		copy(dAtA[i:], m.CookbookId)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.CookbookId)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Creator) > 0 {
+		i -= len(m.Creator)
+		copy(dAtA[i:], m.Creator)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Creator)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSetItemStringResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSetItemStringResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSetItemStringResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgUpdateItemAttributes) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUpdateItemAttributes) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUpdateItemAttributes) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Strings) > 0 {
+		for iNdEx := len(m.Strings) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Strings[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x32
+		}
+	}
+	if len(m.Longs) > 0 {
+		for iNdEx := len(m.Longs) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Longs[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
+	if len(m.Doubles) > 0 {
+		for iNdEx := len(m.Doubles) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Doubles[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if len(m.Id) > 0 {
+		i -= len(m.Id)
+		copy(dAtA[i:], m.Id)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Id)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.CookbookId) > 0 {
+		i -= len(m.CookbookId)
+		copy(dAtA[i:], m.CookbookId)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.CookbookId)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Creator) > 0 {
+		i -= len(m.Creator)
+		copy(dAtA[i:], m.Creator)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Creator)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgUpdateItemAttributesResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUpdateItemAttributesResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUpdateItemAttributesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgDepositItems) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgDepositItems) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgDepositItems) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ItemIds) > 0 {
+		for iNdEx := len(m.ItemIds) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.ItemIds[iNdEx])
+			copy(dAtA[i:], m.ItemIds[iNdEx])
+			i = encodeVarintTx(dAtA, i, uint64(len(m.ItemIds[iNdEx])))
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if len(m.ContainerId) > 0 {
+		i -= len(m.ContainerId)
+		copy(dAtA[i:], m.ContainerId)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.ContainerId)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.CookbookId) > 0 {
+		i -= len(m.CookbookId)
+		copy(dAtA[i:], m.CookbookId)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.CookbookId)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Creator) > 0 {
+		i -= len(m.Creator)
+		copy(dAtA[i:], m.Creator)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Creator)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgDepositItemsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgDepositItemsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgDepositItemsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgWithdrawItems) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgWithdrawItems) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgWithdrawItems) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ItemIds) > 0 {
+		for iNdEx := len(m.ItemIds) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.ItemIds[iNdEx])
+			copy(dAtA[i:], m.ItemIds[iNdEx])
+			i = encodeVarintTx(dAtA, i, uint64(len(m.ItemIds[iNdEx])))
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if len(m.ContainerId) > 0 {
+		i -= len(m.ContainerId)
+		copy(dAtA[i:], m.ContainerId)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.ContainerId)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.CookbookId) > 0 {
+		i -= len(m.CookbookId)
+		copy(dAtA[i:], m.CookbookId)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.CookbookId)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Creator) > 0 {
+		i -= len(m.Creator)
+		copy(dAtA[i:], m.Creator)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Creator)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgWithdrawItemsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgWithdrawItemsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgWithdrawItemsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgCreateRecipe) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgCreateRecipe) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgCreateRecipe) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ExtraInfo) > 0 {
+		i -= len(m.ExtraInfo)
+		copy(dAtA[i:], m.ExtraInfo)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.ExtraInfo)))
+		i--
+		dAtA[i] = 0x72
+	}
+	if m.Enabled {
+		i--
+		if m.Enabled {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x68
+	}
+	{
+		size, err := m.CostPerBlock.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x62
+	if m.BlockInterval != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.BlockInterval))
+		i--
+		dAtA[i] = 0x58
+	}
+	if len(m.Outputs) > 0 {
+		for iNdEx := len(m.Outputs) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Outputs[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x52
+		}
+	}
+	{
+		size, err := m.Entries.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x4a
+	if len(m.ItemInputs) > 0 {
+		for iNdEx := len(m.ItemInputs) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ItemInputs[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x42
+		}
+	}
+	if len(m.CoinInputs) > 0 {
+		for iNdEx := len(m.CoinInputs) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.CoinInputs[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x3a
+		}
+	}
+	if len(m.Version) > 0 {
+		i -= len(m.Version)
+		copy(dAtA[i:], m.Version)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Version)))
+		i--
+		dAtA[i] = 0x32
+	}
+	if len(m.Description) > 0 {
+		i -= len(m.Description)
+		copy(dAtA[i:], m.Description)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Description)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.Name) > 0 {
+		i -= len(m.Name)
+		copy(dAtA[i:], m.Name)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Name)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.Id) > 0 {
+		i -= len(m.Id)
+		copy(dAtA[i:], m.Id)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Id)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.CookbookId) > 0 {
+		i -= len(m.CookbookId)
+		copy(dAtA[i:], m.CookbookId)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.CookbookId)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Creator) > 0 {
+		i -= len(m.Creator)
+		copy(dAtA[i:], m.Creator)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Creator)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgCreateRecipeResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgCreateRecipeResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgCreateRecipeResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgUpdateRecipe) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUpdateRecipe) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUpdateRecipe) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ExtraInfo) > 0 {
+		i -= len(m.ExtraInfo)
+		copy(dAtA[i:], m.ExtraInfo)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.ExtraInfo)))
+		i--
+		dAtA[i] = 0x72
+	}
+	if m.Enabled {
+		i--
+		if m.Enabled {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x68
+	}
+	{
+		size, err := m.CostPerBlock.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x62
+	if m.BlockInterval != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.BlockInterval))
+		i--
+		dAtA[i] = 0x58
+	}
+	if len(m.Outputs) > 0 {
+		for iNdEx := len(m.Outputs) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Outputs[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x52
+		}
+	}
+	{
+		size, err := m.Entries.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x4a
+	if len(m.ItemInputs) > 0 {
+		for iNdEx := len(m.ItemInputs) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ItemInputs[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x42
+		}
+	}
+	if len(m.CoinInputs) > 0 {
+		for iNdEx := len(m.CoinInputs) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.CoinInputs[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x3a
+		}
+	}
+	if len(m.Version) > 0 {
+		i -= len(m.Version)
+		copy(dAtA[i:], m.Version)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Version)))
+		i--
+		dAtA[i] = 0x32
+	}
+	if len(m.Description) > 0 {
+		i -= len(m.Description)
+		copy(dAtA[i:], m.Description)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Description)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.Name) > 0 {
+		i -= len(m.Name)
+		copy(dAtA[i:], m.Name)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Name)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.Id) > 0 {
+		i -= len(m.Id)
+		copy(dAtA[i:], m.Id)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Id)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.CookbookId) > 0 {
+		i -= len(m.CookbookId)
+		copy(dAtA[i:], m.CookbookId)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.CookbookId)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Creator) > 0 {
+		i -= len(m.Creator)
+		copy(dAtA[i:], m.Creator)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Creator)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgUpdateRecipeResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUpdateRecipeResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUpdateRecipeResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgCreateCookbook) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgCreateCookbook) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgCreateCookbook) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.AttributeSchema) > 0 {
+		for iNdEx := len(m.AttributeSchema) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.AttributeSchema[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x62
+		}
+	}
+	if len(m.AttributePermissions) > 0 {
+		for iNdEx := len(m.AttributePermissions) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.AttributePermissions[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x5a
+		}
+	}
+	if len(m.IndexedAttributes) > 0 {
+		for iNdEx := len(m.IndexedAttributes) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.IndexedAttributes[iNdEx])
+			copy(dAtA[i:], m.IndexedAttributes[iNdEx])
+			i = encodeVarintTx(dAtA, i, uint64(len(m.IndexedAttributes[iNdEx])))
+			i--
+			dAtA[i] = 0x52
+		}
+	}
+	if len(m.BurnRefund) > 0 {
+		for iNdEx := len(m.BurnRefund) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.BurnRefund[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x4a
+		}
+	}
+	if m.Enabled {
+		i--
+		if m.Enabled {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x40
+	}
+	if len(m.SupportEmail) > 0 {
+		i -= len(m.SupportEmail)
+		copy(dAtA[i:], m.SupportEmail)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.SupportEmail)))
+		i--
+		dAtA[i] = 0x3a
+	}
+	if len(m.Version) > 0 {
+		i -= len(m.Version)
+		copy(dAtA[i:], m.Version)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Version)))
+		i--
+		dAtA[i] = 0x32
+	}
+	if len(m.Developer) > 0 {
+		i -= len(m.Developer)
+		copy(dAtA[i:], m.Developer)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Developer)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.Description) > 0 {
+		i -= len(m.Description)
+		copy(dAtA[i:], m.Description)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Description)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.Name) > 0 {
+		i -= len(m.Name)
+		copy(dAtA[i:], m.Name)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Name)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Id) > 0 {
+		i -= len(m.Id)
+		copy(dAtA[i:], m.Id)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Id)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Creator) > 0 {
+		i -= len(m.Creator)
+		copy(dAtA[i:], m.Creator)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Creator)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgCreateCookbookResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgCreateCookbookResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgCreateCookbookResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgUpdateCookbook) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUpdateCookbook) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUpdateCookbook) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.AttributeSchema) > 0 {
+		for iNdEx := len(m.AttributeSchema) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.AttributeSchema[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x62
+		}
+	}
+	if len(m.AttributePermissions) > 0 {
+		for iNdEx := len(m.AttributePermissions) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.AttributePermissions[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x5a
+		}
+	}
+	if len(m.IndexedAttributes) > 0 {
+		for iNdEx := len(m.IndexedAttributes) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.IndexedAttributes[iNdEx])
+			copy(dAtA[i:], m.IndexedAttributes[iNdEx])
+			i = encodeVarintTx(dAtA, i, uint64(len(m.IndexedAttributes[iNdEx])))
+			i--
+			dAtA[i] = 0x52
+		}
+	}
+	if len(m.BurnRefund) > 0 {
+		for iNdEx := len(m.BurnRefund) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.BurnRefund[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x4a
+		}
+	}
+	if m.Enabled {
+		i--
+		if m.Enabled {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x40
+	}
+	if len(m.SupportEmail) > 0 {
+		i -= len(m.SupportEmail)
+		copy(dAtA[i:], m.SupportEmail)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.SupportEmail)))
+		i--
+		dAtA[i] = 0x3a
+	}
+	if len(m.Version) > 0 {
+		i -= len(m.Version)
+		copy(dAtA[i:], m.Version)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Version)))
+		i--
+		dAtA[i] = 0x32
+	}
+	if len(m.Developer) > 0 {
+		i -= len(m.Developer)
+		copy(dAtA[i:], m.Developer)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Developer)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.Description) > 0 {
+		i -= len(m.Description)
+		copy(dAtA[i:], m.Description)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Description)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.Name) > 0 {
+		i -= len(m.Name)
+		copy(dAtA[i:], m.Name)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Name)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Id) > 0 {
+		i -= len(m.Id)
+		copy(dAtA[i:], m.Id)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Id)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Creator) > 0 {
+		i -= len(m.Creator)
+		copy(dAtA[i:], m.Creator)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Creator)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgUpdateCookbookResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUpdateCookbookResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUpdateCookbookResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
+	offset -= sovTx(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *MsgAppleIap) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Creator)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.ProductId)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.PurchaseId)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.ReceiptDataBase64)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgAppleIapResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgAddStripeRefund) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Creator)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.Payment != nil {
+		l = m.Payment.Size()
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgAddStripeRefundResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgBurnDebtToken) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Creator)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.RedeemInfo.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgBurnDebtTokenResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgUpdateAccount) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Creator)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Username)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgUpdateAccountResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgCreateAccount) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Creator)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Username)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Token)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.ReferralAddress)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgCreateAccountResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgFulfillTrade) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Creator)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.Id != 0 {
+		n += 1 + sovTx(uint64(m.Id))
+	}
+	if m.CoinInputsIndex != 0 {
+		n += 1 + sovTx(uint64(m.CoinInputsIndex))
+	}
+	if len(m.Items) > 0 {
+		for _, e := range m.Items {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	if len(m.PaymentInfos) > 0 {
+		for _, e := range m.PaymentInfos {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	if m.FillAmount != 0 {
+		n += 1 + sovTx(uint64(m.FillAmount))
+	}
+	return n
+}
+
+func (m *MsgFulfillTradeResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgCreateTrade) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Creator)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if len(m.CoinInputs) > 0 {
+		for _, e := range m.CoinInputs {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	if len(m.ItemInputs) > 0 {
+		for _, e := range m.ItemInputs {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	if len(m.CoinOutputs) > 0 {
+		for _, e := range m.CoinOutputs {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	if len(m.ItemOutputs) > 0 {
+		for _, e := range m.ItemOutputs {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	l = len(m.ExtraInfo)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Receiver)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.ExpiresAtHeight != 0 {
+		n += 1 + sovTx(uint64(m.ExpiresAtHeight))
+	}
+	if m.ExpiresAt != 0 {
+		n += 1 + sovTx(uint64(m.ExpiresAt))
+	}
+	if m.Quantity != 0 {
+		n += 1 + sovTx(uint64(m.Quantity))
+	}
+	return n
+}
+
+func (m *MsgCreateTradeResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Id != 0 {
+		n += 1 + sovTx(uint64(m.Id))
+	}
+	return n
+}
+
+func (m *MsgCancelTrade) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Creator)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.Id != 0 {
+		n += 1 + sovTx(uint64(m.Id))
+	}
+	return n
+}
+
+func (m *MsgCancelTradeResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgCompleteExecutionEarly) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Creator)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Id)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgCompleteExecutionEarlyResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Id)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgTransferCookbook) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Creator)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Id)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Recipient)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgTransferCookbookResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgGoogleInAppPurchaseGetCoins) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Creator)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.ProductId)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.PurchaseToken)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.ReceiptDataBase64)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Signature)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgGoogleInAppPurchaseGetCoinsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgSendItems) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Creator)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Receiver)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if len(m.Items) > 0 {
+		for _, e := range m.Items {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *MsgSendItemsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgBurnItems) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Creator)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if len(m.Items) > 0 {
+		for _, e := range m.Items {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *MsgBurnItemsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgCreateLending) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Creator)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.Item.Size()
+	n += 1 + l + sovTx(uint64(l))
+	l = len(m.Borrower)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if len(m.Price) > 0 {
+		for _, e := range m.Price {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	if m.Duration != 0 {
+		n += 1 + sovTx(uint64(m.Duration))
+	}
+	return n
+}
+
+func (m *MsgCreateLendingResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Id != 0 {
+		n += 1 + sovTx(uint64(m.Id))
+	}
+	return n
+}
+
+func (m *MsgAcceptLending) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Creator)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.Id != 0 {
+		n += 1 + sovTx(uint64(m.Id))
+	}
+	return n
+}
+
+func (m *MsgAcceptLendingResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgCancelLending) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Creator)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.Id != 0 {
+		n += 1 + sovTx(uint64(m.Id))
+	}
+	return n
+}
+
+func (m *MsgCancelLendingResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgCreateAuction) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Creator)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if len(m.Items) > 0 {
+		for _, e := range m.Items {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	l = m.ReservePrice.Size()
+	n += 1 + l + sovTx(uint64(l))
+	if m.EndTime != 0 {
+		n += 1 + sovTx(uint64(m.EndTime))
+	}
+	return n
+}
+
+func (m *MsgCreateAuctionResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Id != 0 {
+		n += 1 + sovTx(uint64(m.Id))
+	}
+	return n
+}
+
+func (m *MsgPlaceBid) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Creator)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.Id != 0 {
+		n += 1 + sovTx(uint64(m.Id))
+	}
+	l = m.Amount.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgPlaceBidResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgCancelAuction) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Creator)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.Id != 0 {
+		n += 1 + sovTx(uint64(m.Id))
+	}
+	return n
+}
+
+func (m *MsgCancelAuctionResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgCreateDutchAuction) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Creator)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if len(m.Items) > 0 {
+		for _, e := range m.Items {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	l = m.StartPrice.Size()
+	n += 1 + l + sovTx(uint64(l))
+	l = m.FloorPrice.Size()
+	n += 1 + l + sovTx(uint64(l))
+	if m.StartHeight != 0 {
+		n += 1 + sovTx(uint64(m.StartHeight))
+	}
+	if m.EndHeight != 0 {
+		n += 1 + sovTx(uint64(m.EndHeight))
+	}
+	l = len(m.Decay)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgCreateDutchAuctionResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Id != 0 {
+		n += 1 + sovTx(uint64(m.Id))
+	}
+	return n
+}
+
+func (m *MsgBuyDutchAuction) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Creator)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.Id != 0 {
+		n += 1 + sovTx(uint64(m.Id))
+	}
+	l = m.MaxPrice.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgBuyDutchAuctionResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Price.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgCancelDutchAuction) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Creator)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.Id != 0 {
+		n += 1 + sovTx(uint64(m.Id))
+	}
+	return n
+}
+
+func (m *MsgCancelDutchAuctionResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgCreateItemOffer) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Creator)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.CookbookId)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.ItemId)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.ItemInput.Size()
+	n += 1 + l + sovTx(uint64(l))
+	if len(m.Price) > 0 {
+		for _, e := range m.Price {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	if m.ExpiresAtHeight != 0 {
+		n += 1 + sovTx(uint64(m.ExpiresAtHeight))
+	}
+	if m.ExpiresAt != 0 {
+		n += 1 + sovTx(uint64(m.ExpiresAt))
+	}
+	return n
+}
+
+func (m *MsgCreateItemOfferResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Id != 0 {
+		n += 1 + sovTx(uint64(m.Id))
+	}
+	return n
+}
+
+func (m *MsgAcceptItemOffer) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Creator)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.Id != 0 {
+		n += 1 + sovTx(uint64(m.Id))
+	}
+	l = len(m.ItemId)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgAcceptItemOfferResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgCancelItemOffer) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Creator)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.Id != 0 {
+		n += 1 + sovTx(uint64(m.Id))
+	}
+	return n
+}
+
+func (m *MsgCancelItemOfferResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgCreateSwap) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Creator)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if len(m.Participants) > 0 {
+		for _, e := range m.Participants {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *MsgCreateSwapResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Id != 0 {
+		n += 1 + sovTx(uint64(m.Id))
+	}
+	return n
+}
+
+func (m *MsgDepositSwap) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Creator)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.Id != 0 {
+		n += 1 + sovTx(uint64(m.Id))
+	}
+	return n
+}
+
+func (m *MsgDepositSwapResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgAbortSwap) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Creator)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.Id != 0 {
+		n += 1 + sovTx(uint64(m.Id))
+	}
+	return n
+}
+
+func (m *MsgAbortSwapResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgApproveItem) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Creator)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.CookbookId)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.ItemId)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Operator)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgApproveItemResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgRevokeItemApproval) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Creator)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.CookbookId)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.ItemId)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgRevokeItemApprovalResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgSetOperator) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Creator)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.CookbookId)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Operator)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.Approved {
+		n += 2
+	}
+	return n
+}
+
+func (m *MsgSetOperatorResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgTransferItems) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Creator)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.SourcePort)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.SourceChannel)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.CookbookId)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if len(m.ItemIds) > 0 {
+		for _, s := range m.ItemIds {
+			l = len(s)
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	l = len(m.Receiver)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.TimeoutHeight.Size()
+	n += 1 + l + sovTx(uint64(l))
+	if m.TimeoutTimestamp != 0 {
+		n += 1 + sovTx(uint64(m.TimeoutTimestamp))
+	}
+	l = len(m.Memo)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgTransferItemsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Sequence != 0 {
+		n += 1 + sovTx(uint64(m.Sequence))
+	}
+	return n
+}
+
+func (m *MsgExecuteRecipe) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Creator)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.CookbookId)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.RecipeId)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.CoinInputsIndex != 0 {
+		n += 1 + sovTx(uint64(m.CoinInputsIndex))
+	}
+	if len(m.ItemIds) > 0 {
+		for _, s := range m.ItemIds {
+			l = len(s)
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	if len(m.PaymentInfos) > 0 {
+		for _, e := range m.PaymentInfos {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *MsgExecuteRecipeResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Id)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgSetItemString) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Creator)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.CookbookId)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Id)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Field)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Value)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgSetItemStringResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgUpdateItemAttributes) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Creator)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.CookbookId)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Id)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if len(m.Doubles) > 0 {
+		for _, e := range m.Doubles {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	if len(m.Longs) > 0 {
+		for _, e := range m.Longs {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	if len(m.Strings) > 0 {
+		for _, e := range m.Strings {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *MsgUpdateItemAttributesResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgDepositItems) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Creator)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.CookbookId)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.ContainerId)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if len(m.ItemIds) > 0 {
+		for _, s := range m.ItemIds {
+			l = len(s)
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *MsgDepositItemsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgWithdrawItems) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Creator)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.CookbookId)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.ContainerId)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if len(m.ItemIds) > 0 {
+		for _, s := range m.ItemIds {
+			l = len(s)
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *MsgWithdrawItemsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgCreateRecipe) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Creator)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.CookbookId)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Id)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Description)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Version)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if len(m.CoinInputs) > 0 {
+		for _, e := range m.CoinInputs {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	if len(m.ItemInputs) > 0 {
+		for _, e := range m.ItemInputs {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	l = m.Entries.Size()
+	n += 1 + l + sovTx(uint64(l))
+	if len(m.Outputs) > 0 {
+		for _, e := range m.Outputs {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	if m.BlockInterval != 0 {
+		n += 1 + sovTx(uint64(m.BlockInterval))
+	}
+	l = m.CostPerBlock.Size()
+	n += 1 + l + sovTx(uint64(l))
+	if m.Enabled {
+		n += 2
+	}
+	l = len(m.ExtraInfo)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgCreateRecipeResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgUpdateRecipe) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Creator)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.CookbookId)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Id)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Description)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Version)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if len(m.CoinInputs) > 0 {
+		for _, e := range m.CoinInputs {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	if len(m.ItemInputs) > 0 {
+		for _, e := range m.ItemInputs {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	l = m.Entries.Size()
+	n += 1 + l + sovTx(uint64(l))
+	if len(m.Outputs) > 0 {
+		for _, e := range m.Outputs {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	if m.BlockInterval != 0 {
+		n += 1 + sovTx(uint64(m.BlockInterval))
+	}
+	l = m.CostPerBlock.Size()
+	n += 1 + l + sovTx(uint64(l))
+	if m.Enabled {
+		n += 2
+	}
+	l = len(m.ExtraInfo)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgUpdateRecipeResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgCreateCookbook) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Creator)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Id)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Description)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Developer)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Version)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.SupportEmail)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.Enabled {
+		n += 2
+	}
+	if len(m.BurnRefund) > 0 {
+		for _, e := range m.BurnRefund {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	if len(m.IndexedAttributes) > 0 {
+		for _, s := range m.IndexedAttributes {
+			l = len(s)
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	if len(m.AttributePermissions) > 0 {
+		for _, e := range m.AttributePermissions {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	if len(m.AttributeSchema) > 0 {
+		for _, e := range m.AttributeSchema {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *MsgCreateCookbookResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgUpdateCookbook) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Creator)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Id)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Description)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Developer)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Version)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.SupportEmail)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.Enabled {
+		n += 2
+	}
+	if len(m.BurnRefund) > 0 {
+		for _, e := range m.BurnRefund {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	if len(m.IndexedAttributes) > 0 {
+		for _, s := range m.IndexedAttributes {
+			l = len(s)
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	if len(m.AttributePermissions) > 0 {
+		for _, e := range m.AttributePermissions {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	if len(m.AttributeSchema) > 0 {
+		for _, e := range m.AttributeSchema {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *MsgUpdateCookbookResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func sovTx(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozTx(x uint64) (n int) {
+	return sovTx(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *MsgAppleIap) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgAppleIap: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgAppleIap: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Creator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProductId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ProductId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PurchaseId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PurchaseId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ReceiptDataBase64", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ReceiptDataBase64 = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgAppleIapResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgAppleIapResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgAppleIapResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgAddStripeRefund) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgAddStripeRefund: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgAddStripeRefund: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Creator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Payment", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Payment == nil {
+				m.Payment = &PaymentInfo{}
+			}
+			if err := m.Payment.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgAddStripeRefundResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgAddStripeRefundResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgAddStripeRefundResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgBurnDebtToken) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgBurnDebtToken: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgBurnDebtToken: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Creator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RedeemInfo", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.RedeemInfo.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgBurnDebtTokenResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgBurnDebtTokenResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgBurnDebtTokenResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgUpdateAccount) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgUpdateAccount: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgUpdateAccount: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Creator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Username", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Username = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgUpdateAccountResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgUpdateAccountResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgUpdateAccountResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgCreateAccount) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgCreateAccount: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgCreateAccount: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Creator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Username", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Username = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Token", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Token = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ReferralAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ReferralAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgCreateAccountResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgCreateAccountResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgCreateAccountResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgFulfillTrade) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgFulfillTrade: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgFulfillTrade: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Creator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			m.Id = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Id |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CoinInputsIndex", wireType)
+			}
+			m.CoinInputsIndex = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.CoinInputsIndex |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Items", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Items = append(m.Items, ItemRef{})
+			if err := m.Items[len(m.Items)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PaymentInfos", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PaymentInfos = append(m.PaymentInfos, PaymentInfo{})
+			if err := m.PaymentInfos[len(m.PaymentInfos)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FillAmount", wireType)
+			}
+			m.FillAmount = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.FillAmount |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgFulfillTradeResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgFulfillTradeResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgFulfillTradeResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgCreateTrade) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgCreateTrade: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgCreateTrade: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Creator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CoinInputs", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CoinInputs = append(m.CoinInputs, CoinInput{})
+			if err := m.CoinInputs[len(m.CoinInputs)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ItemInputs", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ItemInputs = append(m.ItemInputs, ItemInput{})
+			if err := m.ItemInputs[len(m.ItemInputs)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CoinOutputs", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CoinOutputs = append(m.CoinOutputs, types.Coin{})
+			if err := m.CoinOutputs[len(m.CoinOutputs)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ItemOutputs", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ItemOutputs = append(m.ItemOutputs, ItemRef{})
+			if err := m.ItemOutputs[len(m.ItemOutputs)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExtraInfo", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ExtraInfo = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Receiver", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Receiver = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExpiresAtHeight", wireType)
+			}
+			m.ExpiresAtHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ExpiresAtHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 9:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExpiresAt", wireType)
+			}
+			m.ExpiresAt = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ExpiresAt |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 10:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Quantity", wireType)
+			}
+			m.Quantity = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Quantity |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgCreateTradeResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgCreateTradeResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgCreateTradeResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			m.Id = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Id |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgCancelTrade) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgCancelTrade: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgCancelTrade: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Creator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			m.Id = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Id |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgCancelTradeResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgCancelTradeResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgCancelTradeResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgCompleteExecutionEarly) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgCompleteExecutionEarly: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgCompleteExecutionEarly: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Creator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Id = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgCompleteExecutionEarlyResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgCompleteExecutionEarlyResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgCompleteExecutionEarlyResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Id = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgTransferCookbook) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgTransferCookbook: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgTransferCookbook: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Creator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Id = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Recipient", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Recipient = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgTransferCookbookResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgTransferCookbookResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgTransferCookbookResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgGoogleInAppPurchaseGetCoins) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgGoogleInAppPurchaseGetCoins: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgGoogleInAppPurchaseGetCoins: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Creator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProductId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ProductId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PurchaseToken", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PurchaseToken = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ReceiptDataBase64", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ReceiptDataBase64 = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Signature", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Signature = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgGoogleInAppPurchaseGetCoinsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgGoogleInAppPurchaseGetCoinsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgGoogleInAppPurchaseGetCoinsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgSendItems) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgSendItems: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgSendItems: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Creator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Receiver", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Receiver = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Items", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Items = append(m.Items, ItemRef{})
+			if err := m.Items[len(m.Items)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgSendItemsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgSendItemsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgSendItemsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgBurnItems) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgBurnItems: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgBurnItems: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Creator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Items", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Items = append(m.Items, ItemRef{})
+			if err := m.Items[len(m.Items)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgBurnItemsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgBurnItemsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgBurnItemsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgCreateLending) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgCreateLending: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgCreateLending: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Creator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Item", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Item.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Borrower", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Borrower = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Price", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Price = append(m.Price, types.Coin{})
+			if err := m.Price[len(m.Price)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Duration", wireType)
+			}
+			m.Duration = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Duration |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgCreateLendingResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgCreateLendingResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgCreateLendingResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			m.Id = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Id |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgAcceptLending) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgAcceptLending: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgAcceptLending: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Creator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			m.Id = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Id |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgAcceptLendingResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgAcceptLendingResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgAcceptLendingResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgCancelLending) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgCancelLending: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgCancelLending: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Creator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			m.Id = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Id |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgCancelLendingResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgCancelLendingResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgCancelLendingResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgCreateAuction) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgCreateAuction: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgCreateAuction: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Creator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Items", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Items = append(m.Items, ItemRef{})
+			if err := m.Items[len(m.Items)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ReservePrice", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ReservePrice.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EndTime", wireType)
+			}
+			m.EndTime = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.EndTime |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgCreateAuctionResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgCreateAuctionResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgCreateAuctionResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			m.Id = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Id |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgPlaceBid) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgPlaceBid: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgPlaceBid: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Creator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			m.Id = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Id |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgPlaceBidResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgPlaceBidResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgPlaceBidResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgCancelAuction) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgCancelAuction: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgCancelAuction: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Creator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			m.Id = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Id |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgCancelAuctionResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgCancelAuctionResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgCancelAuctionResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgCreateDutchAuction) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgCreateDutchAuction: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgCreateDutchAuction: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Creator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Items", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Items = append(m.Items, ItemRef{})
+			if err := m.Items[len(m.Items)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StartPrice", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.StartPrice.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FloorPrice", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.FloorPrice.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StartHeight", wireType)
+			}
+			m.StartHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.StartHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EndHeight", wireType)
+			}
+			m.EndHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.EndHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Decay", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Decay = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgCreateDutchAuctionResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgCreateDutchAuctionResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgCreateDutchAuctionResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			m.Id = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Id |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgBuyDutchAuction) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgBuyDutchAuction: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgBuyDutchAuction: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Creator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			m.Id = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Id |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxPrice", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MaxPrice.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgBuyDutchAuctionResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgBuyDutchAuctionResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgBuyDutchAuctionResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Price", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Price.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgCancelDutchAuction) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgCancelDutchAuction: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgCancelDutchAuction: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Creator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			m.Id = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Id |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgCancelDutchAuctionResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgCancelDutchAuctionResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgCancelDutchAuctionResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgCreateItemOffer) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgCreateItemOffer: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgCreateItemOffer: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Creator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CookbookId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CookbookId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ItemId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ItemId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ItemInput", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ItemInput.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Price", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Price = append(m.Price, types.Coin{})
+			if err := m.Price[len(m.Price)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExpiresAtHeight", wireType)
+			}
+			m.ExpiresAtHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ExpiresAtHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExpiresAt", wireType)
+			}
+			m.ExpiresAt = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ExpiresAt |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgCreateItemOfferResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgCreateItemOfferResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgCreateItemOfferResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			m.Id = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Id |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
 }
-func (m *MsgAppleIap) Unmarshal(dAtA []byte) error {
+func (m *MsgAcceptItemOffer) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5353,10 +16460,10 @@ func (m *MsgAppleIap) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgAppleIap: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgAcceptItemOffer: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgAppleIap: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgAcceptItemOffer: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -5392,8 +16499,159 @@ func (m *MsgAppleIap) Unmarshal(dAtA []byte) error {
 			m.Creator = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			m.Id = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Id |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ProductId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ItemId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ItemId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgAcceptItemOfferResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgAcceptItemOfferResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgAcceptItemOfferResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgCancelItemOffer) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgCancelItemOffer: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgCancelItemOffer: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -5421,11 +16679,130 @@ func (m *MsgAppleIap) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ProductId = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 3:
+			m.Creator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			m.Id = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Id |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgCancelItemOfferResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgCancelItemOfferResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgCancelItemOfferResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgCreateSwap) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgCreateSwap: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgCreateSwap: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PurchaseId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -5453,13 +16830,13 @@ func (m *MsgAppleIap) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.PurchaseId = string(dAtA[iNdEx:postIndex])
+			m.Creator = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 4:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ReceiptDataBase64", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Participants", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -5469,23 +16846,25 @@ func (m *MsgAppleIap) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ReceiptDataBase64 = string(dAtA[iNdEx:postIndex])
+			m.Participants = append(m.Participants, SwapParticipant{})
+			if err := m.Participants[len(m.Participants)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -5508,7 +16887,7 @@ func (m *MsgAppleIap) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgAppleIapResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgCreateSwapResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5531,12 +16910,31 @@ func (m *MsgAppleIapResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgAppleIapResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgCreateSwapResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgAppleIapResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgCreateSwapResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			m.Id = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Id |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -5558,7 +16956,7 @@ func (m *MsgAppleIapResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgAddStripeRefund) Unmarshal(dAtA []byte) error {
+func (m *MsgDepositSwap) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5581,10 +16979,10 @@ func (m *MsgAddStripeRefund) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgAddStripeRefund: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgDepositSwap: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgAddStripeRefund: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgDepositSwap: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -5620,10 +17018,10 @@ func (m *MsgAddStripeRefund) Unmarshal(dAtA []byte) error {
 			m.Creator = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Payment", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
 			}
-			var msglen int
+			m.Id = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -5633,28 +17031,11 @@ func (m *MsgAddStripeRefund) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.Id |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthTx
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.Payment == nil {
-				m.Payment = &PaymentInfo{}
-			}
-			if err := m.Payment.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -5676,7 +17057,7 @@ func (m *MsgAddStripeRefund) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgAddStripeRefundResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgDepositSwapResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5699,10 +17080,10 @@ func (m *MsgAddStripeRefundResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgAddStripeRefundResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgDepositSwapResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgAddStripeRefundResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgDepositSwapResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -5726,7 +17107,7 @@ func (m *MsgAddStripeRefundResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgBurnDebtToken) Unmarshal(dAtA []byte) error {
+func (m *MsgAbortSwap) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5749,10 +17130,10 @@ func (m *MsgBurnDebtToken) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgBurnDebtToken: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgAbortSwap: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgBurnDebtToken: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgAbortSwap: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -5788,10 +17169,10 @@ func (m *MsgBurnDebtToken) Unmarshal(dAtA []byte) error {
 			m.Creator = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RedeemInfo", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
 			}
-			var msglen int
+			m.Id = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -5801,25 +17182,11 @@ func (m *MsgBurnDebtToken) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.Id |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthTx
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if err := m.RedeemInfo.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -5841,7 +17208,7 @@ func (m *MsgBurnDebtToken) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgBurnDebtTokenResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgAbortSwapResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5864,10 +17231,10 @@ func (m *MsgBurnDebtTokenResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgBurnDebtTokenResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgAbortSwapResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgBurnDebtTokenResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgAbortSwapResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -5891,7 +17258,7 @@ func (m *MsgBurnDebtTokenResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgUpdateAccount) Unmarshal(dAtA []byte) error {
+func (m *MsgApproveItem) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5914,10 +17281,10 @@ func (m *MsgUpdateAccount) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgUpdateAccount: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgApproveItem: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgUpdateAccount: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgApproveItem: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -5954,7 +17321,7 @@ func (m *MsgUpdateAccount) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Username", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field CookbookId", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -5982,7 +17349,71 @@ func (m *MsgUpdateAccount) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Username = string(dAtA[iNdEx:postIndex])
+			m.CookbookId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ItemId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ItemId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Operator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Operator = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -6005,7 +17436,7 @@ func (m *MsgUpdateAccount) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgUpdateAccountResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgApproveItemResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -6028,10 +17459,10 @@ func (m *MsgUpdateAccountResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgUpdateAccountResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgApproveItemResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgUpdateAccountResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgApproveItemResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -6055,7 +17486,7 @@ func (m *MsgUpdateAccountResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgCreateAccount) Unmarshal(dAtA []byte) error {
+func (m *MsgRevokeItemApproval) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -6078,10 +17509,10 @@ func (m *MsgCreateAccount) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgCreateAccount: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgRevokeItemApproval: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgCreateAccount: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgRevokeItemApproval: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -6118,7 +17549,7 @@ func (m *MsgCreateAccount) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Username", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field CookbookId", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -6146,43 +17577,11 @@ func (m *MsgCreateAccount) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Username = string(dAtA[iNdEx:postIndex])
+			m.CookbookId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Token", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthTx
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Token = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 4:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ReferralAddress", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ItemId", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -6210,7 +17609,7 @@ func (m *MsgCreateAccount) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ReferralAddress = string(dAtA[iNdEx:postIndex])
+			m.ItemId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -6233,7 +17632,7 @@ func (m *MsgCreateAccount) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgCreateAccountResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgRevokeItemApprovalResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -6256,10 +17655,10 @@ func (m *MsgCreateAccountResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgCreateAccountResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgRevokeItemApprovalResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgCreateAccountResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgRevokeItemApprovalResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -6283,7 +17682,7 @@ func (m *MsgCreateAccountResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgFulfillTrade) Unmarshal(dAtA []byte) error {
+func (m *MsgSetOperator) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -6306,10 +17705,10 @@ func (m *MsgFulfillTrade) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgFulfillTrade: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgSetOperator: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgFulfillTrade: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgSetOperator: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -6343,31 +17742,12 @@ func (m *MsgFulfillTrade) Unmarshal(dAtA []byte) error {
 				return io.ErrUnexpectedEOF
 			}
 			m.Creator = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
-			}
-			m.Id = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.Id |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 3:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CoinInputsIndex", wireType)
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CookbookId", wireType)
 			}
-			m.CoinInputsIndex = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -6377,16 +17757,29 @@ func (m *MsgFulfillTrade) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.CoinInputsIndex |= uint64(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 4:
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CookbookId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Items", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Operator", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -6396,31 +17789,29 @@ func (m *MsgFulfillTrade) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Items = append(m.Items, ItemRef{})
-			if err := m.Items[len(m.Items)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Operator = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 5:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PaymentInfos", wireType)
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Approved", wireType)
 			}
-			var msglen int
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -6430,26 +17821,12 @@ func (m *MsgFulfillTrade) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				v |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthTx
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.PaymentInfos = append(m.PaymentInfos, PaymentInfo{})
-			if err := m.PaymentInfos[len(m.PaymentInfos)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
+			m.Approved = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -6471,7 +17848,7 @@ func (m *MsgFulfillTrade) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgFulfillTradeResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgSetOperatorResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -6494,10 +17871,10 @@ func (m *MsgFulfillTradeResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgFulfillTradeResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgSetOperatorResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgFulfillTradeResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgSetOperatorResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -6521,7 +17898,7 @@ func (m *MsgFulfillTradeResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgCreateTrade) Unmarshal(dAtA []byte) error {
+func (m *MsgTransferItems) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -6544,10 +17921,10 @@ func (m *MsgCreateTrade) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgCreateTrade: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgTransferItems: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgCreateTrade: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgTransferItems: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -6584,9 +17961,9 @@ func (m *MsgCreateTrade) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CoinInputs", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field SourcePort", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -6596,31 +17973,29 @@ func (m *MsgCreateTrade) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.CoinInputs = append(m.CoinInputs, CoinInput{})
-			if err := m.CoinInputs[len(m.CoinInputs)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.SourcePort = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ItemInputs", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field SourceChannel", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -6630,31 +18005,29 @@ func (m *MsgCreateTrade) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ItemInputs = append(m.ItemInputs, ItemInput{})
-			if err := m.ItemInputs[len(m.ItemInputs)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.SourceChannel = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CoinOutputs", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field CookbookId", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -6664,31 +18037,29 @@ func (m *MsgCreateTrade) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.CoinOutputs = append(m.CoinOutputs, types.Coin{})
-			if err := m.CoinOutputs[len(m.CoinOutputs)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.CookbookId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ItemOutputs", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ItemIds", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -6698,29 +18069,27 @@ func (m *MsgCreateTrade) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ItemOutputs = append(m.ItemOutputs, ItemRef{})
-			if err := m.ItemOutputs[len(m.ItemOutputs)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.ItemIds = append(m.ItemIds, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
 		case 6:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ExtraInfo", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Receiver", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -6748,63 +18117,13 @@ func (m *MsgCreateTrade) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ExtraInfo = string(dAtA[iNdEx:postIndex])
+			m.Receiver = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipTx(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthTx
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *MsgCreateTradeResponse) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowTx
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: MsgCreateTradeResponse: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgCreateTradeResponse: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TimeoutHeight", wireType)
 			}
-			m.Id = 0
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -6814,64 +18133,47 @@ func (m *MsgCreateTradeResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Id |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		default:
-			iNdEx = preIndex
-			skippy, err := skipTx(dAtA[iNdEx:])
-			if err != nil {
-				return err
+			if msglen < 0 {
+				return ErrInvalidLengthTx
 			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
-			if (iNdEx + skippy) > l {
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *MsgCancelTrade) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowTx
+			if err := m.TimeoutHeight.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
+			iNdEx = postIndex
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TimeoutTimestamp", wireType)
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			m.TimeoutTimestamp = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TimeoutTimestamp |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: MsgCancelTrade: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgCancelTrade: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+		case 9:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Memo", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -6899,27 +18201,8 @@ func (m *MsgCancelTrade) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Creator = string(dAtA[iNdEx:postIndex])
+			m.Memo = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
-			}
-			m.Id = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.Id |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -6941,7 +18224,7 @@ func (m *MsgCancelTrade) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgCancelTradeResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgTransferItemsResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -6964,12 +18247,31 @@ func (m *MsgCancelTradeResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgCancelTradeResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgTransferItemsResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgCancelTradeResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgTransferItemsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sequence", wireType)
+			}
+			m.Sequence = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Sequence |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -6991,7 +18293,7 @@ func (m *MsgCancelTradeResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgCompleteExecutionEarly) Unmarshal(dAtA []byte) error {
+func (m *MsgExecuteRecipe) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -7014,10 +18316,10 @@ func (m *MsgCompleteExecutionEarly) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgCompleteExecutionEarly: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgExecuteRecipe: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgCompleteExecutionEarly: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgExecuteRecipe: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -7054,7 +18356,7 @@ func (m *MsgCompleteExecutionEarly) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field CookbookId", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -7082,61 +18384,11 @@ func (m *MsgCompleteExecutionEarly) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Id = string(dAtA[iNdEx:postIndex])
+			m.CookbookId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipTx(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthTx
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *MsgCompleteExecutionEarlyResponse) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowTx
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: MsgCompleteExecutionEarlyResponse: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgCompleteExecutionEarlyResponse: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field RecipeId", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -7164,63 +18416,13 @@ func (m *MsgCompleteExecutionEarlyResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Id = string(dAtA[iNdEx:postIndex])
+			m.RecipeId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipTx(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthTx
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *MsgTransferCookbook) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowTx
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: MsgTransferCookbook: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgTransferCookbook: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CoinInputsIndex", wireType)
 			}
-			var stringLen uint64
+			m.CoinInputsIndex = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -7230,27 +18432,14 @@ func (m *MsgTransferCookbook) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.CoinInputsIndex |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthTx
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Creator = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 2:
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ItemIds", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -7278,13 +18467,13 @@ func (m *MsgTransferCookbook) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Id = string(dAtA[iNdEx:postIndex])
+			m.ItemIds = append(m.ItemIds, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
-		case 3:
+		case 6:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Recipient", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field PaymentInfos", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -7294,23 +18483,25 @@ func (m *MsgTransferCookbook) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Recipient = string(dAtA[iNdEx:postIndex])
+			m.PaymentInfos = append(m.PaymentInfos, PaymentInfo{})
+			if err := m.PaymentInfos[len(m.PaymentInfos)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -7333,7 +18524,7 @@ func (m *MsgTransferCookbook) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgTransferCookbookResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgExecuteRecipeResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -7356,12 +18547,44 @@ func (m *MsgTransferCookbookResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgTransferCookbookResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgExecuteRecipeResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgTransferCookbookResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgExecuteRecipeResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Id = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -7383,7 +18606,7 @@ func (m *MsgTransferCookbookResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgGoogleInAppPurchaseGetCoins) Unmarshal(dAtA []byte) error {
+func (m *MsgSetItemString) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -7406,10 +18629,10 @@ func (m *MsgGoogleInAppPurchaseGetCoins) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgGoogleInAppPurchaseGetCoins: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgSetItemString: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgGoogleInAppPurchaseGetCoins: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgSetItemString: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -7446,7 +18669,7 @@ func (m *MsgGoogleInAppPurchaseGetCoins) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ProductId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field CookbookId", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -7474,11 +18697,11 @@ func (m *MsgGoogleInAppPurchaseGetCoins) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ProductId = string(dAtA[iNdEx:postIndex])
+			m.CookbookId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 3:
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PurchaseToken", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -7506,11 +18729,11 @@ func (m *MsgGoogleInAppPurchaseGetCoins) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.PurchaseToken = string(dAtA[iNdEx:postIndex])
+			m.Id = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 4:
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ReceiptDataBase64", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Field", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -7538,11 +18761,11 @@ func (m *MsgGoogleInAppPurchaseGetCoins) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ReceiptDataBase64 = string(dAtA[iNdEx:postIndex])
+			m.Field = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 5:
+		case 6:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Signature", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Value", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -7570,7 +18793,7 @@ func (m *MsgGoogleInAppPurchaseGetCoins) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Signature = string(dAtA[iNdEx:postIndex])
+			m.Value = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -7593,7 +18816,7 @@ func (m *MsgGoogleInAppPurchaseGetCoins) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgGoogleInAppPurchaseGetCoinsResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgSetItemStringResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -7616,10 +18839,10 @@ func (m *MsgGoogleInAppPurchaseGetCoinsResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgGoogleInAppPurchaseGetCoinsResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgSetItemStringResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgGoogleInAppPurchaseGetCoinsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgSetItemStringResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -7643,7 +18866,7 @@ func (m *MsgGoogleInAppPurchaseGetCoinsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgSendItems) Unmarshal(dAtA []byte) error {
+func (m *MsgUpdateItemAttributes) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -7666,10 +18889,10 @@ func (m *MsgSendItems) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgSendItems: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgUpdateItemAttributes: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgSendItems: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgUpdateItemAttributes: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -7706,7 +18929,7 @@ func (m *MsgSendItems) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Receiver", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field CookbookId", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -7734,11 +18957,43 @@ func (m *MsgSendItems) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Receiver = string(dAtA[iNdEx:postIndex])
+			m.CookbookId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Items", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Id = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Doubles", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -7765,8 +19020,76 @@ func (m *MsgSendItems) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Items = append(m.Items, ItemRef{})
-			if err := m.Items[len(m.Items)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Doubles = append(m.Doubles, DoubleKeyValue{})
+			if err := m.Doubles[len(m.Doubles)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Longs", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Longs = append(m.Longs, LongKeyValue{})
+			if err := m.Longs[len(m.Longs)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Strings", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Strings = append(m.Strings, StringKeyValue{})
+			if err := m.Strings[len(m.Strings)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -7791,7 +19114,7 @@ func (m *MsgSendItems) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgSendItemsResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgUpdateItemAttributesResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -7814,10 +19137,10 @@ func (m *MsgSendItemsResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgSendItemsResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgUpdateItemAttributesResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgSendItemsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgUpdateItemAttributesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -7841,7 +19164,7 @@ func (m *MsgSendItemsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgExecuteRecipe) Unmarshal(dAtA []byte) error {
+func (m *MsgDepositItems) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -7864,10 +19187,10 @@ func (m *MsgExecuteRecipe) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgExecuteRecipe: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgDepositItems: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgExecuteRecipe: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgDepositItems: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -7936,7 +19259,7 @@ func (m *MsgExecuteRecipe) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RecipeId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ContainerId", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -7964,28 +19287,9 @@ func (m *MsgExecuteRecipe) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.RecipeId = string(dAtA[iNdEx:postIndex])
+			m.ContainerId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 4:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CoinInputsIndex", wireType)
-			}
-			m.CoinInputsIndex = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.CoinInputsIndex |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 5:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field ItemIds", wireType)
 			}
@@ -8017,40 +19321,6 @@ func (m *MsgExecuteRecipe) Unmarshal(dAtA []byte) error {
 			}
 			m.ItemIds = append(m.ItemIds, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
-		case 6:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PaymentInfos", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthTx
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.PaymentInfos = append(m.PaymentInfos, PaymentInfo{})
-			if err := m.PaymentInfos[len(m.PaymentInfos)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -8072,7 +19342,7 @@ func (m *MsgExecuteRecipe) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgExecuteRecipeResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgDepositItemsResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -8095,44 +19365,12 @@ func (m *MsgExecuteRecipeResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgExecuteRecipeResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgDepositItemsResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgExecuteRecipeResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgDepositItemsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
-		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthTx
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Id = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -8154,7 +19392,7 @@ func (m *MsgExecuteRecipeResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgSetItemString) Unmarshal(dAtA []byte) error {
+func (m *MsgWithdrawItems) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -8177,10 +19415,10 @@ func (m *MsgSetItemString) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgSetItemString: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgWithdrawItems: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgSetItemString: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgWithdrawItems: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -8247,41 +19485,9 @@ func (m *MsgSetItemString) Unmarshal(dAtA []byte) error {
 			}
 			m.CookbookId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 4:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthTx
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Id = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 5:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Field", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ContainerId", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -8309,11 +19515,11 @@ func (m *MsgSetItemString) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Field = string(dAtA[iNdEx:postIndex])
+			m.ContainerId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 6:
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Value", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ItemIds", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -8341,7 +19547,7 @@ func (m *MsgSetItemString) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Value = string(dAtA[iNdEx:postIndex])
+			m.ItemIds = append(m.ItemIds, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -8364,7 +19570,7 @@ func (m *MsgSetItemString) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgSetItemStringResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgWithdrawItemsResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -8387,10 +19593,10 @@ func (m *MsgSetItemStringResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgSetItemStringResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgWithdrawItemsResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgSetItemStringResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgWithdrawItemsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -9749,6 +20955,140 @@ func (m *MsgCreateCookbook) Unmarshal(dAtA []byte) error {
 				}
 			}
 			m.Enabled = bool(v != 0)
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BurnRefund", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.BurnRefund = append(m.BurnRefund, types.Coin{})
+			if err := m.BurnRefund[len(m.BurnRefund)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IndexedAttributes", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.IndexedAttributes = append(m.IndexedAttributes, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AttributePermissions", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AttributePermissions = append(m.AttributePermissions, ItemAttributePermission{})
+			if err := m.AttributePermissions[len(m.AttributePermissions)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 12:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AttributeSchema", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AttributeSchema = append(m.AttributeSchema, ItemAttributeSchema{})
+			if err := m.AttributeSchema[len(m.AttributeSchema)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -10093,6 +21433,140 @@ func (m *MsgUpdateCookbook) Unmarshal(dAtA []byte) error {
 				}
 			}
 			m.Enabled = bool(v != 0)
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BurnRefund", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.BurnRefund = append(m.BurnRefund, types.Coin{})
+			if err := m.BurnRefund[len(m.BurnRefund)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IndexedAttributes", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.IndexedAttributes = append(m.IndexedAttributes, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AttributePermissions", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AttributePermissions = append(m.AttributePermissions, ItemAttributePermission{})
+			if err := m.AttributePermissions[len(m.AttributePermissions)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 12:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AttributeSchema", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AttributeSchema = append(m.AttributeSchema, ItemAttributeSchema{})
+			if err := m.AttributeSchema[len(m.AttributeSchema)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])